@@ -258,6 +258,12 @@ config.yaml: |
     {{- end }}
     tokenRequestor:
       concurrentSyncs: {{ required ".Values.config.controllers.tokenRequestor.concurrentSyncs is required" .Values.config.controllers.tokenRequestor.concurrentSyncs }}
+    {{- if .Values.config.controllers.shootWebhooks }}
+    shootWebhooks:
+      {{- if .Values.config.controllers.shootWebhooks.concurrentSyncs }}
+      concurrentSyncs: {{ .Values.config.controllers.shootWebhooks.concurrentSyncs }}
+      {{- end }}
+    {{- end }}
   resources:
     capacity:
       shoots: {{ required ".Values.config.resources.capacity.shoots is required" .Values.config.resources.capacity.shoots }}
@@ -81,10 +81,22 @@ config.yaml: |
       {{- if .Values.config.controllers.garden.syncPeriod }}
       syncPeriod: {{ .Values.config.controllers.garden.syncPeriod }}
       {{- end }}
+      {{- if .Values.config.controllers.garden.etcdBackupMigrationRetentionPeriod }}
+      etcdBackupMigrationRetentionPeriod: {{ .Values.config.controllers.garden.etcdBackupMigrationRetentionPeriod }}
+      {{- end }}
       {{- if .Values.config.controllers.garden.etcdConfig }}
       etcdConfig:
 {{ toYaml .Values.config.controllers.garden.etcdConfig | indent 8 }}
       {{- end }}
+    {{- if .Values.config.controllers.extension }}
+    extension:
+      {{- if .Values.config.controllers.extension.concurrentSyncs }}
+      concurrentSyncs: {{ .Values.config.controllers.extension.concurrentSyncs }}
+      {{- end }}
+      {{- if .Values.config.controllers.extension.syncPeriod }}
+      syncPeriod: {{ .Values.config.controllers.extension.syncPeriod }}
+      {{- end }}
+    {{- end }}
     {{- if .Values.config.controllers.gardenCare }}
     gardenCare:
       {{- if .Values.config.controllers.gardenCare.syncPeriod }}
@@ -95,6 +107,12 @@ config.yaml: |
 {{ toYaml .Values.config.controllers.gardenCare.conditionThresholds | indent 6 }}
       {{- end }}
     {{- end }}
+    {{- if .Values.config.controllers.gardenGardenlet }}
+    gardenGardenlet:
+      {{- if .Values.config.controllers.gardenGardenlet.syncPeriod }}
+      syncPeriod: {{ .Values.config.controllers.gardenGardenlet.syncPeriod }}
+      {{- end }}
+    {{- end }}
     {{- if .Values.config.controllers.networkPolicy }}
     networkPolicy:
       {{- if .Values.config.controllers.networkPolicy.concurrentSyncs }}
@@ -14,6 +14,7 @@
 
 //go:generate ../../hack/generate-crds.sh -p 10-crd- --allow-dangerous-types operator.gardener.cloud
 //go:generate cp 10-crd-operator.gardener.cloud_gardens.yaml ../../charts/gardener/operator/templates/customresouredefintion.yaml
+//go:generate cp 10-crd-operator.gardener.cloud_extensions.yaml ../../charts/gardener/operator/templates/customresourcedefinition-extensions.yaml
 
 // Package operator contains example manifests for working on operator.
 package operator
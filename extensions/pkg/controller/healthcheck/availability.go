@@ -0,0 +1,139 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	// AvailabilityBucketDuration is the duration covered by a single availability bucket.
+	AvailabilityBucketDuration = time.Hour
+	// AvailabilityWindowShort is the short window for which the availability is computed.
+	AvailabilityWindowShort = 24 * time.Hour
+	// AvailabilityWindowLong is the long window for which the availability is computed. Buckets older than this window
+	// are removed from the status.
+	AvailabilityWindowLong = 7 * 24 * time.Hour
+)
+
+// recordAvailability records the given health check result for the condition type in the availability history, drops
+// buckets which are no longer relevant and recomputes the availability figures.
+func recordAvailability(availabilities []extensionsv1alpha1.HealthCheckAvailability, conditionType gardencorev1beta1.ConditionType, healthy bool, now time.Time) []extensionsv1alpha1.HealthCheckAvailability {
+	var (
+		result      = make([]extensionsv1alpha1.HealthCheckAvailability, 0, len(availabilities)+1)
+		bucketStart = metav1.NewTime(now.UTC().Truncate(AvailabilityBucketDuration))
+		found       bool
+	)
+
+	for _, availability := range availabilities {
+		if availability.Type == conditionType {
+			found = true
+			availability = *availability.DeepCopy()
+			availability.Buckets = addToBucket(availability.Buckets, bucketStart, healthy)
+		}
+		result = append(result, computeAvailability(availability, now))
+	}
+
+	if !found {
+		result = append(result, computeAvailability(extensionsv1alpha1.HealthCheckAvailability{
+			Type:    conditionType,
+			Buckets: addToBucket(nil, bucketStart, healthy),
+		}, now))
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Type < result[j].Type })
+	return result
+}
+
+// removeAvailability removes the availability history of the given condition types.
+func removeAvailability(availabilities []extensionsv1alpha1.HealthCheckAvailability, conditionTypes sets.Set[gardencorev1beta1.ConditionType]) []extensionsv1alpha1.HealthCheckAvailability {
+	var result []extensionsv1alpha1.HealthCheckAvailability
+	for _, availability := range availabilities {
+		if !conditionTypes.Has(availability.Type) {
+			result = append(result, availability)
+		}
+	}
+	return result
+}
+
+func addToBucket(buckets []extensionsv1alpha1.HealthCheckAvailabilityBucket, bucketStart metav1.Time, healthy bool) []extensionsv1alpha1.HealthCheckAvailabilityBucket {
+	if len(buckets) == 0 || !buckets[len(buckets)-1].Start.Equal(&bucketStart) {
+		buckets = append(buckets, extensionsv1alpha1.HealthCheckAvailabilityBucket{Start: bucketStart})
+	}
+
+	bucket := &buckets[len(buckets)-1]
+	bucket.Total++
+	if healthy {
+		bucket.Successful++
+	}
+
+	return buckets
+}
+
+func computeAvailability(availability extensionsv1alpha1.HealthCheckAvailability, now time.Time) extensionsv1alpha1.HealthCheckAvailability {
+	var buckets []extensionsv1alpha1.HealthCheckAvailabilityBucket
+	for _, bucket := range availability.Buckets {
+		if inWindow(bucket, now, AvailabilityWindowLong) {
+			buckets = append(buckets, bucket)
+		}
+	}
+
+	availability.Buckets = buckets
+	availability.Last24Hours = formatAvailability(availabilityPercentage(buckets, now, AvailabilityWindowShort))
+	availability.Last7Days = formatAvailability(availabilityPercentage(buckets, now, AvailabilityWindowLong))
+	return availability
+}
+
+// availabilityPercentage returns the percentage of successful health checks recorded in the buckets within the given
+// window. It returns false if no health check was recorded within the window.
+func availabilityPercentage(buckets []extensionsv1alpha1.HealthCheckAvailabilityBucket, now time.Time, window time.Duration) (float64, bool) {
+	var total, successful int64
+	for _, bucket := range buckets {
+		if inWindow(bucket, now, window) {
+			total += int64(bucket.Total)
+			successful += int64(bucket.Successful)
+		}
+	}
+
+	if total == 0 {
+		return 0, false
+	}
+	return float64(successful) * 100 / float64(total), true
+}
+
+// inWindow returns true if the given bucket belongs to the window ending at the current bucket (inclusive).
+func inWindow(bucket extensionsv1alpha1.HealthCheckAvailabilityBucket, now time.Time, window time.Duration) bool {
+	oldestBucketStart := now.UTC().Truncate(AvailabilityBucketDuration).Add(-window + AvailabilityBucketDuration)
+	return !bucket.Start.UTC().Before(oldestBucketStart)
+}
+
+// formatAvailability formats the percentage with two decimals. The value is truncated instead of rounded so that e.g.
+// 99.999% is not reported as 100%.
+func formatAvailability(percentage float64, ok bool) *string {
+	if !ok {
+		return nil
+	}
+	return pointer.String(fmt.Sprintf("%.2f", math.Floor(percentage*100)/100))
+}
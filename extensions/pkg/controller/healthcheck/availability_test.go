@@ -0,0 +1,116 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("availability", func() {
+	var (
+		now           = time.Date(2023, 6, 15, 12, 30, 0, 0, time.UTC)
+		currentBucket = metav1.NewTime(time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC))
+		conditionType = gardencorev1beta1.ConditionType("ControlPlaneHealthy")
+	)
+
+	Describe("#recordAvailability", func() {
+		It("should add a new entry for an unknown condition type", func() {
+			Expect(recordAvailability(nil, conditionType, true, now)).To(ConsistOf(extensionsv1alpha1.HealthCheckAvailability{
+				Type:        conditionType,
+				Last24Hours: pointer.String("100.00"),
+				Last7Days:   pointer.String("100.00"),
+				Buckets:     []extensionsv1alpha1.HealthCheckAvailabilityBucket{{Start: currentBucket, Total: 1, Successful: 1}},
+			}))
+		})
+
+		It("should add the result to the current bucket", func() {
+			availabilities := []extensionsv1alpha1.HealthCheckAvailability{{
+				Type:    conditionType,
+				Buckets: []extensionsv1alpha1.HealthCheckAvailabilityBucket{{Start: currentBucket, Total: 2, Successful: 2}},
+			}}
+
+			result := recordAvailability(availabilities, conditionType, false, now)
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].Buckets).To(Equal([]extensionsv1alpha1.HealthCheckAvailabilityBucket{{Start: currentBucket, Total: 3, Successful: 2}}))
+			Expect(result[0].Last24Hours).To(PointTo(Equal("66.66")))
+			Expect(result[0].Last7Days).To(PointTo(Equal("66.66")))
+			Expect(availabilities[0].Buckets[0].Total).To(Equal(int32(2)), "input must not be modified")
+		})
+
+		It("should start a new bucket, drop outdated buckets and compute both windows", func() {
+			availabilities := []extensionsv1alpha1.HealthCheckAvailability{{
+				Type: conditionType,
+				Buckets: []extensionsv1alpha1.HealthCheckAvailabilityBucket{
+					{Start: metav1.NewTime(currentBucket.Add(-AvailabilityWindowLong)), Total: 10, Successful: 0},
+					{Start: metav1.NewTime(currentBucket.Add(-48 * time.Hour)), Total: 10, Successful: 0},
+					{Start: metav1.NewTime(currentBucket.Add(-23 * time.Hour)), Total: 9, Successful: 9},
+				},
+			}}
+
+			result := recordAvailability(availabilities, conditionType, true, now)
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].Buckets).To(Equal([]extensionsv1alpha1.HealthCheckAvailabilityBucket{
+				{Start: metav1.NewTime(currentBucket.Add(-48 * time.Hour)), Total: 10, Successful: 0},
+				{Start: metav1.NewTime(currentBucket.Add(-23 * time.Hour)), Total: 9, Successful: 9},
+				{Start: currentBucket, Total: 1, Successful: 1},
+			}))
+			Expect(result[0].Last24Hours).To(PointTo(Equal("100.00")))
+			Expect(result[0].Last7Days).To(PointTo(Equal("50.00")))
+		})
+
+		It("should keep and prune the entries of other condition types", func() {
+			availabilities := []extensionsv1alpha1.HealthCheckAvailability{{
+				Type: "SystemComponentsHealthy",
+				Buckets: []extensionsv1alpha1.HealthCheckAvailabilityBucket{
+					{Start: metav1.NewTime(currentBucket.Add(-30 * time.Hour)), Total: 4, Successful: 3},
+				},
+			}}
+
+			result := recordAvailability(availabilities, conditionType, true, now)
+			Expect(result).To(HaveLen(2))
+			Expect(result[0].Type).To(Equal(conditionType))
+			Expect(result[1].Type).To(Equal(gardencorev1beta1.ConditionType("SystemComponentsHealthy")))
+			Expect(result[1].Last24Hours).To(BeNil())
+			Expect(result[1].Last7Days).To(PointTo(Equal("75.00")))
+		})
+	})
+
+	Describe("#removeAvailability", func() {
+		It("should remove the given condition types", func() {
+			availabilities := []extensionsv1alpha1.HealthCheckAvailability{{Type: conditionType}, {Type: "Foo"}}
+			Expect(removeAvailability(availabilities, sets.New[gardencorev1beta1.ConditionType]("Foo"))).To(Equal([]extensionsv1alpha1.HealthCheckAvailability{{Type: conditionType}}))
+		})
+	})
+
+	Describe("#formatAvailability", func() {
+		It("should truncate instead of rounding", func() {
+			Expect(formatAvailability(99.999, true)).To(PointTo(Equal("99.99")))
+		})
+
+		It("should return nil if no result was recorded", func() {
+			Expect(formatAvailability(0, false)).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,68 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var (
+	// AvailabilityMetric defines the gauge gardener_extension_healthcheck_availability_percent. The value is the
+	// percentage of successful health checks of a condition of an extension resource during the given window.
+	AvailabilityMetric = promauto.With(runtimemetrics.Registry).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "gardener_extension",
+			Name:      "healthcheck_availability_percent",
+			Help:      "Percentage of successful health checks of a condition of an extension resource during the given window.",
+		},
+		[]string{
+			"kind",
+			"namespace",
+			"name",
+			"condition",
+			"window",
+		},
+	)
+
+	availabilityWindows = map[string]time.Duration{
+		"24h": AvailabilityWindowShort,
+		"7d":  AvailabilityWindowLong,
+	}
+)
+
+func reportAvailabilityMetrics(kind, namespace, name string, availabilities []extensionsv1alpha1.HealthCheckAvailability, now time.Time) {
+	for _, availability := range availabilities {
+		for window, duration := range availabilityWindows {
+			labels := prometheus.Labels{"kind": kind, "namespace": namespace, "name": name, "condition": string(availability.Type), "window": window}
+
+			percentage, ok := availabilityPercentage(availability.Buckets, now, duration)
+			if !ok {
+				AvailabilityMetric.Delete(labels)
+				continue
+			}
+			AvailabilityMetric.With(labels).Set(percentage)
+		}
+	}
+}
+
+func deleteAvailabilityMetrics(kind, namespace, name string) {
+	AvailabilityMetric.DeletePartialMatch(prometheus.Labels{"kind": kind, "namespace": namespace, "name": name})
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -43,6 +44,7 @@ type reconciler struct {
 	actuator            HealthCheckActuator
 	registeredExtension RegisteredExtension
 	syncPeriod          metav1.Duration
+	clock               clock.Clock
 }
 
 const (
@@ -62,6 +64,7 @@ func NewReconciler(mgr manager.Manager, actuator HealthCheckActuator, registered
 		client:              mgr.GetClient(),
 		registeredExtension: registeredExtension,
 		syncPeriod:          syncPeriod,
+		clock:               clock.RealClock{},
 	}
 }
 
@@ -78,6 +81,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	extension := r.registeredExtension.getExtensionObjFunc()
 	if err := r.client.Get(ctx, request.NamespacedName, extension); err != nil {
 		if apierrors.IsNotFound(err) {
+			deleteAvailabilityMetrics(r.registeredExtension.groupVersionKind.Kind, request.Namespace, request.Name)
 			log.V(1).Info("Object was not found, requeueing")
 			return r.resultWithRequeue(), nil
 		}
@@ -90,6 +94,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if acc.GetDeletionTimestamp() != nil {
+		deleteAvailabilityMetrics(r.registeredExtension.groupVersionKind.Kind, acc.GetNamespace(), acc.GetName())
 		log.V(1).Info("Do not perform HealthCheck for extension resource, extension is being deleted")
 		return reconcile.Result{}, nil
 	}
@@ -113,6 +118,11 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 			}
 		}
 		extension.GetExtensionStatus().SetConditions(newConditions)
+
+		for _, conditionType := range r.registeredExtension.conditionTypesToRemove.UnsortedList() {
+			AvailabilityMetric.DeletePartialMatch(prometheus.Labels{"kind": r.registeredExtension.groupVersionKind.Kind, "namespace": acc.GetNamespace(), "name": acc.GetName(), "condition": string(conditionType)})
+		}
+		extension.GetExtensionStatus().SetHealthCheckAvailability(removeAvailability(extension.GetExtensionStatus().GetHealthCheckAvailability(), r.registeredExtension.conditionTypesToRemove))
 	}
 
 	if extensionscontroller.IsHibernationEnabled(cluster) {
@@ -269,23 +279,43 @@ func extensionConditionHibernated(conditionBuilder v1beta1helper.ConditionBuilde
 	return condition{
 		builder:             conditionBuilder,
 		healthConditionType: healthConditionType,
+		skipAvailability:    true,
 	}
 }
 
 type condition struct {
 	builder             v1beta1helper.ConditionBuilder
 	healthConditionType string
+	// skipAvailability specifies that the condition must not be recorded in the availability history, e.g. because
+	// the Shoot is hibernated.
+	skipAvailability bool
 }
 
 func (r *reconciler) updateExtensionConditions(ctx context.Context, extension extensionsv1alpha1.Object, conditions ...condition) error {
+	now := r.clock.Now()
+
 	for _, cond := range conditions {
 		if c := v1beta1helper.GetCondition(extension.GetExtensionStatus().GetConditions(), gardencorev1beta1.ConditionType(cond.healthConditionType)); c != nil {
 			cond.builder.WithOldCondition(*c)
 		}
-		updatedCondition, _ := cond.builder.WithClock(clock.RealClock{}).Build()
+		updatedCondition, _ := cond.builder.WithClock(r.clock).Build()
 		extension.GetExtensionStatus().SetConditions(v1beta1helper.MergeConditions(extension.GetExtensionStatus().GetConditions(), updatedCondition))
+
+		// Check errors (status 'Unknown') are not recorded since they indicate that the health could not be determined.
+		if cond.skipAvailability || updatedCondition.Status == gardencorev1beta1.ConditionUnknown {
+			continue
+		}
+
+		healthy := updatedCondition.Status == gardencorev1beta1.ConditionTrue || updatedCondition.Status == gardencorev1beta1.ConditionProgressing
+		extension.GetExtensionStatus().SetHealthCheckAvailability(recordAvailability(extension.GetExtensionStatus().GetHealthCheckAvailability(), updatedCondition.Type, healthy, now))
 	}
-	return r.client.Status().Update(ctx, extension)
+
+	if err := r.client.Status().Update(ctx, extension); err != nil {
+		return err
+	}
+
+	reportAvailabilityMetrics(r.registeredExtension.groupVersionKind.Kind, extension.GetNamespace(), extension.GetName(), extension.GetExtensionStatus().GetHealthCheckAvailability(), now)
+	return nil
 }
 
 func (r *reconciler) resultWithRequeue() reconcile.Result {
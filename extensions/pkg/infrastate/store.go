@@ -0,0 +1,210 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// Store reads and writes typed and versioned infrastructure state of type T from and to the `.status.state` field of
+// Infrastructure resources. Since gardenlet persists this field in the ShootState and writes it back before the
+// Infrastructure is restored, the state is preserved during control plane migration.
+type Store[T any] struct {
+	client            client.Client
+	version           int
+	migrations        map[int]MigrateFunc
+	terraformImporter TerraformImportFunc[T]
+}
+
+// Options are options for creating a Store.
+type Options[T any] struct {
+	// Version is the current schema version of the state type T. It must be increased whenever the schema of T is
+	// changed incompatibly, and a migration for the previous version must be registered.
+	Version int
+	// Migrations maps schema versions to functions migrating the serialized state data from this version to the next.
+	Migrations map[int]MigrateFunc
+	// TerraformImporter converts Terraform states into the typed state. If it is nil, Terraform states cannot be
+	// imported.
+	TerraformImporter TerraformImportFunc[T]
+}
+
+// NewStore creates a new Store for infrastructure state of type T.
+func NewStore[T any](c client.Client, opts Options[T]) *Store[T] {
+	return &Store[T]{
+		client:            c,
+		version:           opts.Version,
+		migrations:        opts.Migrations,
+		terraformImporter: opts.TerraformImporter,
+	}
+}
+
+// Get returns the typed state of the given Infrastructure. It returns nil if the state is empty. States of older
+// schema versions are migrated to the current version. If the Infrastructure still carries a raw Terraform state
+// (e.g., because it is restored from a ShootState written by a Terraformer based actuator), the state is imported with
+// the configured TerraformImporter.
+func (s *Store[T]) Get(infra *extensionsv1alpha1.Infrastructure) (*T, error) {
+	return s.Decode(infra.Status.State)
+}
+
+// Save persists the given state in the status of the given Infrastructure.
+func (s *Store[T]) Save(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, state *T) error {
+	raw, err := s.Encode(state)
+	if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(infra.DeepCopy())
+	infra.Status.State = raw
+	return s.client.Status().Patch(ctx, infra, patch)
+}
+
+// MigrateFromTerraform returns the typed state of the given Infrastructure. If the Infrastructure does not carry a
+// state managed by this package yet, the state is imported from the Terraform state ConfigMap of the Terraformer with
+// the given purpose (or from the raw Terraform state in the status if the ConfigMap does not exist), and it is saved in
+// the status of the Infrastructure. It returns nil if no state exists at all.
+// After the state was migrated successfully, the Terraformer resources can be removed with the
+// `RemoveTerraformerFinalizerFromConfig` and `CleanupConfiguration` functions of the Terraformer.
+func (s *Store[T]) MigrateFromTerraform(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, purpose string) (*T, error) {
+	if isPersistedState(infra.Status.State) {
+		return s.Get(infra)
+	}
+
+	if s.terraformImporter == nil {
+		return nil, fmt.Errorf("no Terraform importer configured")
+	}
+
+	terraformState, err := GetTerraformStateFromConfigMap(ctx, s.client, infra.Namespace, TerraformStateConfigMapName(infra.Name, purpose))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading Terraform state: %w", err)
+	}
+
+	if terraformState == nil {
+		if infra.Status.State == nil || len(infra.Status.State.Raw) == 0 {
+			return nil, nil
+		}
+
+		if terraformState, err = GetTerraformStateFromRawState(infra.Status.State); err != nil {
+			return nil, err
+		}
+		if terraformState == nil {
+			return nil, nil
+		}
+	}
+
+	state, err := s.terraformImporter(terraformState)
+	if err != nil {
+		return nil, fmt.Errorf("failed importing Terraform state: %w", err)
+	}
+
+	if err := s.Save(ctx, infra, state); err != nil {
+		return nil, fmt.Errorf("failed saving imported state: %w", err)
+	}
+
+	return state, nil
+}
+
+// Encode serializes the given state in the persisted format with the current schema version.
+func (s *Store[T]) Encode(state *T) (*runtime.RawExtension, error) {
+	if state == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling state: %w", err)
+	}
+
+	raw, err := json.Marshal(&persistedState{Kind: Kind, Version: s.version, Data: data})
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtime.RawExtension{Raw: raw}, nil
+}
+
+// Decode deserializes the given state and migrates it to the current schema version. Raw Terraform states are imported
+// with the configured TerraformImporter. It returns nil if the given state is empty.
+func (s *Store[T]) Decode(raw *runtime.RawExtension) (*T, error) {
+	if raw == nil || len(raw.Raw) == 0 {
+		return nil, nil
+	}
+
+	if !isPersistedState(raw) {
+		if s.terraformImporter == nil {
+			return nil, fmt.Errorf("state is not of kind %s and no Terraform importer is configured", Kind)
+		}
+
+		terraformState, err := GetTerraformStateFromRawState(raw)
+		if err != nil {
+			return nil, err
+		}
+		if terraformState == nil {
+			return nil, nil
+		}
+
+		return s.terraformImporter(terraformState)
+	}
+
+	persisted := &persistedState{}
+	if err := json.Unmarshal(raw.Raw, persisted); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling state: %w", err)
+	}
+
+	if persisted.Version > s.version {
+		return nil, fmt.Errorf("state has schema version %d which is newer than the supported version %d", persisted.Version, s.version)
+	}
+
+	data := []byte(persisted.Data)
+	for version := persisted.Version; version < s.version; version++ {
+		migrate, ok := s.migrations[version]
+		if !ok {
+			return nil, fmt.Errorf("no migration registered for schema version %d", version)
+		}
+
+		var err error
+		if data, err = migrate(data); err != nil {
+			return nil, fmt.Errorf("failed migrating state from schema version %d to %d: %w", version, version+1, err)
+		}
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	state := new(T)
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling state data: %w", err)
+	}
+
+	return state, nil
+}
+
+func isPersistedState(raw *runtime.RawExtension) bool {
+	if raw == nil || len(raw.Raw) == 0 {
+		return false
+	}
+
+	var sniff struct {
+		Kind string `json:"kind"`
+	}
+	return json.Unmarshal(raw.Raw, &sniff) == nil && sniff.Kind == Kind
+}
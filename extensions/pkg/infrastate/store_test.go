@@ -0,0 +1,177 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastate_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/extensions/pkg/infrastate"
+	"github.com/gardener/gardener/extensions/pkg/terraformer"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+type testState struct {
+	VPCID   string   `json:"vpcID"`
+	Subnets []string `json:"subnets,omitempty"`
+}
+
+var _ = Describe("Store", func() {
+	var (
+		ctx = context.TODO()
+
+		c     client.Client
+		infra *extensionsv1alpha1.Infrastructure
+		store *Store[testState]
+
+		importer = func(state *TerraformState) (*testState, error) {
+			vpcID, ok := state.OutputString("vpc_id")
+			if !ok {
+				return nil, fmt.Errorf("output vpc_id not found")
+			}
+			return &testState{VPCID: vpcID}, nil
+		}
+
+		rawTerraformState = func(data string) *runtime.RawExtension {
+			raw, err := (&terraformer.RawState{Data: data, Encoding: terraformer.NoneEncoding}).Marshal()
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return &runtime.RawExtension{Raw: raw}
+		}
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&extensionsv1alpha1.Infrastructure{}).Build()
+		infra = &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "bar"}}
+		Expect(c.Create(ctx, infra)).To(Succeed())
+
+		store = NewStore[testState](c, Options[testState]{
+			Version: 2,
+			Migrations: map[int]MigrateFunc{
+				1: func(data []byte) ([]byte, error) {
+					old := map[string]string{}
+					if err := json.Unmarshal(data, &old); err != nil {
+						return nil, err
+					}
+					return json.Marshal(&testState{VPCID: old["vpc"]})
+				},
+			},
+			TerraformImporter: importer,
+		})
+	})
+
+	Describe("#Encode and #Decode", func() {
+		It("should return nil for empty states", func() {
+			Expect(store.Encode(nil)).To(BeNil())
+			Expect(store.Decode(nil)).To(BeNil())
+			Expect(store.Decode(&runtime.RawExtension{})).To(BeNil())
+		})
+
+		It("should encode the state with kind and version", func() {
+			raw, err := store.Encode(&testState{VPCID: "vpc-1234"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(raw.Raw)).To(Equal(`{"kind":"InfrastructureState","version":2,"data":{"vpcID":"vpc-1234"}}`))
+
+			Expect(store.Decode(raw)).To(Equal(&testState{VPCID: "vpc-1234"}))
+		})
+
+		It("should migrate states of older schema versions", func() {
+			Expect(store.Decode(&runtime.RawExtension{Raw: []byte(`{"kind":"InfrastructureState","version":1,"data":{"vpc":"vpc-1234"}}`)})).To(Equal(&testState{VPCID: "vpc-1234"}))
+		})
+
+		It("should fail if no migration is registered for the schema version", func() {
+			_, err := store.Decode(&runtime.RawExtension{Raw: []byte(`{"kind":"InfrastructureState","version":0,"data":{}}`)})
+			Expect(err).To(MatchError("no migration registered for schema version 0"))
+		})
+
+		It("should fail for states of newer schema versions", func() {
+			_, err := store.Decode(&runtime.RawExtension{Raw: []byte(`{"kind":"InfrastructureState","version":3,"data":{}}`)})
+			Expect(err).To(MatchError(ContainSubstring("newer than the supported version 2")))
+		})
+
+		It("should import raw Terraform states", func() {
+			Expect(store.Decode(rawTerraformState(terraformStateV4))).To(Equal(&testState{VPCID: "vpc-1234"}))
+		})
+
+		It("should fail for raw Terraform states if no importer is configured", func() {
+			store = NewStore[testState](c, Options[testState]{Version: 1})
+			_, err := store.Decode(rawTerraformState(terraformStateV4))
+			Expect(err).To(MatchError(ContainSubstring("no Terraform importer is configured")))
+		})
+	})
+
+	Describe("#Get and #Save", func() {
+		It("should save the state in the status of the Infrastructure", func() {
+			Expect(store.Save(ctx, infra, &testState{VPCID: "vpc-1234", Subnets: []string{"a"}})).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+			Expect(store.Get(infra)).To(Equal(&testState{VPCID: "vpc-1234", Subnets: []string{"a"}}))
+		})
+	})
+
+	Describe("#MigrateFromTerraform", func() {
+		It("should return the state if it is already managed by the store", func() {
+			Expect(store.Save(ctx, infra, &testState{VPCID: "vpc-5678"})).To(Succeed())
+
+			Expect(store.MigrateFromTerraform(ctx, infra, "infra")).To(Equal(&testState{VPCID: "vpc-5678"}))
+		})
+
+		It("should return nil if no state exists", func() {
+			Expect(store.MigrateFromTerraform(ctx, infra, "infra")).To(BeNil())
+		})
+
+		It("should import the state from the Terraformer state ConfigMap and save it", func() {
+			Expect(c.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: infra.Namespace, Name: "bar.infra.tf-state"},
+				Data:       map[string]string{terraformer.StateKey: terraformStateV4},
+			})).To(Succeed())
+
+			Expect(store.MigrateFromTerraform(ctx, infra, "infra")).To(Equal(&testState{VPCID: "vpc-1234"}))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+			Expect(string(infra.Status.State.Raw)).To(ContainSubstring(`"kind":"InfrastructureState"`))
+		})
+
+		It("should import the raw Terraform state from the status if the ConfigMap does not exist", func() {
+			patch := client.MergeFrom(infra.DeepCopy())
+			infra.Status.State = rawTerraformState(terraformStateV3)
+			Expect(c.Status().Patch(ctx, infra, patch)).To(Succeed())
+
+			Expect(store.MigrateFromTerraform(ctx, infra, "infra")).To(Equal(&testState{VPCID: "vpc-1234"}))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+			Expect(store.Get(infra)).To(Equal(&testState{VPCID: "vpc-1234"}))
+		})
+
+		It("should fail if the Terraform state cannot be imported", func() {
+			Expect(c.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: infra.Namespace, Name: "bar.infra.tf-state"},
+				Data:       map[string]string{terraformer.StateKey: `{"version":4}`},
+			})).To(Succeed())
+
+			_, err := store.MigrateFromTerraform(ctx, infra, "infra")
+			Expect(err).To(MatchError(ContainSubstring("output vpc_id not found")))
+		})
+	})
+})
@@ -0,0 +1,150 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/extensions/pkg/terraformer"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// TerraformStateConfigMapName returns the name of the ConfigMap in which the Terraformer stores the Terraform state for
+// the given name and purpose.
+func TerraformStateConfigMapName(name, purpose string) string {
+	return fmt.Sprintf("%s.%s%s", name, purpose, terraformer.StateSuffix)
+}
+
+// GetTerraformStateFromConfigMap reads the Terraform state from the state ConfigMap of the Terraformer. It returns nil
+// if the ConfigMap does not exist or the state is empty.
+func GetTerraformStateFromConfigMap(ctx context.Context, c client.Reader, namespace, name string) (*TerraformState, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(ctx, kubernetesutils.Key(namespace, name), configMap); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+
+	return ParseTerraformState([]byte(configMap.Data[terraformer.StateKey]))
+}
+
+// GetTerraformStateFromRawState reads the Terraform state from the raw state format which the Terraformer based
+// actuators store in the `.status.state` field of Infrastructure resources. It returns nil if the state is empty.
+func GetTerraformStateFromRawState(raw *runtime.RawExtension) (*TerraformState, error) {
+	rawState, err := terraformer.UnmarshalRawState(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed unmarshalling raw Terraform state: %w", err)
+	}
+
+	return ParseTerraformState([]byte(rawState.Data))
+}
+
+// ParseTerraformState parses the given Terraform state file. Format versions 2, 3, and 4 are supported, however,
+// resources are only parsed for format version 4. It returns nil if the given data is empty.
+func ParseTerraformState(data []byte) (*TerraformState, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var sniff struct {
+		Version *int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &sniff); err != nil {
+		return nil, fmt.Errorf("the state file could not be parsed as JSON: %w", err)
+	}
+	if sniff.Version == nil {
+		return nil, fmt.Errorf("the state file does not have a \"version\" attribute, which is required to identify the format version")
+	}
+
+	state := &TerraformState{Version: *sniff.Version}
+
+	switch state.Version {
+	case 2, 3:
+		var stateV3 struct {
+			Modules []struct {
+				Outputs map[string]TerraformOutput `json:"outputs"`
+			} `json:"modules"`
+		}
+		if err := json.Unmarshal(data, &stateV3); err != nil {
+			return nil, err
+		}
+		if len(stateV3.Modules) > 0 {
+			state.Outputs = stateV3.Modules[0].Outputs
+		}
+	case 4:
+		var stateV4 struct {
+			Outputs   map[string]TerraformOutput `json:"outputs"`
+			Resources []TerraformResource        `json:"resources"`
+		}
+		if err := json.Unmarshal(data, &stateV4); err != nil {
+			return nil, err
+		}
+		state.Outputs = stateV4.Outputs
+		state.Resources = stateV4.Resources
+	default:
+		return nil, fmt.Errorf("the state file uses format version %d, which is not supported", state.Version)
+	}
+
+	return state, nil
+}
+
+// OutputString returns the value of the output variable with the given name as string.
+func (s *TerraformState) OutputString(name string) (string, bool) {
+	output, ok := s.Outputs[name]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprint(output.Value), true
+}
+
+// ManagedResources returns all managed resources of the root module with the given type.
+func (s *TerraformState) ManagedResources(resourceType string) []TerraformResource {
+	var resources []TerraformResource
+	for _, resource := range s.Resources {
+		if resource.Module == "" && resource.Mode == "managed" && resource.Type == resourceType {
+			resources = append(resources, resource)
+		}
+	}
+	return resources
+}
+
+// ManagedResource returns the managed resource of the root module with the given type and name, or nil if it does not
+// exist.
+func (s *TerraformState) ManagedResource(resourceType, name string) *TerraformResource {
+	resources := s.ManagedResources(resourceType)
+	for i := range resources {
+		if resources[i].Name == name {
+			return &resources[i]
+		}
+	}
+	return nil
+}
+
+// AttributeString returns the attribute with the given name of the first instance of the resource as string.
+func (r *TerraformResource) AttributeString(name string) (string, bool) {
+	if len(r.Instances) == 0 {
+		return "", false
+	}
+
+	value, ok := r.Instances[0].Attributes[name]
+	if !ok || value == nil {
+		return "", false
+	}
+	return fmt.Sprint(value), true
+}
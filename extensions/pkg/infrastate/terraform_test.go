@@ -0,0 +1,150 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastate_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/extensions/pkg/infrastate"
+	"github.com/gardener/gardener/extensions/pkg/terraformer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+const (
+	terraformStateV3 = `{"version":3,"modules":[{"outputs":{"vpc_id":{"type":"string","value":"vpc-1234"}}}]}`
+	terraformStateV4 = `{
+  "version": 4,
+  "outputs": {"vpc_id": {"type": "string", "value": "vpc-1234"}, "zones": {"type": ["list", "string"], "value": ["a", "b"]}},
+  "resources": [
+    {"mode": "managed", "type": "aws_vpc", "name": "vpc", "provider": "provider.aws", "instances": [{"attributes": {"id": "vpc-1234", "cidr_block": "10.0.0.0/16"}}]},
+    {"mode": "data", "type": "aws_vpc", "name": "existing", "provider": "provider.aws", "instances": [{"attributes": {"id": "vpc-5678"}}]},
+    {"mode": "managed", "type": "aws_subnet", "name": "nodes", "provider": "provider.aws", "instances": [{"index_key": 0, "attributes": {"id": "subnet-a"}}, {"index_key": 1, "attributes": {"id": "subnet-b"}}]},
+    {"module": "module.foo", "mode": "managed", "type": "aws_vpc", "name": "other", "provider": "provider.aws", "instances": [{"attributes": {"id": "vpc-9999"}}]}
+  ]
+}`
+)
+
+var _ = Describe("Terraform", func() {
+	found := func(value string, ok bool) string {
+		ExpectWithOffset(1, ok).To(BeTrue())
+		return value
+	}
+
+	Describe("#TerraformStateConfigMapName", func() {
+		It("should return the name of the Terraformer state ConfigMap", func() {
+			Expect(TerraformStateConfigMapName("shoot", "infra")).To(Equal("shoot.infra.tf-state"))
+		})
+	})
+
+	Describe("#ParseTerraformState", func() {
+		It("should return nil for empty data", func() {
+			Expect(ParseTerraformState(nil)).To(BeNil())
+		})
+
+		It("should fail for invalid JSON", func() {
+			_, err := ParseTerraformState([]byte("{"))
+			Expect(err).To(MatchError(ContainSubstring("could not be parsed as JSON")))
+		})
+
+		It("should fail if the version is missing", func() {
+			_, err := ParseTerraformState([]byte("{}"))
+			Expect(err).To(MatchError(ContainSubstring("does not have a \"version\" attribute")))
+		})
+
+		It("should fail for unsupported versions", func() {
+			_, err := ParseTerraformState([]byte(`{"version":5}`))
+			Expect(err).To(MatchError(ContainSubstring("format version 5")))
+		})
+
+		It("should parse the outputs of a version 3 state", func() {
+			state, err := ParseTerraformState([]byte(terraformStateV3))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.Version).To(Equal(3))
+			Expect(found(state.OutputString("vpc_id"))).To(Equal("vpc-1234"))
+			Expect(state.Resources).To(BeEmpty())
+		})
+
+		It("should parse the outputs and resources of a version 4 state", func() {
+			state, err := ParseTerraformState([]byte(terraformStateV4))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.Version).To(Equal(4))
+
+			Expect(found(state.OutputString("vpc_id"))).To(Equal("vpc-1234"))
+			_, ok := state.OutputString("foo")
+			Expect(ok).To(BeFalse())
+
+			Expect(state.ManagedResources("aws_subnet")).To(HaveLen(1))
+			Expect(state.ManagedResources("aws_subnet")[0].Instances).To(HaveLen(2))
+
+			vpc := state.ManagedResource("aws_vpc", "vpc")
+			Expect(vpc).NotTo(BeNil())
+			Expect(found(vpc.AttributeString("cidr_block"))).To(Equal("10.0.0.0/16"))
+			_, ok = vpc.AttributeString("foo")
+			Expect(ok).To(BeFalse())
+
+			Expect(state.ManagedResource("aws_vpc", "existing")).To(BeNil())
+			Expect(state.ManagedResource("aws_vpc", "other")).To(BeNil())
+		})
+	})
+
+	Describe("#GetTerraformStateFromConfigMap", func() {
+		var (
+			ctx = context.TODO()
+			c   client.Client
+		)
+
+		BeforeEach(func() {
+			c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		})
+
+		It("should return nil if the ConfigMap does not exist", func() {
+			Expect(GetTerraformStateFromConfigMap(ctx, c, "namespace", "shoot.infra.tf-state")).To(BeNil())
+		})
+
+		It("should read the state from the ConfigMap", func() {
+			Expect(c.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "shoot.infra.tf-state"},
+				Data:       map[string]string{terraformer.StateKey: terraformStateV4},
+			})).To(Succeed())
+
+			state, err := GetTerraformStateFromConfigMap(ctx, c, "namespace", "shoot.infra.tf-state")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found(state.OutputString("vpc_id"))).To(Equal("vpc-1234"))
+		})
+	})
+
+	Describe("#GetTerraformStateFromRawState", func() {
+		It("should return nil for an empty raw state", func() {
+			Expect(GetTerraformStateFromRawState(nil)).To(BeNil())
+		})
+
+		It("should read the state from the raw state", func() {
+			raw, err := (&terraformer.RawState{Data: terraformStateV4, Encoding: terraformer.NoneEncoding}).Marshal()
+			Expect(err).NotTo(HaveOccurred())
+
+			state, err := GetTerraformStateFromRawState(&runtime.RawExtension{Raw: raw})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found(state.OutputString("vpc_id"))).To(Equal("vpc-1234"))
+		})
+	})
+})
@@ -0,0 +1,82 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastate
+
+import (
+	"encoding/json"
+)
+
+// Kind is the kind written into the persisted infrastructure state. It is used to distinguish states managed by this
+// package from other formats, e.g., the raw Terraform state written by the Terraformer.
+const Kind = "InfrastructureState"
+
+// persistedState is the format in which the typed infrastructure state is persisted in the `.status.state` field of
+// Infrastructure resources.
+type persistedState struct {
+	// Kind is always set to `InfrastructureState`.
+	Kind string `json:"kind"`
+	// Version is the schema version of the data.
+	Version int `json:"version"`
+	// Data is the typed state serialized as JSON.
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// MigrateFunc migrates the serialized state data from the schema version it was registered for to the next version.
+type MigrateFunc func(data []byte) ([]byte, error)
+
+// TerraformImportFunc converts a Terraform state into the typed infrastructure state.
+type TerraformImportFunc[T any] func(state *TerraformState) (*T, error)
+
+// TerraformState contains the relevant information of a Terraform state.
+type TerraformState struct {
+	// Version is the format version of the Terraform state.
+	Version int
+	// Outputs are the output variables of the Terraform state.
+	Outputs map[string]TerraformOutput
+	// Resources are the managed and data resources of the Terraform state. They are only available for format version 4.
+	Resources []TerraformResource
+}
+
+// TerraformOutput is an output variable of a Terraform state.
+type TerraformOutput struct {
+	// Type is the type of the output variable.
+	Type json.RawMessage `json:"type,omitempty"`
+	// Value is the value of the output variable.
+	Value interface{} `json:"value"`
+}
+
+// TerraformResource is a resource of a Terraform state.
+type TerraformResource struct {
+	// Module is the address of the module containing the resource. It is empty for the root module.
+	Module string `json:"module,omitempty"`
+	// Mode is either `managed` or `data`.
+	Mode string `json:"mode"`
+	// Type is the resource type, e.g. `aws_vpc`.
+	Type string `json:"type"`
+	// Name is the name of the resource in the Terraform configuration.
+	Name string `json:"name"`
+	// Provider is the provider configuration address.
+	Provider string `json:"provider"`
+	// Instances are the instances of the resource.
+	Instances []TerraformResourceInstance `json:"instances"`
+}
+
+// TerraformResourceInstance is an instance of a Terraform resource.
+type TerraformResourceInstance struct {
+	// IndexKey is the index of the instance if the resource uses `count` or `for_each`.
+	IndexKey interface{} `json:"index_key,omitempty"`
+	// Attributes are the attributes of the instance.
+	Attributes map[string]interface{} `json:"attributes"`
+}
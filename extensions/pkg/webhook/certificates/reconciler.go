@@ -70,7 +70,8 @@ type reconciler struct {
 	Identity string
 	// Name of the component.
 	ComponentName string
-	// ShootWebhookManagedResourceName is the name of the ManagedResource containing the raw shoot webhook config.
+	// ShootWebhookManagedResourceName is the name of the ManagedResource containing the raw shoot webhook config. If it
+	// is empty, the shoot webhook config is declared to gardenlet which deploys it into all relevant shoots.
 	ShootWebhookManagedResourceName string
 	// ShootNamespaceSelector is a label selector for shoot namespaces relevant to the extension.
 	ShootNamespaceSelector map[string]string
@@ -202,9 +203,16 @@ func (r *reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconc
 
 		r.AtomicShootWebhookConfigs.Store(r.ShootWebhookConfigs.DeepCopy())
 
-		// reconcile all shoot webhook configs with the freshly created CA bundle
-		if err := extensionsshootwebhook.ReconcileWebhooksForAllNamespaces(ctx, r.client, r.Namespace, r.ComponentName, r.ShootWebhookManagedResourceName, r.ShootNamespaceSelector, *r.ShootWebhookConfigs); err != nil {
-			return reconcile.Result{}, fmt.Errorf("error reconciling all shoot webhook configs: %w", err)
+		if r.ShootWebhookManagedResourceName == "" {
+			// declare the shoot webhook configs with the freshly created CA bundle, gardenlet rolls them out to all shoots
+			if err := extensionsshootwebhook.ReconcileWebhookDeclaration(ctx, r.client, r.Namespace, r.ComponentName, r.ShootNamespaceSelector, *r.ShootWebhookConfigs); err != nil {
+				return reconcile.Result{}, fmt.Errorf("error reconciling shoot webhook declaration: %w", err)
+			}
+		} else {
+			// reconcile all shoot webhook configs with the freshly created CA bundle
+			if err := extensionsshootwebhook.ReconcileWebhooksForAllNamespaces(ctx, r.client, r.Namespace, r.ComponentName, r.ShootWebhookManagedResourceName, r.ShootNamespaceSelector, *r.ShootWebhookConfigs); err != nil {
+				return reconcile.Result{}, fmt.Errorf("error reconciling all shoot webhook configs: %w", err)
+			}
 		}
 
 		if r.ShootWebhookConfigs.MutatingWebhookConfig != nil {
@@ -184,6 +184,8 @@ type AddToManagerOptions struct {
 
 // NewAddToManagerOptions creates new AddToManagerOptions with the given server name, server, and switch options.
 // It is supposed to be used for webhooks which should be automatically registered in the cluster via a MutatingWebhookConfiguration.
+// If shootWebhookManagedResourceName is empty, the shoot webhooks are declared to gardenlet which deploys them into all
+// shoots matching the shootNamespaceSelector (see docs/extensions/shoot-webhooks.md).
 func NewAddToManagerOptions(extensionName string, shootWebhookManagedResourceName string, shootNamespaceSelector map[string]string, serverOpts *ServerOptions, switchOpts *SwitchOptions) *AddToManagerOptions {
 	return &AddToManagerOptions{
 		extensionName:                   extensionName,
@@ -361,10 +363,23 @@ func (c *AddToManagerConfig) reconcileSeedWebhookConfig(mgr manager.Manager, web
 
 func (c *AddToManagerConfig) reconcileShootWebhookConfigs(mgr manager.Manager, shootWebhookConfigs extensionswebhook.Configs) func(ctx context.Context) error {
 	return func(ctx context.Context) error {
-		if shootWebhookConfigs.HasWebhookConfig() {
-			if err := extensionsshootwebhook.ReconcileWebhooksForAllNamespaces(ctx, mgr.GetClient(), c.Server.Namespace, c.extensionName, c.shootWebhookManagedResourceName, c.shootNamespaceSelector, shootWebhookConfigs); err != nil {
-				return fmt.Errorf("error reconciling all shoot webhook configs: %w", err)
+		if !shootWebhookConfigs.HasWebhookConfig() {
+			return nil
+		}
+
+		if c.shootWebhookManagedResourceName == "" {
+			if c.Server.Namespace == "" {
+				mgr.GetLogger().Info("Not declaring shoot webhooks to gardenlet since --webhook-config-namespace is not configured")
+				return nil
+			}
+			if err := extensionsshootwebhook.ReconcileWebhookDeclaration(ctx, mgr.GetClient(), c.Server.Namespace, c.extensionName, c.shootNamespaceSelector, shootWebhookConfigs); err != nil {
+				return fmt.Errorf("error reconciling shoot webhook declaration: %w", err)
 			}
+			return nil
+		}
+
+		if err := extensionsshootwebhook.ReconcileWebhooksForAllNamespaces(ctx, mgr.GetClient(), c.Server.Namespace, c.extensionName, c.shootWebhookManagedResourceName, c.shootNamespaceSelector, shootWebhookConfigs); err != nil {
+			return fmt.Errorf("error reconciling all shoot webhook configs: %w", err)
 		}
 
 		return nil
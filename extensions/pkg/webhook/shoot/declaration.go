@@ -0,0 +1,84 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/extensions/pkg/webhook"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+// WebhookDeclarationName returns the name of the ConfigMap declaring the shoot webhooks of the given extension.
+func WebhookDeclarationName(extensionName string) string {
+	return webhook.PrefixedName(extensionName) + "-shoot-webhooks"
+}
+
+// ReconcileWebhookDeclaration declares the given shoot webhook configurations to gardenlet by writing them into a
+// ConfigMap in the extension namespace. gardenlet deploys the declared webhook configurations into all shoot clusters
+// whose namespaces match the given selector and removes them again once they are no longer declared. Hence, extensions
+// using this function don't need to deploy the webhook configurations as part of their ControlPlane reconciliation.
+func ReconcileWebhookDeclaration(
+	ctx context.Context,
+	c client.Client,
+	extensionNamespace string,
+	extensionName string,
+	shootNamespaceSelector map[string]string,
+	shootWebhookConfigs webhook.Configs,
+) error {
+	data, err := managedresources.
+		NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer).
+		AddAllAndSerialize(shootWebhookConfigs.GetWebhookConfigs()...)
+	if err != nil {
+		return err
+	}
+
+	var selector []byte
+	if len(shootNamespaceSelector) > 0 {
+		if selector, err = json.Marshal(shootNamespaceSelector); err != nil {
+			return err
+		}
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: WebhookDeclarationName(extensionName), Namespace: extensionNamespace}}
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, configMap, func() error {
+		metav1.SetMetaDataLabel(&configMap.ObjectMeta, v1beta1constants.LabelShootWebhooks, "true")
+
+		if selector != nil {
+			metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, v1beta1constants.AnnotationShootWebhooksNamespaceSelector, string(selector))
+		} else {
+			delete(configMap.Annotations, v1beta1constants.AnnotationShootWebhooksNamespaceSelector)
+		}
+
+		configMap.Data = make(map[string]string, len(data))
+		for key, value := range data {
+			configMap.Data[key] = string(value)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("could not create or update shoot webhook declaration %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	. "github.com/gardener/gardener/extensions/pkg/webhook/shoot"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Declaration", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+
+		shootWebhookConfigs extensionswebhook.Configs
+
+		extensionName      = "provider-test"
+		extensionNamespace = "extension-provider-test-12345"
+		configMap          *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		shootWebhookConfigs = extensionswebhook.Configs{
+			MutatingWebhookConfig: &admissionregistrationv1.MutatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: extensionName},
+				Webhooks: []admissionregistrationv1.MutatingWebhook{{
+					Name:         "some-webhook",
+					ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: []byte("ca1")},
+				}},
+			},
+		}
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-provider-test-shoot-webhooks", Namespace: extensionNamespace}}
+	})
+
+	Describe("#WebhookDeclarationName", func() {
+		It("should return the expected name", func() {
+			Expect(WebhookDeclarationName(extensionName)).To(Equal(configMap.Name))
+		})
+	})
+
+	Describe("#ReconcileWebhookDeclaration", func() {
+		It("should create the declaration", func() {
+			Expect(ReconcileWebhookDeclaration(ctx, fakeClient, extensionNamespace, extensionName, map[string]string{"provider.extensions.gardener.cloud/test": "true"}, shootWebhookConfigs)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Labels).To(HaveKeyWithValue("extensions.gardener.cloud/shoot-webhooks", "true"))
+			Expect(configMap.Annotations).To(HaveKeyWithValue("extensions.gardener.cloud/shoot-namespace-selector", `{"provider.extensions.gardener.cloud/test":"true"}`))
+			Expect(configMap.Data).To(HaveKeyWithValue("mutatingwebhookconfiguration____provider-test.yaml", ContainSubstring("caBundle: Y2Ex")))
+		})
+
+		It("should update the declaration with a new CA bundle and without selector", func() {
+			Expect(ReconcileWebhookDeclaration(ctx, fakeClient, extensionNamespace, extensionName, map[string]string{"foo": "bar"}, shootWebhookConfigs)).To(Succeed())
+
+			Expect(extensionswebhook.InjectCABundleIntoWebhookConfig(shootWebhookConfigs.MutatingWebhookConfig, []byte("ca2"))).To(Succeed())
+			Expect(ReconcileWebhookDeclaration(ctx, fakeClient, extensionNamespace, extensionName, nil, shootWebhookConfigs)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Annotations).NotTo(HaveKey("extensions.gardener.cloud/shoot-namespace-selector"))
+			Expect(configMap.Data).To(HaveKeyWithValue("mutatingwebhookconfiguration____provider-test.yaml", ContainSubstring("caBundle: Y2Ey")))
+		})
+	})
+})
@@ -35,6 +35,7 @@ AVAILABLE_CODEGEN_OPTIONS=(
   "scheduler_groups"
   "gardenlet_groups"
   "resourcemanager_groups"
+  "deletionconfirmation_groups"
   "shoottolerationrestriction_groups"
   "shootdnsrewriting_groups"
   "provider_local_groups"
@@ -170,10 +171,11 @@ settings_groups() {
   echo "Generating API groups for pkg/apis/settings"
 
   bash "${PROJECT_ROOT}"/hack/generate-groups.sh \
-    "all" \
+    "client,lister,informer" \
     github.com/gardener/gardener/pkg/client/settings \
     github.com/gardener/gardener/pkg/apis \
     "settings:v1alpha1" \
+    --plural-exceptions "ShootDefaults:ShootDefaults,ClusterShootDefaults:ClusterShootDefaults" \
     -h "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt"
 
   bash "${PROJECT_ROOT}"/hack/generate-internal-groups.sh \
@@ -401,6 +403,28 @@ export -f nodeagent_groups
 
 # Componentconfig for admission plugins
 
+deletionconfirmation_groups() {
+  echo "Generating API groups for plugin/pkg/global/deletionconfirmation/apis/deletionconfirmation"
+
+  bash "${PROJECT_ROOT}"/hack/generate-internal-groups.sh \
+    deepcopy,defaulter \
+    github.com/gardener/gardener/pkg/client/componentconfig \
+    github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation/apis \
+    github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation/apis \
+    "deletionconfirmation:v1alpha1" \
+    -h "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt"
+
+  bash "${PROJECT_ROOT}"/hack/generate-internal-groups.sh \
+    conversion \
+    github.com/gardener/gardener/pkg/client/componentconfig \
+    github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation/apis \
+    github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation/apis \
+    "deletionconfirmation:v1alpha1" \
+    --extra-peer-dirs=github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation/apis/deletionconfirmation,github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation/apis/deletionconfirmation/v1alpha1,k8s.io/apimachinery/pkg/apis/meta/v1,k8s.io/apimachinery/pkg/conversion,k8s.io/apimachinery/pkg/runtime,k8s.io/component-base/config,k8s.io/component-base/config/v1alpha1 \
+    -h "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt"
+}
+export -f deletionconfirmation_groups
+
 shoottolerationrestriction_groups() {
   echo "Generating API groups for plugin/pkg/shoot/tolerationrestriction/apis/shoottolerationrestriction"
 
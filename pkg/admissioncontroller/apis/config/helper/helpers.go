@@ -97,3 +97,39 @@ func ServiceAccountMatches(subject rbacv1.Subject, userInfo authenticationv1.Use
 
 	return serviceaccount.MatchesUsername(subject.Namespace, subject.Name, userInfo.Username)
 }
+
+func serviceAccountMatch(userInfo authenticationv1.UserInfo, subjects []rbacv1.Subject) bool {
+	for _, subject := range subjects {
+		if subject.Kind == rbacv1.ServiceAccountKind {
+			if ServiceAccountMatches(subject, userInfo) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func userMatch(userInfo authenticationv1.UserInfo, subjects []rbacv1.Subject) bool {
+	for _, subject := range subjects {
+		var match bool
+		switch subject.Kind {
+		case rbacv1.UserKind:
+			match = UserMatches(subject, userInfo)
+		case rbacv1.GroupKind:
+			match = UserGroupMatches(subject, userInfo)
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// IsUnrestrictedUser returns `true` if the given user matches one of the given subjects.
+func IsUnrestrictedUser(userInfo authenticationv1.UserInfo, subjects []rbacv1.Subject) bool {
+	isServiceAccount := strings.HasPrefix(userInfo.Username, serviceaccount.ServiceAccountUsernamePrefix)
+	if isServiceAccount {
+		return serviceAccountMatch(userInfo, subjects)
+	}
+	return userMatch(userInfo, subjects)
+}
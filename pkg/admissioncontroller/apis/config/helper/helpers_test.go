@@ -141,4 +141,15 @@ var _ = Describe("Helpers test", func() {
 		Entry("service account name is found because of wildcard", serviceAccountConfigWildcard, "bar", "users", BeTrue()),
 		Entry("service account name is found because of different namespace", serviceAccountConfigWildcard, "foo", "foo", BeFalse()),
 	)
+
+	DescribeTable("#IsUnrestrictedUser",
+		func(userInfo authenticationv1.UserInfo, matcher gomegatypes.GomegaMatcher) {
+			Expect(IsUnrestrictedUser(userInfo, []rbacv1.Subject{userConfig, groupConfig, serviceAccountConfig})).To(matcher)
+		},
+		Entry("user is unrestricted", authenticationv1.UserInfo{Username: "user"}, BeTrue()),
+		Entry("group is unrestricted", authenticationv1.UserInfo{Username: "other", Groups: []string{"system:masters"}}, BeTrue()),
+		Entry("service account is unrestricted", authenticationv1.UserInfo{Username: serviceaccount.MakeUsername("bar", "foo")}, BeTrue()),
+		Entry("service account does not match user subjects", authenticationv1.UserInfo{Username: serviceaccount.MakeUsername("bar", "bar"), Groups: []string{"system:masters"}}, BeFalse()),
+		Entry("user is restricted", authenticationv1.UserInfo{Username: "other"}, BeFalse()),
+	)
 })
@@ -51,6 +51,8 @@ type ServerConfiguration struct {
 	Metrics *Server
 	// ResourceAdmissionConfiguration is the configuration for the resource admission.
 	ResourceAdmissionConfiguration *ResourceAdmissionConfiguration
+	// ObjectCountAdmissionConfiguration is the configuration for the object count admission.
+	ObjectCountAdmissionConfiguration *ObjectCountAdmissionConfiguration
 	// EnableDebugHandlers determines whether the /debug/ handlers are enabled.
 	EnableDebugHandlers *bool
 }
@@ -83,6 +85,28 @@ type ResourceLimit struct {
 	Size resource.Quantity
 }
 
+// ObjectCountAdmissionConfiguration contains settings about arbitrary kinds and the number of objects of each kind a
+// namespace may contain at most.
+type ObjectCountAdmissionConfiguration struct {
+	// Limits contains configuration for resources which are subjected to object count limitations.
+	Limits []ObjectCountLimit
+	// UnrestrictedSubjects contains references to users, groups, or service accounts which aren't subjected to any object count limit.
+	UnrestrictedSubjects []rbacv1.Subject
+	// OperationMode specifies the mode the webhooks operates in. Allowed values are "block" and "log". Defaults to "block".
+	OperationMode *ResourceAdmissionWebhookMode
+}
+
+// ObjectCountLimit contains settings about a kind and the number of objects of this kind a namespace may contain at most.
+type ObjectCountLimit struct {
+	// APIGroups is the name of the APIGroup that contains the limited resource. WildcardAll represents all groups.
+	APIGroups []string
+	// Resources is the name of the resource this rule applies to. WildcardAll represents all resources.
+	// The limit applies to each matching resource separately.
+	Resources []string
+	// Count specifies the maximum number of objects per namespace.
+	Count int64
+}
+
 // Server contains information for HTTP(S) server configuration.
 type Server struct {
 	// BindAddress is the IP address on which to listen for the specified port.
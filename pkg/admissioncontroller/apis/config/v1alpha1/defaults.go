@@ -63,6 +63,15 @@ func SetDefaults_ResourceAdmissionConfiguration(obj *ResourceAdmissionConfigurat
 	}
 }
 
+// SetDefaults_ObjectCountAdmissionConfiguration sets defaults for the object count admission configuration.
+func SetDefaults_ObjectCountAdmissionConfiguration(obj *ObjectCountAdmissionConfiguration) {
+	for i, subject := range obj.UnrestrictedSubjects {
+		if (subject.Kind == rbacv1.UserKind || subject.Kind == rbacv1.GroupKind) && subject.APIGroup == "" {
+			obj.UnrestrictedSubjects[i].APIGroup = rbacv1.GroupName
+		}
+	}
+}
+
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
 func SetDefaults_ClientConnectionConfiguration(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
 	if obj.QPS == 0.0 {
@@ -178,4 +178,37 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Server.ResourceAdmissionConfiguration).To(Equal(expected))
 		})
 	})
+
+	Describe("ObjectCountAdmissionConfiguration defaulting", func() {
+		It("should correctly default the object count admission configuration", func() {
+			obj = &AdmissionControllerConfiguration{
+				Server: ServerConfiguration{
+					ObjectCountAdmissionConfiguration: &ObjectCountAdmissionConfiguration{
+						UnrestrictedSubjects: []rbacv1.Subject{
+							{Kind: rbacv1.UserKind, Name: "foo"},
+							{Kind: rbacv1.GroupKind, Name: "bar"},
+							{Kind: rbacv1.ServiceAccountKind, Name: "foobar", Namespace: "default"},
+						},
+					},
+				},
+			}
+			expected := &ObjectCountAdmissionConfiguration{
+				UnrestrictedSubjects: []rbacv1.Subject{
+					{Kind: rbacv1.UserKind, Name: "foo", APIGroup: rbacv1.GroupName},
+					{Kind: rbacv1.GroupKind, Name: "bar", APIGroup: rbacv1.GroupName},
+					{Kind: rbacv1.ServiceAccountKind, Name: "foobar", Namespace: "default", APIGroup: ""},
+				},
+			}
+			SetObjectDefaults_AdmissionControllerConfiguration(obj)
+
+			Expect(obj.Server.ObjectCountAdmissionConfiguration).To(Equal(expected))
+		})
+
+		It("should not default the object count admission configuration if not set", func() {
+			obj = &AdmissionControllerConfiguration{}
+			SetObjectDefaults_AdmissionControllerConfiguration(obj)
+
+			Expect(obj.Server.ObjectCountAdmissionConfiguration).To(BeNil())
+		})
+	})
 })
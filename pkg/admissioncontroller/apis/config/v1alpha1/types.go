@@ -55,6 +55,9 @@ type ServerConfiguration struct {
 	// ResourceAdmissionConfiguration is the configuration for the resource admission.
 	// +optional
 	ResourceAdmissionConfiguration *ResourceAdmissionConfiguration `json:"resourceAdmissionConfiguration,omitempty"`
+	// ObjectCountAdmissionConfiguration is the configuration for the object count admission.
+	// +optional
+	ObjectCountAdmissionConfiguration *ObjectCountAdmissionConfiguration `json:"objectCountAdmissionConfiguration,omitempty"`
 	// EnableDebugHandlers determines whether the /debug/ handlers are enabled.
 	// +optional
 	EnableDebugHandlers *bool `json:"enableDebugHandlers,omitempty"`
@@ -92,6 +95,31 @@ type ResourceLimit struct {
 	Size resource.Quantity `json:"size"`
 }
 
+// ObjectCountAdmissionConfiguration contains settings about arbitrary kinds and the number of objects of each kind a
+// namespace may contain at most.
+type ObjectCountAdmissionConfiguration struct {
+	// Limits contains configuration for resources which are subjected to object count limitations.
+	Limits []ObjectCountLimit `json:"limits"`
+	// UnrestrictedSubjects contains references to users, groups, or service accounts which aren't subjected to any object count limit.
+	// +optional
+	UnrestrictedSubjects []rbacv1.Subject `json:"unrestrictedSubjects,omitempty"`
+	// OperationMode specifies the mode the webhooks operates in. Allowed values are "block" and "log". Defaults to "block".
+	// +optional
+	OperationMode *ResourceAdmissionWebhookMode `json:"operationMode,omitempty"`
+}
+
+// ObjectCountLimit contains settings about a kind and the number of objects of this kind a namespace may contain at most.
+type ObjectCountLimit struct {
+	// APIGroups is the name of the APIGroup that contains the limited resource. WildcardAll represents all groups.
+	// +optional
+	APIGroups []string `json:"apiGroups,omitempty"`
+	// Resources is the name of the resource this rule applies to. WildcardAll represents all resources.
+	// The limit applies to each matching resource separately.
+	Resources []string `json:"resources"`
+	// Count specifies the maximum number of objects per namespace.
+	Count int64 `json:"count"`
+}
+
 // Server contains information for HTTP(S) server configuration.
 type Server struct {
 	// BindAddress is the IP address on which to listen for the specified port.
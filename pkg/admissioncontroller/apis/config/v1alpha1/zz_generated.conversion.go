@@ -59,6 +59,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ObjectCountAdmissionConfiguration)(nil), (*config.ObjectCountAdmissionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ObjectCountAdmissionConfiguration_To_config_ObjectCountAdmissionConfiguration(a.(*ObjectCountAdmissionConfiguration), b.(*config.ObjectCountAdmissionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ObjectCountAdmissionConfiguration)(nil), (*ObjectCountAdmissionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ObjectCountAdmissionConfiguration_To_v1alpha1_ObjectCountAdmissionConfiguration(a.(*config.ObjectCountAdmissionConfiguration), b.(*ObjectCountAdmissionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ObjectCountLimit)(nil), (*config.ObjectCountLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ObjectCountLimit_To_config_ObjectCountLimit(a.(*ObjectCountLimit), b.(*config.ObjectCountLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ObjectCountLimit)(nil), (*ObjectCountLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ObjectCountLimit_To_v1alpha1_ObjectCountLimit(a.(*config.ObjectCountLimit), b.(*ObjectCountLimit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ResourceAdmissionConfiguration)(nil), (*config.ResourceAdmissionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ResourceAdmissionConfiguration_To_config_ResourceAdmissionConfiguration(a.(*ResourceAdmissionConfiguration), b.(*config.ResourceAdmissionConfiguration), scope)
 	}); err != nil {
@@ -194,6 +214,54 @@ func Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in *config.HTTPSServer,
 	return autoConvert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in, out, s)
 }
 
+func autoConvert_v1alpha1_ObjectCountAdmissionConfiguration_To_config_ObjectCountAdmissionConfiguration(in *ObjectCountAdmissionConfiguration, out *config.ObjectCountAdmissionConfiguration, s conversion.Scope) error {
+	out.Limits = *(*[]config.ObjectCountLimit)(unsafe.Pointer(&in.Limits))
+	out.UnrestrictedSubjects = *(*[]v1.Subject)(unsafe.Pointer(&in.UnrestrictedSubjects))
+	out.OperationMode = (*config.ResourceAdmissionWebhookMode)(unsafe.Pointer(in.OperationMode))
+	return nil
+}
+
+// Convert_v1alpha1_ObjectCountAdmissionConfiguration_To_config_ObjectCountAdmissionConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ObjectCountAdmissionConfiguration_To_config_ObjectCountAdmissionConfiguration(in *ObjectCountAdmissionConfiguration, out *config.ObjectCountAdmissionConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ObjectCountAdmissionConfiguration_To_config_ObjectCountAdmissionConfiguration(in, out, s)
+}
+
+func autoConvert_config_ObjectCountAdmissionConfiguration_To_v1alpha1_ObjectCountAdmissionConfiguration(in *config.ObjectCountAdmissionConfiguration, out *ObjectCountAdmissionConfiguration, s conversion.Scope) error {
+	out.Limits = *(*[]ObjectCountLimit)(unsafe.Pointer(&in.Limits))
+	out.UnrestrictedSubjects = *(*[]v1.Subject)(unsafe.Pointer(&in.UnrestrictedSubjects))
+	out.OperationMode = (*ResourceAdmissionWebhookMode)(unsafe.Pointer(in.OperationMode))
+	return nil
+}
+
+// Convert_config_ObjectCountAdmissionConfiguration_To_v1alpha1_ObjectCountAdmissionConfiguration is an autogenerated conversion function.
+func Convert_config_ObjectCountAdmissionConfiguration_To_v1alpha1_ObjectCountAdmissionConfiguration(in *config.ObjectCountAdmissionConfiguration, out *ObjectCountAdmissionConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ObjectCountAdmissionConfiguration_To_v1alpha1_ObjectCountAdmissionConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ObjectCountLimit_To_config_ObjectCountLimit(in *ObjectCountLimit, out *config.ObjectCountLimit, s conversion.Scope) error {
+	out.APIGroups = *(*[]string)(unsafe.Pointer(&in.APIGroups))
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_v1alpha1_ObjectCountLimit_To_config_ObjectCountLimit is an autogenerated conversion function.
+func Convert_v1alpha1_ObjectCountLimit_To_config_ObjectCountLimit(in *ObjectCountLimit, out *config.ObjectCountLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ObjectCountLimit_To_config_ObjectCountLimit(in, out, s)
+}
+
+func autoConvert_config_ObjectCountLimit_To_v1alpha1_ObjectCountLimit(in *config.ObjectCountLimit, out *ObjectCountLimit, s conversion.Scope) error {
+	out.APIGroups = *(*[]string)(unsafe.Pointer(&in.APIGroups))
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_config_ObjectCountLimit_To_v1alpha1_ObjectCountLimit is an autogenerated conversion function.
+func Convert_config_ObjectCountLimit_To_v1alpha1_ObjectCountLimit(in *config.ObjectCountLimit, out *ObjectCountLimit, s conversion.Scope) error {
+	return autoConvert_config_ObjectCountLimit_To_v1alpha1_ObjectCountLimit(in, out, s)
+}
+
 func autoConvert_v1alpha1_ResourceAdmissionConfiguration_To_config_ResourceAdmissionConfiguration(in *ResourceAdmissionConfiguration, out *config.ResourceAdmissionConfiguration, s conversion.Scope) error {
 	out.Limits = *(*[]config.ResourceLimit)(unsafe.Pointer(&in.Limits))
 	out.UnrestrictedSubjects = *(*[]v1.Subject)(unsafe.Pointer(&in.UnrestrictedSubjects))
@@ -273,6 +341,7 @@ func autoConvert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(in *
 	out.HealthProbes = (*config.Server)(unsafe.Pointer(in.HealthProbes))
 	out.Metrics = (*config.Server)(unsafe.Pointer(in.Metrics))
 	out.ResourceAdmissionConfiguration = (*config.ResourceAdmissionConfiguration)(unsafe.Pointer(in.ResourceAdmissionConfiguration))
+	out.ObjectCountAdmissionConfiguration = (*config.ObjectCountAdmissionConfiguration)(unsafe.Pointer(in.ObjectCountAdmissionConfiguration))
 	out.EnableDebugHandlers = (*bool)(unsafe.Pointer(in.EnableDebugHandlers))
 	return nil
 }
@@ -289,6 +358,7 @@ func autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *
 	out.HealthProbes = (*Server)(unsafe.Pointer(in.HealthProbes))
 	out.Metrics = (*Server)(unsafe.Pointer(in.Metrics))
 	out.ResourceAdmissionConfiguration = (*ResourceAdmissionConfiguration)(unsafe.Pointer(in.ResourceAdmissionConfiguration))
+	out.ObjectCountAdmissionConfiguration = (*ObjectCountAdmissionConfiguration)(unsafe.Pointer(in.ObjectCountAdmissionConfiguration))
 	out.EnableDebugHandlers = (*bool)(unsafe.Pointer(in.EnableDebugHandlers))
 	return nil
 }
@@ -42,4 +42,7 @@ func SetObjectDefaults_AdmissionControllerConfiguration(in *AdmissionControllerC
 	if in.Server.ResourceAdmissionConfiguration != nil {
 		SetDefaults_ResourceAdmissionConfiguration(in.Server.ResourceAdmissionConfiguration)
 	}
+	if in.Server.ObjectCountAdmissionConfiguration != nil {
+		SetDefaults_ObjectCountAdmissionConfiguration(in.Server.ObjectCountAdmissionConfiguration)
+	}
 }
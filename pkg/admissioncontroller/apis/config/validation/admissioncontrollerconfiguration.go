@@ -39,47 +39,18 @@ func ValidateAdmissionControllerConfiguration(config *admissioncontrollerconfig.
 	if config.Server.ResourceAdmissionConfiguration != nil {
 		allErrs = append(allErrs, ValidateResourceAdmissionConfiguration(config.Server.ResourceAdmissionConfiguration, serverPath.Child("resourceAdmissionConfiguration"))...)
 	}
+	if config.Server.ObjectCountAdmissionConfiguration != nil {
+		allErrs = append(allErrs, ValidateObjectCountAdmissionConfiguration(config.Server.ObjectCountAdmissionConfiguration, serverPath.Child("objectCountAdmissionConfiguration"))...)
+	}
 	return allErrs
 }
 
 // ValidateResourceAdmissionConfiguration validates the given `ResourceAdmissionConfiguration`.
 func ValidateResourceAdmissionConfiguration(config *admissioncontrollerconfig.ResourceAdmissionConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
-	validValues := sets.New(string(admissioncontrollerconfig.AdmissionModeBlock), string(admissioncontrollerconfig.AdmissionModeLog))
-
-	if config.OperationMode != nil && !validValues.Has(string(*config.OperationMode)) {
-		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), string(*config.OperationMode), validValues.UnsortedList()))
-	}
-
-	allowedSubjectKinds := sets.New(rbacv1.UserKind, rbacv1.GroupKind, rbacv1.ServiceAccountKind)
 
-	for i, subject := range config.UnrestrictedSubjects {
-		fld := fldPath.Child("unrestrictedSubjects").Index(i)
-
-		if !allowedSubjectKinds.Has(subject.Kind) {
-			allErrs = append(allErrs, field.NotSupported(fld.Child("kind"), subject.Kind, allowedSubjectKinds.UnsortedList()))
-		}
-		if subject.Name == "" {
-			allErrs = append(allErrs, field.Invalid(fld.Child("name"), subject.Name, "name must not be empty"))
-		}
-
-		switch subject.Kind {
-		case rbacv1.ServiceAccountKind:
-			if subject.Namespace == "" {
-				allErrs = append(allErrs, field.Invalid(fld.Child("namespace"), subject.Namespace, "name must not be empty"))
-			}
-			if subject.APIGroup != "" {
-				allErrs = append(allErrs, field.Invalid(fld.Child("apiGroup"), subject.APIGroup, "apiGroup must be empty"))
-			}
-		case rbacv1.UserKind, rbacv1.GroupKind:
-			if subject.Namespace != "" {
-				allErrs = append(allErrs, field.Invalid(fld.Child("namespace"), subject.Namespace, "name must be empty"))
-			}
-			if subject.APIGroup != rbacv1.GroupName {
-				allErrs = append(allErrs, field.NotSupported(fld.Child("apiGroup"), subject.APIGroup, []string{rbacv1.GroupName}))
-			}
-		}
-	}
+	allErrs = append(allErrs, validateOperationMode(config.OperationMode, fldPath.Child("mode"))...)
+	allErrs = append(allErrs, validateUnrestrictedSubjects(config.UnrestrictedSubjects, fldPath.Child("unrestrictedSubjects"))...)
 
 	for i, limit := range config.Limits {
 		fld := fldPath.Child("limits").Index(i)
@@ -116,3 +87,80 @@ func ValidateResourceAdmissionConfiguration(config *admissioncontrollerconfig.Re
 
 	return allErrs
 }
+
+// ValidateObjectCountAdmissionConfiguration validates the given `ObjectCountAdmissionConfiguration`.
+func ValidateObjectCountAdmissionConfiguration(config *admissioncontrollerconfig.ObjectCountAdmissionConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateOperationMode(config.OperationMode, fldPath.Child("mode"))...)
+	allErrs = append(allErrs, validateUnrestrictedSubjects(config.UnrestrictedSubjects, fldPath.Child("unrestrictedSubjects"))...)
+
+	for i, limit := range config.Limits {
+		fld := fldPath.Child("limits").Index(i)
+
+		if len(limit.Resources) < 1 {
+			allErrs = append(allErrs, field.Invalid(fld.Child("resources"), limit.Resources, "must at least have one element"))
+		}
+		for j, resource := range limit.Resources {
+			if resource == "" {
+				allErrs = append(allErrs, field.Invalid(fld.Child("resources").Index(j), resource, "must not be empty"))
+			}
+		}
+
+		if len(limit.APIGroups) < 1 {
+			allErrs = append(allErrs, field.Invalid(fld.Child("apiGroups"), limit.APIGroups, "must at least have one element"))
+		}
+
+		if limit.Count < 0 {
+			allErrs = append(allErrs, field.Invalid(fld.Child("count"), limit.Count, "value must not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateOperationMode(mode *admissioncontrollerconfig.ResourceAdmissionWebhookMode, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	validValues := sets.New(string(admissioncontrollerconfig.AdmissionModeBlock), string(admissioncontrollerconfig.AdmissionModeLog))
+
+	if mode != nil && !validValues.Has(string(*mode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, string(*mode), validValues.UnsortedList()))
+	}
+
+	return allErrs
+}
+
+func validateUnrestrictedSubjects(subjects []rbacv1.Subject, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allowedSubjectKinds := sets.New(rbacv1.UserKind, rbacv1.GroupKind, rbacv1.ServiceAccountKind)
+
+	for i, subject := range subjects {
+		fld := fldPath.Index(i)
+
+		if !allowedSubjectKinds.Has(subject.Kind) {
+			allErrs = append(allErrs, field.NotSupported(fld.Child("kind"), subject.Kind, allowedSubjectKinds.UnsortedList()))
+		}
+		if subject.Name == "" {
+			allErrs = append(allErrs, field.Invalid(fld.Child("name"), subject.Name, "name must not be empty"))
+		}
+
+		switch subject.Kind {
+		case rbacv1.ServiceAccountKind:
+			if subject.Namespace == "" {
+				allErrs = append(allErrs, field.Invalid(fld.Child("namespace"), subject.Namespace, "name must not be empty"))
+			}
+			if subject.APIGroup != "" {
+				allErrs = append(allErrs, field.Invalid(fld.Child("apiGroup"), subject.APIGroup, "apiGroup must be empty"))
+			}
+		case rbacv1.UserKind, rbacv1.GroupKind:
+			if subject.Namespace != "" {
+				allErrs = append(allErrs, field.Invalid(fld.Child("namespace"), subject.Namespace, "name must be empty"))
+			}
+			if subject.APIGroup != rbacv1.GroupName {
+				allErrs = append(allErrs, field.NotSupported(fld.Child("apiGroup"), subject.APIGroup, []string{rbacv1.GroupName}))
+			}
+		}
+	}
+
+	return allErrs
+}
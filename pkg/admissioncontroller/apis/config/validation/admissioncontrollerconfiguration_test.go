@@ -209,4 +209,57 @@ var _ = Describe("#ValidateAdmissionControllerConfiguration", func() {
 		)
 	})
 
+	Context("Object count validation configuration", func() {
+		var (
+			apiGroups = []string{""}
+			resources = []string{"configmaps"}
+			logMode   = admissioncontrollerconfig.AdmissionModeLog
+			fooMode   = admissioncontrollerconfig.ResourceAdmissionWebhookMode("foo")
+		)
+
+		DescribeTable("Object count configuration",
+			func(apiGroups, resources []string, count int64, mode *admissioncontrollerconfig.ResourceAdmissionWebhookMode, subjects []rbacv1.Subject, matcher gomegatypes.GomegaMatcher) {
+				config := &admissioncontrollerconfig.AdmissionControllerConfiguration{
+					LogLevel:  "info",
+					LogFormat: "json",
+					Server: admissioncontrollerconfig.ServerConfiguration{
+						ObjectCountAdmissionConfiguration: &admissioncontrollerconfig.ObjectCountAdmissionConfiguration{
+							Limits: []admissioncontrollerconfig.ObjectCountLimit{
+								{
+									APIGroups: apiGroups,
+									Resources: resources,
+									Count:     count,
+								},
+							},
+							UnrestrictedSubjects: subjects,
+							OperationMode:        mode,
+						},
+					},
+				}
+
+				Expect(ValidateAdmissionControllerConfiguration(config)).To(matcher)
+			},
+			Entry("should allow valid configuration", apiGroups, resources, int64(100), &logMode, []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "admin", APIGroup: rbacv1.GroupName}},
+				BeEmpty(),
+			),
+			Entry("should deny empty apiGroups", nil, resources, int64(100), nil, nil,
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("server.objectCountAdmissionConfiguration.limits[0].apiGroups")}))),
+			),
+			Entry("should deny empty resources", apiGroups, nil, int64(100), nil, nil,
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("server.objectCountAdmissionConfiguration.limits[0].resources")}))),
+			),
+			Entry("should deny resources w/ zero length", apiGroups, []string{""}, int64(100), nil, nil,
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("server.objectCountAdmissionConfiguration.limits[0].resources[0]")}))),
+			),
+			Entry("should deny negative count", apiGroups, resources, int64(-1), nil, nil,
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("server.objectCountAdmissionConfiguration.limits[0].count")}))),
+			),
+			Entry("should deny unknown mode", apiGroups, resources, int64(100), &fooMode, nil,
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("server.objectCountAdmissionConfiguration.mode")}))),
+			),
+			Entry("should deny invalid subjects", apiGroups, resources, int64(100), nil, []rbacv1.Subject{{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName}},
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("server.objectCountAdmissionConfiguration.unrestrictedSubjects[0].name")}))),
+			),
+		)
+	})
 })
@@ -77,6 +77,65 @@ func (in *HTTPSServer) DeepCopy() *HTTPSServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectCountAdmissionConfiguration) DeepCopyInto(out *ObjectCountAdmissionConfiguration) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]ObjectCountLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UnrestrictedSubjects != nil {
+		in, out := &in.UnrestrictedSubjects, &out.UnrestrictedSubjects
+		*out = make([]v1.Subject, len(*in))
+		copy(*out, *in)
+	}
+	if in.OperationMode != nil {
+		in, out := &in.OperationMode, &out.OperationMode
+		*out = new(ResourceAdmissionWebhookMode)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectCountAdmissionConfiguration.
+func (in *ObjectCountAdmissionConfiguration) DeepCopy() *ObjectCountAdmissionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectCountAdmissionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectCountLimit) DeepCopyInto(out *ObjectCountLimit) {
+	*out = *in
+	if in.APIGroups != nil {
+		in, out := &in.APIGroups, &out.APIGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectCountLimit.
+func (in *ObjectCountLimit) DeepCopy() *ObjectCountLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectCountLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceAdmissionConfiguration) DeepCopyInto(out *ResourceAdmissionConfiguration) {
 	*out = *in
@@ -177,6 +236,11 @@ func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
 		*out = new(ResourceAdmissionConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ObjectCountAdmissionConfiguration != nil {
+		in, out := &in.ObjectCountAdmissionConfiguration, &out.ObjectCountAdmissionConfiguration
+		*out = new(ObjectCountAdmissionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EnableDebugHandlers != nil {
 		in, out := &in.EnableDebugHandlers, &out.EnableDebugHandlers
 		*out = new(bool)
@@ -41,4 +41,19 @@ var (
 			"reason",
 		},
 	)
+
+	// ObjectCount defines the gauge objects which reports the number of objects per namespace and resource which are
+	// subjected to an object count limit. It is updated whenever the count is determined during admission.
+	ObjectCount = Factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "objects",
+			Help:      "Number of objects per namespace and resource subjected to an object count limit.",
+		},
+		[]string{
+			"namespace",
+			"group",
+			"resource",
+		},
+	)
 )
@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/internaldomainsecret"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/kubeconfigsecret"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/namespacedeletion"
+	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/objectcount"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/resourcesize"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/seedrestriction"
 	seedauthorizer "github.com/gardener/gardener/pkg/admissioncontroller/webhook/auth/seed"
@@ -76,6 +77,13 @@ func AddToManager(
 		return fmt.Errorf("failed adding %s webhook handler: %w", resourcesize.HandlerName, err)
 	}
 
+	if err := (&objectcount.Handler{
+		Logger: mgr.GetLogger().WithName("webhook").WithName(objectcount.HandlerName),
+		Config: cfg.Server.ObjectCountAdmissionConfiguration,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding %s webhook handler: %w", objectcount.HandlerName, err)
+	}
+
 	if err := (&seedauthorizer.Handler{
 		Logger: mgr.GetLogger().WithName("webhook").WithName(seedauthorizer.HandlerName),
 	}).AddToManager(ctx, mgr, cfg.Server.EnableDebugHandlers); err != nil {
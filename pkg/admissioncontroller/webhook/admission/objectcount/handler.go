@@ -0,0 +1,154 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectcount
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	admissioncontrollerconfig "github.com/gardener/gardener/pkg/admissioncontroller/apis/config"
+	admissioncontrollerhelper "github.com/gardener/gardener/pkg/admissioncontroller/apis/config/helper"
+	"github.com/gardener/gardener/pkg/admissioncontroller/metrics"
+)
+
+const (
+	// metricReasonCountExceeded is a metric reason value for a reason when an object count was exceeded.
+	metricReasonCountExceeded = "Count Exceeded"
+	// listPageSize is the page size used for listing objects when counting them.
+	listPageSize = 500
+)
+
+// Handler checks the number of objects per namespace.
+type Handler struct {
+	Logger    logr.Logger
+	APIReader client.Reader
+	Config    *admissioncontrollerconfig.ObjectCountAdmissionConfiguration
+}
+
+// Handle checks the number of objects per namespace.
+func (h *Handler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("unknown operation request %q", req.Operation))
+	}
+
+	if err := h.handle(ctx, req); err != nil {
+		var apiStatus apierrors.APIStatus
+		if errors.As(err, &apiStatus) {
+			status := apiStatus.Status()
+			return admission.Response{AdmissionResponse: admissionv1.AdmissionResponse{Allowed: false, Result: &status}}
+		}
+		return admission.Denied(err.Error())
+	}
+
+	return admission.Allowed("")
+}
+
+func (h *Handler) handle(ctx context.Context, req admission.Request) error {
+	if h.Config == nil || req.Namespace == "" || req.SubResource != "" {
+		return nil
+	}
+
+	log := h.Logger.WithValues("user", req.UserInfo.Username, "resource", req.Resource, "name", req.Name, "namespace", req.Namespace)
+
+	if admissioncontrollerhelper.IsUnrestrictedUser(req.UserInfo, h.Config.UnrestrictedSubjects) {
+		return nil
+	}
+
+	limit := findLimitForGroupResource(h.Config.Limits, req.Resource.Group, req.Resource.Resource)
+	if limit == nil {
+		return nil
+	}
+
+	gvk := schema.GroupVersionKind{Group: req.Kind.Group, Version: req.Kind.Version, Kind: req.Kind.Kind}
+	count, err := h.count(ctx, gvk, req.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("failed counting %s in namespace %s: %w", req.Resource.Resource, req.Namespace, err))
+	}
+	metrics.ObjectCount.WithLabelValues(req.Namespace, req.Resource.Group, req.Resource.Resource).Set(float64(count))
+
+	if count >= *limit {
+		if h.Config.OperationMode == nil || *h.Config.OperationMode == admissioncontrollerconfig.AdmissionModeBlock {
+			log.Info("Maximum object count exceeded, rejected request", "count", count, "limit", *limit)
+			metrics.RejectedResources.WithLabelValues(
+				fmt.Sprint(req.Operation),
+				req.Kind.Kind,
+				req.Namespace,
+				metricReasonCountExceeded,
+			).Inc()
+			return apierrors.NewForbidden(schema.GroupResource{Group: req.Resource.Group, Resource: req.Resource.Resource}, req.Name, fmt.Errorf("maximum object count exceeded! Objects in namespace: %d, max allowed: %d", count, *limit))
+		}
+
+		log.Info("Maximum object count exceeded, request would be denied in blocking mode", "count", count, "limit", *limit)
+	}
+
+	return nil
+}
+
+// count returns the number of objects of the given kind in the given namespace. It only reads metadata and uses the
+// remaining item count reported by the API server if available to avoid listing all objects.
+func (h *Handler) count(ctx context.Context, gvk schema.GroupVersionKind, namespace string) (int64, error) {
+	var (
+		count         int64
+		continueToken string
+	)
+
+	for {
+		list := &metav1.PartialObjectMetadataList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+		if err := h.APIReader.List(ctx, list, client.InNamespace(namespace), client.Limit(listPageSize), client.Continue(continueToken)); err != nil {
+			return 0, err
+		}
+
+		count += int64(len(list.Items))
+		if list.Continue == "" {
+			return count, nil
+		}
+		if list.RemainingItemCount != nil {
+			return count + *list.RemainingItemCount, nil
+		}
+
+		continueToken = list.Continue
+	}
+}
+
+func findLimitForGroupResource(limits []admissioncontrollerconfig.ObjectCountLimit, group, resource string) *int64 {
+	for _, limit := range limits {
+		count := limit.Count
+		if matches(limit.APIGroups, group) && matches(limit.Resources, resource) {
+			return &count
+		}
+	}
+	return nil
+}
+
+func matches(values []string, value string) bool {
+	for _, v := range values {
+		if v == admissioncontrollerconfig.WildcardAll || v == value {
+			return true
+		}
+	}
+	return false
+}
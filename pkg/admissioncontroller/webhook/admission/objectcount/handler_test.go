@@ -0,0 +1,154 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectcount_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	admissioncontrollerconfig "github.com/gardener/gardener/pkg/admissioncontroller/apis/config"
+	"github.com/gardener/gardener/pkg/admissioncontroller/metrics"
+	. "github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/objectcount"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Handler", func() {
+	const namespace = "garden-foo"
+
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		handler    *Handler
+		request    admission.Request
+		logMode    = admissioncontrollerconfig.AdmissionModeLog
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		handler = &Handler{
+			Logger:    logr.Discard(),
+			APIReader: fakeClient,
+			Config: &admissioncontrollerconfig.ObjectCountAdmissionConfiguration{
+				Limits: []admissioncontrollerconfig.ObjectCountLimit{{
+					APIGroups: []string{""},
+					Resources: []string{"configmaps"},
+					Count:     2,
+				}},
+				UnrestrictedSubjects: []rbacv1.Subject{{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "admin"}},
+			},
+		}
+
+		request = admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Kind:      metav1.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+			Resource:  metav1.GroupVersionResource{Version: "v1", Resource: "configmaps"},
+			Namespace: namespace,
+			Name:      "new",
+			UserInfo:  authenticationv1.UserInfo{Username: "user"},
+		}}
+	})
+
+	createConfigMaps := func(namespace string, n int) {
+		for i := 0; i < n; i++ {
+			ExpectWithOffset(1, fakeClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("cm-%d", i), Namespace: namespace}})).To(Succeed())
+		}
+	}
+
+	It("should return an error for operations other than create", func() {
+		request.Operation = admissionv1.Update
+
+		response := handler.Handle(ctx, request)
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Code).To(Equal(int32(http.StatusBadRequest)))
+	})
+
+	It("should allow the request if no config is given", func() {
+		handler.Config = nil
+		createConfigMaps(namespace, 3)
+
+		Expect(handler.Handle(ctx, request).Allowed).To(BeTrue())
+	})
+
+	It("should allow the request if the resource is not limited", func() {
+		request.Kind = metav1.GroupVersionKind{Version: "v1", Kind: "Secret"}
+		request.Resource = metav1.GroupVersionResource{Version: "v1", Resource: "secrets"}
+
+		Expect(handler.Handle(ctx, request).Allowed).To(BeTrue())
+	})
+
+	It("should allow the request if the limit is not reached", func() {
+		createConfigMaps(namespace, 1)
+		createConfigMaps("other", 5)
+
+		Expect(handler.Handle(ctx, request).Allowed).To(BeTrue())
+		Expect(testutil.ToFloat64(metrics.ObjectCount.WithLabelValues(namespace, "", "configmaps"))).To(Equal(float64(1)))
+	})
+
+	It("should deny the request if the limit is reached", func() {
+		createConfigMaps(namespace, 2)
+
+		response := handler.Handle(ctx, request)
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Code).To(Equal(int32(http.StatusForbidden)))
+		Expect(response.Result.Message).To(ContainSubstring("maximum object count exceeded! Objects in namespace: 2, max allowed: 2"))
+		Expect(testutil.ToFloat64(metrics.ObjectCount.WithLabelValues(namespace, "", "configmaps"))).To(Equal(float64(2)))
+	})
+
+	It("should match wildcard limits", func() {
+		handler.Config.Limits = []admissioncontrollerconfig.ObjectCountLimit{{
+			APIGroups: []string{"*"},
+			Resources: []string{"*"},
+			Count:     1,
+		}}
+		createConfigMaps(namespace, 1)
+
+		Expect(handler.Handle(ctx, request).Allowed).To(BeFalse())
+	})
+
+	It("should allow the request if the limit is reached but the operation mode is log", func() {
+		handler.Config.OperationMode = &logMode
+		createConfigMaps(namespace, 2)
+
+		Expect(handler.Handle(ctx, request).Allowed).To(BeTrue())
+	})
+
+	It("should allow the request if the limit is reached but the user is unrestricted", func() {
+		request.UserInfo.Username = "admin"
+		createConfigMaps(namespace, 2)
+
+		Expect(handler.Handle(ctx, request).Allowed).To(BeTrue())
+	})
+
+	It("should allow requests for subresources", func() {
+		request.SubResource = "status"
+		createConfigMaps(namespace, 2)
+
+		Expect(handler.Handle(ctx, request).Allowed).To(BeTrue())
+	})
+})
@@ -19,17 +19,13 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/go-logr/logr"
 	admissionv1 "k8s.io/api/admission/v1"
-	authenticationv1 "k8s.io/api/authentication/v1"
-	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apiserver/pkg/authentication/serviceaccount"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	admissioncontrollerconfig "github.com/gardener/gardener/pkg/admissioncontroller/apis/config"
@@ -76,7 +72,7 @@ func (h *Handler) handle(req admission.Request) error {
 		log = log.WithValues("namespace", req.Namespace)
 	}
 
-	if isUnrestrictedUser(req.UserInfo, h.Config.UnrestrictedSubjects) {
+	if admissioncontrollerhelper.IsUnrestrictedUser(req.UserInfo, h.Config.UnrestrictedSubjects) {
 		return nil
 	}
 
@@ -109,41 +105,6 @@ func (h *Handler) handle(req admission.Request) error {
 	return nil
 }
 
-func serviceAccountMatch(userInfo authenticationv1.UserInfo, subjects []rbacv1.Subject) bool {
-	for _, subject := range subjects {
-		if subject.Kind == rbacv1.ServiceAccountKind {
-			if admissioncontrollerhelper.ServiceAccountMatches(subject, userInfo) {
-				return true
-			}
-		}
-	}
-	return false
-}
-
-func userMatch(userInfo authenticationv1.UserInfo, subjects []rbacv1.Subject) bool {
-	for _, subject := range subjects {
-		var match bool
-		switch subject.Kind {
-		case rbacv1.UserKind:
-			match = admissioncontrollerhelper.UserMatches(subject, userInfo)
-		case rbacv1.GroupKind:
-			match = admissioncontrollerhelper.UserGroupMatches(subject, userInfo)
-		}
-		if match {
-			return true
-		}
-	}
-	return false
-}
-
-func isUnrestrictedUser(userInfo authenticationv1.UserInfo, subjects []rbacv1.Subject) bool {
-	isServiceAccount := strings.HasPrefix(userInfo.Username, serviceaccount.ServiceAccountUsernamePrefix)
-	if isServiceAccount {
-		return serviceAccountMatch(userInfo, subjects)
-	}
-	return userMatch(userInfo, subjects)
-}
-
 func findLimitForGVR(limits []admissioncontrollerconfig.ResourceLimit, gvr *metav1.GroupVersionResource) *resource.Quantity {
 	for _, limit := range limits {
 		size := limit.Size
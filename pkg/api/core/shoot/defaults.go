@@ -0,0 +1,98 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+)
+
+// SetDefaults sets default values for the fields of the given Shoot which are not defaulted by the API defaulting
+// because they can still be defaulted by the ShootDefaults admission plugin. Hence, it must only be called after the
+// mutating admission plugins have run. If oldShoot is provided, its maintenance time window is kept instead of
+// computing a new random one.
+func SetDefaults(shoot, oldShoot *core.Shoot) {
+	if shoot.Spec.Maintenance == nil {
+		shoot.Spec.Maintenance = &core.Maintenance{}
+	}
+	if shoot.Spec.Maintenance.TimeWindow == nil {
+		if oldShoot != nil && oldShoot.Spec.Maintenance != nil && oldShoot.Spec.Maintenance.TimeWindow != nil {
+			shoot.Spec.Maintenance.TimeWindow = oldShoot.Spec.Maintenance.TimeWindow.DeepCopy()
+		} else {
+			mt := timewindow.RandomMaintenanceTimeWindow()
+			shoot.Spec.Maintenance.TimeWindow = &core.MaintenanceTimeWindow{
+				Begin: mt.Begin().Formatted(),
+				End:   mt.End().Formatted(),
+			}
+		}
+	}
+
+	// the kubelet settings are relevant only for shoot with workers
+	if len(shoot.Spec.Provider.Workers) > 0 {
+		if shoot.Spec.Kubernetes.Kubelet == nil {
+			shoot.Spec.Kubernetes.Kubelet = &core.KubeletConfig{}
+		}
+		setDefaultsKubeletConfig(shoot.Spec.Kubernetes.Kubelet)
+	}
+}
+
+func setDefaultsKubeletConfig(kubelet *core.KubeletConfig) {
+	if kubelet.FailSwapOn == nil {
+		kubelet.FailSwapOn = pointer.Bool(true)
+	}
+
+	if nodeSwapFeatureGateEnabled, ok := kubelet.FeatureGates["NodeSwap"]; ok && nodeSwapFeatureGateEnabled && !*kubelet.FailSwapOn {
+		if kubelet.MemorySwap == nil {
+			kubelet.MemorySwap = &core.MemorySwapConfiguration{}
+		}
+		if kubelet.MemorySwap.SwapBehavior == nil {
+			limitedSwap := core.LimitedSwap
+			kubelet.MemorySwap.SwapBehavior = &limitedSwap
+		}
+	}
+	if kubelet.ImageGCHighThresholdPercent == nil {
+		kubelet.ImageGCHighThresholdPercent = pointer.Int32(50)
+	}
+	if kubelet.ImageGCLowThresholdPercent == nil {
+		kubelet.ImageGCLowThresholdPercent = pointer.Int32(40)
+	}
+	if kubelet.SerializeImagePulls == nil {
+		kubelet.SerializeImagePulls = pointer.Bool(true)
+	}
+
+	var (
+		kubeReservedMemory = resource.MustParse("1Gi")
+		kubeReservedCPU    = resource.MustParse("80m")
+		kubeReservedPID    = resource.MustParse("20k")
+	)
+
+	if kubelet.KubeReserved == nil {
+		kubelet.KubeReserved = &core.KubeletConfigReserved{Memory: &kubeReservedMemory, CPU: &kubeReservedCPU}
+		kubelet.KubeReserved.PID = &kubeReservedPID
+	} else {
+		if kubelet.KubeReserved.Memory == nil {
+			kubelet.KubeReserved.Memory = &kubeReservedMemory
+		}
+		if kubelet.KubeReserved.CPU == nil {
+			kubelet.KubeReserved.CPU = &kubeReservedCPU
+		}
+		if kubelet.KubeReserved.PID == nil {
+			kubelet.KubeReserved.PID = &kubeReservedPID
+		}
+	}
+}
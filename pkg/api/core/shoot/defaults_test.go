@@ -0,0 +1,174 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+
+	. "github.com/gardener/gardener/pkg/api/core/shoot"
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+var _ = Describe("Defaults", func() {
+	Describe("#SetDefaults", func() {
+		var shoot *core.Shoot
+
+		BeforeEach(func() {
+			shoot = &core.Shoot{
+				Spec: core.ShootSpec{
+					Provider: core.Provider{
+						Workers: []core.Worker{{}},
+					},
+				},
+			}
+		})
+
+		Describe("Maintenance time window defaulting", func() {
+			It("should default the maintenance time window", func() {
+				SetDefaults(shoot, nil)
+
+				Expect(shoot.Spec.Maintenance.TimeWindow).NotTo(BeNil())
+				Expect(shoot.Spec.Maintenance.TimeWindow.Begin).To(HaveSuffix("0000+0000"))
+				Expect(shoot.Spec.Maintenance.TimeWindow.End).To(HaveSuffix("0000+0000"))
+			})
+
+			It("should not overwrite the maintenance time window", func() {
+				shoot.Spec.Maintenance = &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}}
+
+				SetDefaults(shoot, nil)
+
+				Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}))
+			})
+
+			It("should keep the maintenance time window of the old shoot", func() {
+				oldShoot := shoot.DeepCopy()
+				oldShoot.Spec.Maintenance = &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}}
+
+				SetDefaults(shoot, oldShoot)
+
+				Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}))
+			})
+		})
+
+		Describe("Kubelet defaulting", func() {
+			var (
+				defaultKubeReservedMemory = resource.MustParse("1Gi")
+				defaultKubeReservedCPU    = resource.MustParse("80m")
+				defaultKubeReservedPID    = resource.MustParse("20k")
+				kubeReservedMemory        = resource.MustParse("2Gi")
+				kubeReservedCPU           = resource.MustParse("20m")
+				kubeReservedPID           = resource.MustParse("10k")
+			)
+
+			It("should not default the kubelet field for workerless Shoot", func() {
+				shoot.Spec.Provider.Workers = nil
+				SetDefaults(shoot, nil)
+
+				Expect(shoot.Spec.Kubernetes.Kubelet).To(BeNil())
+			})
+
+			It("should default the kubelet fields for Shoot with workers", func() {
+				SetDefaults(shoot, nil)
+
+				Expect(shoot.Spec.Kubernetes.Kubelet).To(Equal(&core.KubeletConfig{
+					FailSwapOn:                  pointer.Bool(true),
+					ImageGCHighThresholdPercent: pointer.Int32(50),
+					ImageGCLowThresholdPercent:  pointer.Int32(40),
+					SerializeImagePulls:         pointer.Bool(true),
+					KubeReserved: &core.KubeletConfigReserved{
+						CPU:    &defaultKubeReservedCPU,
+						Memory: &defaultKubeReservedMemory,
+						PID:    &defaultKubeReservedPID,
+					},
+				}))
+			})
+
+			It("should not overwrite already set values", func() {
+				shoot.Spec.Kubernetes.Kubelet = &core.KubeletConfig{
+					FailSwapOn:                  pointer.Bool(false),
+					ImageGCHighThresholdPercent: pointer.Int32(12),
+					ImageGCLowThresholdPercent:  pointer.Int32(34),
+					SerializeImagePulls:         pointer.Bool(false),
+					KubeReserved: &core.KubeletConfigReserved{
+						CPU:    &kubeReservedCPU,
+						Memory: &kubeReservedMemory,
+						PID:    &kubeReservedPID,
+					},
+				}
+				expected := shoot.Spec.Kubernetes.Kubelet.DeepCopy()
+
+				SetDefaults(shoot, nil)
+
+				Expect(shoot.Spec.Kubernetes.Kubelet).To(Equal(expected))
+			})
+
+			It("should default the missing kubeReserved values", func() {
+				shoot.Spec.Kubernetes.Kubelet = &core.KubeletConfig{KubeReserved: &core.KubeletConfigReserved{CPU: &kubeReservedCPU}}
+
+				SetDefaults(shoot, nil)
+
+				Expect(shoot.Spec.Kubernetes.Kubelet.KubeReserved).To(Equal(&core.KubeletConfigReserved{
+					CPU:    &kubeReservedCPU,
+					Memory: &defaultKubeReservedMemory,
+					PID:    &defaultKubeReservedPID,
+				}))
+			})
+
+			It("should default the swap behaviour", func() {
+				shoot.Spec.Kubernetes.Kubelet = &core.KubeletConfig{
+					FailSwapOn:       pointer.Bool(false),
+					KubernetesConfig: core.KubernetesConfig{FeatureGates: map[string]bool{"NodeSwap": true}},
+				}
+
+				SetDefaults(shoot, nil)
+
+				limitedSwap := core.LimitedSwap
+				Expect(shoot.Spec.Kubernetes.Kubelet.MemorySwap).To(Equal(&core.MemorySwapConfiguration{SwapBehavior: &limitedSwap}))
+			})
+
+			It("should not overwrite already set values for swap behaviour", func() {
+				unlimitedSwap := core.UnlimitedSwap
+				shoot.Spec.Kubernetes.Kubelet = &core.KubeletConfig{
+					FailSwapOn:       pointer.Bool(false),
+					KubernetesConfig: core.KubernetesConfig{FeatureGates: map[string]bool{"NodeSwap": true}},
+					MemorySwap:       &core.MemorySwapConfiguration{SwapBehavior: &unlimitedSwap},
+				}
+
+				SetDefaults(shoot, nil)
+
+				Expect(shoot.Spec.Kubernetes.Kubelet.MemorySwap).To(Equal(&core.MemorySwapConfiguration{SwapBehavior: &unlimitedSwap}))
+			})
+
+			It("should not default the swap behaviour because failSwapOn=true", func() {
+				shoot.Spec.Kubernetes.Kubelet = &core.KubeletConfig{KubernetesConfig: core.KubernetesConfig{FeatureGates: map[string]bool{"NodeSwap": true}}}
+
+				SetDefaults(shoot, nil)
+
+				Expect(shoot.Spec.Kubernetes.Kubelet.MemorySwap).To(BeNil())
+			})
+
+			It("should not default the swap behaviour because kubelet NodeSwap feature gate is false", func() {
+				shoot.Spec.Kubernetes.Kubelet = &core.KubeletConfig{FailSwapOn: pointer.Bool(false)}
+
+				SetDefaults(shoot, nil)
+
+				Expect(shoot.Spec.Kubernetes.Kubelet.MemorySwap).To(BeNil())
+			})
+		})
+	})
+})
@@ -292,3 +292,38 @@ func (u unstructuredStatusAccessor) SetResources(namedResourceReference []garden
 		return
 	}
 }
+
+// GetHealthCheckAvailability implements Status.
+func (u unstructuredStatusAccessor) GetHealthCheckAvailability() []extensionsv1alpha1.HealthCheckAvailability {
+	val, ok, err := unstructured.NestedFieldNoCopy(u.UnstructuredContent(), "status", "healthCheckAvailability")
+	if err != nil || !ok {
+		return nil
+	}
+	var availabilities []extensionsv1alpha1.HealthCheckAvailability
+	interfaceAvailabilitySlice := val.([]interface{})
+	for _, interfaceAvailability := range interfaceAvailabilitySlice {
+		unstructuredAvailability := interfaceAvailability.(map[string]interface{})
+		availability := &extensionsv1alpha1.HealthCheckAvailability{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredAvailability, availability); err != nil {
+			return nil
+		}
+		availabilities = append(availabilities, *availability)
+	}
+	return availabilities
+}
+
+// SetHealthCheckAvailability implements Status.
+func (u unstructuredStatusAccessor) SetHealthCheckAvailability(availabilities []extensionsv1alpha1.HealthCheckAvailability) {
+	var interfaceSlice = make([]interface{}, len(availabilities))
+	for i, d := range availabilities {
+		unstrc, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&d)
+		if err != nil {
+			return
+		}
+		interfaceSlice[i] = unstrc
+	}
+	err := unstructured.SetNestedSlice(u.UnstructuredContent(), interfaceSlice, "status", "healthCheckAvailability")
+	if err != nil {
+		return
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/pointer"
 
 	. "github.com/gardener/gardener/pkg/api/extensions"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -305,6 +306,44 @@ var _ = Describe("Accessor", func() {
 					Expect(acc.GetResources()).To(Equal(namedResourceReference))
 				})
 			})
+
+			Describe("#GetHealthCheckAvailability", func() {
+				It("should get the health check availability", func() {
+					var (
+						availability = []extensionsv1alpha1.HealthCheckAvailability{
+							{
+								Type:        "ABC",
+								Last24Hours: pointer.String("99.50"),
+								Last7Days:   pointer.String("99.90"),
+								Buckets: []extensionsv1alpha1.HealthCheckAvailabilityBucket{
+									{Start: metav1.NewTime(metav1.Now().Truncate(time.Hour)), Total: 120, Successful: 119},
+								},
+							},
+						}
+						acc = mkUnstructuredAccessorWithStatus(extensionsv1alpha1.DefaultStatus{HealthCheckAvailability: availability})
+					)
+					Expect(acc.GetHealthCheckAvailability()).To(Equal(availability))
+				})
+			})
+
+			Describe("#SetHealthCheckAvailability", func() {
+				It("should set the health check availability", func() {
+					var (
+						acc          = mkUnstructuredAccessorWithStatus(extensionsv1alpha1.DefaultStatus{})
+						availability = []extensionsv1alpha1.HealthCheckAvailability{
+							{
+								Type:        "ABC",
+								Last24Hours: pointer.String("100.00"),
+								Buckets: []extensionsv1alpha1.HealthCheckAvailabilityBucket{
+									{Start: metav1.NewTime(metav1.Now().Truncate(time.Hour)), Total: 1, Successful: 1},
+								},
+							},
+						}
+					)
+					acc.SetHealthCheckAvailability(availability)
+					Expect(acc.GetHealthCheckAvailability()).To(Equal(availability))
+				})
+			})
 		})
 	})
 })
@@ -34,6 +34,24 @@ func ProjectNamespaceIndexerFunc(obj client.Object) []string {
 	return []string{pointer.StringDeref(project.Spec.Namespace, "")}
 }
 
+// ShootSeedNameIndexerFunc extracts the .spec.seedName field of a Shoot.
+func ShootSeedNameIndexerFunc(obj client.Object) []string {
+	shoot, ok := obj.(*gardencorev1beta1.Shoot)
+	if !ok {
+		return []string{""}
+	}
+	return []string{pointer.StringDeref(shoot.Spec.SeedName, "")}
+}
+
+// ShootStatusSeedNameIndexerFunc extracts the .status.seedName field of a Shoot.
+func ShootStatusSeedNameIndexerFunc(obj client.Object) []string {
+	shoot, ok := obj.(*gardencorev1beta1.Shoot)
+	if !ok {
+		return []string{""}
+	}
+	return []string{pointer.StringDeref(shoot.Status.SeedName, "")}
+}
+
 // BackupBucketSeedNameIndexerFunc extracts the .spec.seedName field of a BackupBucket.
 func BackupBucketSeedNameIndexerFunc(obj client.Object) []string {
 	backupBucket, ok := obj.(*gardencorev1beta1.BackupBucket)
@@ -89,13 +107,7 @@ func AddProjectNamespace(ctx context.Context, indexer client.FieldIndexer) error
 
 // AddShootSeedName adds an index for core.ShootSeedName to the given indexer.
 func AddShootSeedName(ctx context.Context, indexer client.FieldIndexer) error {
-	if err := indexer.IndexField(ctx, &gardencorev1beta1.Shoot{}, core.ShootSeedName, func(obj client.Object) []string {
-		shoot, ok := obj.(*gardencorev1beta1.Shoot)
-		if !ok {
-			return []string{""}
-		}
-		return []string{pointer.StringDeref(shoot.Spec.SeedName, "")}
-	}); err != nil {
+	if err := indexer.IndexField(ctx, &gardencorev1beta1.Shoot{}, core.ShootSeedName, ShootSeedNameIndexerFunc); err != nil {
 		return fmt.Errorf("failed to add indexer for %s to Shoot Informer: %w", core.ShootSeedName, err)
 	}
 	return nil
@@ -103,13 +115,7 @@ func AddShootSeedName(ctx context.Context, indexer client.FieldIndexer) error {
 
 // AddShootStatusSeedName adds an index for core.ShootStatusSeedName to the given indexer.
 func AddShootStatusSeedName(ctx context.Context, indexer client.FieldIndexer) error {
-	if err := indexer.IndexField(ctx, &gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, func(obj client.Object) []string {
-		shoot, ok := obj.(*gardencorev1beta1.Shoot)
-		if !ok {
-			return []string{""}
-		}
-		return []string{pointer.StringDeref(shoot.Status.SeedName, "")}
-	}); err != nil {
+	if err := indexer.IndexField(ctx, &gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, ShootStatusSeedNameIndexerFunc); err != nil {
 		return fmt.Errorf("failed to add indexer for %s to Shoot Informer: %w", core.ShootStatusSeedName, err)
 	}
 	return nil
@@ -204,6 +204,11 @@ func SeedSettingTopologyAwareRoutingEnabled(settings *core.SeedSettings) bool {
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
 }
 
+// SeedSettingDrainEnabled returns true if the seed is drained.
+func SeedSettingDrainEnabled(settings *core.SeedSettings) bool {
+	return settings != nil && settings.Scheduling != nil && settings.Scheduling.Drain != nil
+}
+
 // FindMachineImageVersion finds the machine image version in the <cloudProfile> for the given <name> and <version>.
 // In case no machine image version can be found with the given <name> or <version>, false is being returned.
 func FindMachineImageVersion(machineImages []core.MachineImage, name, version string) (core.MachineImageVersion, bool) {
@@ -559,6 +559,17 @@ var _ = Describe("helper", func() {
 		Entry("topology-aware routing disabled", &core.SeedSettings{TopologyAwareRouting: &core.SeedSettingTopologyAwareRouting{Enabled: false}}, false),
 	)
 
+	DescribeTable("#SeedSettingDrainEnabled",
+		func(settings *core.SeedSettings, expected bool) {
+			Expect(SeedSettingDrainEnabled(settings)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, false),
+		Entry("no scheduling setting", &core.SeedSettings{}, false),
+		Entry("drain not configured", &core.SeedSettings{Scheduling: &core.SeedSettingScheduling{Visible: true}}, false),
+		Entry("drain configured", &core.SeedSettings{Scheduling: &core.SeedSettingScheduling{Visible: true, Drain: &core.SeedSettingSchedulingDrain{}}}, true),
+	)
+
 	Describe("#FindMachineImageVersion", func() {
 		var machineImages []core.MachineImage
 
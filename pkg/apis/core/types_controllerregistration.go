@@ -30,6 +30,8 @@ type ControllerRegistration struct {
 	// Spec contains the specification of this registration.
 	// If the object's deletion timestamp is set, this field is immutable.
 	Spec ControllerRegistrationSpec
+	// Status contains the status of this registration.
+	Status ControllerRegistrationStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -96,8 +98,60 @@ type ControllerRegistrationDeployment struct {
 	SeedSelector *metav1.LabelSelector
 	// DeploymentRefs holds references to `ControllerDeployments`. Only one element is supported currently.
 	DeploymentRefs []DeploymentRef
+	// Rollout configures how a switch to another `ControllerDeployment` is rolled out to the seeds. If not set, the
+	// referenced `ControllerDeployment` is rolled out to all seeds at once.
+	Rollout *ControllerRegistrationRollout
 }
 
+// ControllerRegistrationRollout configures the progressive rollout of a `ControllerDeployment` to the seeds.
+type ControllerRegistrationRollout struct {
+	// CanarySeedSelector selects the seeds to which the `ControllerDeployment` is rolled out first. The rollout only
+	// continues with the other seeds once the controller is installed and healthy on all canary seeds.
+	CanarySeedSelector *metav1.LabelSelector
+	// MaxUnavailable is the maximum number of seeds (besides the canary seeds) on which the rolled out controller may
+	// not be installed and healthy at the same time. It defaults to 1.
+	MaxUnavailable *int32
+}
+
+// ControllerRegistrationStatus is the status of a ControllerRegistration.
+type ControllerRegistrationStatus struct {
+	// Rollout contains information about the progressive rollout of the referenced `ControllerDeployment`.
+	Rollout *ControllerRegistrationRolloutStatus
+}
+
+// ControllerRegistrationRolloutStatus contains information about the progressive rollout of a `ControllerDeployment`.
+type ControllerRegistrationRolloutStatus struct {
+	// DeploymentName is the name of the `ControllerDeployment` which is rolled out.
+	DeploymentName string
+	// Phase is the phase of the rollout.
+	Phase ControllerRegistrationRolloutPhase
+	// Seeds is the number of seeds the controller is installed on.
+	Seeds int32
+	// UpdatedSeeds is the number of seeds on which the `ControllerInstallation` references the rolled out
+	// `ControllerDeployment`.
+	UpdatedSeeds int32
+	// AvailableSeeds is the number of updated seeds on which the controller is installed and healthy.
+	AvailableSeeds int32
+	// FailedSeeds is the list of updated seeds on which the controller is not valid, failed to install or is unhealthy.
+	// The rollout is paused as long as this list is not empty.
+	FailedSeeds []string
+	// LastUpdateTime is the last time the rollout status was updated.
+	LastUpdateTime *metav1.Time
+}
+
+// ControllerRegistrationRolloutPhase is a string alias.
+type ControllerRegistrationRolloutPhase string
+
+const (
+	// ControllerRegistrationRolloutProgressing indicates that the `ControllerDeployment` is being rolled out.
+	ControllerRegistrationRolloutProgressing ControllerRegistrationRolloutPhase = "Progressing"
+	// ControllerRegistrationRolloutPaused indicates that the rollout is paused because the controller failed on at least
+	// one updated seed.
+	ControllerRegistrationRolloutPaused ControllerRegistrationRolloutPhase = "Paused"
+	// ControllerRegistrationRolloutCompleted indicates that the `ControllerDeployment` has been rolled out to all seeds.
+	ControllerRegistrationRolloutCompleted ControllerRegistrationRolloutPhase = "Completed"
+)
+
 // ControllerDeploymentPolicy is a string alias.
 type ControllerDeploymentPolicy string
 
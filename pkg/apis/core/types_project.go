@@ -15,6 +15,7 @@
 package core
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -66,6 +67,16 @@ type ProjectSpec struct {
 	Namespace *string
 	// Tolerations contains the default tolerations and a list for allowed taints on seed clusters.
 	Tolerations *ProjectTolerations
+	// Quota contains limits for the resources consumed by all Shoots of the project independent of the used
+	// credentials. Please note that this field may only be changed by users having the `modify-spec-quota` verb for
+	// project resources.
+	Quota *ProjectQuota
+	// Restrictions narrows down the settings offered by the CloudProfiles which may be used by the Shoots of the
+	// project. Please note that this field may only be changed by users having the `modify-spec-restrictions` verb for
+	// project resources.
+	Restrictions *ProjectRestrictions
+	// Bastion contains settings for the Bastions of the project.
+	Bastion *ProjectBastionSettings
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -81,8 +92,107 @@ type ProjectStatus struct {
 	StaleAutoDeleteTimestamp *metav1.Time
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	LastActivityTimestamp *metav1.Time
+	// Usage contains the resources currently consumed by all Shoots of the project. The same metrics which can be
+	// limited via `.spec.quota.limits` are reported.
+	Usage corev1.ResourceList
+	// AccessReview contains the result of the last review of the access of the project members.
+	AccessReview *ProjectAccessReview
+	// AccumulatedUsage contains the resources consumed by all Shoots of the project over time, e.g., for chargeback.
+	AccumulatedUsage *ProjectAccumulatedUsage
 }
 
+// ProjectAccumulatedUsage contains the resources consumed by all Shoots of a project over time.
+type ProjectAccumulatedUsage struct {
+	// Since is the time from which on the usage has been accumulated.
+	Since metav1.Time
+	// LastUpdateTimestamp is the time until which the usage has been accumulated.
+	LastUpdateTimestamp metav1.Time
+	// Rates contains the resources consumed by all Shoots of the project at the time of the last update. Other than
+	// in `.status.usage`, only the minimum number of nodes of each worker pool is considered, i.e., the rates are a
+	// lower bound of the actual consumption. The worker nodes and load balancers of hibernated Shoots are not
+	// considered. Instead, the number of hibernated Shoots is reported as `hibernated-shoots`.
+	Rates corev1.ResourceList
+	// Hours contains the resources accumulated over time in hours based on the `rates`, e.g., `cpu` contains the
+	// vCPU-hours, `memory` the memory-hours (in bytes) and `hibernated-shoots` the hours Shoots of the project have been
+	// hibernated. As the `rates`, the hours are a lower bound of the actual consumption.
+	Hours corev1.ResourceList
+	// MaximumRates contains the same resources as `rates` but considers the maximum number of nodes of each worker
+	// pool, i.e., they are an upper bound of the actual consumption.
+	MaximumRates corev1.ResourceList
+	// MaximumHours contains the resources accumulated over time in hours based on the `maximumRates`, i.e., they are an
+	// upper bound of the actual consumption.
+	MaximumHours corev1.ResourceList
+}
+
+// ProjectAccessReview contains the result of a review of the access of the project members.
+type ProjectAccessReview struct {
+	// LastReviewTimestamp is the time when the access of the project members was last reviewed.
+	LastReviewTimestamp metav1.Time
+	// Members contains the review results for the members of kind `User` and `ServiceAccount`.
+	Members []ProjectMemberAccessReview
+}
+
+// ProjectMemberAccessReview contains the review result for a project member.
+type ProjectMemberAccessReview struct {
+	// Subject is the reviewed project member.
+	rbacv1.Subject
+	// FirstReviewTimestamp is the time when the member was reviewed for the first time.
+	FirstReviewTimestamp metav1.Time
+	// LastActivityTimestamp is the time when the member was last seen using the project.
+	LastActivityTimestamp *metav1.Time
+	// FlaggedForRemoval indicates that the member has not used the project for longer than the configured inactivity
+	// threshold and should be removed from the project.
+	FlaggedForRemoval bool
+}
+
+// ProjectQuota contains limits for the resources consumed by all Shoots of a project.
+type ProjectQuota struct {
+	// Limits is the maximum amount of resources which may be consumed by all Shoots of the project.
+	// Supported resources are `shoots`, `cpu`, `gpu`, `memory` and `loadbalancer`.
+	Limits corev1.ResourceList
+}
+
+// ProjectRestrictions contains restrictions for the Shoots of a project. Empty lists do not restrict the respective
+// settings.
+type ProjectRestrictions struct {
+	// Regions is the list of regions which may be used by Shoots.
+	Regions []string
+	// MachineTypes is the list of machine types which may be used by worker pools.
+	MachineTypes []string
+	// Architectures is the list of CPU architectures which may be used by worker pools.
+	Architectures []string
+	// MachineImages is the list of machine images (and optionally their versions) which may be used by worker pools.
+	MachineImages []ProjectMachineImageRestriction
+	// VolumeTypes is the list of volume types which may be used by worker pools.
+	VolumeTypes []string
+}
+
+// ProjectBastionSettings contains settings for the Bastions of a project.
+type ProjectBastionSettings struct {
+	// RequireApproval specifies whether Bastions must be approved by another member of the project having the `admin`
+	// role before the bastion hosts are created.
+	RequireApproval bool
+	// MaxSessionDuration is the maximum duration for which Bastions may be used. If approvals are required, the
+	// duration starts with the approval.
+	MaxSessionDuration *metav1.Duration
+}
+
+// ProjectMachineImageRestriction contains a machine image which may be used by worker pools.
+type ProjectMachineImageRestriction struct {
+	// Name is the name of the machine image.
+	Name string
+	// Versions is the list of versions of the machine image which may be used. If empty, all versions are allowed.
+	Versions []string
+}
+
+const (
+	// ProjectQuotaMetricShoots is the constraint for the number of Shoots in a project.
+	ProjectQuotaMetricShoots corev1.ResourceName = "shoots"
+	// ProjectUsageMetricHibernatedShoots is the number of hibernated Shoots in a project reported in the accumulated
+	// usage.
+	ProjectUsageMetricHibernatedShoots corev1.ResourceName = "hibernated-shoots"
+)
+
 // ProjectMember is a member of a project.
 type ProjectMember struct {
 	// Subject is representing a user name, an email address, or any other identifier of a user, group, or service
@@ -90,6 +200,18 @@ type ProjectMember struct {
 	rbacv1.Subject
 	// Roles is a list of roles of this member.
 	Roles []string
+	// ExpirationDate is the time after which the member is removed from the project.
+	ExpirationDate *metav1.Time
+	// RoleExpirations contains the times after which single roles are removed from the member.
+	RoleExpirations []ProjectMemberRoleExpiration
+}
+
+// ProjectMemberRoleExpiration contains the time after which a role is removed from a project member.
+type ProjectMemberRoleExpiration struct {
+	// Role is the name of the role.
+	Role string
+	// ExpirationDate is the time after which the role is removed from the member.
+	ExpirationDate metav1.Time
 }
 
 // ProjectTolerations contains the tolerations for taints on seed clusters.
@@ -105,6 +105,18 @@ type SeedStatus struct {
 	ClientCertificateExpirationTimestamp *metav1.Time
 	// LastOperation holds information about the last operation on the Seed.
 	LastOperation *LastOperation
+	// Drain contains information about the progress of draining the Seed.
+	Drain *SeedDrainStatus
+}
+
+// SeedDrainStatus contains information about the progress of draining a seed.
+type SeedDrainStatus struct {
+	// StartTimestamp is the time when the drain of the seed was started.
+	StartTimestamp metav1.Time
+	// RemainingShoots is the number of shoots whose control planes are still hosted by the seed.
+	RemainingShoots int32
+	// MigratingShoots is the list of shoots (in the format <namespace>/<name>) which are currently migrated off the seed.
+	MigratingShoots []string
 }
 
 // SeedBackup contains the object store configuration for backups for shoot (currently only etcd).
@@ -232,6 +244,15 @@ type SeedSettingScheduling struct {
 	// Visible controls whether the gardener-scheduler shall consider this seed when scheduling shoots. Invisible seeds
 	// are not considered by the scheduler.
 	Visible bool
+	// Drain controls whether the seed is drained. Draining seeds are not considered by the scheduler, and the shoots
+	// hosted by them are migrated to other seeds during their maintenance time windows.
+	Drain *SeedSettingSchedulingDrain
+}
+
+// SeedSettingSchedulingDrain controls settings for draining the seed.
+type SeedSettingSchedulingDrain struct {
+	// MaxParallelMigrations is the maximum number of shoots which are migrated off the seed at the same time.
+	MaxParallelMigrations *int32
 }
 
 // SeedSettingLoadBalancerServices controls certain settings for services of type load balancer that are created in the
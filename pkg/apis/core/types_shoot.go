@@ -110,6 +110,12 @@ type ShootSpec struct {
 	// If not specified, the default scheduler takes over.
 	// This field is immutable.
 	SchedulerName *string
+	// Hooks contains hooks which are executed at well-defined points of the reconciliation and deletion flows of the
+	// Shoot.
+	Hooks []Hook
+	// Source contains information about the source the shoot cluster is cloned from when it is created.
+	// This field is immutable.
+	Source *ShootSource
 }
 
 // GetProviderType gets the type of the provider.
@@ -163,6 +169,19 @@ type ShootStatus struct {
 	// Secrets are encrypted by default and are not part of the list.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	EncryptedResources []string
+	// Availability contains the availability of the Shoot computed from the health check history reported by the
+	// extensions.
+	Availability *ShootAvailability
+}
+
+// ShootAvailability contains the availability of the Shoot during the last 24 hours and 7 days.
+type ShootAvailability struct {
+	// Last24Hours is the percentage of successful health checks during the last 24 hours. It is the minimum of the
+	// availabilities reported by the extensions.
+	Last24Hours *string
+	// Last7Days is the percentage of successful health checks during the last 7 days. It is the minimum of the
+	// availabilities reported by the extensions.
+	Last7Days *string
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -398,6 +417,63 @@ type Extension struct {
 	Disabled *bool
 }
 
+// Hook contains information about a hook which is executed at a well-defined point of the reconciliation or deletion
+// flow of the Shoot. For every execution, an Extension resource of the hook's type is created in the shoot namespace
+// in the seed, and the flow waits until it was reconciled successfully.
+type Hook struct {
+	// Name is the name of the hook. It must be unique within the Shoot.
+	Name string
+	// Type is the type of the Extension resource which implements the hook.
+	Type string
+	// Point is the point in the flow at which the hook is executed. Possible values are PreHibernation,
+	// PostHibernation, PreKubernetesUpgrade, PostKubernetesUpgrade, and PreDeletion.
+	Point HookPoint
+	// ProviderConfig is the configuration passed to the Extension resource.
+	ProviderConfig *runtime.RawExtension
+	// Timeout is the maximum duration to wait for the hook to succeed.
+	Timeout *metav1.Duration
+	// FailurePolicy defines how a failed or timed out hook is handled. Possible values are Fail (the flow fails) and
+	// Ignore (the flow continues).
+	FailurePolicy *HookFailurePolicy
+}
+
+// HookPoint is a point in the flow at which a hook is executed.
+type HookPoint string
+
+const (
+	// HookPointPreHibernation is the point before the Shoot is hibernated.
+	HookPointPreHibernation HookPoint = "PreHibernation"
+	// HookPointPostHibernation is the point after the Shoot was hibernated.
+	HookPointPostHibernation HookPoint = "PostHibernation"
+	// HookPointPreKubernetesUpgrade is the point before the Kubernetes version of the Shoot is upgraded.
+	HookPointPreKubernetesUpgrade HookPoint = "PreKubernetesUpgrade"
+	// HookPointPostKubernetesUpgrade is the point after the Kubernetes version of the Shoot was upgraded.
+	HookPointPostKubernetesUpgrade HookPoint = "PostKubernetesUpgrade"
+	// HookPointPreDeletion is the point before the Shoot is deleted.
+	HookPointPreDeletion HookPoint = "PreDeletion"
+)
+
+// HookFailurePolicy defines how a failed or timed out hook is handled.
+type HookFailurePolicy string
+
+const (
+	// HookFailurePolicyFail makes the flow fail if the hook fails.
+	HookFailurePolicyFail HookFailurePolicy = "Fail"
+	// HookFailurePolicyIgnore makes the flow continue if the hook fails.
+	HookFailurePolicyIgnore HookFailurePolicy = "Ignore"
+)
+
+// ShootSource contains information about the source a shoot cluster is cloned from.
+type ShootSource struct {
+	// BackupEntryName is the name of the BackupEntry in the namespace of the shoot whose latest ETCD backup is used to
+	// initialize the ETCD of the shoot. Selecting an earlier snapshot is not supported. The BackupEntry must belong to
+	// an existing shoot whose control plane runs on the same seed.
+	BackupEntryName string
+	// MaxBackupAge is the maximum age in days of the ETCD backups of the source shoot which are copied for the shoot.
+	// Older backups are not copied. If not set, all backups are copied.
+	MaxBackupAge *int32
+}
+
 // NamedResourceReference is a named reference to a resource.
 type NamedResourceReference struct {
 	// Name of the resource reference.
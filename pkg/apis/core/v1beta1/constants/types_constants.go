@@ -58,6 +58,9 @@ const (
 	// SecretNameSSHKeyPair is a constant for the name of a Kubernetes secret object that contains the SSH key pair
 	// (public and private key) that can be used to SSH into the shoot nodes.
 	SecretNameSSHKeyPair = "ssh-keypair"
+	// SecretNameSSHUserCA is a constant for the name of a Kubernetes secret object that contains the SSH certificate
+	// authority which is trusted by the shoot nodes for signing user certificates.
+	SecretNameSSHUserCA = "ssh-user-ca"
 	// SecretNameServiceAccountKey is a constant for the name of a Kubernetes secret object that contains a
 	// PEM-encoded private RSA or ECDSA key used by the Kube Controller Manager to sign service account tokens.
 	SecretNameServiceAccountKey = "service-account-key"
@@ -233,6 +236,9 @@ const (
 	// GardenerOperationKeepalive is a constant for the value of the operation annotation describing an
 	// operation that extends the lifetime of the object having the operation annotation.
 	GardenerOperationKeepalive = "keepalive"
+	// GardenerOperationApprove is a constant for the value of the operation annotation describing an operation that
+	// approves the object having the operation annotation.
+	GardenerOperationApprove = "approve"
 	// GardenerOperationRenewKubeconfig is a constant for the value of the operation annotation to renew the gardenlet's
 	// kubeconfig secret.
 	GardenerOperationRenewKubeconfig = "renew-kubeconfig"
@@ -357,6 +363,9 @@ const (
 	ShootTaskRestartControlPlanePods = "restartControlPlanePods"
 	// ShootTaskRestartCoreAddons is a name for a Shoot task which is dedicated to restart some core addons.
 	ShootTaskRestartCoreAddons = "restartCoreAddons"
+	// ShootTaskExecutePostKubernetesUpgradeHooks is a name for a Shoot task which indicates that the
+	// PostKubernetesUpgrade hooks of the Shoot still have to be executed after its Kubernetes version was upgraded.
+	ShootTaskExecutePostKubernetesUpgradeHooks = "executePostKubernetesUpgradeHooks"
 	// ShootOperationMaintain is a constant for an annotation on a Shoot indicating that the Shoot maintenance shall be
 	// executed as soon as possible.
 	ShootOperationMaintain = "maintain"
@@ -423,6 +432,12 @@ const (
 	LabelExtensionPrefix = "extensions.gardener.cloud/"
 	// LabelExtensionConfiguration is used to identify the provider's configuration which will be added to Gardener configuration
 	LabelExtensionConfiguration = LabelExtensionPrefix + "configuration"
+	// LabelShootWebhooks is used to identify ConfigMaps in extension namespaces which declare admission webhooks that
+	// shall be deployed into shoot clusters, and the ManagedResources deploying them.
+	LabelShootWebhooks = LabelExtensionPrefix + "shoot-webhooks"
+	// AnnotationShootWebhooksNamespaceSelector is an annotation on shoot webhook declaration ConfigMaps which contains
+	// a JSON-encoded map of labels that a shoot namespace in the seed must have for the webhooks to be deployed.
+	AnnotationShootWebhooksNamespaceSelector = LabelExtensionPrefix + "shoot-namespace-selector"
 	// LabelLogging is a constant for a label for logging stack configurations
 	LabelLogging = "logging"
 	// LabelMonitoring is a constant for a label for monitoring stack configurations
@@ -581,6 +596,9 @@ const (
 	// AnnotationSchedulingCloudProfiles is a constant for an annotation key on a configmap which denotes
 	// the linked cloudprofiles containing the region distances.
 	AnnotationSchedulingCloudProfiles = "scheduling.gardener.cloud/cloudprofiles"
+	// AnnotationSchedulingReschedule is a constant for an annotation on a Shoot resource which instructs the
+	// gardener-scheduler to migrate the shoot to another seed. It is only respected if the current seed is drained.
+	AnnotationSchedulingReschedule = "scheduling.gardener.cloud/reschedule"
 
 	// AnnotationConfirmationForceDeletion is a constant for an annotation on a Shoot resource whose value must be set to "true" in order to
 	// trigger force-deletion of the cluster. It can only be set if the Shoot has a deletion timestamp and contains an ErrorCode in the Shoot Status.
@@ -718,6 +736,18 @@ const (
 	// skipped by the stale project controller. If the project has already configured stale timestamps in its status
 	// then they will be reset.
 	ProjectSkipStaleCheck = "project.gardener.cloud/skip-stale-check"
+	// ProjectStaleStage is the key of an annotation on a project which is maintained by the stale project controller. It
+	// contains the stage of stale projects which still contain Shoots and are subject to a stale project policy.
+	ProjectStaleStage = "project.gardener.cloud/stale-stage"
+	// ProjectConfirmStaleDeletion is the key of an annotation on a project which can be set to "true" by the owner of the
+	// project in order to confirm that the project and its Shoots may be deleted once the deletion grace period of the
+	// stale project policy has passed. It may only be set while the project is stale and is removed once the project is
+	// not stale anymore.
+	ProjectConfirmStaleDeletion = "project.gardener.cloud/confirm-stale-deletion"
+	// ShootStaleProjectHibernationTimestamp is the key of an annotation on a shoot which is set by the stale project
+	// controller when it hibernates the shoot because its project is stale. Its value is the time of the hibernation.
+	// Updates of shoots changing this annotation are not considered as activity in the project.
+	ShootStaleProjectHibernationTimestamp = "project.gardener.cloud/stale-hibernation-timestamp"
 	// NamespaceProject is the key of an annotation on namespace whose value holds the project uid.
 	NamespaceProject = "namespace.gardener.cloud/project"
 	// NamespaceKeepAfterProjectDeletion is a constant for an annotation on a `Namespace` resource that states that it
@@ -65,3 +65,10 @@ func SetDefaults_ControllerRegistrationDeployment(obj *ControllerRegistrationDep
 		obj.Policy = &p
 	}
 }
+
+// SetDefaults_ControllerRegistrationRollout sets default values for ControllerRegistrationRollout objects.
+func SetDefaults_ControllerRegistrationRollout(obj *ControllerRegistrationRollout) {
+	if obj.MaxUnavailable == nil {
+		obj.MaxUnavailable = pointer.Int32(1)
+	}
+}
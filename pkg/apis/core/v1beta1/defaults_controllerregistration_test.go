@@ -165,4 +165,24 @@ var _ = Describe("ControllerRegistration defaulting", func() {
 			Expect(obj.Spec.Deployment.Policy).To(PointTo(BeEquivalentTo("Always")))
 		})
 	})
+
+	Describe("ControllerRegistrationRollout defaulting", func() {
+		BeforeEach(func() {
+			obj.Spec.Deployment.Rollout = &ControllerRegistrationRollout{}
+		})
+
+		It("should default the maxUnavailable field", func() {
+			SetObjectDefaults_ControllerRegistration(obj)
+
+			Expect(obj.Spec.Deployment.Rollout.MaxUnavailable).To(PointTo(Equal(int32(1))))
+		})
+
+		It("should not overwrite the maxUnavailable field", func() {
+			obj.Spec.Deployment.Rollout.MaxUnavailable = pointer.Int32(3)
+
+			SetObjectDefaults_ControllerRegistration(obj)
+
+			Expect(obj.Spec.Deployment.Rollout.MaxUnavailable).To(PointTo(Equal(int32(3))))
+		})
+	})
 })
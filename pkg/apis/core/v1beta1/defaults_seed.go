@@ -72,6 +72,13 @@ func SetDefaults_SeedSettingDependencyWatchdog(obj *SeedSettingDependencyWatchdo
 	}
 }
 
+// SetDefaults_SeedSettingSchedulingDrain sets defaults for SeedSettingSchedulingDrain objects.
+func SetDefaults_SeedSettingSchedulingDrain(obj *SeedSettingSchedulingDrain) {
+	if obj.MaxParallelMigrations == nil {
+		obj.MaxParallelMigrations = pointer.Int32(1)
+	}
+}
+
 func setDefaults_ExcessCapacityReservationConfig(excessCapacityReservation *SeedSettingExcessCapacityReservation) {
 	excessCapacityReservation.Configs = []SeedSettingExcessCapacityReservationConfig{
 		// This roughly corresponds to a single, moderately large control-plane.
@@ -141,4 +141,19 @@ var _ = Describe("Seed defaulting", func() {
 			Expect(obj.Spec.Settings.DependencyWatchdog.Prober.Enabled).To(Equal(dwdProberEnabled))
 		})
 	})
+	Describe("SeedSettingSchedulingDrain defaulting", func() {
+		It("should default the maximum number of parallel migrations", func() {
+			obj.Spec.Settings = &SeedSettings{Scheduling: &SeedSettingScheduling{Visible: true, Drain: &SeedSettingSchedulingDrain{}}}
+			SetObjectDefaults_Seed(obj)
+
+			Expect(obj.Spec.Settings.Scheduling.Drain.MaxParallelMigrations).To(PointTo(Equal(int32(1))))
+		})
+
+		It("should not overwrite the already set maximum number of parallel migrations", func() {
+			obj.Spec.Settings = &SeedSettings{Scheduling: &SeedSettingScheduling{Visible: true, Drain: &SeedSettingSchedulingDrain{MaxParallelMigrations: pointer.Int32(3)}}}
+			SetObjectDefaults_Seed(obj)
+
+			Expect(obj.Spec.Settings.Scheduling.Drain.MaxParallelMigrations).To(PointTo(Equal(int32(3))))
+		})
+	})
 })
@@ -19,12 +19,10 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
-	"github.com/gardener/gardener/pkg/utils/timewindow"
 )
 
 // SetDefaults_Shoot sets default values for Shoot objects.
@@ -132,53 +130,6 @@ func SetDefaults_Shoot(obj *Shoot) {
 			obj.Spec.Addons.KubernetesDashboard.AuthenticationMode = &defaultAuthMode
 		}
 
-		if obj.Spec.Kubernetes.Kubelet == nil {
-			obj.Spec.Kubernetes.Kubelet = &KubeletConfig{}
-		}
-		if obj.Spec.Kubernetes.Kubelet.FailSwapOn == nil {
-			obj.Spec.Kubernetes.Kubelet.FailSwapOn = pointer.Bool(true)
-		}
-
-		if nodeSwapFeatureGateEnabled, ok := obj.Spec.Kubernetes.Kubelet.FeatureGates["NodeSwap"]; ok && nodeSwapFeatureGateEnabled && !*obj.Spec.Kubernetes.Kubelet.FailSwapOn {
-			if obj.Spec.Kubernetes.Kubelet.MemorySwap == nil {
-				obj.Spec.Kubernetes.Kubelet.MemorySwap = &MemorySwapConfiguration{}
-			}
-			if obj.Spec.Kubernetes.Kubelet.MemorySwap.SwapBehavior == nil {
-				limitedSwap := LimitedSwap
-				obj.Spec.Kubernetes.Kubelet.MemorySwap.SwapBehavior = &limitedSwap
-			}
-		}
-		if obj.Spec.Kubernetes.Kubelet.ImageGCHighThresholdPercent == nil {
-			obj.Spec.Kubernetes.Kubelet.ImageGCHighThresholdPercent = pointer.Int32(50)
-		}
-		if obj.Spec.Kubernetes.Kubelet.ImageGCLowThresholdPercent == nil {
-			obj.Spec.Kubernetes.Kubelet.ImageGCLowThresholdPercent = pointer.Int32(40)
-		}
-		if obj.Spec.Kubernetes.Kubelet.SerializeImagePulls == nil {
-			obj.Spec.Kubernetes.Kubelet.SerializeImagePulls = pointer.Bool(true)
-		}
-
-		var (
-			kubeReservedMemory = resource.MustParse("1Gi")
-			kubeReservedCPU    = resource.MustParse("80m")
-			kubeReservedPID    = resource.MustParse("20k")
-		)
-
-		if obj.Spec.Kubernetes.Kubelet.KubeReserved == nil {
-			obj.Spec.Kubernetes.Kubelet.KubeReserved = &KubeletConfigReserved{Memory: &kubeReservedMemory, CPU: &kubeReservedCPU}
-			obj.Spec.Kubernetes.Kubelet.KubeReserved.PID = &kubeReservedPID
-		} else {
-			if obj.Spec.Kubernetes.Kubelet.KubeReserved.Memory == nil {
-				obj.Spec.Kubernetes.Kubelet.KubeReserved.Memory = &kubeReservedMemory
-			}
-			if obj.Spec.Kubernetes.Kubelet.KubeReserved.CPU == nil {
-				obj.Spec.Kubernetes.Kubelet.KubeReserved.CPU = &kubeReservedCPU
-			}
-			if obj.Spec.Kubernetes.Kubelet.KubeReserved.PID == nil {
-				obj.Spec.Kubernetes.Kubelet.KubeReserved.PID = &kubeReservedPID
-			}
-		}
-
 		if obj.Spec.Maintenance.AutoUpdate.MachineImageVersion == nil {
 			obj.Spec.Maintenance.AutoUpdate.MachineImageVersion = pointer.Bool(true)
 		}
@@ -241,17 +192,6 @@ func SetDefaults_Networking(obj *Networking) {
 	}
 }
 
-// SetDefaults_Maintenance sets default values for Maintenance objects.
-func SetDefaults_Maintenance(obj *Maintenance) {
-	if obj.TimeWindow == nil {
-		mt := timewindow.RandomMaintenanceTimeWindow()
-		obj.TimeWindow = &MaintenanceTimeWindow{
-			Begin: mt.Begin().Formatted(),
-			End:   mt.End().Formatted(),
-		}
-	}
-}
-
 // SetDefaults_VerticalPodAutoscaler sets default values for VerticalPodAutoscaler objects.
 func SetDefaults_VerticalPodAutoscaler(obj *VerticalPodAutoscaler) {
 	if obj.EvictAfterOOMThreshold == nil {
@@ -299,6 +239,17 @@ func SetDefaults_Worker(obj *Worker) {
 	}
 }
 
+// SetDefaults_Hook sets default values for Hook objects.
+func SetDefaults_Hook(obj *Hook) {
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+	if obj.FailurePolicy == nil {
+		failurePolicy := HookFailurePolicyFail
+		obj.FailurePolicy = &failurePolicy
+	}
+}
+
 // SetDefaults_ClusterAutoscaler sets default values for ClusterAutoscaler object.
 func SetDefaults_ClusterAutoscaler(obj *ClusterAutoscaler) {
 	if obj.ScaleDownDelayAfterAdd == nil {
@@ -21,7 +21,6 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
@@ -101,151 +100,10 @@ var _ = Describe("Shoot defaulting", func() {
 			Expect(obj.Spec.Kubernetes.KubeProxy.Enabled).To(PointTo(BeFalse()))
 		})
 
-		Describe("Kubelet defaulting", func() {
-			var (
-				defaultKubeReservedMemory = resource.MustParse("1Gi")
-				defaultKubeReservedCPU    = resource.MustParse("80m")
-				defaultKubeReservedPID    = resource.MustParse("20k")
-				kubeReservedMemory        = resource.MustParse("2Gi")
-				kubeReservedCPU           = resource.MustParse("20m")
-				kubeReservedPID           = resource.MustParse("10k")
-			)
-
-			BeforeEach(func() {
-				obj.Spec.Kubernetes.Kubelet = nil
-			})
-
-			It("should not default the kubelet field for workerless Shoot", func() {
-				obj.Spec.Provider.Workers = nil
-				SetObjectDefaults_Shoot(obj)
-
-				Expect(obj.Spec.Kubernetes.Kubelet).To(BeNil())
-			})
-
-			It("should default the kubelet field for Shoot with workers", func() {
-				SetObjectDefaults_Shoot(obj)
-
-				Expect(obj.Spec.Kubernetes.Kubelet).NotTo(BeNil())
-			})
-
-			It("should default the failSwapOn field", func() {
-				SetObjectDefaults_Shoot(obj)
-
-				Expect(obj.Spec.Kubernetes.Kubelet.FailSwapOn).To(PointTo(BeTrue()))
-			})
-
-			It("should not overwrite already set values for failSwapOn field", func() {
-				obj.Spec.Kubernetes.Kubelet = &KubeletConfig{}
-				obj.Spec.Kubernetes.Kubelet.FailSwapOn = pointer.Bool(false)
-
-				SetObjectDefaults_Shoot(obj)
-
-				Expect(obj.Spec.Kubernetes.Kubelet.FailSwapOn).To(PointTo(BeFalse()))
-			})
-
-			It("should default the swap behaviour", func() {
-				obj.Spec.Kubernetes.Kubelet = &KubeletConfig{}
-				obj.Spec.Kubernetes.Kubelet.FailSwapOn = pointer.Bool(false)
-				obj.Spec.Kubernetes.Kubelet.FeatureGates = map[string]bool{"NodeSwap": true}
-				SetObjectDefaults_Shoot(obj)
-
-				Expect(obj.Spec.Kubernetes.Kubelet.MemorySwap).To(Not(BeNil()))
-				Expect(obj.Spec.Kubernetes.Kubelet.MemorySwap.SwapBehavior).To(PointTo(Equal(LimitedSwap)))
-			})
-
-			It("should not overwrite already set values for swap behaviour", func() {
-				unlimitedSwap := UnlimitedSwap
-				obj.Spec.Kubernetes.Kubelet = &KubeletConfig{}
-				obj.Spec.Kubernetes.Kubelet.FailSwapOn = pointer.Bool(false)
-				obj.Spec.Kubernetes.Kubelet.FeatureGates = map[string]bool{"NodeSwap": true}
-				obj.Spec.Kubernetes.Kubelet.MemorySwap = &MemorySwapConfiguration{SwapBehavior: &unlimitedSwap}
-				SetObjectDefaults_Shoot(obj)
-
-				Expect(obj.Spec.Kubernetes.Kubelet.MemorySwap).To(Not(BeNil()))
-				Expect(obj.Spec.Kubernetes.Kubelet.MemorySwap.SwapBehavior).To(PointTo(Equal(UnlimitedSwap)))
-			})
-
-			It("should not default the swap behaviour because failSwapOn=true", func() {
-				obj.Spec.Kubernetes.Kubelet = &KubeletConfig{}
-				obj.Spec.Kubernetes.Kubelet.FailSwapOn = pointer.Bool(true)
-				obj.Spec.Kubernetes.Kubelet.FeatureGates = map[string]bool{"NodeSwap": true}
-				SetObjectDefaults_Shoot(obj)
-
-				Expect(obj.Spec.Kubernetes.Kubelet.MemorySwap).To(BeNil())
-			})
-
-			It("should not default the swap behaviour because kubelet NodeSwap feature gate is false", func() {
-				obj.Spec.Kubernetes.Kubelet = &KubeletConfig{}
-				obj.Spec.Kubernetes.Kubelet.FailSwapOn = pointer.Bool(false)
-				SetObjectDefaults_Shoot(obj)
-
-				Expect(obj.Spec.Kubernetes.Kubelet.MemorySwap).To(BeNil())
-			})
-
-			It("should default the imageGCThreshold fields", func() {
-				SetObjectDefaults_Shoot(obj)
-
-				Expect(obj.Spec.Kubernetes.Kubelet.ImageGCHighThresholdPercent).To(PointTo(Equal(int32(50))))
-				Expect(obj.Spec.Kubernetes.Kubelet.ImageGCLowThresholdPercent).To(PointTo(Equal(int32(40))))
-			})
-
-			It("should not overwrite already set values for imageGCThreshold field", func() {
-				var (
-					high int32 = 12
-					low  int32 = 34
-				)
-
-				obj.Spec.Kubernetes.Kubelet = &KubeletConfig{}
-				obj.Spec.Kubernetes.Kubelet.ImageGCHighThresholdPercent = &high
-				obj.Spec.Kubernetes.Kubelet.ImageGCLowThresholdPercent = &low
-
-				SetObjectDefaults_Shoot(obj)
-
-				Expect(obj.Spec.Kubernetes.Kubelet.ImageGCHighThresholdPercent).To(PointTo(Equal(high)))
-				Expect(obj.Spec.Kubernetes.Kubelet.ImageGCLowThresholdPercent).To(PointTo(Equal(low)))
-			})
-
-			It("should default the serializeImagePulls field", func() {
-				SetObjectDefaults_Shoot(obj)
-
-				Expect(obj.Spec.Kubernetes.Kubelet.SerializeImagePulls).To(PointTo(BeTrue()))
-			})
-
-			It("should not overwrite already set values for serializeImagePulls field", func() {
-				obj.Spec.Kubernetes.Kubelet = &KubeletConfig{}
-				obj.Spec.Kubernetes.Kubelet.SerializeImagePulls = pointer.Bool(false)
-
-				SetObjectDefaults_Shoot(obj)
-
-				Expect(obj.Spec.Kubernetes.Kubelet.SerializeImagePulls).To(PointTo(BeFalse()))
-			})
-
-			It("should default the kubeReserved field", func() {
-				SetObjectDefaults_Shoot(obj)
-
-				Expect(obj.Spec.Kubernetes.Kubelet.KubeReserved).To(PointTo(Equal(KubeletConfigReserved{
-					CPU:    &defaultKubeReservedCPU,
-					Memory: &defaultKubeReservedMemory,
-					PID:    &defaultKubeReservedPID,
-				})))
-			})
-
-			It("should not overwrite already set values for kubeReserved field", func() {
-				obj.Spec.Kubernetes.Kubelet = &KubeletConfig{
-					KubeReserved: &KubeletConfigReserved{
-						CPU:    &kubeReservedCPU,
-						Memory: &kubeReservedMemory,
-						PID:    &kubeReservedPID,
-					},
-				}
-				SetObjectDefaults_Shoot(obj)
+		It("should not default the kubelet field because it can be defaulted by ShootDefaults", func() {
+			SetObjectDefaults_Shoot(obj)
 
-				Expect(obj.Spec.Kubernetes.Kubelet.KubeReserved).To(PointTo(Equal(KubeletConfigReserved{
-					CPU:    &kubeReservedCPU,
-					Memory: &kubeReservedMemory,
-					PID:    &kubeReservedPID,
-				})))
-			})
+			Expect(obj.Spec.Kubernetes.Kubelet).To(BeNil())
 		})
 	})
 
@@ -541,12 +399,10 @@ var _ = Describe("Shoot defaulting", func() {
 			obj.Spec.Maintenance = nil
 		})
 
-		It("should default the maintenance timeWindow field", func() {
+		It("should not default the maintenance timeWindow field because it can be defaulted by ShootDefaults", func() {
 			SetObjectDefaults_Shoot(obj)
 
-			Expect(obj.Spec.Maintenance.TimeWindow).NotTo(BeNil())
-			Expect(obj.Spec.Maintenance.TimeWindow.Begin).To(HaveSuffix("0000+0000"))
-			Expect(obj.Spec.Maintenance.TimeWindow.End).To(HaveSuffix("0000+0000"))
+			Expect(obj.Spec.Maintenance.TimeWindow).To(BeNil())
 		})
 
 		It("should default both KubernetesVersion and MachineImageVersion field for shoot with workers", func() {
@@ -860,6 +716,27 @@ var _ = Describe("Shoot defaulting", func() {
 		})
 	})
 
+	Describe("Hook defaulting", func() {
+		It("should default the hook fields", func() {
+			obj.Spec.Hooks = []Hook{{Name: "foo"}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Hooks[0].Timeout).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+			Expect(obj.Spec.Hooks[0].FailurePolicy).To(PointTo(Equal(HookFailurePolicyFail)))
+		})
+
+		It("should not overwrite the already set values for hook fields", func() {
+			failurePolicy := HookFailurePolicyIgnore
+			obj.Spec.Hooks = []Hook{{Name: "foo", Timeout: &metav1.Duration{Duration: time.Minute}, FailurePolicy: &failurePolicy}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Hooks[0].Timeout).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			Expect(obj.Spec.Hooks[0].FailurePolicy).To(PointTo(Equal(HookFailurePolicyIgnore)))
+		})
+	})
+
 	Describe("ClusterAutoscaler defaulting", func() {
 		var (
 			expanderRandom     = ClusterAutoscalerExpanderRandom
@@ -1061,10 +1061,66 @@ func (m *ControllerRegistrationList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ControllerRegistrationList proto.InternalMessageInfo
 
+func (m *ControllerRegistrationRollout) Reset()      { *m = ControllerRegistrationRollout{} }
+func (*ControllerRegistrationRollout) ProtoMessage() {}
+func (*ControllerRegistrationRollout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
+}
+func (m *ControllerRegistrationRollout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControllerRegistrationRollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ControllerRegistrationRollout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControllerRegistrationRollout.Merge(m, src)
+}
+func (m *ControllerRegistrationRollout) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControllerRegistrationRollout) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControllerRegistrationRollout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControllerRegistrationRollout proto.InternalMessageInfo
+
+func (m *ControllerRegistrationRolloutStatus) Reset()      { *m = ControllerRegistrationRolloutStatus{} }
+func (*ControllerRegistrationRolloutStatus) ProtoMessage() {}
+func (*ControllerRegistrationRolloutStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
+}
+func (m *ControllerRegistrationRolloutStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControllerRegistrationRolloutStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ControllerRegistrationRolloutStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControllerRegistrationRolloutStatus.Merge(m, src)
+}
+func (m *ControllerRegistrationRolloutStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControllerRegistrationRolloutStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControllerRegistrationRolloutStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControllerRegistrationRolloutStatus proto.InternalMessageInfo
+
 func (m *ControllerRegistrationSpec) Reset()      { *m = ControllerRegistrationSpec{} }
 func (*ControllerRegistrationSpec) ProtoMessage() {}
 func (*ControllerRegistrationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
 }
 func (m *ControllerRegistrationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1089,10 +1145,38 @@ func (m *ControllerRegistrationSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ControllerRegistrationSpec proto.InternalMessageInfo
 
+func (m *ControllerRegistrationStatus) Reset()      { *m = ControllerRegistrationStatus{} }
+func (*ControllerRegistrationStatus) ProtoMessage() {}
+func (*ControllerRegistrationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
+}
+func (m *ControllerRegistrationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControllerRegistrationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ControllerRegistrationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControllerRegistrationStatus.Merge(m, src)
+}
+func (m *ControllerRegistrationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControllerRegistrationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControllerRegistrationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControllerRegistrationStatus proto.InternalMessageInfo
+
 func (m *ControllerResource) Reset()      { *m = ControllerResource{} }
 func (*ControllerResource) ProtoMessage() {}
 func (*ControllerResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
 }
 func (m *ControllerResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1120,7 +1204,7 @@ var xxx_messageInfo_ControllerResource proto.InternalMessageInfo
 func (m *ControllerResourceLifecycle) Reset()      { *m = ControllerResourceLifecycle{} }
 func (*ControllerResourceLifecycle) ProtoMessage() {}
 func (*ControllerResourceLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
 }
 func (m *ControllerResourceLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1148,7 +1232,7 @@ var xxx_messageInfo_ControllerResourceLifecycle proto.InternalMessageInfo
 func (m *CoreDNS) Reset()      { *m = CoreDNS{} }
 func (*CoreDNS) ProtoMessage() {}
 func (*CoreDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
 }
 func (m *CoreDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1176,7 +1260,7 @@ var xxx_messageInfo_CoreDNS proto.InternalMessageInfo
 func (m *CoreDNSAutoscaling) Reset()      { *m = CoreDNSAutoscaling{} }
 func (*CoreDNSAutoscaling) ProtoMessage() {}
 func (*CoreDNSAutoscaling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
 }
 func (m *CoreDNSAutoscaling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1204,7 +1288,7 @@ var xxx_messageInfo_CoreDNSAutoscaling proto.InternalMessageInfo
 func (m *CoreDNSRewriting) Reset()      { *m = CoreDNSRewriting{} }
 func (*CoreDNSRewriting) ProtoMessage() {}
 func (*CoreDNSRewriting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
 }
 func (m *CoreDNSRewriting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1232,7 +1316,7 @@ var xxx_messageInfo_CoreDNSRewriting proto.InternalMessageInfo
 func (m *DNS) Reset()      { *m = DNS{} }
 func (*DNS) ProtoMessage() {}
 func (*DNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
 }
 func (m *DNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1260,7 +1344,7 @@ var xxx_messageInfo_DNS proto.InternalMessageInfo
 func (m *DNSIncludeExclude) Reset()      { *m = DNSIncludeExclude{} }
 func (*DNSIncludeExclude) ProtoMessage() {}
 func (*DNSIncludeExclude) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
 }
 func (m *DNSIncludeExclude) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1288,7 +1372,7 @@ var xxx_messageInfo_DNSIncludeExclude proto.InternalMessageInfo
 func (m *DNSProvider) Reset()      { *m = DNSProvider{} }
 func (*DNSProvider) ProtoMessage() {}
 func (*DNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
 }
 func (m *DNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1316,7 +1400,7 @@ var xxx_messageInfo_DNSProvider proto.InternalMessageInfo
 func (m *DataVolume) Reset()      { *m = DataVolume{} }
 func (*DataVolume) ProtoMessage() {}
 func (*DataVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
 }
 func (m *DataVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1344,7 +1428,7 @@ var xxx_messageInfo_DataVolume proto.InternalMessageInfo
 func (m *DeploymentRef) Reset()      { *m = DeploymentRef{} }
 func (*DeploymentRef) ProtoMessage() {}
 func (*DeploymentRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
 }
 func (m *DeploymentRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1372,7 +1456,7 @@ var xxx_messageInfo_DeploymentRef proto.InternalMessageInfo
 func (m *ETCDEncryptionKeyRotation) Reset()      { *m = ETCDEncryptionKeyRotation{} }
 func (*ETCDEncryptionKeyRotation) ProtoMessage() {}
 func (*ETCDEncryptionKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
 }
 func (m *ETCDEncryptionKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1400,7 +1484,7 @@ var xxx_messageInfo_ETCDEncryptionKeyRotation proto.InternalMessageInfo
 func (m *EncryptionConfig) Reset()      { *m = EncryptionConfig{} }
 func (*EncryptionConfig) ProtoMessage() {}
 func (*EncryptionConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
 }
 func (m *EncryptionConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1428,7 +1512,7 @@ var xxx_messageInfo_EncryptionConfig proto.InternalMessageInfo
 func (m *ExpirableVersion) Reset()      { *m = ExpirableVersion{} }
 func (*ExpirableVersion) ProtoMessage() {}
 func (*ExpirableVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
 }
 func (m *ExpirableVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1456,7 +1540,7 @@ var xxx_messageInfo_ExpirableVersion proto.InternalMessageInfo
 func (m *ExposureClass) Reset()      { *m = ExposureClass{} }
 func (*ExposureClass) ProtoMessage() {}
 func (*ExposureClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
 }
 func (m *ExposureClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1484,7 +1568,7 @@ var xxx_messageInfo_ExposureClass proto.InternalMessageInfo
 func (m *ExposureClassList) Reset()      { *m = ExposureClassList{} }
 func (*ExposureClassList) ProtoMessage() {}
 func (*ExposureClassList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
 }
 func (m *ExposureClassList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1512,7 +1596,7 @@ var xxx_messageInfo_ExposureClassList proto.InternalMessageInfo
 func (m *ExposureClassScheduling) Reset()      { *m = ExposureClassScheduling{} }
 func (*ExposureClassScheduling) ProtoMessage() {}
 func (*ExposureClassScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
 }
 func (m *ExposureClassScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1540,7 +1624,7 @@ var xxx_messageInfo_ExposureClassScheduling proto.InternalMessageInfo
 func (m *Extension) Reset()      { *m = Extension{} }
 func (*Extension) ProtoMessage() {}
 func (*Extension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
 }
 func (m *Extension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1568,7 +1652,7 @@ var xxx_messageInfo_Extension proto.InternalMessageInfo
 func (m *ExtensionResourceState) Reset()      { *m = ExtensionResourceState{} }
 func (*ExtensionResourceState) ProtoMessage() {}
 func (*ExtensionResourceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
 }
 func (m *ExtensionResourceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1596,7 +1680,7 @@ var xxx_messageInfo_ExtensionResourceState proto.InternalMessageInfo
 func (m *FailureTolerance) Reset()      { *m = FailureTolerance{} }
 func (*FailureTolerance) ProtoMessage() {}
 func (*FailureTolerance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
 }
 func (m *FailureTolerance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1624,7 +1708,7 @@ var xxx_messageInfo_FailureTolerance proto.InternalMessageInfo
 func (m *Gardener) Reset()      { *m = Gardener{} }
 func (*Gardener) ProtoMessage() {}
 func (*Gardener) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
 }
 func (m *Gardener) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1652,7 +1736,7 @@ var xxx_messageInfo_Gardener proto.InternalMessageInfo
 func (m *GardenerResourceData) Reset()      { *m = GardenerResourceData{} }
 func (*GardenerResourceData) ProtoMessage() {}
 func (*GardenerResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
 }
 func (m *GardenerResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1680,7 +1764,7 @@ var xxx_messageInfo_GardenerResourceData proto.InternalMessageInfo
 func (m *Hibernation) Reset()      { *m = Hibernation{} }
 func (*Hibernation) ProtoMessage() {}
 func (*Hibernation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
 }
 func (m *Hibernation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1708,7 +1792,7 @@ var xxx_messageInfo_Hibernation proto.InternalMessageInfo
 func (m *HibernationSchedule) Reset()      { *m = HibernationSchedule{} }
 func (*HibernationSchedule) ProtoMessage() {}
 func (*HibernationSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
 }
 func (m *HibernationSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1736,7 +1820,7 @@ var xxx_messageInfo_HibernationSchedule proto.InternalMessageInfo
 func (m *HighAvailability) Reset()      { *m = HighAvailability{} }
 func (*HighAvailability) ProtoMessage() {}
 func (*HighAvailability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
 }
 func (m *HighAvailability) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1761,10 +1845,38 @@ func (m *HighAvailability) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HighAvailability proto.InternalMessageInfo
 
+func (m *Hook) Reset()      { *m = Hook{} }
+func (*Hook) ProtoMessage() {}
+func (*Hook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
+}
+func (m *Hook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Hook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Hook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hook.Merge(m, src)
+}
+func (m *Hook) XXX_Size() int {
+	return m.Size()
+}
+func (m *Hook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hook proto.InternalMessageInfo
+
 func (m *HorizontalPodAutoscalerConfig) Reset()      { *m = HorizontalPodAutoscalerConfig{} }
 func (*HorizontalPodAutoscalerConfig) ProtoMessage() {}
 func (*HorizontalPodAutoscalerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
 }
 func (m *HorizontalPodAutoscalerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1792,7 +1904,7 @@ var xxx_messageInfo_HorizontalPodAutoscalerConfig proto.InternalMessageInfo
 func (m *Ingress) Reset()      { *m = Ingress{} }
 func (*Ingress) ProtoMessage() {}
 func (*Ingress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
 }
 func (m *Ingress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1820,7 +1932,7 @@ var xxx_messageInfo_Ingress proto.InternalMessageInfo
 func (m *IngressController) Reset()      { *m = IngressController{} }
 func (*IngressController) ProtoMessage() {}
 func (*IngressController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
 }
 func (m *IngressController) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1848,7 +1960,7 @@ var xxx_messageInfo_IngressController proto.InternalMessageInfo
 func (m *InternalSecret) Reset()      { *m = InternalSecret{} }
 func (*InternalSecret) ProtoMessage() {}
 func (*InternalSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
 }
 func (m *InternalSecret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1876,7 +1988,7 @@ var xxx_messageInfo_InternalSecret proto.InternalMessageInfo
 func (m *InternalSecretList) Reset()      { *m = InternalSecretList{} }
 func (*InternalSecretList) ProtoMessage() {}
 func (*InternalSecretList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
 }
 func (m *InternalSecretList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1904,7 +2016,7 @@ var xxx_messageInfo_InternalSecretList proto.InternalMessageInfo
 func (m *KubeAPIServerConfig) Reset()      { *m = KubeAPIServerConfig{} }
 func (*KubeAPIServerConfig) ProtoMessage() {}
 func (*KubeAPIServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
 }
 func (m *KubeAPIServerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1932,7 +2044,7 @@ var xxx_messageInfo_KubeAPIServerConfig proto.InternalMessageInfo
 func (m *KubeControllerManagerConfig) Reset()      { *m = KubeControllerManagerConfig{} }
 func (*KubeControllerManagerConfig) ProtoMessage() {}
 func (*KubeControllerManagerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
 }
 func (m *KubeControllerManagerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1960,7 +2072,7 @@ var xxx_messageInfo_KubeControllerManagerConfig proto.InternalMessageInfo
 func (m *KubeProxyConfig) Reset()      { *m = KubeProxyConfig{} }
 func (*KubeProxyConfig) ProtoMessage() {}
 func (*KubeProxyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
 }
 func (m *KubeProxyConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1988,7 +2100,7 @@ var xxx_messageInfo_KubeProxyConfig proto.InternalMessageInfo
 func (m *KubeSchedulerConfig) Reset()      { *m = KubeSchedulerConfig{} }
 func (*KubeSchedulerConfig) ProtoMessage() {}
 func (*KubeSchedulerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
 }
 func (m *KubeSchedulerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2016,7 +2128,7 @@ var xxx_messageInfo_KubeSchedulerConfig proto.InternalMessageInfo
 func (m *KubeletConfig) Reset()      { *m = KubeletConfig{} }
 func (*KubeletConfig) ProtoMessage() {}
 func (*KubeletConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
 }
 func (m *KubeletConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2044,7 +2156,7 @@ var xxx_messageInfo_KubeletConfig proto.InternalMessageInfo
 func (m *KubeletConfigEviction) Reset()      { *m = KubeletConfigEviction{} }
 func (*KubeletConfigEviction) ProtoMessage() {}
 func (*KubeletConfigEviction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
 }
 func (m *KubeletConfigEviction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2072,7 +2184,7 @@ var xxx_messageInfo_KubeletConfigEviction proto.InternalMessageInfo
 func (m *KubeletConfigEvictionMinimumReclaim) Reset()      { *m = KubeletConfigEvictionMinimumReclaim{} }
 func (*KubeletConfigEvictionMinimumReclaim) ProtoMessage() {}
 func (*KubeletConfigEvictionMinimumReclaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
 }
 func (m *KubeletConfigEvictionMinimumReclaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2100,7 +2212,7 @@ var xxx_messageInfo_KubeletConfigEvictionMinimumReclaim proto.InternalMessageInf
 func (m *KubeletConfigEvictionSoftGracePeriod) Reset()      { *m = KubeletConfigEvictionSoftGracePeriod{} }
 func (*KubeletConfigEvictionSoftGracePeriod) ProtoMessage() {}
 func (*KubeletConfigEvictionSoftGracePeriod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
 }
 func (m *KubeletConfigEvictionSoftGracePeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2128,7 +2240,7 @@ var xxx_messageInfo_KubeletConfigEvictionSoftGracePeriod proto.InternalMessageIn
 func (m *KubeletConfigReserved) Reset()      { *m = KubeletConfigReserved{} }
 func (*KubeletConfigReserved) ProtoMessage() {}
 func (*KubeletConfigReserved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
 }
 func (m *KubeletConfigReserved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2156,7 +2268,7 @@ var xxx_messageInfo_KubeletConfigReserved proto.InternalMessageInfo
 func (m *Kubernetes) Reset()      { *m = Kubernetes{} }
 func (*Kubernetes) ProtoMessage() {}
 func (*Kubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
 }
 func (m *Kubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2184,7 +2296,7 @@ var xxx_messageInfo_Kubernetes proto.InternalMessageInfo
 func (m *KubernetesConfig) Reset()      { *m = KubernetesConfig{} }
 func (*KubernetesConfig) ProtoMessage() {}
 func (*KubernetesConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
 }
 func (m *KubernetesConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2212,7 +2324,7 @@ var xxx_messageInfo_KubernetesConfig proto.InternalMessageInfo
 func (m *KubernetesDashboard) Reset()      { *m = KubernetesDashboard{} }
 func (*KubernetesDashboard) ProtoMessage() {}
 func (*KubernetesDashboard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
 }
 func (m *KubernetesDashboard) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2240,7 +2352,7 @@ var xxx_messageInfo_KubernetesDashboard proto.InternalMessageInfo
 func (m *KubernetesSettings) Reset()      { *m = KubernetesSettings{} }
 func (*KubernetesSettings) ProtoMessage() {}
 func (*KubernetesSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
 }
 func (m *KubernetesSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2268,7 +2380,7 @@ var xxx_messageInfo_KubernetesSettings proto.InternalMessageInfo
 func (m *LastError) Reset()      { *m = LastError{} }
 func (*LastError) ProtoMessage() {}
 func (*LastError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
 }
 func (m *LastError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2296,7 +2408,7 @@ var xxx_messageInfo_LastError proto.InternalMessageInfo
 func (m *LastMaintenance) Reset()      { *m = LastMaintenance{} }
 func (*LastMaintenance) ProtoMessage() {}
 func (*LastMaintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
 }
 func (m *LastMaintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2324,7 +2436,7 @@ var xxx_messageInfo_LastMaintenance proto.InternalMessageInfo
 func (m *LastOperation) Reset()      { *m = LastOperation{} }
 func (*LastOperation) ProtoMessage() {}
 func (*LastOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
 }
 func (m *LastOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2352,7 +2464,7 @@ var xxx_messageInfo_LastOperation proto.InternalMessageInfo
 func (m *Machine) Reset()      { *m = Machine{} }
 func (*Machine) ProtoMessage() {}
 func (*Machine) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
 }
 func (m *Machine) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2380,7 +2492,7 @@ var xxx_messageInfo_Machine proto.InternalMessageInfo
 func (m *MachineControllerManagerSettings) Reset()      { *m = MachineControllerManagerSettings{} }
 func (*MachineControllerManagerSettings) ProtoMessage() {}
 func (*MachineControllerManagerSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
 }
 func (m *MachineControllerManagerSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2408,7 +2520,7 @@ var xxx_messageInfo_MachineControllerManagerSettings proto.InternalMessageInfo
 func (m *MachineImage) Reset()      { *m = MachineImage{} }
 func (*MachineImage) ProtoMessage() {}
 func (*MachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
 }
 func (m *MachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2436,7 +2548,7 @@ var xxx_messageInfo_MachineImage proto.InternalMessageInfo
 func (m *MachineImageVersion) Reset()      { *m = MachineImageVersion{} }
 func (*MachineImageVersion) ProtoMessage() {}
 func (*MachineImageVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
 }
 func (m *MachineImageVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2464,7 +2576,7 @@ var xxx_messageInfo_MachineImageVersion proto.InternalMessageInfo
 func (m *MachineType) Reset()      { *m = MachineType{} }
 func (*MachineType) ProtoMessage() {}
 func (*MachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
 }
 func (m *MachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2492,7 +2604,7 @@ var xxx_messageInfo_MachineType proto.InternalMessageInfo
 func (m *MachineTypeStorage) Reset()      { *m = MachineTypeStorage{} }
 func (*MachineTypeStorage) ProtoMessage() {}
 func (*MachineTypeStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
 }
 func (m *MachineTypeStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2520,7 +2632,7 @@ var xxx_messageInfo_MachineTypeStorage proto.InternalMessageInfo
 func (m *Maintenance) Reset()      { *m = Maintenance{} }
 func (*Maintenance) ProtoMessage() {}
 func (*Maintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
 }
 func (m *Maintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2548,7 +2660,7 @@ var xxx_messageInfo_Maintenance proto.InternalMessageInfo
 func (m *MaintenanceAutoUpdate) Reset()      { *m = MaintenanceAutoUpdate{} }
 func (*MaintenanceAutoUpdate) ProtoMessage() {}
 func (*MaintenanceAutoUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
 }
 func (m *MaintenanceAutoUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2576,7 +2688,7 @@ var xxx_messageInfo_MaintenanceAutoUpdate proto.InternalMessageInfo
 func (m *MaintenanceTimeWindow) Reset()      { *m = MaintenanceTimeWindow{} }
 func (*MaintenanceTimeWindow) ProtoMessage() {}
 func (*MaintenanceTimeWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
 }
 func (m *MaintenanceTimeWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2604,7 +2716,7 @@ var xxx_messageInfo_MaintenanceTimeWindow proto.InternalMessageInfo
 func (m *MemorySwapConfiguration) Reset()      { *m = MemorySwapConfiguration{} }
 func (*MemorySwapConfiguration) ProtoMessage() {}
 func (*MemorySwapConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
 }
 func (m *MemorySwapConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2632,7 +2744,7 @@ var xxx_messageInfo_MemorySwapConfiguration proto.InternalMessageInfo
 func (m *Monitoring) Reset()      { *m = Monitoring{} }
 func (*Monitoring) ProtoMessage() {}
 func (*Monitoring) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
 }
 func (m *Monitoring) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2660,7 +2772,7 @@ var xxx_messageInfo_Monitoring proto.InternalMessageInfo
 func (m *NamedResourceReference) Reset()      { *m = NamedResourceReference{} }
 func (*NamedResourceReference) ProtoMessage() {}
 func (*NamedResourceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
 }
 func (m *NamedResourceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2688,7 +2800,7 @@ var xxx_messageInfo_NamedResourceReference proto.InternalMessageInfo
 func (m *Networking) Reset()      { *m = Networking{} }
 func (*Networking) ProtoMessage() {}
 func (*Networking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
 }
 func (m *Networking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2716,7 +2828,7 @@ var xxx_messageInfo_Networking proto.InternalMessageInfo
 func (m *NginxIngress) Reset()      { *m = NginxIngress{} }
 func (*NginxIngress) ProtoMessage() {}
 func (*NginxIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
 }
 func (m *NginxIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2744,7 +2856,7 @@ var xxx_messageInfo_NginxIngress proto.InternalMessageInfo
 func (m *NodeLocalDNS) Reset()      { *m = NodeLocalDNS{} }
 func (*NodeLocalDNS) ProtoMessage() {}
 func (*NodeLocalDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
 }
 func (m *NodeLocalDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2772,7 +2884,7 @@ var xxx_messageInfo_NodeLocalDNS proto.InternalMessageInfo
 func (m *OIDCConfig) Reset()      { *m = OIDCConfig{} }
 func (*OIDCConfig) ProtoMessage() {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2800,7 +2912,7 @@ var xxx_messageInfo_OIDCConfig proto.InternalMessageInfo
 func (m *ObservabilityRotation) Reset()      { *m = ObservabilityRotation{} }
 func (*ObservabilityRotation) ProtoMessage() {}
 func (*ObservabilityRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
 }
 func (m *ObservabilityRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2828,7 +2940,7 @@ var xxx_messageInfo_ObservabilityRotation proto.InternalMessageInfo
 func (m *OpenIDConnectClientAuthentication) Reset()      { *m = OpenIDConnectClientAuthentication{} }
 func (*OpenIDConnectClientAuthentication) ProtoMessage() {}
 func (*OpenIDConnectClientAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
 }
 func (m *OpenIDConnectClientAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2856,7 +2968,7 @@ var xxx_messageInfo_OpenIDConnectClientAuthentication proto.InternalMessageInfo
 func (m *Project) Reset()      { *m = Project{} }
 func (*Project) ProtoMessage() {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2881,10 +2993,94 @@ func (m *Project) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Project proto.InternalMessageInfo
 
+func (m *ProjectAccessReview) Reset()      { *m = ProjectAccessReview{} }
+func (*ProjectAccessReview) ProtoMessage() {}
+func (*ProjectAccessReview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
+}
+func (m *ProjectAccessReview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectAccessReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectAccessReview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectAccessReview.Merge(m, src)
+}
+func (m *ProjectAccessReview) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectAccessReview) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectAccessReview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectAccessReview proto.InternalMessageInfo
+
+func (m *ProjectAccumulatedUsage) Reset()      { *m = ProjectAccumulatedUsage{} }
+func (*ProjectAccumulatedUsage) ProtoMessage() {}
+func (*ProjectAccumulatedUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
+}
+func (m *ProjectAccumulatedUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectAccumulatedUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectAccumulatedUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectAccumulatedUsage.Merge(m, src)
+}
+func (m *ProjectAccumulatedUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectAccumulatedUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectAccumulatedUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectAccumulatedUsage proto.InternalMessageInfo
+
+func (m *ProjectBastionSettings) Reset()      { *m = ProjectBastionSettings{} }
+func (*ProjectBastionSettings) ProtoMessage() {}
+func (*ProjectBastionSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
+}
+func (m *ProjectBastionSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectBastionSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectBastionSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectBastionSettings.Merge(m, src)
+}
+func (m *ProjectBastionSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectBastionSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectBastionSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectBastionSettings proto.InternalMessageInfo
+
 func (m *ProjectList) Reset()      { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage() {}
 func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
 }
 func (m *ProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2909,10 +3105,38 @@ func (m *ProjectList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProjectList proto.InternalMessageInfo
 
+func (m *ProjectMachineImageRestriction) Reset()      { *m = ProjectMachineImageRestriction{} }
+func (*ProjectMachineImageRestriction) ProtoMessage() {}
+func (*ProjectMachineImageRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
+}
+func (m *ProjectMachineImageRestriction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectMachineImageRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectMachineImageRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectMachineImageRestriction.Merge(m, src)
+}
+func (m *ProjectMachineImageRestriction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectMachineImageRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectMachineImageRestriction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectMachineImageRestriction proto.InternalMessageInfo
+
 func (m *ProjectMember) Reset()      { *m = ProjectMember{} }
 func (*ProjectMember) ProtoMessage() {}
 func (*ProjectMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
 }
 func (m *ProjectMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2937,10 +3161,122 @@ func (m *ProjectMember) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProjectMember proto.InternalMessageInfo
 
+func (m *ProjectMemberAccessReview) Reset()      { *m = ProjectMemberAccessReview{} }
+func (*ProjectMemberAccessReview) ProtoMessage() {}
+func (*ProjectMemberAccessReview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
+}
+func (m *ProjectMemberAccessReview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectMemberAccessReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectMemberAccessReview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectMemberAccessReview.Merge(m, src)
+}
+func (m *ProjectMemberAccessReview) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectMemberAccessReview) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectMemberAccessReview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectMemberAccessReview proto.InternalMessageInfo
+
+func (m *ProjectMemberRoleExpiration) Reset()      { *m = ProjectMemberRoleExpiration{} }
+func (*ProjectMemberRoleExpiration) ProtoMessage() {}
+func (*ProjectMemberRoleExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
+}
+func (m *ProjectMemberRoleExpiration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectMemberRoleExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectMemberRoleExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectMemberRoleExpiration.Merge(m, src)
+}
+func (m *ProjectMemberRoleExpiration) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectMemberRoleExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectMemberRoleExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectMemberRoleExpiration proto.InternalMessageInfo
+
+func (m *ProjectQuota) Reset()      { *m = ProjectQuota{} }
+func (*ProjectQuota) ProtoMessage() {}
+func (*ProjectQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
+}
+func (m *ProjectQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectQuota.Merge(m, src)
+}
+func (m *ProjectQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectQuota proto.InternalMessageInfo
+
+func (m *ProjectRestrictions) Reset()      { *m = ProjectRestrictions{} }
+func (*ProjectRestrictions) ProtoMessage() {}
+func (*ProjectRestrictions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
+}
+func (m *ProjectRestrictions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectRestrictions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectRestrictions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectRestrictions.Merge(m, src)
+}
+func (m *ProjectRestrictions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectRestrictions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectRestrictions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectRestrictions proto.InternalMessageInfo
+
 func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
 func (*ProjectSpec) ProtoMessage() {}
 func (*ProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
 }
 func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2968,7 +3304,7 @@ var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
 func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage() {}
 func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
 }
 func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2996,7 +3332,7 @@ var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 func (m *ProjectTolerations) Reset()      { *m = ProjectTolerations{} }
 func (*ProjectTolerations) ProtoMessage() {}
 func (*ProjectTolerations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
 }
 func (m *ProjectTolerations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3024,7 +3360,7 @@ var xxx_messageInfo_ProjectTolerations proto.InternalMessageInfo
 func (m *Provider) Reset()      { *m = Provider{} }
 func (*Provider) ProtoMessage() {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3052,7 +3388,7 @@ var xxx_messageInfo_Provider proto.InternalMessageInfo
 func (m *Quota) Reset()      { *m = Quota{} }
 func (*Quota) ProtoMessage() {}
 func (*Quota) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
 }
 func (m *Quota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3080,7 +3416,7 @@ var xxx_messageInfo_Quota proto.InternalMessageInfo
 func (m *QuotaList) Reset()      { *m = QuotaList{} }
 func (*QuotaList) ProtoMessage() {}
 func (*QuotaList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
 }
 func (m *QuotaList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3108,7 +3444,7 @@ var xxx_messageInfo_QuotaList proto.InternalMessageInfo
 func (m *QuotaSpec) Reset()      { *m = QuotaSpec{} }
 func (*QuotaSpec) ProtoMessage() {}
 func (*QuotaSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
 }
 func (m *QuotaSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3136,7 +3472,7 @@ var xxx_messageInfo_QuotaSpec proto.InternalMessageInfo
 func (m *Region) Reset()      { *m = Region{} }
 func (*Region) ProtoMessage() {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3164,7 +3500,7 @@ var xxx_messageInfo_Region proto.InternalMessageInfo
 func (m *ResourceData) Reset()      { *m = ResourceData{} }
 func (*ResourceData) ProtoMessage() {}
 func (*ResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
 }
 func (m *ResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3192,7 +3528,7 @@ var xxx_messageInfo_ResourceData proto.InternalMessageInfo
 func (m *ResourceWatchCacheSize) Reset()      { *m = ResourceWatchCacheSize{} }
 func (*ResourceWatchCacheSize) ProtoMessage() {}
 func (*ResourceWatchCacheSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
 }
 func (m *ResourceWatchCacheSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3220,7 +3556,7 @@ var xxx_messageInfo_ResourceWatchCacheSize proto.InternalMessageInfo
 func (m *SSHAccess) Reset()      { *m = SSHAccess{} }
 func (*SSHAccess) ProtoMessage() {}
 func (*SSHAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
 }
 func (m *SSHAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3248,7 +3584,7 @@ var xxx_messageInfo_SSHAccess proto.InternalMessageInfo
 func (m *SecretBinding) Reset()      { *m = SecretBinding{} }
 func (*SecretBinding) ProtoMessage() {}
 func (*SecretBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
 }
 func (m *SecretBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3276,7 +3612,7 @@ var xxx_messageInfo_SecretBinding proto.InternalMessageInfo
 func (m *SecretBindingList) Reset()      { *m = SecretBindingList{} }
 func (*SecretBindingList) ProtoMessage() {}
 func (*SecretBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
 }
 func (m *SecretBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3304,7 +3640,7 @@ var xxx_messageInfo_SecretBindingList proto.InternalMessageInfo
 func (m *SecretBindingProvider) Reset()      { *m = SecretBindingProvider{} }
 func (*SecretBindingProvider) ProtoMessage() {}
 func (*SecretBindingProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
 }
 func (m *SecretBindingProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3332,7 +3668,7 @@ var xxx_messageInfo_SecretBindingProvider proto.InternalMessageInfo
 func (m *Seed) Reset()      { *m = Seed{} }
 func (*Seed) ProtoMessage() {}
 func (*Seed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
 }
 func (m *Seed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3360,7 +3696,7 @@ var xxx_messageInfo_Seed proto.InternalMessageInfo
 func (m *SeedBackup) Reset()      { *m = SeedBackup{} }
 func (*SeedBackup) ProtoMessage() {}
 func (*SeedBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
 }
 func (m *SeedBackup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3388,7 +3724,7 @@ var xxx_messageInfo_SeedBackup proto.InternalMessageInfo
 func (m *SeedDNS) Reset()      { *m = SeedDNS{} }
 func (*SeedDNS) ProtoMessage() {}
 func (*SeedDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
 }
 func (m *SeedDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3416,7 +3752,7 @@ var xxx_messageInfo_SeedDNS proto.InternalMessageInfo
 func (m *SeedDNSProvider) Reset()      { *m = SeedDNSProvider{} }
 func (*SeedDNSProvider) ProtoMessage() {}
 func (*SeedDNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
 }
 func (m *SeedDNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3441,10 +3777,38 @@ func (m *SeedDNSProvider) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SeedDNSProvider proto.InternalMessageInfo
 
+func (m *SeedDrainStatus) Reset()      { *m = SeedDrainStatus{} }
+func (*SeedDrainStatus) ProtoMessage() {}
+func (*SeedDrainStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+}
+func (m *SeedDrainStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeedDrainStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SeedDrainStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeedDrainStatus.Merge(m, src)
+}
+func (m *SeedDrainStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeedDrainStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeedDrainStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeedDrainStatus proto.InternalMessageInfo
+
 func (m *SeedList) Reset()      { *m = SeedList{} }
 func (*SeedList) ProtoMessage() {}
 func (*SeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
 }
 func (m *SeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3472,7 +3836,7 @@ var xxx_messageInfo_SeedList proto.InternalMessageInfo
 func (m *SeedNetworks) Reset()      { *m = SeedNetworks{} }
 func (*SeedNetworks) ProtoMessage() {}
 func (*SeedNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
 }
 func (m *SeedNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3500,7 +3864,7 @@ var xxx_messageInfo_SeedNetworks proto.InternalMessageInfo
 func (m *SeedProvider) Reset()      { *m = SeedProvider{} }
 func (*SeedProvider) ProtoMessage() {}
 func (*SeedProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
 }
 func (m *SeedProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3528,7 +3892,7 @@ var xxx_messageInfo_SeedProvider proto.InternalMessageInfo
 func (m *SeedSelector) Reset()      { *m = SeedSelector{} }
 func (*SeedSelector) ProtoMessage() {}
 func (*SeedSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
 }
 func (m *SeedSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3556,7 +3920,7 @@ var xxx_messageInfo_SeedSelector proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdog) Reset()      { *m = SeedSettingDependencyWatchdog{} }
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
 }
 func (m *SeedSettingDependencyWatchdog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3584,7 +3948,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdog proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdogProber) Reset()      { *m = SeedSettingDependencyWatchdogProber{} }
 func (*SeedSettingDependencyWatchdogProber) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogProber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
 }
 func (m *SeedSettingDependencyWatchdogProber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3612,7 +3976,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogProber proto.InternalMessageInf
 func (m *SeedSettingDependencyWatchdogWeeder) Reset()      { *m = SeedSettingDependencyWatchdogWeeder{} }
 func (*SeedSettingDependencyWatchdogWeeder) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogWeeder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
 }
 func (m *SeedSettingDependencyWatchdogWeeder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3640,7 +4004,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogWeeder proto.InternalMessageInf
 func (m *SeedSettingExcessCapacityReservation) Reset()      { *m = SeedSettingExcessCapacityReservation{} }
 func (*SeedSettingExcessCapacityReservation) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
 }
 func (m *SeedSettingExcessCapacityReservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3670,7 +4034,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Reset() {
 }
 func (*SeedSettingExcessCapacityReservationConfig) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
 }
 func (m *SeedSettingExcessCapacityReservationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3698,7 +4062,7 @@ var xxx_messageInfo_SeedSettingExcessCapacityReservationConfig proto.InternalMes
 func (m *SeedSettingLoadBalancerServices) Reset()      { *m = SeedSettingLoadBalancerServices{} }
 func (*SeedSettingLoadBalancerServices) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
 }
 func (m *SeedSettingLoadBalancerServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3726,7 +4090,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServices proto.InternalMessageInfo
 func (m *SeedSettingLoadBalancerServicesZones) Reset()      { *m = SeedSettingLoadBalancerServicesZones{} }
 func (*SeedSettingLoadBalancerServicesZones) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZones) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
 }
 func (m *SeedSettingLoadBalancerServicesZones) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3754,7 +4118,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZones proto.InternalMessageIn
 func (m *SeedSettingScheduling) Reset()      { *m = SeedSettingScheduling{} }
 func (*SeedSettingScheduling) ProtoMessage() {}
 func (*SeedSettingScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
 }
 func (m *SeedSettingScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3779,10 +4143,38 @@ func (m *SeedSettingScheduling) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SeedSettingScheduling proto.InternalMessageInfo
 
+func (m *SeedSettingSchedulingDrain) Reset()      { *m = SeedSettingSchedulingDrain{} }
+func (*SeedSettingSchedulingDrain) ProtoMessage() {}
+func (*SeedSettingSchedulingDrain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
+}
+func (m *SeedSettingSchedulingDrain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeedSettingSchedulingDrain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SeedSettingSchedulingDrain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeedSettingSchedulingDrain.Merge(m, src)
+}
+func (m *SeedSettingSchedulingDrain) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeedSettingSchedulingDrain) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeedSettingSchedulingDrain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeedSettingSchedulingDrain proto.InternalMessageInfo
+
 func (m *SeedSettingTopologyAwareRouting) Reset()      { *m = SeedSettingTopologyAwareRouting{} }
 func (*SeedSettingTopologyAwareRouting) ProtoMessage() {}
 func (*SeedSettingTopologyAwareRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
 }
 func (m *SeedSettingTopologyAwareRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3810,7 +4202,7 @@ var xxx_messageInfo_SeedSettingTopologyAwareRouting proto.InternalMessageInfo
 func (m *SeedSettingVerticalPodAutoscaler) Reset()      { *m = SeedSettingVerticalPodAutoscaler{} }
 func (*SeedSettingVerticalPodAutoscaler) ProtoMessage() {}
 func (*SeedSettingVerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
 }
 func (m *SeedSettingVerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3838,7 +4230,7 @@ var xxx_messageInfo_SeedSettingVerticalPodAutoscaler proto.InternalMessageInfo
 func (m *SeedSettings) Reset()      { *m = SeedSettings{} }
 func (*SeedSettings) ProtoMessage() {}
 func (*SeedSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
 }
 func (m *SeedSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3866,7 +4258,7 @@ var xxx_messageInfo_SeedSettings proto.InternalMessageInfo
 func (m *SeedSpec) Reset()      { *m = SeedSpec{} }
 func (*SeedSpec) ProtoMessage() {}
 func (*SeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
 }
 func (m *SeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3894,7 +4286,7 @@ var xxx_messageInfo_SeedSpec proto.InternalMessageInfo
 func (m *SeedStatus) Reset()      { *m = SeedStatus{} }
 func (*SeedStatus) ProtoMessage() {}
 func (*SeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
 }
 func (m *SeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3922,7 +4314,7 @@ var xxx_messageInfo_SeedStatus proto.InternalMessageInfo
 func (m *SeedTaint) Reset()      { *m = SeedTaint{} }
 func (*SeedTaint) ProtoMessage() {}
 func (*SeedTaint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
 }
 func (m *SeedTaint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3950,7 +4342,7 @@ var xxx_messageInfo_SeedTaint proto.InternalMessageInfo
 func (m *SeedTemplate) Reset()      { *m = SeedTemplate{} }
 func (*SeedTemplate) ProtoMessage() {}
 func (*SeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
 }
 func (m *SeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3978,7 +4370,7 @@ var xxx_messageInfo_SeedTemplate proto.InternalMessageInfo
 func (m *SeedVolume) Reset()      { *m = SeedVolume{} }
 func (*SeedVolume) ProtoMessage() {}
 func (*SeedVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
 }
 func (m *SeedVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4006,7 +4398,7 @@ var xxx_messageInfo_SeedVolume proto.InternalMessageInfo
 func (m *SeedVolumeProvider) Reset()      { *m = SeedVolumeProvider{} }
 func (*SeedVolumeProvider) ProtoMessage() {}
 func (*SeedVolumeProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
 }
 func (m *SeedVolumeProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4034,7 +4426,7 @@ var xxx_messageInfo_SeedVolumeProvider proto.InternalMessageInfo
 func (m *ServiceAccountConfig) Reset()      { *m = ServiceAccountConfig{} }
 func (*ServiceAccountConfig) ProtoMessage() {}
 func (*ServiceAccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
 }
 func (m *ServiceAccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4062,7 +4454,7 @@ var xxx_messageInfo_ServiceAccountConfig proto.InternalMessageInfo
 func (m *ServiceAccountKeyRotation) Reset()      { *m = ServiceAccountKeyRotation{} }
 func (*ServiceAccountKeyRotation) ProtoMessage() {}
 func (*ServiceAccountKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
 }
 func (m *ServiceAccountKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4090,7 +4482,7 @@ var xxx_messageInfo_ServiceAccountKeyRotation proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4118,7 +4510,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *ShootAdvertisedAddress) Reset()      { *m = ShootAdvertisedAddress{} }
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 func (*ShootAdvertisedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
 }
 func (m *ShootAdvertisedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4143,10 +4535,38 @@ func (m *ShootAdvertisedAddress) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ShootAdvertisedAddress proto.InternalMessageInfo
 
+func (m *ShootAvailability) Reset()      { *m = ShootAvailability{} }
+func (*ShootAvailability) ProtoMessage() {}
+func (*ShootAvailability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+}
+func (m *ShootAvailability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootAvailability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootAvailability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootAvailability.Merge(m, src)
+}
+func (m *ShootAvailability) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootAvailability) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootAvailability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootAvailability proto.InternalMessageInfo
+
 func (m *ShootCredentials) Reset()      { *m = ShootCredentials{} }
 func (*ShootCredentials) ProtoMessage() {}
 func (*ShootCredentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
 }
 func (m *ShootCredentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4174,7 +4594,7 @@ var xxx_messageInfo_ShootCredentials proto.InternalMessageInfo
 func (m *ShootCredentialsRotation) Reset()      { *m = ShootCredentialsRotation{} }
 func (*ShootCredentialsRotation) ProtoMessage() {}
 func (*ShootCredentialsRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
 }
 func (m *ShootCredentialsRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4202,7 +4622,7 @@ var xxx_messageInfo_ShootCredentialsRotation proto.InternalMessageInfo
 func (m *ShootKubeconfigRotation) Reset()      { *m = ShootKubeconfigRotation{} }
 func (*ShootKubeconfigRotation) ProtoMessage() {}
 func (*ShootKubeconfigRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
 }
 func (m *ShootKubeconfigRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4230,7 +4650,7 @@ var xxx_messageInfo_ShootKubeconfigRotation proto.InternalMessageInfo
 func (m *ShootList) Reset()      { *m = ShootList{} }
 func (*ShootList) ProtoMessage() {}
 func (*ShootList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
 }
 func (m *ShootList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4258,7 +4678,7 @@ var xxx_messageInfo_ShootList proto.InternalMessageInfo
 func (m *ShootMachineImage) Reset()      { *m = ShootMachineImage{} }
 func (*ShootMachineImage) ProtoMessage() {}
 func (*ShootMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
 }
 func (m *ShootMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4286,7 +4706,7 @@ var xxx_messageInfo_ShootMachineImage proto.InternalMessageInfo
 func (m *ShootNetworks) Reset()      { *m = ShootNetworks{} }
 func (*ShootNetworks) ProtoMessage() {}
 func (*ShootNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
 }
 func (m *ShootNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4314,7 +4734,7 @@ var xxx_messageInfo_ShootNetworks proto.InternalMessageInfo
 func (m *ShootSSHKeypairRotation) Reset()      { *m = ShootSSHKeypairRotation{} }
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 func (*ShootSSHKeypairRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
 }
 func (m *ShootSSHKeypairRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4339,10 +4759,38 @@ func (m *ShootSSHKeypairRotation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ShootSSHKeypairRotation proto.InternalMessageInfo
 
+func (m *ShootSource) Reset()      { *m = ShootSource{} }
+func (*ShootSource) ProtoMessage() {}
+func (*ShootSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
+}
+func (m *ShootSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootSource.Merge(m, src)
+}
+func (m *ShootSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootSource proto.InternalMessageInfo
+
 func (m *ShootSpec) Reset()      { *m = ShootSpec{} }
 func (*ShootSpec) ProtoMessage() {}
 func (*ShootSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
 }
 func (m *ShootSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4370,7 +4818,7 @@ var xxx_messageInfo_ShootSpec proto.InternalMessageInfo
 func (m *ShootState) Reset()      { *m = ShootState{} }
 func (*ShootState) ProtoMessage() {}
 func (*ShootState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
 }
 func (m *ShootState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4398,7 +4846,7 @@ var xxx_messageInfo_ShootState proto.InternalMessageInfo
 func (m *ShootStateList) Reset()      { *m = ShootStateList{} }
 func (*ShootStateList) ProtoMessage() {}
 func (*ShootStateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
 }
 func (m *ShootStateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4426,7 +4874,7 @@ var xxx_messageInfo_ShootStateList proto.InternalMessageInfo
 func (m *ShootStateSpec) Reset()      { *m = ShootStateSpec{} }
 func (*ShootStateSpec) ProtoMessage() {}
 func (*ShootStateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
 }
 func (m *ShootStateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4454,7 +4902,7 @@ var xxx_messageInfo_ShootStateSpec proto.InternalMessageInfo
 func (m *ShootStatus) Reset()      { *m = ShootStatus{} }
 func (*ShootStatus) ProtoMessage() {}
 func (*ShootStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
 }
 func (m *ShootStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4482,7 +4930,7 @@ var xxx_messageInfo_ShootStatus proto.InternalMessageInfo
 func (m *ShootTemplate) Reset()      { *m = ShootTemplate{} }
 func (*ShootTemplate) ProtoMessage() {}
 func (*ShootTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
 }
 func (m *ShootTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4510,7 +4958,7 @@ var xxx_messageInfo_ShootTemplate proto.InternalMessageInfo
 func (m *SystemComponents) Reset()      { *m = SystemComponents{} }
 func (*SystemComponents) ProtoMessage() {}
 func (*SystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
 }
 func (m *SystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4538,7 +4986,7 @@ var xxx_messageInfo_SystemComponents proto.InternalMessageInfo
 func (m *Toleration) Reset()      { *m = Toleration{} }
 func (*Toleration) ProtoMessage() {}
 func (*Toleration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
 }
 func (m *Toleration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4566,7 +5014,7 @@ var xxx_messageInfo_Toleration proto.InternalMessageInfo
 func (m *VerticalPodAutoscaler) Reset()      { *m = VerticalPodAutoscaler{} }
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 func (*VerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
 }
 func (m *VerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4594,7 +5042,7 @@ var xxx_messageInfo_VerticalPodAutoscaler proto.InternalMessageInfo
 func (m *Volume) Reset()      { *m = Volume{} }
 func (*Volume) ProtoMessage() {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4622,7 +5070,7 @@ var xxx_messageInfo_Volume proto.InternalMessageInfo
 func (m *VolumeType) Reset()      { *m = VolumeType{} }
 func (*VolumeType) ProtoMessage() {}
 func (*VolumeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
 }
 func (m *VolumeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4650,7 +5098,7 @@ var xxx_messageInfo_VolumeType proto.InternalMessageInfo
 func (m *WatchCacheSizes) Reset()      { *m = WatchCacheSizes{} }
 func (*WatchCacheSizes) ProtoMessage() {}
 func (*WatchCacheSizes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
 }
 func (m *WatchCacheSizes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4678,7 +5126,7 @@ var xxx_messageInfo_WatchCacheSizes proto.InternalMessageInfo
 func (m *Worker) Reset()      { *m = Worker{} }
 func (*Worker) ProtoMessage() {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4706,7 +5154,7 @@ var xxx_messageInfo_Worker proto.InternalMessageInfo
 func (m *WorkerKubernetes) Reset()      { *m = WorkerKubernetes{} }
 func (*WorkerKubernetes) ProtoMessage() {}
 func (*WorkerKubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
 }
 func (m *WorkerKubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4734,7 +5182,7 @@ var xxx_messageInfo_WorkerKubernetes proto.InternalMessageInfo
 func (m *WorkerSystemComponents) Reset()      { *m = WorkerSystemComponents{} }
 func (*WorkerSystemComponents) ProtoMessage() {}
 func (*WorkerSystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
 }
 func (m *WorkerSystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4762,7 +5210,7 @@ var xxx_messageInfo_WorkerSystemComponents proto.InternalMessageInfo
 func (m *WorkersSettings) Reset()      { *m = WorkersSettings{} }
 func (*WorkersSettings) ProtoMessage() {}
 func (*WorkersSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
 }
 func (m *WorkersSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4824,7 +5272,10 @@ func init() {
 	proto.RegisterType((*ControllerRegistration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerRegistration")
 	proto.RegisterType((*ControllerRegistrationDeployment)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerRegistrationDeployment")
 	proto.RegisterType((*ControllerRegistrationList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerRegistrationList")
+	proto.RegisterType((*ControllerRegistrationRollout)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerRegistrationRollout")
+	proto.RegisterType((*ControllerRegistrationRolloutStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerRegistrationRolloutStatus")
 	proto.RegisterType((*ControllerRegistrationSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerRegistrationSpec")
+	proto.RegisterType((*ControllerRegistrationStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerRegistrationStatus")
 	proto.RegisterType((*ControllerResource)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerResource")
 	proto.RegisterType((*ControllerResourceLifecycle)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerResourceLifecycle")
 	proto.RegisterType((*CoreDNS)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CoreDNS")
@@ -4850,6 +5301,7 @@ func init() {
 	proto.RegisterType((*Hibernation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Hibernation")
 	proto.RegisterType((*HibernationSchedule)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HibernationSchedule")
 	proto.RegisterType((*HighAvailability)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HighAvailability")
+	proto.RegisterType((*Hook)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Hook")
 	proto.RegisterType((*HorizontalPodAutoscalerConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HorizontalPodAutoscalerConfig")
 	proto.RegisterType((*Ingress)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Ingress")
 	proto.RegisterType((*IngressController)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.IngressController")
@@ -4897,10 +5349,24 @@ func init() {
 	proto.RegisterType((*OpenIDConnectClientAuthentication)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OpenIDConnectClientAuthentication")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OpenIDConnectClientAuthentication.ExtraConfigEntry")
 	proto.RegisterType((*Project)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Project")
+	proto.RegisterType((*ProjectAccessReview)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectAccessReview")
+	proto.RegisterType((*ProjectAccumulatedUsage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectAccumulatedUsage")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectAccumulatedUsage.HoursEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectAccumulatedUsage.MaximumHoursEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectAccumulatedUsage.MaximumRatesEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectAccumulatedUsage.RatesEntry")
+	proto.RegisterType((*ProjectBastionSettings)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectBastionSettings")
 	proto.RegisterType((*ProjectList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectList")
+	proto.RegisterType((*ProjectMachineImageRestriction)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectMachineImageRestriction")
 	proto.RegisterType((*ProjectMember)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectMember")
+	proto.RegisterType((*ProjectMemberAccessReview)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectMemberAccessReview")
+	proto.RegisterType((*ProjectMemberRoleExpiration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectMemberRoleExpiration")
+	proto.RegisterType((*ProjectQuota)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectQuota")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectQuota.LimitsEntry")
+	proto.RegisterType((*ProjectRestrictions)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectRestrictions")
 	proto.RegisterType((*ProjectSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectSpec")
 	proto.RegisterType((*ProjectStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectStatus")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectStatus.UsageEntry")
 	proto.RegisterType((*ProjectTolerations)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectTolerations")
 	proto.RegisterType((*Provider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Provider")
 	proto.RegisterType((*Quota)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Quota")
@@ -4919,6 +5385,7 @@ func init() {
 	proto.RegisterType((*SeedBackup)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedBackup")
 	proto.RegisterType((*SeedDNS)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedDNS")
 	proto.RegisterType((*SeedDNSProvider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedDNSProvider")
+	proto.RegisterType((*SeedDrainStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedDrainStatus")
 	proto.RegisterType((*SeedList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedList")
 	proto.RegisterType((*SeedNetworks)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedNetworks")
 	proto.RegisterType((*SeedProvider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedProvider")
@@ -4935,6 +5402,7 @@ func init() {
 	proto.RegisterType((*SeedSettingLoadBalancerServicesZones)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingLoadBalancerServicesZones")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingLoadBalancerServicesZones.AnnotationsEntry")
 	proto.RegisterType((*SeedSettingScheduling)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingScheduling")
+	proto.RegisterType((*SeedSettingSchedulingDrain)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingSchedulingDrain")
 	proto.RegisterType((*SeedSettingTopologyAwareRouting)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingTopologyAwareRouting")
 	proto.RegisterType((*SeedSettingVerticalPodAutoscaler)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingVerticalPodAutoscaler")
 	proto.RegisterType((*SeedSettings)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettings")
@@ -4950,6 +5418,7 @@ func init() {
 	proto.RegisterType((*ServiceAccountKeyRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ServiceAccountKeyRotation")
 	proto.RegisterType((*Shoot)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Shoot")
 	proto.RegisterType((*ShootAdvertisedAddress)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootAdvertisedAddress")
+	proto.RegisterType((*ShootAvailability)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootAvailability")
 	proto.RegisterType((*ShootCredentials)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootCredentials")
 	proto.RegisterType((*ShootCredentialsRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootCredentialsRotation")
 	proto.RegisterType((*ShootKubeconfigRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootKubeconfigRotation")
@@ -4957,6 +5426,7 @@ func init() {
 	proto.RegisterType((*ShootMachineImage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootMachineImage")
 	proto.RegisterType((*ShootNetworks)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootNetworks")
 	proto.RegisterType((*ShootSSHKeypairRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootSSHKeypairRotation")
+	proto.RegisterType((*ShootSource)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootSource")
 	proto.RegisterType((*ShootSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootSpec")
 	proto.RegisterType((*ShootState)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootState")
 	proto.RegisterType((*ShootStateList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootStateList")
@@ -4983,760 +5453,842 @@ func init() {
 }
 
 var fileDescriptor_ca37af0df9a5bbd2 = []byte{
-	// 12035 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x6c, 0x24, 0xc9,
-	0x75, 0x98, 0x7a, 0xf8, 0xfd, 0xf8, 0xb1, 0xdc, 0xda, 0x8f, 0xe3, 0x72, 0xef, 0x76, 0x56, 0x7d,
-	0x27, 0xe5, 0xce, 0x27, 0x73, 0x7d, 0x67, 0xc9, 0xa7, 0x5b, 0xf9, 0x74, 0x22, 0x67, 0xc8, 0xdd,
-	0xf1, 0x92, 0x5c, 0x5e, 0x0d, 0x79, 0x77, 0x3e, 0x3b, 0x67, 0x37, 0xbb, 0x8b, 0xc3, 0x3e, 0xf6,
-	0x74, 0xcf, 0x75, 0xf7, 0x70, 0xc9, 0x3b, 0x3b, 0xb6, 0x14, 0xcb, 0xb1, 0xce, 0x56, 0xe0, 0x18,
-	0x70, 0x04, 0xc9, 0x0e, 0x2c, 0xc3, 0x70, 0xbe, 0x1c, 0x38, 0x86, 0x03, 0x07, 0xb0, 0x8d, 0x00,
-	0x86, 0x01, 0xc7, 0xb2, 0x61, 0x1b, 0x86, 0x94, 0x20, 0x12, 0x12, 0xd3, 0x11, 0xa3, 0xc8, 0x01,
-	0x12, 0x18, 0x01, 0x8c, 0x20, 0xc8, 0xc6, 0x70, 0x82, 0xfa, 0xe8, 0xea, 0xea, 0xaf, 0x21, 0xd9,
-	0x43, 0x52, 0x3a, 0xd8, 0xbf, 0xc8, 0xa9, 0x57, 0xf5, 0x5e, 0x55, 0x75, 0xd5, 0xab, 0x57, 0xaf,
-	0xde, 0x07, 0x2c, 0xb4, 0xec, 0x70, 0xbb, 0xbb, 0x39, 0x67, 0x7a, 0xed, 0x5b, 0x2d, 0xc3, 0xb7,
-	0x88, 0x4b, 0xfc, 0xf8, 0x9f, 0xce, 0x4e, 0xeb, 0x96, 0xd1, 0xb1, 0x83, 0x5b, 0xa6, 0xe7, 0x93,
-	0x5b, 0xbb, 0xcf, 0x6c, 0x92, 0xd0, 0x78, 0xe6, 0x56, 0x8b, 0xc2, 0x8c, 0x90, 0x58, 0x73, 0x1d,
-	0xdf, 0x0b, 0x3d, 0xf4, 0x6c, 0x8c, 0x63, 0x2e, 0x6a, 0x1a, 0xff, 0xd3, 0xd9, 0x69, 0xcd, 0x51,
-	0x1c, 0x73, 0x14, 0xc7, 0x9c, 0xc0, 0x31, 0xfb, 0xad, 0x2a, 0x5d, 0xaf, 0xe5, 0xdd, 0x62, 0xa8,
-	0x36, 0xbb, 0x5b, 0xec, 0x17, 0xfb, 0xc1, 0xfe, 0xe3, 0x24, 0x66, 0x9f, 0xda, 0xf9, 0x70, 0x30,
-	0x67, 0x7b, 0xb4, 0x33, 0xb7, 0x8c, 0x6e, 0xe8, 0x05, 0xa6, 0xe1, 0xd8, 0x6e, 0xeb, 0xd6, 0x6e,
-	0xa6, 0x37, 0xb3, 0xba, 0x52, 0x55, 0x74, 0xbb, 0x67, 0x1d, 0x7f, 0xd3, 0x30, 0xf3, 0xea, 0x7c,
-	0x30, 0xae, 0xd3, 0x36, 0xcc, 0x6d, 0xdb, 0x25, 0xfe, 0x7e, 0x34, 0x21, 0xb7, 0x7c, 0x12, 0x78,
-	0x5d, 0xdf, 0x24, 0x27, 0x6a, 0x15, 0xdc, 0x6a, 0x93, 0xd0, 0xc8, 0xa3, 0x75, 0xab, 0xa8, 0x95,
-	0xdf, 0x75, 0x43, 0xbb, 0x9d, 0x25, 0xf3, 0x1d, 0x47, 0x35, 0x08, 0xcc, 0x6d, 0xd2, 0x36, 0x32,
-	0xed, 0xbe, 0xbd, 0xa8, 0x5d, 0x37, 0xb4, 0x9d, 0x5b, 0xb6, 0x1b, 0x06, 0xa1, 0x9f, 0x6e, 0xa4,
-	0xbf, 0xa3, 0xc1, 0xf4, 0xfc, 0x5a, 0xa3, 0x49, 0xfc, 0x5d, 0xe2, 0x2f, 0x7b, 0xad, 0x96, 0xed,
-	0xb6, 0xd0, 0xd3, 0x30, 0xb6, 0x4b, 0xfc, 0x4d, 0x2f, 0xb0, 0xc3, 0xfd, 0x19, 0xed, 0xa6, 0xf6,
-	0xe4, 0xd0, 0xc2, 0xe4, 0xe1, 0x41, 0x75, 0xec, 0xe5, 0xa8, 0x10, 0xc7, 0x70, 0xd4, 0x80, 0x4b,
-	0xdb, 0x61, 0xd8, 0x99, 0x37, 0x4d, 0x12, 0x04, 0xb2, 0xc6, 0x4c, 0x85, 0x35, 0x7b, 0xe4, 0xf0,
-	0xa0, 0x7a, 0xe9, 0xee, 0xfa, 0xfa, 0x5a, 0x0a, 0x8c, 0xf3, 0xda, 0xe8, 0xbf, 0xaa, 0xc1, 0x45,
-	0xd9, 0x19, 0x4c, 0xde, 0xec, 0x92, 0x20, 0x0c, 0x10, 0x86, 0xab, 0x6d, 0x63, 0x6f, 0xd5, 0x73,
-	0x57, 0xba, 0xa1, 0x11, 0xda, 0x6e, 0xab, 0xe1, 0x6e, 0x39, 0x76, 0x6b, 0x3b, 0x14, 0x5d, 0x9b,
-	0x3d, 0x3c, 0xa8, 0x5e, 0x5d, 0xc9, 0xad, 0x81, 0x0b, 0x5a, 0xd2, 0x4e, 0xb7, 0x8d, 0xbd, 0x0c,
-	0x42, 0xa5, 0xd3, 0x2b, 0x59, 0x30, 0xce, 0x6b, 0xa3, 0x3f, 0x0b, 0x43, 0xf3, 0x96, 0xe5, 0xb9,
-	0xe8, 0x29, 0x18, 0x21, 0xae, 0xb1, 0xe9, 0x10, 0x8b, 0x75, 0x6c, 0x74, 0xe1, 0xc2, 0x17, 0x0e,
-	0xaa, 0xef, 0x39, 0x3c, 0xa8, 0x8e, 0x2c, 0xf2, 0x62, 0x1c, 0xc1, 0xf5, 0x9f, 0xae, 0xc0, 0x30,
-	0x6b, 0x14, 0xa0, 0x9f, 0xd2, 0xe0, 0xd2, 0x4e, 0x77, 0x93, 0xf8, 0x2e, 0x09, 0x49, 0x50, 0x37,
-	0x82, 0xed, 0x4d, 0xcf, 0xf0, 0x39, 0x8a, 0xf1, 0x67, 0xef, 0xcc, 0x9d, 0x7c, 0xff, 0xcd, 0xdd,
-	0xcb, 0xa2, 0xe3, 0x63, 0xca, 0x01, 0xe0, 0x3c, 0xe2, 0x68, 0x17, 0x26, 0xdc, 0x96, 0xed, 0xee,
-	0x35, 0xdc, 0x96, 0x4f, 0x82, 0x80, 0xcd, 0xcb, 0xf8, 0xb3, 0x1f, 0x2b, 0xd3, 0x99, 0x55, 0x05,
-	0xcf, 0xc2, 0xf4, 0xe1, 0x41, 0x75, 0x42, 0x2d, 0xc1, 0x09, 0x3a, 0xfa, 0x5f, 0x69, 0x70, 0x61,
-	0xde, 0x6a, 0xdb, 0x41, 0x60, 0x7b, 0xee, 0x9a, 0xd3, 0x6d, 0xd9, 0x2e, 0xba, 0x09, 0x83, 0xae,
-	0xd1, 0x26, 0x6c, 0x42, 0xc6, 0x16, 0x26, 0xc4, 0x9c, 0x0e, 0xae, 0x1a, 0x6d, 0x82, 0x19, 0x04,
-	0xbd, 0x04, 0xc3, 0xa6, 0xe7, 0x6e, 0xd9, 0x2d, 0xd1, 0xcf, 0x6f, 0x9d, 0xe3, 0x3b, 0x61, 0x4e,
-	0xdd, 0x09, 0xac, 0x7b, 0x62, 0x07, 0xcd, 0x61, 0xe3, 0xc1, 0xe2, 0x5e, 0x48, 0x5c, 0x4a, 0x66,
-	0x01, 0x0e, 0x0f, 0xaa, 0xc3, 0x35, 0x86, 0x00, 0x0b, 0x44, 0xe8, 0x49, 0x18, 0xb5, 0xec, 0x80,
-	0x7f, 0xcc, 0x01, 0xf6, 0x31, 0x27, 0x0e, 0x0f, 0xaa, 0xa3, 0x75, 0x51, 0x86, 0x25, 0x14, 0x2d,
-	0xc3, 0x65, 0x3a, 0x83, 0xbc, 0x5d, 0x93, 0x98, 0x3e, 0x09, 0x69, 0xd7, 0x66, 0x06, 0x59, 0x77,
-	0x67, 0x0e, 0x0f, 0xaa, 0x97, 0xef, 0xe5, 0xc0, 0x71, 0x6e, 0x2b, 0x7d, 0x09, 0x46, 0xe7, 0x1d,
-	0xe2, 0xd3, 0x05, 0x86, 0x6e, 0xc3, 0x14, 0x69, 0x1b, 0xb6, 0x83, 0x89, 0x49, 0xec, 0x5d, 0xe2,
-	0x07, 0x33, 0xda, 0xcd, 0x81, 0x27, 0xc7, 0x16, 0xd0, 0xe1, 0x41, 0x75, 0x6a, 0x31, 0x01, 0xc1,
-	0xa9, 0x9a, 0xfa, 0xc7, 0x35, 0x18, 0x9f, 0xef, 0x5a, 0x76, 0xc8, 0xc7, 0x85, 0x7c, 0x18, 0x37,
-	0xe8, 0xcf, 0x35, 0xcf, 0xb1, 0xcd, 0x7d, 0xb1, 0xb8, 0x5e, 0x2c, 0xf3, 0x3d, 0xe7, 0x63, 0x34,
-	0x0b, 0x17, 0x0e, 0x0f, 0xaa, 0xe3, 0x4a, 0x01, 0x56, 0x89, 0xe8, 0xdb, 0xa0, 0xc2, 0xd0, 0x77,
-	0xc3, 0x04, 0x1f, 0xee, 0x8a, 0xd1, 0xc1, 0x64, 0x4b, 0xf4, 0xe1, 0x71, 0xe5, 0x5b, 0x45, 0x84,
-	0xe6, 0xee, 0x6f, 0xbe, 0x41, 0xcc, 0x10, 0x93, 0x2d, 0xe2, 0x13, 0xd7, 0x24, 0x7c, 0xd9, 0xd4,
-	0x94, 0xc6, 0x38, 0x81, 0x4a, 0xff, 0x53, 0xca, 0xc4, 0x76, 0x0d, 0xdb, 0x31, 0x36, 0x6d, 0xc7,
-	0x0e, 0xf7, 0x5f, 0xf3, 0x5c, 0x72, 0x8c, 0x75, 0xb3, 0x01, 0x8f, 0x74, 0x5d, 0x83, 0xb7, 0x73,
-	0xc8, 0x0a, 0x5f, 0x29, 0xeb, 0xfb, 0x1d, 0x42, 0x17, 0x3c, 0x9d, 0xe9, 0xeb, 0x87, 0x07, 0xd5,
-	0x47, 0x36, 0xf2, 0xab, 0xe0, 0xa2, 0xb6, 0x94, 0x5f, 0x29, 0xa0, 0x97, 0x3d, 0xa7, 0xdb, 0x16,
-	0x58, 0x07, 0x18, 0x56, 0xc6, 0xaf, 0x36, 0x72, 0x6b, 0xe0, 0x82, 0x96, 0xfa, 0x17, 0x2a, 0x30,
-	0xb1, 0x60, 0x98, 0x3b, 0xdd, 0xce, 0x42, 0xd7, 0xdc, 0x21, 0x21, 0xfa, 0x7e, 0x18, 0xa5, 0x07,
-	0x8e, 0x65, 0x84, 0x86, 0x98, 0xc9, 0x6f, 0x2b, 0x5c, 0xf5, 0xec, 0x23, 0xd2, 0xda, 0xf1, 0xdc,
-	0xae, 0x90, 0xd0, 0x58, 0x40, 0x62, 0x4e, 0x20, 0x2e, 0xc3, 0x12, 0x2b, 0xda, 0x82, 0xc1, 0xa0,
-	0x43, 0x4c, 0xb1, 0xa7, 0xea, 0x65, 0xd6, 0x8a, 0xda, 0xe3, 0x66, 0x87, 0x98, 0xf1, 0x57, 0xa0,
-	0xbf, 0x30, 0xc3, 0x8f, 0x5c, 0x18, 0x0e, 0x42, 0x23, 0xec, 0x06, 0x6c, 0xa3, 0x8d, 0x3f, 0xbb,
-	0xd4, 0x37, 0x25, 0x86, 0x6d, 0x61, 0x4a, 0xd0, 0x1a, 0xe6, 0xbf, 0xb1, 0xa0, 0xa2, 0xff, 0x07,
-	0x0d, 0xa6, 0xd5, 0xea, 0xcb, 0x76, 0x10, 0xa2, 0xef, 0xcd, 0x4c, 0xe7, 0xdc, 0xf1, 0xa6, 0x93,
-	0xb6, 0x66, 0x93, 0x39, 0x2d, 0xc8, 0x8d, 0x46, 0x25, 0xca, 0x54, 0x12, 0x18, 0xb2, 0x43, 0xd2,
-	0xe6, 0xcb, 0xaa, 0x24, 0x1f, 0x55, 0xbb, 0xbc, 0x30, 0x29, 0x88, 0x0d, 0x35, 0x28, 0x5a, 0xcc,
-	0xb1, 0xeb, 0xdf, 0x0f, 0x97, 0xd5, 0x5a, 0x6b, 0xbe, 0xb7, 0x6b, 0x5b, 0xc4, 0xa7, 0x3b, 0x21,
-	0xdc, 0xef, 0x64, 0x76, 0x02, 0x5d, 0x59, 0x98, 0x41, 0xd0, 0xfb, 0x61, 0xd8, 0x27, 0x2d, 0xdb,
-	0x73, 0xd9, 0xd7, 0x1e, 0x8b, 0xe7, 0x0e, 0xb3, 0x52, 0x2c, 0xa0, 0xfa, 0xff, 0xaa, 0x24, 0xe7,
-	0x8e, 0x7e, 0x46, 0xb4, 0x0b, 0xa3, 0x1d, 0x41, 0x4a, 0xcc, 0xdd, 0xdd, 0x7e, 0x07, 0x18, 0x75,
-	0x3d, 0x9e, 0xd5, 0xa8, 0x04, 0x4b, 0x5a, 0xc8, 0x86, 0xa9, 0xe8, 0xff, 0x5a, 0x1f, 0xec, 0x9f,
-	0xb1, 0xd3, 0xb5, 0x04, 0x22, 0x9c, 0x42, 0x8c, 0xd6, 0x61, 0x2c, 0x60, 0x4c, 0x9a, 0x32, 0xae,
-	0x81, 0x62, 0xc6, 0xd5, 0x8c, 0x2a, 0x09, 0xc6, 0x75, 0x51, 0x74, 0x7f, 0x4c, 0x02, 0x70, 0x8c,
-	0x88, 0x1e, 0x32, 0x01, 0x21, 0x96, 0x72, 0x5c, 0xb0, 0x43, 0xa6, 0x29, 0xca, 0xb0, 0x84, 0xea,
-	0x9f, 0x1f, 0x04, 0x94, 0x5d, 0xe2, 0xea, 0x0c, 0xf0, 0x12, 0x31, 0xff, 0xfd, 0xcc, 0x80, 0xd8,
-	0x2d, 0x29, 0xc4, 0xe8, 0x2d, 0x98, 0x74, 0x8c, 0x20, 0xbc, 0xdf, 0xa1, 0xd2, 0x63, 0xb4, 0x50,
-	0xc6, 0x9f, 0x9d, 0x2f, 0xf3, 0xa5, 0x97, 0x55, 0x44, 0x0b, 0x17, 0x0f, 0x0f, 0xaa, 0x93, 0x89,
-	0x22, 0x9c, 0x24, 0x85, 0xde, 0x80, 0x31, 0x5a, 0xb0, 0xe8, 0xfb, 0x9e, 0x2f, 0x66, 0xff, 0x85,
-	0xb2, 0x74, 0x19, 0x12, 0x2e, 0xcd, 0xca, 0x9f, 0x38, 0x46, 0x8f, 0xbe, 0x0b, 0x90, 0xb7, 0x19,
-	0x50, 0x01, 0xd4, 0xba, 0xc3, 0x45, 0x65, 0x3a, 0x58, 0xfa, 0x75, 0x06, 0x16, 0x66, 0xc5, 0xd7,
-	0x44, 0xf7, 0x33, 0x35, 0x70, 0x4e, 0x2b, 0xb4, 0x03, 0x48, 0x8a, 0xdb, 0x72, 0x01, 0xcc, 0x0c,
-	0x1d, 0x7f, 0xf9, 0x5c, 0xa5, 0xc4, 0xee, 0x64, 0x50, 0xe0, 0x1c, 0xb4, 0xfa, 0xef, 0x54, 0x60,
-	0x9c, 0x2f, 0x91, 0x45, 0x37, 0xf4, 0xf7, 0xcf, 0xe1, 0x80, 0x20, 0x89, 0x03, 0xa2, 0x56, 0x7e,
-	0xcf, 0xb3, 0x0e, 0x17, 0x9e, 0x0f, 0xed, 0xd4, 0xf9, 0xb0, 0xd8, 0x2f, 0xa1, 0xde, 0xc7, 0xc3,
-	0xbf, 0xd7, 0xe0, 0x82, 0x52, 0xfb, 0x1c, 0x4e, 0x07, 0x2b, 0x79, 0x3a, 0xbc, 0xd8, 0xe7, 0xf8,
-	0x0a, 0x0e, 0x07, 0x2f, 0x31, 0x2c, 0xc6, 0xb8, 0x9f, 0x05, 0xd8, 0x64, 0xec, 0x64, 0x35, 0x96,
-	0x93, 0xe4, 0x27, 0x5f, 0x90, 0x10, 0xac, 0xd4, 0x4a, 0xf0, 0xac, 0x4a, 0x4f, 0x9e, 0xf5, 0x5f,
-	0x07, 0xe0, 0x62, 0x66, 0xda, 0xb3, 0x7c, 0x44, 0xfb, 0x06, 0xf1, 0x91, 0xca, 0x37, 0x82, 0x8f,
-	0x0c, 0x94, 0xe2, 0x23, 0xc7, 0x3e, 0x27, 0x90, 0x0f, 0xa8, 0x6d, 0xb7, 0x78, 0xb3, 0x66, 0x68,
-	0xf8, 0xe1, 0xba, 0xdd, 0x26, 0x82, 0xe3, 0x7c, 0xcb, 0xf1, 0x96, 0x2c, 0x6d, 0xc1, 0x19, 0xcf,
-	0x4a, 0x06, 0x13, 0xce, 0xc1, 0xae, 0x7f, 0x71, 0x10, 0xa0, 0x36, 0x8f, 0xbd, 0x90, 0x77, 0xf6,
-	0x45, 0x18, 0xea, 0x6c, 0x1b, 0x41, 0xb4, 0x9e, 0x9e, 0x8a, 0x16, 0xe3, 0x1a, 0x2d, 0x7c, 0x78,
-	0x50, 0x9d, 0xa9, 0xf9, 0xc4, 0x22, 0x6e, 0x68, 0x1b, 0x4e, 0x10, 0x35, 0x62, 0x30, 0xcc, 0xdb,
-	0xd1, 0x31, 0xd0, 0x69, 0xac, 0x79, 0xed, 0x8e, 0x43, 0x28, 0x94, 0x8d, 0xa1, 0x52, 0x6e, 0x0c,
-	0xcb, 0x19, 0x4c, 0x38, 0x07, 0x7b, 0x44, 0xb3, 0xe1, 0xda, 0xa1, 0x6d, 0x48, 0x9a, 0x03, 0xe5,
-	0x69, 0x26, 0x31, 0xe1, 0x1c, 0xec, 0xe8, 0x1d, 0x0d, 0x66, 0x93, 0xc5, 0x4b, 0xb6, 0x6b, 0x07,
-	0xdb, 0xc4, 0x62, 0xc4, 0x07, 0x4f, 0x4c, 0xfc, 0xc6, 0xe1, 0x41, 0x75, 0x76, 0xb9, 0x10, 0x23,
-	0xee, 0x41, 0x0d, 0x7d, 0x5a, 0x83, 0xeb, 0xa9, 0x79, 0xf1, 0xed, 0x56, 0x8b, 0xf8, 0xa2, 0x37,
-	0x27, 0x5f, 0x42, 0xd5, 0xc3, 0x83, 0xea, 0xf5, 0xe5, 0x62, 0x94, 0xb8, 0x17, 0x3d, 0xfd, 0xb7,
-	0x35, 0x18, 0xa8, 0xe1, 0x06, 0x7a, 0x3a, 0x71, 0x89, 0x7b, 0x44, 0xbd, 0xc4, 0x3d, 0x3c, 0xa8,
-	0x8e, 0xd4, 0x70, 0x43, 0xb9, 0xcf, 0x7d, 0x5a, 0x83, 0x8b, 0xa6, 0xe7, 0x86, 0x06, 0xed, 0x17,
-	0xe6, 0x92, 0x4e, 0xc4, 0x55, 0x4b, 0xdd, 0x5f, 0x6a, 0x29, 0x64, 0x0b, 0xd7, 0x44, 0x07, 0x2e,
-	0xa6, 0x21, 0x01, 0xce, 0x52, 0xd6, 0xbf, 0xac, 0xc1, 0x44, 0xcd, 0xf1, 0xba, 0xd6, 0x9a, 0xef,
-	0x6d, 0xd9, 0x0e, 0x79, 0x77, 0x5c, 0xda, 0xd4, 0x1e, 0x17, 0x1d, 0xca, 0xec, 0x12, 0xa5, 0x56,
-	0x7c, 0x97, 0x5c, 0xa2, 0xd4, 0x2e, 0x17, 0x9c, 0x93, 0x3f, 0x3d, 0x92, 0x1c, 0x19, 0x3b, 0x29,
-	0x9f, 0x84, 0x51, 0xd3, 0x58, 0xe8, 0xba, 0x96, 0x23, 0x6f, 0x51, 0xb4, 0x97, 0xb5, 0x79, 0x5e,
-	0x86, 0x25, 0x14, 0xbd, 0x05, 0x10, 0x2b, 0xd4, 0xc4, 0x67, 0x58, 0xea, 0x4f, 0x89, 0xd7, 0x24,
-	0x61, 0x68, 0xbb, 0xad, 0x20, 0xfe, 0xf4, 0x31, 0x0c, 0x2b, 0xd4, 0xd0, 0x0f, 0xc2, 0xa4, 0x98,
-	0xe4, 0x46, 0xdb, 0x68, 0x09, 0x7d, 0x43, 0xc9, 0x99, 0x5a, 0x51, 0x10, 0x2d, 0x5c, 0x11, 0x84,
-	0x27, 0xd5, 0xd2, 0x00, 0x27, 0xa9, 0xa1, 0x7d, 0x98, 0x68, 0xab, 0x3a, 0x94, 0xc1, 0xf2, 0xe2,
-	0x8c, 0xa2, 0x4f, 0x59, 0xb8, 0x2c, 0x88, 0x4f, 0x24, 0xb4, 0x2f, 0x09, 0x52, 0x39, 0x57, 0xc1,
-	0xa1, 0xb3, 0xba, 0x0a, 0x12, 0x18, 0xe1, 0x97, 0xe1, 0x60, 0x66, 0x98, 0x0d, 0xf0, 0x76, 0x99,
-	0x01, 0xf2, 0x7b, 0x75, 0xac, 0x21, 0xe6, 0xbf, 0x03, 0x1c, 0xe1, 0x46, 0xbb, 0x30, 0x41, 0x4f,
-	0xf5, 0x26, 0x71, 0x88, 0x19, 0x7a, 0xfe, 0xcc, 0x48, 0x79, 0x0d, 0x6c, 0x53, 0xc1, 0xc3, 0x55,
-	0x69, 0x6a, 0x09, 0x4e, 0xd0, 0x91, 0xba, 0x82, 0xd1, 0x42, 0x5d, 0x41, 0x17, 0xc6, 0x77, 0x15,
-	0x9d, 0xd6, 0x18, 0x9b, 0x84, 0x8f, 0x96, 0xe9, 0x58, 0xac, 0xe0, 0x5a, 0xb8, 0x24, 0x08, 0x8d,
-	0xab, 0xca, 0x30, 0x95, 0x8e, 0xfe, 0xcb, 0xe3, 0x70, 0xb1, 0xe6, 0x74, 0x83, 0x90, 0xf8, 0xf3,
-	0xe2, 0x91, 0x88, 0xf8, 0xe8, 0x13, 0x1a, 0x5c, 0x65, 0xff, 0xd6, 0xbd, 0x07, 0x6e, 0x9d, 0x38,
-	0xc6, 0xfe, 0xfc, 0x16, 0xad, 0x61, 0x59, 0x27, 0xe3, 0x40, 0xf5, 0xae, 0x90, 0x22, 0x99, 0x72,
-	0xae, 0x99, 0x8b, 0x11, 0x17, 0x50, 0x42, 0x3f, 0xae, 0xc1, 0xb5, 0x1c, 0x50, 0x9d, 0x38, 0x24,
-	0x8c, 0x24, 0x97, 0x93, 0xf6, 0xe3, 0xb1, 0xc3, 0x83, 0xea, 0xb5, 0x66, 0x11, 0x52, 0x5c, 0x4c,
-	0x0f, 0xfd, 0x7d, 0x0d, 0x66, 0x73, 0xa0, 0x4b, 0x86, 0xed, 0x74, 0xfd, 0x48, 0xa8, 0x39, 0x69,
-	0x77, 0x98, 0x6c, 0xd1, 0x2c, 0xc4, 0x8a, 0x7b, 0x50, 0x44, 0x3f, 0x04, 0x57, 0x24, 0x74, 0xc3,
-	0x75, 0x09, 0xb1, 0x12, 0x22, 0xce, 0x49, 0xbb, 0x72, 0xed, 0xf0, 0xa0, 0x7a, 0xa5, 0x99, 0x87,
-	0x10, 0xe7, 0xd3, 0x41, 0x2d, 0x78, 0x2c, 0x06, 0x84, 0xb6, 0x63, 0xbf, 0xc5, 0xa5, 0xb0, 0x6d,
-	0x9f, 0x04, 0xdb, 0x9e, 0x63, 0x31, 0x66, 0xa1, 0x2d, 0xbc, 0xf7, 0xf0, 0xa0, 0xfa, 0x58, 0xb3,
-	0x57, 0x45, 0xdc, 0x1b, 0x0f, 0xb2, 0x60, 0x22, 0x30, 0x0d, 0xb7, 0xe1, 0x86, 0xc4, 0xdf, 0x35,
-	0x9c, 0x99, 0xe1, 0x52, 0x03, 0xe4, 0x5b, 0x54, 0xc1, 0x83, 0x13, 0x58, 0xd1, 0x87, 0x61, 0x94,
-	0xec, 0x75, 0x0c, 0xd7, 0x22, 0x9c, 0x2d, 0x8c, 0x2d, 0x3c, 0x4a, 0x0f, 0xa3, 0x45, 0x51, 0xf6,
-	0xf0, 0xa0, 0x3a, 0x11, 0xfd, 0xbf, 0xe2, 0x59, 0x04, 0xcb, 0xda, 0xe8, 0x07, 0xe0, 0x32, 0x7b,
-	0x0f, 0xb3, 0x08, 0x63, 0x72, 0x41, 0x24, 0xe8, 0x8e, 0x96, 0xea, 0x27, 0x7b, 0xdb, 0x58, 0xc9,
-	0xc1, 0x87, 0x73, 0xa9, 0xd0, 0xcf, 0xd0, 0x36, 0xf6, 0xee, 0xf8, 0x86, 0x49, 0xb6, 0xba, 0xce,
-	0x3a, 0xf1, 0xdb, 0xb6, 0xcb, 0xef, 0x12, 0xc4, 0xf4, 0x5c, 0x8b, 0xb2, 0x12, 0xed, 0xc9, 0x21,
-	0xfe, 0x19, 0x56, 0x7a, 0x55, 0xc4, 0xbd, 0xf1, 0xa0, 0x0f, 0xc2, 0x84, 0xdd, 0x72, 0x3d, 0x9f,
-	0xac, 0x1b, 0xb6, 0x1b, 0x06, 0x33, 0xc0, 0xd4, 0xee, 0x6c, 0x5a, 0x1b, 0x4a, 0x39, 0x4e, 0xd4,
-	0x42, 0xbb, 0x80, 0x5c, 0xf2, 0x60, 0xcd, 0xb3, 0xd8, 0x12, 0xd8, 0xe8, 0xb0, 0x85, 0x3c, 0x33,
-	0x5e, 0x6a, 0x6a, 0xd8, 0x3d, 0x60, 0x35, 0x83, 0x0d, 0xe7, 0x50, 0x40, 0x4b, 0x80, 0xda, 0xc6,
-	0xde, 0x62, 0xbb, 0x13, 0xee, 0x2f, 0x74, 0x9d, 0x1d, 0xc1, 0x35, 0x26, 0xd8, 0x5c, 0xf0, 0x7b,
-	0x58, 0x06, 0x8a, 0x73, 0x5a, 0x20, 0x03, 0xae, 0xf3, 0xf1, 0xd4, 0x0d, 0xd2, 0xf6, 0xdc, 0x80,
-	0x84, 0x81, 0xb2, 0x48, 0x67, 0x26, 0xd9, 0x2b, 0x16, 0x93, 0xca, 0x1b, 0xc5, 0xd5, 0x70, 0x2f,
-	0x1c, 0xc9, 0x77, 0xe1, 0xa9, 0xde, 0xef, 0xc2, 0xfa, 0xc1, 0x00, 0x8c, 0xd5, 0x3c, 0xd7, 0xb2,
-	0x59, 0xd3, 0x67, 0x12, 0x3a, 0xe8, 0xc7, 0xd4, 0x73, 0xe5, 0xe1, 0x41, 0x75, 0x52, 0x56, 0x54,
-	0x0e, 0x9a, 0xe7, 0xa5, 0xe2, 0x87, 0x2b, 0x1a, 0xde, 0x9b, 0xd4, 0xd8, 0x3c, 0x3c, 0xa8, 0x5e,
-	0x90, 0xcd, 0x92, 0x4a, 0x1c, 0xfa, 0x2d, 0xe9, 0xed, 0x62, 0xdd, 0x37, 0xdc, 0xc0, 0xee, 0xe3,
-	0x3e, 0x27, 0x6f, 0xea, 0xcb, 0x19, 0x6c, 0x38, 0x87, 0x02, 0x7a, 0x03, 0xa6, 0x68, 0xe9, 0x46,
-	0xc7, 0x32, 0x42, 0x52, 0xf2, 0x1a, 0x77, 0x55, 0xd0, 0x9c, 0x5a, 0x4e, 0x60, 0xc2, 0x29, 0xcc,
-	0x5c, 0x67, 0x6f, 0x04, 0x9e, 0xcb, 0xd8, 0x57, 0x42, 0x67, 0x4f, 0x4b, 0xb1, 0x80, 0xa2, 0xa7,
-	0x60, 0xa4, 0x4d, 0x82, 0xc0, 0x68, 0x11, 0xc6, 0x8f, 0xc6, 0x62, 0xa1, 0x63, 0x85, 0x17, 0xe3,
-	0x08, 0x8e, 0x3e, 0x00, 0x43, 0xa6, 0x67, 0x91, 0x60, 0x66, 0x84, 0xed, 0x18, 0xba, 0xfa, 0x86,
-	0x6a, 0xb4, 0xe0, 0xe1, 0x41, 0x75, 0x8c, 0xe9, 0x35, 0xe8, 0x2f, 0xcc, 0x2b, 0xe9, 0x3f, 0x47,
-	0xef, 0x00, 0xa9, 0x4b, 0xcf, 0x31, 0xde, 0x1a, 0xce, 0x4f, 0x6d, 0xaf, 0x7f, 0x86, 0x5e, 0xc0,
-	0x3c, 0x37, 0xf4, 0x3d, 0x67, 0xcd, 0x31, 0x5c, 0x82, 0x7e, 0x54, 0x83, 0xe9, 0x6d, 0xbb, 0xb5,
-	0xad, 0x3e, 0x16, 0x0a, 0x41, 0xa1, 0xd4, 0x5d, 0xe9, 0x6e, 0x0a, 0xd7, 0xc2, 0xe5, 0xc3, 0x83,
-	0xea, 0x74, 0xba, 0x14, 0x67, 0x68, 0xea, 0x9f, 0xaa, 0xc0, 0x65, 0xd1, 0x33, 0x87, 0x9e, 0xdc,
-	0x1d, 0xc7, 0xdb, 0x6f, 0x13, 0xf7, 0x3c, 0xde, 0xf5, 0xa2, 0x2f, 0x54, 0x29, 0xfc, 0x42, 0xed,
-	0xcc, 0x17, 0x1a, 0x28, 0xf3, 0x85, 0xe4, 0x42, 0x3e, 0xe2, 0x2b, 0xfd, 0x99, 0x06, 0x33, 0x79,
-	0x73, 0x71, 0x0e, 0x77, 0xca, 0x76, 0xf2, 0x4e, 0x79, 0xb7, 0xac, 0x92, 0x20, 0xdd, 0xf5, 0x82,
-	0xbb, 0xe5, 0xd7, 0x2b, 0x70, 0x35, 0xae, 0xde, 0x70, 0x83, 0xd0, 0x70, 0x1c, 0xce, 0x5a, 0xcf,
-	0xfe, 0xbb, 0x77, 0x12, 0xaa, 0x81, 0xd5, 0xfe, 0x86, 0xaa, 0xf6, 0xbd, 0x50, 0x73, 0xbf, 0x97,
-	0xd2, 0xdc, 0xaf, 0x9d, 0x22, 0xcd, 0xde, 0x4a, 0xfc, 0xff, 0xae, 0xc1, 0x6c, 0x7e, 0xc3, 0x73,
-	0x58, 0x54, 0x5e, 0x72, 0x51, 0x7d, 0xd7, 0xe9, 0x8d, 0xba, 0x60, 0x59, 0xfd, 0x6a, 0xa5, 0x68,
-	0xb4, 0x4c, 0x79, 0xb1, 0x05, 0x17, 0xe8, 0xad, 0x32, 0x08, 0x85, 0x8a, 0xf9, 0x64, 0xb6, 0x17,
-	0x91, 0xce, 0xed, 0x02, 0x4e, 0xe2, 0xc0, 0x69, 0xa4, 0x68, 0x15, 0x46, 0xe8, 0x55, 0x92, 0xe2,
-	0xaf, 0x1c, 0x1f, 0xbf, 0x3c, 0x8d, 0x9a, 0xbc, 0x2d, 0x8e, 0x90, 0xa0, 0xef, 0x85, 0x49, 0x4b,
-	0xee, 0xa8, 0x23, 0x1e, 0x5e, 0xd3, 0x58, 0xd9, 0x63, 0x40, 0x5d, 0x6d, 0x8d, 0x93, 0xc8, 0xf4,
-	0xbf, 0xd4, 0xe0, 0xd1, 0x5e, 0x6b, 0x0b, 0xbd, 0x09, 0x60, 0x46, 0xe2, 0x05, 0x37, 0xbd, 0x29,
-	0xf9, 0x5c, 0x20, 0x85, 0x94, 0x78, 0x83, 0xca, 0xa2, 0x00, 0x2b, 0x44, 0x72, 0xde, 0x73, 0x2b,
-	0x67, 0xf4, 0x9e, 0xab, 0xff, 0x0f, 0x4d, 0x65, 0x45, 0xea, 0xb7, 0x7d, 0xb7, 0xb1, 0x22, 0xb5,
-	0xef, 0x85, 0xfa, 0xca, 0x2f, 0x55, 0xe0, 0x66, 0x7e, 0x13, 0xe5, 0xec, 0xfd, 0x18, 0x0c, 0x77,
-	0xb8, 0x7d, 0xd4, 0x00, 0x3b, 0x1b, 0x9f, 0xa4, 0x9c, 0x85, 0x5b, 0x2f, 0x3d, 0x3c, 0xa8, 0xce,
-	0xe6, 0x31, 0x7a, 0x61, 0xf7, 0x24, 0xda, 0x21, 0x3b, 0xa5, 0xb5, 0xe1, 0xd2, 0xdf, 0xb7, 0x1f,
-	0x93, 0xb9, 0x18, 0x9b, 0xc4, 0x39, 0xb6, 0xa2, 0xe6, 0xe3, 0x1a, 0x4c, 0x25, 0x56, 0x74, 0x30,
-	0x33, 0xc4, 0xd6, 0x68, 0xa9, 0xa7, 0xb4, 0xc4, 0x56, 0x89, 0x4f, 0xee, 0x44, 0x71, 0x80, 0x53,
-	0x04, 0x53, 0x6c, 0x56, 0x9d, 0xd5, 0x77, 0x1d, 0x9b, 0x55, 0x3b, 0x5f, 0xc0, 0x66, 0x7f, 0xb6,
-	0x52, 0x34, 0x5a, 0xc6, 0x66, 0x1f, 0xc0, 0x58, 0x64, 0x39, 0x1c, 0xb1, 0x8b, 0xa5, 0x7e, 0xfb,
-	0xc4, 0xd1, 0xc5, 0x66, 0x24, 0x51, 0x49, 0x80, 0x63, 0x5a, 0xe8, 0x47, 0x34, 0x80, 0xf8, 0xc3,
-	0x88, 0x4d, 0xb5, 0x7e, 0x7a, 0xd3, 0xa1, 0x88, 0x35, 0x53, 0x74, 0x4b, 0x2b, 0x8b, 0x42, 0xa1,
-	0xab, 0xff, 0x9f, 0x01, 0x40, 0xd9, 0xbe, 0x53, 0x71, 0x73, 0xc7, 0x76, 0xad, 0xf4, 0x85, 0xe0,
-	0x9e, 0xed, 0x5a, 0x98, 0x41, 0x8e, 0x21, 0x90, 0xbe, 0x00, 0x17, 0x5a, 0x8e, 0xb7, 0x69, 0x38,
-	0xce, 0xbe, 0x30, 0xa5, 0x15, 0x46, 0x99, 0x97, 0xe8, 0xc1, 0x74, 0x27, 0x09, 0xc2, 0xe9, 0xba,
-	0xa8, 0x03, 0xd3, 0x3e, 0x31, 0x3d, 0xd7, 0xb4, 0x1d, 0x76, 0x75, 0xf2, 0xba, 0x61, 0x49, 0xdd,
-	0x13, 0x13, 0xef, 0x71, 0x0a, 0x17, 0xce, 0x60, 0x47, 0xef, 0x83, 0x91, 0x8e, 0x6f, 0xb7, 0x0d,
-	0x7f, 0x9f, 0x5d, 0xce, 0x46, 0x17, 0xc6, 0xe9, 0x09, 0xb7, 0xc6, 0x8b, 0x70, 0x04, 0x43, 0x3f,
-	0x00, 0x63, 0x8e, 0xbd, 0x45, 0xcc, 0x7d, 0xd3, 0x21, 0x42, 0x59, 0x74, 0xff, 0x74, 0x96, 0xcc,
-	0x72, 0x84, 0x56, 0x3c, 0x51, 0x47, 0x3f, 0x71, 0x4c, 0x10, 0x35, 0xe0, 0xd2, 0x03, 0xcf, 0xdf,
-	0x21, 0xbe, 0x43, 0x82, 0xa0, 0xd9, 0xed, 0x74, 0x3c, 0x3f, 0x24, 0x16, 0x53, 0x29, 0x8d, 0x72,
-	0x7b, 0xe1, 0x57, 0xb2, 0x60, 0x9c, 0xd7, 0x46, 0x7f, 0xa7, 0x02, 0xd7, 0x7b, 0x74, 0x02, 0x61,
-	0xba, 0x37, 0xc4, 0x1c, 0x89, 0x95, 0xf0, 0x41, 0xbe, 0x9e, 0x45, 0xe1, 0xc3, 0x83, 0xea, 0xe3,
-	0x3d, 0x10, 0x34, 0xe9, 0x52, 0x24, 0xad, 0x7d, 0x1c, 0xa3, 0x41, 0x0d, 0x18, 0xb6, 0x62, 0x0d,
-	0xeb, 0xd8, 0xc2, 0x33, 0x94, 0x5b, 0x73, 0x5d, 0xc8, 0x71, 0xb1, 0x09, 0x04, 0x68, 0x19, 0x46,
-	0xf8, 0xc3, 0x36, 0x11, 0x9c, 0xff, 0x59, 0x76, 0x3d, 0xe6, 0x45, 0xc7, 0x45, 0x16, 0xa1, 0xd0,
-	0xff, 0xb7, 0x06, 0x23, 0x35, 0xcf, 0x27, 0xf5, 0xd5, 0x26, 0xda, 0x87, 0x71, 0xc5, 0xa5, 0x41,
-	0x70, 0xc1, 0x92, 0x6c, 0x81, 0x61, 0x9c, 0x8f, 0xb1, 0x45, 0xe6, 0xb7, 0xb2, 0x00, 0xab, 0xb4,
-	0xd0, 0x9b, 0x74, 0xce, 0x1f, 0xf8, 0x76, 0x48, 0x09, 0xf7, 0xf3, 0x1e, 0xc8, 0x09, 0xe3, 0x08,
-	0x17, 0x5f, 0x51, 0xf2, 0x27, 0x8e, 0xa9, 0xe8, 0x6b, 0x94, 0x03, 0xa4, 0xbb, 0x89, 0x6e, 0xc3,
-	0x60, 0xdb, 0xb3, 0xa2, 0xef, 0xfe, 0xfe, 0x68, 0x7f, 0xaf, 0x78, 0x16, 0x9d, 0xdb, 0xab, 0xd9,
-	0x16, 0x4c, 0x6b, 0xc9, 0xda, 0xe8, 0xab, 0x30, 0x9d, 0xa6, 0x8f, 0x6e, 0xc3, 0x94, 0xe9, 0xb5,
-	0xdb, 0x9e, 0xdb, 0xec, 0x6e, 0x6d, 0xd9, 0x7b, 0x24, 0x61, 0x17, 0x5d, 0x4b, 0x40, 0x70, 0xaa,
-	0xa6, 0xfe, 0x33, 0x1a, 0x0c, 0xd0, 0xef, 0xa2, 0xc3, 0xb0, 0xe5, 0xb5, 0x0d, 0xdb, 0x15, 0xbd,
-	0x62, 0x36, 0xe0, 0x75, 0x56, 0x82, 0x05, 0x04, 0x75, 0x60, 0x2c, 0x12, 0x9a, 0xfa, 0xb2, 0xcd,
-	0xa9, 0xaf, 0x36, 0xa5, 0x3d, 0xa3, 0xe4, 0xe4, 0x51, 0x49, 0x80, 0x63, 0x22, 0xba, 0x01, 0x17,
-	0xeb, 0xab, 0xcd, 0x86, 0x6b, 0x3a, 0x5d, 0x8b, 0x2c, 0xee, 0xb1, 0x3f, 0x94, 0x97, 0xd8, 0xbc,
-	0x44, 0x8c, 0x93, 0xf1, 0x12, 0x51, 0x09, 0x47, 0x30, 0x5a, 0x8d, 0xf0, 0x16, 0xc2, 0x78, 0x99,
-	0x55, 0x13, 0x48, 0x70, 0x04, 0xd3, 0xbf, 0x5c, 0x81, 0x71, 0xa5, 0x43, 0xc8, 0x81, 0x11, 0x3e,
-	0xdc, 0xc8, 0x76, 0x70, 0xb1, 0xe4, 0x10, 0x93, 0xbd, 0xe6, 0xd4, 0xf9, 0x84, 0x06, 0x38, 0x22,
-	0xa1, 0xf2, 0xc5, 0x4a, 0x0f, 0xbe, 0x38, 0x07, 0x10, 0xc4, 0x96, 0xf4, 0x7c, 0x4b, 0xb2, 0xa3,
-	0x47, 0xb1, 0x9f, 0x57, 0x6a, 0xa0, 0x47, 0xc5, 0x09, 0xc2, 0x8d, 0x63, 0x46, 0x53, 0xa7, 0xc7,
-	0x16, 0x0c, 0xbd, 0xe5, 0xb9, 0x24, 0x10, 0x6f, 0x82, 0xa7, 0x34, 0xc0, 0x31, 0x2a, 0x1f, 0xbc,
-	0x46, 0xf1, 0x62, 0x8e, 0x5e, 0xff, 0x79, 0x0d, 0xa0, 0x6e, 0x84, 0x06, 0x7f, 0xc2, 0x3a, 0x86,
-	0xfd, 0xf9, 0xa3, 0x89, 0x83, 0x6f, 0x34, 0x63, 0x93, 0x3b, 0x18, 0xd8, 0x6f, 0x45, 0xc3, 0x97,
-	0x02, 0x35, 0xc7, 0xde, 0xb4, 0xdf, 0x22, 0x98, 0xc1, 0xd1, 0xd3, 0x30, 0x46, 0x5c, 0xd3, 0xdf,
-	0xef, 0x50, 0xe6, 0x3d, 0xc8, 0x66, 0x95, 0xed, 0xd0, 0xc5, 0xa8, 0x10, 0xc7, 0x70, 0xfd, 0x19,
-	0x48, 0xde, 0x8a, 0x8e, 0xee, 0xa5, 0xfe, 0xd5, 0x41, 0xb8, 0xb6, 0xb8, 0x5e, 0xab, 0x0b, 0x7c,
-	0xb6, 0xe7, 0xde, 0x23, 0xfb, 0x7f, 0x63, 0xee, 0xf3, 0x37, 0xe6, 0x3e, 0xa7, 0x68, 0xee, 0xf3,
-	0x22, 0x4c, 0xc7, 0xcb, 0x4b, 0x3c, 0xb4, 0x3f, 0x9d, 0x96, 0xa7, 0xc7, 0xa2, 0x93, 0x27, 0x2b,
-	0x03, 0xeb, 0x0f, 0x35, 0x98, 0x5e, 0xdc, 0xeb, 0xd8, 0x3e, 0x73, 0x9c, 0x20, 0x3e, 0xbd, 0x07,
-	0xa3, 0xa7, 0x60, 0x64, 0x97, 0xff, 0x2b, 0x56, 0xa7, 0xd4, 0x35, 0x88, 0x1a, 0x38, 0x82, 0xa3,
-	0x2d, 0x98, 0x22, 0xac, 0x39, 0x13, 0x78, 0x8d, 0xb0, 0xcc, 0x0a, 0xe4, 0x7e, 0x39, 0x09, 0x2c,
-	0x38, 0x85, 0x15, 0x35, 0x61, 0xca, 0x74, 0x8c, 0x20, 0xb0, 0xb7, 0x6c, 0x33, 0x36, 0x09, 0x1c,
-	0x5b, 0x78, 0x9a, 0x9d, 0x5d, 0x09, 0xc8, 0xc3, 0x83, 0xea, 0x15, 0xd1, 0xcf, 0x24, 0x00, 0xa7,
-	0x50, 0xe8, 0x9f, 0xad, 0xc0, 0xe4, 0xe2, 0x5e, 0xc7, 0x0b, 0xba, 0x3e, 0x61, 0x55, 0xcf, 0xe1,
-	0x0a, 0xff, 0x14, 0x8c, 0x6c, 0x1b, 0xae, 0xe5, 0x10, 0x5f, 0xb0, 0x2f, 0x39, 0xb7, 0x77, 0x79,
-	0x31, 0x8e, 0xe0, 0xe8, 0x6d, 0x80, 0xc0, 0xdc, 0x26, 0x56, 0x97, 0x89, 0x40, 0x7c, 0x97, 0xdd,
-	0x2b, 0xc3, 0x84, 0x13, 0x63, 0x6c, 0x4a, 0x94, 0xe2, 0x68, 0x90, 0xbf, 0xb1, 0x42, 0x4e, 0xff,
-	0x8a, 0x06, 0x17, 0x13, 0xed, 0xce, 0xe1, 0x66, 0xba, 0x95, 0xbc, 0x99, 0xce, 0xf7, 0x3d, 0xd6,
-	0x82, 0x0b, 0xe9, 0x8f, 0x55, 0xe0, 0x91, 0x82, 0x39, 0xc9, 0xd8, 0x8f, 0x68, 0xe7, 0x64, 0x3f,
-	0xd2, 0x85, 0xf1, 0xd0, 0x73, 0x84, 0xe5, 0x6a, 0x34, 0x03, 0xa5, 0xac, 0x43, 0xd6, 0x25, 0x9a,
-	0xd8, 0x3a, 0x24, 0x2e, 0x0b, 0xb0, 0x4a, 0x47, 0xff, 0x6d, 0x0d, 0xc6, 0xa4, 0x02, 0xec, 0x9b,
-	0xea, 0x11, 0xea, 0xf8, 0xae, 0x84, 0xfa, 0x1f, 0x54, 0xe0, 0xaa, 0xc4, 0x1d, 0xb1, 0xb9, 0x66,
-	0x48, 0xf9, 0xc6, 0xd1, 0xb7, 0xe8, 0x47, 0xc5, 0x41, 0xae, 0x08, 0x13, 0x8a, 0xa8, 0x41, 0x05,
-	0xaf, 0xae, 0xdf, 0xf1, 0x82, 0x48, 0x9e, 0xe0, 0x82, 0x17, 0x2f, 0xc2, 0x11, 0x0c, 0xad, 0xc2,
-	0x50, 0x40, 0xe9, 0x89, 0xe3, 0xe8, 0x84, 0xb3, 0xc1, 0x44, 0x22, 0xd6, 0x5f, 0xcc, 0xd1, 0xa0,
-	0xb7, 0x55, 0x1e, 0x3e, 0x54, 0x5e, 0x4f, 0x43, 0x47, 0x62, 0x45, 0x33, 0x92, 0xe3, 0x5e, 0x93,
-	0x7b, 0x26, 0x2c, 0xc3, 0xb4, 0x30, 0x41, 0xe1, 0xcb, 0xc6, 0x35, 0x09, 0xfa, 0x70, 0x62, 0x65,
-	0x3c, 0x91, 0x7a, 0x86, 0xbe, 0x9c, 0xae, 0x1f, 0xaf, 0x18, 0x3d, 0x80, 0xd1, 0x3b, 0xa2, 0x93,
-	0x68, 0x16, 0x2a, 0x76, 0xf4, 0x2d, 0x40, 0xe0, 0xa8, 0x34, 0xea, 0xb8, 0x62, 0x5b, 0x52, 0xa0,
-	0xaa, 0x14, 0x8a, 0x7d, 0xca, 0xb1, 0x34, 0xd0, 0xfb, 0x58, 0xd2, 0xbf, 0x56, 0x81, 0xcb, 0x11,
-	0xd5, 0x68, 0x8c, 0x75, 0xf1, 0x88, 0x77, 0x84, 0x70, 0x79, 0xb4, 0x56, 0xe5, 0x3e, 0x0c, 0x32,
-	0x06, 0x58, 0xea, 0x71, 0x4f, 0x22, 0xa4, 0xdd, 0xc1, 0x0c, 0x11, 0xfa, 0x01, 0x18, 0x76, 0x8c,
-	0x4d, 0xe2, 0x44, 0xa6, 0x7f, 0xa5, 0x74, 0x50, 0x79, 0xc3, 0xe5, 0xaa, 0xd1, 0x80, 0xbb, 0x37,
-	0xc8, 0x37, 0x1f, 0x5e, 0x88, 0x05, 0xcd, 0xd9, 0xe7, 0x61, 0x5c, 0xa9, 0x86, 0xa6, 0x61, 0x60,
-	0x87, 0xf0, 0xc7, 0xdd, 0x31, 0x4c, 0xff, 0x45, 0x97, 0x61, 0x68, 0xd7, 0x70, 0xba, 0x62, 0x4a,
-	0x30, 0xff, 0x71, 0xbb, 0xf2, 0x61, 0x4d, 0xff, 0x65, 0x0d, 0xc6, 0xef, 0xda, 0x9b, 0xc4, 0xe7,
-	0x76, 0x24, 0xec, 0x2e, 0x95, 0xf0, 0xe4, 0x1e, 0xcf, 0xf3, 0xe2, 0x46, 0x7b, 0x30, 0x26, 0x4e,
-	0x1a, 0x69, 0x66, 0x7c, 0xa7, 0xdc, 0x2b, 0xb2, 0x24, 0x2d, 0x38, 0xb8, 0xea, 0x39, 0x16, 0x51,
-	0xc0, 0x31, 0x31, 0xfd, 0x6d, 0xb8, 0x94, 0xd3, 0x08, 0x55, 0xd9, 0xf6, 0xf5, 0x43, 0xb1, 0x2c,
-	0xa2, 0xfd, 0xe8, 0x87, 0x98, 0x97, 0xa3, 0x6b, 0x30, 0x40, 0x5c, 0x4b, 0xac, 0x89, 0x91, 0xc3,
-	0x83, 0xea, 0xc0, 0xa2, 0x6b, 0x61, 0x5a, 0x46, 0xd9, 0x94, 0xe3, 0x25, 0x64, 0x12, 0xc6, 0xa6,
-	0x96, 0x45, 0x19, 0x96, 0x50, 0xf6, 0xee, 0x9f, 0x7e, 0xe2, 0xa6, 0xe2, 0xed, 0xf4, 0x56, 0x6a,
-	0xf7, 0xf4, 0xf3, 0xb2, 0x9e, 0xde, 0x89, 0x0b, 0x33, 0x62, 0x42, 0x32, 0x7b, 0x1a, 0x67, 0xe8,
-	0xea, 0xbf, 0x31, 0x08, 0x8f, 0xdd, 0xf5, 0x7c, 0xfb, 0x2d, 0xcf, 0x0d, 0x0d, 0x67, 0xcd, 0xb3,
-	0x62, 0x8b, 0x41, 0xc1, 0x94, 0x3f, 0xa9, 0xc1, 0x23, 0x66, 0xa7, 0xcb, 0xc5, 0xe3, 0xc8, 0xbe,
-	0x65, 0x8d, 0xf8, 0xb6, 0x57, 0xd6, 0x70, 0x90, 0xf9, 0x0a, 0xd7, 0xd6, 0x36, 0xf2, 0x50, 0xe2,
-	0x22, 0x5a, 0xcc, 0x7e, 0xd1, 0xf2, 0x1e, 0xb8, 0xac, 0x73, 0xcd, 0x90, 0xcd, 0xe6, 0x5b, 0xf1,
-	0x47, 0x28, 0x69, 0xbf, 0x58, 0xcf, 0xc5, 0x88, 0x0b, 0x28, 0xa1, 0x1f, 0x82, 0x2b, 0x36, 0xef,
-	0x1c, 0x26, 0x86, 0x65, 0xbb, 0x24, 0x08, 0xb8, 0xf1, 0x53, 0x1f, 0x06, 0x7a, 0x8d, 0x3c, 0x84,
-	0x38, 0x9f, 0x0e, 0x7a, 0x1d, 0x20, 0xd8, 0x77, 0x4d, 0x31, 0xff, 0x43, 0xa5, 0xa8, 0x72, 0x21,
-	0x50, 0x62, 0xc1, 0x0a, 0x46, 0x7a, 0x95, 0x08, 0xe5, 0xa2, 0x1c, 0x66, 0xc6, 0x7e, 0xec, 0x2a,
-	0x11, 0xaf, 0xa1, 0x18, 0xae, 0xff, 0x0b, 0x0d, 0x46, 0x44, 0x3c, 0x02, 0xf4, 0xfe, 0x94, 0x9a,
-	0x48, 0xf2, 0x9e, 0x94, 0xaa, 0x68, 0x9f, 0xbd, 0x15, 0x0a, 0x15, 0xa1, 0x10, 0x25, 0x4a, 0xe9,
-	0x19, 0x04, 0xe1, 0x58, 0xdf, 0x98, 0x78, 0x33, 0x8c, 0x74, 0x90, 0x0a, 0x31, 0xfd, 0xf3, 0x1a,
-	0x5c, 0xcc, 0xb4, 0x3a, 0x86, 0xbc, 0x70, 0x8e, 0x66, 0x38, 0x5f, 0x1a, 0x84, 0x29, 0x66, 0xbd,
-	0xe8, 0x1a, 0x0e, 0xd7, 0xe0, 0x9c, 0xc3, 0x05, 0xe5, 0x69, 0x18, 0xb3, 0xdb, 0xed, 0x6e, 0x48,
-	0x59, 0xb5, 0x50, 0xc2, 0xb3, 0x6f, 0xde, 0x88, 0x0a, 0x71, 0x0c, 0x47, 0xae, 0x38, 0x0a, 0x39,
-	0x13, 0x5f, 0x2e, 0xf7, 0xe5, 0xd4, 0x01, 0xce, 0xd1, 0x63, 0x8b, 0x9f, 0x57, 0x79, 0x27, 0xe5,
-	0x8f, 0x6a, 0x00, 0x41, 0xe8, 0xdb, 0x6e, 0x8b, 0x16, 0x8a, 0xe3, 0x12, 0x9f, 0x02, 0xd9, 0xa6,
-	0x44, 0xca, 0x89, 0xcb, 0x39, 0x8a, 0x01, 0x58, 0xa1, 0x8c, 0xe6, 0x85, 0x94, 0xc0, 0x39, 0xfe,
-	0xb7, 0xa6, 0xe4, 0xa1, 0xc7, 0xb2, 0xe1, 0x76, 0x84, 0x8f, 0x6a, 0x2c, 0x46, 0xcc, 0x3e, 0x07,
-	0x63, 0x92, 0xde, 0x51, 0xa7, 0xee, 0x84, 0x72, 0xea, 0xce, 0xbe, 0x00, 0x17, 0x52, 0xdd, 0x3d,
-	0xd1, 0xa1, 0xfd, 0x1f, 0x35, 0x40, 0xc9, 0xd1, 0x9f, 0xc3, 0xd5, 0xae, 0x95, 0xbc, 0xda, 0x2d,
-	0xf4, 0xff, 0xc9, 0x0a, 0xee, 0x76, 0x5f, 0x99, 0x02, 0x16, 0xae, 0x45, 0x86, 0xc3, 0x11, 0x07,
-	0x17, 0x3d, 0x67, 0x63, 0x97, 0x0f, 0xb1, 0x73, 0xfb, 0x38, 0x67, 0xef, 0xa5, 0x70, 0xc5, 0xe7,
-	0x6c, 0x1a, 0x82, 0x33, 0x74, 0xd1, 0xa7, 0x34, 0x98, 0x36, 0x92, 0xe1, 0x5a, 0xa2, 0x99, 0x29,
-	0xe5, 0x0e, 0x9c, 0x0a, 0xfd, 0x12, 0xf7, 0x25, 0x05, 0x08, 0x70, 0x86, 0x2c, 0xfa, 0x20, 0x4c,
-	0x18, 0x1d, 0x7b, 0xbe, 0x6b, 0xd9, 0xf4, 0x6a, 0x10, 0xc5, 0xda, 0x60, 0xd7, 0xd5, 0xf9, 0xb5,
-	0x86, 0x2c, 0xc7, 0x89, 0x5a, 0x32, 0x2e, 0x8a, 0x98, 0xc8, 0xc1, 0x3e, 0xe3, 0xa2, 0x88, 0x39,
-	0x8c, 0xe3, 0xa2, 0x88, 0xa9, 0x53, 0x89, 0x20, 0x17, 0xc0, 0xb3, 0x2d, 0x53, 0x90, 0xe4, 0xcf,
-	0x7e, 0xa5, 0x6e, 0xc8, 0xf7, 0x1b, 0xf5, 0x9a, 0xa0, 0xc8, 0x4e, 0xbf, 0xf8, 0x37, 0x56, 0x28,
-	0xa0, 0xcf, 0x68, 0x30, 0x29, 0x78, 0xb7, 0xa0, 0x39, 0xc2, 0x3e, 0xd1, 0x6b, 0x65, 0xd7, 0x4b,
-	0x6a, 0x4d, 0xce, 0x61, 0x15, 0x39, 0xe7, 0x3b, 0xd2, 0x63, 0x28, 0x01, 0xc3, 0xc9, 0x7e, 0xa0,
-	0x7f, 0xa8, 0xc1, 0xe5, 0x80, 0xf8, 0xbb, 0xb6, 0x49, 0xe6, 0x4d, 0xd3, 0xeb, 0xba, 0xd1, 0x77,
-	0x18, 0x2d, 0x1f, 0x46, 0xa2, 0x99, 0x83, 0x8f, 0x9b, 0xaa, 0xe7, 0x41, 0x70, 0x2e, 0x7d, 0x2a,
-	0x96, 0x5d, 0x78, 0x60, 0x84, 0xe6, 0x76, 0xcd, 0x30, 0xb7, 0x99, 0xb2, 0x9d, 0x5b, 0xa7, 0x97,
-	0x5c, 0xd7, 0xaf, 0x24, 0x51, 0xf1, 0x67, 0xeb, 0x54, 0x21, 0x4e, 0x13, 0x44, 0x1e, 0x8c, 0xfa,
-	0x22, 0x06, 0xd6, 0x0c, 0x94, 0x17, 0x29, 0x32, 0x01, 0xb5, 0xb8, 0x60, 0x1f, 0xfd, 0xc2, 0x92,
-	0x08, 0x6a, 0xc1, 0x63, 0xfc, 0x6a, 0x33, 0xef, 0x7a, 0xee, 0x7e, 0xdb, 0xeb, 0x06, 0xf3, 0xdd,
-	0x70, 0x9b, 0xb8, 0x61, 0xa4, 0xab, 0x1c, 0x67, 0xc7, 0x28, 0x33, 0xd0, 0x5f, 0xec, 0x55, 0x11,
-	0xf7, 0xc6, 0x83, 0x5e, 0x85, 0x51, 0xb2, 0x4b, 0xdc, 0x70, 0x7d, 0x7d, 0x99, 0x19, 0xba, 0x9f,
-	0x5c, 0xda, 0x63, 0x43, 0x58, 0x14, 0x38, 0xb0, 0xc4, 0x86, 0x76, 0x60, 0xc4, 0xe1, 0x41, 0xcc,
-	0x98, 0xc1, 0x7b, 0x49, 0xa6, 0x98, 0x0e, 0x88, 0xc6, 0xef, 0x7f, 0xe2, 0x07, 0x8e, 0x28, 0xa0,
-	0x0e, 0xdc, 0xb4, 0xc8, 0x96, 0xd1, 0x75, 0xc2, 0x55, 0x2f, 0xa4, 0x22, 0xed, 0x7e, 0xac, 0x9f,
-	0x8a, 0x7c, 0x1a, 0xa6, 0x98, 0xc7, 0xf7, 0x13, 0x87, 0x07, 0xd5, 0x9b, 0xf5, 0x23, 0xea, 0xe2,
-	0x23, 0xb1, 0xa1, 0x7d, 0x78, 0x5c, 0xd4, 0xd9, 0x70, 0x7d, 0x62, 0x98, 0xdb, 0x74, 0x96, 0xb3,
-	0x44, 0x2f, 0x30, 0xa2, 0x7f, 0xeb, 0xf0, 0xa0, 0xfa, 0x78, 0xfd, 0xe8, 0xea, 0xf8, 0x38, 0x38,
-	0x99, 0xe9, 0x34, 0x49, 0xe9, 0xe8, 0x67, 0xa6, 0xcb, 0xcf, 0x71, 0x5a, 0xdf, 0xcf, 0x6d, 0x2b,
-	0xd2, 0xa5, 0x38, 0x43, 0x73, 0xf6, 0x63, 0x80, 0xb2, 0x0c, 0xe7, 0x28, 0xc9, 0x61, 0x54, 0x95,
-	0x1c, 0x3e, 0x37, 0x04, 0xd7, 0x29, 0x1f, 0x8b, 0xe5, 0xe5, 0x15, 0xc3, 0x35, 0x5a, 0xdf, 0x9c,
-	0x67, 0xec, 0x2f, 0x6b, 0xf0, 0xc8, 0x76, 0xfe, 0x5d, 0x56, 0x48, 0xec, 0x2f, 0x95, 0xd2, 0x39,
-	0xf4, 0xba, 0x1e, 0xf3, 0x2d, 0xde, 0xb3, 0x0a, 0x2e, 0xea, 0x14, 0xfa, 0x18, 0x4c, 0xbb, 0x9e,
-	0x45, 0x6a, 0x8d, 0x3a, 0x5e, 0x31, 0x82, 0x9d, 0x66, 0xf4, 0x86, 0x39, 0xc4, 0xbf, 0xf0, 0x6a,
-	0x0a, 0x86, 0x33, 0xb5, 0xd1, 0x2e, 0xa0, 0x8e, 0x67, 0x2d, 0xee, 0xda, 0x66, 0xf4, 0x7a, 0x56,
-	0xde, 0x62, 0x87, 0x3d, 0xd1, 0xad, 0x65, 0xb0, 0xe1, 0x1c, 0x0a, 0xec, 0x32, 0x4e, 0x3b, 0xb3,
-	0xe2, 0xb9, 0x76, 0xe8, 0xf9, 0xcc, 0xc3, 0xa8, 0xaf, 0x3b, 0x29, 0xbb, 0x8c, 0xaf, 0xe6, 0x62,
-	0xc4, 0x05, 0x94, 0xf4, 0xff, 0xa9, 0xc1, 0x05, 0xba, 0x2c, 0xd6, 0x7c, 0x6f, 0x6f, 0xff, 0x9b,
-	0x71, 0x41, 0x3e, 0x25, 0xcc, 0x39, 0xb8, 0x12, 0xe9, 0x8a, 0x62, 0xca, 0x31, 0xc6, 0xfa, 0x1c,
-	0x5b, 0x6f, 0xa8, 0x7a, 0xb4, 0x81, 0x62, 0x3d, 0x9a, 0xfe, 0x99, 0x0a, 0x97, 0x75, 0x23, 0x3d,
-	0xd6, 0x37, 0xe5, 0x3e, 0x7c, 0x0e, 0x26, 0x69, 0xd9, 0x8a, 0xb1, 0xb7, 0x56, 0x7f, 0xd9, 0x73,
-	0x22, 0xa7, 0x24, 0x66, 0x68, 0x7c, 0x4f, 0x05, 0xe0, 0x64, 0x3d, 0x74, 0x1b, 0x46, 0x3a, 0xdc,
-	0x95, 0x5c, 0xdc, 0xb2, 0x6e, 0x72, 0x9b, 0x07, 0x56, 0xf4, 0xf0, 0xa0, 0x7a, 0x31, 0x7e, 0xb5,
-	0x11, 0x85, 0x38, 0x6a, 0xa0, 0x7f, 0xfa, 0x0a, 0x30, 0xe4, 0x0e, 0x09, 0xbf, 0x19, 0xe7, 0xe4,
-	0x19, 0x18, 0x37, 0x3b, 0xdd, 0xda, 0x52, 0xf3, 0xa5, 0xae, 0xc7, 0x6e, 0xcf, 0x2c, 0xea, 0x25,
-	0x15, 0x7e, 0x6b, 0x6b, 0x1b, 0x51, 0x31, 0x56, 0xeb, 0x50, 0xee, 0x60, 0x76, 0xba, 0x82, 0xdf,
-	0xae, 0xa9, 0xd6, 0xb6, 0x8c, 0x3b, 0xd4, 0xd6, 0x36, 0x12, 0x30, 0x9c, 0xa9, 0x8d, 0x7e, 0x08,
-	0x26, 0x88, 0xd8, 0xb8, 0x77, 0x0d, 0xdf, 0x12, 0x7c, 0xa1, 0x51, 0x76, 0xf0, 0x72, 0x6a, 0x23,
-	0x6e, 0xc0, 0xef, 0x0c, 0x8b, 0x0a, 0x09, 0x9c, 0x20, 0x88, 0xbe, 0x07, 0xae, 0x45, 0xbf, 0xe9,
-	0x57, 0xf6, 0xac, 0x34, 0xa3, 0x18, 0xe2, 0xde, 0xbb, 0x8b, 0x45, 0x95, 0x70, 0x71, 0x7b, 0xf4,
-	0x4b, 0x1a, 0x5c, 0x95, 0x50, 0xdb, 0xb5, 0xdb, 0xdd, 0x36, 0x26, 0xa6, 0x63, 0xd8, 0x6d, 0x71,
-	0x53, 0x78, 0xe5, 0xd4, 0x06, 0x9a, 0x44, 0xcf, 0x99, 0x55, 0x3e, 0x0c, 0x17, 0x74, 0x09, 0x7d,
-	0x5e, 0x83, 0x9b, 0x11, 0x68, 0xcd, 0x27, 0x41, 0xd0, 0xf5, 0x49, 0xec, 0x12, 0x27, 0xa6, 0x64,
-	0xa4, 0x14, 0xef, 0x64, 0x22, 0xd3, 0xe2, 0x11, 0xb8, 0xf1, 0x91, 0xd4, 0xd5, 0xe5, 0xd2, 0xf4,
-	0xb6, 0x42, 0x71, 0xb5, 0x38, 0xab, 0xe5, 0x42, 0x49, 0xe0, 0x04, 0x41, 0xf4, 0x2f, 0x35, 0x78,
-	0x44, 0x2d, 0x50, 0x57, 0x0b, 0xbf, 0x53, 0xbc, 0x7a, 0x6a, 0x9d, 0x49, 0xe1, 0xe7, 0x4a, 0xe9,
-	0x02, 0x20, 0x2e, 0xea, 0x15, 0x65, 0xdb, 0x6d, 0xb6, 0x30, 0xf9, 0xbd, 0x63, 0x88, 0xb3, 0x6d,
-	0xbe, 0x56, 0x03, 0x1c, 0xc1, 0xe8, 0x8d, 0xbb, 0xe3, 0x59, 0x6b, 0xb6, 0x15, 0x2c, 0xdb, 0x6d,
-	0x3b, 0x64, 0xb7, 0x83, 0x01, 0x3e, 0x1d, 0x6b, 0x9e, 0xb5, 0xd6, 0xa8, 0xf3, 0x72, 0x9c, 0xa8,
-	0xc5, 0x9c, 0xe5, 0xed, 0xb6, 0xd1, 0x22, 0x6b, 0x5d, 0xc7, 0x59, 0xf3, 0x3d, 0xa6, 0xb9, 0xac,
-	0x13, 0xc3, 0x72, 0x6c, 0x97, 0x94, 0xbc, 0x0d, 0xb0, 0xed, 0xd6, 0x28, 0x42, 0x8a, 0x8b, 0xe9,
-	0xa1, 0x39, 0x80, 0x2d, 0xc3, 0x76, 0x9a, 0x0f, 0x8c, 0xce, 0xfd, 0xc8, 0x47, 0x96, 0xdd, 0xa5,
-	0x97, 0x64, 0x29, 0x56, 0x6a, 0xd0, 0xd5, 0x44, 0xb9, 0x20, 0x26, 0x3c, 0x48, 0x13, 0x13, 0xef,
-	0x4f, 0x63, 0x35, 0x45, 0x08, 0xf9, 0xf4, 0xdd, 0x53, 0x48, 0xe0, 0x04, 0x41, 0xf4, 0x49, 0x0d,
-	0xa6, 0x82, 0xfd, 0x20, 0x24, 0x6d, 0xd9, 0x87, 0x0b, 0xa7, 0xdd, 0x07, 0xa6, 0xd3, 0x6d, 0x26,
-	0x88, 0xe0, 0x14, 0x51, 0xe6, 0x6d, 0x4c, 0x67, 0xf5, 0x4e, 0xed, 0xae, 0xdd, 0xda, 0x96, 0x2e,
-	0xf0, 0x6b, 0xc4, 0x37, 0x89, 0x1b, 0xb2, 0x8b, 0xc1, 0x90, 0xf0, 0x36, 0x2e, 0xae, 0x86, 0x7b,
-	0xe1, 0x40, 0xaf, 0xc3, 0xac, 0x00, 0x2f, 0x7b, 0x0f, 0x32, 0x14, 0x2e, 0x32, 0x0a, 0xcc, 0x08,
-	0xaa, 0x51, 0x58, 0x0b, 0xf7, 0xc0, 0x80, 0x1a, 0x70, 0x29, 0x20, 0x3e, 0x7b, 0x92, 0x21, 0x72,
-	0xf1, 0x04, 0x33, 0x28, 0xb6, 0x7f, 0x6e, 0x66, 0xc1, 0x38, 0xaf, 0x0d, 0x7a, 0x41, 0xba, 0x58,
-	0xed, 0xd3, 0x82, 0x97, 0xd6, 0x9a, 0x33, 0x97, 0x58, 0xff, 0x2e, 0x29, 0x9e, 0x53, 0x11, 0x08,
-	0xa7, 0xeb, 0x52, 0xd9, 0x22, 0x2a, 0x5a, 0xe8, 0xfa, 0x41, 0x38, 0x73, 0x99, 0x35, 0x66, 0xb2,
-	0x05, 0x56, 0x01, 0x38, 0x59, 0x0f, 0xdd, 0x86, 0xa9, 0x80, 0x98, 0xa6, 0xd7, 0xee, 0x88, 0x7b,
-	0xde, 0xcc, 0x15, 0xd6, 0x7b, 0xfe, 0x05, 0x13, 0x10, 0x9c, 0xaa, 0x89, 0xf6, 0xe1, 0x92, 0x0c,
-	0x59, 0xb4, 0xec, 0xb5, 0x56, 0x8c, 0x3d, 0x26, 0xaa, 0x5f, 0x3d, 0x7a, 0x07, 0xce, 0x45, 0x6f,
-	0xec, 0x73, 0x2f, 0x75, 0x0d, 0x37, 0xb4, 0xc3, 0x7d, 0x3e, 0x5d, 0xb5, 0x2c, 0x3a, 0x9c, 0x47,
-	0x03, 0x2d, 0xc3, 0xe5, 0x54, 0xf1, 0x92, 0xed, 0x90, 0x60, 0xe6, 0x11, 0x36, 0x6c, 0xa6, 0xac,
-	0xa9, 0xe5, 0xc0, 0x71, 0x6e, 0x2b, 0x74, 0x1f, 0xae, 0x74, 0x7c, 0x2f, 0x24, 0x66, 0x78, 0x8f,
-	0x8a, 0x27, 0x8e, 0x18, 0x60, 0x30, 0x33, 0xc3, 0xe6, 0x82, 0x3d, 0x47, 0xad, 0xe5, 0x55, 0xc0,
-	0xf9, 0xed, 0xd0, 0xe7, 0x34, 0xb8, 0x11, 0x84, 0x3e, 0x31, 0xda, 0xb6, 0xdb, 0xaa, 0x79, 0xae,
-	0x4b, 0x18, 0x9b, 0x6c, 0x58, 0xb1, 0xfb, 0xc0, 0xb5, 0x52, 0x7c, 0x4a, 0x3f, 0x3c, 0xa8, 0xde,
-	0x68, 0xf6, 0xc4, 0x8c, 0x8f, 0xa0, 0x8c, 0xde, 0x06, 0x68, 0x93, 0xb6, 0xe7, 0xef, 0x53, 0x8e,
-	0x34, 0x33, 0x5b, 0xde, 0x9a, 0x6a, 0x45, 0x62, 0xe1, 0xdb, 0x3f, 0xf1, 0x90, 0x16, 0x03, 0xb1,
-	0x42, 0x4e, 0x3f, 0xa8, 0xc0, 0x95, 0xdc, 0x83, 0x87, 0xee, 0x00, 0x5e, 0x6f, 0x3e, 0x0a, 0x5f,
-	0x2c, 0xde, 0x9e, 0xd8, 0x0e, 0x58, 0x49, 0x82, 0x70, 0xba, 0x2e, 0x15, 0x0b, 0xd9, 0x4e, 0x5d,
-	0x6a, 0xc6, 0xed, 0x2b, 0xb1, 0x58, 0xd8, 0x48, 0xc1, 0x70, 0xa6, 0x36, 0xaa, 0xc1, 0x45, 0x51,
-	0xd6, 0xa0, 0x37, 0xab, 0x60, 0xc9, 0x27, 0x91, 0xc0, 0x4d, 0xef, 0x28, 0x17, 0x1b, 0x69, 0x20,
-	0xce, 0xd6, 0xa7, 0xa3, 0xa0, 0x3f, 0xd4, 0x5e, 0x0c, 0xc6, 0xa3, 0x58, 0x4d, 0x82, 0x70, 0xba,
-	0x6e, 0x74, 0xf5, 0x4d, 0x74, 0x61, 0x28, 0x1e, 0xc5, 0x6a, 0x0a, 0x86, 0x33, 0xb5, 0xf5, 0xff,
-	0x34, 0x08, 0x8f, 0x1f, 0x43, 0x58, 0x43, 0xed, 0xfc, 0xe9, 0x3e, 0xf9, 0xc6, 0x3d, 0xde, 0xe7,
-	0xe9, 0x14, 0x7c, 0x9e, 0x93, 0xd3, 0x3b, 0xee, 0xe7, 0x0c, 0x8a, 0x3e, 0xe7, 0xc9, 0x49, 0x1e,
-	0xff, 0xf3, 0xb7, 0xf3, 0x3f, 0x7f, 0xc9, 0x59, 0x3d, 0x72, 0xb9, 0x74, 0x0a, 0x96, 0x4b, 0xc9,
-	0x59, 0x3d, 0xc6, 0xf2, 0xfa, 0x93, 0x41, 0x78, 0xe2, 0x38, 0x82, 0x63, 0xc9, 0xf5, 0x95, 0xc3,
-	0xf2, 0xce, 0x74, 0x7d, 0x15, 0x79, 0x68, 0x9d, 0xe1, 0xfa, 0xca, 0x21, 0x79, 0xd6, 0xeb, 0xab,
-	0x68, 0x56, 0xcf, 0x6a, 0x7d, 0x15, 0xcd, 0xea, 0x31, 0xd6, 0xd7, 0x5f, 0xa4, 0xcf, 0x07, 0x29,
-	0x2f, 0x36, 0x60, 0xc0, 0xec, 0x74, 0x4b, 0x32, 0x29, 0x66, 0xa9, 0x54, 0x5b, 0xdb, 0xc0, 0x14,
-	0x07, 0xc2, 0x30, 0xcc, 0xd7, 0x4f, 0x49, 0x16, 0xc4, 0x7c, 0x7d, 0xf8, 0x92, 0xc4, 0x02, 0x13,
-	0x9d, 0x2a, 0xd2, 0xd9, 0x26, 0x6d, 0xe2, 0x1b, 0x4e, 0x33, 0xf4, 0x7c, 0xa3, 0x55, 0x96, 0xdb,
-	0x70, 0x35, 0x76, 0x0a, 0x17, 0xce, 0x60, 0xa7, 0x13, 0xd2, 0xb1, 0xad, 0x92, 0xfc, 0x85, 0x4d,
-	0xc8, 0x5a, 0xa3, 0x8e, 0x29, 0x0e, 0xfd, 0x1f, 0x8f, 0x81, 0x12, 0x12, 0x10, 0x7d, 0x0f, 0x5c,
-	0x33, 0x1c, 0xc7, 0x7b, 0xb0, 0xe6, 0xdb, 0xbb, 0xb6, 0x43, 0x5a, 0xc4, 0x92, 0xc2, 0x54, 0x20,
-	0xec, 0xd9, 0xd8, 0x85, 0x69, 0xbe, 0xa8, 0x12, 0x2e, 0x6e, 0x8f, 0xde, 0xd1, 0xe0, 0xa2, 0x99,
-	0x0e, 0xc3, 0xd6, 0x8f, 0xc5, 0x4b, 0x26, 0xa6, 0x1b, 0xdf, 0x4f, 0x99, 0x62, 0x9c, 0x25, 0x8b,
-	0x7e, 0x58, 0xe3, 0x4a, 0x39, 0xf9, 0x5e, 0x23, 0xbe, 0xd9, 0x9d, 0x53, 0x7a, 0xd9, 0x8c, 0xb5,
-	0x7b, 0xf1, 0x23, 0x5a, 0x92, 0x20, 0xfa, 0xbc, 0x06, 0x57, 0x76, 0xf2, 0xde, 0x12, 0xc4, 0x97,
-	0xbd, 0x5f, 0xb6, 0x2b, 0x05, 0x8f, 0x13, 0x5c, 0x9c, 0xcd, 0xad, 0x80, 0xf3, 0x3b, 0x22, 0x67,
-	0x49, 0xaa, 0x57, 0x05, 0x13, 0x28, 0x3d, 0x4b, 0x29, 0x3d, 0x6d, 0x3c, 0x4b, 0x12, 0x80, 0x93,
-	0x04, 0x51, 0x07, 0xc6, 0x76, 0x22, 0x9d, 0xb6, 0xd0, 0x63, 0xd5, 0xca, 0x52, 0x57, 0x14, 0xe3,
-	0xdc, 0xa2, 0x47, 0x16, 0xe2, 0x98, 0x08, 0xda, 0x86, 0x91, 0x1d, 0xce, 0x88, 0x84, 0xfe, 0x69,
-	0xbe, 0xef, 0xfb, 0x31, 0x57, 0x83, 0x88, 0x22, 0x1c, 0xa1, 0x57, 0xcd, 0x79, 0x47, 0x8f, 0xf0,
-	0x32, 0xf9, 0x9c, 0x06, 0x57, 0x76, 0x89, 0x1f, 0xda, 0x66, 0xfa, 0x25, 0x67, 0xac, 0xfc, 0x1d,
-	0xfe, 0xe5, 0x3c, 0x84, 0x7c, 0x99, 0xe4, 0x82, 0x70, 0x7e, 0x17, 0xe8, 0x8d, 0x9e, 0x2b, 0xe4,
-	0x9b, 0xa1, 0x11, 0xda, 0xe6, 0xba, 0xb7, 0x43, 0xdc, 0x38, 0x73, 0x0d, 0xd3, 0x04, 0x89, 0xf8,
-	0x61, 0x8b, 0xc5, 0xd5, 0x70, 0x2f, 0x1c, 0xfa, 0xd7, 0x35, 0xc8, 0xa8, 0x95, 0xd1, 0x4f, 0x6a,
-	0x30, 0xb1, 0x45, 0x8c, 0xb0, 0xeb, 0x93, 0x3b, 0x46, 0x28, 0x7d, 0xe7, 0x5f, 0x3e, 0x0d, 0x6d,
-	0xf6, 0xdc, 0x92, 0x82, 0x98, 0x5b, 0x26, 0xc8, 0x70, 0xa2, 0x2a, 0x08, 0x27, 0x7a, 0x30, 0xfb,
-	0x22, 0x5c, 0xcc, 0x34, 0x3c, 0xd1, 0x0b, 0xe3, 0xbf, 0xd1, 0x20, 0x2f, 0xd9, 0x12, 0x7a, 0x1d,
-	0x86, 0x0c, 0xcb, 0x92, 0xd9, 0x13, 0x9e, 0x2f, 0x67, 0x24, 0x63, 0xa9, 0x21, 0x0a, 0xd8, 0x4f,
-	0xcc, 0xd1, 0xa2, 0x25, 0x40, 0x46, 0xe2, 0xa9, 0x7d, 0x25, 0x76, 0xbc, 0x65, 0x2f, 0x61, 0xf3,
-	0x19, 0x28, 0xce, 0x69, 0xa1, 0xff, 0x98, 0x06, 0x28, 0x1b, 0x80, 0x16, 0xf9, 0x30, 0x2a, 0x96,
-	0x72, 0xf4, 0x95, 0xea, 0x25, 0x7d, 0x5b, 0x12, 0x8e, 0x5a, 0xb1, 0xc5, 0x95, 0x28, 0x08, 0xb0,
-	0xa4, 0xa3, 0xff, 0xa5, 0x06, 0x71, 0x84, 0x75, 0xf4, 0x21, 0x18, 0xb7, 0x48, 0x60, 0xfa, 0x76,
-	0x27, 0x8c, 0xdd, 0xba, 0xa4, 0x7b, 0x48, 0x3d, 0x06, 0x61, 0xb5, 0x1e, 0xd2, 0x61, 0x38, 0x34,
-	0x82, 0x9d, 0x46, 0x5d, 0x5c, 0x2a, 0x99, 0x08, 0xb0, 0xce, 0x4a, 0xb0, 0x80, 0xc4, 0xc1, 0xcf,
-	0x06, 0x8e, 0x11, 0xfc, 0x0c, 0x6d, 0x9d, 0x42, 0xa4, 0x37, 0x74, 0x74, 0x94, 0x37, 0xfd, 0x17,
-	0x2b, 0x70, 0x81, 0x56, 0x59, 0x31, 0x6c, 0x37, 0x24, 0x2e, 0x73, 0x62, 0x28, 0x39, 0x09, 0x2d,
-	0x98, 0x0c, 0x13, 0x5e, 0x7e, 0x27, 0x77, 0x71, 0x93, 0x66, 0x3d, 0x49, 0xdf, 0xbe, 0x24, 0x5e,
-	0xf4, 0x7c, 0xe4, 0x45, 0xc2, 0xaf, 0xdf, 0x8f, 0x47, 0x4b, 0x95, 0xb9, 0x86, 0x3c, 0x14, 0x2e,
-	0x93, 0x32, 0x2c, 0x7f, 0xc2, 0x61, 0xe4, 0x39, 0x98, 0x14, 0xd6, 0xdc, 0x3c, 0x8a, 0x9d, 0xb8,
-	0x7e, 0xb3, 0x13, 0x66, 0x49, 0x05, 0xe0, 0x64, 0x3d, 0xfd, 0x8b, 0x15, 0x48, 0x06, 0xff, 0x2f,
-	0x3b, 0x4b, 0xd9, 0x10, 0x7e, 0x95, 0x33, 0x0b, 0xe1, 0xf7, 0x01, 0x96, 0x39, 0x87, 0xa7, 0x58,
-	0xe3, 0x4f, 0xe4, 0x6a, 0xbe, 0x1b, 0x9e, 0x20, 0x4d, 0xd6, 0x88, 0xa7, 0x75, 0xf0, 0xc4, 0xd3,
-	0xfa, 0x21, 0x61, 0xe6, 0x39, 0x94, 0x08, 0xa4, 0x18, 0x99, 0x79, 0x5e, 0x4c, 0x34, 0x54, 0x7c,
-	0x5e, 0x7e, 0x4f, 0x83, 0x11, 0x11, 0x75, 0xf9, 0x18, 0x3e, 0x55, 0x5b, 0x30, 0xc4, 0xae, 0x3c,
-	0xfd, 0x48, 0x83, 0xcd, 0x6d, 0xcf, 0x0b, 0x13, 0xb1, 0xa7, 0x99, 0x13, 0x03, 0xfb, 0x17, 0x73,
-	0xf4, 0xcc, 0xd2, 0xcf, 0x37, 0xb7, 0xed, 0x90, 0x98, 0x61, 0x14, 0xd1, 0x36, 0xb2, 0xf4, 0x53,
-	0xca, 0x71, 0xa2, 0x96, 0xfe, 0x33, 0x83, 0x70, 0x53, 0x20, 0xce, 0x88, 0x48, 0x92, 0xc1, 0xed,
-	0xc3, 0x25, 0xf1, 0x6d, 0xeb, 0xbe, 0x61, 0x4b, 0xd3, 0x83, 0x72, 0x57, 0x5f, 0x91, 0x46, 0x30,
-	0x83, 0x0e, 0xe7, 0xd1, 0xe0, 0xb1, 0x59, 0x59, 0xf1, 0x5d, 0x62, 0x38, 0xe1, 0x76, 0x44, 0xbb,
-	0xd2, 0x4f, 0x6c, 0xd6, 0x2c, 0x3e, 0x9c, 0x4b, 0x85, 0x99, 0x3e, 0x08, 0x40, 0xcd, 0x27, 0x86,
-	0x6a, 0x77, 0xd1, 0x87, 0x1f, 0xc2, 0x4a, 0x2e, 0x46, 0x5c, 0x40, 0x89, 0xe9, 0x10, 0x8d, 0x3d,
-	0xa6, 0x92, 0xc0, 0x24, 0xf4, 0x6d, 0x16, 0x43, 0x5c, 0x6a, 0xd1, 0x57, 0x92, 0x20, 0x9c, 0xae,
-	0x8b, 0x6e, 0xc3, 0x14, 0x33, 0x25, 0x89, 0x83, 0x76, 0x0d, 0xc5, 0x71, 0x21, 0x56, 0x13, 0x10,
-	0x9c, 0xaa, 0xa9, 0x7f, 0xbc, 0x02, 0x13, 0xea, 0xb2, 0x3b, 0x86, 0x83, 0x55, 0x57, 0x39, 0x0c,
-	0xfb, 0x70, 0xfe, 0x51, 0xa9, 0x1e, 0xe3, 0x3c, 0x44, 0xaf, 0xc2, 0x54, 0x97, 0x71, 0x90, 0x28,
-	0xf0, 0x88, 0x58, 0xff, 0xdf, 0x46, 0x47, 0xb9, 0x91, 0x80, 0x3c, 0x3c, 0xa8, 0xce, 0xaa, 0xe8,
-	0x93, 0x50, 0x9c, 0xc2, 0xa3, 0x7f, 0x7a, 0x00, 0x2e, 0xe5, 0xf4, 0x86, 0x99, 0x1c, 0x90, 0xd4,
-	0x91, 0xdd, 0x8f, 0xc9, 0x41, 0xe6, 0xf8, 0x97, 0x26, 0x07, 0x69, 0x08, 0xce, 0xd0, 0x45, 0x2f,
-	0xc3, 0x80, 0xe9, 0xdb, 0x62, 0xc2, 0x9f, 0x2b, 0x75, 0xe1, 0xc4, 0x8d, 0x85, 0x71, 0x41, 0x71,
-	0xa0, 0x86, 0x1b, 0x98, 0x22, 0xa4, 0x07, 0x8f, 0xca, 0x2e, 0x22, 0x29, 0x80, 0x1d, 0x3c, 0x2a,
-	0x57, 0x09, 0x70, 0xb2, 0x1e, 0x7a, 0x15, 0x66, 0xc4, 0x4d, 0x20, 0x72, 0xd6, 0xf6, 0xdc, 0x20,
-	0xa4, 0x3b, 0x3b, 0x14, 0x8c, 0xfa, 0xd1, 0xc3, 0x83, 0xea, 0xcc, 0xbd, 0x82, 0x3a, 0xb8, 0xb0,
-	0xb5, 0xfe, 0xe7, 0x03, 0x30, 0xae, 0xc4, 0xbc, 0x47, 0x2b, 0xfd, 0xa8, 0x50, 0xe2, 0x11, 0x47,
-	0x6a, 0x94, 0x15, 0x18, 0x68, 0x75, 0xba, 0x25, 0x75, 0x28, 0x12, 0xdd, 0x1d, 0x8a, 0xae, 0xd5,
-	0xe9, 0xa2, 0x97, 0xa5, 0x56, 0xa6, 0x9c, 0xde, 0x44, 0xba, 0xd6, 0xa4, 0x34, 0x33, 0xd1, 0x46,
-	0x1c, 0x2c, 0xdc, 0x88, 0x6d, 0x18, 0x09, 0x84, 0xca, 0x66, 0xa8, 0x7c, 0x7c, 0x1d, 0x65, 0xa6,
-	0x85, 0x8a, 0x86, 0xdf, 0xf7, 0x22, 0x0d, 0x4e, 0x44, 0x83, 0xca, 0x92, 0x5d, 0xe6, 0xb0, 0xcb,
-	0x2e, 0xb2, 0xa3, 0x5c, 0x96, 0xdc, 0x60, 0x25, 0x58, 0x40, 0x32, 0x47, 0xd4, 0xc8, 0xb1, 0x8e,
-	0xa8, 0xbf, 0x57, 0x01, 0x94, 0xed, 0x06, 0x7a, 0x1c, 0x86, 0x98, 0xc3, 0xbf, 0xe0, 0x45, 0x52,
-	0xf2, 0x67, 0x2e, 0xdf, 0x98, 0xc3, 0x50, 0x53, 0x44, 0x0b, 0x29, 0xf7, 0x39, 0x99, 0xcd, 0x8e,
-	0xa0, 0xa7, 0x84, 0x16, 0xb9, 0x99, 0xf0, 0x0e, 0xc9, 0x3b, 0xf3, 0x37, 0x60, 0xa4, 0x6d, 0xbb,
-	0xec, 0xe1, 0xb0, 0x9c, 0x26, 0x8b, 0x9b, 0x16, 0x70, 0x14, 0x38, 0xc2, 0xa5, 0xff, 0x49, 0x85,
-	0x2e, 0xfd, 0x58, 0xe2, 0xdd, 0x07, 0x30, 0xba, 0xa1, 0xc7, 0x19, 0x98, 0xd8, 0x01, 0x8d, 0x72,
-	0x5f, 0x59, 0x22, 0x9d, 0x97, 0x08, 0xf9, 0x93, 0x57, 0xfc, 0x1b, 0x2b, 0xc4, 0x28, 0xe9, 0xd0,
-	0x6e, 0x93, 0x57, 0x6c, 0xd7, 0xf2, 0x1e, 0x88, 0xe9, 0xed, 0x97, 0xf4, 0xba, 0x44, 0xc8, 0x49,
-	0xc7, 0xbf, 0xb1, 0x42, 0x8c, 0xb2, 0x16, 0x76, 0x71, 0x76, 0x59, 0x12, 0x12, 0xd1, 0x37, 0xcf,
-	0x71, 0xa2, 0x53, 0x79, 0x94, 0xb3, 0x96, 0x5a, 0x41, 0x1d, 0x5c, 0xd8, 0x5a, 0xff, 0x25, 0x0d,
-	0xae, 0xe4, 0x4e, 0x05, 0xba, 0x03, 0x17, 0x63, 0x33, 0x2f, 0x95, 0xd9, 0x8f, 0xc6, 0xc9, 0x6f,
-	0xee, 0xa5, 0x2b, 0xe0, 0x6c, 0x1b, 0x9e, 0x61, 0x39, 0x73, 0x98, 0x08, 0x1b, 0x31, 0x55, 0x34,
-	0x52, 0xc1, 0x38, 0xaf, 0x8d, 0xfe, 0x3d, 0x89, 0xce, 0xc6, 0x93, 0x45, 0x77, 0xc6, 0x26, 0x69,
-	0x49, 0xef, 0x3c, 0xb9, 0x33, 0x16, 0x68, 0x21, 0xe6, 0x30, 0xf4, 0x98, 0xea, 0xf3, 0x2a, 0xf9,
-	0x56, 0xe4, 0xf7, 0xaa, 0x7f, 0x1f, 0x3c, 0x52, 0xf0, 0x12, 0x8a, 0xea, 0x30, 0x11, 0x3c, 0x30,
-	0x3a, 0x0b, 0x64, 0xdb, 0xd8, 0xb5, 0x45, 0x0c, 0x05, 0x6e, 0xbe, 0x37, 0xd1, 0x54, 0xca, 0x1f,
-	0xa6, 0x7e, 0xe3, 0x44, 0x2b, 0x3d, 0x04, 0x10, 0x66, 0x9e, 0xb6, 0xdb, 0x42, 0x5b, 0x30, 0x6a,
-	0x88, 0x04, 0xbf, 0x62, 0x1d, 0x7f, 0x67, 0x29, 0x25, 0x80, 0xc0, 0xc1, 0x0d, 0xe1, 0xa3, 0x5f,
-	0x58, 0xe2, 0xd6, 0xff, 0x99, 0x06, 0x57, 0xf3, 0xbd, 0xe6, 0x8f, 0x21, 0xda, 0xb4, 0x61, 0xdc,
-	0x8f, 0x9b, 0x89, 0x45, 0xff, 0x1d, 0x6a, 0xdc, 0x55, 0x25, 0xd0, 0x18, 0x15, 0xfb, 0x6a, 0xbe,
-	0x17, 0x44, 0x5f, 0x3e, 0x1d, 0x8a, 0x55, 0x5e, 0xb9, 0x94, 0x9e, 0x60, 0x15, 0xbf, 0xfe, 0x1b,
-	0x15, 0x80, 0x55, 0x12, 0x3e, 0xf0, 0xfc, 0x1d, 0x3a, 0x45, 0x8f, 0x26, 0x6e, 0x1a, 0xa3, 0xdf,
-	0xb8, 0xc8, 0x0d, 0x8f, 0xc2, 0x60, 0xc7, 0xb3, 0x02, 0xc1, 0xfe, 0x58, 0x47, 0x98, 0x05, 0x14,
-	0x2b, 0x45, 0x55, 0x18, 0x62, 0x0f, 0x1f, 0xe2, 0x64, 0x62, 0xf7, 0x14, 0x2a, 0x65, 0x06, 0x98,
-	0x97, 0xf3, 0xb4, 0x6d, 0xcc, 0xb9, 0x24, 0x10, 0x17, 0x2f, 0x91, 0xb6, 0x8d, 0x97, 0x61, 0x09,
-	0x45, 0xb7, 0x01, 0xec, 0xce, 0x92, 0xd1, 0xb6, 0x1d, 0x2a, 0xf3, 0x0e, 0xcb, 0x2c, 0xc1, 0xd0,
-	0x58, 0x8b, 0x4a, 0x1f, 0x1e, 0x54, 0x47, 0xc5, 0xaf, 0x7d, 0xac, 0xd4, 0xd6, 0xff, 0x6a, 0x00,
-	0x12, 0x19, 0xb5, 0x63, 0x1d, 0x93, 0x76, 0x36, 0x3a, 0xa6, 0x57, 0x61, 0xc6, 0xf1, 0x0c, 0x6b,
-	0xc1, 0x70, 0xe8, 0x6e, 0xf4, 0x9b, 0xfc, 0x33, 0x1a, 0x6e, 0x4b, 0xa6, 0x4d, 0x66, 0x5c, 0x69,
-	0xb9, 0xa0, 0x0e, 0x2e, 0x6c, 0x8d, 0x42, 0x99, 0xc7, 0x7b, 0xa0, 0xbc, 0x1f, 0xa6, 0x3a, 0x17,
-	0x73, 0xaa, 0x4b, 0x92, 0x14, 0x30, 0x52, 0xa9, 0xbe, 0x3f, 0xa1, 0xc1, 0x15, 0xb2, 0xc7, 0x5d,
-	0xf2, 0xd6, 0x7d, 0x63, 0x6b, 0xcb, 0x36, 0x85, 0x5d, 0x2a, 0xff, 0xb0, 0xcb, 0x87, 0x07, 0xd5,
-	0x2b, 0x8b, 0x79, 0x15, 0x1e, 0x1e, 0x54, 0x6f, 0xe5, 0x7a, 0x48, 0xb2, 0xcf, 0x9a, 0xdb, 0x04,
-	0xe7, 0x93, 0x9a, 0x7d, 0x1e, 0xc6, 0x4f, 0xe0, 0xcd, 0x90, 0xf0, 0x83, 0xfc, 0xcd, 0x0a, 0x4c,
-	0xd0, 0x75, 0xb7, 0xec, 0x99, 0x86, 0x53, 0x5f, 0x6d, 0x9e, 0x20, 0x0f, 0x3d, 0x5a, 0x86, 0xcb,
-	0x5b, 0x9e, 0x6f, 0x92, 0xf5, 0xda, 0xda, 0xba, 0x27, 0x9e, 0x5c, 0xea, 0xab, 0x4d, 0xc1, 0xa5,
-	0xd9, 0x25, 0x72, 0x29, 0x07, 0x8e, 0x73, 0x5b, 0xa1, 0xfb, 0x70, 0x25, 0x2e, 0xdf, 0xe8, 0x70,
-	0x43, 0x16, 0x8a, 0x6e, 0x20, 0x36, 0xc4, 0x59, 0xca, 0xab, 0x80, 0xf3, 0xdb, 0x21, 0x03, 0xae,
-	0x8b, 0xe0, 0x28, 0x4b, 0x9e, 0xff, 0xc0, 0xf0, 0xad, 0x24, 0xda, 0xc1, 0x58, 0x25, 0x5d, 0x2f,
-	0xae, 0x86, 0x7b, 0xe1, 0xd0, 0x7f, 0x76, 0x18, 0x14, 0xbf, 0xb9, 0x13, 0x24, 0xfa, 0xfa, 0x05,
-	0x0d, 0x2e, 0x9b, 0x8e, 0x4d, 0xdc, 0x30, 0xe5, 0x24, 0xc5, 0xd9, 0xd1, 0x46, 0x29, 0x87, 0xbe,
-	0x0e, 0x71, 0x1b, 0x75, 0x61, 0xf7, 0x53, 0xcb, 0x41, 0x2e, 0x6c, 0xa3, 0x72, 0x20, 0x38, 0xb7,
-	0x33, 0x6c, 0x3c, 0xac, 0xbc, 0x51, 0x57, 0xa3, 0x3a, 0xd4, 0x44, 0x19, 0x96, 0x50, 0xf4, 0x0c,
-	0x8c, 0xb7, 0x7c, 0xaf, 0xdb, 0x09, 0x6a, 0xcc, 0xd8, 0x98, 0xaf, 0x7d, 0x26, 0x17, 0xde, 0x89,
-	0x8b, 0xb1, 0x5a, 0x87, 0x4a, 0xb9, 0xfc, 0xe7, 0x9a, 0x4f, 0xb6, 0xec, 0x3d, 0xc1, 0xe4, 0x98,
-	0x94, 0x7b, 0x47, 0x29, 0xc7, 0x89, 0x5a, 0xcc, 0x31, 0x3b, 0x08, 0xba, 0xc4, 0xdf, 0xc0, 0xcb,
-	0x22, 0x23, 0x05, 0x77, 0xcc, 0x8e, 0x0a, 0x71, 0x0c, 0x47, 0x3f, 0xa5, 0xc1, 0x94, 0x4f, 0xde,
-	0xec, 0xda, 0x3e, 0xb1, 0x18, 0xd1, 0x40, 0x38, 0x2f, 0xe2, 0xfe, 0x1c, 0x26, 0xe7, 0x70, 0x02,
-	0x29, 0xe7, 0x10, 0x52, 0x6d, 0x97, 0x04, 0xe2, 0x54, 0x0f, 0xe8, 0x54, 0x05, 0x76, 0xcb, 0xb5,
-	0xdd, 0xd6, 0xbc, 0xd3, 0x0a, 0x66, 0x46, 0x19, 0xd3, 0xe3, 0x22, 0x74, 0x5c, 0x8c, 0xd5, 0x3a,
-	0xf4, 0x7a, 0xd9, 0x0d, 0xe8, 0xbe, 0x6f, 0x13, 0x3e, 0xbf, 0x63, 0xb1, 0x5e, 0x73, 0x43, 0x05,
-	0xe0, 0x64, 0x3d, 0x74, 0x1b, 0xa6, 0xa2, 0x02, 0x31, 0xcb, 0xc0, 0xe3, 0x01, 0xb2, 0xeb, 0x7e,
-	0x02, 0x82, 0x53, 0x35, 0x67, 0xe7, 0xe1, 0x52, 0xce, 0x30, 0x4f, 0xc4, 0x5c, 0xfe, 0x9f, 0x06,
-	0x57, 0x78, 0x96, 0xd2, 0x28, 0x97, 0x45, 0x14, 0xf8, 0x2f, 0x3f, 0x86, 0x9e, 0x76, 0xa6, 0x31,
-	0xf4, 0xbe, 0x01, 0xb1, 0x02, 0xf5, 0x7f, 0x52, 0x81, 0xf7, 0x1e, 0xb9, 0x2f, 0xd1, 0x3f, 0xd2,
-	0x60, 0x9c, 0xec, 0x85, 0xbe, 0x21, 0x3d, 0x32, 0xe8, 0x22, 0xdd, 0x3a, 0x13, 0x26, 0x30, 0xb7,
-	0x18, 0x13, 0xe2, 0x0b, 0x57, 0x8a, 0x58, 0x0a, 0x04, 0xab, 0xfd, 0xa1, 0x97, 0x56, 0x1e, 0x2f,
-	0x53, 0x7d, 0x00, 0x11, 0xc9, 0xa3, 0x05, 0x64, 0xf6, 0xa3, 0x30, 0x9d, 0xc6, 0x7c, 0xa2, 0xb5,
-	0xf2, 0xeb, 0x15, 0x18, 0x59, 0xf3, 0x3d, 0x2a, 0xfd, 0x9d, 0x43, 0x7c, 0x07, 0x23, 0x11, 0x43,
-	0xbe, 0x94, 0xcb, 0xb6, 0xe8, 0x6c, 0x61, 0xfe, 0x0a, 0x3b, 0x95, 0xbf, 0x62, 0xbe, 0x1f, 0x22,
-	0xbd, 0x13, 0x56, 0xfc, 0xa1, 0x06, 0xe3, 0xa2, 0xe6, 0x39, 0x44, 0x31, 0xf8, 0xfe, 0x64, 0x14,
-	0x83, 0x8f, 0xf4, 0x31, 0xae, 0x82, 0xf0, 0x05, 0x9f, 0xd3, 0x60, 0x52, 0xd4, 0x58, 0x21, 0xed,
-	0x4d, 0xe2, 0xa3, 0x25, 0x18, 0x09, 0xba, 0xec, 0x43, 0x8a, 0x01, 0x5d, 0x57, 0xef, 0x13, 0xfe,
-	0xa6, 0x61, 0xb2, 0x0c, 0xe8, 0xbc, 0x8a, 0x92, 0x15, 0x82, 0x17, 0xe0, 0xa8, 0x31, 0xbd, 0xbd,
-	0xf8, 0x9e, 0x93, 0x89, 0x6b, 0x85, 0x3d, 0x87, 0x60, 0x06, 0xa1, 0x82, 0x39, 0xfd, 0x1b, 0xa9,
-	0xf0, 0x98, 0x60, 0x4e, 0xc1, 0x01, 0xe6, 0xe5, 0xfa, 0x27, 0x07, 0xe5, 0x64, 0xb3, 0xc8, 0xed,
-	0x77, 0x61, 0xcc, 0xf4, 0x89, 0x11, 0x12, 0x6b, 0x61, 0xff, 0x38, 0x9d, 0x63, 0xc7, 0x55, 0x2d,
-	0x6a, 0x81, 0xe3, 0xc6, 0xf4, 0x64, 0x50, 0xdf, 0x9c, 0x2a, 0xf1, 0x21, 0x5a, 0xf8, 0xde, 0xf4,
-	0x9d, 0x30, 0xe4, 0x3d, 0x70, 0xa5, 0xe9, 0x4a, 0x4f, 0xc2, 0x6c, 0x28, 0xf7, 0x69, 0x6d, 0xcc,
-	0x1b, 0xa9, 0x71, 0xdd, 0x06, 0x7b, 0xc4, 0x75, 0x73, 0x60, 0xa4, 0xcd, 0x3e, 0x43, 0x5f, 0x49,
-	0x02, 0x12, 0x1f, 0x54, 0x4d, 0x23, 0xc5, 0x30, 0xe3, 0x88, 0x04, 0x3d, 0xe1, 0xe9, 0x29, 0x14,
-	0x74, 0x0c, 0x93, 0xa8, 0x27, 0xfc, 0x6a, 0x54, 0x88, 0x63, 0x38, 0xda, 0x4f, 0x06, 0x0c, 0x1c,
-	0x29, 0xaf, 0xc1, 0x13, 0xdd, 0x53, 0x62, 0x04, 0xf2, 0xa9, 0x2f, 0x0c, 0x1a, 0xf8, 0xe3, 0x83,
-	0x72, 0x91, 0x8a, 0x9c, 0x1f, 0xf9, 0x59, 0xbb, 0xb5, 0x52, 0x59, 0xbb, 0xbf, 0x3d, 0x8a, 0x8c,
-	0x5b, 0x49, 0xa4, 0x3c, 0x93, 0x91, 0x71, 0x27, 0x04, 0xe9, 0x44, 0x34, 0xdc, 0x2e, 0x5c, 0x0a,
-	0x42, 0xc3, 0x21, 0x4d, 0x5b, 0x68, 0x3a, 0x82, 0xd0, 0x68, 0x77, 0x4a, 0x84, 0xa6, 0xe5, 0xfe,
-	0x0b, 0x59, 0x54, 0x38, 0x0f, 0x3f, 0xfa, 0x11, 0x0d, 0x66, 0x58, 0xf9, 0x7c, 0x37, 0xf4, 0x78,
-	0x0c, 0xf5, 0x98, 0xf8, 0xc9, 0x1f, 0xb6, 0xd9, 0x05, 0xb0, 0x59, 0x80, 0x0f, 0x17, 0x52, 0x42,
-	0x6f, 0xc3, 0x15, 0x7a, 0x02, 0xcf, 0x9b, 0xa1, 0xbd, 0x6b, 0x87, 0xfb, 0x71, 0x17, 0x4e, 0x1e,
-	0x8f, 0x96, 0x5d, 0x36, 0x96, 0xf3, 0x90, 0xe1, 0x7c, 0x1a, 0xfa, 0x5f, 0x68, 0x80, 0xb2, 0x4b,
-	0x08, 0x39, 0x30, 0x6a, 0x45, 0x0e, 0x05, 0xda, 0xa9, 0x44, 0xb3, 0x94, 0x9c, 0x59, 0xfa, 0x21,
-	0x48, 0x0a, 0xc8, 0x83, 0xb1, 0x07, 0xdb, 0x76, 0x48, 0x1c, 0x3b, 0x08, 0x4f, 0x29, 0x78, 0xa6,
-	0x8c, 0x24, 0xf7, 0x4a, 0x84, 0x18, 0xc7, 0x34, 0xf4, 0x9f, 0x18, 0x84, 0x51, 0x19, 0x0c, 0xfc,
-	0xe8, 0x37, 0xde, 0x2e, 0x20, 0x53, 0x49, 0xa8, 0xd6, 0x8f, 0x06, 0x86, 0x09, 0x61, 0xb5, 0x0c,
-	0x32, 0x9c, 0x43, 0x00, 0xbd, 0x0d, 0x97, 0x6d, 0x77, 0xcb, 0x37, 0x82, 0xd0, 0xef, 0x32, 0x5d,
-	0x79, 0x3f, 0x79, 0xc9, 0xd8, 0x1d, 0xaa, 0x91, 0x83, 0x0e, 0xe7, 0x12, 0x41, 0x04, 0x46, 0x78,
-	0xce, 0x83, 0x28, 0xae, 0x61, 0xa9, 0x8c, 0xbf, 0x3c, 0x97, 0x42, 0xcc, 0x35, 0xf9, 0xef, 0x00,
-	0x47, 0xb8, 0x79, 0xcc, 0x11, 0xfe, 0x7f, 0xf4, 0x1e, 0x2d, 0xd6, 0x7d, 0xad, 0x3c, 0xbd, 0x38,
-	0x79, 0x34, 0x8f, 0x39, 0x92, 0x2c, 0xc4, 0x69, 0x82, 0xfa, 0xef, 0x6b, 0x30, 0xc4, 0x1d, 0x75,
-	0xcf, 0x5e, 0x82, 0xfb, 0xbe, 0x84, 0x04, 0x57, 0x2a, 0xb5, 0x12, 0xeb, 0x6a, 0x61, 0xd2, 0x9f,
-	0xdf, 0xd3, 0x60, 0x8c, 0xd5, 0x38, 0x07, 0x91, 0xea, 0xf5, 0xa4, 0x48, 0xf5, 0x7c, 0xe9, 0xd1,
-	0x14, 0x08, 0x54, 0xbf, 0x3f, 0x20, 0xc6, 0xc2, 0x24, 0x96, 0x06, 0x5c, 0x12, 0xd6, 0xb0, 0xcb,
-	0xf6, 0x16, 0xa1, 0x4b, 0xbc, 0x6e, 0xec, 0xf3, 0x07, 0xa2, 0x21, 0xe1, 0x8b, 0x95, 0x05, 0xe3,
-	0xbc, 0x36, 0xe8, 0x37, 0x35, 0x2a, 0x1b, 0x84, 0xbe, 0x6d, 0xf6, 0x95, 0x49, 0x47, 0xf6, 0x6d,
-	0x6e, 0x85, 0x23, 0xe3, 0x37, 0x93, 0x8d, 0x58, 0x48, 0x60, 0xa5, 0x0f, 0x0f, 0xaa, 0xd5, 0x1c,
-	0x95, 0x59, 0x9c, 0x55, 0x23, 0x08, 0x3f, 0xf1, 0xa7, 0x3d, 0xab, 0x30, 0x35, 0x75, 0xd4, 0x63,
-	0x74, 0x17, 0x86, 0x02, 0xd3, 0xeb, 0x90, 0x93, 0xe4, 0x06, 0x93, 0x13, 0xdc, 0xa4, 0x2d, 0x31,
-	0x47, 0x30, 0xfb, 0x06, 0x4c, 0xa8, 0x3d, 0xcf, 0xb9, 0xf9, 0xd4, 0xd5, 0x9b, 0xcf, 0x89, 0x5f,
-	0xba, 0xd4, 0x9b, 0xd2, 0x6f, 0x55, 0x60, 0x98, 0x67, 0xfc, 0x3e, 0x86, 0x32, 0xde, 0x8e, 0xd2,
-	0x17, 0x54, 0xca, 0x5b, 0xdc, 0xa9, 0xa1, 0x3a, 0x5f, 0xf3, 0x5c, 0x65, 0x0e, 0xd4, 0x0c, 0x06,
-	0xc8, 0x95, 0x01, 0x5c, 0x07, 0xca, 0xe7, 0x2f, 0xe2, 0x03, 0x3b, 0xeb, 0x90, 0xad, 0x7f, 0xa4,
-	0xc1, 0x44, 0x22, 0x22, 0x6e, 0x1b, 0x06, 0x7c, 0x99, 0xd9, 0xae, 0xec, 0x5b, 0x45, 0x64, 0x53,
-	0x75, 0xbd, 0x47, 0x25, 0x4c, 0xe9, 0xc8, 0xe0, 0xb9, 0x95, 0x53, 0x0a, 0x9e, 0xab, 0x7f, 0x46,
-	0x83, 0xab, 0xd1, 0x80, 0x92, 0xa1, 0xa1, 0xd0, 0x93, 0x30, 0x6a, 0x74, 0x6c, 0xa6, 0x52, 0x53,
-	0x95, 0x92, 0xf3, 0x6b, 0x0d, 0x56, 0x86, 0x25, 0x14, 0x7d, 0x00, 0x46, 0xa3, 0x85, 0x27, 0xc4,
-	0x4e, 0xc9, 0xb3, 0xe4, 0xeb, 0x8b, 0xac, 0x81, 0xde, 0xa7, 0x64, 0x98, 0x18, 0x8a, 0xe5, 0x04,
-	0x49, 0x98, 0xbf, 0x02, 0xeb, 0xdf, 0x01, 0x63, 0xcd, 0xe6, 0xdd, 0x79, 0xd3, 0x24, 0x41, 0x70,
-	0x02, 0xe5, 0xb2, 0xfe, 0xa9, 0x01, 0x98, 0x14, 0x31, 0xee, 0x6c, 0xd7, 0xb2, 0xdd, 0xd6, 0x39,
-	0x9c, 0x29, 0xeb, 0x30, 0xc6, 0xb5, 0x19, 0x47, 0x64, 0x21, 0x6c, 0x46, 0x95, 0xd2, 0x91, 0xa4,
-	0x25, 0x00, 0xc7, 0x88, 0xd0, 0x3d, 0x18, 0x7e, 0x93, 0xf2, 0xb7, 0x68, 0x5f, 0x1c, 0x8b, 0xcd,
-	0xc8, 0x45, 0xcf, 0x58, 0x63, 0x80, 0x05, 0x0a, 0x14, 0x30, 0xa3, 0x3f, 0x26, 0x70, 0xf5, 0x13,
-	0xbb, 0x22, 0x31, 0xb3, 0x32, 0xbf, 0xcc, 0x84, 0xb0, 0x1d, 0x64, 0xbf, 0xb0, 0x24, 0xc4, 0xc2,
-	0xe0, 0x27, 0x5a, 0xbc, 0x4b, 0xc2, 0xe0, 0x27, 0xfa, 0x5c, 0x70, 0x34, 0x3e, 0x0f, 0x57, 0x72,
-	0x27, 0xe3, 0x68, 0x71, 0x56, 0xff, 0x95, 0x0a, 0x0c, 0x36, 0x09, 0xb1, 0xce, 0x61, 0x65, 0xbe,
-	0x9e, 0x90, 0x76, 0xbe, 0xb3, 0x74, 0x20, 0xfe, 0x22, 0x65, 0xd5, 0x56, 0x4a, 0x59, 0xf5, 0xd1,
-	0xd2, 0x14, 0x7a, 0x6b, 0xaa, 0x7e, 0xae, 0x02, 0x40, 0xab, 0x2d, 0x18, 0xe6, 0x0e, 0xe7, 0x38,
-	0x72, 0x35, 0x6b, 0x49, 0x8e, 0x93, 0x5d, 0x86, 0xe7, 0xf9, 0x78, 0xab, 0xc3, 0xb0, 0xcf, 0x4e,
-	0x22, 0xf1, 0xee, 0x01, 0x3c, 0x35, 0x36, 0x2d, 0xc1, 0x02, 0x92, 0xe4, 0x16, 0x83, 0xa7, 0xc4,
-	0x2d, 0xf4, 0x3d, 0x60, 0xb9, 0x4c, 0xeb, 0xab, 0x4d, 0xd4, 0x56, 0x66, 0xa7, 0x52, 0x5e, 0x96,
-	0x17, 0xe8, 0x8e, 0xdc, 0xe5, 0x9f, 0xd2, 0xe0, 0x42, 0xaa, 0xee, 0x31, 0xee, 0x74, 0x67, 0xc2,
-	0x33, 0xf5, 0xdf, 0xd5, 0x60, 0x94, 0xf6, 0xe5, 0x1c, 0x18, 0xcd, 0xdf, 0x4e, 0x32, 0x9a, 0x0f,
-	0x97, 0x9d, 0xe2, 0x02, 0xfe, 0xf2, 0x67, 0x15, 0x60, 0x19, 0x2f, 0x84, 0x89, 0x82, 0xf2, 0xf2,
-	0xaf, 0x15, 0xbc, 0xfc, 0xdf, 0x14, 0x86, 0x03, 0x29, 0x1d, 0xa5, 0x62, 0x3c, 0xf0, 0x01, 0xc5,
-	0x36, 0x60, 0x20, 0xb9, 0x6d, 0x72, 0xec, 0x03, 0xde, 0x82, 0xc9, 0x60, 0xdb, 0xf3, 0x42, 0x19,
-	0xd9, 0x60, 0xb0, 0xbc, 0x3e, 0x9a, 0x59, 0x58, 0x47, 0x43, 0xe1, 0x0f, 0x50, 0x4d, 0x15, 0x37,
-	0x4e, 0x92, 0x42, 0x73, 0x00, 0x9b, 0x8e, 0x67, 0xee, 0xd4, 0x1a, 0x75, 0x1c, 0x59, 0xd4, 0x32,
-	0xa3, 0xa5, 0x05, 0x59, 0x8a, 0x95, 0x1a, 0x7d, 0xd9, 0x32, 0x7c, 0x4d, 0xe3, 0x33, 0x7d, 0x82,
-	0xc5, 0x7b, 0x8e, 0x1c, 0xe5, 0xfd, 0x29, 0x8e, 0xa2, 0x24, 0xdc, 0x4f, 0x70, 0x95, 0x6a, 0x24,
-	0xb0, 0x0f, 0xc6, 0xfa, 0xe7, 0x44, 0xa2, 0xb0, 0x5f, 0x17, 0xc3, 0x94, 0x49, 0x53, 0x3a, 0x30,
-	0xe9, 0xa8, 0xc9, 0x5f, 0xc5, 0x1e, 0x29, 0x95, 0x37, 0x56, 0xba, 0x68, 0x24, 0x8a, 0x71, 0x92,
-	0x00, 0x7a, 0x0e, 0x26, 0xa3, 0xd1, 0xd1, 0xc9, 0x8c, 0x2c, 0x37, 0xd8, 0x72, 0x58, 0x53, 0x01,
-	0x38, 0x59, 0x4f, 0xff, 0x6c, 0x05, 0x1e, 0xe3, 0x7d, 0x67, 0x1a, 0x83, 0x3a, 0xe9, 0x10, 0xd7,
-	0x22, 0xae, 0xb9, 0xcf, 0x64, 0x56, 0xcb, 0x6b, 0xa1, 0xb7, 0x61, 0xf8, 0x01, 0x21, 0x96, 0xd4,
-	0x68, 0xbf, 0x52, 0x3e, 0xe7, 0x4c, 0x01, 0x89, 0x57, 0x18, 0x7a, 0xce, 0xd1, 0xf9, 0xff, 0x58,
-	0x90, 0xa4, 0xc4, 0x3b, 0xbe, 0xb7, 0x29, 0x45, 0xab, 0xd3, 0x27, 0xbe, 0xc6, 0xd0, 0x73, 0xe2,
-	0xfc, 0x7f, 0x2c, 0x48, 0xea, 0x6b, 0xf0, 0xf8, 0x31, 0x9a, 0x9e, 0x44, 0x84, 0x3e, 0x0a, 0x23,
-	0x1f, 0xfd, 0x49, 0x30, 0x7e, 0x45, 0x83, 0x27, 0x14, 0x94, 0x8b, 0x7b, 0x54, 0xaa, 0xaf, 0x19,
-	0x1d, 0xc3, 0xa4, 0x77, 0x54, 0xe6, 0xad, 0x7d, 0xa2, 0x1c, 0x18, 0x9f, 0xd2, 0x60, 0x84, 0x1b,
-	0xd2, 0x44, 0xec, 0xf7, 0xf5, 0x3e, 0xa7, 0xbc, 0xb0, 0x4b, 0x51, 0x70, 0xe5, 0x68, 0x6c, 0xfc,
-	0x77, 0x80, 0x23, 0xfa, 0xfa, 0xbf, 0x1d, 0x82, 0x6f, 0x39, 0x3e, 0x22, 0xf4, 0x35, 0x2d, 0x9b,
-	0xb1, 0xb7, 0x7d, 0xb6, 0x9d, 0x97, 0x5a, 0x0c, 0x71, 0x31, 0x7e, 0x25, 0x93, 0xc0, 0xe6, 0x94,
-	0x14, 0x24, 0x4a, 0x7a, 0xe0, 0x7f, 0xae, 0xc1, 0x04, 0x3d, 0x96, 0x24, 0x73, 0xe1, 0x9f, 0xa9,
-	0x73, 0xc6, 0x23, 0x5d, 0x55, 0x48, 0xa6, 0x3c, 0x2f, 0x55, 0x10, 0x4e, 0xf4, 0x0d, 0x6d, 0x24,
-	0x5f, 0x83, 0xf8, 0x75, 0xeb, 0x46, 0x9e, 0x34, 0x72, 0x92, 0xf4, 0x50, 0xb3, 0x0e, 0x4c, 0x25,
-	0x67, 0xfe, 0x2c, 0xd5, 0x3b, 0xb3, 0x2f, 0xc2, 0xc5, 0xcc, 0xe8, 0x4f, 0xa4, 0xdc, 0xf8, 0xbb,
-	0x83, 0x50, 0x55, 0xa6, 0x3a, 0x61, 0x4a, 0x17, 0xc9, 0x04, 0x3f, 0xa3, 0xc1, 0xb8, 0xe1, 0xba,
-	0xc2, 0x1c, 0x23, 0x5a, 0xbf, 0x56, 0x9f, 0x5f, 0x35, 0x8f, 0xd4, 0xdc, 0x7c, 0x4c, 0x26, 0x65,
-	0x6f, 0xa0, 0x40, 0xb0, 0xda, 0x9b, 0x1e, 0x46, 0x75, 0x95, 0x73, 0x33, 0xaa, 0x43, 0x3f, 0x18,
-	0x1d, 0xc4, 0x7c, 0x19, 0xbd, 0x7a, 0x06, 0x73, 0xc3, 0xce, 0xf5, 0x7c, 0x6d, 0xda, 0xec, 0x47,
-	0x61, 0x3a, 0x3d, 0x73, 0x27, 0x5a, 0x05, 0xbf, 0x32, 0x90, 0x60, 0xd5, 0x85, 0xe4, 0x8f, 0xa1,
-	0x43, 0xfc, 0x7c, 0x6a, 0xb1, 0x70, 0x16, 0x60, 0x9f, 0xd5, 0x84, 0x9c, 0xee, 0x8a, 0x19, 0x38,
-	0x3f, 0x33, 0xcc, 0x7e, 0x3f, 0xd9, 0x02, 0x5c, 0x51, 0xe6, 0x47, 0x49, 0xc7, 0xf7, 0x14, 0x8c,
-	0xec, 0xda, 0x81, 0x1d, 0xc5, 0xd1, 0x51, 0x4e, 0xe8, 0x97, 0x79, 0x31, 0x8e, 0xe0, 0xfa, 0x72,
-	0x62, 0xef, 0xaf, 0x7b, 0x1d, 0xcf, 0xf1, 0x5a, 0xfb, 0xf3, 0x0f, 0x0c, 0x9f, 0x60, 0xaf, 0x1b,
-	0x0a, 0x6c, 0xc7, 0x3d, 0xef, 0x57, 0xe0, 0xa6, 0x82, 0x2d, 0x37, 0x20, 0xc0, 0x49, 0xd0, 0xfd,
-	0xe1, 0x48, 0x24, 0xba, 0x0a, 0x8f, 0xc9, 0x5f, 0xd3, 0xe0, 0x1a, 0x29, 0x3a, 0x0a, 0x84, 0x1c,
-	0xfb, 0xea, 0x59, 0x1d, 0x35, 0x22, 0xce, 0x6a, 0x11, 0x18, 0x17, 0xf7, 0x0c, 0xed, 0x27, 0x92,
-	0x52, 0x56, 0xfa, 0xd1, 0xc3, 0xe5, 0x7c, 0xef, 0x5e, 0x29, 0x29, 0xd1, 0xcf, 0x6b, 0x70, 0xd9,
-	0xc9, 0xd9, 0x3a, 0x42, 0x64, 0x6d, 0x9e, 0xc1, 0xae, 0xe4, 0x6f, 0x9e, 0x79, 0x10, 0x9c, 0xdb,
-	0x15, 0xf4, 0x8b, 0x85, 0x91, 0x2a, 0xf8, 0x93, 0xe4, 0x7a, 0x9f, 0x9d, 0x3c, 0xad, 0xa0, 0x15,
-	0x9f, 0xd5, 0x00, 0x59, 0x19, 0xb1, 0x58, 0x58, 0x91, 0xbc, 0x74, 0xea, 0xc2, 0x3f, 0x7f, 0xb4,
-	0xce, 0x96, 0xe3, 0x9c, 0x4e, 0xb0, 0xef, 0x1c, 0xe6, 0x6c, 0x5f, 0x11, 0x82, 0xb6, 0xdf, 0xef,
-	0x9c, 0xc7, 0x19, 0xf8, 0x77, 0xce, 0x83, 0xe0, 0xdc, 0xae, 0xe8, 0xbf, 0x33, 0xcc, 0xb5, 0x34,
-	0xec, 0x55, 0x71, 0x13, 0x86, 0x37, 0x99, 0x56, 0x4f, 0xec, 0xdb, 0xd2, 0x2a, 0x44, 0xae, 0x1b,
-	0xe4, 0x77, 0x24, 0xfe, 0x3f, 0x16, 0x98, 0xd1, 0x6b, 0x30, 0x60, 0xb9, 0x81, 0xd8, 0x70, 0x1f,
-	0xe9, 0x43, 0x19, 0x16, 0xbb, 0xf2, 0xd4, 0x57, 0x9b, 0x98, 0x22, 0x45, 0x2e, 0x8c, 0xba, 0x42,
-	0xb1, 0x21, 0xee, 0x9e, 0xa5, 0xf3, 0x9d, 0x4a, 0x05, 0x89, 0x54, 0xcb, 0x44, 0x25, 0x58, 0xd2,
-	0xa0, 0xf4, 0x52, 0x9a, 0xfc, 0xd2, 0xf4, 0xa4, 0x6a, 0xaf, 0x97, 0xf6, 0x94, 0xc0, 0x70, 0x68,
-	0xd8, 0x6e, 0xc8, 0xd5, 0x2a, 0x25, 0x9f, 0xcc, 0x29, 0xb5, 0x75, 0x8a, 0x25, 0xd6, 0x5f, 0xb0,
-	0x9f, 0x01, 0x16, 0xc8, 0xe9, 0x32, 0xd8, 0x65, 0x49, 0xc6, 0xc5, 0x36, 0x2a, 0xbd, 0x0c, 0x78,
-	0xaa, 0x72, 0xbe, 0x0c, 0xf8, 0xff, 0x58, 0x60, 0x46, 0x6f, 0xc0, 0x68, 0x10, 0x19, 0x39, 0x8c,
-	0xf6, 0x9b, 0x9a, 0x56, 0x58, 0x38, 0x08, 0xef, 0x1a, 0x61, 0xda, 0x20, 0xf1, 0xa3, 0x4d, 0x18,
-	0xb1, 0xb9, 0x3f, 0x88, 0x08, 0xb3, 0xf3, 0x91, 0x3e, 0x32, 0xb3, 0x45, 0xd9, 0xf7, 0x79, 0x70,
-	0x86, 0x08, 0xb1, 0xfe, 0x87, 0xc0, 0xb5, 0xe2, 0xc2, 0x8e, 0x6c, 0x0b, 0x46, 0x23, 0x74, 0xfd,
-	0x78, 0x79, 0x45, 0xb9, 0x30, 0xf9, 0xd0, 0x64, 0x66, 0x4c, 0x89, 0x1b, 0xd5, 0xf2, 0xbc, 0xf5,
-	0xe2, 0xc0, 0xfc, 0xc7, 0xf3, 0xd4, 0x7b, 0x93, 0x25, 0xaf, 0x8b, 0x7c, 0xe6, 0x07, 0xca, 0x2f,
-	0x2d, 0xe9, 0x4f, 0x9f, 0x48, 0x5a, 0x17, 0xb9, 0xdc, 0x2b, 0x44, 0x0a, 0xec, 0xec, 0x06, 0x4b,
-	0xd9, 0xd9, 0xbd, 0x00, 0x17, 0x84, 0x5d, 0x43, 0x83, 0x25, 0x9a, 0x0f, 0xf7, 0x85, 0x23, 0x02,
-	0xb3, 0x78, 0xa9, 0x25, 0x41, 0x38, 0x5d, 0x17, 0xfd, 0x96, 0x06, 0xa3, 0xa6, 0x10, 0x10, 0xc4,
-	0xbe, 0x5a, 0xee, 0xef, 0xe9, 0x64, 0x2e, 0x92, 0x37, 0xb8, 0xe8, 0xfb, 0x72, 0xb4, 0xa3, 0xa3,
-	0xe2, 0x53, 0xba, 0xe2, 0xcb, 0x5e, 0xa3, 0x3f, 0xa0, 0xd2, 0xbd, 0xc3, 0xf2, 0x73, 0x32, 0xbf,
-	0x64, 0xee, 0x21, 0x71, 0xbf, 0xcf, 0x51, 0xcc, 0xc7, 0x18, 0xf9, 0x40, 0xbe, 0x5b, 0xca, 0xf0,
-	0x31, 0xe4, 0x94, 0xc6, 0xa2, 0x76, 0x1f, 0xfd, 0x53, 0x0d, 0x9e, 0xe0, 0x6e, 0x29, 0x35, 0x7a,
-	0xe6, 0xb3, 0x34, 0xe7, 0x24, 0xce, 0xab, 0x1e, 0x5b, 0x05, 0x8e, 0x9e, 0xd8, 0x2a, 0xf0, 0xc9,
-	0xc3, 0x83, 0xea, 0x13, 0xb5, 0x63, 0xe0, 0xc6, 0xc7, 0xea, 0x01, 0x7a, 0x0b, 0x26, 0x1d, 0x35,
-	0x76, 0x8a, 0x60, 0x30, 0xa5, 0x14, 0xf3, 0x89, 0x20, 0x2c, 0x5c, 0x13, 0x9b, 0x28, 0xc2, 0x49,
-	0x52, 0xb3, 0x3b, 0x30, 0x99, 0x58, 0x68, 0x67, 0xaa, 0xd2, 0x70, 0x61, 0x3a, 0xbd, 0x1e, 0xce,
-	0xd4, 0x42, 0xe6, 0x1e, 0x8c, 0xc9, 0x83, 0x0a, 0x3d, 0xa6, 0x10, 0x8a, 0x8f, 0xfd, 0x7b, 0x64,
-	0x9f, 0x53, 0xad, 0x26, 0xae, 0x63, 0x5c, 0xdf, 0xfe, 0x32, 0x2d, 0x10, 0x08, 0xf5, 0x3f, 0x16,
-	0xfa, 0xf6, 0x75, 0xd2, 0xee, 0x38, 0x46, 0x48, 0xde, 0xfd, 0xaf, 0xbd, 0xfa, 0x7f, 0xd3, 0xf8,
-	0x79, 0xc3, 0x8f, 0x55, 0x64, 0xc0, 0x78, 0x9b, 0x07, 0x08, 0x66, 0xae, 0xf8, 0x5a, 0xf9, 0x20,
-	0x00, 0x2b, 0x31, 0x1a, 0xac, 0xe2, 0x44, 0x0f, 0x60, 0x2c, 0x12, 0x44, 0x22, 0xfd, 0xc1, 0x52,
-	0x7f, 0x82, 0x81, 0x94, 0x79, 0xe4, 0x43, 0x62, 0x54, 0x12, 0xe0, 0x98, 0x96, 0x6e, 0x00, 0xca,
-	0xb6, 0xa1, 0x77, 0xd6, 0xc8, 0xf0, 0x5d, 0x4b, 0x46, 0xdd, 0xcb, 0x18, 0xbf, 0x1f, 0x99, 0x91,
-	0x5b, 0xff, 0xed, 0x0a, 0xe4, 0x66, 0x87, 0x43, 0x3a, 0x0c, 0x73, 0x5f, 0xb4, 0x28, 0xd9, 0x37,
-	0x15, 0x65, 0xb8, 0xa3, 0x1a, 0x16, 0x10, 0x74, 0x9f, 0xeb, 0x2d, 0x5c, 0x8b, 0x45, 0xbb, 0x8b,
-	0xb9, 0x84, 0xea, 0xf5, 0xb8, 0x98, 0x57, 0x01, 0xe7, 0xb7, 0x43, 0xbb, 0x80, 0xda, 0xc6, 0x5e,
-	0x1a, 0x5b, 0x1f, 0xe9, 0x8f, 0x56, 0x32, 0xd8, 0x70, 0x0e, 0x05, 0x7a, 0x90, 0x1a, 0xa6, 0x49,
-	0x3a, 0x21, 0xb1, 0xf8, 0x10, 0xa3, 0xe7, 0x3e, 0x76, 0x90, 0xce, 0x27, 0x41, 0x38, 0x5d, 0x57,
-	0xff, 0xea, 0x20, 0x5c, 0x4b, 0x4e, 0x22, 0xdd, 0xa1, 0x91, 0xbb, 0xd8, 0x8b, 0x91, 0x35, 0x3c,
-	0x9f, 0xc8, 0xa7, 0xd2, 0xd6, 0xf0, 0x33, 0x35, 0x9f, 0xb0, 0x23, 0xd9, 0x70, 0x82, 0xa8, 0x51,
-	0xc2, 0x32, 0xfe, 0x1b, 0xe0, 0xfb, 0x55, 0xe0, 0xe3, 0x36, 0x70, 0xa6, 0x3e, 0x6e, 0xef, 0x68,
-	0x30, 0x9b, 0x2c, 0x5e, 0xb2, 0x5d, 0x3b, 0xd8, 0x16, 0x31, 0xdb, 0x4e, 0x6e, 0x8c, 0xcf, 0x52,
-	0x24, 0x2c, 0x17, 0x62, 0xc4, 0x3d, 0xa8, 0xa1, 0x4f, 0x6b, 0x70, 0x3d, 0x35, 0x2f, 0x89, 0x08,
-	0x72, 0x27, 0xb7, 0xcb, 0x67, 0xde, 0xba, 0xcb, 0xc5, 0x28, 0x71, 0x2f, 0x7a, 0xfa, 0xbf, 0xaa,
-	0xc0, 0x10, 0x7b, 0xad, 0x7e, 0x77, 0x98, 0x27, 0xb3, 0xae, 0x16, 0x5a, 0xec, 0xb4, 0x52, 0x16,
-	0x3b, 0x2f, 0x96, 0x27, 0xd1, 0xdb, 0x64, 0xe7, 0xbb, 0xe1, 0x2a, 0xab, 0x36, 0x6f, 0x31, 0x25,
-	0x4a, 0x40, 0xac, 0x79, 0xcb, 0x62, 0xb1, 0x02, 0x8e, 0xd6, 0x1c, 0x3f, 0x06, 0x03, 0x5d, 0xdf,
-	0x49, 0x47, 0xcf, 0xd8, 0xc0, 0xcb, 0x98, 0x96, 0xeb, 0xef, 0x68, 0x30, 0xcd, 0x70, 0x2b, 0xdb,
-	0x17, 0xed, 0xc2, 0xa8, 0x2f, 0xb6, 0xb0, 0xf8, 0x36, 0xcb, 0xa5, 0x87, 0x96, 0xc3, 0x16, 0x44,
-	0xfe, 0x4a, 0xf1, 0x0b, 0x4b, 0x5a, 0xfa, 0x97, 0x87, 0x61, 0xa6, 0xa8, 0x11, 0xfa, 0x29, 0x0d,
-	0xae, 0x9a, 0xb1, 0x34, 0x37, 0xdf, 0x0d, 0xb7, 0x3d, 0xdf, 0x0e, 0x6d, 0x61, 0xc6, 0x51, 0xf2,
-	0x9a, 0x5b, 0x9b, 0x97, 0xbd, 0x62, 0x11, 0xcf, 0x6a, 0xb9, 0x14, 0x70, 0x01, 0x65, 0xf4, 0x36,
-	0xc0, 0x4e, 0x1c, 0x62, 0xb5, 0x52, 0x3e, 0x99, 0x03, 0x1b, 0xb6, 0x12, 0x86, 0x35, 0xea, 0x14,
-	0xd3, 0x43, 0x2a, 0xe5, 0x0a, 0x39, 0x4a, 0x3c, 0x08, 0xb6, 0xef, 0x91, 0xfd, 0x8e, 0x61, 0x47,
-	0x8f, 0xf5, 0xe5, 0x89, 0x37, 0x9b, 0x77, 0x05, 0xaa, 0x24, 0x71, 0xa5, 0x5c, 0x21, 0x87, 0x3e,
-	0xa1, 0xc1, 0xa4, 0xa7, 0x3a, 0x16, 0xf7, 0x63, 0x0b, 0x99, 0xeb, 0xa1, 0xcc, 0x45, 0xe8, 0x24,
-	0x28, 0x49, 0x92, 0xae, 0x89, 0x8b, 0x41, 0xfa, 0xc8, 0x12, 0x4c, 0x6d, 0xa5, 0xff, 0xe4, 0xb3,
-	0xca, 0xf9, 0xc7, 0xaf, 0xe3, 0x59, 0x70, 0x96, 0x3c, 0xeb, 0x14, 0x09, 0x4d, 0x2b, 0x4e, 0x85,
-	0x49, 0x3b, 0x35, 0x5c, 0xbe, 0x53, 0x8b, 0xeb, 0xb5, 0x7a, 0x02, 0x59, 0xb2, 0x53, 0x59, 0x70,
-	0x96, 0xbc, 0xfe, 0xf1, 0x0a, 0x3c, 0x52, 0xb0, 0xc6, 0xfe, 0xda, 0x78, 0x82, 0xff, 0x9e, 0x06,
-	0x63, 0x6c, 0x0e, 0xde, 0x25, 0xee, 0x24, 0xac, 0xaf, 0x05, 0x36, 0x6d, 0xbf, 0xab, 0xc1, 0xc5,
-	0x4c, 0xac, 0xcd, 0x63, 0x39, 0x23, 0x9c, 0x9b, 0xb9, 0xd5, 0xfb, 0xe2, 0xb8, 0xda, 0x03, 0xb1,
-	0x6b, 0x6b, 0x3a, 0xa6, 0xb6, 0xfe, 0x0a, 0x4c, 0x26, 0x4c, 0xda, 0x64, 0xd4, 0x1e, 0x2d, 0x37,
-	0x6a, 0x8f, 0x1a, 0x94, 0xa7, 0xd2, 0x2b, 0x28, 0x4f, 0xbc, 0xe4, 0xb3, 0x9c, 0xed, 0xaf, 0xcd,
-	0x92, 0xff, 0xca, 0x05, 0xb1, 0xe4, 0xd9, 0xfb, 0xc0, 0xeb, 0x30, 0xcc, 0x42, 0x00, 0x45, 0x27,
-	0xe6, 0xed, 0xd2, 0xa1, 0x85, 0x02, 0x7e, 0x93, 0xe2, 0xff, 0x63, 0x81, 0x15, 0xd5, 0x61, 0xda,
-	0x74, 0xbc, 0xae, 0x25, 0xd2, 0x60, 0xae, 0xc6, 0x97, 0x36, 0x19, 0x21, 0xb2, 0x96, 0x82, 0xe3,
-	0x4c, 0x0b, 0x84, 0xf9, 0x0b, 0x03, 0x3f, 0xcf, 0x4a, 0x45, 0x88, 0xac, 0xaf, 0x36, 0x79, 0x86,
-	0x05, 0xf9, 0xb2, 0xf0, 0x26, 0x00, 0x89, 0x16, 0x6f, 0xe4, 0x05, 0xf8, 0x42, 0xb9, 0xd8, 0x97,
-	0x72, 0x0b, 0x44, 0xc2, 0xa7, 0x2c, 0x0a, 0xb0, 0x42, 0x04, 0xf9, 0x30, 0xbe, 0x6d, 0x6f, 0x12,
-	0xdf, 0xe5, 0x72, 0xd4, 0x50, 0x79, 0x11, 0xf1, 0x6e, 0x8c, 0x86, 0xdf, 0xf1, 0x95, 0x02, 0xac,
-	0x12, 0x41, 0x3e, 0x17, 0x47, 0xb8, 0x7a, 0xb8, 0x9f, 0xcc, 0xf4, 0xb1, 0xde, 0x39, 0x1e, 0x67,
-	0x5c, 0x86, 0x15, 0x2a, 0xc8, 0x05, 0x70, 0x65, 0xec, 0xaf, 0x7e, 0x5e, 0x1c, 0xe2, 0x08, 0x62,
-	0x5c, 0xf0, 0x88, 0x7f, 0x63, 0x85, 0x02, 0x9d, 0xd7, 0x76, 0x1c, 0x4c, 0x4e, 0xe8, 0x10, 0x5f,
-	0xec, 0x33, 0xa0, 0x9f, 0xd0, 0x9d, 0xc4, 0x05, 0x58, 0x25, 0x42, 0xc7, 0xd8, 0x96, 0x21, 0xe0,
-	0x84, 0x8e, 0xb0, 0xd4, 0x18, 0xe3, 0x40, 0x72, 0x22, 0x4d, 0x97, 0xfc, 0x8d, 0x15, 0x0a, 0xe8,
-	0x0d, 0xe5, 0x61, 0x0a, 0xca, 0x6b, 0xa0, 0x8e, 0xf5, 0x28, 0xf5, 0xa1, 0x58, 0x11, 0x33, 0xce,
-	0xf6, 0xea, 0x75, 0x45, 0x09, 0xc3, 0x42, 0xe3, 0x51, 0xfe, 0x91, 0x51, 0xca, 0xc4, 0xc6, 0xb4,
-	0x13, 0x3d, 0x8d, 0x69, 0x6b, 0x54, 0x42, 0x53, 0x9c, 0x3b, 0x18, 0x53, 0x98, 0x8c, 0x5f, 0x38,
-	0x9a, 0x69, 0x20, 0xce, 0xd6, 0xe7, 0x4c, 0x9f, 0x58, 0xac, 0xed, 0x94, 0xca, 0xf4, 0x79, 0x19,
-	0x96, 0x50, 0xb4, 0x0b, 0x13, 0x81, 0x62, 0x99, 0x2b, 0x72, 0x2b, 0xf6, 0xf1, 0x36, 0x25, 0xac,
-	0x72, 0x59, 0x50, 0x24, 0xb5, 0x04, 0x27, 0xe8, 0xa0, 0xb7, 0x55, 0x53, 0xc4, 0xe9, 0xf2, 0x6e,
-	0x98, 0xf9, 0x21, 0xff, 0x62, 0x0d, 0x9b, 0xb4, 0x82, 0x53, 0x2d, 0x04, 0xbb, 0x49, 0xa3, 0xbb,
-	0x8b, 0xa7, 0xe2, 0x76, 0x7e, 0xa4, 0x51, 0x1e, 0xfd, 0xb4, 0x64, 0xaf, 0xe3, 0x05, 0x5d, 0x9f,
-	0xb0, 0x50, 0xa6, 0xec, 0xf3, 0xa0, 0xf8, 0xd3, 0x2e, 0xa6, 0x81, 0x38, 0x5b, 0x9f, 0xa5, 0xa5,
-	0xe7, 0xa9, 0x29, 0xe9, 0xd1, 0xe5, 0xb9, 0xc4, 0x0d, 0x03, 0x96, 0x7b, 0xb1, 0xa4, 0xa7, 0x64,
-	0x33, 0x85, 0x8b, 0xe7, 0xf3, 0x49, 0x97, 0xe2, 0x0c, 0x4d, 0xba, 0x72, 0x54, 0xc7, 0x75, 0x96,
-	0xc2, 0xb1, 0xe4, 0xca, 0x51, 0x9d, 0xe2, 0xf9, 0xca, 0x51, 0x4b, 0x70, 0x82, 0x0e, 0x7a, 0x0e,
-	0x26, 0x83, 0x28, 0xcf, 0x0a, 0x9b, 0xc1, 0x2b, 0x71, 0x64, 0xa9, 0xa6, 0x0a, 0xc0, 0xc9, 0x7a,
-	0xfa, 0xbf, 0xd3, 0x00, 0xa4, 0xf6, 0xe0, 0x3c, 0x74, 0xe2, 0x56, 0x42, 0xa1, 0xb2, 0xd0, 0x97,
-	0xb6, 0x83, 0x14, 0x6a, 0xc6, 0xbf, 0xa4, 0xc1, 0x54, 0x5c, 0xed, 0x1c, 0x44, 0x75, 0x33, 0x29,
-	0xaa, 0x7f, 0xb4, 0xbf, 0x71, 0x15, 0xc8, 0xeb, 0xff, 0xb7, 0xa2, 0x8e, 0x8a, 0x49, 0x63, 0xbb,
-	0x89, 0x37, 0x66, 0x4a, 0xfa, 0x6e, 0x3f, 0x6f, 0xcc, 0xaa, 0x33, 0x6d, 0x3c, 0xde, 0x9c, 0x37,
-	0xe7, 0xbf, 0x93, 0x90, 0x85, 0xfa, 0x70, 0x19, 0x97, 0x82, 0x4f, 0x44, 0x9a, 0x4f, 0xc0, 0x51,
-	0x82, 0xd1, 0x9b, 0x2a, 0xab, 0xe4, 0xaf, 0xd5, 0x1f, 0x2b, 0xe7, 0xa7, 0xac, 0x0c, 0xb8, 0x27,
-	0x83, 0xd4, 0xff, 0xc1, 0x14, 0x8c, 0x2b, 0x8a, 0xb6, 0xd4, 0x8b, 0xb9, 0x76, 0x1e, 0x2f, 0xe6,
-	0x21, 0x8c, 0x9b, 0x32, 0x34, 0x78, 0x34, 0xed, 0x7d, 0xd2, 0x94, 0x2c, 0x3a, 0x0e, 0x3a, 0x1e,
-	0x60, 0x95, 0x0c, 0x15, 0x24, 0xe4, 0x1a, 0x1b, 0x38, 0x05, 0x3b, 0x86, 0x5e, 0xeb, 0xea, 0x83,
-	0x00, 0x91, 0x2c, 0x4a, 0x2c, 0x11, 0xdb, 0x51, 0x9a, 0x8c, 0x37, 0x82, 0xbb, 0x12, 0x86, 0x95,
-	0x7a, 0xd9, 0x17, 0xd8, 0xa1, 0x73, 0x7b, 0x81, 0xa5, 0xcb, 0xc0, 0x89, 0x32, 0xd3, 0xf4, 0x65,
-	0x93, 0x23, 0xf3, 0xdb, 0xc4, 0xcb, 0x40, 0x16, 0x05, 0x58, 0x21, 0x52, 0x60, 0x38, 0x31, 0x52,
-	0xca, 0x70, 0xa2, 0x0b, 0x97, 0x7c, 0x12, 0xfa, 0xfb, 0xb5, 0x7d, 0x93, 0x25, 0x6c, 0xf2, 0x43,
-	0x76, 0xa3, 0x1c, 0x2d, 0x17, 0x6b, 0x08, 0x67, 0x51, 0xe1, 0x3c, 0xfc, 0x09, 0x61, 0x6c, 0xac,
-	0xa7, 0x30, 0xf6, 0x21, 0x18, 0x0f, 0x89, 0xb9, 0xed, 0xda, 0xa6, 0xe1, 0x34, 0xea, 0x22, 0xf0,
-	0x61, 0x2c, 0x57, 0xc4, 0x20, 0xac, 0xd6, 0x43, 0x0b, 0x30, 0xd0, 0xb5, 0x2d, 0x21, 0x8d, 0x7e,
-	0x9b, 0x54, 0x59, 0x37, 0xea, 0x0f, 0x0f, 0xaa, 0xef, 0x8d, 0x2d, 0x11, 0xe4, 0xa8, 0x6e, 0x75,
-	0x76, 0x5a, 0xb7, 0xc2, 0xfd, 0x0e, 0x09, 0xe6, 0x36, 0x1a, 0x75, 0x4c, 0x1b, 0xe7, 0x19, 0x95,
-	0x4c, 0x9c, 0xc0, 0xa8, 0xe4, 0xb3, 0x1a, 0x5c, 0x32, 0xd2, 0xda, 0x76, 0x12, 0xcc, 0x4c, 0x96,
-	0xe7, 0x96, 0xf9, 0x1a, 0xfc, 0x85, 0xeb, 0x62, 0x7c, 0x97, 0xe6, 0xb3, 0xe4, 0x70, 0x5e, 0x1f,
-	0x90, 0x0f, 0xa8, 0x6d, 0xb7, 0x64, 0x92, 0x18, 0xf1, 0xd5, 0xa7, 0xca, 0xe9, 0x11, 0x56, 0x32,
-	0x98, 0x70, 0x0e, 0x76, 0xf4, 0x00, 0xc6, 0xcd, 0x58, 0x27, 0x2f, 0xa4, 0xea, 0xfa, 0x69, 0x3c,
-	0x0a, 0xf0, 0x9b, 0x97, 0xaa, 0xf0, 0x57, 0x29, 0xc9, 0xd7, 0x34, 0xe5, 0xca, 0x2b, 0x5e, 0x94,
-	0xd8, 0xa8, 0xa7, 0xcb, 0xbf, 0xa6, 0xe5, 0x63, 0xc4, 0x3d, 0xa8, 0xb1, 0x08, 0x3f, 0x4e, 0x32,
-	0x97, 0x13, 0x4b, 0x63, 0x5e, 0xd2, 0x2b, 0x38, 0x95, 0x16, 0x8a, 0x2f, 0xcd, 0x54, 0x21, 0x4e,
-	0x13, 0x44, 0x4b, 0x80, 0x08, 0x57, 0xed, 0xc6, 0x17, 0x85, 0x60, 0x06, 0xc9, 0x9c, 0x57, 0x68,
-	0x31, 0x03, 0xc5, 0x39, 0x2d, 0xf4, 0x2f, 0x6a, 0x42, 0xf1, 0x76, 0x8e, 0x56, 0x15, 0x67, 0xfd,
-	0x24, 0xa7, 0xff, 0xb9, 0x06, 0x19, 0x59, 0x1f, 0x6d, 0xc2, 0x08, 0x45, 0x51, 0x5f, 0x6d, 0x8a,
-	0x61, 0x7d, 0xa4, 0xdc, 0xb1, 0xcb, 0x50, 0x70, 0x2d, 0xa6, 0xf8, 0x81, 0x23, 0xc4, 0xf4, 0xf6,
-	0xe0, 0x2a, 0x31, 0x9c, 0xc5, 0x08, 0x4b, 0xc9, 0x35, 0x6a, 0x2c, 0x68, 0x7e, 0x7b, 0x50, 0x4b,
-	0x70, 0x82, 0x8e, 0xbe, 0x0c, 0x10, 0xdf, 0xcf, 0xfa, 0x36, 0xb4, 0xf9, 0xfa, 0x10, 0x5c, 0xe9,
-	0xd7, 0xc5, 0x80, 0xa5, 0x22, 0x22, 0xbb, 0xb6, 0x19, 0xce, 0x6f, 0x85, 0xc4, 0xbf, 0x7f, 0x7f,
-	0x45, 0x26, 0xee, 0x2f, 0x99, 0x0b, 0x89, 0x3d, 0xcc, 0x2d, 0xe6, 0x62, 0xc4, 0x05, 0x94, 0xd8,
-	0xdd, 0x54, 0xa4, 0x46, 0xc6, 0x54, 0x28, 0x65, 0x59, 0xf9, 0x79, 0x9c, 0x14, 0x7e, 0x37, 0x4d,
-	0x03, 0x71, 0xb6, 0x7e, 0x1a, 0xc9, 0xb2, 0xdd, 0xb6, 0x79, 0x4e, 0x18, 0x2d, 0x8b, 0x84, 0x01,
-	0x71, 0xb6, 0xbe, 0x8a, 0x84, 0x7f, 0x29, 0xca, 0x35, 0x86, 0xb2, 0x48, 0x24, 0x10, 0x67, 0xeb,
-	0x23, 0x0b, 0x1e, 0xf5, 0x89, 0xe9, 0xb5, 0xdb, 0xc4, 0xb5, 0x78, 0x96, 0x3f, 0xc3, 0x6f, 0xd9,
-	0xee, 0x92, 0x6f, 0xb0, 0x8a, 0x4c, 0xd5, 0xa7, 0xb1, 0xcc, 0x06, 0x8f, 0xe2, 0x1e, 0xf5, 0x70,
-	0x4f, 0x2c, 0xa8, 0x0d, 0x17, 0x78, 0x4a, 0x21, 0xbf, 0xe1, 0x86, 0xc4, 0xdf, 0x35, 0x1c, 0xa1,
-	0xcf, 0x2b, 0x95, 0xde, 0x78, 0x23, 0x89, 0x0a, 0xa7, 0x71, 0xa3, 0x7d, 0x2a, 0xbf, 0x88, 0xee,
-	0x28, 0x24, 0x47, 0xcb, 0x27, 0xeb, 0xc2, 0x59, 0x74, 0x38, 0x8f, 0x86, 0xfe, 0x59, 0x0d, 0x84,
-	0x45, 0x33, 0x7a, 0x34, 0xf1, 0x66, 0x32, 0x9a, 0x7a, 0x2f, 0x89, 0x72, 0x19, 0x54, 0x72, 0x73,
-	0x19, 0xbc, 0x5f, 0x09, 0xc0, 0x33, 0x16, 0xf3, 0x3e, 0x8e, 0x59, 0xc9, 0xc3, 0xf2, 0x34, 0x8c,
-	0x49, 0x0e, 0x2c, 0x24, 0x63, 0x16, 0x50, 0x33, 0x66, 0xd5, 0x31, 0x5c, 0xff, 0x23, 0x0d, 0x04,
-	0x06, 0x96, 0x35, 0xe8, 0x58, 0xd9, 0x63, 0x8e, 0x34, 0x91, 0x52, 0xb2, 0xde, 0x0c, 0x14, 0x66,
-	0xbd, 0x39, 0xa3, 0x64, 0x30, 0xbf, 0xa6, 0xc1, 0x85, 0x64, 0x44, 0xa4, 0x00, 0xbd, 0x0f, 0x46,
-	0x44, 0xcc, 0x44, 0x11, 0xf4, 0x8c, 0x35, 0x15, 0x41, 0x0b, 0x70, 0x04, 0x4b, 0xaa, 0xd5, 0xfa,
-	0xb8, 0xaa, 0xe6, 0x07, 0x66, 0x3a, 0xe2, 0xd6, 0xf8, 0xc9, 0x69, 0x18, 0xe6, 0x01, 0xf7, 0x28,
-	0x4f, 0xcb, 0x71, 0xd6, 0xbc, 0x57, 0x3e, 0xae, 0x5f, 0x19, 0x0f, 0x3b, 0x35, 0xb6, 0x7d, 0xa5,
-	0x67, 0x6c, 0x7b, 0xcc, 0x93, 0x6c, 0xf5, 0xf1, 0x84, 0x52, 0xc3, 0x0d, 0x91, 0xb5, 0x3b, 0x4a,
-	0xb0, 0x15, 0x26, 0xde, 0x16, 0x06, 0xcb, 0x4b, 0x80, 0x7c, 0x02, 0x94, 0x17, 0x86, 0xa9, 0x9e,
-	0xaf, 0x0b, 0x51, 0x44, 0xb3, 0xa1, 0xf2, 0x26, 0x8b, 0x62, 0xca, 0x8f, 0x11, 0xd1, 0x4c, 0x6e,
-	0xa4, 0xe1, 0xc2, 0x8d, 0xb4, 0x05, 0x23, 0x62, 0x2b, 0x08, 0xe6, 0xf8, 0x91, 0x3e, 0xb2, 0x55,
-	0x29, 0x41, 0x78, 0x79, 0x01, 0x8e, 0x90, 0xd3, 0x13, 0xb7, 0x6d, 0xec, 0xd9, 0xed, 0x6e, 0x9b,
-	0x71, 0xc4, 0x21, 0xb5, 0x2a, 0x2b, 0xc6, 0x11, 0x9c, 0x55, 0xe5, 0x96, 0x9e, 0xec, 0x42, 0xa6,
-	0x56, 0xe5, 0xc5, 0x38, 0x82, 0xa3, 0xd7, 0x60, 0xb4, 0x6d, 0xec, 0x35, 0xbb, 0x7e, 0x8b, 0x88,
-	0x97, 0x85, 0x62, 0x19, 0xaf, 0x1b, 0xda, 0xce, 0x9c, 0xed, 0x86, 0x41, 0xe8, 0xcf, 0x35, 0xdc,
-	0xf0, 0xbe, 0xdf, 0x0c, 0x7d, 0x99, 0xb2, 0x66, 0x45, 0x60, 0xc1, 0x12, 0x1f, 0x72, 0x60, 0xaa,
-	0x6d, 0xec, 0x6d, 0xb8, 0x86, 0x4c, 0x8e, 0x3f, 0x5e, 0x92, 0x02, 0x7b, 0x5e, 0x5e, 0x49, 0xe0,
-	0xc2, 0x29, 0xdc, 0x39, 0x2f, 0xd9, 0x13, 0x67, 0xf5, 0x92, 0x3d, 0x2f, 0xfd, 0x76, 0xf8, 0xfd,
-	0xef, 0x5a, 0xae, 0x3f, 0x7b, 0x4f, 0x9f, 0x9c, 0xd7, 0xa5, 0x4f, 0xce, 0x54, 0xf9, 0xa7, 0xd7,
-	0x1e, 0xfe, 0x38, 0x5d, 0x18, 0xa7, 0x12, 0x36, 0x2f, 0xa5, 0x17, 0xb4, 0xd2, 0xaa, 0xcc, 0xba,
-	0x44, 0xa3, 0x24, 0x5b, 0x8d, 0x51, 0x63, 0x95, 0x0e, 0xba, 0xcf, 0x93, 0xa7, 0x3b, 0x24, 0x8c,
-	0xab, 0x30, 0xc5, 0xc0, 0x34, 0xdb, 0x3f, 0x32, 0xd7, 0x79, 0xa6, 0x02, 0xce, 0x6f, 0x17, 0xc7,
-	0x5e, 0xb9, 0x98, 0x1f, 0x7b, 0x05, 0xfd, 0x44, 0xde, 0x7b, 0x01, 0x62, 0x73, 0xfa, 0x5d, 0xe5,
-	0x79, 0x43, 0xe9, 0x57, 0x83, 0x7f, 0xad, 0xc1, 0x4c, 0xbb, 0x20, 0x2b, 0xa9, 0x78, 0xc6, 0x58,
-	0xef, 0x83, 0x3f, 0x14, 0x66, 0x3a, 0x5d, 0x78, 0xe2, 0xf0, 0xa0, 0x7a, 0x64, 0x3e, 0x54, 0x5c,
-	0xd8, 0x37, 0xe4, 0xc3, 0x48, 0xb0, 0x1f, 0x98, 0xa1, 0x13, 0xcc, 0x5c, 0x2e, 0x9f, 0xfc, 0x52,
-	0x70, 0xd6, 0x26, 0xc7, 0xc4, 0x59, 0x6b, 0x1c, 0xfa, 0x9d, 0x97, 0xe2, 0x88, 0x50, 0xbf, 0xde,
-	0xd9, 0x7d, 0x84, 0x9b, 0x9c, 0xbd, 0x0d, 0x13, 0x6a, 0x27, 0x4f, 0xe4, 0x14, 0xfe, 0x0b, 0x1a,
-	0x4c, 0xa7, 0x0f, 0x2d, 0x35, 0x3f, 0xbd, 0x76, 0xb6, 0xf9, 0xe9, 0x15, 0x3b, 0x9a, 0x4a, 0x0f,
-	0x3b, 0x9a, 0x17, 0xe0, 0x6a, 0xfe, 0x5a, 0xa6, 0x12, 0xa4, 0xe1, 0x38, 0xde, 0x03, 0x71, 0x73,
-	0x8b, 0xb3, 0x42, 0xd1, 0x42, 0xcc, 0x61, 0xfa, 0x0f, 0x42, 0x3a, 0xb8, 0x30, 0x7a, 0x03, 0xc6,
-	0x82, 0x60, 0x9b, 0xc7, 0x8d, 0x14, 0x83, 0x2c, 0x77, 0x65, 0x8f, 0x82, 0x4f, 0x72, 0xa1, 0x57,
-	0xfe, 0xc4, 0x31, 0xfa, 0x85, 0x57, 0xbf, 0xf0, 0xd5, 0x1b, 0xef, 0xf9, 0xe3, 0xaf, 0xde, 0x78,
-	0xcf, 0x97, 0xbf, 0x7a, 0xe3, 0x3d, 0x3f, 0x7c, 0x78, 0x43, 0xfb, 0xc2, 0xe1, 0x0d, 0xed, 0x8f,
-	0x0f, 0x6f, 0x68, 0x5f, 0x3e, 0xbc, 0xa1, 0xfd, 0xe7, 0xc3, 0x1b, 0xda, 0x4f, 0xfe, 0x97, 0x1b,
-	0xef, 0x79, 0xed, 0xd9, 0x98, 0xfa, 0xad, 0x88, 0x68, 0xfc, 0x4f, 0x67, 0xa7, 0x75, 0x8b, 0x52,
-	0x8f, 0x5c, 0x94, 0x18, 0xf5, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x00, 0xd7, 0x75, 0x8b, 0x45,
-	0xeb, 0x00, 0x00,
+	// 13345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x6c, 0x6d, 0xd9,
+	0x59, 0x58, 0xf6, 0x39, 0x3e, 0x7e, 0x7c, 0xf6, 0x7d, 0x78, 0xdd, 0xc7, 0x9c, 0xf1, 0xcc, 0x5c,
+	0xdf, 0xec, 0x19, 0xd2, 0x19, 0x02, 0xbe, 0x99, 0x49, 0x42, 0x92, 0x81, 0xc9, 0xc4, 0x3e, 0xf6,
+	0xbd, 0xd7, 0xb9, 0xf6, 0xbd, 0xce, 0x77, 0xec, 0x99, 0x21, 0xc0, 0xc0, 0xbe, 0xe7, 0x2c, 0x1f,
+	0xef, 0xf1, 0x3e, 0x7b, 0x9f, 0xd9, 0x7b, 0x1f, 0x5f, 0x7b, 0x26, 0xe1, 0xd5, 0x92, 0x92, 0x94,
+	0x50, 0x84, 0x44, 0xa3, 0xf0, 0x28, 0x41, 0x88, 0xd2, 0x96, 0x8a, 0x22, 0x10, 0x54, 0x80, 0x5a,
+	0x51, 0x24, 0x4a, 0x8a, 0xa0, 0x42, 0xd0, 0xaa, 0xa1, 0x2d, 0xa6, 0x31, 0x34, 0x54, 0xad, 0x84,
+	0x90, 0x50, 0x55, 0xf5, 0xb6, 0x82, 0x6a, 0xbd, 0xf6, 0x5e, 0xfb, 0x75, 0x6c, 0xef, 0x63, 0x3b,
+	0x19, 0xc1, 0x2f, 0xfb, 0xac, 0x6f, 0xad, 0xef, 0x5b, 0x6b, 0xed, 0xf5, 0xf8, 0xd6, 0xf7, 0x84,
+	0x85, 0x8e, 0x1d, 0x6e, 0xf5, 0xef, 0xcf, 0xb5, 0xbc, 0xee, 0x8d, 0x8e, 0xe5, 0xb7, 0xa9, 0x4b,
+	0xfd, 0xf8, 0x9f, 0xde, 0x76, 0xe7, 0x86, 0xd5, 0xb3, 0x83, 0x1b, 0x2d, 0xcf, 0xa7, 0x37, 0x76,
+	0x9e, 0xbd, 0x4f, 0x43, 0xeb, 0xd9, 0x1b, 0x1d, 0x06, 0xb3, 0x42, 0xda, 0x9e, 0xeb, 0xf9, 0x5e,
+	0xe8, 0x91, 0xe7, 0x62, 0x1c, 0x73, 0xaa, 0x69, 0xfc, 0x4f, 0x6f, 0xbb, 0x33, 0xc7, 0x70, 0xcc,
+	0x31, 0x1c, 0x73, 0x12, 0xc7, 0xcc, 0xd7, 0xea, 0x74, 0xbd, 0x8e, 0x77, 0x83, 0xa3, 0xba, 0xdf,
+	0xdf, 0xe4, 0xbf, 0xf8, 0x0f, 0xfe, 0x9f, 0x20, 0x31, 0xf3, 0xcc, 0xf6, 0xfb, 0x83, 0x39, 0xdb,
+	0x63, 0x9d, 0xb9, 0x61, 0xf5, 0x43, 0x2f, 0x68, 0x59, 0x8e, 0xed, 0x76, 0x6e, 0xec, 0x64, 0x7a,
+	0x33, 0x63, 0x6a, 0x55, 0x65, 0xb7, 0x07, 0xd6, 0xf1, 0xef, 0x5b, 0xad, 0xbc, 0x3a, 0xef, 0x89,
+	0xeb, 0x74, 0xad, 0xd6, 0x96, 0xed, 0x52, 0x7f, 0x4f, 0x4d, 0xc8, 0x0d, 0x9f, 0x06, 0x5e, 0xdf,
+	0x6f, 0xd1, 0x63, 0xb5, 0x0a, 0x6e, 0x74, 0x69, 0x68, 0xe5, 0xd1, 0xba, 0x51, 0xd4, 0xca, 0xef,
+	0xbb, 0xa1, 0xdd, 0xcd, 0x92, 0xf9, 0xba, 0xc3, 0x1a, 0x04, 0xad, 0x2d, 0xda, 0xb5, 0x32, 0xed,
+	0xde, 0x5d, 0xd4, 0xae, 0x1f, 0xda, 0xce, 0x0d, 0xdb, 0x0d, 0x83, 0xd0, 0x4f, 0x37, 0x32, 0x3f,
+	0x65, 0xc0, 0xc5, 0xf9, 0xb5, 0xe5, 0x26, 0xf5, 0x77, 0xa8, 0xbf, 0xe2, 0x75, 0x3a, 0xb6, 0xdb,
+	0x21, 0xef, 0x84, 0x89, 0x1d, 0xea, 0xdf, 0xf7, 0x02, 0x3b, 0xdc, 0xab, 0x1b, 0xd7, 0x8d, 0xa7,
+	0x6b, 0x0b, 0xe7, 0x0e, 0xf6, 0x67, 0x27, 0x5e, 0x52, 0x85, 0x18, 0xc3, 0xc9, 0x32, 0x5c, 0xda,
+	0x0a, 0xc3, 0xde, 0x7c, 0xab, 0x45, 0x83, 0x20, 0xaa, 0x51, 0xaf, 0xf0, 0x66, 0x8f, 0x1c, 0xec,
+	0xcf, 0x5e, 0xba, 0xbd, 0xbe, 0xbe, 0x96, 0x02, 0x63, 0x5e, 0x1b, 0xf3, 0xe7, 0x0d, 0x98, 0x8e,
+	0x3a, 0x83, 0xf4, 0xf5, 0x3e, 0x0d, 0xc2, 0x80, 0x20, 0x5c, 0xed, 0x5a, 0xbb, 0x77, 0x3d, 0x77,
+	0xb5, 0x1f, 0x5a, 0xa1, 0xed, 0x76, 0x96, 0xdd, 0x4d, 0xc7, 0xee, 0x6c, 0x85, 0xb2, 0x6b, 0x33,
+	0x07, 0xfb, 0xb3, 0x57, 0x57, 0x73, 0x6b, 0x60, 0x41, 0x4b, 0xd6, 0xe9, 0xae, 0xb5, 0x9b, 0x41,
+	0xa8, 0x75, 0x7a, 0x35, 0x0b, 0xc6, 0xbc, 0x36, 0xe6, 0x73, 0x50, 0x9b, 0x6f, 0xb7, 0x3d, 0x97,
+	0x3c, 0x03, 0x63, 0xd4, 0xb5, 0xee, 0x3b, 0xb4, 0xcd, 0x3b, 0x36, 0xbe, 0x70, 0xe1, 0xf3, 0xfb,
+	0xb3, 0x6f, 0x3b, 0xd8, 0x9f, 0x1d, 0x5b, 0x12, 0xc5, 0xa8, 0xe0, 0xe6, 0x0f, 0x55, 0x60, 0x94,
+	0x37, 0x0a, 0xc8, 0x0f, 0x1a, 0x70, 0x69, 0xbb, 0x7f, 0x9f, 0xfa, 0x2e, 0x0d, 0x69, 0xb0, 0x68,
+	0x05, 0x5b, 0xf7, 0x3d, 0xcb, 0x17, 0x28, 0x26, 0x9f, 0xbb, 0x35, 0x77, 0xfc, 0xfd, 0x37, 0x77,
+	0x27, 0x8b, 0x4e, 0x8c, 0x29, 0x07, 0x80, 0x79, 0xc4, 0xc9, 0x0e, 0x4c, 0xb9, 0x1d, 0xdb, 0xdd,
+	0x5d, 0x76, 0x3b, 0x3e, 0x0d, 0x02, 0x3e, 0x2f, 0x93, 0xcf, 0x7d, 0xa8, 0x4c, 0x67, 0xee, 0x6a,
+	0x78, 0x16, 0x2e, 0x1e, 0xec, 0xcf, 0x4e, 0xe9, 0x25, 0x98, 0xa0, 0x63, 0xfe, 0xa5, 0x01, 0x17,
+	0xe6, 0xdb, 0x5d, 0x3b, 0x08, 0x6c, 0xcf, 0x5d, 0x73, 0xfa, 0x1d, 0xdb, 0x25, 0xd7, 0x61, 0xc4,
+	0xb5, 0xba, 0x94, 0x4f, 0xc8, 0xc4, 0xc2, 0x94, 0x9c, 0xd3, 0x91, 0xbb, 0x56, 0x97, 0x22, 0x87,
+	0x90, 0x8f, 0xc0, 0x68, 0xcb, 0x73, 0x37, 0xed, 0x8e, 0xec, 0xe7, 0xd7, 0xce, 0x89, 0x9d, 0x30,
+	0xa7, 0xef, 0x04, 0xde, 0x3d, 0xb9, 0x83, 0xe6, 0xd0, 0x7a, 0xb0, 0xb4, 0x1b, 0x52, 0x97, 0x91,
+	0x59, 0x80, 0x83, 0xfd, 0xd9, 0xd1, 0x06, 0x47, 0x80, 0x12, 0x11, 0x79, 0x1a, 0xc6, 0xdb, 0x76,
+	0x20, 0x3e, 0x66, 0x95, 0x7f, 0xcc, 0xa9, 0x83, 0xfd, 0xd9, 0xf1, 0x45, 0x59, 0x86, 0x11, 0x94,
+	0xac, 0xc0, 0x65, 0x36, 0x83, 0xa2, 0x5d, 0x93, 0xb6, 0x7c, 0x1a, 0xb2, 0xae, 0xd5, 0x47, 0x78,
+	0x77, 0xeb, 0x07, 0xfb, 0xb3, 0x97, 0xef, 0xe4, 0xc0, 0x31, 0xb7, 0x95, 0x79, 0x13, 0xc6, 0xe7,
+	0x1d, 0xea, 0xb3, 0x05, 0x46, 0x9e, 0x87, 0xf3, 0xb4, 0x6b, 0xd9, 0x0e, 0xd2, 0x16, 0xb5, 0x77,
+	0xa8, 0x1f, 0xd4, 0x8d, 0xeb, 0xd5, 0xa7, 0x27, 0x16, 0xc8, 0xc1, 0xfe, 0xec, 0xf9, 0xa5, 0x04,
+	0x04, 0x53, 0x35, 0xcd, 0xef, 0x32, 0x60, 0x72, 0xbe, 0xdf, 0xb6, 0x43, 0x31, 0x2e, 0xe2, 0xc3,
+	0xa4, 0xc5, 0x7e, 0xae, 0x79, 0x8e, 0xdd, 0xda, 0x93, 0x8b, 0xeb, 0xc5, 0x32, 0xdf, 0x73, 0x3e,
+	0x46, 0xb3, 0x70, 0xe1, 0x60, 0x7f, 0x76, 0x52, 0x2b, 0x40, 0x9d, 0x88, 0xb9, 0x05, 0x3a, 0x8c,
+	0x7c, 0x23, 0x4c, 0x89, 0xe1, 0xae, 0x5a, 0x3d, 0xa4, 0x9b, 0xb2, 0x0f, 0x4f, 0x6a, 0xdf, 0x4a,
+	0x11, 0x9a, 0xbb, 0x77, 0xff, 0x35, 0xda, 0x0a, 0x91, 0x6e, 0x52, 0x9f, 0xba, 0x2d, 0x2a, 0x96,
+	0x4d, 0x43, 0x6b, 0x8c, 0x09, 0x54, 0xe6, 0x1f, 0xb1, 0x43, 0x6c, 0xc7, 0xb2, 0x1d, 0xeb, 0xbe,
+	0xed, 0xd8, 0xe1, 0xde, 0x47, 0x3d, 0x97, 0x1e, 0x61, 0xdd, 0x6c, 0xc0, 0x23, 0x7d, 0xd7, 0x12,
+	0xed, 0x1c, 0xba, 0x2a, 0x56, 0xca, 0xfa, 0x5e, 0x8f, 0xb2, 0x05, 0xcf, 0x66, 0xfa, 0xb1, 0x83,
+	0xfd, 0xd9, 0x47, 0x36, 0xf2, 0xab, 0x60, 0x51, 0x5b, 0x76, 0x5e, 0x69, 0xa0, 0x97, 0x3c, 0xa7,
+	0xdf, 0x95, 0x58, 0xab, 0x1c, 0x2b, 0x3f, 0xaf, 0x36, 0x72, 0x6b, 0x60, 0x41, 0x4b, 0xf3, 0xf3,
+	0x15, 0x98, 0x5a, 0xb0, 0x5a, 0xdb, 0xfd, 0xde, 0x42, 0xbf, 0xb5, 0x4d, 0x43, 0xf2, 0x6d, 0x30,
+	0xce, 0x2e, 0x9c, 0xb6, 0x15, 0x5a, 0x72, 0x26, 0xdf, 0x55, 0xb8, 0xea, 0xf9, 0x47, 0x64, 0xb5,
+	0xe3, 0xb9, 0x5d, 0xa5, 0xa1, 0xb5, 0x40, 0xe4, 0x9c, 0x40, 0x5c, 0x86, 0x11, 0x56, 0xb2, 0x09,
+	0x23, 0x41, 0x8f, 0xb6, 0xe4, 0x9e, 0x5a, 0x2c, 0xb3, 0x56, 0xf4, 0x1e, 0x37, 0x7b, 0xb4, 0x15,
+	0x7f, 0x05, 0xf6, 0x0b, 0x39, 0x7e, 0xe2, 0xc2, 0x68, 0x10, 0x5a, 0x61, 0x3f, 0xe0, 0x1b, 0x6d,
+	0xf2, 0xb9, 0x9b, 0x43, 0x53, 0xe2, 0xd8, 0x16, 0xce, 0x4b, 0x5a, 0xa3, 0xe2, 0x37, 0x4a, 0x2a,
+	0xe6, 0x7f, 0x34, 0xe0, 0xa2, 0x5e, 0x7d, 0xc5, 0x0e, 0x42, 0xf2, 0xcd, 0x99, 0xe9, 0x9c, 0x3b,
+	0xda, 0x74, 0xb2, 0xd6, 0x7c, 0x32, 0x2f, 0x4a, 0x72, 0xe3, 0xaa, 0x44, 0x9b, 0x4a, 0x0a, 0x35,
+	0x3b, 0xa4, 0x5d, 0xb1, 0xac, 0x4a, 0x9e, 0xa3, 0x7a, 0x97, 0x17, 0xce, 0x49, 0x62, 0xb5, 0x65,
+	0x86, 0x16, 0x05, 0x76, 0xf3, 0xdb, 0xe0, 0xb2, 0x5e, 0x6b, 0xcd, 0xf7, 0x76, 0xec, 0x36, 0xf5,
+	0xd9, 0x4e, 0x08, 0xf7, 0x7a, 0x99, 0x9d, 0xc0, 0x56, 0x16, 0x72, 0x08, 0x79, 0x07, 0x8c, 0xfa,
+	0xb4, 0x63, 0x7b, 0x2e, 0xff, 0xda, 0x13, 0xf1, 0xdc, 0x21, 0x2f, 0x45, 0x09, 0x35, 0xff, 0x57,
+	0x25, 0x39, 0x77, 0xec, 0x33, 0x92, 0x1d, 0x18, 0xef, 0x49, 0x52, 0x72, 0xee, 0x6e, 0x0f, 0x3b,
+	0x40, 0xd5, 0xf5, 0x78, 0x56, 0x55, 0x09, 0x46, 0xb4, 0x88, 0x0d, 0xe7, 0xd5, 0xff, 0x8d, 0x21,
+	0x8e, 0x7f, 0x7e, 0x9c, 0xae, 0x25, 0x10, 0x61, 0x0a, 0x31, 0x59, 0x87, 0x89, 0x80, 0x1f, 0xd2,
+	0xec, 0xe0, 0xaa, 0x16, 0x1f, 0x5c, 0x4d, 0x55, 0x49, 0x1e, 0x5c, 0xd3, 0xb2, 0xfb, 0x13, 0x11,
+	0x00, 0x63, 0x44, 0xec, 0x92, 0x09, 0x28, 0x6d, 0x6b, 0xd7, 0x05, 0xbf, 0x64, 0x9a, 0xb2, 0x0c,
+	0x23, 0xa8, 0xf9, 0xb9, 0x11, 0x20, 0xd9, 0x25, 0xae, 0xcf, 0x80, 0x28, 0xa9, 0x1b, 0x43, 0xcf,
+	0x80, 0xdc, 0x2d, 0x29, 0xc4, 0xe4, 0x0d, 0x38, 0xe7, 0x58, 0x41, 0x78, 0xaf, 0x47, 0x7d, 0x2b,
+	0x54, 0x0b, 0x65, 0xf2, 0xb9, 0xf9, 0x32, 0x5f, 0x7a, 0x45, 0x47, 0xb4, 0x30, 0x7d, 0xb0, 0x3f,
+	0x7b, 0x2e, 0x51, 0x84, 0x49, 0x52, 0xe4, 0x35, 0x98, 0x60, 0x05, 0x4b, 0xbe, 0xef, 0xf9, 0x72,
+	0xf6, 0x5f, 0x28, 0x4b, 0x97, 0x23, 0x11, 0xdc, 0x6c, 0xf4, 0x13, 0x63, 0xf4, 0xe4, 0xc3, 0x40,
+	0xbc, 0xfb, 0x01, 0x63, 0x40, 0xdb, 0xb7, 0xa8, 0xab, 0x06, 0xcb, 0xbe, 0x4e, 0x75, 0x61, 0x46,
+	0x7e, 0x4d, 0x72, 0x2f, 0x53, 0x03, 0x73, 0x5a, 0x91, 0x6d, 0x20, 0x11, 0xbb, 0x1d, 0x2d, 0x80,
+	0x7a, 0xed, 0xe8, 0xcb, 0xe7, 0x2a, 0x23, 0x76, 0x2b, 0x83, 0x02, 0x73, 0xd0, 0x9a, 0xbf, 0x51,
+	0x81, 0x49, 0xb1, 0x44, 0x96, 0xdc, 0xd0, 0xdf, 0x3b, 0x83, 0x0b, 0x82, 0x26, 0x2e, 0x88, 0x46,
+	0xf9, 0x3d, 0xcf, 0x3b, 0x5c, 0x78, 0x3f, 0x74, 0x53, 0xf7, 0xc3, 0xd2, 0xb0, 0x84, 0x06, 0x5f,
+	0x0f, 0xff, 0xc1, 0x80, 0x0b, 0x5a, 0xed, 0x33, 0xb8, 0x1d, 0xda, 0xc9, 0xdb, 0xe1, 0xc5, 0x21,
+	0xc7, 0x57, 0x70, 0x39, 0x78, 0x89, 0x61, 0xf1, 0x83, 0xfb, 0x39, 0x80, 0xfb, 0xfc, 0x38, 0xb9,
+	0x1b, 0xf3, 0x49, 0xd1, 0x27, 0x5f, 0x88, 0x20, 0xa8, 0xd5, 0x4a, 0x9c, 0x59, 0x95, 0x81, 0x67,
+	0xd6, 0x7f, 0xab, 0xc2, 0x74, 0x66, 0xda, 0xb3, 0xe7, 0x88, 0xf1, 0x65, 0x3a, 0x47, 0x2a, 0x5f,
+	0x8e, 0x73, 0xa4, 0x5a, 0xea, 0x1c, 0x39, 0xf2, 0x3d, 0x41, 0x7c, 0x20, 0x5d, 0xbb, 0x23, 0x9a,
+	0x35, 0x43, 0xcb, 0x0f, 0xd7, 0xed, 0x2e, 0x95, 0x27, 0xce, 0x57, 0x1f, 0x6d, 0xc9, 0xb2, 0x16,
+	0xe2, 0xe0, 0x59, 0xcd, 0x60, 0xc2, 0x1c, 0xec, 0xe6, 0xef, 0x8d, 0x00, 0x34, 0xe6, 0xd1, 0x0b,
+	0x45, 0x67, 0x5f, 0x84, 0x5a, 0x6f, 0xcb, 0x0a, 0xd4, 0x7a, 0x7a, 0x46, 0x2d, 0xc6, 0x35, 0x56,
+	0xf8, 0x70, 0x7f, 0xb6, 0xde, 0xf0, 0x69, 0x9b, 0xba, 0xa1, 0x6d, 0x39, 0x81, 0x6a, 0xc4, 0x61,
+	0x28, 0xda, 0xb1, 0x31, 0xb0, 0x69, 0x6c, 0x78, 0xdd, 0x9e, 0x43, 0x19, 0x94, 0x8f, 0xa1, 0x52,
+	0x6e, 0x0c, 0x2b, 0x19, 0x4c, 0x98, 0x83, 0x5d, 0xd1, 0x5c, 0x76, 0xed, 0xd0, 0xb6, 0x22, 0x9a,
+	0xd5, 0xf2, 0x34, 0x93, 0x98, 0x30, 0x07, 0x3b, 0xf9, 0x94, 0x01, 0x33, 0xc9, 0xe2, 0x9b, 0xb6,
+	0x6b, 0x07, 0x5b, 0xb4, 0xbd, 0x6e, 0xcb, 0x0f, 0x7d, 0x3c, 0xe2, 0xd7, 0x0e, 0xf6, 0x67, 0x67,
+	0x56, 0x0a, 0x31, 0xe2, 0x00, 0x6a, 0xe4, 0xd3, 0x06, 0x3c, 0x96, 0x9a, 0x17, 0xdf, 0xee, 0x74,
+	0xa8, 0x4f, 0xdb, 0x25, 0x97, 0xd0, 0xec, 0xc1, 0xfe, 0xec, 0x63, 0x2b, 0xc5, 0x28, 0x71, 0x10,
+	0x3d, 0xf3, 0xd7, 0x0d, 0xa8, 0x36, 0x70, 0x99, 0xbc, 0x33, 0xf1, 0x88, 0x7b, 0x44, 0x7f, 0xc4,
+	0x3d, 0xdc, 0x9f, 0x1d, 0x6b, 0xe0, 0xb2, 0xf6, 0x9e, 0xfb, 0xb4, 0x01, 0xd3, 0x2d, 0xcf, 0x0d,
+	0x2d, 0xd6, 0x2f, 0x14, 0x9c, 0x8e, 0x3a, 0x55, 0x4b, 0xbd, 0x5f, 0x1a, 0x29, 0x64, 0x0b, 0x8f,
+	0xca, 0x0e, 0x4c, 0xa7, 0x21, 0x01, 0x66, 0x29, 0x9b, 0x5f, 0x30, 0x60, 0xaa, 0xe1, 0x78, 0xfd,
+	0xf6, 0x9a, 0xef, 0x6d, 0xda, 0x0e, 0x7d, 0x6b, 0x3c, 0xda, 0xf4, 0x1e, 0x17, 0x5d, 0xca, 0xfc,
+	0x11, 0xa5, 0x57, 0x7c, 0x8b, 0x3c, 0xa2, 0xf4, 0x2e, 0x17, 0xdc, 0x93, 0x3f, 0x34, 0x96, 0x1c,
+	0x19, 0xbf, 0x29, 0x9f, 0x86, 0xf1, 0x96, 0xb5, 0xd0, 0x77, 0xdb, 0x4e, 0xf4, 0x8a, 0x62, 0xbd,
+	0x6c, 0xcc, 0x8b, 0x32, 0x8c, 0xa0, 0xe4, 0x0d, 0x80, 0x58, 0xa0, 0x56, 0xaf, 0x94, 0x7f, 0xd1,
+	0xc6, 0xb2, 0xba, 0x26, 0x0d, 0x43, 0xdb, 0xed, 0x04, 0xf1, 0xa7, 0x8f, 0x61, 0xa8, 0x51, 0x23,
+	0x1f, 0x87, 0x73, 0x72, 0x92, 0x97, 0xbb, 0x56, 0x47, 0xca, 0x1b, 0x4a, 0xce, 0xd4, 0xaa, 0x86,
+	0x68, 0xe1, 0x8a, 0x24, 0x7c, 0x4e, 0x2f, 0x0d, 0x30, 0x49, 0x8d, 0xec, 0xc1, 0x54, 0x57, 0x97,
+	0xa1, 0x8c, 0x94, 0x67, 0x67, 0x34, 0x79, 0xca, 0xc2, 0x65, 0x49, 0x7c, 0x2a, 0x21, 0x7d, 0x49,
+	0x90, 0xca, 0x79, 0x0a, 0xd6, 0x4e, 0xeb, 0x29, 0x48, 0x61, 0x4c, 0x3c, 0x86, 0x83, 0xfa, 0x28,
+	0x1f, 0xe0, 0xf3, 0x65, 0x06, 0x28, 0xde, 0xd5, 0xb1, 0x84, 0x58, 0xfc, 0x0e, 0x50, 0xe1, 0x66,
+	0x12, 0x58, 0x76, 0xab, 0x37, 0xa9, 0x43, 0x5b, 0xa1, 0xe7, 0xd7, 0xc7, 0xca, 0x4b, 0x60, 0x9b,
+	0x1a, 0x1e, 0x21, 0x4a, 0xd3, 0x4b, 0x30, 0x41, 0x27, 0x92, 0x15, 0x8c, 0x17, 0xca, 0x0a, 0xfa,
+	0x30, 0xb9, 0xa3, 0xc9, 0xb4, 0x26, 0xf8, 0x24, 0x7c, 0xb0, 0x4c, 0xc7, 0x62, 0x01, 0xd7, 0xc2,
+	0x25, 0x49, 0x68, 0x52, 0x17, 0x86, 0xe9, 0x74, 0xcc, 0x9f, 0x9d, 0x84, 0xe9, 0x86, 0xd3, 0x0f,
+	0x42, 0xea, 0xcf, 0x4b, 0x25, 0x11, 0xf5, 0xc9, 0x77, 0x1b, 0x70, 0x95, 0xff, 0xbb, 0xe8, 0x3d,
+	0x70, 0x17, 0xa9, 0x63, 0xed, 0xcd, 0x6f, 0xb2, 0x1a, 0xed, 0xf6, 0xf1, 0x4e, 0xa0, 0xc5, 0xbe,
+	0xe4, 0x22, 0xb9, 0x70, 0xae, 0x99, 0x8b, 0x11, 0x0b, 0x28, 0x91, 0xbf, 0x67, 0xc0, 0xa3, 0x39,
+	0xa0, 0x45, 0xea, 0xd0, 0x50, 0x71, 0x2e, 0xc7, 0xed, 0xc7, 0x13, 0x07, 0xfb, 0xb3, 0x8f, 0x36,
+	0x8b, 0x90, 0x62, 0x31, 0x3d, 0xf2, 0xfd, 0x06, 0xcc, 0xe4, 0x40, 0x6f, 0x5a, 0xb6, 0xd3, 0xf7,
+	0x15, 0x53, 0x73, 0xdc, 0xee, 0x70, 0xde, 0xa2, 0x59, 0x88, 0x15, 0x07, 0x50, 0x24, 0xdf, 0x01,
+	0x57, 0x22, 0xe8, 0x86, 0xeb, 0x52, 0xda, 0x4e, 0xb0, 0x38, 0xc7, 0xed, 0xca, 0xa3, 0x07, 0xfb,
+	0xb3, 0x57, 0x9a, 0x79, 0x08, 0x31, 0x9f, 0x0e, 0xe9, 0xc0, 0x13, 0x31, 0x20, 0xb4, 0x1d, 0xfb,
+	0x0d, 0xc1, 0x85, 0x6d, 0xf9, 0x34, 0xd8, 0xf2, 0x9c, 0x36, 0x3f, 0x2c, 0x8c, 0x85, 0xb7, 0x1f,
+	0xec, 0xcf, 0x3e, 0xd1, 0x1c, 0x54, 0x11, 0x07, 0xe3, 0x21, 0x6d, 0x98, 0x0a, 0x5a, 0x96, 0xbb,
+	0xec, 0x86, 0xd4, 0xdf, 0xb1, 0x9c, 0xfa, 0x68, 0xa9, 0x01, 0x8a, 0x2d, 0xaa, 0xe1, 0xc1, 0x04,
+	0x56, 0xf2, 0x7e, 0x18, 0xa7, 0xbb, 0x3d, 0xcb, 0x6d, 0x53, 0x71, 0x2c, 0x4c, 0x2c, 0x3c, 0xce,
+	0x2e, 0xa3, 0x25, 0x59, 0xf6, 0x70, 0x7f, 0x76, 0x4a, 0xfd, 0xbf, 0xea, 0xb5, 0x29, 0x46, 0xb5,
+	0xc9, 0xc7, 0xe0, 0x32, 0xd7, 0x87, 0xb5, 0x29, 0x3f, 0xe4, 0x02, 0xc5, 0xe8, 0x8e, 0x97, 0xea,
+	0x27, 0xd7, 0x6d, 0xac, 0xe6, 0xe0, 0xc3, 0x5c, 0x2a, 0xec, 0x33, 0x74, 0xad, 0xdd, 0x5b, 0xbe,
+	0xd5, 0xa2, 0x9b, 0x7d, 0x67, 0x9d, 0xfa, 0x5d, 0xdb, 0x15, 0x6f, 0x09, 0xa6, 0x07, 0x69, 0xb3,
+	0xa3, 0x84, 0x69, 0xdf, 0xf8, 0x67, 0x58, 0x1d, 0x54, 0x11, 0x07, 0xe3, 0x21, 0xef, 0x81, 0x29,
+	0xbb, 0xe3, 0x7a, 0x3e, 0x5d, 0xb7, 0x6c, 0x37, 0x0c, 0xea, 0xc0, 0xc5, 0xee, 0x7c, 0x5a, 0x97,
+	0xb5, 0x72, 0x4c, 0xd4, 0x22, 0x3b, 0x40, 0x5c, 0xfa, 0x60, 0xcd, 0x6b, 0xf3, 0x25, 0xb0, 0xd1,
+	0xe3, 0x0b, 0xb9, 0x3e, 0x59, 0x6a, 0x6a, 0xf8, 0x3b, 0xe0, 0x6e, 0x06, 0x1b, 0xe6, 0x50, 0x20,
+	0x37, 0x81, 0x74, 0xad, 0xdd, 0xa5, 0x6e, 0x2f, 0xdc, 0x5b, 0xe8, 0x3b, 0xdb, 0xf2, 0xd4, 0x98,
+	0xe2, 0x73, 0x21, 0xde, 0x61, 0x19, 0x28, 0xe6, 0xb4, 0x20, 0x16, 0x3c, 0x26, 0xc6, 0xb3, 0x68,
+	0xd1, 0xae, 0xe7, 0x06, 0x34, 0x0c, 0xb4, 0x45, 0x5a, 0x3f, 0xc7, 0xb5, 0x58, 0x9c, 0x2b, 0x5f,
+	0x2e, 0xae, 0x86, 0x83, 0x70, 0x24, 0xf5, 0xc2, 0xe7, 0x07, 0xeb, 0x85, 0xcd, 0xfd, 0x2a, 0x4c,
+	0x34, 0x3c, 0xb7, 0x6d, 0xf3, 0xa6, 0xcf, 0x26, 0x64, 0xd0, 0x4f, 0xe8, 0xf7, 0xca, 0xc3, 0xfd,
+	0xd9, 0x73, 0x51, 0x45, 0xed, 0xa2, 0xf9, 0x40, 0x24, 0xf8, 0x11, 0x82, 0x86, 0xb7, 0x27, 0x25,
+	0x36, 0x0f, 0xf7, 0x67, 0x2f, 0x44, 0xcd, 0x92, 0x42, 0x1c, 0xf6, 0x2d, 0xd9, 0xeb, 0x62, 0xdd,
+	0xb7, 0xdc, 0xc0, 0x1e, 0xe2, 0x3d, 0x17, 0xbd, 0xd4, 0x57, 0x32, 0xd8, 0x30, 0x87, 0x02, 0x79,
+	0x0d, 0xce, 0xb3, 0xd2, 0x8d, 0x5e, 0xdb, 0x0a, 0x69, 0xc9, 0x67, 0xdc, 0x55, 0x49, 0xf3, 0xfc,
+	0x4a, 0x02, 0x13, 0xa6, 0x30, 0x0b, 0x99, 0xbd, 0x15, 0x78, 0x6e, 0xbd, 0x96, 0x96, 0xd9, 0x5b,
+	0x81, 0x90, 0xd9, 0x5b, 0x81, 0x50, 0x4b, 0x77, 0x69, 0x10, 0x58, 0x1d, 0xca, 0xcf, 0xa3, 0x89,
+	0x98, 0xe9, 0x58, 0x15, 0xc5, 0xa8, 0xe0, 0xe4, 0x6b, 0xa0, 0xd6, 0xf2, 0xda, 0x34, 0xa8, 0x8f,
+	0xf1, 0x1d, 0xc3, 0x56, 0x5f, 0xad, 0xc1, 0x0a, 0x1e, 0xee, 0xcf, 0x4e, 0x70, 0xb9, 0x06, 0xfb,
+	0x85, 0xa2, 0x92, 0xf9, 0xe3, 0xec, 0x0d, 0x90, 0x7a, 0xf4, 0x1c, 0x41, 0xd7, 0x70, 0x76, 0x62,
+	0x7b, 0xf3, 0x33, 0xec, 0x01, 0xe6, 0xb9, 0xa1, 0xef, 0x39, 0x6b, 0x8e, 0xe5, 0x52, 0xf2, 0x09,
+	0x03, 0x2e, 0x6e, 0xd9, 0x9d, 0x2d, 0x5d, 0x59, 0x58, 0x37, 0xca, 0xbf, 0x95, 0x6e, 0xa7, 0x70,
+	0x2d, 0x5c, 0x3e, 0xd8, 0x9f, 0xbd, 0x98, 0x2e, 0xc5, 0x0c, 0x4d, 0xf3, 0x93, 0x15, 0xb8, 0x2c,
+	0x7b, 0xe6, 0xb0, 0x9b, 0xbb, 0xe7, 0x78, 0x7b, 0x5d, 0xea, 0x9e, 0x85, 0x5e, 0x4f, 0x7d, 0xa1,
+	0x4a, 0xe1, 0x17, 0xea, 0x66, 0xbe, 0x50, 0xb5, 0xcc, 0x17, 0x8a, 0x16, 0xf2, 0x21, 0x5f, 0xe9,
+	0x4f, 0x0d, 0xa8, 0xe7, 0xcd, 0xc5, 0x19, 0xbc, 0x29, 0xbb, 0xc9, 0x37, 0xe5, 0xed, 0xb2, 0x42,
+	0x82, 0x74, 0xd7, 0x0b, 0xde, 0x96, 0x5f, 0xaa, 0xc0, 0xd5, 0xb8, 0xfa, 0xb2, 0x1b, 0x84, 0x96,
+	0xe3, 0x88, 0xa3, 0xf5, 0xf4, 0xbf, 0x7b, 0x2f, 0x21, 0x1a, 0xb8, 0x3b, 0xdc, 0x50, 0xf5, 0xbe,
+	0x17, 0x4a, 0xee, 0x77, 0x53, 0x92, 0xfb, 0xb5, 0x13, 0xa4, 0x39, 0x58, 0x88, 0xff, 0x3f, 0x0d,
+	0x98, 0xc9, 0x6f, 0x78, 0x06, 0x8b, 0xca, 0x4b, 0x2e, 0xaa, 0x0f, 0x9f, 0xdc, 0xa8, 0x0b, 0x96,
+	0xd5, 0xcf, 0x57, 0x8a, 0x46, 0xcb, 0x85, 0x17, 0x9b, 0x70, 0xc1, 0xa7, 0x1d, 0x3b, 0x08, 0xa5,
+	0x88, 0xf9, 0x78, 0xb6, 0x17, 0x4a, 0xe6, 0x76, 0x01, 0x93, 0x38, 0x30, 0x8d, 0x94, 0xdc, 0x85,
+	0x31, 0xf6, 0x94, 0x64, 0xf8, 0x2b, 0x47, 0xc7, 0x1f, 0xdd, 0x46, 0x4d, 0xd1, 0x16, 0x15, 0x12,
+	0xf2, 0xcd, 0x70, 0xae, 0x1d, 0xed, 0xa8, 0x43, 0x14, 0xaf, 0x69, 0xac, 0x5c, 0x19, 0xb0, 0xa8,
+	0xb7, 0xc6, 0x24, 0x32, 0xf3, 0xff, 0x19, 0xf0, 0xf8, 0xa0, 0xb5, 0x45, 0x5e, 0x07, 0x68, 0x29,
+	0xf6, 0x42, 0x98, 0xde, 0x94, 0x54, 0x17, 0x44, 0x4c, 0x4a, 0xbc, 0x41, 0xa3, 0xa2, 0x00, 0x35,
+	0x22, 0x39, 0xfa, 0xdc, 0xca, 0x29, 0xe9, 0x73, 0x53, 0x47, 0x91, 0xfe, 0x6d, 0xdf, 0x6a, 0x47,
+	0x91, 0xde, 0xf7, 0xb3, 0x3a, 0x8a, 0x12, 0x34, 0x07, 0x1f, 0x45, 0xbf, 0x53, 0x85, 0xeb, 0xf9,
+	0x0d, 0xb5, 0x5b, 0xff, 0x43, 0x30, 0xda, 0x13, 0x96, 0x59, 0x55, 0x7e, 0x2b, 0x3f, 0xcd, 0x10,
+	0x09, 0xbb, 0xa9, 0x87, 0xfb, 0xb3, 0x33, 0x79, 0x57, 0x8c, 0x80, 0xa2, 0x6c, 0x47, 0xec, 0x94,
+	0xbc, 0x48, 0xf0, 0x9d, 0xef, 0x3e, 0xe2, 0xb1, 0x66, 0xdd, 0xa7, 0xce, 0x91, 0x45, 0x44, 0xdf,
+	0x65, 0xc0, 0xf9, 0xc4, 0x5e, 0x0a, 0xea, 0xb5, 0xeb, 0xd5, 0xb2, 0x4a, 0xbc, 0xc4, 0x26, 0x8d,
+	0x79, 0x86, 0x44, 0x71, 0x80, 0x29, 0x82, 0x64, 0x17, 0xc6, 0xd8, 0x84, 0x78, 0xfd, 0x50, 0x3e,
+	0xb2, 0x3f, 0x72, 0x72, 0x1f, 0x14, 0x05, 0xe2, 0x85, 0x49, 0x2e, 0x98, 0x13, 0x3f, 0x50, 0x91,
+	0x4b, 0x5d, 0x2d, 0x7a, 0xbb, 0xb7, 0xdc, 0xd5, 0xa2, 0x77, 0xbe, 0xe0, 0x6a, 0xf9, 0x82, 0x01,
+	0x4f, 0x0c, 0x9c, 0x25, 0xf2, 0x26, 0x90, 0x96, 0xe5, 0x5a, 0xfe, 0x9e, 0xbe, 0x62, 0xea, 0x46,
+	0xf9, 0xe5, 0xc7, 0xdf, 0xbc, 0x8d, 0x0c, 0x4a, 0xcc, 0x21, 0xc3, 0x4c, 0x24, 0xbb, 0xd6, 0xae,
+	0x66, 0x4b, 0x27, 0x2d, 0x78, 0xf9, 0x09, 0xb8, 0x9a, 0x80, 0x60, 0xaa, 0xa6, 0xf9, 0x3f, 0xaa,
+	0xf0, 0xe4, 0xc0, 0xa1, 0xc9, 0x7b, 0xe0, 0x83, 0xfa, 0x6a, 0xd7, 0x34, 0xe5, 0x39, 0x4b, 0x95,
+	0x41, 0x31, 0x55, 0x9b, 0x2c, 0x2b, 0x85, 0xa8, 0x60, 0xb8, 0xdf, 0x9d, 0x56, 0x88, 0x9a, 0x03,
+	0x3b, 0x91, 0x50, 0x8d, 0x3e, 0x09, 0x35, 0xb6, 0x13, 0xc5, 0x21, 0x56, 0x8b, 0x3f, 0x19, 0x9b,
+	0x93, 0x00, 0x05, 0x8c, 0xbc, 0x1f, 0xa6, 0xfa, 0xfc, 0x95, 0xd8, 0xe6, 0xc5, 0xfc, 0x24, 0xa8,
+	0xc5, 0x52, 0xf4, 0x0d, 0x0d, 0x86, 0x89, 0x9a, 0x6c, 0xa4, 0xd1, 0xf4, 0x88, 0xb6, 0x35, 0x21,
+	0x85, 0x50, 0x23, 0x9d, 0x4f, 0x40, 0x31, 0x55, 0x9b, 0x3c, 0x0b, 0x93, 0x9b, 0x96, 0xed, 0x28,
+	0xc2, 0xa3, 0xfc, 0x11, 0xc9, 0x6d, 0x44, 0x6f, 0xc6, 0xc5, 0xa8, 0xd7, 0x21, 0x9b, 0x99, 0x07,
+	0xf3, 0xd8, 0xb1, 0x1f, 0xcc, 0xe4, 0xf0, 0xc7, 0xb2, 0xf9, 0xa3, 0x95, 0xa2, 0x5d, 0xcb, 0x59,
+	0xa4, 0x07, 0x30, 0xa1, 0xac, 0xfe, 0xd5, 0x55, 0x7f, 0x73, 0xd8, 0xbd, 0x25, 0xd0, 0xc5, 0x26,
+	0x60, 0xaa, 0x24, 0xc0, 0x98, 0x16, 0xf9, 0x3b, 0x06, 0x40, 0xbc, 0x5e, 0xe4, 0x85, 0xb8, 0x7e,
+	0x72, 0xdb, 0x5a, 0x7b, 0x92, 0x9c, 0x67, 0xd7, 0x71, 0xfc, 0x1b, 0x35, 0xba, 0xe6, 0x3f, 0x4c,
+	0x30, 0x43, 0xd9, 0xdb, 0x8d, 0x7c, 0x7b, 0x7c, 0xde, 0x8a, 0xad, 0xfd, 0xf2, 0x89, 0x9f, 0xb7,
+	0xf2, 0x1e, 0xcd, 0x3f, 0x75, 0xff, 0x4f, 0x15, 0x48, 0x76, 0x72, 0xd9, 0x5b, 0x76, 0xdb, 0x76,
+	0xdb, 0x69, 0x69, 0xc3, 0x1d, 0xdb, 0x6d, 0x23, 0x87, 0x1c, 0xe1, 0xb5, 0xfb, 0x02, 0x5c, 0xe8,
+	0x38, 0xde, 0x7d, 0xcb, 0x71, 0xf6, 0xa4, 0x9d, 0xbe, 0xb4, 0xf8, 0xbe, 0xc4, 0xb8, 0xde, 0x5b,
+	0x49, 0x10, 0xa6, 0xeb, 0x92, 0x1e, 0x5c, 0xf4, 0x99, 0xdc, 0xb1, 0x65, 0x3b, 0x7c, 0xa9, 0xb1,
+	0x29, 0x2a, 0x27, 0xd8, 0xe6, 0xb2, 0x03, 0x4c, 0xe1, 0xc2, 0x0c, 0x76, 0xf2, 0x55, 0x30, 0xd6,
+	0xf3, 0xed, 0xae, 0xe5, 0xef, 0xf1, 0xfd, 0x39, 0x2e, 0xa6, 0x6c, 0x4d, 0x14, 0xa1, 0x82, 0x91,
+	0x8f, 0xc1, 0x84, 0x63, 0x6f, 0xd2, 0xd6, 0x5e, 0xcb, 0xa1, 0xf2, 0x92, 0xbc, 0x77, 0x32, 0x6b,
+	0x7a, 0x45, 0xa1, 0x95, 0xf6, 0x2f, 0xea, 0x27, 0xc6, 0x04, 0x99, 0x83, 0xc5, 0x03, 0xcf, 0xdf,
+	0xa6, 0xbe, 0x43, 0x83, 0xa0, 0xd9, 0xef, 0xf5, 0x3c, 0x3f, 0xa4, 0x6d, 0xbe, 0xbb, 0xc7, 0x85,
+	0x33, 0xc2, 0xcb, 0x59, 0x30, 0xe6, 0xb5, 0x31, 0x3f, 0x55, 0x81, 0xc7, 0x06, 0x74, 0x82, 0x20,
+	0x4c, 0x44, 0x73, 0x24, 0x57, 0xc2, 0x7b, 0xc4, 0x86, 0x93, 0x85, 0x0f, 0xf7, 0x67, 0x9f, 0x1c,
+	0x80, 0xa0, 0xc9, 0xd6, 0x21, 0xed, 0xec, 0x61, 0x8c, 0x86, 0x2c, 0xc3, 0x68, 0x3b, 0x56, 0xdf,
+	0x4c, 0x2c, 0x3c, 0xcb, 0x18, 0x32, 0x21, 0x68, 0x3d, 0x2a, 0x36, 0x89, 0x80, 0xac, 0xc0, 0x98,
+	0xb0, 0x9a, 0xa1, 0x92, 0xb9, 0x7b, 0x8e, 0xcb, 0xde, 0x44, 0xd1, 0x51, 0x91, 0x29, 0x14, 0xe6,
+	0xff, 0x36, 0x60, 0xac, 0xc1, 0x04, 0xb4, 0x77, 0x9b, 0x64, 0x8f, 0x19, 0xf5, 0x47, 0xfe, 0x52,
+	0x72, 0x63, 0x96, 0x3c, 0xb7, 0x38, 0xc6, 0xf9, 0x18, 0x9b, 0xb2, 0xed, 0x8f, 0x0a, 0x50, 0xa7,
+	0x45, 0x5e, 0x67, 0x73, 0xfe, 0xc0, 0xb7, 0x43, 0x46, 0x78, 0x18, 0x63, 0x03, 0x41, 0x18, 0x15,
+	0x2e, 0xb1, 0xa2, 0xa2, 0x9f, 0x18, 0x53, 0x31, 0xd7, 0x80, 0xc8, 0xda, 0x5a, 0xaf, 0xc8, 0xf3,
+	0x30, 0xd2, 0xf5, 0xda, 0xea, 0xbb, 0xbf, 0x43, 0xed, 0x6f, 0xa6, 0xf8, 0x78, 0xb8, 0x3f, 0x7b,
+	0x35, 0xdb, 0x82, 0x41, 0x90, 0xb7, 0x31, 0xef, 0xc2, 0x45, 0x09, 0x8f, 0x08, 0x32, 0x8e, 0xa2,
+	0xe5, 0x75, 0xbb, 0x9e, 0xdb, 0xec, 0x6f, 0x6e, 0xda, 0xbb, 0x34, 0xe1, 0x74, 0xd1, 0x48, 0x40,
+	0x30, 0x55, 0xd3, 0xfc, 0x11, 0x03, 0xaa, 0xec, 0xbb, 0x98, 0x30, 0xda, 0xf6, 0xba, 0x96, 0xed,
+	0xca, 0x5e, 0x71, 0x07, 0x93, 0x45, 0x5e, 0x82, 0x12, 0x42, 0x7a, 0x30, 0xa1, 0x5e, 0x64, 0x43,
+	0x19, 0xfe, 0x2d, 0xde, 0x6d, 0x46, 0xc6, 0xd2, 0xd1, 0x55, 0xa3, 0x4a, 0x02, 0x8c, 0x89, 0x98,
+	0x16, 0x4c, 0x2f, 0xde, 0x6d, 0x2e, 0xbb, 0x2d, 0xa7, 0xdf, 0xa6, 0x4b, 0xbb, 0xfc, 0x0f, 0x3b,
+	0x4b, 0x6c, 0x51, 0x22, 0xc7, 0xc9, 0xcf, 0x12, 0x59, 0x09, 0x15, 0x8c, 0x55, 0xa3, 0xa2, 0x45,
+	0xbd, 0x12, 0x57, 0x93, 0x48, 0x50, 0xc1, 0xcc, 0x2f, 0x54, 0x60, 0x52, 0xeb, 0x10, 0x71, 0x60,
+	0x4c, 0x0c, 0x57, 0x19, 0x26, 0x2f, 0x95, 0x1c, 0x62, 0xb2, 0xd7, 0x82, 0xba, 0x98, 0xd0, 0x00,
+	0x15, 0x09, 0xfd, 0x5c, 0xac, 0x0c, 0x38, 0x17, 0xe7, 0x00, 0x82, 0xd8, 0x4d, 0x47, 0x6c, 0x49,
+	0x7e, 0x37, 0x6a, 0xce, 0x39, 0x5a, 0x0d, 0xf2, 0xb8, 0xbc, 0x41, 0x84, 0xe5, 0xdd, 0x78, 0xea,
+	0xf6, 0xd8, 0x84, 0xda, 0x1b, 0x9e, 0x4b, 0x83, 0x7a, 0xed, 0x24, 0x07, 0x38, 0xc1, 0xb8, 0x3a,
+	0xe6, 0xc5, 0x12, 0xa0, 0x40, 0x6f, 0xfe, 0x84, 0x01, 0xb0, 0x68, 0x85, 0x96, 0xd0, 0x8f, 0x1f,
+	0xc1, 0xb9, 0xe5, 0xf1, 0xc4, 0xc5, 0x37, 0x9e, 0x31, 0xf8, 0x1f, 0x09, 0xec, 0x37, 0xd4, 0xf0,
+	0xa3, 0xd7, 0xba, 0xc0, 0xde, 0xb4, 0xdf, 0xa0, 0xc8, 0xe1, 0x4c, 0xe3, 0x43, 0xdd, 0x96, 0xbf,
+	0xd7, 0x63, 0x87, 0xf7, 0x08, 0x9f, 0x55, 0xbe, 0x43, 0x97, 0x54, 0x21, 0xc6, 0x70, 0xf3, 0x59,
+	0x48, 0x8a, 0x5c, 0x0e, 0xef, 0xa5, 0xf9, 0xc5, 0x11, 0x78, 0x74, 0x69, 0xbd, 0xb1, 0x28, 0xf1,
+	0xd9, 0x9e, 0x7b, 0x87, 0xee, 0xfd, 0x8d, 0x2d, 0xe1, 0xdf, 0xd8, 0x12, 0x9e, 0xa0, 0x2d, 0xe1,
+	0x8b, 0x70, 0x31, 0x5e, 0x5e, 0xd2, 0x8a, 0xe7, 0x9d, 0x69, 0x86, 0x7f, 0x42, 0xdd, 0x3c, 0x59,
+	0x26, 0xdd, 0x7c, 0x68, 0xc0, 0xc5, 0xa5, 0xdd, 0x9e, 0xed, 0x73, 0xaf, 0x2c, 0xea, 0x07, 0xb6,
+	0x50, 0xab, 0xed, 0x88, 0x7f, 0xe5, 0xea, 0x8c, 0x04, 0x99, 0xb2, 0x06, 0x2a, 0x38, 0x7b, 0xe4,
+	0x50, 0xde, 0x9c, 0x73, 0xe4, 0x56, 0x58, 0x66, 0x05, 0x0a, 0xa7, 0xbf, 0x04, 0x16, 0x4c, 0x61,
+	0x25, 0x4d, 0x38, 0xdf, 0x72, 0xac, 0x20, 0xb0, 0x37, 0xed, 0x56, 0x6c, 0x6f, 0x3c, 0xb1, 0xf0,
+	0x4e, 0x7e, 0x77, 0x25, 0x20, 0x0f, 0xf7, 0x67, 0xaf, 0xc8, 0x7e, 0x26, 0x01, 0x98, 0x42, 0x61,
+	0x7e, 0xb6, 0x02, 0xe7, 0x96, 0x76, 0x7b, 0x5e, 0xd0, 0xf7, 0x29, 0xaf, 0x7a, 0x06, 0xf2, 0xc1,
+	0x67, 0x60, 0x6c, 0xcb, 0x62, 0xe6, 0x74, 0x7e, 0xbd, 0x92, 0x9c, 0xdb, 0xdb, 0xa2, 0x18, 0x15,
+	0x9c, 0xbc, 0x09, 0xc0, 0xdc, 0xa1, 0xdb, 0x7d, 0xce, 0x02, 0x89, 0x5d, 0x76, 0xa7, 0xcc, 0x21,
+	0x9c, 0x18, 0x63, 0x33, 0x42, 0x29, 0xaf, 0x86, 0xe8, 0x37, 0x6a, 0xe4, 0xcc, 0x3f, 0x30, 0x60,
+	0x3a, 0xd1, 0xee, 0x0c, 0x44, 0x40, 0x9b, 0x49, 0x11, 0xd0, 0xfc, 0xd0, 0x63, 0x2d, 0x90, 0xfc,
+	0x7c, 0x6f, 0x05, 0x1e, 0x29, 0x98, 0x93, 0x8c, 0x71, 0x9a, 0x71, 0x46, 0xc6, 0x69, 0x7d, 0x98,
+	0x0c, 0x3d, 0x47, 0x9a, 0xc5, 0xab, 0x19, 0x28, 0x65, 0x7a, 0xb6, 0x1e, 0xa1, 0x89, 0x4d, 0xcf,
+	0xe2, 0xb2, 0x00, 0x75, 0x3a, 0xcc, 0x18, 0x79, 0x22, 0x92, 0xae, 0x7f, 0x45, 0x69, 0xb8, 0x8f,
+	0xee, 0xa7, 0x6c, 0xfe, 0x76, 0x05, 0xae, 0x46, 0xb8, 0xd5, 0x31, 0xc7, 0xde, 0xdc, 0x47, 0x79,
+	0x45, 0x3f, 0x2e, 0x2f, 0x72, 0x8d, 0x99, 0xd0, 0x58, 0x0d, 0xc6, 0x78, 0xf5, 0xfd, 0x9e, 0x17,
+	0x28, 0x7e, 0x42, 0x30, 0x5e, 0xa2, 0x08, 0x15, 0x8c, 0xdc, 0x85, 0x5a, 0xc0, 0xe8, 0xd5, 0x47,
+	0xca, 0xcc, 0x06, 0x67, 0x89, 0x78, 0x7f, 0x51, 0xa0, 0x21, 0x6f, 0xea, 0x67, 0x78, 0xad, 0xbc,
+	0x40, 0x94, 0x8d, 0xa4, 0xad, 0x66, 0x24, 0xc7, 0x77, 0x2f, 0xf7, 0x4e, 0x58, 0x81, 0x8b, 0xd2,
+	0xbe, 0x4d, 0x2c, 0x1b, 0xb7, 0x45, 0xc9, 0xfb, 0x13, 0x2b, 0xe3, 0xa9, 0x94, 0x8d, 0xcb, 0xe5,
+	0x74, 0xfd, 0x78, 0xc5, 0x98, 0x01, 0x8c, 0xdf, 0x92, 0x9d, 0x24, 0x33, 0x50, 0xb1, 0xd5, 0xb7,
+	0x00, 0x89, 0xa3, 0xb2, 0xbc, 0x88, 0x15, 0xbb, 0x4d, 0xae, 0x27, 0xbe, 0x43, 0x1e, 0xdb, 0xa7,
+	0x5d, 0x4b, 0xd5, 0xc1, 0xd7, 0x92, 0xf9, 0x27, 0x15, 0xb8, 0xac, 0xa8, 0xaa, 0x31, 0x2e, 0x4a,
+	0x0b, 0x81, 0x43, 0x98, 0xcb, 0xc3, 0xa5, 0x2a, 0xf7, 0x60, 0x84, 0x1f, 0x80, 0xa5, 0x2c, 0x07,
+	0x22, 0x84, 0xac, 0x3b, 0xc8, 0x11, 0x91, 0x8f, 0xc1, 0xa8, 0xc3, 0xe4, 0xc4, 0xca, 0xae, 0xb8,
+	0x94, 0x90, 0x2c, 0x6f, 0xb8, 0x42, 0xfc, 0x1c, 0x08, 0xdf, 0xa9, 0x48, 0x8b, 0x23, 0x0a, 0x51,
+	0xd2, 0x9c, 0xf9, 0x00, 0x4c, 0x6a, 0xd5, 0xc8, 0x45, 0xa8, 0x6e, 0x53, 0x61, 0x39, 0x32, 0x81,
+	0xec, 0x5f, 0x72, 0x19, 0x6a, 0x3b, 0x96, 0xd3, 0x97, 0x53, 0x82, 0xe2, 0xc7, 0xf3, 0x95, 0xf7,
+	0x1b, 0xe6, 0xcf, 0x1a, 0x30, 0x79, 0xdb, 0xbe, 0x4f, 0x7d, 0x61, 0xa4, 0xc6, 0xdf, 0x52, 0x89,
+	0x30, 0x11, 0x93, 0x79, 0x21, 0x22, 0xc8, 0x2e, 0x4c, 0xc8, 0x9b, 0x26, 0xf2, 0x61, 0xb8, 0x55,
+	0xce, 0x44, 0x25, 0x22, 0x2d, 0x4f, 0x70, 0xdd, 0x2d, 0x55, 0x51, 0xc0, 0x98, 0x98, 0xf9, 0x26,
+	0x5c, 0xca, 0x69, 0x44, 0x66, 0xf9, 0xf6, 0xf5, 0x43, 0xb9, 0x2c, 0xd4, 0x7e, 0xf4, 0x43, 0x14,
+	0xe5, 0xe4, 0x51, 0xa8, 0x52, 0xb7, 0x2d, 0xd7, 0xc4, 0xd8, 0xc1, 0xfe, 0x6c, 0x75, 0xc9, 0x6d,
+	0x23, 0x2b, 0x63, 0xc7, 0x94, 0xe3, 0x25, 0x78, 0x12, 0x7e, 0x4c, 0xad, 0xc8, 0x32, 0x8c, 0xa0,
+	0xdc, 0xa8, 0x28, 0x6d, 0x3f, 0xc3, 0xd8, 0xdb, 0x8b, 0x9b, 0xa9, 0xdd, 0x33, 0x8c, 0xd9, 0x4e,
+	0x7a, 0x27, 0x2e, 0xd4, 0xe5, 0x84, 0x64, 0xf6, 0x34, 0x66, 0xe8, 0x9a, 0xdf, 0x57, 0x85, 0x91,
+	0xdb, 0x9e, 0xb7, 0x7d, 0x22, 0xdb, 0xe4, 0x5d, 0x50, 0xeb, 0x79, 0xb6, 0x1b, 0xca, 0x59, 0x99,
+	0x89, 0x5e, 0x38, 0xac, 0x90, 0x59, 0x5d, 0x31, 0x4a, 0xfc, 0x07, 0x8a, 0x8a, 0x39, 0x97, 0xcb,
+	0xc8, 0x69, 0x5d, 0x2e, 0x1b, 0x30, 0x16, 0x4a, 0x89, 0x66, 0xad, 0x94, 0x44, 0x93, 0xaf, 0x6c,
+	0x25, 0xc8, 0x54, 0xb8, 0xc8, 0x0a, 0x9c, 0x93, 0x93, 0x2a, 0xa3, 0x51, 0x08, 0xb3, 0xb4, 0x77,
+	0x30, 0x95, 0xfd, 0x4d, 0x1d, 0xf0, 0x70, 0x7f, 0x76, 0x9a, 0x8d, 0x3f, 0x51, 0x88, 0xc9, 0xc6,
+	0xe6, 0xaf, 0x8c, 0xc0, 0x13, 0xb7, 0x3d, 0xdf, 0x7e, 0xc3, 0x73, 0x43, 0xcb, 0x59, 0xf3, 0xda,
+	0xb1, 0x75, 0xb8, 0x1c, 0xc6, 0xf7, 0x18, 0xf0, 0x48, 0xab, 0xd7, 0x17, 0xaf, 0x15, 0x65, 0xcb,
+	0xb8, 0x46, 0x7d, 0xdb, 0x2b, 0x6b, 0x24, 0xce, 0xe3, 0x42, 0x34, 0xd6, 0x36, 0xf2, 0x50, 0x62,
+	0x11, 0x2d, 0x6e, 0xab, 0xde, 0xf6, 0x1e, 0xb8, 0xbc, 0x73, 0xcd, 0x90, 0x2f, 0xee, 0x37, 0xe2,
+	0x3d, 0x51, 0xd2, 0x56, 0x7d, 0x31, 0x17, 0x23, 0x16, 0x50, 0x62, 0xc6, 0xd8, 0xb6, 0xe8, 0x1c,
+	0x52, 0xab, 0x6d, 0xbb, 0x34, 0x08, 0x84, 0xa1, 0xeb, 0x10, 0xc6, 0xd8, 0xcb, 0x79, 0x08, 0x31,
+	0x9f, 0x0e, 0x79, 0x15, 0x20, 0xd8, 0x73, 0x5b, 0x72, 0xfe, 0xcb, 0xad, 0x2b, 0xc1, 0x93, 0x47,
+	0x58, 0x50, 0xc3, 0xc8, 0x5e, 0x76, 0x61, 0x74, 0x46, 0x8c, 0x72, 0xc3, 0x6e, 0xfe, 0xb2, 0x8b,
+	0xb7, 0x74, 0x0c, 0x37, 0xff, 0x99, 0x01, 0x63, 0x32, 0xf6, 0x0c, 0xb3, 0xa7, 0x4c, 0x48, 0xed,
+	0xa2, 0xab, 0x20, 0x25, 0xb9, 0xdb, 0xe3, 0x76, 0x21, 0x52, 0x62, 0x2b, 0x39, 0xbb, 0x52, 0x62,
+	0x1f, 0x49, 0x38, 0x16, 0xff, 0x26, 0xec, 0x43, 0x64, 0x19, 0x6a, 0xc4, 0xcc, 0xcf, 0x19, 0x30,
+	0x9d, 0x69, 0x75, 0x04, 0xf6, 0xed, 0x0c, 0x4d, 0x2e, 0x7f, 0x7f, 0x04, 0xce, 0x73, 0x4b, 0x75,
+	0xd7, 0x72, 0x84, 0x40, 0xed, 0x0c, 0xde, 0x8b, 0xef, 0x84, 0x09, 0xbb, 0xdb, 0xed, 0x87, 0x5c,
+	0x03, 0x5c, 0x8b, 0xa5, 0x54, 0xcb, 0xaa, 0x10, 0x63, 0x38, 0x71, 0x25, 0x67, 0x22, 0xee, 0xd4,
+	0x95, 0x72, 0x5f, 0x4e, 0x1f, 0xe0, 0x1c, 0xe3, 0x22, 0x04, 0xfb, 0x90, 0xc7, 0xb8, 0x7c, 0xc2,
+	0x00, 0x08, 0x42, 0xdf, 0x76, 0x3b, 0xac, 0x50, 0x72, 0x2f, 0x78, 0x02, 0x64, 0x9b, 0x11, 0x52,
+	0x41, 0x3c, 0x9a, 0xa3, 0x18, 0x80, 0x1a, 0x65, 0x32, 0x2f, 0x6f, 0x23, 0x71, 0xd5, 0x7c, 0x6d,
+	0x8a, 0x3d, 0x7d, 0x22, 0x1b, 0x5a, 0x4d, 0xc6, 0x23, 0x88, 0xaf, 0xab, 0x99, 0xf7, 0xc1, 0x44,
+	0x44, 0xef, 0x30, 0x26, 0x68, 0x4a, 0x63, 0x82, 0x66, 0x5e, 0x80, 0x0b, 0xa9, 0xee, 0x1e, 0x8b,
+	0x87, 0xfa, 0xcf, 0x06, 0x90, 0xe4, 0xe8, 0xcf, 0xe0, 0xa5, 0xdd, 0x49, 0xbe, 0xb4, 0x17, 0x86,
+	0xff, 0x64, 0x05, 0x4f, 0xed, 0x3f, 0x38, 0x0f, 0x3c, 0x34, 0x57, 0x14, 0xfa, 0x4c, 0x5e, 0x5c,
+	0x8c, 0xed, 0x89, 0xdd, 0xfb, 0xe4, 0xce, 0x1d, 0x82, 0xed, 0xb9, 0x93, 0xc2, 0x15, 0xb3, 0x3d,
+	0x69, 0x08, 0x66, 0xe8, 0x92, 0x4f, 0x1a, 0x70, 0xd1, 0x4a, 0x86, 0xe6, 0x52, 0x33, 0x53, 0x2a,
+	0xf4, 0x43, 0x2a, 0xcc, 0x57, 0xdc, 0x97, 0x14, 0x20, 0xc0, 0x0c, 0x59, 0xe6, 0xe0, 0x61, 0xf5,
+	0x6c, 0x16, 0x5c, 0x8a, 0xbd, 0xd4, 0x54, 0x5c, 0x25, 0x2e, 0x3d, 0x98, 0x5f, 0x5b, 0x8e, 0xca,
+	0x31, 0x51, 0x2b, 0x8a, 0x81, 0x95, 0x60, 0x9b, 0xca, 0xc7, 0xc0, 0x92, 0x73, 0x18, 0xc7, 0xc0,
+	0x92, 0x53, 0xa7, 0x13, 0x21, 0x2e, 0x80, 0x67, 0xb7, 0x5b, 0x92, 0xa4, 0xd0, 0xc2, 0x96, 0x12,
+	0x58, 0xdc, 0x5b, 0x5e, 0x6c, 0x48, 0x8a, 0xfc, 0xf6, 0x8b, 0x7f, 0xa3, 0x46, 0x81, 0x7c, 0xc6,
+	0x80, 0x73, 0xf2, 0xec, 0x96, 0x34, 0xc7, 0xf8, 0x27, 0xfa, 0x68, 0xd9, 0xf5, 0x92, 0x5a, 0x93,
+	0x73, 0xa8, 0x23, 0x17, 0xe7, 0x4e, 0xe4, 0x1d, 0x9a, 0x80, 0x61, 0xb2, 0x1f, 0xe4, 0x1f, 0x18,
+	0x70, 0x39, 0xa0, 0xfe, 0x8e, 0xdd, 0xa2, 0xf3, 0xad, 0x96, 0xd7, 0x77, 0xd5, 0x77, 0x18, 0x2f,
+	0x1f, 0x32, 0xa8, 0x99, 0x83, 0x4f, 0xb8, 0x25, 0xe5, 0x41, 0x30, 0x97, 0x3e, 0x63, 0xcb, 0x2e,
+	0x3c, 0xb0, 0xc2, 0xd6, 0x56, 0xc3, 0x6a, 0x6d, 0x71, 0xdd, 0x87, 0xf0, 0x44, 0x2a, 0xb9, 0xae,
+	0x5f, 0x4e, 0xa2, 0x12, 0x56, 0x04, 0xa9, 0x42, 0x4c, 0x13, 0x24, 0x1e, 0x8c, 0xfb, 0x32, 0xde,
+	0x61, 0x1d, 0xca, 0xb3, 0x14, 0x99, 0xe0, 0x89, 0xe2, 0x9d, 0xa5, 0x7e, 0x61, 0x44, 0x84, 0x39,
+	0x63, 0x89, 0x97, 0xe6, 0xbc, 0xeb, 0xb9, 0x7b, 0x5d, 0xaf, 0x1f, 0xcc, 0xf7, 0xc3, 0x2d, 0xea,
+	0x86, 0x4a, 0x74, 0x3c, 0xc9, 0xaf, 0x51, 0xee, 0x8c, 0xb5, 0x34, 0xa8, 0x22, 0x0e, 0xc6, 0x43,
+	0x5e, 0x81, 0x71, 0xba, 0x43, 0xdd, 0x70, 0x7d, 0x7d, 0xa5, 0x3e, 0x75, 0x9c, 0x33, 0x3a, 0xe2,
+	0xf6, 0xf8, 0x10, 0x96, 0x24, 0x0e, 0x8c, 0xb0, 0x91, 0x6d, 0x18, 0x73, 0x44, 0xc0, 0xca, 0xfa,
+	0xb9, 0xf2, 0x87, 0x62, 0x3a, 0xf8, 0xa5, 0x78, 0xb4, 0xc8, 0x1f, 0xa8, 0x28, 0x90, 0x1e, 0x5c,
+	0x6f, 0xd3, 0x4d, 0xab, 0xef, 0x84, 0x77, 0xbd, 0x90, 0xb1, 0xb4, 0x7b, 0xb1, 0xb8, 0x50, 0xf9,
+	0xaf, 0x9d, 0xe7, 0xd1, 0x3d, 0x9e, 0x3a, 0xd8, 0x9f, 0xbd, 0xbe, 0x78, 0x48, 0x5d, 0x3c, 0x14,
+	0x1b, 0xd9, 0x83, 0x27, 0x65, 0x9d, 0x0d, 0xd7, 0xa7, 0x56, 0x6b, 0x8b, 0xcd, 0x72, 0x96, 0xe8,
+	0x05, 0x4e, 0xf4, 0x6f, 0x1d, 0xec, 0xcf, 0x3e, 0xb9, 0x78, 0x78, 0x75, 0x3c, 0x0a, 0x4e, 0xee,
+	0x26, 0x43, 0x53, 0x2a, 0x93, 0xfa, 0xc5, 0xf2, 0x73, 0x9c, 0x56, 0xbf, 0x08, 0x53, 0x97, 0x74,
+	0x29, 0x66, 0x68, 0xce, 0x7c, 0x08, 0x48, 0xf6, 0xc0, 0x39, 0x8c, 0x73, 0x18, 0xd7, 0x39, 0x87,
+	0x1f, 0xae, 0xc1, 0x63, 0xec, 0x1c, 0x8b, 0xf9, 0xe5, 0x55, 0xcb, 0xb5, 0x3a, 0x5f, 0x99, 0x77,
+	0xec, 0xcf, 0x1a, 0xf0, 0xc8, 0x56, 0xfe, 0x5b, 0xb6, 0x5e, 0x29, 0x6f, 0xe6, 0x3a, 0xf0, 0x79,
+	0x2c, 0xb6, 0xf8, 0xc0, 0x2a, 0x58, 0xd4, 0x29, 0xf2, 0x21, 0xb8, 0xe8, 0x7a, 0x6d, 0xda, 0x58,
+	0x5e, 0xc4, 0x55, 0x2b, 0xd8, 0x6e, 0x2a, 0x95, 0x72, 0x4d, 0x7c, 0xe1, 0xbb, 0x29, 0x18, 0x66,
+	0x6a, 0x33, 0x4f, 0xbd, 0x9e, 0xd7, 0x5e, 0xda, 0xb1, 0x5b, 0x4a, 0x99, 0x59, 0xde, 0x80, 0x8a,
+	0x6b, 0x4c, 0xd7, 0x32, 0xd8, 0x30, 0x87, 0x02, 0x7f, 0x8c, 0xb3, 0xce, 0xac, 0x7a, 0xae, 0x1d,
+	0x7a, 0x3e, 0xf7, 0x26, 0x1d, 0xea, 0x4d, 0xca, 0x1f, 0xe3, 0x77, 0x73, 0x31, 0x62, 0x01, 0x25,
+	0xf3, 0xcf, 0x0d, 0xb8, 0xc0, 0x96, 0xc5, 0x9a, 0xef, 0xed, 0xee, 0x7d, 0x25, 0x2e, 0xc8, 0x67,
+	0xa4, 0x75, 0x8d, 0x10, 0x60, 0x5d, 0xd1, 0x2c, 0x6b, 0x26, 0x78, 0x9f, 0x63, 0x63, 0x1a, 0x5d,
+	0xac, 0x59, 0x2d, 0x16, 0x6b, 0x9a, 0x9f, 0xa9, 0x08, 0x5e, 0x57, 0x89, 0x15, 0xbf, 0x22, 0xf7,
+	0xe1, 0xfb, 0xe0, 0x1c, 0x2b, 0x5b, 0xb5, 0x76, 0xd7, 0x16, 0x5f, 0xf2, 0x1c, 0xe5, 0x80, 0xca,
+	0x9d, 0x4a, 0xee, 0xe8, 0x00, 0x4c, 0xd6, 0x23, 0xcf, 0x33, 0x13, 0x14, 0x1e, 0x36, 0x44, 0xbe,
+	0xb2, 0xae, 0x0b, 0x13, 0x14, 0x5e, 0xc4, 0xc4, 0x59, 0xb1, 0x12, 0x4d, 0x16, 0xa2, 0x6a, 0x60,
+	0x7e, 0xfa, 0x0a, 0x70, 0xe4, 0x0e, 0x0d, 0xbf, 0x12, 0xe7, 0xe4, 0x59, 0x98, 0x6c, 0xf5, 0xfa,
+	0x8d, 0x9b, 0xcd, 0x8f, 0xf4, 0x3d, 0xfe, 0x7a, 0xe6, 0x11, 0x8e, 0x19, 0xf3, 0xdb, 0x58, 0xdb,
+	0x50, 0xc5, 0xa8, 0xd7, 0x61, 0xa7, 0x43, 0xab, 0xd7, 0x97, 0xe7, 0xed, 0x9a, 0xee, 0xdf, 0xc0,
+	0x4f, 0x87, 0xc6, 0xda, 0x46, 0x02, 0x86, 0x99, 0xda, 0xe4, 0x3b, 0x60, 0x8a, 0xca, 0x8d, 0x7b,
+	0x9b, 0x05, 0x45, 0x16, 0xe7, 0xc2, 0x72, 0xd9, 0xc1, 0x47, 0x53, 0xab, 0x4e, 0x03, 0xf1, 0x66,
+	0x58, 0xd2, 0x48, 0x60, 0x82, 0x20, 0xf9, 0x26, 0x78, 0x54, 0xfd, 0x66, 0x5f, 0xd9, 0x6b, 0xa7,
+	0x0f, 0x8a, 0x9a, 0x88, 0xd4, 0xb0, 0x54, 0x54, 0x09, 0x8b, 0xdb, 0x93, 0x9f, 0x31, 0xe0, 0x6a,
+	0x04, 0xb5, 0x5d, 0xbb, 0xdb, 0xef, 0x22, 0x6d, 0x39, 0x96, 0xdd, 0xad, 0x8f, 0x96, 0x37, 0xb2,
+	0xcd, 0x1d, 0x68, 0x12, 0xbd, 0x38, 0xac, 0xf2, 0x61, 0x58, 0xd0, 0x25, 0xf2, 0x39, 0x03, 0xae,
+	0x2b, 0xd0, 0x9a, 0x4f, 0x03, 0xa6, 0x18, 0x8e, 0xdd, 0x9f, 0xe5, 0x94, 0x8c, 0x95, 0x3a, 0x3b,
+	0x39, 0xcb, 0xb4, 0x74, 0x08, 0x6e, 0x3c, 0x94, 0xba, 0xbe, 0x5c, 0x9a, 0xde, 0x66, 0x58, 0x1f,
+	0x3f, 0xd5, 0xe5, 0xc2, 0x48, 0x60, 0x82, 0x20, 0xf9, 0xe7, 0x06, 0x3c, 0xa2, 0x17, 0xe8, 0xab,
+	0x45, 0xbc, 0x29, 0x5e, 0x39, 0xb1, 0xce, 0xa4, 0xf0, 0x0b, 0xa1, 0x74, 0x01, 0x10, 0x8b, 0x7a,
+	0xc5, 0x8e, 0xed, 0x2e, 0x5f, 0x98, 0xe2, 0xdd, 0x51, 0x13, 0xc7, 0xb6, 0x58, 0xab, 0x01, 0x2a,
+	0x18, 0x7b, 0x71, 0xf7, 0xbc, 0xf6, 0x9a, 0xdd, 0x0e, 0x56, 0xec, 0xae, 0x1d, 0xf2, 0xd7, 0x41,
+	0x55, 0x4c, 0xc7, 0x9a, 0xd7, 0x5e, 0x5b, 0x5e, 0x14, 0xe5, 0x98, 0xa8, 0xc5, 0x03, 0xa3, 0xd8,
+	0x5d, 0xab, 0x43, 0xd7, 0xfa, 0x8e, 0xb3, 0xe6, 0x7b, 0x5c, 0x72, 0xb9, 0x48, 0xad, 0xb6, 0x63,
+	0xbb, 0xb4, 0xe4, 0x6b, 0x80, 0x6f, 0xb7, 0xe5, 0x22, 0xa4, 0x58, 0x4c, 0x8f, 0x19, 0xfe, 0x31,
+	0xd5, 0x41, 0xf3, 0x81, 0xd5, 0xbb, 0xa7, 0xe2, 0x21, 0xf0, 0xb7, 0xf4, 0xcd, 0xa8, 0x14, 0xb5,
+	0x1a, 0x6c, 0x35, 0xb1, 0x53, 0x10, 0xa9, 0x08, 0xc8, 0x57, 0x3f, 0x7f, 0x42, 0xab, 0x49, 0x21,
+	0x14, 0xd3, 0x77, 0x47, 0x23, 0x81, 0x09, 0x82, 0x4c, 0x71, 0x71, 0x3e, 0xd8, 0x0b, 0x42, 0xda,
+	0x8d, 0xfa, 0x70, 0xe1, 0xa4, 0xfb, 0xc0, 0x65, 0xba, 0xcd, 0x04, 0x11, 0x4c, 0x11, 0xe5, 0x91,
+	0x25, 0xd8, 0xac, 0xde, 0x6a, 0x30, 0xcd, 0x5c, 0x14, 0xee, 0x64, 0x8d, 0xfa, 0x2d, 0xe6, 0xb4,
+	0x70, 0x91, 0xaf, 0x1b, 0x11, 0x59, 0xa2, 0xb8, 0x1a, 0x0e, 0xc2, 0x41, 0x5e, 0x85, 0x19, 0x09,
+	0x5e, 0xf1, 0x1e, 0x64, 0x28, 0x4c, 0x73, 0x0a, 0xdc, 0x26, 0x6d, 0xb9, 0xb0, 0x16, 0x0e, 0xc0,
+	0xc0, 0xcc, 0xd1, 0x03, 0xea, 0x73, 0x95, 0x0c, 0x8d, 0x16, 0x4f, 0x50, 0x27, 0xb1, 0x39, 0x7a,
+	0x33, 0x0b, 0xc6, 0xbc, 0x36, 0xcc, 0x5f, 0x40, 0x7a, 0xbe, 0xee, 0xb1, 0x82, 0x8f, 0xac, 0x35,
+	0xeb, 0x97, 0x78, 0xff, 0x2e, 0x69, 0x5e, 0xb2, 0x0a, 0x84, 0xe9, 0xba, 0x8c, 0xb7, 0x50, 0x45,
+	0x0b, 0x7d, 0x3f, 0x08, 0xeb, 0x97, 0x79, 0x63, 0xce, 0x5b, 0xa0, 0x0e, 0xc0, 0x64, 0x3d, 0x66,
+	0x99, 0x1c, 0xd0, 0x56, 0xcb, 0xeb, 0xf6, 0xe4, 0x3b, 0xaf, 0x7e, 0x85, 0xf7, 0x5e, 0x7c, 0xc1,
+	0x04, 0x04, 0x53, 0x35, 0xc9, 0x1e, 0x5c, 0x8a, 0xc2, 0xd3, 0xad, 0x78, 0x9d, 0x55, 0x6b, 0x97,
+	0xb3, 0xea, 0x57, 0x0f, 0xdf, 0x81, 0x73, 0xca, 0xe4, 0x61, 0xee, 0x23, 0x7d, 0xcb, 0x0d, 0x59,
+	0x8c, 0x03, 0x3e, 0x5d, 0x8d, 0x2c, 0x3a, 0xcc, 0xa3, 0xc1, 0xe2, 0xe3, 0xa7, 0x8a, 0x6f, 0xda,
+	0x4c, 0xa5, 0xfd, 0x08, 0x1f, 0x36, 0x17, 0xd6, 0x34, 0x72, 0xe0, 0x98, 0xdb, 0x8a, 0xdc, 0x83,
+	0x2b, 0x3d, 0xdf, 0x0b, 0x69, 0x2b, 0xbc, 0x43, 0x7d, 0x97, 0x3a, 0x72, 0x80, 0x41, 0xbd, 0xce,
+	0xe7, 0x82, 0xab, 0xa3, 0xd6, 0xf2, 0x2a, 0x60, 0x7e, 0x3b, 0xf2, 0xc3, 0x06, 0x5c, 0x0b, 0x42,
+	0x9f, 0x5a, 0x5d, 0xdb, 0xed, 0x34, 0x3c, 0xd7, 0xa5, 0xfc, 0x98, 0x5c, 0x6e, 0xc7, 0xde, 0x1c,
+	0x8f, 0x96, 0x3a, 0xa7, 0xcc, 0x83, 0xfd, 0xd9, 0x6b, 0xcd, 0x81, 0x98, 0xf1, 0x10, 0xca, 0xcc,
+	0xb8, 0xad, 0x4b, 0xbb, 0x9e, 0xbf, 0xc7, 0x4e, 0xa4, 0xfa, 0x4c, 0x79, 0xe3, 0xb6, 0xd5, 0x08,
+	0x8b, 0xd8, 0xfe, 0x09, 0x45, 0x5a, 0x0c, 0x44, 0x8d, 0x9c, 0xb9, 0x5f, 0x81, 0x2b, 0xb9, 0x17,
+	0x0f, 0xdb, 0x01, 0xa2, 0x5e, 0xe4, 0x0f, 0x26, 0x75, 0x4f, 0x7c, 0x07, 0xac, 0x26, 0x41, 0x98,
+	0xae, 0xcb, 0xd8, 0x42, 0xbe, 0x53, 0x6f, 0x36, 0xe7, 0x13, 0x6e, 0x7b, 0x92, 0x2d, 0x5c, 0x4e,
+	0xc1, 0x30, 0x53, 0x9b, 0x34, 0x60, 0x5a, 0x96, 0x2d, 0xb3, 0x97, 0x55, 0x70, 0xd3, 0xa7, 0x8a,
+	0xe1, 0x66, 0x6f, 0x94, 0xe9, 0xe5, 0x34, 0x10, 0xb3, 0xf5, 0xd9, 0x28, 0xd8, 0x0f, 0xbd, 0x17,
+	0x23, 0xf1, 0x28, 0xee, 0x26, 0x41, 0x98, 0xae, 0xab, 0x9e, 0xbe, 0x89, 0x2e, 0xd4, 0xe2, 0x51,
+	0xdc, 0x4d, 0xc1, 0x30, 0x53, 0xdb, 0xfc, 0x2f, 0x23, 0xf0, 0xe4, 0x11, 0x98, 0x35, 0xd2, 0xcd,
+	0x9f, 0xee, 0xe3, 0x6f, 0xdc, 0xa3, 0x7d, 0x9e, 0x5e, 0xc1, 0xe7, 0x39, 0x3e, 0xbd, 0xa3, 0x7e,
+	0xce, 0xa0, 0xe8, 0x73, 0x1e, 0x9f, 0xe4, 0xd1, 0x3f, 0x7f, 0x37, 0xff, 0xf3, 0x97, 0x9c, 0xd5,
+	0x43, 0x97, 0x4b, 0xaf, 0x60, 0xb9, 0x94, 0x9c, 0xd5, 0x23, 0x2c, 0xaf, 0x3f, 0x1c, 0x81, 0xa7,
+	0x8e, 0xc2, 0x38, 0x96, 0x5c, 0x5f, 0x39, 0x47, 0xde, 0xa9, 0xae, 0xaf, 0x22, 0x87, 0xb9, 0x53,
+	0x5c, 0x5f, 0x39, 0x24, 0x4f, 0x7b, 0x7d, 0x15, 0xcd, 0xea, 0x69, 0xad, 0xaf, 0xa2, 0x59, 0x3d,
+	0xc2, 0xfa, 0xfa, 0x8b, 0xf4, 0xfd, 0x10, 0xf1, 0x8b, 0xcb, 0x50, 0x6d, 0xf5, 0xfa, 0x25, 0x0f,
+	0x29, 0x6e, 0x38, 0xd6, 0x58, 0xdb, 0x40, 0x86, 0x83, 0x20, 0x8c, 0x8a, 0xf5, 0x53, 0xf2, 0x08,
+	0xe2, 0xae, 0x57, 0x62, 0x49, 0xa2, 0xc4, 0xc4, 0xa6, 0x8a, 0xf6, 0xb6, 0x68, 0x97, 0xfa, 0x96,
+	0xd3, 0x0c, 0x3d, 0xdf, 0xea, 0x94, 0x3d, 0x6d, 0x84, 0x18, 0x3b, 0x85, 0x0b, 0x33, 0xd8, 0xd9,
+	0x84, 0xf4, 0xec, 0x76, 0x7d, 0xa4, 0xfc, 0x84, 0xac, 0x2d, 0x2f, 0x22, 0xc3, 0x61, 0xfe, 0xa3,
+	0x09, 0xd0, 0xc2, 0xbf, 0x32, 0xf9, 0x84, 0xe5, 0x38, 0xde, 0x83, 0x35, 0xdf, 0xde, 0xb1, 0x1d,
+	0xda, 0xa1, 0xed, 0x88, 0x99, 0x0a, 0xa4, 0x79, 0x21, 0x7f, 0x30, 0xcd, 0x17, 0x55, 0xc2, 0xe2,
+	0xf6, 0x4c, 0xfe, 0x34, 0xdd, 0x4a, 0x87, 0xdc, 0x1c, 0xc6, 0xe2, 0x25, 0x13, 0xbf, 0x53, 0xec,
+	0xa7, 0x4c, 0x31, 0x66, 0xc9, 0x92, 0xef, 0x34, 0x84, 0x50, 0x2e, 0xd2, 0xd7, 0xc8, 0x6f, 0x76,
+	0xeb, 0x84, 0x34, 0x9b, 0xb1, 0x74, 0x2f, 0x02, 0x60, 0x92, 0x20, 0x93, 0x80, 0x5c, 0xd9, 0xce,
+	0xd3, 0x25, 0xd4, 0x47, 0xca, 0xbb, 0xd7, 0x0e, 0x50, 0x4e, 0x08, 0x76, 0x36, 0xb7, 0x02, 0xe6,
+	0x77, 0x24, 0x9a, 0xa5, 0x48, 0xbc, 0x5a, 0xaf, 0x0d, 0x37, 0x4b, 0x29, 0x39, 0x6d, 0x3c, 0x4b,
+	0x11, 0x00, 0x93, 0x04, 0x99, 0x67, 0xe3, 0xb6, 0x92, 0x69, 0xd7, 0x47, 0xcb, 0x2b, 0x52, 0x53,
+	0x82, 0x71, 0x61, 0xd1, 0x13, 0x15, 0x62, 0x4c, 0x84, 0x6c, 0xc1, 0xd8, 0xb6, 0x38, 0x88, 0xa4,
+	0xfc, 0x69, 0x7e, 0xe8, 0xf7, 0xb1, 0x10, 0x83, 0xc8, 0x22, 0x54, 0xe8, 0x75, 0xeb, 0xea, 0xf1,
+	0x43, 0x9c, 0x7e, 0x7e, 0xd8, 0x80, 0x2b, 0x3b, 0xd4, 0x0f, 0xed, 0x56, 0x5a, 0x93, 0x33, 0x51,
+	0xfe, 0x0d, 0xff, 0x52, 0x1e, 0x42, 0xb1, 0x4c, 0x72, 0x41, 0x98, 0xdf, 0x05, 0xf6, 0xa2, 0x17,
+	0x02, 0xf9, 0x66, 0x68, 0x85, 0x76, 0x6b, 0xdd, 0xdb, 0xa6, 0x6e, 0x9c, 0xa5, 0xac, 0x0e, 0x71,
+	0xac, 0xc8, 0xa5, 0xe2, 0x6a, 0x38, 0x08, 0x87, 0xf9, 0x25, 0x03, 0x32, 0x62, 0x65, 0xf2, 0x03,
+	0x06, 0x4c, 0x6d, 0x52, 0x2b, 0xec, 0xfb, 0xf4, 0x96, 0x15, 0x46, 0xb1, 0x16, 0x5e, 0x3a, 0x09,
+	0x69, 0xf6, 0xdc, 0x4d, 0x0d, 0xb1, 0xb0, 0x4c, 0x88, 0x82, 0x5e, 0xe8, 0x20, 0x4c, 0xf4, 0x60,
+	0xe6, 0x45, 0x98, 0xce, 0x34, 0x3c, 0x96, 0x86, 0xf1, 0x5f, 0x1a, 0x90, 0x97, 0x58, 0x8f, 0xbc,
+	0x0a, 0x35, 0x8b, 0xa5, 0xf8, 0x93, 0x07, 0xe6, 0x07, 0xca, 0x19, 0xc9, 0xb4, 0xf5, 0xd0, 0x2c,
+	0xfc, 0x27, 0x0a, 0xb4, 0x2c, 0x6e, 0xa8, 0x95, 0x50, 0xb5, 0xaf, 0xc6, 0x7e, 0xd0, 0x5c, 0x13,
+	0x36, 0x9f, 0x81, 0x62, 0x4e, 0x0b, 0xf3, 0x7b, 0x0d, 0x20, 0xd9, 0x60, 0xe3, 0xc4, 0x87, 0x71,
+	0xb9, 0x94, 0xd5, 0x57, 0x5a, 0x2c, 0xe9, 0x6a, 0x94, 0xf0, 0x9b, 0x8b, 0x2d, 0xae, 0x64, 0x41,
+	0x80, 0x11, 0x1d, 0x16, 0x93, 0x2b, 0xce, 0xa6, 0x41, 0xde, 0x0b, 0x93, 0x6d, 0x1a, 0xb4, 0x7c,
+	0xbb, 0x17, 0xc6, 0x5e, 0x76, 0x91, 0xb7, 0xce, 0x62, 0x0c, 0x42, 0xbd, 0x1e, 0xf3, 0xbe, 0x0e,
+	0xad, 0x60, 0x7b, 0x79, 0x51, 0x3e, 0x2a, 0x39, 0x0b, 0xb0, 0xce, 0x4b, 0x50, 0x42, 0xe2, 0x40,
+	0x97, 0xd5, 0x23, 0x04, 0xba, 0xcc, 0x09, 0x52, 0x32, 0x72, 0x2a, 0x41, 0x4a, 0x7e, 0xaa, 0x02,
+	0x17, 0x58, 0x95, 0x55, 0xcb, 0x76, 0x43, 0xea, 0x72, 0x9f, 0x92, 0x92, 0x93, 0xd0, 0x81, 0x73,
+	0x61, 0xc2, 0xe9, 0xf2, 0xf8, 0x1e, 0x87, 0x91, 0x59, 0x4f, 0xd2, 0xd5, 0x32, 0x89, 0x97, 0x7c,
+	0x40, 0x39, 0xf5, 0x88, 0xe7, 0xf7, 0x93, 0x51, 0x48, 0x9a, 0x50, 0x44, 0x37, 0x20, 0x89, 0x8c,
+	0x2c, 0x09, 0xff, 0x9d, 0xf7, 0x45, 0x76, 0xe0, 0x22, 0x62, 0xa9, 0x7c, 0x7e, 0x4f, 0x6b, 0x76,
+	0xe0, 0x02, 0x80, 0xc9, 0x7a, 0xe6, 0xef, 0x55, 0x20, 0x99, 0xe8, 0xa5, 0xec, 0x2c, 0x65, 0xc3,
+	0xb5, 0x56, 0x4e, 0x2d, 0x5c, 0xeb, 0xd7, 0xf0, 0x2c, 0x69, 0x22, 0x9d, 0xa6, 0x50, 0x91, 0xeb,
+	0xb9, 0xcd, 0x78, 0x39, 0x46, 0x35, 0xe2, 0x69, 0x1d, 0x39, 0xf6, 0xb4, 0xbe, 0x57, 0x9a, 0x79,
+	0xd6, 0x12, 0x41, 0x73, 0x95, 0x99, 0xe7, 0x74, 0xa2, 0xa1, 0xe6, 0x82, 0xf4, 0x6f, 0x0d, 0x18,
+	0x93, 0x11, 0xf6, 0x8f, 0xe0, 0xe2, 0xc6, 0xbc, 0x10, 0xd9, 0x93, 0x67, 0x18, 0x6e, 0xb0, 0xb9,
+	0xe5, 0x79, 0x61, 0x22, 0xcf, 0x00, 0xf7, 0x29, 0xe1, 0xff, 0xa2, 0x40, 0xcf, 0x2d, 0xfd, 0xfc,
+	0xd6, 0x96, 0x1d, 0xd2, 0x56, 0xa8, 0xa2, 0x97, 0x2b, 0x4b, 0x3f, 0xad, 0x1c, 0x13, 0xb5, 0xcc,
+	0x1f, 0x19, 0x81, 0xeb, 0x12, 0x71, 0x86, 0x45, 0x8a, 0x0e, 0xb8, 0x3d, 0x96, 0x02, 0x96, 0xd7,
+	0x59, 0xf4, 0x2d, 0x3b, 0x32, 0x3d, 0x28, 0xf7, 0xf4, 0x95, 0x29, 0x63, 0x33, 0xe8, 0x30, 0x8f,
+	0x86, 0x88, 0xc3, 0xcd, 0x8b, 0x6f, 0x53, 0xcb, 0x09, 0xb7, 0x14, 0xed, 0xca, 0x30, 0x71, 0xb8,
+	0xb3, 0xf8, 0x30, 0x97, 0x0a, 0x37, 0x7d, 0x90, 0x80, 0x86, 0x4f, 0x2d, 0xdd, 0xee, 0x62, 0x08,
+	0x3f, 0x84, 0xd5, 0x5c, 0x8c, 0x58, 0x40, 0x89, 0xcb, 0x10, 0xad, 0x5d, 0x2e, 0x92, 0x40, 0x1a,
+	0xfa, 0x36, 0x55, 0x81, 0xaa, 0x84, 0x10, 0x21, 0x09, 0xc2, 0x74, 0x5d, 0x26, 0x0c, 0xe7, 0xa6,
+	0x24, 0x71, 0x80, 0xc6, 0x5a, 0x1c, 0xa6, 0xe3, 0x6e, 0x02, 0x82, 0xa9, 0x9a, 0xe6, 0x77, 0x55,
+	0x60, 0x4a, 0x5f, 0x76, 0x47, 0x70, 0xe4, 0xe9, 0x6b, 0x97, 0xe1, 0x10, 0xbe, 0x58, 0x3a, 0xd5,
+	0x23, 0xdc, 0x87, 0xe4, 0x15, 0x38, 0x2f, 0x02, 0x74, 0xa9, 0x38, 0x30, 0x72, 0xfd, 0xbf, 0x8b,
+	0x8d, 0x72, 0x23, 0x01, 0x61, 0x61, 0x02, 0x75, 0xf4, 0x49, 0x28, 0xa6, 0xf0, 0x98, 0x9f, 0xae,
+	0xc2, 0xa5, 0x9c, 0xde, 0x70, 0x93, 0x03, 0x9a, 0xba, 0xb2, 0x87, 0x31, 0x39, 0xc8, 0x5c, 0xff,
+	0x91, 0xc9, 0x41, 0x1a, 0x82, 0x19, 0xba, 0xe4, 0x25, 0xa8, 0xb6, 0x7c, 0x5b, 0x4e, 0xf8, 0xfb,
+	0x4a, 0x3d, 0x38, 0x71, 0x79, 0x61, 0x52, 0x52, 0x64, 0xf9, 0x84, 0x90, 0x21, 0x64, 0x17, 0x8f,
+	0x7e, 0x5c, 0x28, 0x2e, 0x80, 0x5f, 0x3c, 0xfa, 0xa9, 0x12, 0x60, 0xb2, 0x1e, 0x79, 0x05, 0xea,
+	0xf2, 0x25, 0xa0, 0x7c, 0xe7, 0x3d, 0x37, 0x08, 0xd9, 0xce, 0x0e, 0xeb, 0x23, 0x51, 0x24, 0xfe,
+	0xfa, 0x9d, 0x82, 0x3a, 0x58, 0xd8, 0xda, 0xfc, 0xb3, 0x2a, 0x4c, 0x6a, 0xf9, 0x4d, 0xc8, 0xea,
+	0x30, 0x22, 0x94, 0x78, 0xc4, 0x4a, 0x8c, 0xb2, 0x0a, 0xd5, 0x4e, 0xaf, 0x5f, 0xaf, 0x0c, 0x87,
+	0xee, 0x16, 0x43, 0xd7, 0xe9, 0xf5, 0xc9, 0x4b, 0x91, 0x54, 0xa6, 0x9c, 0xdc, 0x24, 0x72, 0xad,
+	0x49, 0x49, 0x66, 0xd4, 0x46, 0x1c, 0x29, 0xdc, 0x88, 0x5d, 0x18, 0x0b, 0xa4, 0xc8, 0xa6, 0x56,
+	0x3e, 0xdc, 0x91, 0x36, 0xd3, 0x52, 0x44, 0x23, 0xde, 0x7b, 0xf2, 0x07, 0x2a, 0x1a, 0x8c, 0x97,
+	0xec, 0x73, 0xff, 0x69, 0xfe, 0x90, 0x1d, 0x17, 0xbc, 0xe4, 0x06, 0x2f, 0x41, 0x09, 0xc9, 0x5c,
+	0x51, 0x63, 0x47, 0xba, 0xa2, 0xfe, 0x6e, 0x05, 0x48, 0xb6, 0x1b, 0x2c, 0xc2, 0x1f, 0x8f, 0xbf,
+	0x20, 0xcf, 0xa2, 0x88, 0xf3, 0xe7, 0x1e, 0xf8, 0x28, 0x60, 0xa4, 0x29, 0x83, 0xb7, 0x94, 0xfb,
+	0x9c, 0xdc, 0x66, 0x47, 0xd2, 0xd3, 0x22, 0xbd, 0x5c, 0x4f, 0x78, 0x87, 0xe4, 0xdd, 0xf9, 0x1b,
+	0x2c, 0x90, 0x95, 0xcb, 0x9a, 0x94, 0x94, 0x64, 0x09, 0xd3, 0x02, 0x81, 0x02, 0x15, 0x2e, 0xf3,
+	0x0f, 0x2b, 0x30, 0xa9, 0x73, 0xbc, 0x7b, 0x00, 0x56, 0x3f, 0xf4, 0xc4, 0x01, 0x56, 0x37, 0xca,
+	0x3f, 0x96, 0x35, 0xa4, 0xf3, 0x11, 0x42, 0xa1, 0xf2, 0x8a, 0x7f, 0xa3, 0x46, 0x8c, 0x91, 0x0e,
+	0xed, 0x2e, 0x7d, 0xd9, 0x76, 0xdb, 0xde, 0x83, 0x7a, 0xe5, 0x44, 0x48, 0xaf, 0x47, 0x08, 0x05,
+	0xe9, 0xf8, 0x37, 0x6a, 0xc4, 0xd8, 0xd1, 0xc2, 0x1f, 0xce, 0x2e, 0x4f, 0x38, 0x25, 0xfb, 0x26,
+	0x23, 0xee, 0x09, 0x7b, 0x3a, 0x7e, 0xb4, 0x34, 0x0a, 0xea, 0x60, 0x61, 0x6b, 0xf3, 0x67, 0x0c,
+	0xb8, 0x92, 0x3b, 0x15, 0xe4, 0x16, 0x4c, 0xc7, 0x66, 0x5e, 0xfa, 0x61, 0x3f, 0x1e, 0x27, 0x3a,
+	0xbb, 0x93, 0xae, 0x80, 0xd9, 0x36, 0x22, 0x9b, 0x7e, 0xe6, 0x32, 0x91, 0x36, 0x62, 0x3a, 0x6b,
+	0xa4, 0x83, 0x31, 0xaf, 0x8d, 0xf9, 0x4d, 0x89, 0xce, 0xc6, 0x93, 0xc5, 0x76, 0xc6, 0x7d, 0xda,
+	0xb1, 0xdd, 0xf4, 0xce, 0x58, 0x60, 0x85, 0x28, 0x60, 0xe4, 0x09, 0xdd, 0x05, 0x39, 0x3a, 0xb7,
+	0x94, 0x1b, 0xb2, 0xf9, 0xad, 0xf0, 0x48, 0x81, 0x26, 0x94, 0x2c, 0xc2, 0x54, 0xf0, 0xc0, 0xea,
+	0x2d, 0xd0, 0x2d, 0x6b, 0xc7, 0x96, 0x21, 0x2d, 0x84, 0xf9, 0xde, 0x54, 0x53, 0x2b, 0x7f, 0x98,
+	0xfa, 0x8d, 0x89, 0x56, 0x66, 0x08, 0x20, 0xcd, 0x3c, 0x99, 0xcd, 0xf8, 0x26, 0x8c, 0x5b, 0x32,
+	0x99, 0xbb, 0x5c, 0xc7, 0xdf, 0x50, 0x4a, 0x08, 0x20, 0x71, 0x08, 0x43, 0x78, 0xf5, 0x0b, 0x23,
+	0xdc, 0xe6, 0x3f, 0x31, 0xe0, 0x6a, 0x7e, 0x10, 0x83, 0x23, 0xb0, 0x36, 0x5d, 0x98, 0xf4, 0xe3,
+	0x66, 0x72, 0xd1, 0x7f, 0x9d, 0xb6, 0xb3, 0xe7, 0xb4, 0xb8, 0x6f, 0x8c, 0xed, 0x6b, 0xf8, 0x5e,
+	0xa0, 0xbe, 0x7c, 0x3a, 0xec, 0x76, 0xf4, 0xe4, 0xd2, 0x7a, 0x82, 0x3a, 0x7e, 0xf3, 0x57, 0x2a,
+	0x00, 0x77, 0x69, 0xc8, 0xe2, 0xfc, 0xb1, 0x29, 0x7a, 0x3c, 0xf1, 0xd2, 0x18, 0xff, 0xf2, 0x05,
+	0xd2, 0x78, 0x1c, 0x46, 0x7a, 0x9e, 0x8c, 0xac, 0x2a, 0x3b, 0xc2, 0x2d, 0xa0, 0x78, 0x29, 0xf3,
+	0x7d, 0xe7, 0x8a, 0x0f, 0x79, 0x33, 0xf1, 0x77, 0x0a, 0xe3, 0x32, 0x03, 0x14, 0xe5, 0x22, 0x45,
+	0x27, 0x77, 0x2e, 0x09, 0xe4, 0xc3, 0x4b, 0xa6, 0xe8, 0x14, 0x65, 0x18, 0x41, 0xc9, 0xf3, 0x00,
+	0x76, 0xef, 0xa6, 0xd5, 0xb5, 0x1d, 0x9b, 0xaa, 0x18, 0xa9, 0x8c, 0x81, 0x86, 0xe5, 0x35, 0x55,
+	0xfa, 0x70, 0x7f, 0x76, 0x5c, 0xfe, 0xda, 0x43, 0xad, 0xb6, 0xf9, 0x97, 0x55, 0x98, 0xba, 0xdb,
+	0xb1, 0xdd, 0x5d, 0xe5, 0xb3, 0x1a, 0xc9, 0x98, 0x8c, 0xd3, 0x91, 0x31, 0xbd, 0x02, 0x75, 0xc7,
+	0xb3, 0xda, 0x0b, 0x96, 0xc3, 0x76, 0xa3, 0xdf, 0x14, 0x9f, 0xd1, 0x72, 0x3b, 0x51, 0x8a, 0x7c,
+	0x7e, 0x2a, 0xad, 0x14, 0xd4, 0xc1, 0xc2, 0xd6, 0x24, 0x84, 0xd1, 0x96, 0xca, 0x2d, 0x51, 0xda,
+	0x0f, 0x53, 0x9f, 0x8b, 0x39, 0xdd, 0x25, 0x29, 0x62, 0x30, 0xe4, 0xd7, 0x96, 0xb4, 0xd8, 0xd3,
+	0xe7, 0x0a, 0xdd, 0x15, 0x2e, 0x79, 0xeb, 0xbe, 0xb5, 0xb9, 0x69, 0xb7, 0xa4, 0x5d, 0xaa, 0xf8,
+	0xb0, 0x2b, 0x4c, 0x92, 0xba, 0x94, 0x57, 0xe1, 0xe1, 0xfe, 0xec, 0x8d, 0x5c, 0x0f, 0x49, 0xfe,
+	0x59, 0x73, 0x9b, 0x60, 0x3e, 0x29, 0x16, 0x4b, 0xe2, 0x18, 0xde, 0x0c, 0x09, 0x3f, 0xc8, 0x5f,
+	0xad, 0xc0, 0x14, 0x5b, 0x77, 0x2c, 0x70, 0x82, 0xc3, 0x42, 0x0d, 0x3e, 0x93, 0x0e, 0x26, 0x11,
+	0x09, 0xa4, 0x33, 0x01, 0x25, 0x56, 0xe0, 0xf2, 0xa6, 0xe7, 0xb7, 0xe8, 0x7a, 0x63, 0x6d, 0xdd,
+	0x93, 0x2a, 0x97, 0xc5, 0xbb, 0x4d, 0x79, 0x4a, 0xf3, 0x47, 0xe4, 0xcd, 0x1c, 0x38, 0xe6, 0xb6,
+	0x62, 0x86, 0x38, 0x71, 0xf9, 0x46, 0x4f, 0x18, 0xb2, 0x30, 0x74, 0xd5, 0xd8, 0x10, 0xe7, 0x66,
+	0x5e, 0x05, 0xcc, 0x6f, 0xc7, 0x44, 0xd2, 0x32, 0x56, 0xcd, 0x4d, 0xcf, 0x7f, 0x60, 0xf9, 0xed,
+	0x24, 0xda, 0x91, 0x58, 0x24, 0xbd, 0x58, 0x5c, 0x0d, 0x07, 0xe1, 0x30, 0x7f, 0x74, 0x14, 0x34,
+	0xbf, 0xb9, 0x63, 0x24, 0x75, 0xfc, 0x49, 0x03, 0x2e, 0xb7, 0x1c, 0x9b, 0xba, 0x61, 0xca, 0x49,
+	0x4a, 0x1c, 0x47, 0x1b, 0xa5, 0x1c, 0xfa, 0x7a, 0xd4, 0x5d, 0x5e, 0x94, 0x76, 0x3f, 0x8d, 0x1c,
+	0xe4, 0xd2, 0x36, 0x2a, 0x07, 0x82, 0xb9, 0x9d, 0xe1, 0xe3, 0xe1, 0xe5, 0xcb, 0x8b, 0x7a, 0x90,
+	0x8d, 0x86, 0x2c, 0xc3, 0x08, 0xca, 0x6c, 0xb9, 0x3b, 0xbe, 0xd7, 0xef, 0x05, 0x0d, 0x6e, 0x6c,
+	0x2c, 0xd6, 0x3e, 0xe7, 0x0b, 0x6f, 0xc5, 0xc5, 0xa8, 0xd7, 0x61, 0x5c, 0xae, 0xf8, 0xb9, 0xe6,
+	0xd3, 0x4d, 0x7b, 0xb7, 0x5e, 0x8b, 0xb9, 0xdc, 0x5b, 0x5a, 0x39, 0x26, 0x6a, 0x71, 0xc7, 0xec,
+	0x20, 0xe8, 0x53, 0x7f, 0x03, 0x57, 0x64, 0x98, 0x07, 0xe1, 0x98, 0xad, 0x0a, 0x31, 0x86, 0x93,
+	0x1f, 0x34, 0xe0, 0x3c, 0xf3, 0x4f, 0xb3, 0x7d, 0xda, 0xe6, 0x44, 0x83, 0xfa, 0x58, 0x79, 0x67,
+	0xe9, 0xf8, 0x43, 0xcf, 0x61, 0x02, 0xa9, 0x38, 0x21, 0x22, 0xb1, 0x5d, 0x12, 0x88, 0xa9, 0x1e,
+	0xb0, 0xa9, 0x0a, 0xec, 0x8e, 0x6b, 0xbb, 0x9d, 0x79, 0xa7, 0x13, 0xd4, 0xc7, 0xe3, 0x98, 0xd6,
+	0xcd, 0xb8, 0x18, 0xf5, 0x3a, 0xec, 0x79, 0xd9, 0x0f, 0xd8, 0xbe, 0xef, 0x52, 0x31, 0xbf, 0x13,
+	0xb1, 0x5c, 0x73, 0x43, 0x07, 0x60, 0xb2, 0x1e, 0x13, 0x6a, 0xa8, 0x02, 0x39, 0xcb, 0xc0, 0x5b,
+	0xf2, 0xfb, 0x6b, 0x23, 0x01, 0xc1, 0x54, 0xcd, 0x99, 0x79, 0xb8, 0x94, 0x33, 0xcc, 0x63, 0x1d,
+	0x2e, 0x7f, 0x65, 0xc0, 0x15, 0x91, 0x91, 0x5a, 0xe5, 0x2d, 0x52, 0x71, 0x18, 0xf3, 0x43, 0x1a,
+	0x1a, 0xa7, 0x1a, 0xd2, 0xf0, 0xcb, 0x10, 0xba, 0xd1, 0xfc, 0xe9, 0x0a, 0xbc, 0xfd, 0xd0, 0x7d,
+	0x49, 0x7e, 0xcc, 0x80, 0x49, 0xba, 0x1b, 0xfa, 0x56, 0xe4, 0x91, 0xc1, 0x16, 0xe9, 0xe6, 0xa9,
+	0x1c, 0x02, 0x73, 0x4b, 0x31, 0x21, 0xb1, 0x70, 0x23, 0x16, 0x4b, 0x83, 0xa0, 0xde, 0x1f, 0xf6,
+	0x68, 0x15, 0xe1, 0x4b, 0x75, 0x05, 0x88, 0x70, 0x40, 0x47, 0x09, 0x99, 0xf9, 0x20, 0x8b, 0x68,
+	0x98, 0xc4, 0x7c, 0xac, 0xb5, 0xf2, 0xcb, 0x15, 0x60, 0x6e, 0x2d, 0x8c, 0xfb, 0x3b, 0x83, 0xf8,
+	0x0e, 0x56, 0x22, 0x5f, 0x48, 0x29, 0x97, 0x6d, 0xd9, 0xd9, 0xc2, 0x04, 0x21, 0x76, 0x2a, 0x41,
+	0xc8, 0xfc, 0x30, 0x44, 0x06, 0x67, 0x04, 0xf9, 0x54, 0x05, 0x2e, 0xc9, 0x9a, 0xf3, 0xad, 0x16,
+	0x93, 0xfb, 0xd3, 0x1d, 0x9b, 0x3e, 0x60, 0x02, 0x69, 0xb6, 0x26, 0xc5, 0x2f, 0xb6, 0x1e, 0x83,
+	0xd0, 0xea, 0xf6, 0x4a, 0x6c, 0xb3, 0xc7, 0x24, 0xe1, 0x4b, 0x2b, 0x59, 0x74, 0x98, 0x47, 0x83,
+	0xa5, 0xd3, 0xe8, 0xd2, 0xee, 0xfd, 0x38, 0x16, 0xf1, 0xea, 0x10, 0xc3, 0x5f, 0xe5, 0x98, 0xf4,
+	0xa1, 0xe9, 0x29, 0xe7, 0x38, 0x15, 0x54, 0xe4, 0xcc, 0x3f, 0x9e, 0x84, 0x47, 0xe2, 0xc9, 0xe8,
+	0x77, 0xfb, 0x8e, 0x15, 0xd2, 0xf6, 0x06, 0x4f, 0x47, 0x77, 0x0f, 0x6a, 0x81, 0x1d, 0x87, 0x7a,
+	0x3a, 0xce, 0x14, 0xc4, 0xa9, 0x11, 0x18, 0x02, 0x14, 0x78, 0xd4, 0x0c, 0xc7, 0x5a, 0x19, 0x31,
+	0xc3, 0x95, 0xe1, 0x66, 0x38, 0x85, 0x0e, 0xf3, 0x68, 0x90, 0x7f, 0x6d, 0x40, 0xcd, 0xe7, 0x2a,
+	0xef, 0x6a, 0x79, 0x95, 0x77, 0xc1, 0x44, 0xcd, 0x61, 0xac, 0xf2, 0x6e, 0xaa, 0x81, 0xf3, 0xb2,
+	0x87, 0xfb, 0xb3, 0xb3, 0x39, 0x5c, 0x6b, 0x1c, 0x67, 0x3c, 0x08, 0xbf, 0xfb, 0x8f, 0x06, 0x56,
+	0xe1, 0x2f, 0x45, 0xd1, 0x73, 0x3e, 0x86, 0x2d, 0xaf, 0xef, 0xab, 0x10, 0x6c, 0x27, 0x3a, 0x86,
+	0xdb, 0x0c, 0x71, 0x6a, 0x0c, 0xbc, 0xec, 0xa4, 0xc6, 0xc0, 0x7b, 0x4e, 0xfe, 0xd0, 0x60, 0x59,
+	0xaa, 0x77, 0xb9, 0x7d, 0x2d, 0xff, 0x1c, 0x22, 0x70, 0xe0, 0xb7, 0x9c, 0xe4, 0x50, 0x56, 0x35,
+	0xfc, 0x62, 0x44, 0x1f, 0x8d, 0x73, 0x58, 0xc7, 0xa0, 0x13, 0x1a, 0x58, 0x62, 0x38, 0xfa, 0xf8,
+	0xf8, 0xec, 0xd5, 0x47, 0x4f, 0x6d, 0x7c, 0xda, 0x17, 0x4b, 0x8f, 0xef, 0x24, 0x3f, 0x5c, 0x62,
+	0x38, 0x33, 0x5b, 0x00, 0x38, 0xc8, 0x4e, 0x63, 0x51, 0xbf, 0xb2, 0x8e, 0x2d, 0xa2, 0xd4, 0x43,
+	0xd6, 0x6c, 0x01, 0xc4, 0x23, 0x3c, 0x55, 0x4a, 0x1e, 0x4c, 0x67, 0x96, 0xcc, 0x19, 0x11, 0x3c,
+	0x9b, 0x11, 0x9a, 0xbf, 0x6f, 0xc0, 0x55, 0xb9, 0x9a, 0x16, 0xac, 0x40, 0x84, 0x1a, 0x90, 0x6a,
+	0xd8, 0x79, 0xe6, 0x4e, 0xc3, 0x19, 0xd7, 0xf9, 0x1e, 0x93, 0xc9, 0x58, 0x8e, 0x7c, 0xc9, 0x6a,
+	0x89, 0xe7, 0x12, 0x60, 0x4c, 0xd7, 0x67, 0x3e, 0xe4, 0x5d, 0x6b, 0xb7, 0x49, 0x79, 0x8c, 0x18,
+	0xa5, 0x95, 0x2c, 0xa9, 0x4c, 0x55, 0x19, 0x77, 0x53, 0xd8, 0x30, 0x87, 0x82, 0xf9, 0x3b, 0x06,
+	0x4c, 0xca, 0x51, 0x9d, 0x41, 0x38, 0xa2, 0x6f, 0x4b, 0x86, 0x23, 0xfa, 0xfa, 0x21, 0x76, 0x74,
+	0x41, 0x1c, 0x22, 0x07, 0xae, 0xa9, 0x2b, 0x5c, 0x93, 0xcc, 0x22, 0x0d, 0x42, 0x5f, 0x7a, 0x7e,
+	0x1c, 0x2e, 0x4e, 0x7c, 0x3a, 0xa5, 0x29, 0x95, 0x8f, 0xd0, 0x1c, 0x63, 0x9f, 0xcf, 0x54, 0xe1,
+	0x5c, 0x82, 0x63, 0x20, 0x37, 0x61, 0x2c, 0xe8, 0x73, 0xfe, 0x4f, 0x4e, 0xdf, 0x63, 0xda, 0xf4,
+	0xcd, 0xf9, 0xf7, 0xad, 0x16, 0x9b, 0xac, 0xa6, 0xa8, 0xa2, 0x25, 0x0e, 0x14, 0x05, 0xa8, 0x1a,
+	0xb3, 0x5e, 0xfa, 0x9e, 0x93, 0x09, 0xbb, 0x88, 0x9e, 0x43, 0x91, 0x43, 0x98, 0x3c, 0x8f, 0xfd,
+	0x55, 0x9a, 0x3f, 0x2e, 0xcf, 0x63, 0xe0, 0x00, 0x45, 0x79, 0x4e, 0xc8, 0xee, 0x91, 0x53, 0x09,
+	0xd9, 0xfd, 0x83, 0x06, 0x5c, 0x60, 0x14, 0xe3, 0x6a, 0xea, 0x46, 0xba, 0x37, 0x34, 0x07, 0x86,
+	0x09, 0xbc, 0xda, 0x7e, 0x4a, 0xd2, 0xc3, 0x74, 0x07, 0xcc, 0x9f, 0xae, 0xc2, 0xa3, 0x85, 0xbc,
+	0xdc, 0x89, 0x7d, 0xa5, 0x8f, 0xc1, 0xe5, 0x4d, 0xdb, 0xcf, 0xf2, 0xbb, 0xc7, 0xe7, 0xc6, 0x1e,
+	0x97, 0x34, 0x2e, 0xdf, 0xcc, 0xc1, 0x87, 0xb9, 0x54, 0xc8, 0x9b, 0x70, 0x85, 0xb1, 0x69, 0xf3,
+	0xad, 0xd0, 0xde, 0xb1, 0xc3, 0xbd, 0x98, 0xfc, 0xf1, 0x03, 0xf5, 0x73, 0x59, 0xd7, 0x4a, 0x1e,
+	0x32, 0xcc, 0xa7, 0xc1, 0x14, 0x2f, 0x9b, 0x8e, 0xd5, 0xe9, 0xd0, 0xf6, 0x4d, 0xcf, 0x47, 0xda,
+	0xe5, 0xa7, 0xde, 0x48, 0x52, 0xf1, 0x72, 0x33, 0x5d, 0x01, 0xb3, 0x6d, 0x98, 0x6e, 0xe7, 0xb1,
+	0x01, 0xdf, 0x3c, 0xda, 0x09, 0x46, 0xe1, 0x4e, 0x78, 0xed, 0x04, 0x62, 0xd3, 0x47, 0xb2, 0x94,
+	0xc1, 0x8b, 0xdd, 0xfc, 0xa5, 0x0a, 0x4c, 0xc9, 0xde, 0x8a, 0x00, 0x01, 0xbf, 0x62, 0xc0, 0xa8,
+	0x63, 0x77, 0xed, 0x50, 0x99, 0x18, 0xae, 0x0c, 0xb1, 0xe8, 0x39, 0xca, 0x39, 0xee, 0x78, 0x2c,
+	0xb9, 0x92, 0xf5, 0x28, 0x98, 0x2f, 0x2f, 0x3c, 0x21, 0x7e, 0x44, 0xf6, 0x78, 0xc6, 0x86, 0x49,
+	0x8d, 0xd8, 0xa9, 0x5e, 0x9f, 0x7f, 0x1e, 0xbf, 0x18, 0xb5, 0xd3, 0x98, 0x67, 0x3c, 0xf1, 0x69,
+	0x27, 0x32, 0xd1, 0x94, 0x81, 0xb7, 0x51, 0x14, 0xa1, 0x82, 0x31, 0xd9, 0x5d, 0x37, 0x56, 0x35,
+	0xab, 0x73, 0xf9, 0xa2, 0xe0, 0xd7, 0xe2, 0x72, 0x4c, 0xd4, 0x2a, 0x6f, 0x25, 0xf1, 0xf7, 0x0d,
+	0x38, 0xa7, 0xab, 0xf6, 0x82, 0x61, 0x62, 0x1e, 0x0e, 0xbe, 0x90, 0x62, 0x23, 0x45, 0xbd, 0x42,
+	0x80, 0x49, 0xfa, 0x4c, 0x88, 0xb7, 0xc3, 0x33, 0x9b, 0x88, 0xf1, 0xd7, 0x62, 0x21, 0xde, 0x4b,
+	0x71, 0x31, 0xea, 0x75, 0xcc, 0xff, 0x34, 0x1a, 0xdd, 0xed, 0x3c, 0x43, 0xdc, 0x6d, 0x98, 0x68,
+	0xf9, 0x94, 0xf1, 0xc0, 0x0b, 0x7b, 0x47, 0x39, 0xf7, 0xb8, 0x98, 0xb3, 0xa1, 0x5a, 0x60, 0xdc,
+	0x98, 0x75, 0x46, 0xb7, 0x55, 0xac, 0xc4, 0xc2, 0xd7, 0x42, 0x3b, 0xc5, 0x6f, 0x80, 0x9a, 0xf7,
+	0xc0, 0x8d, 0x5c, 0x1e, 0x06, 0x12, 0xe6, 0x77, 0xd9, 0x3d, 0x56, 0x1b, 0x45, 0x23, 0x3d, 0x3c,
+	0xfb, 0xc8, 0x80, 0xf0, 0xec, 0x4e, 0x2c, 0x03, 0x18, 0x22, 0x9d, 0x67, 0xe2, 0x34, 0x2a, 0x7e,
+	0xf7, 0x33, 0xc9, 0x30, 0xe3, 0x17, 0x82, 0x9e, 0xd5, 0xa2, 0xba, 0x64, 0xf8, 0xae, 0x2a, 0xc4,
+	0x18, 0xce, 0x12, 0x5d, 0xe9, 0x71, 0xff, 0xc7, 0xca, 0x5b, 0x7e, 0xc8, 0xee, 0x69, 0xa1, 0xfe,
+	0xc5, 0xd4, 0x17, 0xc5, 0xfe, 0x27, 0x16, 0xd4, 0x5e, 0xe7, 0x01, 0x4f, 0xc6, 0xcb, 0xe7, 0x38,
+	0xd0, 0x0f, 0x28, 0xf1, 0x7d, 0xf8, 0xbf, 0x28, 0x30, 0x93, 0x8f, 0xc3, 0x94, 0xaf, 0xed, 0x6a,
+	0xe9, 0x1f, 0x70, 0x6b, 0x08, 0x4a, 0xfa, 0x21, 0x21, 0xf6, 0xb9, 0x5e, 0x82, 0x09, 0x72, 0xe4,
+	0x75, 0x18, 0xbb, 0x2f, 0x78, 0x72, 0x19, 0x79, 0xee, 0xc3, 0xc3, 0x70, 0x96, 0x49, 0xee, 0x5e,
+	0x2c, 0x35, 0x59, 0x88, 0x8a, 0x8e, 0xf9, 0xaf, 0xc6, 0x23, 0xd6, 0x4f, 0xe6, 0x17, 0xfc, 0x30,
+	0x10, 0xef, 0xbe, 0x70, 0x1f, 0xbb, 0x45, 0x5d, 0x39, 0xfb, 0x7c, 0x9f, 0x55, 0xa3, 0xa0, 0xd8,
+	0xe4, 0x5e, 0xa6, 0x06, 0xe6, 0xb4, 0x22, 0xef, 0x4e, 0x26, 0xdc, 0x7c, 0x22, 0x9d, 0x35, 0x48,
+	0xdd, 0x41, 0x89, 0x4c, 0x41, 0x7d, 0xb8, 0x14, 0x84, 0x2c, 0x5a, 0xb2, 0x2d, 0xcd, 0x0e, 0xca,
+	0x72, 0x03, 0x22, 0x98, 0x40, 0x16, 0x15, 0xe6, 0xe1, 0x67, 0xf9, 0x1f, 0xeb, 0xbc, 0x9c, 0x99,
+	0x65, 0x88, 0xfc, 0x72, 0x31, 0xf1, 0xe3, 0xb3, 0x9c, 0x5c, 0x1b, 0xdb, 0x2c, 0xc0, 0x87, 0x85,
+	0x94, 0x8a, 0xb9, 0xa1, 0xda, 0x19, 0x70, 0x43, 0xff, 0xc2, 0x80, 0x5a, 0x3f, 0x60, 0x26, 0x5d,
+	0xa3, 0x43, 0x33, 0x01, 0x62, 0x39, 0xcd, 0x71, 0x39, 0x45, 0x4a, 0x98, 0xc4, 0xcb, 0x4e, 0x4a,
+	0x98, 0xc4, 0xfb, 0xcb, 0x76, 0xae, 0xa5, 0xb1, 0xc6, 0xf5, 0xb1, 0xa1, 0x77, 0x6e, 0x42, 0x6a,
+	0x2a, 0x6c, 0xc8, 0xb4, 0x12, 0x4c, 0x90, 0x63, 0x89, 0x96, 0x2e, 0x5a, 0x29, 0xe1, 0x4c, 0x7d,
+	0xbc, 0x7c, 0x94, 0x80, 0x02, 0x79, 0x8f, 0x70, 0x73, 0x4c, 0x97, 0x62, 0x86, 0x34, 0x93, 0x98,
+	0xc4, 0x13, 0x7f, 0xaa, 0x0c, 0xd1, 0x5f, 0x18, 0x40, 0xb2, 0x47, 0x39, 0x71, 0x60, 0xbc, 0xad,
+	0x02, 0x42, 0x18, 0x27, 0x92, 0x1c, 0x26, 0x7a, 0x90, 0x47, 0x71, 0x24, 0x22, 0x0a, 0xc4, 0x83,
+	0x89, 0x07, 0x8c, 0xed, 0x71, 0xec, 0x20, 0x3c, 0xa1, 0x5c, 0x34, 0x51, 0x62, 0x86, 0x97, 0x15,
+	0x62, 0x8c, 0x69, 0x98, 0xdf, 0x37, 0x02, 0xe3, 0x51, 0x6e, 0xbd, 0xc3, 0x6d, 0xf4, 0xfb, 0x40,
+	0x64, 0xec, 0xf0, 0x35, 0xc7, 0x72, 0xe9, 0x30, 0x16, 0x34, 0x22, 0x27, 0x73, 0x06, 0x19, 0xe6,
+	0x10, 0x20, 0x6f, 0xc2, 0x65, 0xdb, 0xdd, 0xf4, 0xad, 0x20, 0xf4, 0xfb, 0x9c, 0x23, 0x6c, 0x28,
+	0x3b, 0x8f, 0x12, 0x84, 0xb9, 0x0e, 0x7c, 0x39, 0x07, 0x1d, 0xe6, 0x12, 0x21, 0x14, 0xc6, 0x44,
+	0x0a, 0x51, 0xc5, 0x74, 0x3e, 0x5f, 0x2a, 0x86, 0x2b, 0x47, 0x11, 0x73, 0x2f, 0xe2, 0x77, 0x80,
+	0x0a, 0xb7, 0x88, 0x19, 0x2b, 0xfe, 0x57, 0x57, 0x5d, 0xbd, 0x56, 0xde, 0xd5, 0xf1, 0xe5, 0x24,
+	0x2a, 0x19, 0x33, 0x36, 0x59, 0x88, 0x69, 0x82, 0xe6, 0x6f, 0x19, 0x20, 0x18, 0x89, 0x33, 0xd0,
+	0xc0, 0x7d, 0x6b, 0x42, 0x03, 0xf7, 0x42, 0x99, 0x41, 0xf2, 0xae, 0x16, 0xe9, 0xdf, 0x98, 0xfb,
+	0xc9, 0x04, 0xaf, 0x71, 0x06, 0x92, 0xb4, 0x57, 0x93, 0x92, 0xb4, 0x0f, 0x94, 0x1e, 0x4d, 0x81,
+	0x1c, 0xed, 0xb7, 0xaa, 0x72, 0x2c, 0xfc, 0xe5, 0xb0, 0x0c, 0x97, 0xa4, 0x37, 0x33, 0x4b, 0xeb,
+	0xca, 0x96, 0xf8, 0xa2, 0xb5, 0x27, 0x0c, 0x7c, 0x6b, 0x32, 0x96, 0x4e, 0x16, 0x8c, 0x79, 0x6d,
+	0xc8, 0xaf, 0x1a, 0x8c, 0x47, 0x0f, 0x7d, 0xbb, 0x35, 0x54, 0x06, 0xf8, 0xa8, 0x6f, 0x73, 0xab,
+	0x02, 0x99, 0xb8, 0x29, 0x37, 0x62, 0x66, 0x9d, 0x97, 0x9e, 0xd0, 0x5d, 0xa9, 0x7a, 0x4c, 0x6e,
+	0x43, 0x2d, 0x68, 0x79, 0x3d, 0xe5, 0x6c, 0xff, 0xa4, 0xfe, 0x8a, 0x91, 0xfd, 0x9b, 0x4b, 0x1b,
+	0x14, 0xc6, 0x7a, 0x3c, 0xd6, 0x12, 0x05, 0x82, 0x99, 0xd7, 0x60, 0x4a, 0xef, 0xf9, 0xa9, 0x5e,
+	0x35, 0xbf, 0x56, 0x81, 0x51, 0xf1, 0xa2, 0x3e, 0x82, 0xf4, 0xd3, 0x56, 0xd9, 0x40, 0x2b, 0xe5,
+	0x3d, 0x26, 0xf5, 0xcc, 0x37, 0x2c, 0x05, 0x68, 0x3c, 0x07, 0x7a, 0x42, 0x50, 0xe2, 0x46, 0xf9,
+	0x90, 0xaa, 0xe5, 0xf3, 0x95, 0x8b, 0x81, 0x9d, 0x76, 0x06, 0xa4, 0x7f, 0x67, 0xc0, 0x54, 0x22,
+	0xc1, 0x54, 0x17, 0xaa, 0x3e, 0xdd, 0xac, 0x1b, 0x43, 0xd9, 0x9a, 0x2a, 0x9f, 0xb8, 0xc7, 0x06,
+	0x54, 0x42, 0x46, 0x27, 0xca, 0x45, 0x55, 0x39, 0xa1, 0x5c, 0x54, 0xe6, 0x67, 0x0c, 0xb8, 0xaa,
+	0x06, 0x94, 0x0c, 0xed, 0xcd, 0xe4, 0xdf, 0x56, 0xcf, 0xe6, 0x26, 0x51, 0xba, 0x51, 0xd9, 0xfc,
+	0xda, 0x32, 0x2f, 0xc3, 0x08, 0xca, 0x1c, 0x02, 0xd5, 0xc2, 0x93, 0x2f, 0x95, 0xe8, 0xcc, 0x52,
+	0xb8, 0x31, 0xaa, 0x41, 0xbe, 0x4a, 0x4b, 0xd8, 0x5a, 0x8b, 0xf9, 0x84, 0x88, 0xb0, 0xb0, 0xe2,
+	0x37, 0xbf, 0x0e, 0x26, 0x9a, 0xcd, 0xdb, 0x82, 0x67, 0x3c, 0x86, 0x71, 0xa0, 0xf9, 0xc9, 0x2a,
+	0x9c, 0x93, 0x39, 0x0a, 0x6c, 0xb7, 0xcd, 0x2c, 0x73, 0x4f, 0xff, 0x4e, 0x59, 0x87, 0x09, 0x61,
+	0x8d, 0x12, 0xdb, 0x1d, 0xe7, 0x9e, 0x09, 0x4d, 0x55, 0x29, 0x9d, 0x98, 0x2d, 0x02, 0x60, 0x8c,
+	0x88, 0xdc, 0x81, 0x51, 0xfe, 0xac, 0x56, 0xfb, 0xe2, 0x48, 0xc7, 0x4c, 0xb4, 0xe8, 0xf9, 0xd1,
+	0x18, 0xa0, 0x44, 0x41, 0x02, 0xee, 0xb4, 0xc9, 0x19, 0xae, 0x61, 0x62, 0x8f, 0x26, 0x66, 0x36,
+	0x4a, 0xd7, 0x3c, 0x25, 0x7d, 0x3f, 0xf9, 0x2f, 0x8c, 0x08, 0xf1, 0xac, 0x92, 0x89, 0x16, 0x6f,
+	0x91, 0xac, 0x92, 0x89, 0x3e, 0x17, 0x5c, 0x8d, 0x1f, 0x80, 0x2b, 0xb9, 0x93, 0x71, 0x38, 0x3b,
+	0x6b, 0xfe, 0x5c, 0x05, 0x46, 0x58, 0x6e, 0xc8, 0x33, 0x58, 0x99, 0xaf, 0x26, 0xb8, 0x9d, 0x6f,
+	0x28, 0x9d, 0xd7, 0xb2, 0xc8, 0xd8, 0x68, 0x33, 0x65, 0x6c, 0xf4, 0xc1, 0xd2, 0x14, 0x06, 0x5b,
+	0x1a, 0xfd, 0x78, 0x05, 0x80, 0x55, 0x5b, 0xb0, 0x5a, 0xdb, 0xe2, 0xc4, 0x89, 0x56, 0xb3, 0x91,
+	0x3c, 0x71, 0xb2, 0xcb, 0xf0, 0x2c, 0x8d, 0xef, 0x4d, 0x18, 0x15, 0xb2, 0xea, 0x7a, 0x35, 0xb6,
+	0x58, 0x13, 0x77, 0x13, 0x4a, 0x48, 0xf2, 0xb4, 0x18, 0x39, 0xa1, 0xd3, 0xc2, 0xdc, 0x85, 0x31,
+	0x36, 0x41, 0xcc, 0x00, 0xb9, 0xab, 0xcd, 0x4e, 0xa5, 0x3c, 0x2f, 0x2f, 0xd1, 0x1d, 0xba, 0xcb,
+	0x3f, 0x69, 0xc0, 0x85, 0x54, 0xdd, 0x23, 0xbc, 0xe9, 0x4e, 0xe5, 0xcc, 0x34, 0xff, 0x4a, 0xf5,
+	0xc5, 0xb7, 0x6c, 0x57, 0x4a, 0xe4, 0x5e, 0x83, 0xf3, 0x3c, 0xad, 0xdf, 0x30, 0x86, 0x68, 0x91,
+	0x62, 0xa8, 0x99, 0xc0, 0x84, 0x29, 0xcc, 0xc2, 0x06, 0xa0, 0x6b, 0xd9, 0xae, 0xed, 0x76, 0xb8,
+	0x57, 0xb8, 0x88, 0xb8, 0x5d, 0xd3, 0x6d, 0x00, 0x12, 0x60, 0x4c, 0xd7, 0xe7, 0xfe, 0xc4, 0x76,
+	0xc7, 0xb7, 0xc2, 0x18, 0x85, 0xd0, 0x57, 0x08, 0x7f, 0xe2, 0x24, 0x08, 0xd3, 0x75, 0xcd, 0xdf,
+	0x34, 0x60, 0x9c, 0xcd, 0xc0, 0x19, 0x1c, 0xb5, 0xdf, 0x92, 0x3c, 0x6a, 0xdf, 0x5f, 0x76, 0x91,
+	0x15, 0x9c, 0xb0, 0x7f, 0x5a, 0x01, 0x9e, 0x42, 0x57, 0x3a, 0xd9, 0x68, 0xbe, 0x2b, 0x46, 0x81,
+	0xef, 0xca, 0x75, 0xe9, 0xfa, 0x92, 0x52, 0x97, 0x6b, 0xee, 0x2f, 0x5f, 0xa3, 0x79, 0xb7, 0x54,
+	0x93, 0x07, 0x47, 0x8e, 0x87, 0xcb, 0x1b, 0x70, 0x2e, 0x60, 0xb3, 0x1a, 0xc5, 0xe6, 0x1c, 0x29,
+	0x6f, 0x51, 0xc9, 0x3f, 0x8f, 0x1a, 0x8a, 0xd0, 0x3d, 0x35, 0x75, 0xdc, 0x98, 0x24, 0xc5, 0x62,
+	0xfc, 0xde, 0x77, 0xbc, 0xd6, 0x36, 0xcb, 0x31, 0xa0, 0x14, 0x3d, 0xdc, 0xed, 0x6e, 0x21, 0x2a,
+	0x45, 0xad, 0xc6, 0x50, 0xde, 0x38, 0x7f, 0x62, 0x88, 0x99, 0x3e, 0xc6, 0xf6, 0x3d, 0xc3, 0x33,
+	0xf5, 0x1d, 0xa9, 0x33, 0x35, 0xba, 0x23, 0x52, 0xe7, 0xea, 0xac, 0x7a, 0xb2, 0x8c, 0xc4, 0xa6,
+	0x10, 0xfa, 0x43, 0xc3, 0xfc, 0x65, 0x39, 0xcc, 0x28, 0x0b, 0x73, 0x0f, 0xce, 0xf1, 0x37, 0x41,
+	0x2a, 0xfd, 0xf3, 0xbb, 0x8f, 0xb8, 0x47, 0xf4, 0xa6, 0xb1, 0xfe, 0x2e, 0x51, 0x8c, 0x49, 0x02,
+	0x4c, 0x15, 0xa9, 0x46, 0xa7, 0x6b, 0x30, 0xf9, 0x72, 0x58, 0xd3, 0x01, 0x98, 0xac, 0xc7, 0x92,
+	0x97, 0x3f, 0x21, 0xfa, 0xce, 0x65, 0x26, 0x8b, 0xb4, 0x47, 0xdd, 0x36, 0x75, 0x5b, 0x7b, 0x9c,
+	0x6b, 0x6f, 0x7b, 0x4c, 0x5a, 0x35, 0xfa, 0x80, 0xd2, 0x76, 0xa4, 0x5b, 0x7b, 0xb9, 0xf4, 0x55,
+	0x5c, 0x44, 0xe2, 0x65, 0x8e, 0x5e, 0xdc, 0x69, 0xe2, 0x7f, 0x94, 0x24, 0x19, 0xf1, 0x9e, 0xef,
+	0xdd, 0x8f, 0x98, 0xcb, 0x93, 0x27, 0xbe, 0xc6, 0xd1, 0x0b, 0xe2, 0xe2, 0x7f, 0x94, 0x24, 0xcd,
+	0x35, 0x78, 0xf2, 0x08, 0x4d, 0x8f, 0xf3, 0x88, 0x38, 0x0c, 0xa3, 0x18, 0xfd, 0x71, 0x30, 0xfe,
+	0x81, 0x01, 0x4f, 0x69, 0x28, 0x97, 0x76, 0xd9, 0xbb, 0xa6, 0x61, 0xf5, 0xac, 0x16, 0x7b, 0xa5,
+	0xf3, 0x78, 0x83, 0xc7, 0x4a, 0xaa, 0xfb, 0x49, 0x03, 0xc6, 0x84, 0x2b, 0x98, 0x3a, 0x7e, 0x5f,
+	0x1d, 0x72, 0xca, 0x0b, 0xbb, 0xa4, 0xd2, 0x83, 0xa9, 0xb1, 0x89, 0xdf, 0x01, 0x2a, 0xfa, 0xe6,
+	0xbf, 0xa9, 0xc1, 0x57, 0x1f, 0x1d, 0x11, 0xf9, 0x13, 0x43, 0x4f, 0x77, 0x2d, 0xa4, 0xdb, 0xdd,
+	0xd3, 0xed, 0x7c, 0x24, 0xc7, 0x91, 0xa2, 0x81, 0x97, 0x33, 0x19, 0xb1, 0x4f, 0x48, 0x44, 0x14,
+	0x0f, 0x8c, 0xfc, 0x53, 0x03, 0xa6, 0xd8, 0xb5, 0x14, 0x1d, 0x2e, 0xe2, 0x33, 0xf5, 0x4e, 0x79,
+	0xa4, 0x77, 0x35, 0x92, 0xa9, 0xd8, 0x61, 0x3a, 0x08, 0x13, 0x7d, 0x23, 0x1b, 0x49, 0xbd, 0xb4,
+	0x78, 0x70, 0x5e, 0xcb, 0xe3, 0xc7, 0x8e, 0x93, 0x6f, 0x7e, 0xc6, 0x81, 0xf3, 0xc9, 0x99, 0x3f,
+	0x55, 0x63, 0xd0, 0x17, 0x61, 0x3a, 0x33, 0xfa, 0x63, 0x89, 0x77, 0xfe, 0xf6, 0x08, 0xcc, 0x6a,
+	0x53, 0x9d, 0x70, 0x06, 0x55, 0x3c, 0xc1, 0x8f, 0x18, 0x30, 0x69, 0xb9, 0xae, 0x74, 0x28, 0x52,
+	0xeb, 0xb7, 0x3d, 0xe4, 0x57, 0xcd, 0x23, 0x35, 0x37, 0x1f, 0x93, 0x49, 0x79, 0xcc, 0x68, 0x10,
+	0xd4, 0x7b, 0x33, 0xc0, 0x2d, 0xb4, 0x72, 0x66, 0x6e, 0xa1, 0xe4, 0xe3, 0xea, 0x22, 0x16, 0xcb,
+	0xe8, 0x95, 0x53, 0x98, 0x1b, 0x7e, 0xaf, 0xe7, 0xcb, 0x13, 0x99, 0x47, 0x50, 0x7a, 0xe6, 0x8e,
+	0xb5, 0x0a, 0x7e, 0xae, 0x0a, 0x4f, 0x1d, 0x85, 0xfc, 0x11, 0xa4, 0xa8, 0x9f, 0x4b, 0x2d, 0x16,
+	0x71, 0x04, 0xd8, 0xa7, 0x35, 0x21, 0x27, 0xbb, 0x62, 0xaa, 0x67, 0xe7, 0x48, 0x3c, 0xec, 0x27,
+	0xfb, 0x05, 0x03, 0xae, 0x68, 0x13, 0x14, 0xe7, 0x26, 0xe2, 0x71, 0x2e, 0xed, 0xc0, 0x56, 0xa1,
+	0xa0, 0xb5, 0x2b, 0xfa, 0x25, 0x51, 0x8c, 0x0a, 0x4e, 0x3c, 0xa8, 0xb5, 0x7d, 0xcb, 0x56, 0xf6,
+	0xd6, 0x77, 0x87, 0xfc, 0x4a, 0x71, 0x27, 0xf8, 0x63, 0x54, 0xf0, 0xa3, 0xfc, 0x5f, 0x14, 0x74,
+	0xcc, 0x2e, 0xcc, 0x14, 0xd7, 0x67, 0x7e, 0xc9, 0x2c, 0x67, 0x89, 0xe5, 0x5b, 0x8e, 0x43, 0x1d,
+	0xf9, 0xee, 0x13, 0x27, 0x0e, 0x7b, 0x50, 0x72, 0xeb, 0x84, 0xd5, 0xbc, 0x0a, 0x98, 0xdf, 0xce,
+	0x5c, 0x49, 0x1c, 0x6e, 0xeb, 0x5e, 0xcf, 0x73, 0xbc, 0xce, 0xde, 0xfc, 0x03, 0xcb, 0xa7, 0xe8,
+	0xf5, 0x43, 0x39, 0x5b, 0x47, 0x65, 0x68, 0x56, 0xe1, 0xba, 0x86, 0x2d, 0x37, 0x66, 0xe7, 0x71,
+	0xd0, 0xfd, 0xce, 0x18, 0x4c, 0x69, 0xf8, 0x02, 0xf2, 0x8b, 0x06, 0x3c, 0x4a, 0x8b, 0xee, 0x3a,
+	0xc9, 0xa8, 0xbf, 0x72, 0x5a, 0x77, 0xa9, 0x4c, 0x85, 0x54, 0x04, 0xc6, 0xe2, 0x9e, 0xb1, 0xc8,
+	0x2b, 0x41, 0xf4, 0x25, 0x87, 0x89, 0xbc, 0x92, 0xbb, 0x34, 0x64, 0xc2, 0xf0, 0xe8, 0x37, 0x6a,
+	0xc4, 0xc8, 0x4f, 0x18, 0x70, 0xd9, 0xc9, 0x39, 0x1b, 0x24, 0x4f, 0xde, 0x3c, 0x85, 0x63, 0x47,
+	0xa8, 0xb5, 0xf3, 0x20, 0x98, 0xdb, 0x15, 0xf2, 0x53, 0x85, 0xc1, 0x64, 0x85, 0xd6, 0x79, 0x7d,
+	0xc8, 0x4e, 0x9e, 0x54, 0x5c, 0xd9, 0xcf, 0x1a, 0x40, 0xda, 0x19, 0xbe, 0xbf, 0x3e, 0x56, 0x3e,
+	0x77, 0xe1, 0xc0, 0x07, 0x85, 0xb0, 0x4b, 0xc8, 0x96, 0x63, 0x4e, 0x27, 0xf8, 0x77, 0x0e, 0x73,
+	0xb6, 0x6f, 0x7d, 0xfc, 0x44, 0xbe, 0x73, 0xde, 0xc9, 0x20, 0xbe, 0x73, 0x1e, 0x04, 0x73, 0xbb,
+	0x62, 0xfe, 0xc6, 0xa8, 0x10, 0x43, 0x71, 0xc5, 0xf1, 0x7d, 0x18, 0xbd, 0xcf, 0x05, 0xb7, 0x75,
+	0x63, 0x38, 0x29, 0xb1, 0x10, 0xff, 0x8a, 0x47, 0xa0, 0xf8, 0x1f, 0x25, 0x66, 0xf2, 0x51, 0xa8,
+	0xb6, 0xdd, 0x40, 0x6e, 0xb8, 0xaf, 0x1f, 0x42, 0xde, 0x19, 0x47, 0xdb, 0x61, 0x61, 0x18, 0x18,
+	0x52, 0xe2, 0xc2, 0xb8, 0x2b, 0x25, 0x37, 0xf5, 0x6a, 0x79, 0xeb, 0x49, 0x5d, 0x98, 0x15, 0xcb,
+	0x9d, 0x54, 0x09, 0x46, 0x34, 0x18, 0xbd, 0x94, 0xb2, 0xa6, 0x34, 0xbd, 0x48, 0x7a, 0x3b, 0x48,
+	0x40, 0x4e, 0x59, 0xa0, 0x59, 0xdb, 0x0d, 0x95, 0x8f, 0xdd, 0x0b, 0x65, 0xa9, 0xad, 0x33, 0x2c,
+	0xb1, 0x80, 0x86, 0xff, 0x0c, 0x50, 0x22, 0x67, 0xcb, 0x40, 0x18, 0x26, 0xd7, 0xc7, 0x86, 0x5b,
+	0x06, 0xc2, 0xd6, 0x59, 0x2c, 0x03, 0xf1, 0x3f, 0x4a, 0xcc, 0xe4, 0x35, 0x26, 0xe0, 0x93, 0x76,
+	0x2c, 0xe3, 0xc3, 0x4d, 0x5d, 0x64, 0xc4, 0x22, 0x03, 0xe0, 0x88, 0x5f, 0x18, 0xe1, 0x27, 0xf7,
+	0x61, 0xcc, 0x16, 0x21, 0x5b, 0xea, 0x13, 0xe5, 0x97, 0x9d, 0x8c, 0xfa, 0x22, 0xde, 0xf9, 0xf2,
+	0x07, 0x2a, 0xc4, 0xe6, 0x2f, 0x4c, 0x0a, 0xc5, 0x87, 0x94, 0x65, 0x6f, 0xc2, 0xb8, 0x42, 0x37,
+	0x4c, 0x20, 0xa6, 0x5b, 0x12, 0x2c, 0x86, 0xa6, 0x7e, 0x61, 0x84, 0x9b, 0xe5, 0xa5, 0xc9, 0x06,
+	0xd4, 0x8a, 0x73, 0x67, 0x1e, 0x2d, 0x98, 0xd6, 0xeb, 0x00, 0xad, 0x38, 0xac, 0x65, 0xb5, 0xfc,
+	0xd2, 0x8a, 0x42, 0x5e, 0xc6, 0xda, 0xae, 0xa8, 0x28, 0x40, 0x8d, 0x48, 0x81, 0xf5, 0xed, 0x48,
+	0x29, 0xeb, 0xdb, 0x17, 0xe0, 0x82, 0x34, 0x5d, 0x59, 0x6e, 0x53, 0xfe, 0xd8, 0x94, 0xb1, 0x42,
+	0xb8, 0x20, 0xbe, 0x91, 0x04, 0x61, 0xba, 0x2e, 0xf9, 0x35, 0x83, 0x45, 0x65, 0x11, 0x0c, 0xc2,
+	0x30, 0xf6, 0xa0, 0xf1, 0xd7, 0x9f, 0x53, 0xfc, 0x86, 0xe0, 0xed, 0x5f, 0x52, 0x3b, 0x5a, 0x15,
+	0x9f, 0x90, 0x0c, 0x23, 0xea, 0x35, 0xf9, 0x6d, 0xf6, 0x7c, 0x71, 0x1c, 0xaf, 0x65, 0x85, 0x3c,
+	0x74, 0xe0, 0x58, 0x79, 0x7f, 0x2e, 0x6d, 0x14, 0xf3, 0x31, 0x46, 0x31, 0x90, 0x6f, 0x8c, 0x1e,
+	0x29, 0x31, 0xe4, 0x84, 0xc6, 0xa2, 0x77, 0x9f, 0xfc, 0x63, 0x03, 0x9e, 0x12, 0x91, 0x63, 0x1a,
+	0xd4, 0x0f, 0xed, 0x4d, 0xbb, 0x65, 0x85, 0x9a, 0x8f, 0x51, 0xac, 0x1f, 0x1a, 0x3f, 0xb6, 0x7e,
+	0xe8, 0xe9, 0x83, 0xfd, 0xd9, 0xa7, 0x1a, 0x47, 0xc0, 0x8d, 0x47, 0xea, 0x01, 0xd3, 0x3c, 0x38,
+	0x7a, 0x78, 0xe3, 0xfa, 0x44, 0x79, 0xcd, 0x43, 0x22, 0x4e, 0xb2, 0x10, 0x35, 0x27, 0x8a, 0x30,
+	0x49, 0x8a, 0xb4, 0xd5, 0x3b, 0x08, 0x86, 0xd4, 0x1d, 0xc6, 0x3a, 0xb8, 0xec, 0xe3, 0x67, 0x66,
+	0x1b, 0xce, 0x25, 0x96, 0xf3, 0xa9, 0x4a, 0x86, 0x5c, 0xb8, 0x98, 0x5e, 0x75, 0xa7, 0x6a, 0x6a,
+	0x75, 0x07, 0x26, 0xa2, 0xeb, 0x90, 0x3c, 0xa1, 0x11, 0x8a, 0x99, 0x8b, 0x3b, 0x74, 0x4f, 0x50,
+	0x9d, 0x4d, 0xbc, 0x6a, 0xc5, 0x4c, 0xbd, 0xc4, 0x0a, 0x24, 0x42, 0xf3, 0x77, 0xa5, 0xda, 0x62,
+	0x9d, 0x76, 0x7b, 0x8e, 0x15, 0xd2, 0xb7, 0xbe, 0xd9, 0x80, 0xf9, 0xdf, 0x0d, 0x71, 0xab, 0x89,
+	0xcb, 0x9b, 0x58, 0x30, 0xd9, 0x15, 0x99, 0xc2, 0x78, 0x4c, 0x4e, 0xa3, 0x7c, 0x34, 0xd0, 0xd5,
+	0x18, 0x0d, 0xea, 0x38, 0xc9, 0x03, 0x98, 0x50, 0xec, 0x8e, 0x12, 0xc3, 0xdc, 0x1c, 0x8e, 0xfd,
+	0x88, 0x38, 0xab, 0x48, 0x23, 0xad, 0x4a, 0x02, 0x8c, 0x69, 0x99, 0x16, 0x90, 0x6c, 0x1b, 0xf6,
+	0x32, 0x56, 0x9e, 0x4c, 0x46, 0x32, 0xfd, 0x46, 0xc6, 0x9b, 0x49, 0x49, 0x99, 0x2a, 0x45, 0x52,
+	0x26, 0xf3, 0xd7, 0x2b, 0x70, 0x59, 0x3e, 0xb0, 0xe6, 0x5b, 0x2d, 0xaf, 0xef, 0x86, 0xb1, 0x35,
+	0x82, 0x08, 0x4a, 0x25, 0x89, 0x70, 0x86, 0x49, 0x44, 0xac, 0x42, 0x09, 0x61, 0x62, 0x06, 0x26,
+	0x93, 0x71, 0xdb, 0x3c, 0xed, 0x45, 0x7c, 0x16, 0xe9, 0xe1, 0xcf, 0x96, 0xf2, 0x2a, 0x60, 0x7e,
+	0x3b, 0xe9, 0xc3, 0x9e, 0xc6, 0x36, 0x32, 0x94, 0x0f, 0x7b, 0x9a, 0x74, 0x0e, 0x05, 0x76, 0x5d,
+	0x5b, 0xad, 0x16, 0xed, 0x85, 0xb4, 0x2d, 0x86, 0xa8, 0xb4, 0xa6, 0xfc, 0xba, 0x9e, 0x4f, 0x82,
+	0x30, 0x5d, 0xd7, 0xfc, 0xe2, 0x08, 0x3c, 0x9a, 0x9c, 0x44, 0xb6, 0x43, 0x55, 0xdc, 0xa8, 0x17,
+	0x95, 0x27, 0x8e, 0x98, 0xc8, 0x67, 0xd2, 0x9e, 0x38, 0xf5, 0x86, 0x4f, 0xf9, 0xc5, 0x6f, 0x39,
+	0x81, 0x6a, 0x94, 0xf0, 0xca, 0xf9, 0x32, 0x04, 0x81, 0x2a, 0x08, 0x76, 0x55, 0x3d, 0xd5, 0x60,
+	0x57, 0x9f, 0x32, 0x60, 0x26, 0x59, 0x7c, 0xd3, 0x76, 0xed, 0x60, 0x4b, 0x26, 0x6f, 0x38, 0xbe,
+	0x23, 0x10, 0xcf, 0x95, 0xba, 0x52, 0x88, 0x11, 0x07, 0x50, 0x63, 0x6e, 0x25, 0x8f, 0xa5, 0xe6,
+	0x25, 0x91, 0x4a, 0xe2, 0xf8, 0x3e, 0x41, 0x3c, 0x6c, 0xdf, 0x4a, 0x31, 0x4a, 0x1c, 0x44, 0xcf,
+	0xfc, 0x85, 0x0a, 0xd4, 0xb8, 0xd2, 0xff, 0xad, 0x61, 0xe7, 0xce, 0xbb, 0x5a, 0x68, 0xfa, 0xd5,
+	0x49, 0x99, 0x7e, 0xbd, 0x58, 0x9e, 0xc4, 0x60, 0xdb, 0xaf, 0x6f, 0x84, 0xab, 0xbc, 0xda, 0x7c,
+	0x9b, 0x8b, 0x6a, 0x02, 0xda, 0x9e, 0x6f, 0xb7, 0x79, 0xd0, 0xd0, 0xc3, 0x05, 0xf0, 0x4f, 0x40,
+	0xb5, 0xef, 0x3b, 0xe9, 0x30, 0xba, 0x2c, 0x5c, 0x1f, 0x2b, 0x37, 0x03, 0x98, 0x16, 0xa8, 0x35,
+	0x4b, 0x65, 0xe6, 0xd6, 0xca, 0x3e, 0xe2, 0x73, 0xef, 0x11, 0x61, 0x67, 0x8c, 0xd8, 0xad, 0x75,
+	0x25, 0x2e, 0x46, 0xbd, 0x0e, 0xf3, 0x01, 0x65, 0x3f, 0xdf, 0xc7, 0xed, 0xe1, 0x2b, 0xb1, 0x0f,
+	0xe8, 0x8a, 0x2a, 0xc4, 0x18, 0x6e, 0xb2, 0xc8, 0xf4, 0x9c, 0xaa, 0x76, 0x66, 0x90, 0x1d, 0x18,
+	0xf7, 0xe5, 0xb9, 0x21, 0x17, 0xc4, 0x4a, 0xe9, 0xf9, 0xcc, 0x39, 0x8b, 0xc4, 0x43, 0x4f, 0xfd,
+	0xc2, 0x88, 0x96, 0xf9, 0x85, 0x51, 0xa8, 0x17, 0x35, 0x62, 0x31, 0x1d, 0xae, 0xb6, 0x62, 0x46,
+	0x95, 0x05, 0x74, 0xf3, 0x7c, 0x3b, 0xb4, 0xa5, 0x09, 0x4e, 0xc9, 0x17, 0x7c, 0x63, 0x3e, 0xea,
+	0x15, 0xcf, 0xb7, 0xd0, 0xc8, 0xa5, 0x80, 0x05, 0x94, 0x59, 0x2a, 0xd9, 0xed, 0x38, 0xc1, 0x53,
+	0xa5, 0xbc, 0x93, 0x18, 0x1f, 0xb6, 0x96, 0x04, 0x4a, 0x75, 0x8a, 0x8b, 0x58, 0xb5, 0x72, 0x8d,
+	0x1c, 0x23, 0x1e, 0x04, 0x5b, 0x77, 0xe8, 0x5e, 0xcf, 0xb2, 0x95, 0xa1, 0x45, 0x79, 0xe2, 0xcd,
+	0xe6, 0x6d, 0x89, 0x2a, 0x49, 0x5c, 0x2b, 0xd7, 0xc8, 0x31, 0x55, 0xcd, 0x39, 0x4f, 0x0f, 0x6b,
+	0x38, 0x8c, 0x25, 0x6f, 0x6e, 0x7c, 0x44, 0xf1, 0x3a, 0x48, 0x82, 0x92, 0x24, 0xd9, 0x9a, 0x98,
+	0x0e, 0xd2, 0xf7, 0xa4, 0x3c, 0x49, 0x57, 0xcb, 0x71, 0x54, 0x05, 0x97, 0xae, 0x90, 0x34, 0x64,
+	0xc1, 0x59, 0xf2, 0xbc, 0x53, 0x34, 0x6c, 0xb5, 0x97, 0xdc, 0x96, 0xbf, 0xc7, 0x3d, 0xcd, 0x59,
+	0xa7, 0x46, 0xcb, 0x77, 0x6a, 0x69, 0xbd, 0xb1, 0x98, 0x40, 0x96, 0xec, 0x54, 0x16, 0x9c, 0x25,
+	0xcf, 0xb2, 0x73, 0x3c, 0x52, 0xb0, 0xc6, 0xfe, 0xda, 0xc4, 0xa1, 0x64, 0xce, 0x50, 0x7c, 0x0e,
+	0xde, 0x22, 0xce, 0x50, 0xbc, 0xaf, 0x05, 0xf6, 0x88, 0xbf, 0x69, 0xc8, 0xdb, 0xe2, 0x98, 0x29,
+	0x57, 0xce, 0xd0, 0x54, 0xee, 0xab, 0xe2, 0xac, 0x7e, 0xd5, 0x38, 0x40, 0x42, 0x3a, 0xa3, 0x9f,
+	0xf9, 0x32, 0x9c, 0x4b, 0x98, 0x23, 0x46, 0x31, 0xc3, 0x8d, 0xdc, 0x98, 0xe1, 0x7a, 0x48, 0xf0,
+	0xca, 0xa0, 0x90, 0xe0, 0xf1, 0x92, 0xcf, 0x9e, 0x6c, 0x7f, 0x6d, 0x96, 0xfc, 0x27, 0x0c, 0x98,
+	0x14, 0x73, 0x20, 0xfc, 0x5d, 0xe6, 0xe1, 0x82, 0x50, 0x51, 0x70, 0xb1, 0xc3, 0xdd, 0x78, 0xad,
+	0x44, 0x26, 0xc1, 0x0b, 0x49, 0x30, 0xa6, 0xeb, 0x8b, 0xb0, 0x27, 0xbb, 0xa2, 0xda, 0xbc, 0x4c,
+	0x55, 0x55, 0x53, 0x61, 0x4f, 0xe2, 0x72, 0x4c, 0xd4, 0x32, 0x7f, 0x6c, 0x5a, 0xee, 0x3d, 0xae,
+	0x83, 0x79, 0x15, 0x46, 0x79, 0x24, 0x74, 0x75, 0x75, 0x3f, 0x5f, 0x3a, 0xc2, 0x7a, 0x20, 0xde,
+	0x91, 0xe2, 0x7f, 0x94, 0x58, 0xc9, 0x22, 0x5c, 0x6c, 0x39, 0x5e, 0x9f, 0x29, 0x1c, 0x36, 0x6d,
+	0x87, 0x4b, 0xdf, 0xe4, 0x62, 0x89, 0x12, 0xe5, 0x34, 0x52, 0x70, 0xcc, 0xb4, 0x20, 0x28, 0xb4,
+	0x38, 0xe2, 0x62, 0x2d, 0x95, 0x28, 0x87, 0x69, 0x70, 0xc6, 0x12, 0xda, 0x9b, 0xd7, 0x01, 0xa8,
+	0xda, 0x45, 0xca, 0x99, 0xf6, 0x85, 0x72, 0x29, 0x80, 0xa2, 0xbd, 0xa8, 0x58, 0xef, 0xa8, 0x28,
+	0x40, 0x8d, 0x08, 0xf1, 0x61, 0x72, 0xcb, 0x66, 0xe2, 0x70, 0xc1, 0xd0, 0xd5, 0xca, 0x33, 0xc8,
+	0xb7, 0x63, 0x34, 0x82, 0x07, 0xd5, 0x0a, 0x50, 0x27, 0x42, 0x7c, 0x80, 0x58, 0x04, 0x5f, 0x1f,
+	0x2d, 0xcf, 0x9f, 0xc5, 0xb2, 0xfd, 0x78, 0x9c, 0x71, 0x19, 0x6a, 0x54, 0x88, 0x0b, 0xe0, 0x46,
+	0x29, 0x10, 0x86, 0xd1, 0xea, 0xc4, 0x89, 0x14, 0x04, 0x07, 0x14, 0xff, 0x46, 0x8d, 0x02, 0x9b,
+	0xd7, 0x6e, 0x9c, 0x53, 0xa3, 0x3e, 0x5e, 0x7e, 0x5e, 0xb5, 0xd4, 0x1c, 0x52, 0x72, 0x14, 0x17,
+	0xa0, 0x4e, 0x84, 0x8d, 0xb1, 0x1b, 0x65, 0xc2, 0xa8, 0x4f, 0x94, 0x1f, 0x63, 0x9c, 0x4f, 0x43,
+	0x8c, 0x31, 0xfe, 0x8d, 0x1a, 0x05, 0xa6, 0xc1, 0x8a, 0x94, 0x7f, 0x50, 0x5e, 0xfe, 0x76, 0x24,
+	0xc5, 0xdf, 0x7b, 0x63, 0x31, 0xd4, 0x24, 0xdf, 0xab, 0x8f, 0x69, 0x22, 0x28, 0x9e, 0x21, 0x84,
+	0x9d, 0x1f, 0x19, 0x91, 0x54, 0x6c, 0x91, 0x3d, 0x35, 0xd0, 0x22, 0xbb, 0x01, 0xd3, 0xc2, 0x35,
+	0x43, 0xfa, 0x48, 0xf1, 0x43, 0xe1, 0x5c, 0xac, 0x45, 0x6a, 0xa6, 0x81, 0x98, 0xad, 0x2f, 0x6e,
+	0x1f, 0xda, 0xe6, 0x6d, 0xcf, 0xeb, 0xb7, 0x8f, 0x28, 0xc3, 0x08, 0x4a, 0x76, 0x60, 0x2a, 0xd0,
+	0xcc, 0xbb, 0xeb, 0x17, 0x86, 0xd5, 0xff, 0x09, 0x3c, 0xe2, 0xa0, 0xd5, 0x4b, 0x30, 0x41, 0x87,
+	0xbc, 0xa9, 0xdb, 0xb3, 0x5e, 0x2c, 0xef, 0xcd, 0x9c, 0x9f, 0xf9, 0x24, 0x96, 0x2f, 0x2a, 0x50,
+	0xa0, 0x9b, 0x99, 0xf6, 0x93, 0x96, 0x9b, 0xd3, 0x27, 0x12, 0xbd, 0xe1, 0x50, 0xcb, 0x4e, 0xf6,
+	0x69, 0xe9, 0x6e, 0xcf, 0x0b, 0x58, 0xc0, 0x02, 0xc7, 0x0a, 0x02, 0xfe, 0x79, 0x48, 0xfc, 0x69,
+	0x97, 0xd2, 0x40, 0xcc, 0xd6, 0x27, 0x9f, 0x30, 0xe0, 0x62, 0xb0, 0x17, 0x84, 0xb4, 0xcb, 0xee,
+	0x50, 0xcf, 0xa5, 0x4c, 0x05, 0x7d, 0xa9, 0x7c, 0x8e, 0xb6, 0x66, 0x0a, 0x97, 0x88, 0xf7, 0x91,
+	0x2e, 0xc5, 0x0c, 0x4d, 0xb6, 0x72, 0xf4, 0xf8, 0x0f, 0xf5, 0xcb, 0xe5, 0x57, 0x8e, 0x1e, 0x5b,
+	0x42, 0xac, 0x1c, 0xbd, 0x04, 0x13, 0x74, 0x98, 0x3b, 0x40, 0xa0, 0xd2, 0x4d, 0xf3, 0x19, 0xbc,
+	0x12, 0x07, 0xd8, 0x6f, 0xea, 0x00, 0x4c, 0xd6, 0x63, 0xae, 0x37, 0x5b, 0x9e, 0xb7, 0x1d, 0xd4,
+	0xaf, 0x96, 0x77, 0xbd, 0xb9, 0xed, 0x79, 0xdb, 0x31, 0xab, 0xcb, 0x7e, 0x05, 0x28, 0xb0, 0x92,
+	0x16, 0x8c, 0x4a, 0x7f, 0xde, 0x47, 0x86, 0x95, 0xed, 0x70, 0x34, 0x32, 0x72, 0x3b, 0xff, 0x1f,
+	0x25, 0x6a, 0xf3, 0xdf, 0x33, 0x25, 0x80, 0x92, 0xff, 0x9c, 0x85, 0x56, 0xa3, 0x9d, 0x10, 0x89,
+	0x2d, 0x0c, 0x25, 0xaf, 0xa2, 0x85, 0xba, 0x8d, 0xdf, 0x37, 0xe0, 0x7c, 0x5c, 0xed, 0x0c, 0xde,
+	0x3d, 0xad, 0xe4, 0xbb, 0xe7, 0x83, 0xc3, 0x8d, 0xab, 0xe0, 0xf1, 0xf3, 0x7f, 0x2b, 0xfa, 0xa8,
+	0x38, 0x47, 0xb9, 0x93, 0xb0, 0x45, 0x60, 0xa4, 0x6f, 0x0f, 0x63, 0x8b, 0xa0, 0xfb, 0xd5, 0xc7,
+	0xe3, 0xcd, 0xb1, 0x4d, 0xf8, 0xf6, 0x04, 0x3f, 0x37, 0x44, 0xf4, 0x88, 0x88, 0x79, 0x53, 0xa4,
+	0xc5, 0x04, 0x1c, 0xc6, 0xdc, 0xbd, 0xae, 0x1f, 0xf7, 0xc2, 0xaa, 0xe1, 0x43, 0xe5, 0x42, 0x16,
+	0x68, 0x03, 0x1e, 0x78, 0xc8, 0x9b, 0xdf, 0x7f, 0x41, 0xbd, 0x29, 0x84, 0x19, 0x48, 0xd2, 0xb2,
+	0xc2, 0x38, 0x0b, 0xcb, 0x8a, 0x10, 0x26, 0x5b, 0x51, 0x96, 0x47, 0x35, 0xed, 0x43, 0xd2, 0x8c,
+	0xae, 0x99, 0x38, 0x7f, 0x64, 0x80, 0x3a, 0x19, 0xc6, 0x0c, 0x45, 0x6b, 0xac, 0x7a, 0x02, 0xf6,
+	0x2e, 0x83, 0xd6, 0xd5, 0x7b, 0x00, 0x14, 0x3f, 0x4d, 0xdb, 0x32, 0x88, 0x69, 0xe4, 0x3b, 0xb1,
+	0x1c, 0xdc, 0x8e, 0x60, 0xa8, 0xd5, 0xcb, 0x6a, 0xea, 0x6b, 0x67, 0xa7, 0xa9, 0x7f, 0x1d, 0xc0,
+	0x51, 0x49, 0xc6, 0x87, 0xb2, 0xdd, 0x8a, 0x52, 0x95, 0xc7, 0xcb, 0x20, 0x2a, 0x0a, 0x50, 0x23,
+	0x52, 0x60, 0x60, 0x33, 0x56, 0xca, 0xc0, 0xa6, 0x0f, 0x97, 0x7c, 0x1a, 0xfa, 0x7b, 0x8d, 0xbd,
+	0x16, 0xcf, 0xbd, 0x2f, 0x1c, 0x69, 0x4b, 0x58, 0x5f, 0xf0, 0xd8, 0x33, 0x98, 0x45, 0x85, 0x79,
+	0xf8, 0x13, 0x0c, 0xe5, 0xc4, 0x40, 0x86, 0xf2, 0xbd, 0x30, 0x19, 0xd2, 0xd6, 0x96, 0x6b, 0xb7,
+	0x2c, 0x67, 0x79, 0x51, 0xe6, 0xb0, 0x89, 0x79, 0xa3, 0x18, 0x84, 0x7a, 0x3d, 0xb2, 0x00, 0xd5,
+	0xbe, 0xdd, 0x96, 0x1c, 0xf5, 0xbb, 0x22, 0xa5, 0xc3, 0xf2, 0xe2, 0xc3, 0xfd, 0xd9, 0xb7, 0xc7,
+	0x16, 0x2b, 0xd1, 0xa8, 0x6e, 0xf4, 0xb6, 0x3b, 0x37, 0x98, 0x57, 0x65, 0x30, 0xb7, 0xb1, 0xbc,
+	0x88, 0xac, 0x71, 0x9e, 0xf1, 0xd1, 0xd4, 0x31, 0x8c, 0x8f, 0x3e, 0x6b, 0xc0, 0x25, 0x2b, 0xad,
+	0x2f, 0xa1, 0x41, 0xfd, 0x5c, 0xf9, 0xd3, 0x32, 0x5f, 0x07, 0x13, 0x27, 0x90, 0x98, 0xcf, 0x92,
+	0xc3, 0xbc, 0x3e, 0x30, 0xa1, 0x4c, 0x57, 0x59, 0xa5, 0xc7, 0x5f, 0xfd, 0x7c, 0x39, 0xa1, 0xcc,
+	0x6a, 0x06, 0x13, 0xe6, 0x60, 0x27, 0x0f, 0x60, 0xb2, 0x15, 0x2b, 0x38, 0xea, 0x17, 0x86, 0xe0,
+	0x31, 0x53, 0xca, 0x12, 0xf1, 0x7a, 0xd4, 0x0a, 0x50, 0xa7, 0x14, 0xe9, 0x43, 0xb5, 0x67, 0xbb,
+	0xd4, 0x09, 0xf2, 0x51, 0x5f, 0x2c, 0xaf, 0x0f, 0xcd, 0xc7, 0x88, 0x03, 0xa8, 0xf1, 0x60, 0x5f,
+	0x4e, 0x32, 0x2d, 0x7f, 0x7d, 0xba, 0xbc, 0x91, 0x4f, 0x2a, 0xc3, 0xbf, 0x58, 0x9a, 0xa9, 0x42,
+	0x4c, 0x13, 0x24, 0x37, 0x81, 0x50, 0x21, 0x27, 0x8f, 0x1f, 0x3b, 0x41, 0x9d, 0x70, 0x55, 0x3d,
+	0xff, 0xa4, 0x4b, 0x19, 0x28, 0xe6, 0xb4, 0x20, 0x6f, 0xc2, 0x94, 0xa5, 0xa9, 0xed, 0xea, 0x97,
+	0x86, 0xcc, 0xdf, 0xae, 0xeb, 0x00, 0x65, 0xc0, 0x42, 0xad, 0x04, 0x13, 0xc4, 0xcc, 0xdf, 0x33,
+	0xa4, 0x08, 0xf5, 0x0c, 0x8d, 0x72, 0x4e, 0x5b, 0xa3, 0x6b, 0xfe, 0x19, 0x53, 0x4c, 0xa6, 0x9f,
+	0x46, 0xf7, 0x99, 0x9f, 0xa9, 0x4f, 0x59, 0xe6, 0x3a, 0xa3, 0xbc, 0x91, 0x6b, 0x43, 0xa0, 0x10,
+	0xf2, 0x68, 0xf9, 0x03, 0x15, 0x62, 0xf6, 0xfc, 0x72, 0xb5, 0x5c, 0x80, 0x72, 0x84, 0xa5, 0x98,
+	0x2a, 0x3d, 0xa7, 0xa0, 0xf8, 0x8a, 0x7a, 0x09, 0x26, 0xe8, 0x98, 0x2b, 0x00, 0xf1, 0x03, 0x77,
+	0x68, 0x3b, 0xad, 0x2f, 0xd5, 0xe0, 0xca, 0xb0, 0x7e, 0x30, 0x3c, 0xa5, 0x3d, 0xdd, 0xb1, 0x5b,
+	0xe1, 0xfc, 0x66, 0x48, 0xfd, 0x7b, 0xf7, 0x56, 0xd7, 0xb7, 0x7c, 0x1a, 0x6c, 0x79, 0x4e, 0xbb,
+	0x64, 0x1a, 0x08, 0xae, 0x62, 0x5d, 0xca, 0xc5, 0x88, 0x05, 0x94, 0xf8, 0xe3, 0x7e, 0x47, 0x44,
+	0xd5, 0x45, 0xc6, 0x11, 0xf7, 0xfd, 0x20, 0x94, 0xf1, 0x9a, 0xc4, 0xe3, 0x3e, 0x0d, 0xc4, 0x6c,
+	0xfd, 0x34, 0x12, 0x1e, 0x61, 0x9c, 0x73, 0x55, 0x46, 0x16, 0x09, 0x07, 0x62, 0xb6, 0xbe, 0x8e,
+	0x44, 0x7c, 0x29, 0x76, 0x64, 0xd5, 0xb2, 0x48, 0x22, 0x20, 0x66, 0xeb, 0x93, 0x36, 0x3c, 0xee,
+	0xd3, 0x96, 0xd7, 0xed, 0x52, 0xb7, 0xcd, 0x27, 0x65, 0xd5, 0xf2, 0x3b, 0xb6, 0x7b, 0xd3, 0xb7,
+	0x78, 0x45, 0x2e, 0x2b, 0x35, 0x78, 0x86, 0xdc, 0xc7, 0x71, 0x40, 0x3d, 0x1c, 0x88, 0x85, 0x74,
+	0xe1, 0x82, 0x48, 0x4d, 0xef, 0x2f, 0xbb, 0x21, 0x53, 0x98, 0x3a, 0xf5, 0xb1, 0x52, 0x5f, 0x8c,
+	0x1f, 0xa3, 0x1b, 0x49, 0x54, 0x98, 0xc6, 0xcd, 0x32, 0x40, 0x45, 0xdd, 0xd1, 0x48, 0x8e, 0x97,
+	0x22, 0x29, 0x19, 0xa8, 0x0c, 0x3a, 0xcc, 0xa3, 0x61, 0x7e, 0xd6, 0x00, 0x69, 0x76, 0xcf, 0x14,
+	0x47, 0x9a, 0xf6, 0x6b, 0x3c, 0xa5, 0xf9, 0x52, 0x39, 0x71, 0x2b, 0xb9, 0x39, 0x71, 0xdf, 0xa1,
+	0x05, 0x02, 0x9b, 0x88, 0xcf, 0x3e, 0x81, 0x59, 0xcb, 0xe7, 0xfd, 0x4e, 0x98, 0x88, 0x8e, 0x7f,
+	0xc9, 0x96, 0x73, 0xe3, 0x8a, 0xf8, 0x9e, 0x88, 0xe1, 0x2c, 0x42, 0x1b, 0xc4, 0xa1, 0xd0, 0x8f,
+	0x96, 0x85, 0xfc, 0x50, 0x0b, 0x3b, 0x2d, 0x7b, 0x7a, 0xb5, 0x30, 0x7b, 0xfa, 0x29, 0x25, 0x15,
+	0xff, 0x45, 0x03, 0x2e, 0x24, 0x23, 0xb3, 0xf1, 0x68, 0xf9, 0x32, 0x76, 0xab, 0x74, 0x06, 0xe4,
+	0x4d, 0x65, 0xe8, 0x10, 0x54, 0xb0, 0xa4, 0x5c, 0x72, 0x88, 0x77, 0x72, 0x7e, 0x80, 0xb8, 0x43,
+	0x9e, 0xac, 0xdf, 0x73, 0x11, 0x46, 0x45, 0xe0, 0x4f, 0x76, 0xa6, 0xe5, 0xb8, 0x4c, 0xdf, 0x29,
+	0x1f, 0x5f, 0xb4, 0x8c, 0x9f, 0xab, 0x9e, 0x23, 0xb5, 0x32, 0x30, 0x47, 0x2a, 0x42, 0xb5, 0xe5,
+	0xdb, 0xc3, 0xe8, 0xa0, 0x1a, 0xb8, 0x2c, 0x74, 0x50, 0x0d, 0x5c, 0x46, 0x86, 0x8c, 0x84, 0x09,
+	0xe5, 0xcc, 0x48, 0x79, 0xf6, 0x53, 0x4c, 0x80, 0xa6, 0xa2, 0x39, 0x3f, 0x50, 0x3d, 0xa3, 0x22,
+	0x2b, 0xd6, 0xca, 0x5b, 0xbc, 0xca, 0x29, 0x3f, 0x42, 0x64, 0xc5, 0x68, 0x23, 0x8d, 0x16, 0x6e,
+	0xa4, 0x4d, 0x18, 0x93, 0x5b, 0xa1, 0x3e, 0x56, 0x9e, 0x9b, 0x90, 0x0a, 0x78, 0x2d, 0x28, 0xbf,
+	0x28, 0x40, 0x85, 0x9c, 0xdd, 0xb8, 0x32, 0xd9, 0x16, 0x3f, 0x11, 0x6b, 0x7a, 0x55, 0x5e, 0x8c,
+	0x0a, 0xce, 0xab, 0x0a, 0x43, 0xe1, 0xfa, 0x44, 0xaa, 0xaa, 0x28, 0x46, 0x05, 0x27, 0x1f, 0x85,
+	0x71, 0x96, 0x3c, 0xa9, 0xef, 0x77, 0x68, 0x1d, 0x0e, 0xe1, 0xf1, 0xfa, 0xa1, 0xed, 0xcc, 0x31,
+	0x19, 0x46, 0xe8, 0xcf, 0x2d, 0xbb, 0xe1, 0x3d, 0xbf, 0x19, 0xfa, 0x51, 0xea, 0xf3, 0x55, 0x89,
+	0x05, 0x23, 0x7c, 0xc4, 0x81, 0xf3, 0x5d, 0x6b, 0x77, 0xc3, 0x95, 0x6c, 0xa6, 0x23, 0x34, 0x32,
+	0x65, 0x28, 0x70, 0x43, 0x81, 0xd5, 0x04, 0x2e, 0x4c, 0xe1, 0xce, 0xb1, 0x49, 0x98, 0x3a, 0x2d,
+	0x9b, 0x84, 0xf9, 0xc8, 0xb9, 0x4c, 0x3c, 0x3e, 0x1f, 0xcd, 0x8d, 0x2a, 0x31, 0xd0, 0x71, 0xec,
+	0xd5, 0xc8, 0x71, 0xec, 0x7c, 0x79, 0xdd, 0xf5, 0x00, 0xa7, 0xb1, 0x3e, 0x4c, 0x32, 0x0e, 0x5b,
+	0x94, 0xb2, 0xd7, 0x61, 0x69, 0x39, 0xea, 0x62, 0x84, 0x26, 0x3e, 0x92, 0xe2, 0xb2, 0x00, 0x75,
+	0x3a, 0xcc, 0xf4, 0x9a, 0x6d, 0x56, 0x87, 0x86, 0x71, 0x95, 0xbb, 0x96, 0x7c, 0x15, 0x4e, 0x08,
+	0xd3, 0xeb, 0x3b, 0x79, 0x15, 0x30, 0xbf, 0x5d, 0x1c, 0x01, 0x69, 0x3a, 0x3f, 0x02, 0x12, 0xf9,
+	0xbe, 0x3c, 0x85, 0x0b, 0x29, 0x9f, 0x2b, 0x41, 0x9c, 0x0d, 0xa5, 0xd5, 0x2e, 0xbf, 0x64, 0x40,
+	0x5d, 0xae, 0x32, 0xa9, 0x24, 0x71, 0xa8, 0xbf, 0x6a, 0xb9, 0x56, 0x87, 0xfa, 0xf5, 0x4b, 0xe5,
+	0xfd, 0x81, 0x57, 0x0b, 0x70, 0x46, 0x1e, 0x7d, 0x4f, 0x1d, 0xec, 0xcf, 0x5e, 0x3f, 0xac, 0x16,
+	0x16, 0xf6, 0x8d, 0xf8, 0x30, 0x16, 0xec, 0x05, 0xad, 0xd0, 0x09, 0xea, 0x97, 0xaf, 0x57, 0xcb,
+	0x46, 0xca, 0x97, 0x27, 0x6b, 0x53, 0x60, 0x12, 0x47, 0x6b, 0x9c, 0x65, 0x4a, 0x94, 0xa2, 0x22,
+	0x34, 0x6c, 0x8c, 0x84, 0x21, 0xc2, 0xde, 0xce, 0x3c, 0x0f, 0x53, 0x7a, 0x27, 0x8f, 0x15, 0x9a,
+	0xe1, 0x27, 0x0d, 0xb8, 0x98, 0xbe, 0xb4, 0xc8, 0x16, 0x8c, 0xc9, 0x15, 0x5c, 0x37, 0xca, 0x8b,
+	0x4b, 0xe5, 0xde, 0x90, 0xf1, 0x89, 0x38, 0x0f, 0x24, 0x8b, 0x50, 0xa1, 0xd7, 0x2d, 0xa2, 0x2a,
+	0x03, 0x2c, 0xa2, 0x5e, 0x80, 0xab, 0xf9, 0x6b, 0x99, 0x71, 0x90, 0x96, 0xe3, 0x78, 0x0f, 0xe4,
+	0xcb, 0x2d, 0xe2, 0x20, 0x99, 0x3f, 0xd1, 0x03, 0x14, 0x30, 0xf3, 0xe3, 0x90, 0x0e, 0x72, 0x4e,
+	0x5e, 0x83, 0x89, 0x20, 0xd8, 0x12, 0xf1, 0x6b, 0xeb, 0xc6, 0x10, 0x4f, 0x76, 0x15, 0x04, 0x57,
+	0x30, 0xbd, 0xd1, 0x4f, 0x8c, 0xd1, 0x2f, 0xbc, 0xf2, 0xf9, 0x2f, 0x5e, 0x7b, 0xdb, 0xef, 0x7e,
+	0xf1, 0xda, 0xdb, 0xbe, 0xf0, 0xc5, 0x6b, 0x6f, 0xfb, 0xce, 0x83, 0x6b, 0xc6, 0xe7, 0x0f, 0xae,
+	0x19, 0xbf, 0x7b, 0x70, 0xcd, 0xf8, 0xc2, 0xc1, 0x35, 0xe3, 0xbf, 0x1e, 0x5c, 0x33, 0x7e, 0xe0,
+	0x8f, 0xaf, 0xbd, 0xed, 0xa3, 0xcf, 0xc5, 0xd4, 0x6f, 0x28, 0xa2, 0xf1, 0x3f, 0x4c, 0x06, 0xc9,
+	0xa8, 0x2b, 0x3f, 0x3a, 0x4e, 0xfd, 0xff, 0x0f, 0x00, 0x5c, 0xc1, 0x65, 0x6b, 0x79, 0x07, 0x01,
+	0x00,
 }
 
 func (m *APIServerLogging) Marshal() (dAtA []byte, err error) {
@@ -7514,6 +8066,16 @@ func (m *ControllerRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -7557,6 +8119,18 @@ func (m *ControllerRegistrationDeployment) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.Rollout != nil {
+		{
+			size, err := m.Rollout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.DeploymentRefs) > 0 {
 		for iNdEx := len(m.DeploymentRefs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7640,6 +8214,109 @@ func (m *ControllerRegistrationList) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ControllerRegistrationRollout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerRegistrationRollout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerRegistrationRollout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUnavailable != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxUnavailable))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CanarySeedSelector != nil {
+		{
+			size, err := m.CanarySeedSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ControllerRegistrationRolloutStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerRegistrationRolloutStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerRegistrationRolloutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdateTime != nil {
+		{
+			size, err := m.LastUpdateTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FailedSeeds) > 0 {
+		for iNdEx := len(m.FailedSeeds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailedSeeds[iNdEx])
+			copy(dAtA[i:], m.FailedSeeds[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailedSeeds[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.AvailableSeeds))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintGenerated(dAtA, i, uint64(m.UpdatedSeeds))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Seeds))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.DeploymentName)
+	copy(dAtA[i:], m.DeploymentName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeploymentName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ControllerRegistrationSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7689,6 +8366,41 @@ func (m *ControllerRegistrationSpec) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ControllerRegistrationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerRegistrationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerRegistrationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rollout != nil {
+		{
+			size, err := m.Rollout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ControllerResource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8837,6 +9549,75 @@ func (m *HighAvailability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Hook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailurePolicy != nil {
+		i -= len(*m.FailurePolicy)
+		copy(dAtA[i:], *m.FailurePolicy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.FailurePolicy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ProviderConfig != nil {
+		{
+			size, err := m.ProviderConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Point)
+	copy(dAtA[i:], m.Point)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Point)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HorizontalPodAutoscalerConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11591,6 +12372,255 @@ func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectAccessReview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectAccessReview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectAccessReview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.LastReviewTimestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectAccumulatedUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectAccumulatedUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectAccumulatedUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaximumHours) > 0 {
+		keysForMaximumHours := make([]string, 0, len(m.MaximumHours))
+		for k := range m.MaximumHours {
+			keysForMaximumHours = append(keysForMaximumHours, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMaximumHours)
+		for iNdEx := len(keysForMaximumHours) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MaximumHours[k8s_io_api_core_v1.ResourceName(keysForMaximumHours[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMaximumHours[iNdEx])
+			copy(dAtA[i:], keysForMaximumHours[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMaximumHours[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MaximumRates) > 0 {
+		keysForMaximumRates := make([]string, 0, len(m.MaximumRates))
+		for k := range m.MaximumRates {
+			keysForMaximumRates = append(keysForMaximumRates, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMaximumRates)
+		for iNdEx := len(keysForMaximumRates) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MaximumRates[k8s_io_api_core_v1.ResourceName(keysForMaximumRates[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMaximumRates[iNdEx])
+			copy(dAtA[i:], keysForMaximumRates[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMaximumRates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Hours) > 0 {
+		keysForHours := make([]string, 0, len(m.Hours))
+		for k := range m.Hours {
+			keysForHours = append(keysForHours, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHours)
+		for iNdEx := len(keysForHours) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hours[k8s_io_api_core_v1.ResourceName(keysForHours[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHours[iNdEx])
+			copy(dAtA[i:], keysForHours[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHours[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Rates) > 0 {
+		keysForRates := make([]string, 0, len(m.Rates))
+		for k := range m.Rates {
+			keysForRates = append(keysForRates, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForRates)
+		for iNdEx := len(keysForRates) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Rates[k8s_io_api_core_v1.ResourceName(keysForRates[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForRates[iNdEx])
+			copy(dAtA[i:], keysForRates[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForRates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.LastUpdateTimestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Since.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectBastionSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectBastionSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectBastionSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSessionDuration != nil {
+		{
+			size, err := m.MaxSessionDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i--
+	if m.RequireApproval {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11638,6 +12668,43 @@ func (m *ProjectList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectMachineImageRestriction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectMachineImageRestriction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectMachineImageRestriction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Versions[iNdEx])
+			copy(dAtA[i:], m.Versions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Versions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11658,6 +12725,32 @@ func (m *ProjectMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RoleExpirations) > 0 {
+		for iNdEx := len(m.RoleExpirations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleExpirations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExpirationDate != nil {
+		{
+			size, err := m.ExpirationDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Roles) > 0 {
 		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Roles[iNdEx])
@@ -11685,6 +12778,232 @@ func (m *ProjectMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectMemberAccessReview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectMemberAccessReview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectMemberAccessReview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.FlaggedForRemoval {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if m.LastActivityTimestamp != nil {
+		{
+			size, err := m.LastActivityTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.FirstReviewTimestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Subject.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectMemberRoleExpiration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectMemberRoleExpiration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectMemberRoleExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExpirationDate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Role)
+	copy(dAtA[i:], m.Role)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Role)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Limits) > 0 {
+		keysForLimits := make([]string, 0, len(m.Limits))
+		for k := range m.Limits {
+			keysForLimits = append(keysForLimits, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLimits)
+		for iNdEx := len(keysForLimits) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Limits[k8s_io_api_core_v1.ResourceName(keysForLimits[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLimits[iNdEx])
+			copy(dAtA[i:], keysForLimits[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLimits[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectRestrictions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectRestrictions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectRestrictions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VolumeTypes) > 0 {
+		for iNdEx := len(m.VolumeTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VolumeTypes[iNdEx])
+			copy(dAtA[i:], m.VolumeTypes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.VolumeTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MachineImages) > 0 {
+		for iNdEx := len(m.MachineImages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MachineImages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Architectures) > 0 {
+		for iNdEx := len(m.Architectures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Architectures[iNdEx])
+			copy(dAtA[i:], m.Architectures[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Architectures[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MachineTypes) > 0 {
+		for iNdEx := len(m.MachineTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MachineTypes[iNdEx])
+			copy(dAtA[i:], m.MachineTypes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.MachineTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Regions) > 0 {
+		for iNdEx := len(m.Regions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Regions[iNdEx])
+			copy(dAtA[i:], m.Regions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Regions[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11705,6 +13024,42 @@ func (m *ProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Bastion != nil {
+		{
+			size, err := m.Bastion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Restrictions != nil {
+		{
+			size, err := m.Restrictions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Quota != nil {
+		{
+			size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Tolerations != nil {
 		{
 			size, err := m.Tolerations.MarshalToSizedBuffer(dAtA[:i])
@@ -11799,6 +13154,59 @@ func (m *ProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AccumulatedUsage != nil {
+		{
+			size, err := m.AccumulatedUsage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.AccessReview != nil {
+		{
+			size, err := m.AccessReview.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Usage) > 0 {
+		keysForUsage := make([]string, 0, len(m.Usage))
+		for k := range m.Usage {
+			keysForUsage = append(keysForUsage, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsage)
+		for iNdEx := len(keysForUsage) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Usage[k8s_io_api_core_v1.ResourceName(keysForUsage[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsage[iNdEx])
+			copy(dAtA[i:], keysForUsage[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsage[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.LastActivityTimestamp != nil {
 		{
 			size, err := m.LastActivityTimestamp.MarshalToSizedBuffer(dAtA[:i])
@@ -12637,6 +14045,51 @@ func (m *SeedDNSProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SeedDrainStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeedDrainStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeedDrainStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MigratingShoots) > 0 {
+		for iNdEx := len(m.MigratingShoots) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MigratingShoots[iNdEx])
+			copy(dAtA[i:], m.MigratingShoots[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.MigratingShoots[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RemainingShoots))
+	i--
+	dAtA[i] = 0x10
+	{
+		size, err := m.StartTimestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SeedList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13243,6 +14696,18 @@ func (m *SeedSettingScheduling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Drain != nil {
+		{
+			size, err := m.Drain.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	i--
 	if m.Visible {
 		dAtA[i] = 1
@@ -13254,6 +14719,34 @@ func (m *SeedSettingScheduling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SeedSettingSchedulingDrain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeedSettingSchedulingDrain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeedSettingSchedulingDrain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxParallelMigrations != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxParallelMigrations))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SeedSettingTopologyAwareRouting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13546,6 +15039,18 @@ func (m *SeedStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Drain != nil {
+		{
+			size, err := m.Drain.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.LastOperation != nil {
 		{
 			size, err := m.LastOperation.MarshalToSizedBuffer(dAtA[:i])
@@ -14057,6 +15562,43 @@ func (m *ShootAdvertisedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ShootAvailability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootAvailability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootAvailability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Last7Days != nil {
+		i -= len(*m.Last7Days)
+		copy(dAtA[i:], *m.Last7Days)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Last7Days)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Last24Hours != nil {
+		i -= len(*m.Last24Hours)
+		copy(dAtA[i:], *m.Last24Hours)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Last24Hours)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ShootCredentials) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14412,6 +15954,39 @@ func (m *ShootSSHKeypairRotation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ShootSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBackupAge != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxBackupAge))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.BackupEntryName)
+	copy(dAtA[i:], m.BackupEntryName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BackupEntryName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ShootSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14432,6 +16007,36 @@ func (m *ShootSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Source != nil {
+		{
+			size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
 	if m.SchedulerName != nil {
 		i -= len(*m.SchedulerName)
 		copy(dAtA[i:], *m.SchedulerName)
@@ -14837,6 +16442,20 @@ func (m *ShootStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Availability != nil {
+		{
+			size, err := m.Availability.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if len(m.EncryptedResources) > 0 {
 		for iNdEx := len(m.EncryptedResources) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EncryptedResources[iNdEx])
@@ -16442,6 +18061,8 @@ func (m *ControllerRegistration) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16465,6 +18086,10 @@ func (m *ControllerRegistrationDeployment) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Rollout != nil {
+		l = m.Rollout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16485,6 +18110,48 @@ func (m *ControllerRegistrationList) Size() (n int) {
 	return n
 }
 
+func (m *ControllerRegistrationRollout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CanarySeedSelector != nil {
+		l = m.CanarySeedSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxUnavailable != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxUnavailable))
+	}
+	return n
+}
+
+func (m *ControllerRegistrationRolloutStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeploymentName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Seeds))
+	n += 1 + sovGenerated(uint64(m.UpdatedSeeds))
+	n += 1 + sovGenerated(uint64(m.AvailableSeeds))
+	if len(m.FailedSeeds) > 0 {
+		for _, s := range m.FailedSeeds {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.LastUpdateTime != nil {
+		l = m.LastUpdateTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ControllerRegistrationSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16504,6 +18171,19 @@ func (m *ControllerRegistrationSpec) Size() (n int) {
 	return n
 }
 
+func (m *ControllerRegistrationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rollout != nil {
+		l = m.Rollout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ControllerResource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16957,6 +18637,33 @@ func (m *HighAvailability) Size() (n int) {
 	return n
 }
 
+func (m *Hook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Point)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ProviderConfig != nil {
+		l = m.ProviderConfig.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FailurePolicy != nil {
+		l = len(*m.FailurePolicy)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *HorizontalPodAutoscalerConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17987,6 +19694,86 @@ func (m *Project) Size() (n int) {
 	return n
 }
 
+func (m *ProjectAccessReview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LastReviewTimestamp.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectAccumulatedUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Since.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastUpdateTimestamp.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Rates) > 0 {
+		for k, v := range m.Rates {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Hours) > 0 {
+		for k, v := range m.Hours {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MaximumRates) > 0 {
+		for k, v := range m.MaximumRates {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MaximumHours) > 0 {
+		for k, v := range m.MaximumHours {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ProjectBastionSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	if m.MaxSessionDuration != nil {
+		l = m.MaxSessionDuration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ProjectList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18004,6 +19791,23 @@ func (m *ProjectList) Size() (n int) {
 	return n
 }
 
+func (m *ProjectMachineImageRestriction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Versions) > 0 {
+		for _, s := range m.Versions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ProjectMember) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18020,6 +19824,104 @@ func (m *ProjectMember) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.ExpirationDate != nil {
+		l = m.ExpirationDate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.RoleExpirations) > 0 {
+		for _, e := range m.RoleExpirations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectMemberAccessReview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Subject.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.FirstReviewTimestamp.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastActivityTimestamp != nil {
+		l = m.LastActivityTimestamp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
+func (m *ProjectMemberRoleExpiration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ExpirationDate.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ProjectQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Limits) > 0 {
+		for k, v := range m.Limits {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ProjectRestrictions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Regions) > 0 {
+		for _, s := range m.Regions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.MachineTypes) > 0 {
+		for _, s := range m.MachineTypes {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Architectures) > 0 {
+		for _, s := range m.Architectures {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.MachineImages) > 0 {
+		for _, e := range m.MachineImages {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.VolumeTypes) > 0 {
+		for _, s := range m.VolumeTypes {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18059,6 +19961,18 @@ func (m *ProjectSpec) Size() (n int) {
 		l = m.Tolerations.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Restrictions != nil {
+		l = m.Restrictions.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Bastion != nil {
+		l = m.Bastion.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18083,6 +19997,23 @@ func (m *ProjectStatus) Size() (n int) {
 		l = m.LastActivityTimestamp.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Usage) > 0 {
+		for k, v := range m.Usage {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.AccessReview != nil {
+		l = m.AccessReview.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AccumulatedUsage != nil {
+		l = m.AccumulatedUsage.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18366,6 +20297,24 @@ func (m *SeedDNSProvider) Size() (n int) {
 	return n
 }
 
+func (m *SeedDrainStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StartTimestamp.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.RemainingShoots))
+	if len(m.MigratingShoots) > 0 {
+		for _, s := range m.MigratingShoots {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SeedList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18600,6 +20549,22 @@ func (m *SeedSettingScheduling) Size() (n int) {
 	var l int
 	_ = l
 	n += 2
+	if m.Drain != nil {
+		l = m.Drain.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SeedSettingSchedulingDrain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxParallelMigrations != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxParallelMigrations))
+	}
 	return n
 }
 
@@ -18744,6 +20709,10 @@ func (m *SeedStatus) Size() (n int) {
 		l = m.LastOperation.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Drain != nil {
+		l = m.Drain.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18888,6 +20857,23 @@ func (m *ShootAdvertisedAddress) Size() (n int) {
 	return n
 }
 
+func (m *ShootAvailability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Last24Hours != nil {
+		l = len(*m.Last24Hours)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Last7Days != nil {
+		l = len(*m.Last7Days)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ShootCredentials) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19021,6 +21007,20 @@ func (m *ShootSSHKeypairRotation) Size() (n int) {
 	return n
 }
 
+func (m *ShootSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BackupEntryName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MaxBackupAge != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxBackupAge))
+	}
+	return n
+}
+
 func (m *ShootSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19109,6 +21109,16 @@ func (m *ShootSpec) Size() (n int) {
 		l = len(*m.SchedulerName)
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Source != nil {
+		l = m.Source.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19245,6 +21255,10 @@ func (m *ShootStatus) Size() (n int) {
 			n += 2 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Availability != nil {
+		l = m.Availability.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19993,6 +22007,7 @@ func (this *ControllerRegistration) String() string {
 	s := strings.Join([]string{`&ControllerRegistration{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ControllerRegistrationSpec", "ControllerRegistrationSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ControllerRegistrationStatus", "ControllerRegistrationStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20010,6 +22025,7 @@ func (this *ControllerRegistrationDeployment) String() string {
 		`Policy:` + valueToStringGenerated(this.Policy) + `,`,
 		`SeedSelector:` + strings.Replace(fmt.Sprintf("%v", this.SeedSelector), "LabelSelector", "v11.LabelSelector", 1) + `,`,
 		`DeploymentRefs:` + repeatedStringForDeploymentRefs + `,`,
+		`Rollout:` + strings.Replace(this.Rollout.String(), "ControllerRegistrationRollout", "ControllerRegistrationRollout", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20030,6 +22046,33 @@ func (this *ControllerRegistrationList) String() string {
 	}, "")
 	return s
 }
+func (this *ControllerRegistrationRollout) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ControllerRegistrationRollout{`,
+		`CanarySeedSelector:` + strings.Replace(fmt.Sprintf("%v", this.CanarySeedSelector), "LabelSelector", "v11.LabelSelector", 1) + `,`,
+		`MaxUnavailable:` + valueToStringGenerated(this.MaxUnavailable) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ControllerRegistrationRolloutStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ControllerRegistrationRolloutStatus{`,
+		`DeploymentName:` + fmt.Sprintf("%v", this.DeploymentName) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Seeds:` + fmt.Sprintf("%v", this.Seeds) + `,`,
+		`UpdatedSeeds:` + fmt.Sprintf("%v", this.UpdatedSeeds) + `,`,
+		`AvailableSeeds:` + fmt.Sprintf("%v", this.AvailableSeeds) + `,`,
+		`FailedSeeds:` + fmt.Sprintf("%v", this.FailedSeeds) + `,`,
+		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v11.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ControllerRegistrationSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -20046,6 +22089,16 @@ func (this *ControllerRegistrationSpec) String() string {
 	}, "")
 	return s
 }
+func (this *ControllerRegistrationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ControllerRegistrationStatus{`,
+		`Rollout:` + strings.Replace(this.Rollout.String(), "ControllerRegistrationRolloutStatus", "ControllerRegistrationRolloutStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ControllerResource) String() string {
 	if this == nil {
 		return "nil"
@@ -20363,6 +22416,21 @@ func (this *HighAvailability) String() string {
 	}, "")
 	return s
 }
+func (this *Hook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Hook{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Point:` + fmt.Sprintf("%v", this.Point) + `,`,
+		`ProviderConfig:` + strings.Replace(fmt.Sprintf("%v", this.ProviderConfig), "RawExtension", "runtime.RawExtension", 1) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v11.Duration", 1) + `,`,
+		`FailurePolicy:` + valueToStringGenerated(this.FailurePolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HorizontalPodAutoscalerConfig) String() string {
 	if this == nil {
 		return "nil"
@@ -21000,6 +23068,88 @@ func (this *Project) String() string {
 	}, "")
 	return s
 }
+func (this *ProjectAccessReview) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMembers := "[]ProjectMemberAccessReview{"
+	for _, f := range this.Members {
+		repeatedStringForMembers += strings.Replace(strings.Replace(f.String(), "ProjectMemberAccessReview", "ProjectMemberAccessReview", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMembers += "}"
+	s := strings.Join([]string{`&ProjectAccessReview{`,
+		`LastReviewTimestamp:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastReviewTimestamp), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Members:` + repeatedStringForMembers + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectAccumulatedUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForRates := make([]string, 0, len(this.Rates))
+	for k := range this.Rates {
+		keysForRates = append(keysForRates, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRates)
+	mapStringForRates := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForRates {
+		mapStringForRates += fmt.Sprintf("%v: %v,", k, this.Rates[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForRates += "}"
+	keysForHours := make([]string, 0, len(this.Hours))
+	for k := range this.Hours {
+		keysForHours = append(keysForHours, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHours)
+	mapStringForHours := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForHours {
+		mapStringForHours += fmt.Sprintf("%v: %v,", k, this.Hours[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForHours += "}"
+	keysForMaximumRates := make([]string, 0, len(this.MaximumRates))
+	for k := range this.MaximumRates {
+		keysForMaximumRates = append(keysForMaximumRates, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMaximumRates)
+	mapStringForMaximumRates := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForMaximumRates {
+		mapStringForMaximumRates += fmt.Sprintf("%v: %v,", k, this.MaximumRates[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForMaximumRates += "}"
+	keysForMaximumHours := make([]string, 0, len(this.MaximumHours))
+	for k := range this.MaximumHours {
+		keysForMaximumHours = append(keysForMaximumHours, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMaximumHours)
+	mapStringForMaximumHours := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForMaximumHours {
+		mapStringForMaximumHours += fmt.Sprintf("%v: %v,", k, this.MaximumHours[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForMaximumHours += "}"
+	s := strings.Join([]string{`&ProjectAccumulatedUsage{`,
+		`Since:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Since), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`LastUpdateTimestamp:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTimestamp), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Rates:` + mapStringForRates + `,`,
+		`Hours:` + mapStringForHours + `,`,
+		`MaximumRates:` + mapStringForMaximumRates + `,`,
+		`MaximumHours:` + mapStringForMaximumHours + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectBastionSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectBastionSettings{`,
+		`RequireApproval:` + fmt.Sprintf("%v", this.RequireApproval) + `,`,
+		`MaxSessionDuration:` + strings.Replace(fmt.Sprintf("%v", this.MaxSessionDuration), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectList) String() string {
 	if this == nil {
 		return "nil"
@@ -21016,14 +23166,95 @@ func (this *ProjectList) String() string {
 	}, "")
 	return s
 }
+func (this *ProjectMachineImageRestriction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectMachineImageRestriction{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Versions:` + fmt.Sprintf("%v", this.Versions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectMember) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForRoleExpirations := "[]ProjectMemberRoleExpiration{"
+	for _, f := range this.RoleExpirations {
+		repeatedStringForRoleExpirations += strings.Replace(strings.Replace(f.String(), "ProjectMemberRoleExpiration", "ProjectMemberRoleExpiration", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRoleExpirations += "}"
 	s := strings.Join([]string{`&ProjectMember{`,
 		`Subject:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Subject), "Subject", "v13.Subject", 1), `&`, ``, 1) + `,`,
 		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
 		`Roles:` + fmt.Sprintf("%v", this.Roles) + `,`,
+		`ExpirationDate:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationDate), "Time", "v11.Time", 1) + `,`,
+		`RoleExpirations:` + repeatedStringForRoleExpirations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectMemberAccessReview) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectMemberAccessReview{`,
+		`Subject:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Subject), "Subject", "v13.Subject", 1), `&`, ``, 1) + `,`,
+		`FirstReviewTimestamp:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FirstReviewTimestamp), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`LastActivityTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LastActivityTimestamp), "Time", "v11.Time", 1) + `,`,
+		`FlaggedForRemoval:` + fmt.Sprintf("%v", this.FlaggedForRemoval) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectMemberRoleExpiration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectMemberRoleExpiration{`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`ExpirationDate:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpirationDate), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectQuota) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLimits := make([]string, 0, len(this.Limits))
+	for k := range this.Limits {
+		keysForLimits = append(keysForLimits, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLimits)
+	mapStringForLimits := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForLimits {
+		mapStringForLimits += fmt.Sprintf("%v: %v,", k, this.Limits[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForLimits += "}"
+	s := strings.Join([]string{`&ProjectQuota{`,
+		`Limits:` + mapStringForLimits + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectRestrictions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMachineImages := "[]ProjectMachineImageRestriction{"
+	for _, f := range this.MachineImages {
+		repeatedStringForMachineImages += strings.Replace(strings.Replace(f.String(), "ProjectMachineImageRestriction", "ProjectMachineImageRestriction", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMachineImages += "}"
+	s := strings.Join([]string{`&ProjectRestrictions{`,
+		`Regions:` + fmt.Sprintf("%v", this.Regions) + `,`,
+		`MachineTypes:` + fmt.Sprintf("%v", this.MachineTypes) + `,`,
+		`Architectures:` + fmt.Sprintf("%v", this.Architectures) + `,`,
+		`MachineImages:` + repeatedStringForMachineImages + `,`,
+		`VolumeTypes:` + fmt.Sprintf("%v", this.VolumeTypes) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21045,6 +23276,9 @@ func (this *ProjectSpec) String() string {
 		`Members:` + repeatedStringForMembers + `,`,
 		`Namespace:` + valueToStringGenerated(this.Namespace) + `,`,
 		`Tolerations:` + strings.Replace(this.Tolerations.String(), "ProjectTolerations", "ProjectTolerations", 1) + `,`,
+		`Quota:` + strings.Replace(this.Quota.String(), "ProjectQuota", "ProjectQuota", 1) + `,`,
+		`Restrictions:` + strings.Replace(this.Restrictions.String(), "ProjectRestrictions", "ProjectRestrictions", 1) + `,`,
+		`Bastion:` + strings.Replace(this.Bastion.String(), "ProjectBastionSettings", "ProjectBastionSettings", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21053,12 +23287,25 @@ func (this *ProjectStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForUsage := make([]string, 0, len(this.Usage))
+	for k := range this.Usage {
+		keysForUsage = append(keysForUsage, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsage)
+	mapStringForUsage := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForUsage {
+		mapStringForUsage += fmt.Sprintf("%v: %v,", k, this.Usage[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForUsage += "}"
 	s := strings.Join([]string{`&ProjectStatus{`,
 		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`StaleSinceTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleSinceTimestamp), "Time", "v11.Time", 1) + `,`,
 		`StaleAutoDeleteTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleAutoDeleteTimestamp), "Time", "v11.Time", 1) + `,`,
 		`LastActivityTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LastActivityTimestamp), "Time", "v11.Time", 1) + `,`,
+		`Usage:` + mapStringForUsage + `,`,
+		`AccessReview:` + strings.Replace(this.AccessReview.String(), "ProjectAccessReview", "ProjectAccessReview", 1) + `,`,
+		`AccumulatedUsage:` + strings.Replace(this.AccumulatedUsage.String(), "ProjectAccumulatedUsage", "ProjectAccumulatedUsage", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21302,6 +23549,18 @@ func (this *SeedDNSProvider) String() string {
 	}, "")
 	return s
 }
+func (this *SeedDrainStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SeedDrainStatus{`,
+		`StartTimestamp:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartTimestamp), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`RemainingShoots:` + fmt.Sprintf("%v", this.RemainingShoots) + `,`,
+		`MigratingShoots:` + fmt.Sprintf("%v", this.MigratingShoots) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SeedList) String() string {
 	if this == nil {
 		return "nil"
@@ -21496,6 +23755,17 @@ func (this *SeedSettingScheduling) String() string {
 	}
 	s := strings.Join([]string{`&SeedSettingScheduling{`,
 		`Visible:` + fmt.Sprintf("%v", this.Visible) + `,`,
+		`Drain:` + strings.Replace(this.Drain.String(), "SeedSettingSchedulingDrain", "SeedSettingSchedulingDrain", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SeedSettingSchedulingDrain) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SeedSettingSchedulingDrain{`,
+		`MaxParallelMigrations:` + valueToStringGenerated(this.MaxParallelMigrations) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21596,6 +23866,7 @@ func (this *SeedStatus) String() string {
 		`Allocatable:` + mapStringForAllocatable + `,`,
 		`ClientCertificateExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertificateExpirationTimestamp), "Time", "v11.Time", 1) + `,`,
 		`LastOperation:` + strings.Replace(this.LastOperation.String(), "LastOperation", "LastOperation", 1) + `,`,
+		`Drain:` + strings.Replace(this.Drain.String(), "SeedDrainStatus", "SeedDrainStatus", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21699,6 +23970,17 @@ func (this *ShootAdvertisedAddress) String() string {
 	}, "")
 	return s
 }
+func (this *ShootAvailability) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootAvailability{`,
+		`Last24Hours:` + valueToStringGenerated(this.Last24Hours) + `,`,
+		`Last7Days:` + valueToStringGenerated(this.Last7Days) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ShootCredentials) String() string {
 	if this == nil {
 		return "nil"
@@ -21785,6 +24067,17 @@ func (this *ShootSSHKeypairRotation) String() string {
 	}, "")
 	return s
 }
+func (this *ShootSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootSource{`,
+		`BackupEntryName:` + fmt.Sprintf("%v", this.BackupEntryName) + `,`,
+		`MaxBackupAge:` + valueToStringGenerated(this.MaxBackupAge) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ShootSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -21804,6 +24097,11 @@ func (this *ShootSpec) String() string {
 		repeatedStringForTolerations += strings.Replace(strings.Replace(f.String(), "Toleration", "Toleration", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForTolerations += "}"
+	repeatedStringForHooks := "[]Hook{"
+	for _, f := range this.Hooks {
+		repeatedStringForHooks += strings.Replace(strings.Replace(f.String(), "Hook", "Hook", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHooks += "}"
 	s := strings.Join([]string{`&ShootSpec{`,
 		`Addons:` + strings.Replace(this.Addons.String(), "Addons", "Addons", 1) + `,`,
 		`CloudProfileName:` + fmt.Sprintf("%v", this.CloudProfileName) + `,`,
@@ -21826,6 +24124,8 @@ func (this *ShootSpec) String() string {
 		`SystemComponents:` + strings.Replace(this.SystemComponents.String(), "SystemComponents", "SystemComponents", 1) + `,`,
 		`ControlPlane:` + strings.Replace(this.ControlPlane.String(), "ControlPlane", "ControlPlane", 1) + `,`,
 		`SchedulerName:` + valueToStringGenerated(this.SchedulerName) + `,`,
+		`Hooks:` + repeatedStringForHooks + `,`,
+		`Source:` + strings.Replace(this.Source.String(), "ShootSource", "ShootSource", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21927,6 +24227,7 @@ func (this *ShootStatus) String() string {
 		`LastHibernationTriggerTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHibernationTriggerTime), "Time", "v11.Time", 1) + `,`,
 		`LastMaintenance:` + strings.Replace(this.LastMaintenance.String(), "LastMaintenance", "LastMaintenance", 1) + `,`,
 		`EncryptedResources:` + fmt.Sprintf("%v", this.EncryptedResources) + `,`,
+		`Availability:` + strings.Replace(this.Availability.String(), "ShootAvailability", "ShootAvailability", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -27213,6 +29514,39 @@ func (m *ControllerRegistration) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27366,6 +29700,42 @@ func (m *ControllerRegistrationDeployment) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rollout == nil {
+				m.Rollout = &ControllerRegistrationRollout{}
+			}
+			if err := m.Rollout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27504,7 +29874,7 @@ func (m *ControllerRegistrationList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControllerRegistrationSpec) Unmarshal(dAtA []byte) error {
+func (m *ControllerRegistrationRollout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27527,15 +29897,15 @@ func (m *ControllerRegistrationSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControllerRegistrationSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerRegistrationRollout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControllerRegistrationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerRegistrationRollout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanarySeedSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27562,16 +29932,18 @@ func (m *ControllerRegistrationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, ControllerResource{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CanarySeedSelector == nil {
+				m.CanarySeedSelector = &v11.LabelSelector{}
+			}
+			if err := m.CanarySeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27581,28 +29953,12 @@ func (m *ControllerRegistrationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Deployment == nil {
-				m.Deployment = &ControllerRegistrationDeployment{}
-			}
-			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.MaxUnavailable = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27624,7 +29980,7 @@ func (m *ControllerRegistrationSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControllerResource) Unmarshal(dAtA []byte) error {
+func (m *ControllerRegistrationRolloutStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27647,15 +30003,15 @@ func (m *ControllerResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControllerResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerRegistrationRolloutStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControllerResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerRegistrationRolloutStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27683,11 +30039,11 @@ func (m *ControllerResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.DeploymentName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27715,13 +30071,13 @@ func (m *ControllerResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Phase = ControllerRegistrationRolloutPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GloballyEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seeds", wireType)
 			}
-			var v int
+			m.Seeds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27731,18 +30087,16 @@ func (m *ControllerResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Seeds |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.GloballyEnabled = &b
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReconcileTimeout", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedSeeds", wireType)
 			}
-			var msglen int
+			m.UpdatedSeeds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27752,33 +30106,16 @@ func (m *ControllerResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UpdatedSeeds |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ReconcileTimeout == nil {
-				m.ReconcileTimeout = &v11.Duration{}
-			}
-			if err := m.ReconcileTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableSeeds", wireType)
 			}
-			var v int
+			m.AvailableSeeds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27788,123 +30125,14 @@ func (m *ControllerResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.AvailableSeeds |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Primary = &b
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Lifecycle == nil {
-				m.Lifecycle = &ControllerResourceLifecycle{}
-			}
-			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerlessSupported", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.WorkerlessSupported = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ControllerResourceLifecycle) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ControllerResourceLifecycle: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControllerResourceLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reconcile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedSeeds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27932,14 +30160,13 @@ func (m *ControllerResourceLifecycle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := ControllerResourceLifecycleStrategy(dAtA[iNdEx:postIndex])
-			m.Reconcile = &s
+			m.FailedSeeds = append(m.FailedSeeds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27949,57 +30176,27 @@ func (m *ControllerResourceLifecycle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := ControllerResourceLifecycleStrategy(dAtA[iNdEx:postIndex])
-			m.Delete = &s
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Migrate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = &v11.Time{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			s := ControllerResourceLifecycleStrategy(dAtA[iNdEx:postIndex])
-			m.Migrate = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28022,7 +30219,7 @@ func (m *ControllerResourceLifecycle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoreDNS) Unmarshal(dAtA []byte) error {
+func (m *ControllerRegistrationSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28045,15 +30242,15 @@ func (m *CoreDNS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoreDNS: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerRegistrationSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoreDNS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerRegistrationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28080,16 +30277,14 @@ func (m *CoreDNS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Autoscaling == nil {
-				m.Autoscaling = &CoreDNSAutoscaling{}
-			}
-			if err := m.Autoscaling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Resources = append(m.Resources, ControllerResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewriting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28116,10 +30311,10 @@ func (m *CoreDNS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rewriting == nil {
-				m.Rewriting = &CoreDNSRewriting{}
+			if m.Deployment == nil {
+				m.Deployment = &ControllerRegistrationDeployment{}
 			}
-			if err := m.Rewriting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28144,7 +30339,7 @@ func (m *CoreDNS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoreDNSAutoscaling) Unmarshal(dAtA []byte) error {
+func (m *ControllerRegistrationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28167,17 +30362,17 @@ func (m *CoreDNSAutoscaling) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoreDNSAutoscaling: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerRegistrationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoreDNSAutoscaling: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerRegistrationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28187,23 +30382,27 @@ func (m *CoreDNSAutoscaling) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mode = CoreDNSAutoscalingMode(dAtA[iNdEx:postIndex])
+			if m.Rollout == nil {
+				m.Rollout = &ControllerRegistrationRolloutStatus{}
+			}
+			if err := m.Rollout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28226,7 +30425,7 @@ func (m *CoreDNSAutoscaling) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoreDNSRewriting) Unmarshal(dAtA []byte) error {
+func (m *ControllerResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28249,15 +30448,15 @@ func (m *CoreDNSRewriting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoreDNSRewriting: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoreDNSRewriting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonSuffixes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28285,63 +30484,66 @@ func (m *CoreDNSRewriting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommonSuffixes = append(m.CommonSuffixes, string(dAtA[iNdEx:postIndex]))
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DNS) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GloballyEnabled", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DNS: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DNS: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.GloballyEnabled = &b
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconcileTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28351,28 +30553,52 @@ func (m *DNS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Domain = &s
+			if m.ReconcileTimeout == nil {
+				m.ReconcileTimeout = &v11.Duration{}
+			}
+			if err := m.ReconcileTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Primary = &b
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28399,11 +30625,34 @@ func (m *DNS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Providers = append(m.Providers, DNSProvider{})
-			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Lifecycle == nil {
+				m.Lifecycle = &ControllerResourceLifecycle{}
+			}
+			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerlessSupported", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.WorkerlessSupported = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28425,7 +30674,7 @@ func (m *DNS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
+func (m *ControllerResourceLifecycle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28448,15 +30697,15 @@ func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DNSIncludeExclude: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerResourceLifecycle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DNSIncludeExclude: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerResourceLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconcile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28484,11 +30733,12 @@ func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Include = append(m.Include, string(dAtA[iNdEx:postIndex]))
+			s := ControllerResourceLifecycleStrategy(dAtA[iNdEx:postIndex])
+			m.Reconcile = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28516,7 +30766,41 @@ func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exclude = append(m.Exclude, string(dAtA[iNdEx:postIndex]))
+			s := ControllerResourceLifecycleStrategy(dAtA[iNdEx:postIndex])
+			m.Delete = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := ControllerResourceLifecycleStrategy(dAtA[iNdEx:postIndex])
+			m.Migrate = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28539,7 +30823,7 @@ func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DNSProvider) Unmarshal(dAtA []byte) error {
+func (m *CoreDNS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28562,15 +30846,15 @@ func (m *DNSProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DNSProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: CoreDNS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DNSProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CoreDNS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28597,103 +30881,16 @@ func (m *DNSProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Domains == nil {
-				m.Domains = &DNSIncludeExclude{}
+			if m.Autoscaling == nil {
+				m.Autoscaling = &CoreDNSAutoscaling{}
 			}
-			if err := m.Domains.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Autoscaling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Primary = &b
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.SecretName = &s
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Type = &s
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewriting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28720,10 +30917,10 @@ func (m *DNSProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Zones == nil {
-				m.Zones = &DNSIncludeExclude{}
+			if m.Rewriting == nil {
+				m.Rewriting = &CoreDNSRewriting{}
 			}
-			if err := m.Zones.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rewriting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28748,7 +30945,7 @@ func (m *DNSProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataVolume) Unmarshal(dAtA []byte) error {
+func (m *CoreDNSAutoscaling) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28771,15 +30968,15 @@ func (m *DataVolume) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataVolume: wiretype end group for non-group")
+			return fmt.Errorf("proto: CoreDNSAutoscaling: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CoreDNSAutoscaling: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28807,44 +31004,61 @@ func (m *DataVolume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Mode = CoreDNSAutoscalingMode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoreDNSRewriting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Type = &s
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoreDNSRewriting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoreDNSRewriting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonSuffixes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28872,29 +31086,8 @@ func (m *DataVolume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeSize = string(dAtA[iNdEx:postIndex])
+			m.CommonSuffixes = append(m.CommonSuffixes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encrypted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Encrypted = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28916,7 +31109,7 @@ func (m *DataVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeploymentRef) Unmarshal(dAtA []byte) error {
+func (m *DNS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28939,15 +31132,15 @@ func (m *DeploymentRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeploymentRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: DNS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeploymentRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DNS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28975,7 +31168,42 @@ func (m *DeploymentRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Domain = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, DNSProvider{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28998,7 +31226,7 @@ func (m *DeploymentRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
+func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29021,15 +31249,15 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ETCDEncryptionKeyRotation: wiretype end group for non-group")
+			return fmt.Errorf("proto: DNSIncludeExclude: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ETCDEncryptionKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DNSIncludeExclude: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29057,13 +31285,13 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = CredentialsRotationPhase(dAtA[iNdEx:postIndex])
+			m.Include = append(m.Include, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29073,31 +31301,77 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
-			}
-			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Exclude = append(m.Exclude, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DNSProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DNSProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DNSProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29124,18 +31398,39 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
+			if m.Domains == nil {
+				m.Domains = &DNSIncludeExclude{}
 			}
-			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Domains.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Primary = &b
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationFinishedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29145,31 +31440,61 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationFinishedTime == nil {
-				m.LastInitiationFinishedTime = &v11.Time{}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SecretName = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Type = &s
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTriggeredTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29196,10 +31521,10 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionTriggeredTime == nil {
-				m.LastCompletionTriggeredTime = &v11.Time{}
+			if m.Zones == nil {
+				m.Zones = &DNSIncludeExclude{}
 			}
-			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Zones.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29224,7 +31549,7 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
+func (m *DataVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29247,15 +31572,15 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptionConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29283,61 +31608,44 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExpirableVersion: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExpirableVersion: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			s := string(dAtA[iNdEx:postIndex])
+			m.Type = &s
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29365,13 +31673,13 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.VolumeSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encrypted", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29381,31 +31689,66 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			b := bool(v != 0)
+			m.Encrypted = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationDate == nil {
-				m.ExpirationDate = &v11.Time{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeploymentRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeploymentRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeploymentRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Classification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29433,8 +31776,7 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := VersionClassification(dAtA[iNdEx:postIndex])
-			m.Classification = &s
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29457,7 +31799,7 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExposureClass) Unmarshal(dAtA []byte) error {
+func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29480,17 +31822,17 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExposureClass: wiretype end group for non-group")
+			return fmt.Errorf("proto: ETCDEncryptionKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExposureClass: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ETCDEncryptionKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29500,30 +31842,29 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = CredentialsRotationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29533,27 +31874,31 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Handler = string(dAtA[iNdEx:postIndex])
+			if m.LastCompletionTime == nil {
+				m.LastCompletionTime = &v11.Time{}
+			}
+			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scheduling", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29580,66 +31925,16 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scheduling == nil {
-				m.Scheduling = &ExposureClassScheduling{}
+			if m.LastInitiationTime == nil {
+				m.LastInitiationTime = &v11.Time{}
 			}
-			if err := m.Scheduling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExposureClassList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExposureClassList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationFinishedTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29666,13 +31961,16 @@ func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastInitiationFinishedTime == nil {
+				m.LastInitiationFinishedTime = &v11.Time{}
+			}
+			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTriggeredTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29699,8 +31997,10 @@ func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ExposureClass{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastCompletionTriggeredTime == nil {
+				m.LastCompletionTriggeredTime = &v11.Time{}
+			}
+			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29725,7 +32025,7 @@ func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExposureClassScheduling) Unmarshal(dAtA []byte) error {
+func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29748,53 +32048,17 @@ func (m *ExposureClassScheduling) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExposureClassScheduling: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncryptionConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExposureClassScheduling: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncryptionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedSelector", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SeedSelector == nil {
-				m.SeedSelector = &SeedSelector{}
-			}
-			if err := m.SeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29804,25 +32068,23 @@ func (m *ExposureClassScheduling) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29845,7 +32107,7 @@ func (m *ExposureClassScheduling) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Extension) Unmarshal(dAtA []byte) error {
+func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29868,15 +32130,15 @@ func (m *Extension) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Extension: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExpirableVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Extension: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExpirableVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29904,11 +32166,11 @@ func (m *Extension) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29935,18 +32197,18 @@ func (m *Extension) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = &v11.Time{}
 			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Classification", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29956,13 +32218,25 @@ func (m *Extension) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Disabled = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := VersionClassification(dAtA[iNdEx:postIndex])
+			m.Classification = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29984,7 +32258,7 @@ func (m *Extension) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
+func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30007,17 +32281,17 @@ func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtensionResourceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExposureClass: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtensionResourceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExposureClass: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30027,27 +32301,28 @@ func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30075,14 +32350,13 @@ func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Handler = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduling", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30092,28 +32366,81 @@ func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Purpose = &s
+			if m.Scheduling == nil {
+				m.Scheduling = &ExposureClassScheduling{}
+			}
+			if err := m.Scheduling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExposureClassList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExposureClassList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30140,16 +32467,13 @@ func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &runtime.RawExtension{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30176,8 +32500,8 @@ func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, NamedResourceReference{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ExposureClass{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30202,7 +32526,7 @@ func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FailureTolerance) Unmarshal(dAtA []byte) error {
+func (m *ExposureClassScheduling) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30225,17 +32549,17 @@ func (m *FailureTolerance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FailureTolerance: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExposureClassScheduling: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FailureTolerance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExposureClassScheduling: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedSelector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30245,23 +32569,61 @@ func (m *FailureTolerance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = FailureToleranceType(dAtA[iNdEx:postIndex])
+			if m.SeedSelector == nil {
+				m.SeedSelector = &SeedSelector{}
+			}
+			if err := m.SeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30284,7 +32646,7 @@ func (m *FailureTolerance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Gardener) Unmarshal(dAtA []byte) error {
+func (m *Extension) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30307,15 +32669,15 @@ func (m *Gardener) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Gardener: wiretype end group for non-group")
+			return fmt.Errorf("proto: Extension: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gardener: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Extension: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30343,13 +32705,13 @@ func (m *Gardener) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30359,29 +32721,33 @@ func (m *Gardener) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30391,24 +32757,13 @@ func (m *Gardener) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Disabled = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30430,7 +32785,7 @@ func (m *Gardener) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
+func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30453,15 +32808,15 @@ func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GardenerResourceData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtensionResourceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GardenerResourceData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtensionResourceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30489,11 +32844,11 @@ func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30521,11 +32876,45 @@ func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Purpose = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30552,13 +32941,16 @@ func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &runtime.RawExtension{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30585,103 +32977,10 @@ func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Resources = append(m.Resources, NamedResourceReference{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30704,7 +33003,7 @@ func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Hibernation) Unmarshal(dAtA []byte) error {
+func (m *FailureTolerance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30727,38 +33026,17 @@ func (m *Hibernation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Hibernation: wiretype end group for non-group")
+			return fmt.Errorf("proto: FailureTolerance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Hibernation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FailureTolerance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Enabled = &b
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30768,25 +33046,23 @@ func (m *Hibernation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedules = append(m.Schedules, HibernationSchedule{})
-			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = FailureToleranceType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30809,7 +33085,7 @@ func (m *Hibernation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HibernationSchedule) Unmarshal(dAtA []byte) error {
+func (m *Gardener) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30832,15 +33108,15 @@ func (m *HibernationSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HibernationSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: Gardener: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HibernationSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Gardener: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30868,12 +33144,11 @@ func (m *HibernationSchedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Start = &s
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30901,12 +33176,11 @@ func (m *HibernationSchedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.End = &s
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30934,8 +33208,7 @@ func (m *HibernationSchedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Location = &s
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30958,7 +33231,7 @@ func (m *HibernationSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HighAvailability) Unmarshal(dAtA []byte) error {
+func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30981,17 +33254,17 @@ func (m *HighAvailability) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HighAvailability: wiretype end group for non-group")
+			return fmt.Errorf("proto: GardenerResourceData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HighAvailability: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GardenerResourceData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureTolerance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31001,80 +33274,29 @@ func (m *HighAvailability) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FailureTolerance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HorizontalPodAutoscalerConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HorizontalPodAutoscalerConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HorizontalPodAutoscalerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPUInitializationPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31084,31 +33306,27 @@ func (m *HorizontalPodAutoscalerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CPUInitializationPeriod == nil {
-				m.CPUInitializationPeriod = &v11.Duration{}
-			}
-			if err := m.CPUInitializationPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownscaleStabilization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31135,16 +33353,13 @@ func (m *HorizontalPodAutoscalerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownscaleStabilization == nil {
-				m.DownscaleStabilization = &v11.Duration{}
-			}
-			if err := m.DownscaleStabilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialReadinessDelay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31171,61 +33386,104 @@ func (m *HorizontalPodAutoscalerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitialReadinessDelay == nil {
-				m.InitialReadinessDelay = &v11.Duration{}
-			}
-			if err := m.InitialReadinessDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPeriod", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SyncPeriod == nil {
-				m.SyncPeriod = &v11.Duration{}
-			}
-			if err := m.SyncPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerance", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			v2 := float64(math.Float64frombits(v))
-			m.Tolerance = &v2
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31247,7 +33505,7 @@ func (m *HorizontalPodAutoscalerConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Ingress) Unmarshal(dAtA []byte) error {
+func (m *Hibernation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31270,17 +33528,17 @@ func (m *Ingress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Ingress: wiretype end group for non-group")
+			return fmt.Errorf("proto: Hibernation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Ingress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Hibernation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31290,27 +33548,16 @@ func (m *Ingress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Enabled = &b
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31337,7 +33584,8 @@ func (m *Ingress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Controller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schedules = append(m.Schedules, HibernationSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31362,7 +33610,7 @@ func (m *Ingress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IngressController) Unmarshal(dAtA []byte) error {
+func (m *HibernationSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31385,15 +33633,15 @@ func (m *IngressController) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IngressController: wiretype end group for non-group")
+			return fmt.Errorf("proto: HibernationSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IngressController: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HibernationSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31421,13 +33669,14 @@ func (m *IngressController) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Start = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31437,27 +33686,57 @@ func (m *IngressController) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
+			s := string(dAtA[iNdEx:postIndex])
+			m.End = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Location = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31480,7 +33759,7 @@ func (m *IngressController) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InternalSecret) Unmarshal(dAtA []byte) error {
+func (m *HighAvailability) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31503,15 +33782,15 @@ func (m *InternalSecret) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InternalSecret: wiretype end group for non-group")
+			return fmt.Errorf("proto: HighAvailability: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InternalSecret: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HighAvailability: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureTolerance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31538,15 +33817,65 @@ func (m *InternalSecret) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FailureTolerance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31556,121 +33885,25 @@ func (m *InternalSecret) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = make(map[string][]byte)
-			}
-			var mapkey string
-			mapvalue := []byte{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapbyteLen uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postbytesIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-					iNdEx = postbytesIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Data[mapkey] = mapvalue
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
@@ -31700,13 +33933,13 @@ func (m *InternalSecret) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = k8s_io_api_core_v1.SecretType(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StringData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Point", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31716,124 +33949,29 @@ func (m *InternalSecret) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StringData == nil {
-				m.StringData = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.StringData[mapkey] = mapvalue
+			m.Point = HookPoint(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Immutable", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31843,66 +33981,31 @@ func (m *InternalSecret) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Immutable = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InternalSecretList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InternalSecretList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InternalSecretList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31929,15 +34032,18 @@ func (m *InternalSecretList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Timeout == nil {
+				m.Timeout = &v11.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31947,25 +34053,24 @@ func (m *InternalSecretList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, InternalSecret{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := HookFailurePolicy(dAtA[iNdEx:postIndex])
+			m.FailurePolicy = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31988,7 +34093,7 @@ func (m *InternalSecretList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
+func (m *HorizontalPodAutoscalerConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32011,15 +34116,15 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubeAPIServerConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: HorizontalPodAutoscalerConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubeAPIServerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HorizontalPodAutoscalerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUInitializationPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32046,13 +34151,16 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CPUInitializationPeriod == nil {
+				m.CPUInitializationPeriod = &v11.Duration{}
+			}
+			if err := m.CPUInitializationPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdmissionPlugins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownscaleStabilization", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32079,16 +34187,18 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdmissionPlugins = append(m.AdmissionPlugins, AdmissionPlugin{})
-			if err := m.AdmissionPlugins[len(m.AdmissionPlugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DownscaleStabilization == nil {
+				m.DownscaleStabilization = &v11.Duration{}
+			}
+			if err := m.DownscaleStabilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIAudiences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialReadinessDelay", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32098,27 +34208,31 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIAudiences = append(m.APIAudiences, string(dAtA[iNdEx:postIndex]))
+			if m.InitialReadinessDelay == nil {
+				m.InitialReadinessDelay = &v11.Duration{}
+			}
+			if err := m.InitialReadinessDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuditConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32145,18 +34259,80 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuditConfig == nil {
-				m.AuditConfig = &AuditConfig{}
+			if m.SyncPeriod == nil {
+				m.SyncPeriod = &v11.Duration{}
 			}
-			if err := m.AuditConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SyncPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerance", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.Tolerance = &v2
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ingress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ingress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ingress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OIDCConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32166,31 +34342,27 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OIDCConfig == nil {
-				m.OIDCConfig = &OIDCConfig{}
-			}
-			if err := m.OIDCConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32217,16 +34389,250 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RuntimeConfig == nil {
-				m.RuntimeConfig = make(map[string]bool)
+			if err := m.Controller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IngressController) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IngressController: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IngressController: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InternalSecret) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InternalSecret: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InternalSecret: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
 						return ErrIntOverflowGenerated
 					}
 					if iNdEx >= l {
@@ -32270,7 +34676,7 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapvaluetemp int
+					var mapbyteLen uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -32280,12 +34686,184 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
+						mapbyteLen |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					mapvalue = bool(mapvaluetemp != 0)
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Data[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = k8s_io_api_core_v1.SecretType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StringData == nil {
+				m.StringData = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32301,11 +34879,2337 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.RuntimeConfig[mapkey] = mapvalue
+			m.StringData[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Immutable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Immutable = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InternalSecretList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InternalSecretList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InternalSecretList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, InternalSecret{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeAPIServerConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeAPIServerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdmissionPlugins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdmissionPlugins = append(m.AdmissionPlugins, AdmissionPlugin{})
+			if err := m.AdmissionPlugins[len(m.AdmissionPlugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIAudiences", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIAudiences = append(m.APIAudiences, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuditConfig == nil {
+				m.AuditConfig = &AuditConfig{}
+			}
+			if err := m.AuditConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDCConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OIDCConfig == nil {
+				m.OIDCConfig = &OIDCConfig{}
+			}
+			if err := m.OIDCConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RuntimeConfig == nil {
+				m.RuntimeConfig = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RuntimeConfig[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ServiceAccountConfig == nil {
+				m.ServiceAccountConfig = &ServiceAccountConfig{}
+			}
+			if err := m.ServiceAccountConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchCacheSizes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WatchCacheSizes == nil {
+				m.WatchCacheSizes = &WatchCacheSizes{}
+			}
+			if err := m.WatchCacheSizes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Requests == nil {
+				m.Requests = &APIServerRequests{}
+			}
+			if err := m.Requests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableAnonymousAuthentication", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.EnableAnonymousAuthentication = &b
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EventTTL == nil {
+				m.EventTTL = &v11.Duration{}
+			}
+			if err := m.EventTTL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logging", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Logging == nil {
+				m.Logging = &APIServerLogging{}
+			}
+			if err := m.Logging.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultNotReadyTolerationSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DefaultNotReadyTolerationSeconds = &v
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultUnreachableTolerationSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DefaultUnreachableTolerationSeconds = &v
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EncryptionConfig == nil {
+				m.EncryptionConfig = &EncryptionConfig{}
+			}
+			if err := m.EncryptionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeControllerManagerConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeControllerManagerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HorizontalPodAutoscalerConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HorizontalPodAutoscalerConfig == nil {
+				m.HorizontalPodAutoscalerConfig = &HorizontalPodAutoscalerConfig{}
+			}
+			if err := m.HorizontalPodAutoscalerConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeCIDRMaskSize", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NodeCIDRMaskSize = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodEvictionTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodEvictionTimeout == nil {
+				m.PodEvictionTimeout = &v11.Duration{}
+			}
+			if err := m.PodEvictionTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeMonitorGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeMonitorGracePeriod == nil {
+				m.NodeMonitorGracePeriod = &v11.Duration{}
+			}
+			if err := m.NodeMonitorGracePeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeProxyConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeProxyConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeProxyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := ProxyMode(dAtA[iNdEx:postIndex])
+			m.Mode = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Enabled = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeSchedulerConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeSchedulerConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeSchedulerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeMaxPDVols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.KubeMaxPDVols = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := SchedulingProfile(dAtA[iNdEx:postIndex])
+			m.Profile = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeletConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeletConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUCFSQuota", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.CPUCFSQuota = &b
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUManagerPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.CPUManagerPolicy = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvictionHard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvictionHard == nil {
+				m.EvictionHard = &KubeletConfigEviction{}
+			}
+			if err := m.EvictionHard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvictionMaxPodGracePeriod", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EvictionMaxPodGracePeriod = &v
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvictionMinimumReclaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvictionMinimumReclaim == nil {
+				m.EvictionMinimumReclaim = &KubeletConfigEvictionMinimumReclaim{}
+			}
+			if err := m.EvictionMinimumReclaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvictionPressureTransitionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvictionPressureTransitionPeriod == nil {
+				m.EvictionPressureTransitionPeriod = &v11.Duration{}
+			}
+			if err := m.EvictionPressureTransitionPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvictionSoft", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvictionSoft == nil {
+				m.EvictionSoft = &KubeletConfigEviction{}
+			}
+			if err := m.EvictionSoft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvictionSoftGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvictionSoftGracePeriod == nil {
+				m.EvictionSoftGracePeriod = &KubeletConfigEvictionSoftGracePeriod{}
+			}
+			if err := m.EvictionSoftGracePeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPods", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxPods = &v
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPIDsLimit", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PodPIDsLimit = &v
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullProgressDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ImagePullProgressDeadline == nil {
+				m.ImagePullProgressDeadline = &v11.Duration{}
+			}
+			if err := m.ImagePullProgressDeadline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailSwapOn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.FailSwapOn = &b
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeReserved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubeReserved == nil {
+				m.KubeReserved = &KubeletConfigReserved{}
+			}
+			if err := m.KubeReserved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemReserved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SystemReserved == nil {
+				m.SystemReserved = &KubeletConfigReserved{}
+			}
+			if err := m.SystemReserved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageGCHighThresholdPercent", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ImageGCHighThresholdPercent = &v
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageGCLowThresholdPercent", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ImageGCLowThresholdPercent = &v
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SerializeImagePulls", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.SerializeImagePulls = &b
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryPullQPS", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RegistryPullQPS = &v
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryBurst", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RegistryBurst = &v
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeccompDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.SeccompDefault = &b
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerLogMaxSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContainerLogMaxSize == nil {
+				m.ContainerLogMaxSize = &resource.Quantity{}
+			}
+			if err := m.ContainerLogMaxSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerLogMaxFiles", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContainerLogMaxFiles = &v
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtectKernelDefaults", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ProtectKernelDefaults = &b
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingConnectionIdleTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StreamingConnectionIdleTimeout == nil {
+				m.StreamingConnectionIdleTimeout = &v11.Duration{}
+			}
+			if err := m.StreamingConnectionIdleTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemorySwap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemorySwap == nil {
+				m.MemorySwap = &MemorySwapConfiguration{}
+			}
+			if err := m.MemorySwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeletConfigEviction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeletConfigEviction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeletConfigEviction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryAvailable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.MemoryAvailable = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageFSAvailable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ImageFSAvailable = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageFSInodesFree", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ImageFSInodesFree = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFSAvailable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.NodeFSAvailable = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFSInodesFree", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.NodeFSInodesFree = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeletConfigEvictionMinimumReclaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeletConfigEvictionMinimumReclaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeletConfigEvictionMinimumReclaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryAvailable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemoryAvailable == nil {
+				m.MemoryAvailable = &resource.Quantity{}
+			}
+			if err := m.MemoryAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageFSAvailable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32332,16 +37236,16 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServiceAccountConfig == nil {
-				m.ServiceAccountConfig = &ServiceAccountConfig{}
+			if m.ImageFSAvailable == nil {
+				m.ImageFSAvailable = &resource.Quantity{}
 			}
-			if err := m.ServiceAccountConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ImageFSAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchCacheSizes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageFSInodesFree", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32368,16 +37272,16 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WatchCacheSizes == nil {
-				m.WatchCacheSizes = &WatchCacheSizes{}
+			if m.ImageFSInodesFree == nil {
+				m.ImageFSInodesFree = &resource.Quantity{}
 			}
-			if err := m.WatchCacheSizes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ImageFSInodesFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFSAvailable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32404,18 +37308,18 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Requests == nil {
-				m.Requests = &APIServerRequests{}
+			if m.NodeFSAvailable == nil {
+				m.NodeFSAvailable = &resource.Quantity{}
 			}
-			if err := m.Requests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NodeFSAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableAnonymousAuthentication", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFSInodesFree", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32425,16 +37329,81 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.EnableAnonymousAuthentication = &b
-		case 12:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeFSInodesFree == nil {
+				m.NodeFSInodesFree = &resource.Quantity{}
+			}
+			if err := m.NodeFSInodesFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeletConfigEvictionSoftGracePeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeletConfigEvictionSoftGracePeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventTTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryAvailable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32461,16 +37430,16 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EventTTL == nil {
-				m.EventTTL = &v11.Duration{}
+			if m.MemoryAvailable == nil {
+				m.MemoryAvailable = &v11.Duration{}
 			}
-			if err := m.EventTTL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MemoryAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logging", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageFSAvailable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32497,18 +37466,18 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Logging == nil {
-				m.Logging = &APIServerLogging{}
+			if m.ImageFSAvailable == nil {
+				m.ImageFSAvailable = &v11.Duration{}
 			}
-			if err := m.Logging.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ImageFSAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultNotReadyTolerationSeconds", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageFSInodesFree", wireType)
 			}
-			var v int64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32518,17 +37487,33 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DefaultNotReadyTolerationSeconds = &v
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultUnreachableTolerationSeconds", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int64
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ImageFSInodesFree == nil {
+				m.ImageFSInodesFree = &v11.Duration{}
+			}
+			if err := m.ImageFSInodesFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFSAvailable", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32538,15 +37523,31 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DefaultUnreachableTolerationSeconds = &v
-		case 16:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeFSAvailable == nil {
+				m.NodeFSAvailable = &v11.Duration{}
+			}
+			if err := m.NodeFSAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFSInodesFree", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32573,10 +37574,10 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EncryptionConfig == nil {
-				m.EncryptionConfig = &EncryptionConfig{}
+			if m.NodeFSInodesFree == nil {
+				m.NodeFSInodesFree = &v11.Duration{}
 			}
-			if err := m.EncryptionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NodeFSInodesFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32601,7 +37602,7 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
+func (m *KubeletConfigReserved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32624,15 +37625,15 @@ func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubeControllerManagerConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: KubeletConfigReserved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubeControllerManagerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KubeletConfigReserved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CPU", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32659,13 +37660,16 @@ func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CPU == nil {
+				m.CPU = &resource.Quantity{}
+			}
+			if err := m.CPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HorizontalPodAutoscalerConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32692,36 +37696,16 @@ func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HorizontalPodAutoscalerConfig == nil {
-				m.HorizontalPodAutoscalerConfig = &HorizontalPodAutoscalerConfig{}
+			if m.Memory == nil {
+				m.Memory = &resource.Quantity{}
 			}
-			if err := m.HorizontalPodAutoscalerConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Memory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeCIDRMaskSize", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.NodeCIDRMaskSize = &v
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodEvictionTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralStorage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32748,16 +37732,16 @@ func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PodEvictionTimeout == nil {
-				m.PodEvictionTimeout = &v11.Duration{}
+			if m.EphemeralStorage == nil {
+				m.EphemeralStorage = &resource.Quantity{}
 			}
-			if err := m.PodEvictionTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EphemeralStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeMonitorGracePeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32784,10 +37768,10 @@ func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeMonitorGracePeriod == nil {
-				m.NodeMonitorGracePeriod = &v11.Duration{}
+			if m.PID == nil {
+				m.PID = &resource.Quantity{}
 			}
-			if err := m.NodeMonitorGracePeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32812,7 +37796,7 @@ func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubeProxyConfig) Unmarshal(dAtA []byte) error {
+func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32835,17 +37819,254 @@ func (m *KubeProxyConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubeProxyConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Kubernetes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubeProxyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Kubernetes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowPrivilegedContainers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.AllowPrivilegedContainers = &b
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterAutoscaler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterAutoscaler == nil {
+				m.ClusterAutoscaler = &ClusterAutoscaler{}
+			}
+			if err := m.ClusterAutoscaler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeAPIServer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubeAPIServer == nil {
+				m.KubeAPIServer = &KubeAPIServerConfig{}
+			}
+			if err := m.KubeAPIServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeControllerManager", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubeControllerManager == nil {
+				m.KubeControllerManager = &KubeControllerManagerConfig{}
+			}
+			if err := m.KubeControllerManager.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeScheduler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubeScheduler == nil {
+				m.KubeScheduler = &KubeSchedulerConfig{}
+			}
+			if err := m.KubeScheduler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeProxy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubeProxy == nil {
+				m.KubeProxy = &KubeProxyConfig{}
+			}
+			if err := m.KubeProxy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubelet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kubelet == nil {
+				m.Kubelet = &KubeletConfig{}
+			}
+			if err := m.Kubelet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32855,30 +38076,29 @@ func (m *KubeProxyConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerticalPodAutoscaler", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32888,28 +38108,31 @@ func (m *KubeProxyConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := ProxyMode(dAtA[iNdEx:postIndex])
-			m.Mode = &s
+			if m.VerticalPodAutoscaler == nil {
+				m.VerticalPodAutoscaler = &VerticalPodAutoscaler{}
+			}
+			if err := m.VerticalPodAutoscaler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStaticTokenKubeconfig", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -32927,7 +38150,7 @@ func (m *KubeProxyConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			b := bool(v != 0)
-			m.Enabled = &b
+			m.EnableStaticTokenKubeconfig = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32949,7 +38172,7 @@ func (m *KubeProxyConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubeSchedulerConfig) Unmarshal(dAtA []byte) error {
+func (m *KubernetesConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32972,15 +38195,15 @@ func (m *KubeSchedulerConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubeSchedulerConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: KubernetesConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubeSchedulerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KubernetesConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeatureGates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33007,75 +38230,91 @@ func (m *KubeSchedulerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeMaxPDVols", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.KubeMaxPDVols = &s
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			if m.FeatureGates == nil {
+				m.FeatureGates = make(map[string]bool)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := SchedulingProfile(dAtA[iNdEx:postIndex])
-			m.Profile = &s
+			m.FeatureGates[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33098,7 +38337,7 @@ func (m *KubeSchedulerConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
+func (m *KubernetesDashboard) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33121,69 +38360,15 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubeletConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: KubernetesDashboard: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubeletConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KubernetesDashboard: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPUCFSQuota", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.CPUCFSQuota = &b
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPUManagerPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticationMode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33212,11 +38397,11 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.CPUManagerPolicy = &s
+			m.AuthenticationMode = &s
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvictionHard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addon", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33243,108 +38428,63 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvictionHard == nil {
-				m.EvictionHard = &KubeletConfigEviction{}
-			}
-			if err := m.EvictionHard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Addon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvictionMaxPodGracePeriod", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EvictionMaxPodGracePeriod = &v
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvictionMinimumReclaim", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvictionMinimumReclaim == nil {
-				m.EvictionMinimumReclaim = &KubeletConfigEvictionMinimumReclaim{}
-			}
-			if err := m.EvictionMinimumReclaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvictionPressureTransitionPeriod", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubernetesSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvictionPressureTransitionPeriod == nil {
-				m.EvictionPressureTransitionPeriod = &v11.Duration{}
-			}
-			if err := m.EvictionPressureTransitionPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubernetesSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubernetesSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvictionSoft", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33371,94 +38511,66 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvictionSoft == nil {
-				m.EvictionSoft = &KubeletConfigEviction{}
-			}
-			if err := m.EvictionSoft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Versions = append(m.Versions, ExpirableVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvictionSoftGracePeriod", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvictionSoftGracePeriod == nil {
-				m.EvictionSoftGracePeriod = &KubeletConfigEvictionSoftGracePeriod{}
-			}
-			if err := m.EvictionSoftGracePeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPods", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.MaxPods = &v
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPIDsLimit", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.PodPIDsLimit = &v
-		case 12:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullProgressDeadline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33468,33 +38580,29 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ImagePullProgressDeadline == nil {
-				m.ImagePullProgressDeadline = &v11.Duration{}
-			}
-			if err := m.ImagePullProgressDeadline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailSwapOn", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33504,18 +38612,30 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.FailSwapOn = &b
-		case 14:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TaskID = &s
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeReserved", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33525,31 +38645,27 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KubeReserved == nil {
-				m.KubeReserved = &KubeletConfigReserved{}
-			}
-			if err := m.KubeReserved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Codes = append(m.Codes, ErrorCode(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemReserved", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33576,79 +38692,68 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SystemReserved == nil {
-				m.SystemReserved = &KubeletConfigReserved{}
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = &v11.Time{}
 			}
-			if err := m.SystemReserved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageGCHighThresholdPercent", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ImageGCHighThresholdPercent = &v
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageGCLowThresholdPercent", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.ImageGCLowThresholdPercent = &v
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SerializeImagePulls", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			b := bool(v != 0)
-			m.SerializeImagePulls = &b
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistryPullQPS", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33658,56 +38763,27 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RegistryPullQPS = &v
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistryBurst", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.RegistryBurst = &v
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeccompDefault", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			b := bool(v != 0)
-			m.SeccompDefault = &b
-		case 22:
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerLogMaxSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggeredTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33734,59 +38810,15 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerLogMaxSize == nil {
-				m.ContainerLogMaxSize = &resource.Quantity{}
-			}
-			if err := m.ContainerLogMaxSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerLogMaxFiles", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ContainerLogMaxFiles = &v
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtectKernelDefaults", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.ProtectKernelDefaults = &b
-		case 25:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamingConnectionIdleTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33796,33 +38828,29 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StreamingConnectionIdleTimeout == nil {
-				m.StreamingConnectionIdleTimeout = &v11.Duration{}
-			}
-			if err := m.StreamingConnectionIdleTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.State = LastOperationState(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemorySwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33832,27 +38860,24 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemorySwap == nil {
-				m.MemorySwap = &MemorySwapConfiguration{}
-			}
-			if err := m.MemorySwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.FailureReason = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33875,7 +38900,7 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubeletConfigEviction) Unmarshal(dAtA []byte) error {
+func (m *LastOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33898,15 +38923,15 @@ func (m *KubeletConfigEviction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubeletConfigEviction: wiretype end group for non-group")
+			return fmt.Errorf("proto: LastOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubeletConfigEviction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LastOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoryAvailable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33934,14 +38959,13 @@ func (m *KubeletConfigEviction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.MemoryAvailable = &s
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageFSAvailable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33951,30 +38975,30 @@ func (m *KubeletConfigEviction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ImageFSAvailable = &s
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageFSInodesFree", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
 			}
-			var stringLen uint64
+			m.Progress = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33984,28 +39008,14 @@ func (m *KubeletConfigEviction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Progress |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ImageFSInodesFree = &s
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeFSAvailable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34033,12 +39043,11 @@ func (m *KubeletConfigEviction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.NodeFSAvailable = &s
+			m.State = LastOperationState(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeFSInodesFree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34066,8 +39075,7 @@ func (m *KubeletConfigEviction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.NodeFSInodesFree = &s
+			m.Type = LastOperationType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34090,7 +39098,7 @@ func (m *KubeletConfigEviction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubeletConfigEvictionMinimumReclaim) Unmarshal(dAtA []byte) error {
+func (m *Machine) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34113,17 +39121,17 @@ func (m *KubeletConfigEvictionMinimumReclaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubeletConfigEvictionMinimumReclaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: Machine: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubeletConfigEvictionMinimumReclaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Machine: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoryAvailable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34133,31 +39141,27 @@ func (m *KubeletConfigEvictionMinimumReclaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemoryAvailable == nil {
-				m.MemoryAvailable = &resource.Quantity{}
-			}
-			if err := m.MemoryAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageFSAvailable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34184,90 +39188,18 @@ func (m *KubeletConfigEvictionMinimumReclaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ImageFSAvailable == nil {
-				m.ImageFSAvailable = &resource.Quantity{}
+			if m.Image == nil {
+				m.Image = &ShootMachineImage{}
 			}
-			if err := m.ImageFSAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageFSInodesFree", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ImageFSInodesFree == nil {
-				m.ImageFSInodesFree = &resource.Quantity{}
-			}
-			if err := m.ImageFSInodesFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeFSAvailable", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NodeFSAvailable == nil {
-				m.NodeFSAvailable = &resource.Quantity{}
-			}
-			if err := m.NodeFSAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeFSInodesFree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Architecture", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34277,27 +39209,24 @@ func (m *KubeletConfigEvictionMinimumReclaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeFSInodesFree == nil {
-				m.NodeFSInodesFree = &resource.Quantity{}
-			}
-			if err := m.NodeFSInodesFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Architecture = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34320,7 +39249,7 @@ func (m *KubeletConfigEvictionMinimumReclaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
+func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34343,15 +39272,15 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubeletConfigEvictionSoftGracePeriod: wiretype end group for non-group")
+			return fmt.Errorf("proto: MachineControllerManagerSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubeletConfigEvictionSoftGracePeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MachineControllerManagerSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoryAvailable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineDrainTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34378,16 +39307,16 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemoryAvailable == nil {
-				m.MemoryAvailable = &v11.Duration{}
+			if m.MachineDrainTimeout == nil {
+				m.MachineDrainTimeout = &v11.Duration{}
 			}
-			if err := m.MemoryAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MachineDrainTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageFSAvailable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineHealthTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34414,16 +39343,16 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ImageFSAvailable == nil {
-				m.ImageFSAvailable = &v11.Duration{}
+			if m.MachineHealthTimeout == nil {
+				m.MachineHealthTimeout = &v11.Duration{}
 			}
-			if err := m.ImageFSAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MachineHealthTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageFSInodesFree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineCreationTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34450,18 +39379,18 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ImageFSInodesFree == nil {
-				m.ImageFSInodesFree = &v11.Duration{}
+			if m.MachineCreationTimeout == nil {
+				m.MachineCreationTimeout = &v11.Duration{}
 			}
-			if err := m.ImageFSInodesFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MachineCreationTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeFSAvailable", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEvictRetries", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34471,33 +39400,17 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NodeFSAvailable == nil {
-				m.NodeFSAvailable = &v11.Duration{}
-			}
-			if err := m.NodeFSAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.MaxEvictRetries = &v
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeFSInodesFree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeConditions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34507,27 +39420,23 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeFSInodesFree == nil {
-				m.NodeFSInodesFree = &v11.Duration{}
-			}
-			if err := m.NodeFSInodesFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeConditions = append(m.NodeConditions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34550,7 +39459,7 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubeletConfigReserved) Unmarshal(dAtA []byte) error {
+func (m *MachineImage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34573,17 +39482,17 @@ func (m *KubeletConfigReserved) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubeletConfigReserved: wiretype end group for non-group")
+			return fmt.Errorf("proto: MachineImage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubeletConfigReserved: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MachineImage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPU", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34593,31 +39502,27 @@ func (m *KubeletConfigReserved) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CPU == nil {
-				m.CPU = &resource.Quantity{}
-			}
-			if err := m.CPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34644,54 +39549,16 @@ func (m *KubeletConfigReserved) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Memory == nil {
-				m.Memory = &resource.Quantity{}
-			}
-			if err := m.Memory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Versions = append(m.Versions, MachineImageVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralStorage", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EphemeralStorage == nil {
-				m.EphemeralStorage = &resource.Quantity{}
-			}
-			if err := m.EphemeralStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStrategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34701,27 +39568,24 @@ func (m *KubeletConfigReserved) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PID == nil {
-				m.PID = &resource.Quantity{}
-			}
-			if err := m.PID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := MachineImageUpdateStrategy(dAtA[iNdEx:postIndex])
+			m.UpdateStrategy = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34744,7 +39608,7 @@ func (m *KubeletConfigReserved) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Kubernetes) Unmarshal(dAtA []byte) error {
+func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34767,17 +39631,17 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Kubernetes: wiretype end group for non-group")
+			return fmt.Errorf("proto: MachineImageVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Kubernetes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MachineImageVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowPrivilegedContainers", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirableVersion", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34787,16 +39651,28 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.AllowPrivilegedContainers = &b
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpirableVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterAutoscaler", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CRI", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34823,18 +39699,16 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClusterAutoscaler == nil {
-				m.ClusterAutoscaler = &ClusterAutoscaler{}
-			}
-			if err := m.ClusterAutoscaler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CRI = append(m.CRI, CRI{})
+			if err := m.CRI[len(m.CRI)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeAPIServer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Architectures", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34844,33 +39718,29 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KubeAPIServer == nil {
-				m.KubeAPIServer = &KubeAPIServerConfig{}
-			}
-			if err := m.KubeAPIServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Architectures = append(m.Architectures, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeControllerManager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeletVersionConstraint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34880,31 +39750,78 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.KubeletVersionConstraint = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MachineType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KubeControllerManager == nil {
-				m.KubeControllerManager = &KubeControllerManagerConfig{}
-			}
-			if err := m.KubeControllerManager.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MachineType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MachineType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeScheduler", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CPU", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34931,16 +39848,13 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KubeScheduler == nil {
-				m.KubeScheduler = &KubeSchedulerConfig{}
-			}
-			if err := m.KubeScheduler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeProxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GPU", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34967,16 +39881,13 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KubeProxy == nil {
-				m.KubeProxy = &KubeProxyConfig{}
-			}
-			if err := m.KubeProxy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kubelet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35003,16 +39914,13 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kubelet == nil {
-				m.Kubelet = &KubeletConfig{}
-			}
-			if err := m.Kubelet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Memory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35040,11 +39948,11 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerticalPodAutoscaler", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35071,16 +39979,16 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VerticalPodAutoscaler == nil {
-				m.VerticalPodAutoscaler = &VerticalPodAutoscaler{}
+			if m.Storage == nil {
+				m.Storage = &MachineTypeStorage{}
 			}
-			if err := m.VerticalPodAutoscaler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStaticTokenKubeconfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usable", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -35098,62 +40006,12 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 				}
 			}
 			b := bool(v != 0)
-			m.EnableStaticTokenKubeconfig = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KubernetesConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KubernetesConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubernetesConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Usable = &b
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeatureGates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Architecture", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35163,106 +40021,24 @@ func (m *KubernetesConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeatureGates == nil {
-				m.FeatureGates = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.FeatureGates[mapkey] = mapvalue
+			s := string(dAtA[iNdEx:postIndex])
+			m.Architecture = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35285,7 +40061,7 @@ func (m *KubernetesConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubernetesDashboard) Unmarshal(dAtA []byte) error {
+func (m *MachineTypeStorage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35308,15 +40084,15 @@ func (m *KubernetesDashboard) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubernetesDashboard: wiretype end group for non-group")
+			return fmt.Errorf("proto: MachineTypeStorage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubernetesDashboard: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MachineTypeStorage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticationMode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35344,12 +40120,11 @@ func (m *KubernetesDashboard) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.AuthenticationMode = &s
+			m.Class = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageSize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35376,63 +40151,48 @@ func (m *KubernetesDashboard) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Addon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StorageSize == nil {
+				m.StorageSize = &resource.Quantity{}
+			}
+			if err := m.StorageSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KubernetesSettings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KubernetesSettings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubernetesSettings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35459,8 +40219,10 @@ func (m *KubernetesSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, ExpirableVersion{})
-			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MinSize == nil {
+				m.MinSize = &resource.Quantity{}
+			}
+			if err := m.MinSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -35485,7 +40247,7 @@ func (m *KubernetesSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastError) Unmarshal(dAtA []byte) error {
+func (m *Maintenance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35508,17 +40270,17 @@ func (m *LastError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastError: wiretype end group for non-group")
+			return fmt.Errorf("proto: Maintenance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Maintenance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUpdate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35528,29 +40290,33 @@ func (m *LastError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.AutoUpdate == nil {
+				m.AutoUpdate = &MaintenanceAutoUpdate{}
+			}
+			if err := m.AutoUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWindow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35560,62 +40326,33 @@ func (m *LastError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.TaskID = &s
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.TimeWindow == nil {
+				m.TimeWindow = &MaintenanceTimeWindow{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.TimeWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Codes = append(m.Codes, ErrorCode(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfineSpecUpdateRollout", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35625,28 +40362,13 @@ func (m *LastError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastUpdateTime == nil {
-				m.LastUpdateTime = &v11.Time{}
-			}
-			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.ConfineSpecUpdateRollout = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35668,7 +40390,7 @@ func (m *LastError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35691,17 +40413,17 @@ func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastMaintenance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceAutoUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceAutoUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesVersion", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35711,29 +40433,17 @@ func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.KubernetesVersion = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggeredTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImageVersion", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35743,28 +40453,66 @@ func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			b := bool(v != 0)
+			m.MachineImageVersion = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceTimeWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceTimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35792,11 +40540,11 @@ func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = LastOperationState(dAtA[iNdEx:postIndex])
+			m.Begin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35824,8 +40572,7 @@ func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.FailureReason = &s
+			m.End = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35848,7 +40595,7 @@ func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastOperation) Unmarshal(dAtA []byte) error {
+func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35871,15 +40618,15 @@ func (m *LastOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemorySwapConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemorySwapConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapBehavior", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35907,65 +40654,64 @@ func (m *LastOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			s := SwapBehavior(dAtA[iNdEx:postIndex])
+			m.SwapBehavior = &s
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Monitoring) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Progress = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Progress |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Monitoring: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Monitoring: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alerting", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35975,55 +40721,27 @@ func (m *LastOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = LastOperationState(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Alerting == nil {
+				m.Alerting = &Alerting{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Alerting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Type = LastOperationType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36046,7 +40764,7 @@ func (m *LastOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Machine) Unmarshal(dAtA []byte) error {
+func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36069,15 +40787,15 @@ func (m *Machine) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Machine: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamedResourceReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Machine: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamedResourceReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36105,11 +40823,11 @@ func (m *Machine) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36136,46 +40854,10 @@ func (m *Machine) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &ShootMachineImage{}
-			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Architecture", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Architecture = &s
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36197,7 +40879,7 @@ func (m *Machine) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
+func (m *Networking) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36220,17 +40902,17 @@ func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MachineControllerManagerSettings: wiretype end group for non-group")
+			return fmt.Errorf("proto: Networking: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MachineControllerManagerSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Networking: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineDrainTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36240,31 +40922,28 @@ func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MachineDrainTimeout == nil {
-				m.MachineDrainTimeout = &v11.Duration{}
-			}
-			if err := m.MachineDrainTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Type = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineHealthTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36291,18 +40970,18 @@ func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MachineHealthTimeout == nil {
-				m.MachineHealthTimeout = &v11.Duration{}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
 			}
-			if err := m.MachineHealthTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineCreationTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36312,33 +40991,30 @@ func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MachineCreationTimeout == nil {
-				m.MachineCreationTimeout = &v11.Duration{}
-			}
-			if err := m.MachineCreationTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pods = &s
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEvictRetries", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36348,15 +41024,28 @@ func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MaxEvictRetries = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Nodes = &s
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeConditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36384,7 +41073,40 @@ func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeConditions = append(m.NodeConditions, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.Services = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IPFamilies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IPFamilies = append(m.IPFamilies, IPFamily(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36407,7 +41129,7 @@ func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MachineImage) Unmarshal(dAtA []byte) error {
+func (m *NginxIngress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36430,15 +41152,48 @@ func (m *MachineImage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MachineImage: wiretype end group for non-group")
+			return fmt.Errorf("proto: NginxIngress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MachineImage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NginxIngress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Addon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadBalancerSourceRanges", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36466,11 +41221,11 @@ func (m *MachineImage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.LoadBalancerSourceRanges = append(m.LoadBalancerSourceRanges, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36497,14 +41252,107 @@ func (m *MachineImage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, MachineImageVersion{})
-			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Config == nil {
+				m.Config = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Config[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalTrafficPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36532,8 +41380,8 @@ func (m *MachineImage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := MachineImageUpdateStrategy(dAtA[iNdEx:postIndex])
-			m.UpdateStrategy = &s
+			s := k8s_io_api_core_v1.ServiceExternalTrafficPolicy(dAtA[iNdEx:postIndex])
+			m.ExternalTrafficPolicy = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36556,7 +41404,7 @@ func (m *MachineImage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
+func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36579,17 +41427,17 @@ func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MachineImageVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeLocalDNS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MachineImageVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeLocalDNS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirableVersion", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36599,30 +41447,17 @@ func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ExpirableVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CRI", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTCPToClusterDNS", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36632,31 +41467,18 @@ func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CRI = append(m.CRI, CRI{})
-			if err := m.CRI[len(m.CRI)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.ForceTCPToClusterDNS = &b
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Architectures", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTCPToUpstreamDNS", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36666,29 +41488,18 @@ func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Architectures = append(m.Architectures, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.ForceTCPToUpstreamDNS = &b
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeletVersionConstraint", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableForwardToUpstreamDNS", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36698,25 +41509,13 @@ func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.KubeletVersionConstraint = &s
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.DisableForwardToUpstreamDNS = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36738,7 +41537,7 @@ func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MachineType) Unmarshal(dAtA []byte) error {
+func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36761,17 +41560,17 @@ func (m *MachineType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MachineType: wiretype end group for non-group")
+			return fmt.Errorf("proto: OIDCConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MachineType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OIDCConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPU", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36781,28 +41580,28 @@ func (m *MachineType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.CABundle = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GPU", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientAuthentication", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36829,15 +41628,18 @@ func (m *MachineType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClientAuthentication == nil {
+				m.ClientAuthentication = &OpenIDConnectClientAuthentication{}
+			}
+			if err := m.ClientAuthentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36847,28 +41649,28 @@ func (m *MachineType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Memory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ClientID = &s
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupsClaim", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36896,13 +41698,14 @@ func (m *MachineType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.GroupsClaim = &s
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupsPrefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36912,52 +41715,28 @@ func (m *MachineType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Storage == nil {
-				m.Storage = &MachineTypeStorage{}
-			}
-			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.GroupsPrefix = &s
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Usable = &b
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Architecture", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36986,63 +41765,13 @@ func (m *MachineType) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Architecture = &s
+			m.IssuerURL = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MachineTypeStorage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MachineTypeStorage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MachineTypeStorage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredClaims", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37052,29 +41781,124 @@ func (m *MachineTypeStorage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Class = string(dAtA[iNdEx:postIndex])
+			if m.RequiredClaims == nil {
+				m.RequiredClaims = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RequiredClaims[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningAlgs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37084,31 +41908,27 @@ func (m *MachineTypeStorage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageSize == nil {
-				m.StorageSize = &resource.Quantity{}
-			}
-			if err := m.StorageSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SigningAlgs = append(m.SigningAlgs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameClaim", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37136,13 +41956,14 @@ func (m *MachineTypeStorage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.UsernameClaim = &s
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernamePrefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37152,27 +41973,24 @@ func (m *MachineTypeStorage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MinSize == nil {
-				m.MinSize = &resource.Quantity{}
-			}
-			if err := m.MinSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.UsernamePrefix = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37195,7 +42013,7 @@ func (m *MachineTypeStorage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Maintenance) Unmarshal(dAtA []byte) error {
+func (m *ObservabilityRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37218,51 +42036,15 @@ func (m *Maintenance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Maintenance: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObservabilityRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Maintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObservabilityRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoUpdate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AutoUpdate == nil {
-				m.AutoUpdate = &MaintenanceAutoUpdate{}
-			}
-			if err := m.AutoUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37289,109 +42071,18 @@ func (m *Maintenance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TimeWindow == nil {
-				m.TimeWindow = &MaintenanceTimeWindow{}
+			if m.LastInitiationTime == nil {
+				m.LastInitiationTime = &v11.Time{}
 			}
-			if err := m.TimeWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfineSpecUpdateRollout", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.ConfineSpecUpdateRollout = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MaintenanceAutoUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaintenanceAutoUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesVersion", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.KubernetesVersion = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineImageVersion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37401,13 +42092,28 @@ func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.MachineImageVersion = &b
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionTime == nil {
+				m.LastCompletionTime = &v11.Time{}
+			}
+			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37429,7 +42135,7 @@ func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
+func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37452,17 +42158,17 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaintenanceTimeWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpenIDConnectClientAuthentication: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaintenanceTimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OpenIDConnectClientAuthentication: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37472,27 +42178,122 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Begin = string(dAtA[iNdEx:postIndex])
+			if m.ExtraConfig == nil {
+				m.ExtraConfig = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ExtraConfig[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37520,7 +42321,8 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.End = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Secret = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37543,7 +42345,7 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
+func (m *Project) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37566,17 +42368,17 @@ func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemorySwapConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: Project: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemorySwapConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapBehavior", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37586,78 +42388,61 @@ func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := SwapBehavior(dAtA[iNdEx:postIndex])
-			m.SwapBehavior = &s
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Monitoring) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Monitoring: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Monitoring: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alerting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37684,10 +42469,7 @@ func (m *Monitoring) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Alerting == nil {
-				m.Alerting = &Alerting{}
-			}
-			if err := m.Alerting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -37712,7 +42494,7 @@ func (m *Monitoring) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
+func (m *ProjectAccessReview) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37735,17 +42517,17 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamedResourceReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectAccessReview: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamedResourceReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectAccessReview: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReviewTimestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37755,27 +42537,28 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.LastReviewTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37802,7 +42585,8 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, ProjectMemberAccessReview{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -37827,7 +42611,7 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Networking) Unmarshal(dAtA []byte) error {
+func (m *ProjectAccumulatedUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37850,17 +42634,17 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Networking: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectAccumulatedUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Networking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectAccumulatedUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37870,28 +42654,28 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Type = &s
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37918,84 +42702,15 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
-			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastUpdateTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Pods = &s
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Nodes = &s
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38005,110 +42720,124 @@ func (m *Networking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Services = &s
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IPFamilies", wireType)
+			if m.Rates == nil {
+				m.Rates = make(k8s_io_api_core_v1.ResourceList)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IPFamilies = append(m.IPFamilies, IPFamily(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NginxIngress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NginxIngress: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NginxIngress: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Rates[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hours", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38135,45 +42864,109 @@ func (m *NginxIngress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Addon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoadBalancerSourceRanges", wireType)
+			if m.Hours == nil {
+				m.Hours = make(k8s_io_api_core_v1.ResourceList)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LoadBalancerSourceRanges = append(m.LoadBalancerSourceRanges, string(dAtA[iNdEx:postIndex]))
+			m.Hours[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38200,11 +42993,11 @@ func (m *NginxIngress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = make(map[string]string)
+			if m.MaximumRates == nil {
+				m.MaximumRates = make(k8s_io_api_core_v1.ResourceList)
 			}
-			var mapkey string
-			var mapvalue string
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -38250,10 +43043,10 @@ func (m *NginxIngress) Unmarshal(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -38263,24 +43056,26 @@ func (m *NginxIngress) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38296,13 +43091,13 @@ func (m *NginxIngress) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Config[mapkey] = mapvalue
+			m.MaximumRates[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalTrafficPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumHours", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38312,24 +43107,120 @@ func (m *NginxIngress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := k8s_io_api_core_v1.ServiceExternalTrafficPolicy(dAtA[iNdEx:postIndex])
-			m.ExternalTrafficPolicy = &s
+			if m.MaximumHours == nil {
+				m.MaximumHours = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MaximumHours[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38352,7 +43243,7 @@ func (m *NginxIngress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
+func (m *ProjectBastionSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38375,15 +43266,15 @@ func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeLocalDNS: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectBastionSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeLocalDNS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectBastionSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireApproval", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -38400,12 +43291,12 @@ func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			m.RequireApproval = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceTCPToClusterDNS", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSessionDuration", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38415,18 +43306,83 @@ func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.ForceTCPToClusterDNS = &b
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceTCPToUpstreamDNS", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxSessionDuration == nil {
+				m.MaxSessionDuration = &v11.Duration{}
+			}
+			if err := m.MaxSessionDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38436,18 +43392,30 @@ func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.ForceTCPToUpstreamDNS = &b
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableForwardToUpstreamDNS", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38457,13 +43425,26 @@ func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.DisableForwardToUpstreamDNS = &b
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Project{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38485,7 +43466,7 @@ func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
+func (m *ProjectMachineImageRestriction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38508,15 +43489,15 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OIDCConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectMachineImageRestriction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OIDCConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectMachineImageRestriction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38544,12 +43525,93 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.CABundle = &s
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientAuthentication", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38576,16 +43638,13 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientAuthentication == nil {
-				m.ClientAuthentication = &OpenIDConnectClientAuthentication{}
-			}
-			if err := m.ClientAuthentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38613,12 +43672,11 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ClientID = &s
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupsClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38646,14 +43704,13 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.GroupsClaim = &s
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupsPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38663,30 +43720,33 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.GroupsPrefix = &s
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = &v11.Time{}
+			}
+			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleExpirations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38696,28 +43756,79 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.IssuerURL = &s
+			m.RoleExpirations = append(m.RoleExpirations, ProjectMemberRoleExpiration{})
+			if err := m.RoleExpirations[len(m.RoleExpirations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectMemberAccessReview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectMemberAccessReview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectMemberAccessReview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredClaims", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38744,109 +43855,15 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RequiredClaims == nil {
-				m.RequiredClaims = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RequiredClaims[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningAlgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstReviewTimestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38856,29 +43873,30 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SigningAlgs = append(m.SigningAlgs, string(dAtA[iNdEx:postIndex]))
+			if err := m.FirstReviewTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityTimestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38888,30 +43906,33 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.UsernameClaim = &s
+			if m.LastActivityTimestamp == nil {
+				m.LastActivityTimestamp = &v11.Time{}
+			}
+			if err := m.LastActivityTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernamePrefix", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlaggedForRemoval", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38921,25 +43942,12 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.UsernamePrefix = &s
-			iNdEx = postIndex
+			m.FlaggedForRemoval = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38961,7 +43969,7 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObservabilityRotation) Unmarshal(dAtA []byte) error {
+func (m *ProjectMemberRoleExpiration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38984,17 +43992,17 @@ func (m *ObservabilityRotation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObservabilityRotation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectMemberRoleExpiration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObservabilityRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectMemberRoleExpiration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39004,31 +44012,27 @@ func (m *ObservabilityRotation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
-			}
-			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39055,10 +44059,7 @@ func (m *ObservabilityRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
-			}
-			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -39083,7 +44084,7 @@ func (m *ObservabilityRotation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
+func (m *ProjectQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39106,15 +44107,15 @@ func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OpenIDConnectClientAuthentication: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OpenIDConnectClientAuthentication: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39141,11 +44142,11 @@ func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExtraConfig == nil {
-				m.ExtraConfig = make(map[string]string)
+			if m.Limits == nil {
+				m.Limits = make(k8s_io_api_core_v1.ResourceList)
 			}
-			var mapkey string
-			var mapvalue string
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -39191,10 +44192,10 @@ func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -39204,24 +44205,26 @@ func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39237,189 +44240,7 @@ func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.ExtraConfig[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Secret = &s
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Project) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Project: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Limits[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39442,7 +44263,7 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectList) Unmarshal(dAtA []byte) error {
+func (m *ProjectRestrictions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39465,17 +44286,17 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectRestrictions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectRestrictions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39485,30 +44306,29 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Regions = append(m.Regions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineTypes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39518,81 +44338,29 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Project{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MachineTypes = append(m.MachineTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProjectMember) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectMember: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectMember: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Architectures", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39602,30 +44370,29 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Architectures = append(m.Architectures, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39635,27 +44402,29 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.MachineImages = append(m.MachineImages, ProjectMachineImageRestriction{})
+			if err := m.MachineImages[len(m.MachineImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39683,7 +44452,7 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			m.VolumeTypes = append(m.VolumeTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39976,6 +44745,114 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &ProjectQuota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Restrictions == nil {
+				m.Restrictions = &ProjectRestrictions{}
+			}
+			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bastion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bastion == nil {
+				m.Bastion = &ProjectBastionSettings{}
+			}
+			if err := m.Bastion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40106,16 +44983,217 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StaleSinceTimestamp == nil {
-				m.StaleSinceTimestamp = &v11.Time{}
+			if m.StaleSinceTimestamp == nil {
+				m.StaleSinceTimestamp = &v11.Time{}
+			}
+			if err := m.StaleSinceTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleAutoDeleteTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StaleAutoDeleteTimestamp == nil {
+				m.StaleAutoDeleteTimestamp = &v11.Time{}
+			}
+			if err := m.StaleAutoDeleteTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastActivityTimestamp == nil {
+				m.LastActivityTimestamp = &v11.Time{}
+			}
+			if err := m.LastActivityTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = make(k8s_io_api_core_v1.ResourceList)
 			}
-			if err := m.StaleSinceTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Usage[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StaleAutoDeleteTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessReview", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40142,16 +45220,16 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StaleAutoDeleteTimestamp == nil {
-				m.StaleAutoDeleteTimestamp = &v11.Time{}
+			if m.AccessReview == nil {
+				m.AccessReview = &ProjectAccessReview{}
 			}
-			if err := m.StaleAutoDeleteTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccessReview.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedUsage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40178,10 +45256,10 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastActivityTimestamp == nil {
-				m.LastActivityTimestamp = &v11.Time{}
+			if m.AccumulatedUsage == nil {
+				m.AccumulatedUsage = &ProjectAccumulatedUsage{}
 			}
-			if err := m.LastActivityTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccumulatedUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -42294,7 +47372,208 @@ func (m *SeedBackup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SeedDNS) Unmarshal(dAtA []byte) error {
+func (m *SeedDNS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedDNS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedDNS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Provider == nil {
+				m.Provider = &SeedDNSProvider{}
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedDNSProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedDNSProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedDNSProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedDrainStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42317,15 +47596,15 @@ func (m *SeedDNS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedDNS: wiretype end group for non-group")
+			return fmt.Errorf("proto: SeedDrainStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedDNS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SeedDrainStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42352,68 +47631,15 @@ func (m *SeedDNS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Provider == nil {
-				m.Provider = &SeedDNSProvider{}
-			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SeedDNSProvider) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SeedDNSProvider: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedDNSProvider: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingShoots", wireType)
 			}
-			var stringLen uint64
+			m.RemainingShoots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42423,29 +47649,16 @@ func (m *SeedDNSProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RemainingShoots |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratingShoots", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42455,24 +47668,23 @@ func (m *SeedDNSProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MigratingShoots = append(m.MigratingShoots, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -44398,6 +49610,112 @@ func (m *SeedSettingScheduling) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Visible = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Drain == nil {
+				m.Drain = &SeedSettingSchedulingDrain{}
+			}
+			if err := m.Drain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedSettingSchedulingDrain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedSettingSchedulingDrain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedSettingSchedulingDrain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxParallelMigrations", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxParallelMigrations = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -45666,6 +50984,42 @@ func (m *SeedStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Drain == nil {
+				m.Drain = &SeedDrainStatus{}
+			}
+			if err := m.Drain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -46641,15 +51995,98 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootAdvertisedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootAdvertisedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46659,24 +52096,55 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -46699,7 +52167,7 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
+func (m *ShootAvailability) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46722,15 +52190,15 @@ func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShootAdvertisedAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootAvailability: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootAdvertisedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootAvailability: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Last24Hours", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46758,11 +52226,12 @@ func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Last24Hours = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Last7Days", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46790,7 +52259,8 @@ func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Last7Days = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -47345,15 +52815,167 @@ func (m *ShootList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Shoot{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootMachineImage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootMachineImage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootMachineImage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47363,25 +52985,24 @@ func (m *ShootList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Shoot{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Version = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -47404,7 +53025,7 @@ func (m *ShootList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShootMachineImage) Unmarshal(dAtA []byte) error {
+func (m *ShootNetworks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47427,15 +53048,15 @@ func (m *ShootMachineImage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShootMachineImage: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootNetworks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootMachineImage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootNetworks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47463,47 +53084,12 @@ func (m *ShootMachineImage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Pods = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
-			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47532,7 +53118,7 @@ func (m *ShootMachineImage) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Version = &s
+			m.Services = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -47555,7 +53141,7 @@ func (m *ShootMachineImage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShootNetworks) Unmarshal(dAtA []byte) error {
+func (m *ShootSSHKeypairRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47578,17 +53164,17 @@ func (m *ShootNetworks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShootNetworks: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootSSHKeypairRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootNetworks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootSSHKeypairRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47598,30 +53184,33 @@ func (m *ShootNetworks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Pods = &s
+			if m.LastInitiationTime == nil {
+				m.LastInitiationTime = &v11.Time{}
+			}
+			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47631,24 +53220,27 @@ func (m *ShootNetworks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Services = &s
+			if m.LastCompletionTime == nil {
+				m.LastCompletionTime = &v11.Time{}
+			}
+			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -47671,7 +53263,7 @@ func (m *ShootNetworks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShootSSHKeypairRotation) Unmarshal(dAtA []byte) error {
+func (m *ShootSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47694,17 +53286,17 @@ func (m *ShootSSHKeypairRotation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShootSSHKeypairRotation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootSSHKeypairRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupEntryName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47714,33 +53306,29 @@ func (m *ShootSSHKeypairRotation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
-			}
-			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BackupEntryName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackupAge", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47750,28 +53338,12 @@ func (m *ShootSSHKeypairRotation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
-			}
-			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.MaxBackupAge = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48543,6 +54115,76 @@ func (m *ShootSpec) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.SchedulerName = &s
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, Hook{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &ShootSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -49564,6 +55206,42 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.EncryptedResources = append(m.EncryptedResources, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Availability", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Availability == nil {
+				m.Availability = &ShootAvailability{}
+			}
+			if err := m.Availability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -410,6 +410,11 @@ func SeedSettingTopologyAwareRoutingEnabled(settings *gardencorev1beta1.SeedSett
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
 }
 
+// SeedSettingDrainEnabled returns true if the seed is drained.
+func SeedSettingDrainEnabled(settings *gardencorev1beta1.SeedSettings) bool {
+	return settings != nil && settings.Scheduling != nil && settings.Scheduling.Drain != nil
+}
+
 // DetermineMachineImageForName finds the cloud specific machine images in the <cloudProfile> for the given <name> and
 // region. In case it does not find the machine image with the <name>, it returns false. Otherwise, true and the
 // cloud-specific machine image will be returned.
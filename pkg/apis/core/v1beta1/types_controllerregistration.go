@@ -30,6 +30,9 @@ type ControllerRegistration struct {
 	// Spec contains the specification of this registration.
 	// If the object's deletion timestamp is set, this field is immutable.
 	Spec ControllerRegistrationSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the status of this registration.
+	// +optional
+	Status ControllerRegistrationStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -107,8 +110,66 @@ type ControllerRegistrationDeployment struct {
 	// DeploymentRefs holds references to `ControllerDeployments`. Only one element is supported currently.
 	// +optional
 	DeploymentRefs []DeploymentRef `json:"deploymentRefs,omitempty" protobuf:"bytes,5,opt,name=deploymentRefs"`
+	// Rollout configures how a switch to another `ControllerDeployment` is rolled out to the seeds. If not set, the
+	// referenced `ControllerDeployment` is rolled out to all seeds at once.
+	// +optional
+	Rollout *ControllerRegistrationRollout `json:"rollout,omitempty" protobuf:"bytes,6,opt,name=rollout"`
+}
+
+// ControllerRegistrationRollout configures the progressive rollout of a `ControllerDeployment` to the seeds.
+type ControllerRegistrationRollout struct {
+	// CanarySeedSelector selects the seeds to which the `ControllerDeployment` is rolled out first. The rollout only
+	// continues with the other seeds once the controller is installed and healthy on all canary seeds.
+	// +optional
+	CanarySeedSelector *metav1.LabelSelector `json:"canarySeedSelector,omitempty" protobuf:"bytes,1,opt,name=canarySeedSelector"`
+	// MaxUnavailable is the maximum number of seeds (besides the canary seeds) on which the rolled out controller may
+	// not be installed and healthy at the same time. It defaults to 1.
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty" protobuf:"varint,2,opt,name=maxUnavailable"`
+}
+
+// ControllerRegistrationStatus is the status of a ControllerRegistration.
+type ControllerRegistrationStatus struct {
+	// Rollout contains information about the progressive rollout of the referenced `ControllerDeployment`.
+	// +optional
+	Rollout *ControllerRegistrationRolloutStatus `json:"rollout,omitempty" protobuf:"bytes,1,opt,name=rollout"`
 }
 
+// ControllerRegistrationRolloutStatus contains information about the progressive rollout of a `ControllerDeployment`.
+type ControllerRegistrationRolloutStatus struct {
+	// DeploymentName is the name of the `ControllerDeployment` which is rolled out.
+	DeploymentName string `json:"deploymentName" protobuf:"bytes,1,opt,name=deploymentName"`
+	// Phase is the phase of the rollout.
+	Phase ControllerRegistrationRolloutPhase `json:"phase" protobuf:"bytes,2,opt,name=phase,casttype=ControllerRegistrationRolloutPhase"`
+	// Seeds is the number of seeds the controller is installed on.
+	Seeds int32 `json:"seeds" protobuf:"varint,3,opt,name=seeds"`
+	// UpdatedSeeds is the number of seeds on which the `ControllerInstallation` references the rolled out
+	// `ControllerDeployment`.
+	UpdatedSeeds int32 `json:"updatedSeeds" protobuf:"varint,4,opt,name=updatedSeeds"`
+	// AvailableSeeds is the number of updated seeds on which the controller is installed and healthy.
+	AvailableSeeds int32 `json:"availableSeeds" protobuf:"varint,5,opt,name=availableSeeds"`
+	// FailedSeeds is the list of updated seeds on which the controller is not valid, failed to install or is unhealthy.
+	// The rollout is paused as long as this list is not empty.
+	// +optional
+	FailedSeeds []string `json:"failedSeeds,omitempty" protobuf:"bytes,6,rep,name=failedSeeds"`
+	// LastUpdateTime is the last time the rollout status was updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty" protobuf:"bytes,7,opt,name=lastUpdateTime"`
+}
+
+// ControllerRegistrationRolloutPhase is a string alias.
+type ControllerRegistrationRolloutPhase string
+
+const (
+	// ControllerRegistrationRolloutProgressing indicates that the `ControllerDeployment` is being rolled out.
+	ControllerRegistrationRolloutProgressing ControllerRegistrationRolloutPhase = "Progressing"
+	// ControllerRegistrationRolloutPaused indicates that the rollout is paused because the controller failed on at least
+	// one updated seed.
+	ControllerRegistrationRolloutPaused ControllerRegistrationRolloutPhase = "Paused"
+	// ControllerRegistrationRolloutCompleted indicates that the `ControllerDeployment` has been rolled out to all seeds.
+	ControllerRegistrationRolloutCompleted ControllerRegistrationRolloutPhase = "Completed"
+)
+
 // ControllerDeploymentPolicy is a string alias.
 type ControllerDeploymentPolicy string
 
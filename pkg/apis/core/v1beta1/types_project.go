@@ -15,6 +15,7 @@
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -81,6 +82,19 @@ type ProjectSpec struct {
 	// Tolerations contains the tolerations for taints on seed clusters.
 	// +optional
 	Tolerations *ProjectTolerations `json:"tolerations,omitempty" protobuf:"bytes,7,opt,name=tolerations"`
+	// Quota contains limits for the resources consumed by all Shoots of the project independent of the used
+	// credentials. Please note that this field may only be changed by users having the `modify-spec-quota` verb for
+	// project resources.
+	// +optional
+	Quota *ProjectQuota `json:"quota,omitempty" protobuf:"bytes,8,opt,name=quota"`
+	// Restrictions narrows down the settings offered by the CloudProfiles which may be used by the Shoots of the
+	// project. Please note that this field may only be changed by users having the `modify-spec-restrictions` verb for
+	// project resources.
+	// +optional
+	Restrictions *ProjectRestrictions `json:"restrictions,omitempty" protobuf:"bytes,9,opt,name=restrictions"`
+	// Bastion contains settings for the Bastions of the project.
+	// +optional
+	Bastion *ProjectBastionSettings `json:"bastion,omitempty" protobuf:"bytes,10,opt,name=bastion"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -100,8 +114,126 @@ type ProjectStatus struct {
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	// +optional
 	LastActivityTimestamp *metav1.Time `json:"lastActivityTimestamp,omitempty" protobuf:"bytes,5,opt,name=lastActivityTimestamp"`
+	// Usage contains the resources currently consumed by all Shoots of the project. The same metrics which can be
+	// limited via `.spec.quota.limits` are reported.
+	// +optional
+	Usage corev1.ResourceList `json:"usage,omitempty" protobuf:"bytes,6,rep,name=usage,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// AccessReview contains the result of the last review of the access of the project members.
+	// +optional
+	AccessReview *ProjectAccessReview `json:"accessReview,omitempty" protobuf:"bytes,7,opt,name=accessReview"`
+	// AccumulatedUsage contains the resources consumed by all Shoots of the project over time, e.g., for chargeback.
+	// +optional
+	AccumulatedUsage *ProjectAccumulatedUsage `json:"accumulatedUsage,omitempty" protobuf:"bytes,8,opt,name=accumulatedUsage"`
+}
+
+// ProjectAccumulatedUsage contains the resources consumed by all Shoots of a project over time.
+type ProjectAccumulatedUsage struct {
+	// Since is the time from which on the usage has been accumulated.
+	Since metav1.Time `json:"since" protobuf:"bytes,1,opt,name=since"`
+	// LastUpdateTimestamp is the time until which the usage has been accumulated.
+	LastUpdateTimestamp metav1.Time `json:"lastUpdateTimestamp" protobuf:"bytes,2,opt,name=lastUpdateTimestamp"`
+	// Rates contains the resources consumed by all Shoots of the project at the time of the last update. Other than
+	// in `.status.usage`, only the minimum number of nodes of each worker pool is considered, i.e., the rates are a
+	// lower bound of the actual consumption. The worker nodes and load balancers of hibernated Shoots are not
+	// considered. Instead, the number of hibernated Shoots is reported as `hibernated-shoots`.
+	// +optional
+	Rates corev1.ResourceList `json:"rates,omitempty" protobuf:"bytes,3,rep,name=rates,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// Hours contains the resources accumulated over time in hours based on the `rates`, e.g., `cpu` contains the
+	// vCPU-hours, `memory` the memory-hours (in bytes) and `hibernated-shoots` the hours Shoots of the project have been
+	// hibernated. As the `rates`, the hours are a lower bound of the actual consumption.
+	// +optional
+	Hours corev1.ResourceList `json:"hours,omitempty" protobuf:"bytes,4,rep,name=hours,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// MaximumRates contains the same resources as `rates` but considers the maximum number of nodes of each worker
+	// pool, i.e., they are an upper bound of the actual consumption.
+	// +optional
+	MaximumRates corev1.ResourceList `json:"maximumRates,omitempty" protobuf:"bytes,5,rep,name=maximumRates,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// MaximumHours contains the resources accumulated over time in hours based on the `maximumRates`, i.e., they are an
+	// upper bound of the actual consumption.
+	// +optional
+	MaximumHours corev1.ResourceList `json:"maximumHours,omitempty" protobuf:"bytes,6,rep,name=maximumHours,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+}
+
+// ProjectAccessReview contains the result of a review of the access of the project members.
+type ProjectAccessReview struct {
+	// LastReviewTimestamp is the time when the access of the project members was last reviewed.
+	LastReviewTimestamp metav1.Time `json:"lastReviewTimestamp" protobuf:"bytes,1,opt,name=lastReviewTimestamp"`
+	// Members contains the review results for the members of kind `User` and `ServiceAccount`.
+	// +optional
+	Members []ProjectMemberAccessReview `json:"members,omitempty" protobuf:"bytes,2,rep,name=members"`
+}
+
+// ProjectMemberAccessReview contains the review result for a project member.
+type ProjectMemberAccessReview struct {
+	// Subject is the reviewed project member.
+	rbacv1.Subject `json:",inline" protobuf:"bytes,1,opt,name=subject"`
+	// FirstReviewTimestamp is the time when the member was reviewed for the first time.
+	FirstReviewTimestamp metav1.Time `json:"firstReviewTimestamp" protobuf:"bytes,2,opt,name=firstReviewTimestamp"`
+	// LastActivityTimestamp is the time when the member was last seen using the project.
+	// +optional
+	LastActivityTimestamp *metav1.Time `json:"lastActivityTimestamp,omitempty" protobuf:"bytes,3,opt,name=lastActivityTimestamp"`
+	// FlaggedForRemoval indicates that the member has not used the project for longer than the configured inactivity
+	// threshold and should be removed from the project.
+	// +optional
+	FlaggedForRemoval bool `json:"flaggedForRemoval,omitempty" protobuf:"varint,4,opt,name=flaggedForRemoval"`
+}
+
+// ProjectQuota contains limits for the resources consumed by all Shoots of a project.
+type ProjectQuota struct {
+	// Limits is the maximum amount of resources which may be consumed by all Shoots of the project.
+	// Supported resources are `shoots`, `cpu`, `gpu`, `memory` and `loadbalancer`.
+	// +optional
+	Limits corev1.ResourceList `json:"limits,omitempty" protobuf:"bytes,1,rep,name=limits,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+}
+
+// ProjectRestrictions contains restrictions for the Shoots of a project. Empty lists do not restrict the respective
+// settings.
+type ProjectRestrictions struct {
+	// Regions is the list of regions which may be used by Shoots.
+	// +optional
+	Regions []string `json:"regions,omitempty" protobuf:"bytes,1,rep,name=regions"`
+	// MachineTypes is the list of machine types which may be used by worker pools.
+	// +optional
+	MachineTypes []string `json:"machineTypes,omitempty" protobuf:"bytes,2,rep,name=machineTypes"`
+	// Architectures is the list of CPU architectures which may be used by worker pools.
+	// +optional
+	Architectures []string `json:"architectures,omitempty" protobuf:"bytes,3,rep,name=architectures"`
+	// MachineImages is the list of machine images (and optionally their versions) which may be used by worker pools.
+	// +optional
+	MachineImages []ProjectMachineImageRestriction `json:"machineImages,omitempty" protobuf:"bytes,4,rep,name=machineImages"`
+	// VolumeTypes is the list of volume types which may be used by worker pools.
+	// +optional
+	VolumeTypes []string `json:"volumeTypes,omitempty" protobuf:"bytes,5,rep,name=volumeTypes"`
+}
+
+// ProjectBastionSettings contains settings for the Bastions of a project.
+type ProjectBastionSettings struct {
+	// RequireApproval specifies whether Bastions must be approved by another member of the project having the `admin`
+	// role before the bastion hosts are created.
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty" protobuf:"varint,1,opt,name=requireApproval"`
+	// MaxSessionDuration is the maximum duration for which Bastions may be used. If approvals are required, the
+	// duration starts with the approval.
+	// +optional
+	MaxSessionDuration *metav1.Duration `json:"maxSessionDuration,omitempty" protobuf:"bytes,2,opt,name=maxSessionDuration"`
+}
+
+// ProjectMachineImageRestriction contains a machine image which may be used by worker pools.
+type ProjectMachineImageRestriction struct {
+	// Name is the name of the machine image.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Versions is the list of versions of the machine image which may be used. If empty, all versions are allowed.
+	// +optional
+	Versions []string `json:"versions,omitempty" protobuf:"bytes,2,rep,name=versions"`
 }
 
+const (
+	// ProjectQuotaMetricShoots is the constraint for the number of Shoots in a project.
+	ProjectQuotaMetricShoots corev1.ResourceName = "shoots"
+	// ProjectUsageMetricHibernatedShoots is the number of hibernated Shoots in a project reported in the accumulated
+	// usage.
+	ProjectUsageMetricHibernatedShoots corev1.ResourceName = "hibernated-shoots"
+)
+
 // ProjectMember is a member of a project.
 type ProjectMember struct {
 	// Subject is representing a user name, an email address, or any other identifier of a user, group, or service
@@ -115,6 +247,20 @@ type ProjectMember struct {
 	// Roles represents the list of roles of this member.
 	// +optional
 	Roles []string `json:"roles,omitempty" protobuf:"bytes,3,rep,name=roles"`
+	// ExpirationDate is the time after which the member is removed from the project.
+	// +optional
+	ExpirationDate *metav1.Time `json:"expirationDate,omitempty" protobuf:"bytes,4,opt,name=expirationDate"`
+	// RoleExpirations contains the times after which single roles are removed from the member.
+	// +optional
+	RoleExpirations []ProjectMemberRoleExpiration `json:"roleExpirations,omitempty" protobuf:"bytes,5,rep,name=roleExpirations"`
+}
+
+// ProjectMemberRoleExpiration contains the time after which a role is removed from a project member.
+type ProjectMemberRoleExpiration struct {
+	// Role is the name of the role.
+	Role string `json:"role" protobuf:"bytes,1,opt,name=role"`
+	// ExpirationDate is the time after which the role is removed from the member.
+	ExpirationDate metav1.Time `json:"expirationDate" protobuf:"bytes,2,opt,name=expirationDate"`
 }
 
 // ProjectTolerations contains the tolerations for taints on seed clusters.
@@ -179,4 +325,15 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventMemberExpired indicates that a member has been removed from the project because it expired.
+	ProjectEventMemberExpired = "MemberExpired"
+	// ProjectEventMemberRoleExpired indicates that a role has been removed from a member because it expired.
+	ProjectEventMemberRoleExpired = "MemberRoleExpired"
+	// ProjectEventStale indicates that the project is stale although it still contains Shoots.
+	ProjectEventStale = "Stale"
+	// ProjectEventStaleShootsHibernated indicates that the Shoots of a stale project are hibernated.
+	ProjectEventStaleShootsHibernated = "StaleShootsHibernated"
+	// ProjectEventStaleDeletionPending indicates that a stale project will be deleted once its owner confirmed the
+	// deletion.
+	ProjectEventStaleDeletionPending = "StaleDeletionPending"
 )
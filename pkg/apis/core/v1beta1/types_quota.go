@@ -55,3 +55,18 @@ type QuotaSpec struct {
 	// Scope is the scope of the Quota object, either 'project' or 'secret'. This field is immutable.
 	Scope corev1.ObjectReference `json:"scope" protobuf:"bytes,3,opt,name=scope"`
 }
+
+const (
+	// QuotaMetricCPU is the constraint for the amount of CPUs
+	QuotaMetricCPU corev1.ResourceName = corev1.ResourceCPU
+	// QuotaMetricGPU is the constraint for the amount of GPUs (e.g. from Nvidia)
+	QuotaMetricGPU corev1.ResourceName = "gpu"
+	// QuotaMetricMemory is the constraint for the amount of memory
+	QuotaMetricMemory corev1.ResourceName = corev1.ResourceMemory
+	// QuotaMetricStorageStandard is the constraint for the size of a standard disk
+	QuotaMetricStorageStandard corev1.ResourceName = corev1.ResourceStorage + ".standard"
+	// QuotaMetricStoragePremium is the constraint for the size of a premium disk (e.g. SSD)
+	QuotaMetricStoragePremium corev1.ResourceName = corev1.ResourceStorage + ".premium"
+	// QuotaMetricLoadbalancer is the constraint for the amount of loadbalancers
+	QuotaMetricLoadbalancer corev1.ResourceName = "loadbalancer"
+)
@@ -123,6 +123,20 @@ type SeedStatus struct {
 	// LastOperation holds information about the last operation on the Seed.
 	// +optional
 	LastOperation *LastOperation `json:"lastOperation,omitempty" protobuf:"bytes,9,opt,name=lastOperation"`
+	// Drain contains information about the progress of draining the Seed.
+	// +optional
+	Drain *SeedDrainStatus `json:"drain,omitempty" protobuf:"bytes,10,opt,name=drain"`
+}
+
+// SeedDrainStatus contains information about the progress of draining a seed.
+type SeedDrainStatus struct {
+	// StartTimestamp is the time when the drain of the seed was started.
+	StartTimestamp metav1.Time `json:"startTimestamp" protobuf:"bytes,1,opt,name=startTimestamp"`
+	// RemainingShoots is the number of shoots whose control planes are still hosted by the seed.
+	RemainingShoots int32 `json:"remainingShoots" protobuf:"varint,2,opt,name=remainingShoots"`
+	// MigratingShoots is the list of shoots (in the format <namespace>/<name>) which are currently migrated off the seed.
+	// +optional
+	MigratingShoots []string `json:"migratingShoots,omitempty" protobuf:"bytes,3,rep,name=migratingShoots"`
 }
 
 // SeedBackup contains the object store configuration for backups for shoot (currently only etcd).
@@ -292,6 +306,18 @@ type SeedSettingScheduling struct {
 	// Visible controls whether the gardener-scheduler shall consider this seed when scheduling shoots. Invisible seeds
 	// are not considered by the scheduler.
 	Visible bool `json:"visible" protobuf:"bytes,1,opt,name=visible"`
+	// Drain controls whether the seed is drained. Draining seeds are not considered by the scheduler, and the shoots
+	// hosted by them are migrated to other seeds during their maintenance time windows.
+	// +optional
+	Drain *SeedSettingSchedulingDrain `json:"drain,omitempty" protobuf:"bytes,2,opt,name=drain"`
+}
+
+// SeedSettingSchedulingDrain controls settings for draining the seed.
+type SeedSettingSchedulingDrain struct {
+	// MaxParallelMigrations is the maximum number of shoots which are migrated off the seed at the same time.
+	// Defaults to 1.
+	// +optional
+	MaxParallelMigrations *int32 `json:"maxParallelMigrations,omitempty" protobuf:"varint,1,opt,name=maxParallelMigrations"`
 }
 
 // SeedSettingLoadBalancerServices controls certain settings for services of type load balancer that are created in the
@@ -420,6 +446,15 @@ const (
 	SeedSystemComponentsHealthy ConditionType = "SeedSystemComponentsHealthy"
 )
 
+const (
+	// SeedEventShootMigrationTriggered indicates that the migration of a shoot off a drained seed was triggered.
+	SeedEventShootMigrationTriggered = "ShootMigrationTriggered"
+	// SeedEventDrainPaused indicates that the drain of a seed is paused because its gardenlet is not ready.
+	SeedEventDrainPaused = "DrainPaused"
+	// SeedEventDrained indicates that all shoots have been migrated off a drained seed.
+	SeedEventDrained = "Drained"
+)
+
 // Resource constants for Gardener object types
 const (
 	// ResourceShoots is a resource constant for the number of shoots.
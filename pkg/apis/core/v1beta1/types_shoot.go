@@ -135,6 +135,15 @@ type ShootSpec struct {
 	// This field is immutable.
 	// +optional
 	SchedulerName *string `json:"schedulerName,omitempty" protobuf:"bytes,21,opt,name=schedulerName"`
+	// Hooks contains hooks which are executed at well-defined points of the reconciliation and deletion flows of the
+	// Shoot.
+	// +optional
+	Hooks []Hook `json:"hooks,omitempty" protobuf:"bytes,22,rep,name=hooks"`
+	// Source contains information about the source the shoot cluster is cloned from when it is created. The ETCD of
+	// the shoot is initialized with the latest backup of the source before it is started for the first time, and
+	// node-related state is reset afterwards. This field is immutable.
+	// +optional
+	Source *ShootSource `json:"source,omitempty" protobuf:"bytes,23,opt,name=source"`
 }
 
 // GetProviderType gets the type of the provider.
@@ -208,6 +217,22 @@ type ShootStatus struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	// +optional
 	EncryptedResources []string `json:"encryptedResources,omitempty" protobuf:"bytes,18,rep,name=encryptedResources"`
+	// Availability contains the availability of the Shoot computed from the health check history reported by the
+	// extensions.
+	// +optional
+	Availability *ShootAvailability `json:"availability,omitempty" protobuf:"bytes,19,opt,name=availability"`
+}
+
+// ShootAvailability contains the availability of the Shoot during the last 24 hours and 7 days.
+type ShootAvailability struct {
+	// Last24Hours is the percentage of successful health checks during the last 24 hours. It is the minimum of the
+	// availabilities reported by the extensions.
+	// +optional
+	Last24Hours *string `json:"last24Hours,omitempty" protobuf:"bytes,1,opt,name=last24Hours"`
+	// Last7Days is the percentage of successful health checks during the last 7 days. It is the minimum of the
+	// availabilities reported by the extensions.
+	// +optional
+	Last7Days *string `json:"last7Days,omitempty" protobuf:"bytes,2,opt,name=last7Days"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -488,6 +513,67 @@ type Extension struct {
 	Disabled *bool `json:"disabled,omitempty" protobuf:"varint,3,opt,name=disabled"`
 }
 
+// Hook contains information about a hook which is executed at a well-defined point of the reconciliation or deletion
+// flow of the Shoot. For every execution, an Extension resource of the hook's type is created in the shoot namespace
+// in the seed, and the flow waits until it was reconciled successfully.
+type Hook struct {
+	// Name is the name of the hook. It must be unique within the Shoot.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Type is the type of the Extension resource which implements the hook.
+	Type string `json:"type" protobuf:"bytes,2,opt,name=type"`
+	// Point is the point in the flow at which the hook is executed. Possible values are PreHibernation,
+	// PostHibernation, PreKubernetesUpgrade, PostKubernetesUpgrade, and PreDeletion.
+	Point HookPoint `json:"point" protobuf:"bytes,3,opt,name=point,casttype=HookPoint"`
+	// ProviderConfig is the configuration passed to the Extension resource.
+	// +optional
+	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty" protobuf:"bytes,4,opt,name=providerConfig"`
+	// Timeout is the maximum duration to wait for the hook to succeed. Defaults to 10m.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,5,opt,name=timeout"`
+	// FailurePolicy defines how a failed or timed out hook is handled. Possible values are Fail (the flow fails) and
+	// Ignore (the flow continues). Defaults to Fail.
+	// +optional
+	FailurePolicy *HookFailurePolicy `json:"failurePolicy,omitempty" protobuf:"bytes,6,opt,name=failurePolicy,casttype=HookFailurePolicy"`
+}
+
+// HookPoint is a point in the flow at which a hook is executed.
+type HookPoint string
+
+const (
+	// HookPointPreHibernation is the point before the Shoot is hibernated.
+	HookPointPreHibernation HookPoint = "PreHibernation"
+	// HookPointPostHibernation is the point after the Shoot was hibernated.
+	HookPointPostHibernation HookPoint = "PostHibernation"
+	// HookPointPreKubernetesUpgrade is the point before the Kubernetes version of the Shoot is upgraded.
+	HookPointPreKubernetesUpgrade HookPoint = "PreKubernetesUpgrade"
+	// HookPointPostKubernetesUpgrade is the point after the Kubernetes version of the Shoot was upgraded.
+	HookPointPostKubernetesUpgrade HookPoint = "PostKubernetesUpgrade"
+	// HookPointPreDeletion is the point before the Shoot is deleted.
+	HookPointPreDeletion HookPoint = "PreDeletion"
+)
+
+// HookFailurePolicy defines how a failed or timed out hook is handled.
+type HookFailurePolicy string
+
+const (
+	// HookFailurePolicyFail makes the flow fail if the hook fails.
+	HookFailurePolicyFail HookFailurePolicy = "Fail"
+	// HookFailurePolicyIgnore makes the flow continue if the hook fails.
+	HookFailurePolicyIgnore HookFailurePolicy = "Ignore"
+)
+
+// ShootSource contains information about the source a shoot cluster is cloned from.
+type ShootSource struct {
+	// BackupEntryName is the name of the BackupEntry in the namespace of the shoot whose latest ETCD backup is used to
+	// initialize the ETCD of the shoot. Selecting an earlier snapshot is not supported. The BackupEntry must belong to
+	// an existing shoot whose control plane runs on the same seed.
+	BackupEntryName string `json:"backupEntryName" protobuf:"bytes,1,opt,name=backupEntryName"`
+	// MaxBackupAge is the maximum age in days of the ETCD backups of the source shoot which are copied for the shoot.
+	// Older backups are not copied. If not set, all backups are copied.
+	// +optional
+	MaxBackupAge *int32 `json:"maxBackupAge,omitempty" protobuf:"varint,2,opt,name=maxBackupAge"`
+}
+
 // NamedResourceReference is a named reference to a resource.
 type NamedResourceReference struct {
 	// Name of the resource reference.
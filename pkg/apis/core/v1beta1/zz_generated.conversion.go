@@ -402,6 +402,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerRegistrationRollout)(nil), (*core.ControllerRegistrationRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControllerRegistrationRollout_To_core_ControllerRegistrationRollout(a.(*ControllerRegistrationRollout), b.(*core.ControllerRegistrationRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerRegistrationRollout)(nil), (*ControllerRegistrationRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerRegistrationRollout_To_v1beta1_ControllerRegistrationRollout(a.(*core.ControllerRegistrationRollout), b.(*ControllerRegistrationRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControllerRegistrationRolloutStatus)(nil), (*core.ControllerRegistrationRolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControllerRegistrationRolloutStatus_To_core_ControllerRegistrationRolloutStatus(a.(*ControllerRegistrationRolloutStatus), b.(*core.ControllerRegistrationRolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerRegistrationRolloutStatus)(nil), (*ControllerRegistrationRolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerRegistrationRolloutStatus_To_v1beta1_ControllerRegistrationRolloutStatus(a.(*core.ControllerRegistrationRolloutStatus), b.(*ControllerRegistrationRolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerRegistrationSpec)(nil), (*core.ControllerRegistrationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControllerRegistrationSpec_To_core_ControllerRegistrationSpec(a.(*ControllerRegistrationSpec), b.(*core.ControllerRegistrationSpec), scope)
 	}); err != nil {
@@ -412,6 +432,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerRegistrationStatus)(nil), (*core.ControllerRegistrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(a.(*ControllerRegistrationStatus), b.(*core.ControllerRegistrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerRegistrationStatus)(nil), (*ControllerRegistrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerRegistrationStatus_To_v1beta1_ControllerRegistrationStatus(a.(*core.ControllerRegistrationStatus), b.(*ControllerRegistrationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerResource)(nil), (*core.ControllerResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControllerResource_To_core_ControllerResource(a.(*ControllerResource), b.(*core.ControllerResource), scope)
 	}); err != nil {
@@ -652,6 +682,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Hook)(nil), (*core.Hook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Hook_To_core_Hook(a.(*Hook), b.(*core.Hook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.Hook)(nil), (*Hook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Hook_To_v1beta1_Hook(a.(*core.Hook), b.(*Hook), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HorizontalPodAutoscalerConfig)(nil), (*core.HorizontalPodAutoscalerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HorizontalPodAutoscalerConfig_To_core_HorizontalPodAutoscalerConfig(a.(*HorizontalPodAutoscalerConfig), b.(*core.HorizontalPodAutoscalerConfig), scope)
 	}); err != nil {
@@ -1047,6 +1087,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectAccessReview)(nil), (*core.ProjectAccessReview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectAccessReview_To_core_ProjectAccessReview(a.(*ProjectAccessReview), b.(*core.ProjectAccessReview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectAccessReview)(nil), (*ProjectAccessReview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectAccessReview_To_v1beta1_ProjectAccessReview(a.(*core.ProjectAccessReview), b.(*ProjectAccessReview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectAccumulatedUsage)(nil), (*core.ProjectAccumulatedUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectAccumulatedUsage_To_core_ProjectAccumulatedUsage(a.(*ProjectAccumulatedUsage), b.(*core.ProjectAccumulatedUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectAccumulatedUsage)(nil), (*ProjectAccumulatedUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectAccumulatedUsage_To_v1beta1_ProjectAccumulatedUsage(a.(*core.ProjectAccumulatedUsage), b.(*ProjectAccumulatedUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectBastionSettings)(nil), (*core.ProjectBastionSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectBastionSettings_To_core_ProjectBastionSettings(a.(*ProjectBastionSettings), b.(*core.ProjectBastionSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectBastionSettings)(nil), (*ProjectBastionSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectBastionSettings_To_v1beta1_ProjectBastionSettings(a.(*core.ProjectBastionSettings), b.(*ProjectBastionSettings), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectList)(nil), (*core.ProjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectList_To_core_ProjectList(a.(*ProjectList), b.(*core.ProjectList), scope)
 	}); err != nil {
@@ -1057,6 +1127,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectMachineImageRestriction)(nil), (*core.ProjectMachineImageRestriction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectMachineImageRestriction_To_core_ProjectMachineImageRestriction(a.(*ProjectMachineImageRestriction), b.(*core.ProjectMachineImageRestriction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectMachineImageRestriction)(nil), (*ProjectMachineImageRestriction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectMachineImageRestriction_To_v1beta1_ProjectMachineImageRestriction(a.(*core.ProjectMachineImageRestriction), b.(*ProjectMachineImageRestriction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectMemberAccessReview)(nil), (*core.ProjectMemberAccessReview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectMemberAccessReview_To_core_ProjectMemberAccessReview(a.(*ProjectMemberAccessReview), b.(*core.ProjectMemberAccessReview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectMemberAccessReview)(nil), (*ProjectMemberAccessReview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectMemberAccessReview_To_v1beta1_ProjectMemberAccessReview(a.(*core.ProjectMemberAccessReview), b.(*ProjectMemberAccessReview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectMemberRoleExpiration)(nil), (*core.ProjectMemberRoleExpiration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectMemberRoleExpiration_To_core_ProjectMemberRoleExpiration(a.(*ProjectMemberRoleExpiration), b.(*core.ProjectMemberRoleExpiration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectMemberRoleExpiration)(nil), (*ProjectMemberRoleExpiration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectMemberRoleExpiration_To_v1beta1_ProjectMemberRoleExpiration(a.(*core.ProjectMemberRoleExpiration), b.(*ProjectMemberRoleExpiration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectQuota)(nil), (*core.ProjectQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectQuota_To_core_ProjectQuota(a.(*ProjectQuota), b.(*core.ProjectQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectQuota)(nil), (*ProjectQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectQuota_To_v1beta1_ProjectQuota(a.(*core.ProjectQuota), b.(*ProjectQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectRestrictions)(nil), (*core.ProjectRestrictions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectRestrictions_To_core_ProjectRestrictions(a.(*ProjectRestrictions), b.(*core.ProjectRestrictions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectRestrictions)(nil), (*ProjectRestrictions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectRestrictions_To_v1beta1_ProjectRestrictions(a.(*core.ProjectRestrictions), b.(*ProjectRestrictions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectStatus)(nil), (*core.ProjectStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectStatus_To_core_ProjectStatus(a.(*ProjectStatus), b.(*core.ProjectStatus), scope)
 	}); err != nil {
@@ -1227,6 +1347,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedDrainStatus)(nil), (*core.SeedDrainStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(a.(*SeedDrainStatus), b.(*core.SeedDrainStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedDrainStatus)(nil), (*SeedDrainStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(a.(*core.SeedDrainStatus), b.(*SeedDrainStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedList)(nil), (*core.SeedList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedList_To_core_SeedList(a.(*SeedList), b.(*core.SeedList), scope)
 	}); err != nil {
@@ -1347,6 +1477,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingSchedulingDrain)(nil), (*core.SeedSettingSchedulingDrain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingSchedulingDrain_To_core_SeedSettingSchedulingDrain(a.(*SeedSettingSchedulingDrain), b.(*core.SeedSettingSchedulingDrain), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedSettingSchedulingDrain)(nil), (*SeedSettingSchedulingDrain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSettingSchedulingDrain_To_v1beta1_SeedSettingSchedulingDrain(a.(*core.SeedSettingSchedulingDrain), b.(*SeedSettingSchedulingDrain), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedSettingTopologyAwareRouting)(nil), (*core.SeedSettingTopologyAwareRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedSettingTopologyAwareRouting_To_core_SeedSettingTopologyAwareRouting(a.(*SeedSettingTopologyAwareRouting), b.(*core.SeedSettingTopologyAwareRouting), scope)
 	}); err != nil {
@@ -1477,6 +1617,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootAvailability)(nil), (*core.ShootAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootAvailability_To_core_ShootAvailability(a.(*ShootAvailability), b.(*core.ShootAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootAvailability)(nil), (*ShootAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootAvailability_To_v1beta1_ShootAvailability(a.(*core.ShootAvailability), b.(*ShootAvailability), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootCredentials)(nil), (*core.ShootCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootCredentials_To_core_ShootCredentials(a.(*ShootCredentials), b.(*core.ShootCredentials), scope)
 	}); err != nil {
@@ -1547,6 +1697,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootSource)(nil), (*core.ShootSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootSource_To_core_ShootSource(a.(*ShootSource), b.(*core.ShootSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootSource)(nil), (*ShootSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootSource_To_v1beta1_ShootSource(a.(*core.ShootSource), b.(*ShootSource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootSpec)(nil), (*core.ShootSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootSpec_To_core_ShootSpec(a.(*ShootSpec), b.(*core.ShootSpec), scope)
 	}); err != nil {
@@ -2602,6 +2762,9 @@ func autoConvert_v1beta1_ControllerRegistration_To_core_ControllerRegistration(i
 	if err := Convert_v1beta1_ControllerRegistrationSpec_To_core_ControllerRegistrationSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1beta1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2615,6 +2778,9 @@ func autoConvert_core_ControllerRegistration_To_v1beta1_ControllerRegistration(i
 	if err := Convert_core_ControllerRegistrationSpec_To_v1beta1_ControllerRegistrationSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_ControllerRegistrationStatus_To_v1beta1_ControllerRegistrationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2627,6 +2793,7 @@ func autoConvert_v1beta1_ControllerRegistrationDeployment_To_core_ControllerRegi
 	out.Policy = (*core.ControllerDeploymentPolicy)(unsafe.Pointer(in.Policy))
 	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
 	out.DeploymentRefs = *(*[]core.DeploymentRef)(unsafe.Pointer(&in.DeploymentRefs))
+	out.Rollout = (*core.ControllerRegistrationRollout)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -2639,6 +2806,7 @@ func autoConvert_core_ControllerRegistrationDeployment_To_v1beta1_ControllerRegi
 	out.Policy = (*ControllerDeploymentPolicy)(unsafe.Pointer(in.Policy))
 	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
 	out.DeploymentRefs = *(*[]DeploymentRef)(unsafe.Pointer(&in.DeploymentRefs))
+	out.Rollout = (*ControllerRegistrationRollout)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -2669,6 +2837,60 @@ func Convert_core_ControllerRegistrationList_To_v1beta1_ControllerRegistrationLi
 	return autoConvert_core_ControllerRegistrationList_To_v1beta1_ControllerRegistrationList(in, out, s)
 }
 
+func autoConvert_v1beta1_ControllerRegistrationRollout_To_core_ControllerRegistrationRollout(in *ControllerRegistrationRollout, out *core.ControllerRegistrationRollout, s conversion.Scope) error {
+	out.CanarySeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.CanarySeedSelector))
+	out.MaxUnavailable = (*int32)(unsafe.Pointer(in.MaxUnavailable))
+	return nil
+}
+
+// Convert_v1beta1_ControllerRegistrationRollout_To_core_ControllerRegistrationRollout is an autogenerated conversion function.
+func Convert_v1beta1_ControllerRegistrationRollout_To_core_ControllerRegistrationRollout(in *ControllerRegistrationRollout, out *core.ControllerRegistrationRollout, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControllerRegistrationRollout_To_core_ControllerRegistrationRollout(in, out, s)
+}
+
+func autoConvert_core_ControllerRegistrationRollout_To_v1beta1_ControllerRegistrationRollout(in *core.ControllerRegistrationRollout, out *ControllerRegistrationRollout, s conversion.Scope) error {
+	out.CanarySeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.CanarySeedSelector))
+	out.MaxUnavailable = (*int32)(unsafe.Pointer(in.MaxUnavailable))
+	return nil
+}
+
+// Convert_core_ControllerRegistrationRollout_To_v1beta1_ControllerRegistrationRollout is an autogenerated conversion function.
+func Convert_core_ControllerRegistrationRollout_To_v1beta1_ControllerRegistrationRollout(in *core.ControllerRegistrationRollout, out *ControllerRegistrationRollout, s conversion.Scope) error {
+	return autoConvert_core_ControllerRegistrationRollout_To_v1beta1_ControllerRegistrationRollout(in, out, s)
+}
+
+func autoConvert_v1beta1_ControllerRegistrationRolloutStatus_To_core_ControllerRegistrationRolloutStatus(in *ControllerRegistrationRolloutStatus, out *core.ControllerRegistrationRolloutStatus, s conversion.Scope) error {
+	out.DeploymentName = in.DeploymentName
+	out.Phase = core.ControllerRegistrationRolloutPhase(in.Phase)
+	out.Seeds = in.Seeds
+	out.UpdatedSeeds = in.UpdatedSeeds
+	out.AvailableSeeds = in.AvailableSeeds
+	out.FailedSeeds = *(*[]string)(unsafe.Pointer(&in.FailedSeeds))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_v1beta1_ControllerRegistrationRolloutStatus_To_core_ControllerRegistrationRolloutStatus is an autogenerated conversion function.
+func Convert_v1beta1_ControllerRegistrationRolloutStatus_To_core_ControllerRegistrationRolloutStatus(in *ControllerRegistrationRolloutStatus, out *core.ControllerRegistrationRolloutStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControllerRegistrationRolloutStatus_To_core_ControllerRegistrationRolloutStatus(in, out, s)
+}
+
+func autoConvert_core_ControllerRegistrationRolloutStatus_To_v1beta1_ControllerRegistrationRolloutStatus(in *core.ControllerRegistrationRolloutStatus, out *ControllerRegistrationRolloutStatus, s conversion.Scope) error {
+	out.DeploymentName = in.DeploymentName
+	out.Phase = ControllerRegistrationRolloutPhase(in.Phase)
+	out.Seeds = in.Seeds
+	out.UpdatedSeeds = in.UpdatedSeeds
+	out.AvailableSeeds = in.AvailableSeeds
+	out.FailedSeeds = *(*[]string)(unsafe.Pointer(&in.FailedSeeds))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_core_ControllerRegistrationRolloutStatus_To_v1beta1_ControllerRegistrationRolloutStatus is an autogenerated conversion function.
+func Convert_core_ControllerRegistrationRolloutStatus_To_v1beta1_ControllerRegistrationRolloutStatus(in *core.ControllerRegistrationRolloutStatus, out *ControllerRegistrationRolloutStatus, s conversion.Scope) error {
+	return autoConvert_core_ControllerRegistrationRolloutStatus_To_v1beta1_ControllerRegistrationRolloutStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_ControllerRegistrationSpec_To_core_ControllerRegistrationSpec(in *ControllerRegistrationSpec, out *core.ControllerRegistrationSpec, s conversion.Scope) error {
 	out.Resources = *(*[]core.ControllerResource)(unsafe.Pointer(&in.Resources))
 	out.Deployment = (*core.ControllerRegistrationDeployment)(unsafe.Pointer(in.Deployment))
@@ -2691,6 +2913,26 @@ func Convert_core_ControllerRegistrationSpec_To_v1beta1_ControllerRegistrationSp
 	return autoConvert_core_ControllerRegistrationSpec_To_v1beta1_ControllerRegistrationSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(in *ControllerRegistrationStatus, out *core.ControllerRegistrationStatus, s conversion.Scope) error {
+	out.Rollout = (*core.ControllerRegistrationRolloutStatus)(unsafe.Pointer(in.Rollout))
+	return nil
+}
+
+// Convert_v1beta1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus is an autogenerated conversion function.
+func Convert_v1beta1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(in *ControllerRegistrationStatus, out *core.ControllerRegistrationStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(in, out, s)
+}
+
+func autoConvert_core_ControllerRegistrationStatus_To_v1beta1_ControllerRegistrationStatus(in *core.ControllerRegistrationStatus, out *ControllerRegistrationStatus, s conversion.Scope) error {
+	out.Rollout = (*ControllerRegistrationRolloutStatus)(unsafe.Pointer(in.Rollout))
+	return nil
+}
+
+// Convert_core_ControllerRegistrationStatus_To_v1beta1_ControllerRegistrationStatus is an autogenerated conversion function.
+func Convert_core_ControllerRegistrationStatus_To_v1beta1_ControllerRegistrationStatus(in *core.ControllerRegistrationStatus, out *ControllerRegistrationStatus, s conversion.Scope) error {
+	return autoConvert_core_ControllerRegistrationStatus_To_v1beta1_ControllerRegistrationStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_ControllerResource_To_core_ControllerResource(in *ControllerResource, out *core.ControllerResource, s conversion.Scope) error {
 	out.Kind = in.Kind
 	out.Type = in.Type
@@ -3259,6 +3501,36 @@ func Convert_core_HighAvailability_To_v1beta1_HighAvailability(in *core.HighAvai
 	return autoConvert_core_HighAvailability_To_v1beta1_HighAvailability(in, out, s)
 }
 
+func autoConvert_v1beta1_Hook_To_core_Hook(in *Hook, out *core.Hook, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Point = core.HookPoint(in.Point)
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	out.Timeout = (*metav1.Duration)(unsafe.Pointer(in.Timeout))
+	out.FailurePolicy = (*core.HookFailurePolicy)(unsafe.Pointer(in.FailurePolicy))
+	return nil
+}
+
+// Convert_v1beta1_Hook_To_core_Hook is an autogenerated conversion function.
+func Convert_v1beta1_Hook_To_core_Hook(in *Hook, out *core.Hook, s conversion.Scope) error {
+	return autoConvert_v1beta1_Hook_To_core_Hook(in, out, s)
+}
+
+func autoConvert_core_Hook_To_v1beta1_Hook(in *core.Hook, out *Hook, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Point = HookPoint(in.Point)
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	out.Timeout = (*metav1.Duration)(unsafe.Pointer(in.Timeout))
+	out.FailurePolicy = (*HookFailurePolicy)(unsafe.Pointer(in.FailurePolicy))
+	return nil
+}
+
+// Convert_core_Hook_To_v1beta1_Hook is an autogenerated conversion function.
+func Convert_core_Hook_To_v1beta1_Hook(in *core.Hook, out *Hook, s conversion.Scope) error {
+	return autoConvert_core_Hook_To_v1beta1_Hook(in, out, s)
+}
+
 func autoConvert_v1beta1_HorizontalPodAutoscalerConfig_To_core_HorizontalPodAutoscalerConfig(in *HorizontalPodAutoscalerConfig, out *core.HorizontalPodAutoscalerConfig, s conversion.Scope) error {
 	out.CPUInitializationPeriod = (*metav1.Duration)(unsafe.Pointer(in.CPUInitializationPeriod))
 	out.DownscaleStabilization = (*metav1.Duration)(unsafe.Pointer(in.DownscaleStabilization))
@@ -4469,6 +4741,80 @@ func Convert_core_Project_To_v1beta1_Project(in *core.Project, out *Project, s c
 	return autoConvert_core_Project_To_v1beta1_Project(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectAccessReview_To_core_ProjectAccessReview(in *ProjectAccessReview, out *core.ProjectAccessReview, s conversion.Scope) error {
+	out.LastReviewTimestamp = in.LastReviewTimestamp
+	out.Members = *(*[]core.ProjectMemberAccessReview)(unsafe.Pointer(&in.Members))
+	return nil
+}
+
+// Convert_v1beta1_ProjectAccessReview_To_core_ProjectAccessReview is an autogenerated conversion function.
+func Convert_v1beta1_ProjectAccessReview_To_core_ProjectAccessReview(in *ProjectAccessReview, out *core.ProjectAccessReview, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectAccessReview_To_core_ProjectAccessReview(in, out, s)
+}
+
+func autoConvert_core_ProjectAccessReview_To_v1beta1_ProjectAccessReview(in *core.ProjectAccessReview, out *ProjectAccessReview, s conversion.Scope) error {
+	out.LastReviewTimestamp = in.LastReviewTimestamp
+	out.Members = *(*[]ProjectMemberAccessReview)(unsafe.Pointer(&in.Members))
+	return nil
+}
+
+// Convert_core_ProjectAccessReview_To_v1beta1_ProjectAccessReview is an autogenerated conversion function.
+func Convert_core_ProjectAccessReview_To_v1beta1_ProjectAccessReview(in *core.ProjectAccessReview, out *ProjectAccessReview, s conversion.Scope) error {
+	return autoConvert_core_ProjectAccessReview_To_v1beta1_ProjectAccessReview(in, out, s)
+}
+
+func autoConvert_v1beta1_ProjectAccumulatedUsage_To_core_ProjectAccumulatedUsage(in *ProjectAccumulatedUsage, out *core.ProjectAccumulatedUsage, s conversion.Scope) error {
+	out.Since = in.Since
+	out.LastUpdateTimestamp = in.LastUpdateTimestamp
+	out.Rates = *(*v1.ResourceList)(unsafe.Pointer(&in.Rates))
+	out.Hours = *(*v1.ResourceList)(unsafe.Pointer(&in.Hours))
+	out.MaximumRates = *(*v1.ResourceList)(unsafe.Pointer(&in.MaximumRates))
+	out.MaximumHours = *(*v1.ResourceList)(unsafe.Pointer(&in.MaximumHours))
+	return nil
+}
+
+// Convert_v1beta1_ProjectAccumulatedUsage_To_core_ProjectAccumulatedUsage is an autogenerated conversion function.
+func Convert_v1beta1_ProjectAccumulatedUsage_To_core_ProjectAccumulatedUsage(in *ProjectAccumulatedUsage, out *core.ProjectAccumulatedUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectAccumulatedUsage_To_core_ProjectAccumulatedUsage(in, out, s)
+}
+
+func autoConvert_core_ProjectAccumulatedUsage_To_v1beta1_ProjectAccumulatedUsage(in *core.ProjectAccumulatedUsage, out *ProjectAccumulatedUsage, s conversion.Scope) error {
+	out.Since = in.Since
+	out.LastUpdateTimestamp = in.LastUpdateTimestamp
+	out.Rates = *(*v1.ResourceList)(unsafe.Pointer(&in.Rates))
+	out.Hours = *(*v1.ResourceList)(unsafe.Pointer(&in.Hours))
+	out.MaximumRates = *(*v1.ResourceList)(unsafe.Pointer(&in.MaximumRates))
+	out.MaximumHours = *(*v1.ResourceList)(unsafe.Pointer(&in.MaximumHours))
+	return nil
+}
+
+// Convert_core_ProjectAccumulatedUsage_To_v1beta1_ProjectAccumulatedUsage is an autogenerated conversion function.
+func Convert_core_ProjectAccumulatedUsage_To_v1beta1_ProjectAccumulatedUsage(in *core.ProjectAccumulatedUsage, out *ProjectAccumulatedUsage, s conversion.Scope) error {
+	return autoConvert_core_ProjectAccumulatedUsage_To_v1beta1_ProjectAccumulatedUsage(in, out, s)
+}
+
+func autoConvert_v1beta1_ProjectBastionSettings_To_core_ProjectBastionSettings(in *ProjectBastionSettings, out *core.ProjectBastionSettings, s conversion.Scope) error {
+	out.RequireApproval = in.RequireApproval
+	out.MaxSessionDuration = (*metav1.Duration)(unsafe.Pointer(in.MaxSessionDuration))
+	return nil
+}
+
+// Convert_v1beta1_ProjectBastionSettings_To_core_ProjectBastionSettings is an autogenerated conversion function.
+func Convert_v1beta1_ProjectBastionSettings_To_core_ProjectBastionSettings(in *ProjectBastionSettings, out *core.ProjectBastionSettings, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectBastionSettings_To_core_ProjectBastionSettings(in, out, s)
+}
+
+func autoConvert_core_ProjectBastionSettings_To_v1beta1_ProjectBastionSettings(in *core.ProjectBastionSettings, out *ProjectBastionSettings, s conversion.Scope) error {
+	out.RequireApproval = in.RequireApproval
+	out.MaxSessionDuration = (*metav1.Duration)(unsafe.Pointer(in.MaxSessionDuration))
+	return nil
+}
+
+// Convert_core_ProjectBastionSettings_To_v1beta1_ProjectBastionSettings is an autogenerated conversion function.
+func Convert_core_ProjectBastionSettings_To_v1beta1_ProjectBastionSettings(in *core.ProjectBastionSettings, out *ProjectBastionSettings, s conversion.Scope) error {
+	return autoConvert_core_ProjectBastionSettings_To_v1beta1_ProjectBastionSettings(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectList_To_core_ProjectList(in *ProjectList, out *core.ProjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -4511,19 +4857,141 @@ func Convert_core_ProjectList_To_v1beta1_ProjectList(in *core.ProjectList, out *
 	return autoConvert_core_ProjectList_To_v1beta1_ProjectList(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectMachineImageRestriction_To_core_ProjectMachineImageRestriction(in *ProjectMachineImageRestriction, out *core.ProjectMachineImageRestriction, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Versions = *(*[]string)(unsafe.Pointer(&in.Versions))
+	return nil
+}
+
+// Convert_v1beta1_ProjectMachineImageRestriction_To_core_ProjectMachineImageRestriction is an autogenerated conversion function.
+func Convert_v1beta1_ProjectMachineImageRestriction_To_core_ProjectMachineImageRestriction(in *ProjectMachineImageRestriction, out *core.ProjectMachineImageRestriction, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectMachineImageRestriction_To_core_ProjectMachineImageRestriction(in, out, s)
+}
+
+func autoConvert_core_ProjectMachineImageRestriction_To_v1beta1_ProjectMachineImageRestriction(in *core.ProjectMachineImageRestriction, out *ProjectMachineImageRestriction, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Versions = *(*[]string)(unsafe.Pointer(&in.Versions))
+	return nil
+}
+
+// Convert_core_ProjectMachineImageRestriction_To_v1beta1_ProjectMachineImageRestriction is an autogenerated conversion function.
+func Convert_core_ProjectMachineImageRestriction_To_v1beta1_ProjectMachineImageRestriction(in *core.ProjectMachineImageRestriction, out *ProjectMachineImageRestriction, s conversion.Scope) error {
+	return autoConvert_core_ProjectMachineImageRestriction_To_v1beta1_ProjectMachineImageRestriction(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectMember_To_core_ProjectMember(in *ProjectMember, out *core.ProjectMember, s conversion.Scope) error {
 	out.Subject = in.Subject
 	// WARNING: in.Role requires manual conversion: does not exist in peer-type
 	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	out.RoleExpirations = *(*[]core.ProjectMemberRoleExpiration)(unsafe.Pointer(&in.RoleExpirations))
 	return nil
 }
 
 func autoConvert_core_ProjectMember_To_v1beta1_ProjectMember(in *core.ProjectMember, out *ProjectMember, s conversion.Scope) error {
 	out.Subject = in.Subject
 	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	out.RoleExpirations = *(*[]ProjectMemberRoleExpiration)(unsafe.Pointer(&in.RoleExpirations))
+	return nil
+}
+
+func autoConvert_v1beta1_ProjectMemberAccessReview_To_core_ProjectMemberAccessReview(in *ProjectMemberAccessReview, out *core.ProjectMemberAccessReview, s conversion.Scope) error {
+	out.Subject = in.Subject
+	out.FirstReviewTimestamp = in.FirstReviewTimestamp
+	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.FlaggedForRemoval = in.FlaggedForRemoval
+	return nil
+}
+
+// Convert_v1beta1_ProjectMemberAccessReview_To_core_ProjectMemberAccessReview is an autogenerated conversion function.
+func Convert_v1beta1_ProjectMemberAccessReview_To_core_ProjectMemberAccessReview(in *ProjectMemberAccessReview, out *core.ProjectMemberAccessReview, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectMemberAccessReview_To_core_ProjectMemberAccessReview(in, out, s)
+}
+
+func autoConvert_core_ProjectMemberAccessReview_To_v1beta1_ProjectMemberAccessReview(in *core.ProjectMemberAccessReview, out *ProjectMemberAccessReview, s conversion.Scope) error {
+	out.Subject = in.Subject
+	out.FirstReviewTimestamp = in.FirstReviewTimestamp
+	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.FlaggedForRemoval = in.FlaggedForRemoval
+	return nil
+}
+
+// Convert_core_ProjectMemberAccessReview_To_v1beta1_ProjectMemberAccessReview is an autogenerated conversion function.
+func Convert_core_ProjectMemberAccessReview_To_v1beta1_ProjectMemberAccessReview(in *core.ProjectMemberAccessReview, out *ProjectMemberAccessReview, s conversion.Scope) error {
+	return autoConvert_core_ProjectMemberAccessReview_To_v1beta1_ProjectMemberAccessReview(in, out, s)
+}
+
+func autoConvert_v1beta1_ProjectMemberRoleExpiration_To_core_ProjectMemberRoleExpiration(in *ProjectMemberRoleExpiration, out *core.ProjectMemberRoleExpiration, s conversion.Scope) error {
+	out.Role = in.Role
+	out.ExpirationDate = in.ExpirationDate
 	return nil
 }
 
+// Convert_v1beta1_ProjectMemberRoleExpiration_To_core_ProjectMemberRoleExpiration is an autogenerated conversion function.
+func Convert_v1beta1_ProjectMemberRoleExpiration_To_core_ProjectMemberRoleExpiration(in *ProjectMemberRoleExpiration, out *core.ProjectMemberRoleExpiration, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectMemberRoleExpiration_To_core_ProjectMemberRoleExpiration(in, out, s)
+}
+
+func autoConvert_core_ProjectMemberRoleExpiration_To_v1beta1_ProjectMemberRoleExpiration(in *core.ProjectMemberRoleExpiration, out *ProjectMemberRoleExpiration, s conversion.Scope) error {
+	out.Role = in.Role
+	out.ExpirationDate = in.ExpirationDate
+	return nil
+}
+
+// Convert_core_ProjectMemberRoleExpiration_To_v1beta1_ProjectMemberRoleExpiration is an autogenerated conversion function.
+func Convert_core_ProjectMemberRoleExpiration_To_v1beta1_ProjectMemberRoleExpiration(in *core.ProjectMemberRoleExpiration, out *ProjectMemberRoleExpiration, s conversion.Scope) error {
+	return autoConvert_core_ProjectMemberRoleExpiration_To_v1beta1_ProjectMemberRoleExpiration(in, out, s)
+}
+
+func autoConvert_v1beta1_ProjectQuota_To_core_ProjectQuota(in *ProjectQuota, out *core.ProjectQuota, s conversion.Scope) error {
+	out.Limits = *(*v1.ResourceList)(unsafe.Pointer(&in.Limits))
+	return nil
+}
+
+// Convert_v1beta1_ProjectQuota_To_core_ProjectQuota is an autogenerated conversion function.
+func Convert_v1beta1_ProjectQuota_To_core_ProjectQuota(in *ProjectQuota, out *core.ProjectQuota, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectQuota_To_core_ProjectQuota(in, out, s)
+}
+
+func autoConvert_core_ProjectQuota_To_v1beta1_ProjectQuota(in *core.ProjectQuota, out *ProjectQuota, s conversion.Scope) error {
+	out.Limits = *(*v1.ResourceList)(unsafe.Pointer(&in.Limits))
+	return nil
+}
+
+// Convert_core_ProjectQuota_To_v1beta1_ProjectQuota is an autogenerated conversion function.
+func Convert_core_ProjectQuota_To_v1beta1_ProjectQuota(in *core.ProjectQuota, out *ProjectQuota, s conversion.Scope) error {
+	return autoConvert_core_ProjectQuota_To_v1beta1_ProjectQuota(in, out, s)
+}
+
+func autoConvert_v1beta1_ProjectRestrictions_To_core_ProjectRestrictions(in *ProjectRestrictions, out *core.ProjectRestrictions, s conversion.Scope) error {
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	out.MachineTypes = *(*[]string)(unsafe.Pointer(&in.MachineTypes))
+	out.Architectures = *(*[]string)(unsafe.Pointer(&in.Architectures))
+	out.MachineImages = *(*[]core.ProjectMachineImageRestriction)(unsafe.Pointer(&in.MachineImages))
+	out.VolumeTypes = *(*[]string)(unsafe.Pointer(&in.VolumeTypes))
+	return nil
+}
+
+// Convert_v1beta1_ProjectRestrictions_To_core_ProjectRestrictions is an autogenerated conversion function.
+func Convert_v1beta1_ProjectRestrictions_To_core_ProjectRestrictions(in *ProjectRestrictions, out *core.ProjectRestrictions, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectRestrictions_To_core_ProjectRestrictions(in, out, s)
+}
+
+func autoConvert_core_ProjectRestrictions_To_v1beta1_ProjectRestrictions(in *core.ProjectRestrictions, out *ProjectRestrictions, s conversion.Scope) error {
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	out.MachineTypes = *(*[]string)(unsafe.Pointer(&in.MachineTypes))
+	out.Architectures = *(*[]string)(unsafe.Pointer(&in.Architectures))
+	out.MachineImages = *(*[]ProjectMachineImageRestriction)(unsafe.Pointer(&in.MachineImages))
+	out.VolumeTypes = *(*[]string)(unsafe.Pointer(&in.VolumeTypes))
+	return nil
+}
+
+// Convert_core_ProjectRestrictions_To_v1beta1_ProjectRestrictions is an autogenerated conversion function.
+func Convert_core_ProjectRestrictions_To_v1beta1_ProjectRestrictions(in *core.ProjectRestrictions, out *ProjectRestrictions, s conversion.Scope) error {
+	return autoConvert_core_ProjectRestrictions_To_v1beta1_ProjectRestrictions(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *core.ProjectSpec, s conversion.Scope) error {
 	out.CreatedBy = (*rbacv1.Subject)(unsafe.Pointer(in.CreatedBy))
 	out.Description = (*string)(unsafe.Pointer(in.Description))
@@ -4542,6 +5010,9 @@ func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *c
 	}
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*core.ProjectTolerations)(unsafe.Pointer(in.Tolerations))
+	out.Quota = (*core.ProjectQuota)(unsafe.Pointer(in.Quota))
+	out.Restrictions = (*core.ProjectRestrictions)(unsafe.Pointer(in.Restrictions))
+	out.Bastion = (*core.ProjectBastionSettings)(unsafe.Pointer(in.Bastion))
 	return nil
 }
 
@@ -4563,6 +5034,9 @@ func autoConvert_core_ProjectSpec_To_v1beta1_ProjectSpec(in *core.ProjectSpec, o
 	}
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*ProjectTolerations)(unsafe.Pointer(in.Tolerations))
+	out.Quota = (*ProjectQuota)(unsafe.Pointer(in.Quota))
+	out.Restrictions = (*ProjectRestrictions)(unsafe.Pointer(in.Restrictions))
+	out.Bastion = (*ProjectBastionSettings)(unsafe.Pointer(in.Bastion))
 	return nil
 }
 
@@ -4572,6 +5046,9 @@ func autoConvert_v1beta1_ProjectStatus_To_core_ProjectStatus(in *ProjectStatus,
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.Usage = *(*v1.ResourceList)(unsafe.Pointer(&in.Usage))
+	out.AccessReview = (*core.ProjectAccessReview)(unsafe.Pointer(in.AccessReview))
+	out.AccumulatedUsage = (*core.ProjectAccumulatedUsage)(unsafe.Pointer(in.AccumulatedUsage))
 	return nil
 }
 
@@ -4586,6 +5063,9 @@ func autoConvert_core_ProjectStatus_To_v1beta1_ProjectStatus(in *core.ProjectSta
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.Usage = *(*v1.ResourceList)(unsafe.Pointer(&in.Usage))
+	out.AccessReview = (*ProjectAccessReview)(unsafe.Pointer(in.AccessReview))
+	out.AccumulatedUsage = (*ProjectAccumulatedUsage)(unsafe.Pointer(in.AccumulatedUsage))
 	return nil
 }
 
@@ -4994,6 +5474,30 @@ func Convert_core_SeedDNSProvider_To_v1beta1_SeedDNSProvider(in *core.SeedDNSPro
 	return autoConvert_core_SeedDNSProvider_To_v1beta1_SeedDNSProvider(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(in *SeedDrainStatus, out *core.SeedDrainStatus, s conversion.Scope) error {
+	out.StartTimestamp = in.StartTimestamp
+	out.RemainingShoots = in.RemainingShoots
+	out.MigratingShoots = *(*[]string)(unsafe.Pointer(&in.MigratingShoots))
+	return nil
+}
+
+// Convert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus is an autogenerated conversion function.
+func Convert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(in *SeedDrainStatus, out *core.SeedDrainStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(in, out, s)
+}
+
+func autoConvert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(in *core.SeedDrainStatus, out *SeedDrainStatus, s conversion.Scope) error {
+	out.StartTimestamp = in.StartTimestamp
+	out.RemainingShoots = in.RemainingShoots
+	out.MigratingShoots = *(*[]string)(unsafe.Pointer(&in.MigratingShoots))
+	return nil
+}
+
+// Convert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus is an autogenerated conversion function.
+func Convert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(in *core.SeedDrainStatus, out *SeedDrainStatus, s conversion.Scope) error {
+	return autoConvert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedList_To_core_SeedList(in *SeedList, out *core.SeedList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -5272,6 +5776,7 @@ func Convert_core_SeedSettingLoadBalancerServicesZones_To_v1beta1_SeedSettingLoa
 
 func autoConvert_v1beta1_SeedSettingScheduling_To_core_SeedSettingScheduling(in *SeedSettingScheduling, out *core.SeedSettingScheduling, s conversion.Scope) error {
 	out.Visible = in.Visible
+	out.Drain = (*core.SeedSettingSchedulingDrain)(unsafe.Pointer(in.Drain))
 	return nil
 }
 
@@ -5282,6 +5787,7 @@ func Convert_v1beta1_SeedSettingScheduling_To_core_SeedSettingScheduling(in *See
 
 func autoConvert_core_SeedSettingScheduling_To_v1beta1_SeedSettingScheduling(in *core.SeedSettingScheduling, out *SeedSettingScheduling, s conversion.Scope) error {
 	out.Visible = in.Visible
+	out.Drain = (*SeedSettingSchedulingDrain)(unsafe.Pointer(in.Drain))
 	return nil
 }
 
@@ -5290,6 +5796,26 @@ func Convert_core_SeedSettingScheduling_To_v1beta1_SeedSettingScheduling(in *cor
 	return autoConvert_core_SeedSettingScheduling_To_v1beta1_SeedSettingScheduling(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedSettingSchedulingDrain_To_core_SeedSettingSchedulingDrain(in *SeedSettingSchedulingDrain, out *core.SeedSettingSchedulingDrain, s conversion.Scope) error {
+	out.MaxParallelMigrations = (*int32)(unsafe.Pointer(in.MaxParallelMigrations))
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingSchedulingDrain_To_core_SeedSettingSchedulingDrain is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingSchedulingDrain_To_core_SeedSettingSchedulingDrain(in *SeedSettingSchedulingDrain, out *core.SeedSettingSchedulingDrain, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingSchedulingDrain_To_core_SeedSettingSchedulingDrain(in, out, s)
+}
+
+func autoConvert_core_SeedSettingSchedulingDrain_To_v1beta1_SeedSettingSchedulingDrain(in *core.SeedSettingSchedulingDrain, out *SeedSettingSchedulingDrain, s conversion.Scope) error {
+	out.MaxParallelMigrations = (*int32)(unsafe.Pointer(in.MaxParallelMigrations))
+	return nil
+}
+
+// Convert_core_SeedSettingSchedulingDrain_To_v1beta1_SeedSettingSchedulingDrain is an autogenerated conversion function.
+func Convert_core_SeedSettingSchedulingDrain_To_v1beta1_SeedSettingSchedulingDrain(in *core.SeedSettingSchedulingDrain, out *SeedSettingSchedulingDrain, s conversion.Scope) error {
+	return autoConvert_core_SeedSettingSchedulingDrain_To_v1beta1_SeedSettingSchedulingDrain(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSettingTopologyAwareRouting_To_core_SeedSettingTopologyAwareRouting(in *SeedSettingTopologyAwareRouting, out *core.SeedSettingTopologyAwareRouting, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -5416,6 +5942,7 @@ func autoConvert_v1beta1_SeedStatus_To_core_SeedStatus(in *SeedStatus, out *core
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.Drain = (*core.SeedDrainStatus)(unsafe.Pointer(in.Drain))
 	return nil
 }
 
@@ -5434,6 +5961,7 @@ func autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.Drain = (*SeedDrainStatus)(unsafe.Pointer(in.Drain))
 	return nil
 }
 
@@ -5642,6 +6170,28 @@ func Convert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in *c
 	return autoConvert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootAvailability_To_core_ShootAvailability(in *ShootAvailability, out *core.ShootAvailability, s conversion.Scope) error {
+	out.Last24Hours = (*string)(unsafe.Pointer(in.Last24Hours))
+	out.Last7Days = (*string)(unsafe.Pointer(in.Last7Days))
+	return nil
+}
+
+// Convert_v1beta1_ShootAvailability_To_core_ShootAvailability is an autogenerated conversion function.
+func Convert_v1beta1_ShootAvailability_To_core_ShootAvailability(in *ShootAvailability, out *core.ShootAvailability, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootAvailability_To_core_ShootAvailability(in, out, s)
+}
+
+func autoConvert_core_ShootAvailability_To_v1beta1_ShootAvailability(in *core.ShootAvailability, out *ShootAvailability, s conversion.Scope) error {
+	out.Last24Hours = (*string)(unsafe.Pointer(in.Last24Hours))
+	out.Last7Days = (*string)(unsafe.Pointer(in.Last7Days))
+	return nil
+}
+
+// Convert_core_ShootAvailability_To_v1beta1_ShootAvailability is an autogenerated conversion function.
+func Convert_core_ShootAvailability_To_v1beta1_ShootAvailability(in *core.ShootAvailability, out *ShootAvailability, s conversion.Scope) error {
+	return autoConvert_core_ShootAvailability_To_v1beta1_ShootAvailability(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredentials, out *core.ShootCredentials, s conversion.Scope) error {
 	out.Rotation = (*core.ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
 	return nil
@@ -5828,6 +6378,28 @@ func Convert_core_ShootSSHKeypairRotation_To_v1beta1_ShootSSHKeypairRotation(in
 	return autoConvert_core_ShootSSHKeypairRotation_To_v1beta1_ShootSSHKeypairRotation(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootSource_To_core_ShootSource(in *ShootSource, out *core.ShootSource, s conversion.Scope) error {
+	out.BackupEntryName = in.BackupEntryName
+	out.MaxBackupAge = (*int32)(unsafe.Pointer(in.MaxBackupAge))
+	return nil
+}
+
+// Convert_v1beta1_ShootSource_To_core_ShootSource is an autogenerated conversion function.
+func Convert_v1beta1_ShootSource_To_core_ShootSource(in *ShootSource, out *core.ShootSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootSource_To_core_ShootSource(in, out, s)
+}
+
+func autoConvert_core_ShootSource_To_v1beta1_ShootSource(in *core.ShootSource, out *ShootSource, s conversion.Scope) error {
+	out.BackupEntryName = in.BackupEntryName
+	out.MaxBackupAge = (*int32)(unsafe.Pointer(in.MaxBackupAge))
+	return nil
+}
+
+// Convert_core_ShootSource_To_v1beta1_ShootSource is an autogenerated conversion function.
+func Convert_core_ShootSource_To_v1beta1_ShootSource(in *core.ShootSource, out *ShootSource, s conversion.Scope) error {
+	return autoConvert_core_ShootSource_To_v1beta1_ShootSource(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.ShootSpec, s conversion.Scope) error {
 	out.Addons = (*core.Addons)(unsafe.Pointer(in.Addons))
 	out.CloudProfileName = in.CloudProfileName
@@ -5854,6 +6426,8 @@ func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.Sh
 	out.SystemComponents = (*core.SystemComponents)(unsafe.Pointer(in.SystemComponents))
 	out.ControlPlane = (*core.ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
+	out.Hooks = *(*[]core.Hook)(unsafe.Pointer(&in.Hooks))
+	out.Source = (*core.ShootSource)(unsafe.Pointer(in.Source))
 	return nil
 }
 
@@ -5888,6 +6462,8 @@ func autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *Sh
 	out.SystemComponents = (*SystemComponents)(unsafe.Pointer(in.SystemComponents))
 	out.ControlPlane = (*ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
+	out.Hooks = *(*[]Hook)(unsafe.Pointer(&in.Hooks))
+	out.Source = (*ShootSource)(unsafe.Pointer(in.Source))
 	return nil
 }
 
@@ -5989,6 +6565,7 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.LastHibernationTriggerTime = (*metav1.Time)(unsafe.Pointer(in.LastHibernationTriggerTime))
 	out.LastMaintenance = (*core.LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
+	out.Availability = (*core.ShootAvailability)(unsafe.Pointer(in.Availability))
 	return nil
 }
 
@@ -6018,6 +6595,7 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.Credentials = (*ShootCredentials)(unsafe.Pointer(in.Credentials))
 	out.LastMaintenance = (*LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
+	out.Availability = (*ShootAvailability)(unsafe.Pointer(in.Availability))
 	return nil
 }
 
@@ -80,6 +80,9 @@ func SetObjectDefaults_ControllerRegistration(in *ControllerRegistration) {
 	}
 	if in.Spec.Deployment != nil {
 		SetDefaults_ControllerRegistrationDeployment(in.Spec.Deployment)
+		if in.Spec.Deployment.Rollout != nil {
+			SetDefaults_ControllerRegistrationRollout(in.Spec.Deployment.Rollout)
+		}
 	}
 }
 
@@ -121,6 +124,11 @@ func SetObjectDefaults_Seed(in *Seed) {
 	SetDefaults_SeedNetworks(&in.Spec.Networks)
 	if in.Spec.Settings != nil {
 		SetDefaults_SeedSettings(in.Spec.Settings)
+		if in.Spec.Settings.Scheduling != nil {
+			if in.Spec.Settings.Scheduling.Drain != nil {
+				SetDefaults_SeedSettingSchedulingDrain(in.Spec.Settings.Scheduling.Drain)
+			}
+		}
 		if in.Spec.Settings.DependencyWatchdog != nil {
 			SetDefaults_SeedSettingDependencyWatchdog(in.Spec.Settings.DependencyWatchdog)
 		}
@@ -153,13 +161,14 @@ func SetObjectDefaults_Shoot(in *Shoot) {
 	if in.Spec.Networking != nil {
 		SetDefaults_Networking(in.Spec.Networking)
 	}
-	if in.Spec.Maintenance != nil {
-		SetDefaults_Maintenance(in.Spec.Maintenance)
-	}
 	for i := range in.Spec.Provider.Workers {
 		a := &in.Spec.Provider.Workers[i]
 		SetDefaults_Worker(a)
 	}
+	for i := range in.Spec.Hooks {
+		a := &in.Spec.Hooks[i]
+		SetDefaults_Hook(a)
+	}
 }
 
 func SetObjectDefaults_ShootList(in *ShootList) {
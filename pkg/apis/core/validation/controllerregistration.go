@@ -150,6 +150,18 @@ func ValidateControllerRegistrationSpec(spec *core.ControllerRegistrationSpec, f
 				allErrs = append(allErrs, field.Required(fld.Child("name"), "must not be empty"))
 			}
 		}
+
+		if rollout := deployment.Rollout; rollout != nil {
+			rolloutPath := deploymentPath.Child("rollout")
+
+			if rollout.CanarySeedSelector != nil {
+				allErrs = append(allErrs, metav1validation.ValidateLabelSelector(rollout.CanarySeedSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, rolloutPath.Child("canarySeedSelector"))...)
+			}
+
+			if rollout.MaxUnavailable != nil && *rollout.MaxUnavailable < 1 {
+				allErrs = append(allErrs, field.Invalid(rolloutPath.Child("maxUnavailable"), *rollout.MaxUnavailable, "must be at least 1"))
+			}
+		}
 	}
 
 	return allErrs
@@ -189,3 +201,10 @@ func ValidateControllerRegistrationSpecUpdate(new, old *core.ControllerRegistrat
 
 	return allErrs
 }
+
+// ValidateControllerRegistrationStatusUpdate validates the status field of a ControllerRegistration object.
+func ValidateControllerRegistrationStatusUpdate(_, _ core.ControllerRegistrationStatus) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	return allErrs
+}
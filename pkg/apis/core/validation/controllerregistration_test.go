@@ -362,6 +362,35 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.deployment.deploymentRefs[0].name"),
 			}))))
 		})
+
+		It("should allow a valid rollout configuration", func() {
+			controllerRegistration.Spec.Deployment.Rollout = &core.ControllerRegistrationRollout{
+				CanarySeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+				MaxUnavailable:     pointer.Int32(2),
+			}
+
+			Expect(ValidateControllerRegistration(controllerRegistration)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid rollout configuration", func() {
+			controllerRegistration.Spec.Deployment.Rollout = &core.ControllerRegistrationRollout{
+				CanarySeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "no/slash/allowed"}},
+				MaxUnavailable:     pointer.Int32(0),
+			}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.deployment.rollout.canarySeedSelector.matchLabels"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.deployment.rollout.maxUnavailable"),
+				})),
+			))
+		})
 	})
 
 	Describe("#ValidateControllerRegistrationUpdate", func() {
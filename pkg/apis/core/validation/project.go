@@ -16,6 +16,7 @@ package validation
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -26,7 +27,9 @@ import (
 	"k8s.io/apiserver/pkg/authentication/serviceaccount"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/utils"
+	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
 
 // ValidateProject validates a Project object.
@@ -122,6 +125,88 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, fldPath *field.Path) fie
 		allErrs = append(allErrs, ValidateTolerationsAgainstAllowlist(projectSpec.Tolerations.Defaults, projectSpec.Tolerations.Whitelist, fldPath.Child("tolerations", "defaults"))...)
 	}
 
+	if projectSpec.Quota != nil {
+		allErrs = append(allErrs, validateProjectQuota(projectSpec.Quota, fldPath.Child("quota"))...)
+	}
+
+	if projectSpec.Restrictions != nil {
+		allErrs = append(allErrs, validateProjectRestrictions(projectSpec.Restrictions, fldPath.Child("restrictions"))...)
+	}
+
+	if projectSpec.Bastion != nil && projectSpec.Bastion.MaxSessionDuration != nil && projectSpec.Bastion.MaxSessionDuration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("bastion", "maxSessionDuration"), projectSpec.Bastion.MaxSessionDuration.Duration.String(), "must be a positive duration"))
+	}
+
+	return allErrs
+}
+
+var supportedProjectQuotaMetrics = sets.New(
+	string(core.ProjectQuotaMetricShoots),
+	string(core.QuotaMetricCPU),
+	string(core.QuotaMetricGPU),
+	string(core.QuotaMetricMemory),
+	string(core.QuotaMetricLoadbalancer),
+)
+
+func validateProjectQuota(quota *core.ProjectQuota, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	limitsPath := fldPath.Child("limits")
+	for k, v := range quota.Limits {
+		keyPath := limitsPath.Key(string(k))
+		if !supportedProjectQuotaMetrics.Has(string(k)) {
+			allErrs = append(allErrs, field.NotSupported(keyPath, k, sets.List(supportedProjectQuotaMetrics)))
+		}
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(k.String(), v, keyPath)...)
+	}
+
+	return allErrs
+}
+
+func validateProjectRestrictions(restrictions *core.ProjectRestrictions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateRestrictionNames(restrictions.Regions, fldPath.Child("regions"))...)
+	allErrs = append(allErrs, validateRestrictionNames(restrictions.MachineTypes, fldPath.Child("machineTypes"))...)
+	allErrs = append(allErrs, validateRestrictionNames(restrictions.Architectures, fldPath.Child("architectures"))...)
+	allErrs = append(allErrs, validateRestrictionNames(restrictions.VolumeTypes, fldPath.Child("volumeTypes"))...)
+
+	for i, arch := range restrictions.Architectures {
+		if !slices.Contains(v1beta1constants.ValidArchitectures, arch) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("architectures").Index(i), arch, v1beta1constants.ValidArchitectures))
+		}
+	}
+
+	imageNames := sets.New[string]()
+	for i, image := range restrictions.MachineImages {
+		idxPath := fldPath.Child("machineImages").Index(i)
+
+		if len(image.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if imageNames.Has(image.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), image.Name))
+		}
+		imageNames.Insert(image.Name)
+
+		allErrs = append(allErrs, validateRestrictionNames(image.Versions, idxPath.Child("versions"))...)
+	}
+
+	return allErrs
+}
+
+func validateRestrictionNames(names []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := sets.New[string]()
+	for i, name := range names {
+		if len(name) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), name, "must not be empty"))
+		} else if seen.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), name))
+		}
+		seen.Insert(name)
+	}
+
 	return allErrs
 }
 
@@ -202,6 +287,28 @@ func ValidateProjectMember(member core.ProjectMember, fldPath *field.Path) field
 		}
 	}
 
+	_, isOwner := foundRoles[core.ProjectMemberOwner]
+	if member.ExpirationDate != nil && isOwner {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("expirationDate"), "the member having the owner role must not expire"))
+	}
+
+	expiringRoles := make(map[string]struct{}, len(member.RoleExpirations))
+	for i, roleExpiration := range member.RoleExpirations {
+		rolePath := fldPath.Child("roleExpirations").Index(i).Child("role")
+
+		if _, ok := expiringRoles[roleExpiration.Role]; ok {
+			allErrs = append(allErrs, field.Duplicate(rolePath, roleExpiration.Role))
+		}
+		expiringRoles[roleExpiration.Role] = struct{}{}
+
+		if _, ok := foundRoles[roleExpiration.Role]; !ok {
+			allErrs = append(allErrs, field.Invalid(rolePath, roleExpiration.Role, "role must be one of the roles of the member"))
+		}
+		if roleExpiration.Role == core.ProjectMemberOwner {
+			allErrs = append(allErrs, field.Forbidden(rolePath, "the owner role must not expire"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -258,6 +365,10 @@ func ValidateProjectStatusUpdate(newProject, oldProject *core.Project) field.Err
 		allErrs = append(allErrs, field.Invalid(field.NewPath("status").Child("phase"), newProject.Status.Phase, "phase cannot be updated to an empty string"))
 	}
 
+	for k, v := range newProject.Status.Usage {
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(k.String(), v, field.NewPath("status", "usage").Key(string(k)))...)
+	}
+
 	return allErrs
 }
 
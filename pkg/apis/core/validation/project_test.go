@@ -16,12 +16,15 @@ package validation_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
@@ -402,6 +405,150 @@ var _ = Describe("Project Validation Tests", func() {
 			))
 		})
 
+		It("should allow valid quota limits", func() {
+			project.Spec.Quota = &core.ProjectQuota{
+				Limits: corev1.ResourceList{
+					"shoots":       resource.MustParse("10"),
+					"cpu":          resource.MustParse("200"),
+					"gpu":          resource.MustParse("0"),
+					"memory":       resource.MustParse("4000Gi"),
+					"loadbalancer": resource.MustParse("20"),
+				},
+			}
+
+			Expect(ValidateProject(project)).To(BeEmpty())
+		})
+
+		It("should forbid unsupported or negative quota limits", func() {
+			project.Spec.Quota = &core.ProjectQuota{
+				Limits: corev1.ResourceList{
+					"shoots":           resource.MustParse("-1"),
+					"storage.standard": resource.MustParse("100Gi"),
+				},
+			}
+
+			Expect(ValidateProject(project)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.quota.limits[shoots]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.quota.limits[storage.standard]"),
+				})),
+			))
+		})
+
+		It("should allow valid restrictions", func() {
+			project.Spec.Restrictions = &core.ProjectRestrictions{
+				Regions:       []string{"europe-central-1"},
+				MachineTypes:  []string{"m5.large", "m5.xlarge"},
+				Architectures: []string{"amd64"},
+				MachineImages: []core.ProjectMachineImageRestriction{
+					{Name: "gardenlinux", Versions: []string{"1.2.3"}},
+					{Name: "suse-chost"},
+				},
+				VolumeTypes: []string{"gp3"},
+			}
+
+			Expect(ValidateProject(project)).To(BeEmpty())
+		})
+
+		It("should forbid invalid restrictions", func() {
+			project.Spec.Restrictions = &core.ProjectRestrictions{
+				Regions:       []string{""},
+				MachineTypes:  []string{"m5.large", "m5.large"},
+				Architectures: []string{"foo"},
+				MachineImages: []core.ProjectMachineImageRestriction{
+					{Name: "gardenlinux", Versions: []string{"1.2.3", "1.2.3"}},
+					{Name: "gardenlinux"},
+					{},
+				},
+			}
+
+			Expect(ValidateProject(project)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.restrictions.regions[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.restrictions.machineTypes[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.restrictions.architectures[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.restrictions.machineImages[0].versions[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.restrictions.machineImages[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.restrictions.machineImages[2].name"),
+				})),
+			))
+		})
+
+		It("should allow expiring members and roles", func() {
+			project.Spec.Members[1].ExpirationDate = &metav1.Time{Time: time.Now()}
+			project.Spec.Members[1].RoleExpirations = []core.ProjectMemberRoleExpiration{
+				{Role: core.ProjectMemberUserAccessManager, ExpirationDate: metav1.Time{Time: time.Now()}},
+			}
+
+			Expect(ValidateProject(project)).To(BeEmpty())
+		})
+
+		It("should forbid invalid member and role expirations", func() {
+			project.Spec.Members[0].Roles = append(project.Spec.Members[0].Roles, core.ProjectMemberOwner)
+			project.Spec.Members[0].ExpirationDate = &metav1.Time{Time: time.Now()}
+			project.Spec.Members[0].RoleExpirations = []core.ProjectMemberRoleExpiration{
+				{Role: core.ProjectMemberOwner},
+			}
+			project.Spec.Members[1].RoleExpirations = []core.ProjectMemberRoleExpiration{
+				{Role: core.ProjectMemberViewer},
+				{Role: core.ProjectMemberViewer},
+				{Role: core.ProjectMemberAdmin},
+			}
+
+			Expect(ValidateProject(project)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.members[0].expirationDate"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.members[0].roleExpirations[0].role"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.members[1].roleExpirations[1].role"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.members[1].roleExpirations[2].role"),
+				})),
+			))
+		})
+
+		It("should forbid non-positive bastion session durations", func() {
+			project.Spec.Bastion = &core.ProjectBastionSettings{
+				RequireApproval:    true,
+				MaxSessionDuration: &metav1.Duration{Duration: -time.Minute},
+			}
+
+			Expect(ValidateProject(project)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.bastion.maxSessionDuration"),
+				})),
+			))
+		})
+
 		DescribeTable("namespace immutability",
 			func(old, new *string, matcher gomegatypes.GomegaMatcher) {
 				project.Spec.Namespace = old
@@ -458,6 +605,34 @@ var _ = Describe("Project Validation Tests", func() {
 			}))))
 		})
 	})
+
+	Describe("#ValidateProjectStatusUpdate", func() {
+		var project *core.Project
+
+		BeforeEach(func() {
+			project = &core.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "project-1"},
+				Status:     core.ProjectStatus{Phase: core.ProjectReady},
+			}
+		})
+
+		It("should allow valid usage", func() {
+			newProject := prepareProjectForUpdate(project)
+			newProject.Status.Usage = corev1.ResourceList{"shoots": resource.MustParse("2")}
+
+			Expect(ValidateProjectStatusUpdate(newProject, project)).To(BeEmpty())
+		})
+
+		It("should forbid negative usage", func() {
+			newProject := prepareProjectForUpdate(project)
+			newProject.Status.Usage = corev1.ResourceList{"shoots": resource.MustParse("-2")}
+
+			Expect(ValidateProjectStatusUpdate(newProject, project)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("status.usage[shoots]"),
+			}))))
+		})
+	})
 })
 
 func prepareProjectForUpdate(project *core.Project) *core.Project {
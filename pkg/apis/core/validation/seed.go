@@ -207,6 +207,16 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 		if helper.SeedSettingTopologyAwareRoutingEnabled(seedSpec.Settings) && len(seedSpec.Provider.Zones) <= 1 {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("settings", "topologyAwareRouting", "enabled"), "topology-aware routing can only be enabled on multi-zone Seed clusters (with at least two zones in spec.provider.zones)"))
 		}
+		if seedSpec.Settings.Scheduling != nil && seedSpec.Settings.Scheduling.Drain != nil {
+			drainPath := fldPath.Child("settings", "scheduling", "drain")
+
+			if seedSpec.Backup == nil {
+				allErrs = append(allErrs, field.Forbidden(drainPath, "seeds can only be drained if backup is configured (shoots are migrated to other seeds)"))
+			}
+			if maxParallelMigrations := seedSpec.Settings.Scheduling.Drain.MaxParallelMigrations; maxParallelMigrations != nil && *maxParallelMigrations < 1 {
+				allErrs = append(allErrs, field.Invalid(drainPath.Child("maxParallelMigrations"), *maxParallelMigrations, "must be at least 1"))
+			}
+		}
 	}
 
 	if !inTemplate && seedSpec.Ingress == nil {
@@ -1048,6 +1048,40 @@ var _ = Describe("Seed Validation Tests", func() {
 
 				Expect(errorList).To(BeEmpty())
 			})
+
+			It("should allow draining a Seed with backup", func() {
+				seed.Spec.Settings = &core.SeedSettings{
+					Scheduling: &core.SeedSettingScheduling{
+						Drain: &core.SeedSettingSchedulingDrain{MaxParallelMigrations: pointer.Int32(2)},
+					},
+				}
+
+				errorList := ValidateSeed(seed)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should prevent draining a Seed without backup or with invalid parallelism", func() {
+				seed.Spec.Backup = nil
+				seed.Spec.Settings = &core.SeedSettings{
+					Scheduling: &core.SeedSettingScheduling{
+						Drain: &core.SeedSettingSchedulingDrain{MaxParallelMigrations: pointer.Int32(0)},
+					},
+				}
+
+				errorList := ValidateSeed(seed)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.settings.scheduling.drain"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.settings.scheduling.drain.maxParallelMigrations"),
+					})),
+				))
+			})
 		})
 
 		It("should fail updating immutable fields", func() {
@@ -112,6 +112,17 @@ var (
 		string(core.CoreDNSAutoscalingModeClusterProportional),
 		string(core.CoreDNSAutoscalingModeHorizontal),
 	)
+	availableHookPoints = sets.New(
+		string(core.HookPointPreHibernation),
+		string(core.HookPointPostHibernation),
+		string(core.HookPointPreKubernetesUpgrade),
+		string(core.HookPointPostKubernetesUpgrade),
+		string(core.HookPointPreDeletion),
+	)
+	availableHookFailurePolicies = sets.New(
+		string(core.HookFailurePolicyFail),
+		string(core.HookFailurePolicyIgnore),
+	)
 	availableSchedulingProfiles = sets.New(
 		string(core.SchedulingProfileBalanced),
 		string(core.SchedulingProfileBinPacking),
@@ -268,6 +279,8 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	allErrs = append(allErrs, validateAddons(spec.Addons, spec.Purpose, workerless, fldPath.Child("addons"))...)
 	allErrs = append(allErrs, validateDNS(spec.DNS, fldPath.Child("dns"))...)
 	allErrs = append(allErrs, validateExtensions(spec.Extensions, fldPath.Child("extensions"))...)
+	allErrs = append(allErrs, validateHooks(spec.Hooks, fldPath.Child("hooks"))...)
+	allErrs = append(allErrs, validateSource(spec.Source, fldPath.Child("source"), inTemplate)...)
 	allErrs = append(allErrs, validateResources(spec.Resources, fldPath.Child("resources"))...)
 	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, spec.Networking, isDockerConfigured(spec.Provider.Workers), workerless, fldPath.Child("kubernetes"))...)
 	allErrs = append(allErrs, validateNetworking(spec.Networking, workerless, fldPath.Child("networking"))...)
@@ -332,6 +345,7 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta met
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.CloudProfileName, oldSpec.CloudProfileName, fldPath.Child("cloudProfileName"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SecretBindingName, oldSpec.SecretBindingName, fldPath.Child("secretBindingName"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.ExposureClassName, oldSpec.ExposureClassName, fldPath.Child("exposureClassName"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Source, oldSpec.Source, fldPath.Child("source"))...)
 
 	allErrs = append(allErrs, validateDNSUpdate(newSpec.DNS, oldSpec.DNS, newSpec.SeedName != nil, fldPath.Child("dns"))...)
 	allErrs = append(allErrs, ValidateKubernetesVersionUpdate(newSpec.Kubernetes.Version, oldSpec.Kubernetes.Version, fldPath.Child("kubernetes", "version"))...)
@@ -849,6 +863,68 @@ func validateExtensions(extensions []core.Extension, fldPath *field.Path) field.
 	return allErrs
 }
 
+func validateHooks(hooks []core.Hook, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.Set[string]{}
+	for i, hook := range hooks {
+		idxPath := fldPath.Index(i)
+
+		if hook.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "field must not be empty"))
+		} else if names.Has(hook.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), hook.Name))
+		} else {
+			names.Insert(hook.Name)
+			for _, msg := range validation.IsDNS1123Label(hook.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), hook.Name, msg))
+			}
+		}
+
+		if hook.Type == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "field must not be empty"))
+		}
+
+		if !availableHookPoints.Has(string(hook.Point)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("point"), hook.Point, sets.List(availableHookPoints)))
+		}
+
+		if hook.Timeout != nil && hook.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("timeout"), hook.Timeout.Duration.String(), "timeout must be positive"))
+		}
+
+		if hook.FailurePolicy != nil && !availableHookFailurePolicies.Has(string(*hook.FailurePolicy)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("failurePolicy"), *hook.FailurePolicy, sets.List(availableHookFailurePolicies)))
+		}
+	}
+	return allErrs
+}
+
+func validateSource(source *core.ShootSource, fldPath *field.Path, inTemplate bool) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if source == nil {
+		return allErrs
+	}
+
+	if inTemplate {
+		return append(allErrs, field.Forbidden(fldPath, "shoots cannot be cloned from a template"))
+	}
+
+	if source.BackupEntryName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("backupEntryName"), "field must not be empty"))
+	} else {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(source.BackupEntryName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("backupEntryName"), source.BackupEntryName, msg))
+		}
+	}
+
+	if source.MaxBackupAge != nil && *source.MaxBackupAge <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBackupAge"), *source.MaxBackupAge, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
 func validateResources(resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	names := sets.Set[string]{}
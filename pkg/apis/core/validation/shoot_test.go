@@ -890,6 +890,128 @@ var _ = Describe("Shoot Validation Tests", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 
+		It("should allow valid hooks", func() {
+			failurePolicy := core.HookFailurePolicyIgnore
+			shoot.Spec.Hooks = []core.Hook{
+				{Name: "backup", Type: "app-backup", Point: core.HookPointPreHibernation, Timeout: &metav1.Duration{Duration: time.Minute}, FailurePolicy: &failurePolicy},
+				{Name: "smoke-test", Type: "smoke-test", Point: core.HookPointPostKubernetesUpgrade},
+			}
+
+			Expect(ValidateShoot(shoot)).To(BeEmpty())
+		})
+
+		It("should forbid invalid hooks", func() {
+			failurePolicy := core.HookFailurePolicy("Retry")
+			shoot.Spec.Hooks = []core.Hook{
+				{},
+				{Name: "Foo_Bar", Type: "foo", Point: "PreCreation", Timeout: &metav1.Duration{Duration: -time.Minute}, FailurePolicy: &failurePolicy},
+				{Name: "backup", Type: "foo", Point: core.HookPointPreDeletion},
+				{Name: "backup", Type: "foo", Point: core.HookPointPreDeletion},
+			}
+
+			Expect(ValidateShoot(shoot)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.hooks[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.hooks[0].type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.hooks[0].point"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.hooks[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.hooks[1].point"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.hooks[1].timeout"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.hooks[1].failurePolicy"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.hooks[3].name"),
+				})),
+			))
+		})
+
+		It("should allow a valid source", func() {
+			shoot.Spec.Source = &core.ShootSource{BackupEntryName: "shoot--foo--bar--1234"}
+
+			Expect(ValidateShoot(shoot)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid source", func() {
+			shoot.Spec.Source = &core.ShootSource{}
+
+			Expect(ValidateShoot(shoot)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.source.backupEntryName"),
+				})),
+			))
+
+			shoot.Spec.Source.BackupEntryName = "Foo_Bar"
+
+			Expect(ValidateShoot(shoot)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.source.backupEntryName"),
+				})),
+			))
+		})
+
+		It("should allow a source with a maximum backup age", func() {
+			shoot.Spec.Source = &core.ShootSource{BackupEntryName: "shoot--foo--bar--1234", MaxBackupAge: pointer.Int32(3)}
+
+			Expect(ValidateShoot(shoot)).To(BeEmpty())
+		})
+
+		It("should forbid a source with a non-positive maximum backup age", func() {
+			shoot.Spec.Source = &core.ShootSource{BackupEntryName: "shoot--foo--bar--1234", MaxBackupAge: pointer.Int32(0)}
+
+			Expect(ValidateShoot(shoot)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.source.maxBackupAge"),
+				})),
+			))
+		})
+
+		It("should forbid a source in a shoot template", func() {
+			shoot.Spec.Source = &core.ShootSource{BackupEntryName: "shoot--foo--bar--1234"}
+
+			Expect(ValidateShootTemplate(&core.ShootTemplate{Spec: shoot.Spec}, field.NewPath("spec"))).To(ContainElement(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.spec.source"),
+				})),
+			))
+		})
+
+		It("should forbid to change the source", func() {
+			shoot.Spec.Source = &core.ShootSource{BackupEntryName: "shoot--foo--bar--1234"}
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Spec.Source = nil
+
+			Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.source"),
+				})),
+			))
+		})
+
 		It("should forbid resources w/o names or w/ invalid references", func() {
 			ref := core.NamedResourceReference{}
 			shoot.Spec.Resources = append(shoot.Spec.Resources, ref)
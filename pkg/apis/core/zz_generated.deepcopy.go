@@ -1007,6 +1007,7 @@ func (in *ControllerRegistration) DeepCopyInto(out *ControllerRegistration) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -1046,6 +1047,11 @@ func (in *ControllerRegistrationDeployment) DeepCopyInto(out *ControllerRegistra
 		*out = make([]DeploymentRef, len(*in))
 		copy(*out, *in)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ControllerRegistrationRollout)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1092,6 +1098,57 @@ func (in *ControllerRegistrationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerRegistrationRollout) DeepCopyInto(out *ControllerRegistrationRollout) {
+	*out = *in
+	if in.CanarySeedSelector != nil {
+		in, out := &in.CanarySeedSelector, &out.CanarySeedSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerRegistrationRollout.
+func (in *ControllerRegistrationRollout) DeepCopy() *ControllerRegistrationRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerRegistrationRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerRegistrationRolloutStatus) DeepCopyInto(out *ControllerRegistrationRolloutStatus) {
+	*out = *in
+	if in.FailedSeeds != nil {
+		in, out := &in.FailedSeeds, &out.FailedSeeds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerRegistrationRolloutStatus.
+func (in *ControllerRegistrationRolloutStatus) DeepCopy() *ControllerRegistrationRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerRegistrationRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerRegistrationSpec) DeepCopyInto(out *ControllerRegistrationSpec) {
 	*out = *in
@@ -1120,6 +1177,27 @@ func (in *ControllerRegistrationSpec) DeepCopy() *ControllerRegistrationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerRegistrationStatus) DeepCopyInto(out *ControllerRegistrationStatus) {
+	*out = *in
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ControllerRegistrationRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerRegistrationStatus.
+func (in *ControllerRegistrationStatus) DeepCopy() *ControllerRegistrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerRegistrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerResource) DeepCopyInto(out *ControllerResource) {
 	*out = *in
@@ -1756,6 +1834,37 @@ func (in *HighAvailability) DeepCopy() *HighAvailability {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hook) DeepCopyInto(out *Hook) {
+	*out = *in
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(HookFailurePolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hook.
+func (in *Hook) DeepCopy() *Hook {
+	if in == nil {
+		return nil
+	}
+	out := new(Hook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HorizontalPodAutoscalerConfig) DeepCopyInto(out *HorizontalPodAutoscalerConfig) {
 	*out = *in
@@ -3170,6 +3279,97 @@ func (in *Project) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAccessReview) DeepCopyInto(out *ProjectAccessReview) {
+	*out = *in
+	in.LastReviewTimestamp.DeepCopyInto(&out.LastReviewTimestamp)
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]ProjectMemberAccessReview, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAccessReview.
+func (in *ProjectAccessReview) DeepCopy() *ProjectAccessReview {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAccessReview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAccumulatedUsage) DeepCopyInto(out *ProjectAccumulatedUsage) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+	in.LastUpdateTimestamp.DeepCopyInto(&out.LastUpdateTimestamp)
+	if in.Rates != nil {
+		in, out := &in.Rates, &out.Rates
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Hours != nil {
+		in, out := &in.Hours, &out.Hours
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.MaximumRates != nil {
+		in, out := &in.MaximumRates, &out.MaximumRates
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.MaximumHours != nil {
+		in, out := &in.MaximumHours, &out.MaximumHours
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAccumulatedUsage.
+func (in *ProjectAccumulatedUsage) DeepCopy() *ProjectAccumulatedUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAccumulatedUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectBastionSettings) DeepCopyInto(out *ProjectBastionSettings) {
+	*out = *in
+	if in.MaxSessionDuration != nil {
+		in, out := &in.MaxSessionDuration, &out.MaxSessionDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectBastionSettings.
+func (in *ProjectBastionSettings) DeepCopy() *ProjectBastionSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectBastionSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectList) DeepCopyInto(out *ProjectList) {
 	*out = *in
@@ -3203,6 +3403,27 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMachineImageRestriction) DeepCopyInto(out *ProjectMachineImageRestriction) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMachineImageRestriction.
+func (in *ProjectMachineImageRestriction) DeepCopy() *ProjectMachineImageRestriction {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMachineImageRestriction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectMember) DeepCopyInto(out *ProjectMember) {
 	*out = *in
@@ -3212,6 +3433,17 @@ func (in *ProjectMember) DeepCopyInto(out *ProjectMember) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExpirationDate != nil {
+		in, out := &in.ExpirationDate, &out.ExpirationDate
+		*out = (*in).DeepCopy()
+	}
+	if in.RoleExpirations != nil {
+		in, out := &in.RoleExpirations, &out.RoleExpirations
+		*out = make([]ProjectMemberRoleExpiration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3225,6 +3457,111 @@ func (in *ProjectMember) DeepCopy() *ProjectMember {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMemberAccessReview) DeepCopyInto(out *ProjectMemberAccessReview) {
+	*out = *in
+	out.Subject = in.Subject
+	in.FirstReviewTimestamp.DeepCopyInto(&out.FirstReviewTimestamp)
+	if in.LastActivityTimestamp != nil {
+		in, out := &in.LastActivityTimestamp, &out.LastActivityTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMemberAccessReview.
+func (in *ProjectMemberAccessReview) DeepCopy() *ProjectMemberAccessReview {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMemberAccessReview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMemberRoleExpiration) DeepCopyInto(out *ProjectMemberRoleExpiration) {
+	*out = *in
+	in.ExpirationDate.DeepCopyInto(&out.ExpirationDate)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMemberRoleExpiration.
+func (in *ProjectMemberRoleExpiration) DeepCopy() *ProjectMemberRoleExpiration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMemberRoleExpiration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectQuota) DeepCopyInto(out *ProjectQuota) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectQuota.
+func (in *ProjectQuota) DeepCopy() *ProjectQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRestrictions) DeepCopyInto(out *ProjectRestrictions) {
+	*out = *in
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MachineTypes != nil {
+		in, out := &in.MachineTypes, &out.MachineTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MachineImages != nil {
+		in, out := &in.MachineImages, &out.MachineImages
+		*out = make([]ProjectMachineImageRestriction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeTypes != nil {
+		in, out := &in.VolumeTypes, &out.VolumeTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRestrictions.
+func (in *ProjectRestrictions) DeepCopy() *ProjectRestrictions {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRestrictions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
@@ -3265,6 +3602,21 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 		*out = new(ProjectTolerations)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(ProjectQuota)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Restrictions != nil {
+		in, out := &in.Restrictions, &out.Restrictions
+		*out = new(ProjectRestrictions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(ProjectBastionSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3293,6 +3645,23 @@ func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 		in, out := &in.LastActivityTimestamp, &out.LastActivityTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.AccessReview != nil {
+		in, out := &in.AccessReview, &out.AccessReview
+		*out = new(ProjectAccessReview)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccumulatedUsage != nil {
+		in, out := &in.AccumulatedUsage, &out.AccumulatedUsage
+		*out = new(ProjectAccumulatedUsage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3727,6 +4096,28 @@ func (in *SeedDNSProvider) DeepCopy() *SeedDNSProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedDrainStatus) DeepCopyInto(out *SeedDrainStatus) {
+	*out = *in
+	in.StartTimestamp.DeepCopyInto(&out.StartTimestamp)
+	if in.MigratingShoots != nil {
+		in, out := &in.MigratingShoots, &out.MigratingShoots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDrainStatus.
+func (in *SeedDrainStatus) DeepCopy() *SeedDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedList) DeepCopyInto(out *SeedList) {
 	*out = *in
@@ -4033,6 +4424,11 @@ func (in *SeedSettingLoadBalancerServicesZones) DeepCopy() *SeedSettingLoadBalan
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSettingScheduling) DeepCopyInto(out *SeedSettingScheduling) {
 	*out = *in
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(SeedSettingSchedulingDrain)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4046,6 +4442,27 @@ func (in *SeedSettingScheduling) DeepCopy() *SeedSettingScheduling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingSchedulingDrain) DeepCopyInto(out *SeedSettingSchedulingDrain) {
+	*out = *in
+	if in.MaxParallelMigrations != nil {
+		in, out := &in.MaxParallelMigrations, &out.MaxParallelMigrations
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingSchedulingDrain.
+func (in *SeedSettingSchedulingDrain) DeepCopy() *SeedSettingSchedulingDrain {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingSchedulingDrain)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSettingTopologyAwareRouting) DeepCopyInto(out *SeedSettingTopologyAwareRouting) {
 	*out = *in
@@ -4089,7 +4506,7 @@ func (in *SeedSettings) DeepCopyInto(out *SeedSettings) {
 	if in.Scheduling != nil {
 		in, out := &in.Scheduling, &out.Scheduling
 		*out = new(SeedSettingScheduling)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.LoadBalancerServices != nil {
 		in, out := &in.LoadBalancerServices, &out.LoadBalancerServices
@@ -4218,6 +4635,11 @@ func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
 		*out = new(LastOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(SeedDrainStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4424,6 +4846,32 @@ func (in *ShootAdvertisedAddress) DeepCopy() *ShootAdvertisedAddress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootAvailability) DeepCopyInto(out *ShootAvailability) {
+	*out = *in
+	if in.Last24Hours != nil {
+		in, out := &in.Last24Hours, &out.Last24Hours
+		*out = new(string)
+		**out = **in
+	}
+	if in.Last7Days != nil {
+		in, out := &in.Last7Days, &out.Last7Days
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAvailability.
+func (in *ShootAvailability) DeepCopy() *ShootAvailability {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootAvailability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootCredentials) DeepCopyInto(out *ShootCredentials) {
 	*out = *in
@@ -4619,6 +5067,27 @@ func (in *ShootSSHKeypairRotation) DeepCopy() *ShootSSHKeypairRotation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootSource) DeepCopyInto(out *ShootSource) {
+	*out = *in
+	if in.MaxBackupAge != nil {
+		in, out := &in.MaxBackupAge, &out.MaxBackupAge
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSource.
+func (in *ShootSource) DeepCopy() *ShootSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 	*out = *in
@@ -4713,6 +5182,18 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]Hook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(ShootSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4895,6 +5376,11 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Availability != nil {
+		in, out := &in.Availability, &out.Availability
+		*out = new(ShootAvailability)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -53,6 +53,10 @@ type Status interface {
 	// SetResources sets a list of named resource references in the Status, that are referred by
 	// their names in the State.
 	SetResources(namedResourceReferences []gardencorev1beta1.NamedResourceReference)
+	// GetHealthCheckAvailability retrieves the availability history of the health check conditions.
+	GetHealthCheckAvailability() []HealthCheckAvailability
+	// SetHealthCheckAvailability sets the availability history of the health check conditions.
+	SetHealthCheckAvailability([]HealthCheckAvailability)
 }
 
 // Spec is the spec section of an Object.
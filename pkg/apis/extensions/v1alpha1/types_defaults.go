@@ -15,6 +15,7 @@
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -72,6 +73,35 @@ type DefaultStatus struct {
 	// Resources holds a list of named resource references that can be referred to in the state by their names.
 	// +optional
 	Resources []gardencorev1beta1.NamedResourceReference `json:"resources,omitempty"`
+	// HealthCheckAvailability contains the rolling availability history of the health check conditions maintained by the
+	// extension's health check controller.
+	// +optional
+	HealthCheckAvailability []HealthCheckAvailability `json:"healthCheckAvailability,omitempty"`
+}
+
+// HealthCheckAvailability contains the availability history of a single health check condition.
+type HealthCheckAvailability struct {
+	// Type is the type of the health check condition.
+	Type gardencorev1beta1.ConditionType `json:"type"`
+	// Last24Hours is the percentage of successful health checks during the last 24 hours.
+	// +optional
+	Last24Hours *string `json:"last24Hours,omitempty"`
+	// Last7Days is the percentage of successful health checks during the last 7 days.
+	// +optional
+	Last7Days *string `json:"last7Days,omitempty"`
+	// Buckets contains the hourly aggregated health check results the availability figures are computed from.
+	// +optional
+	Buckets []HealthCheckAvailabilityBucket `json:"buckets,omitempty"`
+}
+
+// HealthCheckAvailabilityBucket contains the aggregated health check results of one hour.
+type HealthCheckAvailabilityBucket struct {
+	// Start is the beginning of the hour the bucket covers.
+	Start metav1.Time `json:"start"`
+	// Total is the number of health checks performed during the hour.
+	Total int32 `json:"total"`
+	// Successful is the number of health checks which reported the condition to be healthy during the hour.
+	Successful int32 `json:"successful"`
 }
 
 // GetProviderStatus implements Status.
@@ -138,3 +168,13 @@ func (d *DefaultStatus) GetResources() []gardencorev1beta1.NamedResourceReferenc
 func (d *DefaultStatus) SetResources(namedResourceReference []gardencorev1beta1.NamedResourceReference) {
 	d.Resources = namedResourceReference
 }
+
+// GetHealthCheckAvailability implements Status.
+func (d *DefaultStatus) GetHealthCheckAvailability() []HealthCheckAvailability {
+	return d.HealthCheckAvailability
+}
+
+// SetHealthCheckAvailability implements Status.
+func (d *DefaultStatus) SetHealthCheckAvailability(availability []HealthCheckAvailability) {
+	d.HealthCheckAvailability = availability
+}
@@ -23,6 +23,10 @@ var _ Object = (*Extension)(nil)
 // ExtensionResource is a constant for the name of the Extension resource.
 const ExtensionResource = "Extension"
 
+// LabelHookPoint is the key of a label on Extension resources which are created for the execution of a Shoot hook. Its
+// value is the point in the flow at which the hook is executed, e.g. 'PreHibernation'.
+const LabelHookPoint = "extensions.gardener.cloud/hook-point"
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:resource:scope=Namespaced,path=extensions,shortName=ext,singular=extension
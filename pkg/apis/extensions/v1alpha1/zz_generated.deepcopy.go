@@ -892,6 +892,13 @@ func (in *DefaultStatus) DeepCopyInto(out *DefaultStatus) {
 		*out = make([]v1beta1.NamedResourceReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.HealthCheckAvailability != nil {
+		in, out := &in.HealthCheckAvailability, &out.HealthCheckAvailability
+		*out = make([]HealthCheckAvailability, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1122,6 +1129,56 @@ func (in *FileContentSecretRef) DeepCopy() *FileContentSecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckAvailability) DeepCopyInto(out *HealthCheckAvailability) {
+	*out = *in
+	if in.Last24Hours != nil {
+		in, out := &in.Last24Hours, &out.Last24Hours
+		*out = new(string)
+		**out = **in
+	}
+	if in.Last7Days != nil {
+		in, out := &in.Last7Days, &out.Last7Days
+		*out = new(string)
+		**out = **in
+	}
+	if in.Buckets != nil {
+		in, out := &in.Buckets, &out.Buckets
+		*out = make([]HealthCheckAvailabilityBucket, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckAvailability.
+func (in *HealthCheckAvailability) DeepCopy() *HealthCheckAvailability {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckAvailability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckAvailabilityBucket) DeepCopyInto(out *HealthCheckAvailabilityBucket) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckAvailabilityBucket.
+func (in *HealthCheckAvailabilityBucket) DeepCopy() *HealthCheckAvailabilityBucket {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckAvailabilityBucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Infrastructure) DeepCopyInto(out *Infrastructure) {
 	*out = *in
@@ -60,6 +60,13 @@ type BastionSpec struct {
 	SSHPublicKey string
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy
+	// ApprovalRequired specifies whether the Bastion must be approved by another admin of the project before the
+	// bastion host is created. This field is populated by the API server based on the `.spec.bastion` settings of the
+	// Project and is immutable.
+	ApprovalRequired *bool
+	// MaxSessionDuration is the maximum duration for which the Bastion may be used. This field is populated by the API
+	// server based on the `.spec.bastion` settings of the Project and is immutable.
+	MaxSessionDuration *metav1.Duration
 }
 
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
@@ -84,4 +91,25 @@ type BastionStatus struct {
 	// ObservedGeneration is the most recent generation observed for this Bastion. It corresponds to the
 	// Bastion's generation, which is updated on mutation by the API Server.
 	ObservedGeneration *int64
+	// Approval contains information about the approval of the Bastion.
+	Approval *BastionApproval
+	// SSHCertificate contains a short-lived SSH user certificate for the public key of the Bastion which is trusted by
+	// the worker nodes of the shoot.
+	SSHCertificate *BastionSSHCertificate
+}
+
+// BastionApproval contains information about the approval of a Bastion.
+type BastionApproval struct {
+	// ApprovedBy is the name of the user who approved the Bastion.
+	ApprovedBy string
+	// ApprovalTimestamp is the time when the Bastion was approved.
+	ApprovalTimestamp metav1.Time
+}
+
+// BastionSSHCertificate contains a short-lived SSH user certificate.
+type BastionSSHCertificate struct {
+	// Certificate is the SSH user certificate in the authorized keys format.
+	Certificate string
+	// ValidUntil is the time when the certificate expires.
+	ValidUntil metav1.Time
 }
@@ -74,10 +74,38 @@ func (m *Bastion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Bastion proto.InternalMessageInfo
 
+func (m *BastionApproval) Reset()      { *m = BastionApproval{} }
+func (*BastionApproval) ProtoMessage() {}
+func (*BastionApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8b335fad1255a79, []int{1}
+}
+func (m *BastionApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BastionApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BastionApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BastionApproval.Merge(m, src)
+}
+func (m *BastionApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *BastionApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_BastionApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BastionApproval proto.InternalMessageInfo
+
 func (m *BastionIngressPolicy) Reset()      { *m = BastionIngressPolicy{} }
 func (*BastionIngressPolicy) ProtoMessage() {}
 func (*BastionIngressPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8b335fad1255a79, []int{1}
+	return fileDescriptor_a8b335fad1255a79, []int{2}
 }
 func (m *BastionIngressPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -105,7 +133,7 @@ var xxx_messageInfo_BastionIngressPolicy proto.InternalMessageInfo
 func (m *BastionList) Reset()      { *m = BastionList{} }
 func (*BastionList) ProtoMessage() {}
 func (*BastionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8b335fad1255a79, []int{2}
+	return fileDescriptor_a8b335fad1255a79, []int{3}
 }
 func (m *BastionList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -130,10 +158,38 @@ func (m *BastionList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BastionList proto.InternalMessageInfo
 
+func (m *BastionSSHCertificate) Reset()      { *m = BastionSSHCertificate{} }
+func (*BastionSSHCertificate) ProtoMessage() {}
+func (*BastionSSHCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8b335fad1255a79, []int{4}
+}
+func (m *BastionSSHCertificate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BastionSSHCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BastionSSHCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BastionSSHCertificate.Merge(m, src)
+}
+func (m *BastionSSHCertificate) XXX_Size() int {
+	return m.Size()
+}
+func (m *BastionSSHCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_BastionSSHCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BastionSSHCertificate proto.InternalMessageInfo
+
 func (m *BastionSpec) Reset()      { *m = BastionSpec{} }
 func (*BastionSpec) ProtoMessage() {}
 func (*BastionSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8b335fad1255a79, []int{3}
+	return fileDescriptor_a8b335fad1255a79, []int{5}
 }
 func (m *BastionSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +217,7 @@ var xxx_messageInfo_BastionSpec proto.InternalMessageInfo
 func (m *BastionStatus) Reset()      { *m = BastionStatus{} }
 func (*BastionStatus) ProtoMessage() {}
 func (*BastionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8b335fad1255a79, []int{4}
+	return fileDescriptor_a8b335fad1255a79, []int{6}
 }
 func (m *BastionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,8 +244,10 @@ var xxx_messageInfo_BastionStatus proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Bastion)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.Bastion")
+	proto.RegisterType((*BastionApproval)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionApproval")
 	proto.RegisterType((*BastionIngressPolicy)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionIngressPolicy")
 	proto.RegisterType((*BastionList)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionList")
+	proto.RegisterType((*BastionSSHCertificate)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionSSHCertificate")
 	proto.RegisterType((*BastionSpec)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionSpec")
 	proto.RegisterType((*BastionStatus)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionStatus")
 }
@@ -199,57 +257,71 @@ func init() {
 }
 
 var fileDescriptor_a8b335fad1255a79 = []byte{
-	// 799 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0xe3, 0xdd, 0x6c, 0x77, 0x3b, 0x9b, 0x42, 0x35, 0xad, 0x96, 0x68, 0x0f, 0xce, 0x92,
-	0x0b, 0x11, 0x12, 0x63, 0x52, 0x55, 0xa8, 0x3d, 0x70, 0x19, 0x44, 0xd9, 0x88, 0xa5, 0x1b, 0x4d,
-	0x2a, 0x0e, 0x08, 0x09, 0x26, 0xf6, 0x5b, 0x67, 0x48, 0xec, 0x31, 0x9e, 0x49, 0x20, 0x1c, 0x10,
-	0x1f, 0x01, 0x3e, 0x15, 0x7b, 0xec, 0x81, 0x43, 0x4f, 0x11, 0x6b, 0x3e, 0x06, 0x17, 0xe4, 0xf1,
-	0x24, 0x76, 0x48, 0x2a, 0x42, 0xdb, 0xdb, 0xcc, 0x9b, 0xf7, 0x7e, 0xff, 0x37, 0xef, 0x3d, 0x8f,
-	0x8c, 0x7a, 0xa1, 0xd0, 0xa3, 0xe9, 0x90, 0xf8, 0x32, 0xf2, 0x42, 0x9e, 0x06, 0x10, 0x43, 0x5a,
-	0x2e, 0x92, 0x71, 0xe8, 0xf1, 0x44, 0x28, 0x4f, 0x26, 0x90, 0x72, 0x2d, 0x64, 0xac, 0xbc, 0x59,
-	0x97, 0x4f, 0x92, 0x11, 0xef, 0x7a, 0x61, 0xee, 0xc2, 0x35, 0x04, 0x24, 0x49, 0xa5, 0x96, 0xf8,
-	0x71, 0x89, 0x22, 0x4b, 0x42, 0xb9, 0x48, 0xc6, 0x21, 0xc9, 0x51, 0xa4, 0x44, 0x91, 0x25, 0xea,
-	0x94, 0xee, 0x96, 0x85, 0x2f, 0x53, 0xf0, 0x66, 0xdd, 0x21, 0xe8, 0x4d, 0xf9, 0xd3, 0x0f, 0xaa,
-	0x0c, 0x19, 0x4a, 0xcf, 0x98, 0x87, 0xd3, 0x2b, 0xb3, 0x33, 0x1b, 0xb3, 0xb2, 0xee, 0xed, 0xf1,
-	0x23, 0x45, 0x84, 0xcc, 0xc1, 0x4b, 0xee, 0x06, 0xb2, 0x53, 0xf1, 0x89, 0x41, 0xff, 0x20, 0xd3,
-	0xb1, 0x88, 0xc3, 0x6d, 0x9e, 0x0f, 0x4b, 0xcf, 0x88, 0xfb, 0x23, 0x11, 0x43, 0x3a, 0x2f, 0xf3,
-	0x8e, 0x40, 0xf3, 0x6d, 0x51, 0xde, 0xcb, 0xa2, 0xd2, 0x69, 0xac, 0x45, 0x04, 0x1b, 0x01, 0x1f,
-	0xfd, 0x57, 0x80, 0xf2, 0x47, 0x10, 0xf1, 0x7f, 0xc7, 0xb5, 0x7f, 0xdf, 0x43, 0x87, 0x94, 0xab,
-	0xbc, 0xea, 0xf8, 0x5b, 0x74, 0x94, 0xe7, 0x13, 0x70, 0xcd, 0x9b, 0xce, 0x99, 0xd3, 0x39, 0x7e,
-	0xf0, 0x21, 0x29, 0xb0, 0xa4, 0x8a, 0x2d, 0x1b, 0x96, 0x7b, 0x93, 0x59, 0x97, 0x5c, 0x0e, 0xbf,
-	0x03, 0x5f, 0x7f, 0x01, 0x9a, 0x53, 0x7c, 0xbd, 0x68, 0xd5, 0xb2, 0x45, 0x0b, 0x95, 0x36, 0xb6,
-	0xa2, 0xe2, 0x11, 0xaa, 0xab, 0x04, 0xfc, 0xe6, 0x9e, 0xa1, 0x3f, 0x21, 0xaf, 0x3c, 0x17, 0xc4,
-	0xe6, 0x3c, 0x48, 0xc0, 0xa7, 0x0d, 0xab, 0x59, 0xcf, 0x77, 0xcc, 0x28, 0xe0, 0x04, 0xdd, 0x52,
-	0x9a, 0xeb, 0xa9, 0x6a, 0xee, 0x1b, 0xad, 0xf3, 0x37, 0xa0, 0x65, 0x78, 0xf4, 0x2d, 0xab, 0x76,
-	0xab, 0xd8, 0x33, 0xab, 0xd3, 0x0e, 0xd0, 0x7d, 0xeb, 0xd8, 0x8b, 0xc3, 0x14, 0x94, 0xea, 0xcb,
-	0x89, 0xf0, 0xe7, 0xf8, 0x02, 0x1d, 0x8a, 0x84, 0x4e, 0xa4, 0x3f, 0xb6, 0x45, 0x7d, 0xb7, 0x52,
-	0x54, 0x52, 0x0e, 0x4f, 0x5e, 0xc8, 0x5e, 0xdf, 0x38, 0xd2, 0xb7, 0xad, 0xc6, 0xa1, 0x35, 0xb0,
-	0x25, 0xa2, 0xfd, 0x87, 0x83, 0x8e, 0xad, 0xcc, 0x85, 0x50, 0x1a, 0x7f, 0xbd, 0xd1, 0x33, 0xb2,
-	0x5b, 0xcf, 0xf2, 0x68, 0xd3, 0xb1, 0xbb, 0x56, 0xeb, 0x68, 0x69, 0xa9, 0xf4, 0x2b, 0x44, 0x07,
-	0x42, 0x43, 0xa4, 0x9a, 0x7b, 0x67, 0xfb, 0x9d, 0xe3, 0x07, 0xf4, 0xf5, 0x8b, 0x48, 0xef, 0x58,
-	0xb9, 0x83, 0x5e, 0x0e, 0x66, 0x05, 0xbf, 0xfd, 0xf7, 0xde, 0xea, 0x5a, 0x79, 0x13, 0xf1, 0x97,
-	0xe8, 0x48, 0x8d, 0xa4, 0xd4, 0x0c, 0xae, 0xec, 0xb5, 0x3a, 0xd5, 0xaa, 0xe5, 0x9f, 0xa5, 0xb9,
-	0x84, 0xf4, 0xf9, 0xa4, 0x98, 0x34, 0x06, 0x57, 0x90, 0x42, 0xec, 0x43, 0x79, 0xa1, 0x81, 0x25,
-	0xb0, 0x15, 0x0b, 0x77, 0xd0, 0x91, 0x02, 0x08, 0x9e, 0xf2, 0x08, 0xcc, 0x10, 0xde, 0xa6, 0x0d,
-	0xe3, 0x69, 0x6d, 0x6c, 0x75, 0x8a, 0x1f, 0xa2, 0x46, 0x92, 0xca, 0x99, 0x08, 0x20, 0x7d, 0x36,
-	0x4f, 0xc0, 0x8c, 0xd1, 0x6d, 0x7a, 0x37, 0x5b, 0xb4, 0x1a, 0xfd, 0x8a, 0x9d, 0xad, 0x79, 0xe1,
-	0x47, 0xa8, 0xa1, 0xd4, 0xa8, 0x3f, 0x1d, 0x4e, 0x84, 0xff, 0x39, 0xcc, 0x9b, 0x75, 0x13, 0x75,
-	0xdf, 0x66, 0xd4, 0x18, 0x0c, 0xce, 0x57, 0x67, 0x6c, 0xcd, 0x13, 0xff, 0x84, 0x0e, 0x45, 0x31,
-	0x37, 0xcd, 0x03, 0x53, 0xec, 0xcb, 0xd7, 0x2f, 0xf6, 0xda, 0x20, 0x56, 0x86, 0xaa, 0x30, 0xb3,
-	0xa5, 0x60, 0xfb, 0xb7, 0x3a, 0xba, 0xb3, 0x36, 0xe4, 0xf8, 0x69, 0x99, 0x4d, 0x51, 0xfe, 0xf7,
-	0xb6, 0x97, 0x9f, 0x07, 0x94, 0x4f, 0x78, 0xec, 0x43, 0x6a, 0xa1, 0xf4, 0x78, 0x9b, 0x02, 0xfe,
-	0x1e, 0x21, 0x5f, 0xc6, 0x81, 0x30, 0x79, 0xda, 0x69, 0xfa, 0x78, 0xc7, 0x0b, 0x5a, 0x35, 0xf3,
-	0xb6, 0x93, 0x4f, 0x96, 0x94, 0xf2, 0xa5, 0x59, 0x99, 0x14, 0xab, 0x88, 0xe0, 0x9f, 0xd1, 0xc9,
-	0x84, 0x2b, 0x7d, 0x0e, 0x3c, 0xd5, 0x43, 0xe0, 0xfa, 0x99, 0x88, 0x40, 0x69, 0x1e, 0x25, 0xf6,
-	0x45, 0x78, 0x7f, 0xb7, 0xef, 0x24, 0x0f, 0xa3, 0xa7, 0xd9, 0xa2, 0x75, 0x72, 0xb1, 0x95, 0xc6,
-	0x5e, 0xa2, 0x82, 0xa7, 0xe8, 0x1e, 0xfc, 0x98, 0x88, 0xa2, 0x37, 0xa5, 0x78, 0xfd, 0x7f, 0x8b,
-	0xbf, 0x93, 0x2d, 0x5a, 0xf7, 0x3e, 0xdd, 0x44, 0xb1, 0x6d, 0x7c, 0xfc, 0x04, 0x61, 0x39, 0x54,
-	0x90, 0xce, 0x20, 0xf8, 0xac, 0x78, 0xeb, 0x85, 0x8c, 0x9b, 0x07, 0x67, 0x4e, 0x67, 0x9f, 0x9e,
-	0x64, 0x8b, 0x16, 0xbe, 0xdc, 0x38, 0x65, 0x5b, 0x22, 0xe8, 0x37, 0xd7, 0x37, 0x6e, 0xed, 0xf9,
-	0x8d, 0x5b, 0x7b, 0x71, 0xe3, 0xd6, 0x7e, 0xc9, 0x5c, 0xe7, 0x3a, 0x73, 0x9d, 0xe7, 0x99, 0xeb,
-	0xbc, 0xc8, 0x5c, 0xe7, 0xcf, 0xcc, 0x75, 0x7e, 0xfd, 0xcb, 0xad, 0x7d, 0xf5, 0xf8, 0x95, 0x7f,
-	0x12, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xea, 0x1f, 0x49, 0x60, 0x08, 0x00, 0x00,
+	// 1018 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcb, 0x72, 0x1b, 0x45,
+	0x14, 0xd5, 0xd8, 0xb2, 0x25, 0xb7, 0x14, 0xc7, 0x69, 0x1b, 0x23, 0xbc, 0x90, 0x8c, 0x36, 0xa8,
+	0xa8, 0x62, 0x84, 0x5d, 0x81, 0x4a, 0x16, 0x54, 0xe1, 0x09, 0x04, 0x1b, 0x9c, 0x58, 0xd5, 0x0a,
+	0x59, 0x50, 0x14, 0xd0, 0x9a, 0xb9, 0x96, 0x1a, 0xcd, 0x2b, 0xd3, 0x2d, 0x11, 0xb1, 0xa0, 0xf8,
+	0x00, 0x16, 0xac, 0xf8, 0x0c, 0x76, 0x6c, 0xf8, 0x01, 0xbc, 0xcc, 0x82, 0x45, 0x56, 0x2a, 0x3c,
+	0xfc, 0x08, 0x35, 0x3d, 0x3d, 0x0f, 0x59, 0x4a, 0xe1, 0x47, 0x76, 0xdd, 0xb7, 0xcf, 0x3d, 0xa7,
+	0x75, 0xef, 0xb9, 0xad, 0x41, 0x47, 0x7d, 0x26, 0x06, 0xa3, 0x9e, 0x6e, 0x7a, 0x4e, 0xbb, 0x4f,
+	0x03, 0x0b, 0x5c, 0x08, 0xb2, 0x85, 0x3f, 0xec, 0xb7, 0xa9, 0xcf, 0x78, 0xdb, 0xf3, 0x21, 0xa0,
+	0x82, 0x79, 0x2e, 0x6f, 0x8f, 0xf7, 0xa8, 0xed, 0x0f, 0xe8, 0x5e, 0xbb, 0x1f, 0x41, 0xa8, 0x00,
+	0x4b, 0xf7, 0x03, 0x4f, 0x78, 0xf8, 0x7e, 0x46, 0xa5, 0x27, 0x0c, 0xd9, 0xc2, 0x1f, 0xf6, 0xf5,
+	0x88, 0x4a, 0xcf, 0xa8, 0xf4, 0x84, 0x6a, 0xc7, 0xb8, 0xdc, 0x2d, 0x4c, 0x2f, 0x80, 0xf6, 0x78,
+	0xaf, 0x07, 0x62, 0x5e, 0x7e, 0xe7, 0xbd, 0x3c, 0x87, 0xd7, 0xf7, 0xda, 0x32, 0xdc, 0x1b, 0x9d,
+	0xca, 0x9d, 0xdc, 0xc8, 0x95, 0x82, 0x37, 0x87, 0xf7, 0xb8, 0xce, 0xbc, 0x88, 0x38, 0xe1, 0x9d,
+	0xa3, 0x6c, 0xe5, 0x30, 0x2e, 0x88, 0x1f, 0xbc, 0x60, 0xc8, 0xdc, 0xfe, 0x22, 0xe4, 0xdd, 0x0c,
+	0xe9, 0x50, 0x73, 0xc0, 0x5c, 0x08, 0x26, 0xd9, 0xbd, 0x1d, 0x10, 0x74, 0x51, 0x56, 0xfb, 0x55,
+	0x59, 0xc1, 0xc8, 0x15, 0xcc, 0x81, 0xb9, 0x84, 0x0f, 0xff, 0x2f, 0x81, 0x9b, 0x03, 0x70, 0xe8,
+	0xc5, 0xbc, 0xe6, 0x5f, 0x4b, 0xa8, 0x64, 0x50, 0x1e, 0x55, 0x1d, 0x7f, 0x87, 0xca, 0xd1, 0x7d,
+	0x2c, 0x2a, 0x68, 0x4d, 0xdb, 0xd5, 0x5a, 0x95, 0xfd, 0xf7, 0xf5, 0x98, 0x56, 0xcf, 0xd3, 0x66,
+	0x0d, 0x8b, 0xd0, 0xfa, 0x78, 0x4f, 0x3f, 0xe9, 0x7d, 0x0f, 0xa6, 0x78, 0x04, 0x82, 0x1a, 0xf8,
+	0x6c, 0xda, 0x28, 0x84, 0xd3, 0x06, 0xca, 0x62, 0x24, 0x65, 0xc5, 0x03, 0x54, 0xe4, 0x3e, 0x98,
+	0xb5, 0x25, 0xc9, 0xfe, 0x50, 0xbf, 0xb6, 0x2f, 0x74, 0x75, 0xe7, 0xae, 0x0f, 0xa6, 0x51, 0x55,
+	0x9a, 0xc5, 0x68, 0x47, 0xa4, 0x02, 0xf6, 0xd1, 0x2a, 0x17, 0x54, 0x8c, 0x78, 0x6d, 0x59, 0x6a,
+	0x1d, 0xbe, 0x06, 0x2d, 0xc9, 0x67, 0xac, 0x2b, 0xb5, 0xd5, 0x78, 0x4f, 0x94, 0x4e, 0xf3, 0x4f,
+	0x0d, 0xdd, 0x56, 0xc8, 0x03, 0xdf, 0x0f, 0xbc, 0x31, 0xb5, 0xf1, 0x3e, 0x42, 0x54, 0xae, 0xc1,
+	0x32, 0x26, 0xb2, 0xa6, 0x6b, 0x59, 0x85, 0x0e, 0xd2, 0x13, 0x92, 0x43, 0x61, 0x8e, 0xee, 0x50,
+	0x95, 0xff, 0x84, 0x39, 0xc0, 0x05, 0x75, 0x7c, 0x55, 0xb0, 0x77, 0x2f, 0xd7, 0x8e, 0x28, 0xcd,
+	0x78, 0x4b, 0xc9, 0xdc, 0x39, 0xb8, 0x48, 0x46, 0xe6, 0xf9, 0x9b, 0x16, 0xda, 0x52, 0x77, 0x3f,
+	0x72, 0xfb, 0x01, 0x70, 0xde, 0xf1, 0x6c, 0x66, 0x4e, 0xf0, 0x31, 0x2a, 0x31, 0xdf, 0xb0, 0x3d,
+	0x73, 0xa8, 0x1c, 0xf1, 0x76, 0xee, 0x0a, 0x7a, 0xe6, 0xfc, 0x48, 0xf6, 0xa8, 0x23, 0x81, 0xc6,
+	0x6d, 0xa5, 0x5c, 0x52, 0x01, 0x92, 0x50, 0x34, 0xff, 0xd6, 0x50, 0x45, 0xc9, 0x1c, 0x33, 0x2e,
+	0xf0, 0xd7, 0x73, 0x86, 0xd3, 0x2f, 0xf7, 0x0b, 0xa3, 0x6c, 0x69, 0xb7, 0x0d, 0xa5, 0x55, 0x4e,
+	0x22, 0x39, 0xb3, 0xf5, 0xd1, 0x0a, 0x13, 0xe0, 0xf0, 0xda, 0xd2, 0xee, 0x72, 0xab, 0xb2, 0x6f,
+	0xdc, 0xdc, 0x01, 0xc6, 0x2d, 0x25, 0xb7, 0x72, 0x14, 0x11, 0x93, 0x98, 0xbf, 0xf9, 0xbb, 0x86,
+	0xde, 0x48, 0x3c, 0xd2, 0x3d, 0x7c, 0x00, 0x81, 0x60, 0xa7, 0xcc, 0xa4, 0x02, 0xf0, 0x07, 0xa8,
+	0x62, 0x66, 0x5b, 0x65, 0x80, 0x4d, 0x45, 0x52, 0xc9, 0x21, 0x49, 0x1e, 0x87, 0xbf, 0x41, 0x68,
+	0x4c, 0x6d, 0x66, 0x7d, 0xe9, 0x0a, 0x66, 0x5f, 0xa3, 0xf7, 0xa9, 0xc5, 0x9e, 0xa6, 0x2c, 0x24,
+	0xc7, 0xd8, 0xfc, 0xa3, 0x98, 0xf6, 0x21, 0x1a, 0x19, 0xfc, 0x14, 0x95, 0xf9, 0xc0, 0xf3, 0x04,
+	0x81, 0x53, 0xd5, 0x87, 0x56, 0xbe, 0xcd, 0xd1, 0x23, 0x28, 0xab, 0xee, 0x99, 0xd4, 0x8e, 0xe7,
+	0x9a, 0xc0, 0x29, 0x04, 0xe0, 0x9a, 0x90, 0x75, 0xa0, 0xab, 0x18, 0x48, 0xca, 0x85, 0x5b, 0xa8,
+	0xcc, 0x01, 0xac, 0xc7, 0xd4, 0x01, 0xf9, 0x2b, 0xd6, 0x8c, 0xaa, 0x44, 0xaa, 0x18, 0x49, 0x4f,
+	0xf1, 0x5d, 0x54, 0x8d, 0x2c, 0xc9, 0x2c, 0x08, 0x9e, 0x4c, 0x7c, 0x90, 0x43, 0xbb, 0x66, 0x6c,
+	0x84, 0xd3, 0x46, 0xb5, 0x93, 0x8b, 0x93, 0x19, 0x14, 0xbe, 0x87, 0xaa, 0x9c, 0x0f, 0x3a, 0xa3,
+	0x9e, 0xcd, 0xcc, 0x2f, 0x60, 0x52, 0x2b, 0xca, 0xac, 0x2d, 0x75, 0xa3, 0x6a, 0xb7, 0x7b, 0x98,
+	0x9e, 0x91, 0x19, 0x24, 0xfe, 0x11, 0x95, 0x58, 0x6c, 0xf4, 0xda, 0x8a, 0x74, 0xc7, 0xc9, 0xcd,
+	0xdd, 0x31, 0x33, 0x39, 0xb9, 0x29, 0x88, 0xc3, 0x24, 0x11, 0xc4, 0x1f, 0xa3, 0x8d, 0x64, 0x00,
+	0x09, 0x3c, 0x1b, 0xb1, 0x00, 0xac, 0xda, 0xea, 0xae, 0xd6, 0x2a, 0x1b, 0x5b, 0xe1, 0xb4, 0xb1,
+	0x71, 0x70, 0xe1, 0x8c, 0xcc, 0xa1, 0xf1, 0x18, 0x61, 0x87, 0x3e, 0xef, 0x02, 0xe7, 0xcc, 0x73,
+	0x3f, 0x19, 0xc5, 0x17, 0xaa, 0x95, 0xae, 0x32, 0x41, 0x49, 0x96, 0xb1, 0x1d, 0x4e, 0x1b, 0xf8,
+	0xd1, 0x1c, 0x1b, 0x59, 0xa0, 0xd0, 0xfc, 0x6d, 0x15, 0xdd, 0x9a, 0x79, 0x0c, 0xf1, 0xe3, 0xac,
+	0x8e, 0xb1, 0x71, 0xde, 0x59, 0x6c, 0x1c, 0x6a, 0x19, 0xd4, 0xa6, 0xae, 0x09, 0x81, 0x2a, 0x87,
+	0x51, 0x59, 0x58, 0x9b, 0x67, 0x08, 0x99, 0x9e, 0x6b, 0x31, 0x59, 0x61, 0x35, 0xb8, 0x1f, 0x5d,
+	0xb2, 0x35, 0x4a, 0x4d, 0x7e, 0x03, 0xe8, 0x0f, 0x12, 0x96, 0x6c, 0x18, 0xd2, 0x10, 0x27, 0x39,
+	0x11, 0xfc, 0x13, 0xda, 0xb6, 0x29, 0x17, 0x87, 0x40, 0x03, 0xd1, 0x03, 0x2a, 0xb2, 0x47, 0x77,
+	0xf9, 0xca, 0x83, 0xb7, 0x13, 0x4e, 0x1b, 0xdb, 0xc7, 0x0b, 0xd9, 0xc8, 0x2b, 0x54, 0xf0, 0x08,
+	0x6d, 0xc2, 0x73, 0x9f, 0xc5, 0x25, 0xce, 0xc4, 0x8b, 0x57, 0x16, 0x7f, 0x33, 0x9c, 0x36, 0x36,
+	0x3f, 0x9d, 0xa7, 0x22, 0x8b, 0xf8, 0xf1, 0x43, 0x84, 0xbd, 0x1e, 0x87, 0x60, 0x0c, 0xd6, 0x67,
+	0xf1, 0x37, 0x41, 0xe4, 0xa1, 0x95, 0x5d, 0xad, 0xb5, 0x1c, 0x7b, 0xe2, 0x64, 0xee, 0x94, 0x2c,
+	0xc8, 0xc0, 0x02, 0x95, 0x13, 0x7f, 0x4a, 0x17, 0x57, 0xf6, 0x3f, 0xbf, 0xf9, 0x28, 0x25, 0x33,
+	0x10, 0xbf, 0x17, 0xe9, 0x44, 0xa4, 0x4a, 0xf8, 0x17, 0x0d, 0xad, 0x73, 0x3e, 0xc8, 0xbd, 0xa0,
+	0xca, 0xfe, 0x9d, 0xd7, 0xf0, 0x3f, 0x3f, 0xf3, 0x86, 0x1b, 0x38, 0x9c, 0x36, 0xd6, 0x67, 0x63,
+	0xe4, 0x82, 0xb6, 0xf1, 0xed, 0xd9, 0x79, 0xbd, 0xf0, 0xe2, 0xbc, 0x5e, 0x78, 0x79, 0x5e, 0x2f,
+	0xfc, 0x1c, 0xd6, 0xb5, 0xb3, 0xb0, 0xae, 0xbd, 0x08, 0xeb, 0xda, 0xcb, 0xb0, 0xae, 0xfd, 0x13,
+	0xd6, 0xb5, 0x5f, 0xff, 0xad, 0x17, 0xbe, 0xba, 0x7f, 0xed, 0x2f, 0xea, 0xff, 0x02, 0x00, 0x00,
+	0xff, 0xff, 0x86, 0xca, 0x55, 0x81, 0x8d, 0x0b, 0x00, 0x00,
 }
 
 func (m *Bastion) Marshal() (dAtA []byte, err error) {
@@ -305,6 +377,44 @@ func (m *Bastion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BastionApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BastionApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BastionApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ApprovalTimestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ApprovedBy)
+	copy(dAtA[i:], m.ApprovedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ApprovedBy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *BastionIngressPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -385,6 +495,44 @@ func (m *BastionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BastionSSHCertificate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BastionSSHCertificate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BastionSSHCertificate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ValidUntil.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Certificate)
+	copy(dAtA[i:], m.Certificate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Certificate)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *BastionSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -405,6 +553,28 @@ func (m *BastionSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSessionDuration != nil {
+		{
+			size, err := m.MaxSessionDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ApprovalRequired != nil {
+		i--
+		if *m.ApprovalRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Ingress) > 0 {
 		for iNdEx := len(m.Ingress) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -471,6 +641,30 @@ func (m *BastionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SSHCertificate != nil {
+		{
+			size, err := m.SSHCertificate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Approval != nil {
+		{
+			size, err := m.Approval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.ObservedGeneration != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.ObservedGeneration))
 		i--
@@ -555,6 +749,19 @@ func (m *Bastion) Size() (n int) {
 	return n
 }
 
+func (m *BastionApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ApprovedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ApprovalTimestamp.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *BastionIngressPolicy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -583,6 +790,19 @@ func (m *BastionList) Size() (n int) {
 	return n
 }
 
+func (m *BastionSSHCertificate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Certificate)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ValidUntil.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *BastionSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -607,6 +827,13 @@ func (m *BastionSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.ApprovalRequired != nil {
+		n += 2
+	}
+	if m.MaxSessionDuration != nil {
+		l = m.MaxSessionDuration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -637,6 +864,14 @@ func (m *BastionStatus) Size() (n int) {
 	if m.ObservedGeneration != nil {
 		n += 1 + sovGenerated(uint64(*m.ObservedGeneration))
 	}
+	if m.Approval != nil {
+		l = m.Approval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SSHCertificate != nil {
+		l = m.SSHCertificate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -658,6 +893,17 @@ func (this *Bastion) String() string {
 	}, "")
 	return s
 }
+func (this *BastionApproval) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BastionApproval{`,
+		`ApprovedBy:` + fmt.Sprintf("%v", this.ApprovedBy) + `,`,
+		`ApprovalTimestamp:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApprovalTimestamp), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *BastionIngressPolicy) String() string {
 	if this == nil {
 		return "nil"
@@ -684,6 +930,17 @@ func (this *BastionList) String() string {
 	}, "")
 	return s
 }
+func (this *BastionSSHCertificate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BastionSSHCertificate{`,
+		`Certificate:` + fmt.Sprintf("%v", this.Certificate) + `,`,
+		`ValidUntil:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ValidUntil), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *BastionSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -699,6 +956,8 @@ func (this *BastionSpec) String() string {
 		`ProviderType:` + valueToStringGenerated(this.ProviderType) + `,`,
 		`SSHPublicKey:` + fmt.Sprintf("%v", this.SSHPublicKey) + `,`,
 		`Ingress:` + repeatedStringForIngress + `,`,
+		`ApprovalRequired:` + valueToStringGenerated(this.ApprovalRequired) + `,`,
+		`MaxSessionDuration:` + strings.Replace(fmt.Sprintf("%v", this.MaxSessionDuration), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -718,6 +977,8 @@ func (this *BastionStatus) String() string {
 		`LastHeartbeatTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTimestamp), "Time", "v1.Time", 1) + `,`,
 		`ExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationTimestamp), "Time", "v1.Time", 1) + `,`,
 		`ObservedGeneration:` + valueToStringGenerated(this.ObservedGeneration) + `,`,
+		`Approval:` + strings.Replace(this.Approval.String(), "BastionApproval", "BastionApproval", 1) + `,`,
+		`SSHCertificate:` + strings.Replace(this.SSHCertificate.String(), "BastionSSHCertificate", "BastionSSHCertificate", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -879,6 +1140,121 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BastionApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApprovalTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BastionIngressPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1079,6 +1455,121 @@ func (m *BastionList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BastionSSHCertificate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionSSHCertificate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionSSHCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidUntil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BastionSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1273,6 +1764,63 @@ func (m *BastionSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ApprovalRequired = &b
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSessionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxSessionDuration == nil {
+				m.MaxSessionDuration = &v1.Duration{}
+			}
+			if err := m.MaxSessionDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -1485,6 +2033,78 @@ func (m *BastionStatus) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ObservedGeneration = &v
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Approval == nil {
+				m.Approval = &BastionApproval{}
+			}
+			if err := m.Approval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHCertificate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SSHCertificate == nil {
+				m.SSHCertificate = &BastionSSHCertificate{}
+			}
+			if err := m.SSHCertificate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
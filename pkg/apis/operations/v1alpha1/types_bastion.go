@@ -70,6 +70,15 @@ type BastionSpec struct {
 	SSHPublicKey string `json:"sshPublicKey" protobuf:"bytes,4,opt,name=sshPublicKey"`
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy `json:"ingress" protobuf:"bytes,5,opt,name=ingress"`
+	// ApprovalRequired specifies whether the Bastion must be approved by another admin of the project before the
+	// bastion host is created. This field is populated by the API server based on the `.spec.bastion` settings of the
+	// Project and is immutable.
+	// +optional
+	ApprovalRequired *bool `json:"approvalRequired,omitempty" protobuf:"varint,6,opt,name=approvalRequired"`
+	// MaxSessionDuration is the maximum duration for which the Bastion may be used. This field is populated by the API
+	// server based on the `.spec.bastion` settings of the Project and is immutable.
+	// +optional
+	MaxSessionDuration *metav1.Duration `json:"maxSessionDuration,omitempty" protobuf:"bytes,7,opt,name=maxSessionDuration"`
 }
 
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
@@ -101,4 +110,27 @@ type BastionStatus struct {
 	// Bastion's generation, which is updated on mutation by the API Server.
 	// +optional
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty" protobuf:"varint,5,opt,name=observedGeneration"`
+	// Approval contains information about the approval of the Bastion.
+	// +optional
+	Approval *BastionApproval `json:"approval,omitempty" protobuf:"bytes,6,opt,name=approval"`
+	// SSHCertificate contains a short-lived SSH user certificate for the public key of the Bastion which is trusted by
+	// the worker nodes of the shoot.
+	// +optional
+	SSHCertificate *BastionSSHCertificate `json:"sshCertificate,omitempty" protobuf:"bytes,7,opt,name=sshCertificate"`
+}
+
+// BastionApproval contains information about the approval of a Bastion.
+type BastionApproval struct {
+	// ApprovedBy is the name of the user who approved the Bastion.
+	ApprovedBy string `json:"approvedBy" protobuf:"bytes,1,opt,name=approvedBy"`
+	// ApprovalTimestamp is the time when the Bastion was approved.
+	ApprovalTimestamp metav1.Time `json:"approvalTimestamp" protobuf:"bytes,2,opt,name=approvalTimestamp"`
+}
+
+// BastionSSHCertificate contains a short-lived SSH user certificate.
+type BastionSSHCertificate struct {
+	// Certificate is the SSH user certificate in the authorized keys format.
+	Certificate string `json:"certificate" protobuf:"bytes,1,opt,name=certificate"`
+	// ValidUntil is the time when the certificate expires.
+	ValidUntil metav1.Time `json:"validUntil" protobuf:"bytes,2,opt,name=validUntil"`
 }
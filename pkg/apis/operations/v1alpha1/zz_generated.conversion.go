@@ -27,8 +27,8 @@ import (
 	core "github.com/gardener/gardener/pkg/apis/core"
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operations "github.com/gardener/gardener/pkg/apis/operations"
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -50,6 +50,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BastionApproval)(nil), (*operations.BastionApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionApproval_To_operations_BastionApproval(a.(*BastionApproval), b.(*operations.BastionApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BastionApproval)(nil), (*BastionApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BastionApproval_To_v1alpha1_BastionApproval(a.(*operations.BastionApproval), b.(*BastionApproval), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BastionIngressPolicy)(nil), (*operations.BastionIngressPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BastionIngressPolicy_To_operations_BastionIngressPolicy(a.(*BastionIngressPolicy), b.(*operations.BastionIngressPolicy), scope)
 	}); err != nil {
@@ -70,6 +80,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BastionSSHCertificate)(nil), (*operations.BastionSSHCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionSSHCertificate_To_operations_BastionSSHCertificate(a.(*BastionSSHCertificate), b.(*operations.BastionSSHCertificate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BastionSSHCertificate)(nil), (*BastionSSHCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BastionSSHCertificate_To_v1alpha1_BastionSSHCertificate(a.(*operations.BastionSSHCertificate), b.(*BastionSSHCertificate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BastionSpec)(nil), (*operations.BastionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BastionSpec_To_operations_BastionSpec(a.(*BastionSpec), b.(*operations.BastionSpec), scope)
 	}); err != nil {
@@ -125,6 +145,28 @@ func Convert_operations_Bastion_To_v1alpha1_Bastion(in *operations.Bastion, out
 	return autoConvert_operations_Bastion_To_v1alpha1_Bastion(in, out, s)
 }
 
+func autoConvert_v1alpha1_BastionApproval_To_operations_BastionApproval(in *BastionApproval, out *operations.BastionApproval, s conversion.Scope) error {
+	out.ApprovedBy = in.ApprovedBy
+	out.ApprovalTimestamp = in.ApprovalTimestamp
+	return nil
+}
+
+// Convert_v1alpha1_BastionApproval_To_operations_BastionApproval is an autogenerated conversion function.
+func Convert_v1alpha1_BastionApproval_To_operations_BastionApproval(in *BastionApproval, out *operations.BastionApproval, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionApproval_To_operations_BastionApproval(in, out, s)
+}
+
+func autoConvert_operations_BastionApproval_To_v1alpha1_BastionApproval(in *operations.BastionApproval, out *BastionApproval, s conversion.Scope) error {
+	out.ApprovedBy = in.ApprovedBy
+	out.ApprovalTimestamp = in.ApprovalTimestamp
+	return nil
+}
+
+// Convert_operations_BastionApproval_To_v1alpha1_BastionApproval is an autogenerated conversion function.
+func Convert_operations_BastionApproval_To_v1alpha1_BastionApproval(in *operations.BastionApproval, out *BastionApproval, s conversion.Scope) error {
+	return autoConvert_operations_BastionApproval_To_v1alpha1_BastionApproval(in, out, s)
+}
+
 func autoConvert_v1alpha1_BastionIngressPolicy_To_operations_BastionIngressPolicy(in *BastionIngressPolicy, out *operations.BastionIngressPolicy, s conversion.Scope) error {
 	out.IPBlock = in.IPBlock
 	return nil
@@ -167,12 +209,36 @@ func Convert_operations_BastionList_To_v1alpha1_BastionList(in *operations.Basti
 	return autoConvert_operations_BastionList_To_v1alpha1_BastionList(in, out, s)
 }
 
+func autoConvert_v1alpha1_BastionSSHCertificate_To_operations_BastionSSHCertificate(in *BastionSSHCertificate, out *operations.BastionSSHCertificate, s conversion.Scope) error {
+	out.Certificate = in.Certificate
+	out.ValidUntil = in.ValidUntil
+	return nil
+}
+
+// Convert_v1alpha1_BastionSSHCertificate_To_operations_BastionSSHCertificate is an autogenerated conversion function.
+func Convert_v1alpha1_BastionSSHCertificate_To_operations_BastionSSHCertificate(in *BastionSSHCertificate, out *operations.BastionSSHCertificate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionSSHCertificate_To_operations_BastionSSHCertificate(in, out, s)
+}
+
+func autoConvert_operations_BastionSSHCertificate_To_v1alpha1_BastionSSHCertificate(in *operations.BastionSSHCertificate, out *BastionSSHCertificate, s conversion.Scope) error {
+	out.Certificate = in.Certificate
+	out.ValidUntil = in.ValidUntil
+	return nil
+}
+
+// Convert_operations_BastionSSHCertificate_To_v1alpha1_BastionSSHCertificate is an autogenerated conversion function.
+func Convert_operations_BastionSSHCertificate_To_v1alpha1_BastionSSHCertificate(in *operations.BastionSSHCertificate, out *BastionSSHCertificate, s conversion.Scope) error {
+	return autoConvert_operations_BastionSSHCertificate_To_v1alpha1_BastionSSHCertificate(in, out, s)
+}
+
 func autoConvert_v1alpha1_BastionSpec_To_operations_BastionSpec(in *BastionSpec, out *operations.BastionSpec, s conversion.Scope) error {
 	out.ShootRef = in.ShootRef
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.ProviderType = (*string)(unsafe.Pointer(in.ProviderType))
 	out.SSHPublicKey = in.SSHPublicKey
 	out.Ingress = *(*[]operations.BastionIngressPolicy)(unsafe.Pointer(&in.Ingress))
+	out.ApprovalRequired = (*bool)(unsafe.Pointer(in.ApprovalRequired))
+	out.MaxSessionDuration = (*v1.Duration)(unsafe.Pointer(in.MaxSessionDuration))
 	return nil
 }
 
@@ -187,6 +253,8 @@ func autoConvert_operations_BastionSpec_To_v1alpha1_BastionSpec(in *operations.B
 	out.ProviderType = (*string)(unsafe.Pointer(in.ProviderType))
 	out.SSHPublicKey = in.SSHPublicKey
 	out.Ingress = *(*[]BastionIngressPolicy)(unsafe.Pointer(&in.Ingress))
+	out.ApprovalRequired = (*bool)(unsafe.Pointer(in.ApprovalRequired))
+	out.MaxSessionDuration = (*v1.Duration)(unsafe.Pointer(in.MaxSessionDuration))
 	return nil
 }
 
@@ -196,11 +264,13 @@ func Convert_operations_BastionSpec_To_v1alpha1_BastionSpec(in *operations.Basti
 }
 
 func autoConvert_v1alpha1_BastionStatus_To_operations_BastionStatus(in *BastionStatus, out *operations.BastionStatus, s conversion.Scope) error {
-	out.Ingress = (*v1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
+	out.Ingress = (*corev1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
-	out.LastHeartbeatTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
-	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	out.LastHeartbeatTimestamp = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
+	out.Approval = (*operations.BastionApproval)(unsafe.Pointer(in.Approval))
+	out.SSHCertificate = (*operations.BastionSSHCertificate)(unsafe.Pointer(in.SSHCertificate))
 	return nil
 }
 
@@ -210,11 +280,13 @@ func Convert_v1alpha1_BastionStatus_To_operations_BastionStatus(in *BastionStatu
 }
 
 func autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operations.BastionStatus, out *BastionStatus, s conversion.Scope) error {
-	out.Ingress = (*v1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
+	out.Ingress = (*corev1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
 	out.Conditions = *(*[]v1beta1.Condition)(unsafe.Pointer(&in.Conditions))
-	out.LastHeartbeatTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
-	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	out.LastHeartbeatTimestamp = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
+	out.Approval = (*BastionApproval)(unsafe.Pointer(in.Approval))
+	out.SSHCertificate = (*BastionSSHCertificate)(unsafe.Pointer(in.SSHCertificate))
 	return nil
 }
 
@@ -23,7 +23,8 @@ package v1alpha1
 
 import (
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -55,6 +56,23 @@ func (in *Bastion) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionApproval) DeepCopyInto(out *BastionApproval) {
+	*out = *in
+	in.ApprovalTimestamp.DeepCopyInto(&out.ApprovalTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionApproval.
+func (in *BastionApproval) DeepCopy() *BastionApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionIngressPolicy) DeepCopyInto(out *BastionIngressPolicy) {
 	*out = *in
@@ -105,6 +123,23 @@ func (in *BastionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionSSHCertificate) DeepCopyInto(out *BastionSSHCertificate) {
+	*out = *in
+	in.ValidUntil.DeepCopyInto(&out.ValidUntil)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionSSHCertificate.
+func (in *BastionSSHCertificate) DeepCopy() *BastionSSHCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionSSHCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
 	*out = *in
@@ -126,6 +161,16 @@ func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ApprovalRequired != nil {
+		in, out := &in.ApprovalRequired, &out.ApprovalRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxSessionDuration != nil {
+		in, out := &in.MaxSessionDuration, &out.MaxSessionDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -144,7 +189,7 @@ func (in *BastionStatus) DeepCopyInto(out *BastionStatus) {
 	*out = *in
 	if in.Ingress != nil {
 		in, out := &in.Ingress, &out.Ingress
-		*out = new(v1.LoadBalancerIngress)
+		*out = new(corev1.LoadBalancerIngress)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Conditions != nil {
@@ -167,6 +212,16 @@ func (in *BastionStatus) DeepCopyInto(out *BastionStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(BastionApproval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSHCertificate != nil {
+		in, out := &in.SSHCertificate, &out.SSHCertificate
+		*out = new(BastionSSHCertificate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -75,6 +75,10 @@ func ValidateBastionSpec(spec *operations.BastionSpec, fldPath *field.Path) fiel
 		}
 	}
 
+	if spec.MaxSessionDuration != nil && spec.MaxSessionDuration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSessionDuration"), spec.MaxSessionDuration.Duration.String(), "maxSessionDuration must be positive"))
+	}
+
 	return allErrs
 }
 
@@ -84,6 +88,8 @@ func ValidateBastionSpecUpdate(newSpec, oldSpec *operations.BastionSpec, fldPath
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.ShootRef.Name, oldSpec.ShootRef.Name, fldPath.Child("shootRef.name"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SSHPublicKey, oldSpec.SSHPublicKey, fldPath.Child("sshPublicKey"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.ApprovalRequired, oldSpec.ApprovalRequired, fldPath.Child("approvalRequired"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.MaxSessionDuration, oldSpec.MaxSessionDuration, fldPath.Child("maxSessionDuration"))...)
 
 	return allErrs
 }
@@ -15,6 +15,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -22,6 +24,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/pkg/apis/operations"
 	. "github.com/gardener/gardener/pkg/apis/operations/validation"
@@ -149,6 +152,36 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.sshPublicKey"),
 			}))))
 		})
+
+		It("should forbid non-positive max session durations", func() {
+			bastion.Spec.MaxSessionDuration = &metav1.Duration{}
+
+			errorList := ValidateBastion(bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.maxSessionDuration"),
+			}))))
+		})
+
+		It("should forbid changing the approval requirement and max session duration", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Spec.ApprovalRequired = pointer.Bool(true)
+			newBastion.Spec.MaxSessionDuration = &metav1.Duration{Duration: time.Hour}
+
+			errorList := ValidateBastionUpdate(newBastion, bastion)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.approvalRequired"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.maxSessionDuration"),
+				})),
+			))
+		})
 	})
 })
 
@@ -149,3 +149,9 @@ func HighAvailabilityEnabled(garden *operatorv1alpha1.Garden) bool {
 func TopologyAwareRoutingEnabled(settings *operatorv1alpha1.Settings) bool {
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
 }
+
+// IsSameETCDBackupObjectStore returns true if both backup configurations refer to the same object store, i.e., only
+// their credentials may differ.
+func IsSameETCDBackupObjectStore(backup1, backup2 operatorv1alpha1.Backup) bool {
+	return backup1.Provider == backup2.Provider && backup1.BucketName == backup2.BucketName
+}
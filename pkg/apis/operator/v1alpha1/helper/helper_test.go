@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -191,6 +192,17 @@ var _ = Describe("helper", func() {
 		Entry("topology-aware routing enabled", &operatorv1alpha1.Settings{TopologyAwareRouting: &operatorv1alpha1.SettingTopologyAwareRouting{Enabled: true}}, true),
 		Entry("topology-aware routing disabled", &operatorv1alpha1.Settings{TopologyAwareRouting: &operatorv1alpha1.SettingTopologyAwareRouting{Enabled: false}}, false),
 	)
+
+	DescribeTable("#IsSameETCDBackupObjectStore",
+		func(backup operatorv1alpha1.Backup, expected bool) {
+			Expect(IsSameETCDBackupObjectStore(operatorv1alpha1.Backup{Provider: "local", BucketName: "bucket", SecretRef: corev1.LocalObjectReference{Name: "secret"}}, backup)).To(Equal(expected))
+		},
+
+		Entry("same object store", operatorv1alpha1.Backup{Provider: "local", BucketName: "bucket", SecretRef: corev1.LocalObjectReference{Name: "secret"}}, true),
+		Entry("same object store with other credentials", operatorv1alpha1.Backup{Provider: "local", BucketName: "bucket", SecretRef: corev1.LocalObjectReference{Name: "other"}}, true),
+		Entry("other bucket", operatorv1alpha1.Backup{Provider: "local", BucketName: "other", SecretRef: corev1.LocalObjectReference{Name: "secret"}}, false),
+		Entry("other provider", operatorv1alpha1.Backup{Provider: "other", BucketName: "bucket", SecretRef: corev1.LocalObjectReference{Name: "secret"}}, false),
+	)
 })
 
 func timePointer(t time.Time) *metav1.Time {
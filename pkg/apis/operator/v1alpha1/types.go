@@ -24,6 +24,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -80,6 +81,20 @@ type RuntimeCluster struct {
 	// Settings contains certain settings for this cluster.
 	// +optional
 	Settings *Settings `json:"settings,omitempty"`
+	// Seed contains configuration for registering the runtime cluster as seed cluster. If set, gardener-operator deploys
+	// gardenlet into the runtime cluster which then registers the seed in the virtual garden cluster.
+	// +optional
+	Seed *RuntimeSeed `json:"seed,omitempty"`
+}
+
+// RuntimeSeed contains configuration for registering the runtime cluster as seed cluster.
+type RuntimeSeed struct {
+	// Deployment specifies certain gardenlet deployment parameters, such as the number of replicas, the image, etc.
+	// +optional
+	Deployment *seedmanagementv1alpha1.GardenletDeployment `json:"deployment,omitempty"`
+	// Config is the GardenletConfiguration used to configure gardenlet. It must contain the configuration of the seed
+	// (`.seedConfig`). The name of the seed is always the name of the Garden and must not be specified.
+	Config runtime.RawExtension `json:"config"`
 }
 
 // RuntimeNetworking defines the networking configuration of the runtime cluster.
@@ -225,11 +240,10 @@ type Storage struct {
 
 // Backup contains the object store configuration for backups for the virtual garden etcd.
 type Backup struct {
-	// Provider is a provider name. This field is immutable.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Provider is immutable"
+	// Provider is a provider name. Changing this field triggers a migration of the backups to the new object store.
 	Provider string `json:"provider"`
-	// BucketName is the name of the backup bucket.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="BucketName is immutable"
+	// BucketName is the name of the backup bucket. Changing this field triggers a migration of the backups to the new
+	// bucket.
 	BucketName string `json:"bucketName"`
 	// SecretRef is a reference to a Secret object containing the cloud provider credentials for the object store where
 	// backups should be stored. It should have enough privileges to manipulate the objects as well as buckets.
@@ -240,6 +254,26 @@ type Backup struct {
 type Maintenance struct {
 	// TimeWindow contains information about the time window for maintenance operations.
 	TimeWindow gardencorev1beta1.MaintenanceTimeWindow `json:"timeWindow"`
+	// AutoUpdate contains information about which constraints should be automatically updated.
+	// +optional
+	AutoUpdate *MaintenanceAutoUpdate `json:"autoUpdate,omitempty"`
+}
+
+// MaintenanceAutoUpdate contains information about which constraints should be automatically updated.
+type MaintenanceAutoUpdate struct {
+	// KubernetesVersion indicates whether the patch Kubernetes version of the virtual garden cluster may be automatically
+	// updated to the latest allowed patch version of the same minor version during the maintenance time window.
+	// +optional
+	KubernetesVersion bool `json:"kubernetesVersion,omitempty"`
+	// KubernetesVersions is the list of Kubernetes versions the virtual garden cluster may be automatically updated to.
+	// The highest patch version of the currently used minor version is chosen.
+	// +optional
+	KubernetesVersions []string `json:"kubernetesVersions,omitempty"`
+	// CredentialsRotationCompletion indicates whether credentials rotations (certificate authorities, service account
+	// signing key, ETCD encryption key) which are in phase 'Prepared' may be automatically completed during a
+	// maintenance time window that begins after the preparation has finished.
+	// +optional
+	CredentialsRotationCompletion bool `json:"credentialsRotationCompletion,omitempty"`
 }
 
 // ControlPlane holds information about the general settings for the control plane of the virtual garden cluster.
@@ -525,8 +559,54 @@ type GardenStatus struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/concepts/operator.md#etcd-encryption-config for more details.
 	// +optional
 	EncryptedResources []string `json:"encryptedResources,omitempty"`
+	// LastMaintenance holds information about the last maintenance operations on the Garden.
+	// +optional
+	LastMaintenance *gardencorev1beta1.LastMaintenance `json:"lastMaintenance,omitempty"`
+	// ETCDBackup contains information about the backup of the virtual garden's main ETCD.
+	// +optional
+	ETCDBackup *ETCDBackupStatus `json:"etcdBackup,omitempty"`
 }
 
+// ETCDBackupStatus contains information about the backup of the virtual garden's main ETCD.
+type ETCDBackupStatus struct {
+	// Backup is the object store configuration which is currently used by the virtual garden's main ETCD.
+	Backup Backup `json:"backup"`
+	// Migration contains information about the migration of the backups to a new object store.
+	// +optional
+	Migration *ETCDBackupMigration `json:"migration,omitempty"`
+}
+
+// ETCDBackupMigration contains information about the migration of the backups of the virtual garden's main ETCD to a
+// new object store.
+type ETCDBackupMigration struct {
+	// Phase is the phase of the migration.
+	Phase ETCDBackupMigrationPhase `json:"phase"`
+	// Source is the object store configuration from which the backups are migrated.
+	Source Backup `json:"source"`
+	// StartTime is the time when the migration was started.
+	StartTime metav1.Time `json:"startTime"`
+	// CompletionTime is the time when the main ETCD was switched to the new object store.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// SourceRetainedUntil is the time until which the source object store is retained (i.e., its secret is still
+	// referenced) after the migration was completed.
+	// +optional
+	SourceRetainedUntil *metav1.Time `json:"sourceRetainedUntil,omitempty"`
+}
+
+// ETCDBackupMigrationPhase is a string alias.
+type ETCDBackupMigrationPhase string
+
+const (
+	// ETCDBackupMigrationInProgress is a constant for the 'InProgress' phase of an ETCD backup migration. In this phase,
+	// a full snapshot is taken and the backups are copied from the source to the new object store.
+	ETCDBackupMigrationInProgress ETCDBackupMigrationPhase = "InProgress"
+	// ETCDBackupMigrationCompleted is a constant for the 'Completed' phase of an ETCD backup migration. In this phase,
+	// the main ETCD uses the new object store and the source object store is retained until the retention period has
+	// passed.
+	ETCDBackupMigrationCompleted ETCDBackupMigrationPhase = "Completed"
+)
+
 // Credentials contains information about the virtual garden cluster credentials.
 type Credentials struct {
 	// Rotation contains information about the credential rotations.
@@ -559,6 +639,22 @@ const (
 	VirtualGardenAPIServerAvailable gardencorev1beta1.ConditionType = "VirtualGardenAPIServerAvailable"
 	// ObservabilityComponentsHealthy is a constant for a condition type indicating the health of observability components.
 	ObservabilityComponentsHealthy gardencorev1beta1.ConditionType = "ObservabilityComponentsHealthy"
+	// GardenerAPIServerHealthy is a constant for a condition type indicating the health of the gardener-apiserver.
+	GardenerAPIServerHealthy gardencorev1beta1.ConditionType = "GardenerAPIServerHealthy"
+	// GardenerAdmissionControllerHealthy is a constant for a condition type indicating the health of the
+	// gardener-admission-controller.
+	GardenerAdmissionControllerHealthy gardencorev1beta1.ConditionType = "GardenerAdmissionControllerHealthy"
+	// GardenerControllerManagerHealthy is a constant for a condition type indicating the health of the
+	// gardener-controller-manager.
+	GardenerControllerManagerHealthy gardencorev1beta1.ConditionType = "GardenerControllerManagerHealthy"
+	// GardenerSchedulerHealthy is a constant for a condition type indicating the health of the gardener-scheduler.
+	GardenerSchedulerHealthy gardencorev1beta1.ConditionType = "GardenerSchedulerHealthy"
+	// VirtualGardenAPIServicesAvailable is a constant for a condition type indicating whether the aggregated APIs
+	// registered in the virtual garden's API server are available.
+	VirtualGardenAPIServicesAvailable gardencorev1beta1.ConditionType = "VirtualGardenAPIServicesAvailable"
+	// SeedReady is a constant for a condition type indicating whether the seed registered by the gardenlet deployed
+	// into the runtime cluster is ready.
+	SeedReady gardencorev1beta1.ConditionType = "SeedReady"
 )
 
 // AvailableOperationAnnotations is the set of available operation annotations for Garden resources.
@@ -575,5 +671,10 @@ var AvailableOperationAnnotations = sets.New(
 	v1beta1constants.OperationRotateCredentialsComplete,
 )
 
-// FinalizerName is the name of the finalizer used by gardener-operator.
-const FinalizerName = "gardener.cloud/operator"
+const (
+	// FinalizerName is the name of the finalizer used by gardener-operator.
+	FinalizerName = "gardener.cloud/operator"
+	// FinalizerNameGardenlet is the name of the finalizer used by gardener-operator as long as gardenlet is deployed
+	// into the runtime cluster.
+	FinalizerNameGardenlet = "gardener.cloud/operator-gardenlet"
+)
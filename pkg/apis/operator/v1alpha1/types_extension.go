@@ -0,0 +1,140 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Cluster,shortName="extop"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Installed",type=string,JSONPath=`.status.conditions[?(@.type=="Installed")].status`,description="Indicates whether the extension has been installed."
+// +kubebuilder:printcolumn:name="Healthy",type=string,JSONPath=`.status.conditions[?(@.type=="Healthy")].status`,description="Indicates whether the components of the extension are healthy."
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="creation timestamp"
+
+// Extension describes a Gardener extension.
+type Extension struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec contains the specification of this extension.
+	Spec ExtensionSpec `json:"spec,omitempty"`
+	// Status contains the status of this extension.
+	Status ExtensionStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ExtensionList is a list of Extension resources.
+type ExtensionList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of Extension.
+	Items []Extension `json:"items"`
+}
+
+// ExtensionSpec contains the specification of a Gardener extension.
+type ExtensionSpec struct {
+	// Resources is a list of combinations of kinds (DNSRecord, Backupbucket, ...) and their actual types
+	// (aws-route53, gcp, auditlog, ...). They are written to the ControllerRegistration in the virtual garden cluster.
+	// +optional
+	Resources []gardencorev1beta1.ControllerResource `json:"resources,omitempty"`
+	// Deployment contains deployment configuration for the extension and its admission controller.
+	// +optional
+	Deployment *Deployment `json:"deployment,omitempty"`
+}
+
+// Deployment specifies how an extension and its admission controller can be installed.
+type Deployment struct {
+	// ExtensionDeployment contains the deployment configuration of the extension. It is written to the
+	// ControllerDeployment in the virtual garden cluster and installed into seeds by gardenlet.
+	// +optional
+	ExtensionDeployment *ExtensionDeploymentSpec `json:"extension,omitempty"`
+	// AdmissionDeployment contains the deployment configuration of the admission controller belonging to the
+	// extension.
+	// +optional
+	AdmissionDeployment *AdmissionDeploymentSpec `json:"admission,omitempty"`
+}
+
+// ExtensionDeploymentSpec specifies how to install the extension in seed clusters.
+type ExtensionDeploymentSpec struct {
+	// DeploymentSpec is the deployment configuration for the extension.
+	DeploymentSpec `json:",inline"`
+	// Policy controls how the controller is deployed. It defaults to 'OnDemand'.
+	// +kubebuilder:validation:Enum=OnDemand;Always;AlwaysExceptNoShoots
+	// +optional
+	Policy *gardencorev1beta1.ControllerDeploymentPolicy `json:"policy,omitempty"`
+	// SeedSelector contains an optional label selector for seeds. Only if the labels match then this controller will be
+	// considered for a deployment. An empty list means that all seeds are selected.
+	// +optional
+	SeedSelector *metav1.LabelSelector `json:"seedSelector,omitempty"`
+}
+
+// AdmissionDeploymentSpec contains the deployment configuration of the admission controller belonging to an
+// extension.
+type AdmissionDeploymentSpec struct {
+	// RuntimeCluster is the deployment configuration for the admission controller in the runtime cluster. Typically,
+	// it contains the admission controller's Deployment and the resources required to run it.
+	// +optional
+	RuntimeCluster *DeploymentSpec `json:"runtimeCluster,omitempty"`
+	// VirtualCluster is the deployment configuration for the admission controller in the virtual garden cluster.
+	// Typically, it contains the webhook configurations and the RBAC resources required by the admission controller.
+	// +optional
+	VirtualCluster *DeploymentSpec `json:"virtualCluster,omitempty"`
+}
+
+// DeploymentSpec is the specification for the deployment of a component.
+type DeploymentSpec struct {
+	// Helm contains the specification for a Helm deployment.
+	Helm *ExtensionHelm `json:"helm,omitempty"`
+}
+
+// ExtensionHelm is the configuration for a Helm deployment.
+type ExtensionHelm struct {
+	// RawChart is the base64-encoded, gzip'ed, tar'ed extension controller chart.
+	// +optional
+	RawChart []byte `json:"rawChart,omitempty"`
+	// Values are the chart values.
+	// +optional
+	Values *apiextensionsv1.JSON `json:"values,omitempty"`
+}
+
+// ExtensionStatus is the status of a Gardener extension.
+type ExtensionStatus struct {
+	// ObservedGeneration is the most recent generation observed for this resource.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions represents the latest available observations of an Extension's current state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +optional
+	Conditions []gardencorev1beta1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+const (
+	// ExtensionInstalled is a constant for a condition type indicating that all resources of the extension (the
+	// ControllerRegistration and ControllerDeployment in the virtual garden cluster as well as the admission
+	// controller) have been applied.
+	ExtensionInstalled gardencorev1beta1.ConditionType = "Installed"
+	// ExtensionHealthy is a constant for a condition type indicating the health of the extension's admission
+	// controller and of its ControllerInstallations.
+	ExtensionHealthy gardencorev1beta1.ConditionType = "Healthy"
+)
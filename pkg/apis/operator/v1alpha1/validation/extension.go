@@ -0,0 +1,118 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+)
+
+// ValidateExtension contains functionality for performing extended validation of an Extension object which is not
+// possible with standard CRD validation.
+func ValidateExtension(extension *operatorv1alpha1.Extension) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	specPath := field.NewPath("spec")
+
+	// The resources are written to the ControllerRegistration in the virtual garden cluster, hence they are validated
+	// like the ones of a ControllerRegistration.
+	registrationSpec, err := convertToControllerRegistrationSpec(extension)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("resources"), extension.Spec.Resources, fmt.Sprintf("could not convert resources: %v", err)))
+	} else {
+		allErrs = append(allErrs, gardencorevalidation.ValidateControllerRegistrationSpec(registrationSpec, specPath)...)
+	}
+
+	if deployment := extension.Spec.Deployment; deployment != nil {
+		allErrs = append(allErrs, validateExtensionDeployment(deployment, specPath.Child("deployment"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateExtensionUpdate contains functionality for performing extended validation of an Extension object under
+// update which is not possible with standard CRD validation.
+func ValidateExtensionUpdate(oldExtension, newExtension *operatorv1alpha1.Extension) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	oldRegistrationSpec, oldErr := convertToControllerRegistrationSpec(oldExtension)
+	newRegistrationSpec, newErr := convertToControllerRegistrationSpec(newExtension)
+	if oldErr == nil && newErr == nil {
+		allErrs = append(allErrs, gardencorevalidation.ValidateControllerRegistrationSpecUpdate(newRegistrationSpec, oldRegistrationSpec, newExtension.DeletionTimestamp != nil, field.NewPath("spec"))...)
+	}
+
+	allErrs = append(allErrs, ValidateExtension(newExtension)...)
+
+	return allErrs
+}
+
+func convertToControllerRegistrationSpec(extension *operatorv1alpha1.Extension) (*gardencore.ControllerRegistrationSpec, error) {
+	spec := &gardencore.ControllerRegistrationSpec{}
+	if err := gardenCoreScheme.Convert(&gardencorev1beta1.ControllerRegistrationSpec{Resources: extension.Spec.Resources}, spec, nil); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func validateExtensionDeployment(deployment *operatorv1alpha1.Deployment, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if extensionDeployment := deployment.ExtensionDeployment; extensionDeployment != nil {
+		extensionPath := fldPath.Child("extension")
+
+		allErrs = append(allErrs, validateDeploymentSpec(extensionDeployment.DeploymentSpec, extensionPath)...)
+
+		if extensionDeployment.SeedSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(extensionDeployment.SeedSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, extensionPath.Child("seedSelector"))...)
+		}
+	}
+
+	if admissionDeployment := deployment.AdmissionDeployment; admissionDeployment != nil {
+		admissionPath := fldPath.Child("admission")
+
+		if admissionDeployment.RuntimeCluster == nil && admissionDeployment.VirtualCluster == nil {
+			allErrs = append(allErrs, field.Required(admissionPath, "at least one of runtimeCluster or virtualCluster must be set"))
+		}
+		if admissionDeployment.RuntimeCluster != nil {
+			allErrs = append(allErrs, validateDeploymentSpec(*admissionDeployment.RuntimeCluster, admissionPath.Child("runtimeCluster"))...)
+		}
+		if admissionDeployment.VirtualCluster != nil {
+			allErrs = append(allErrs, validateDeploymentSpec(*admissionDeployment.VirtualCluster, admissionPath.Child("virtualCluster"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateDeploymentSpec(deploymentSpec operatorv1alpha1.DeploymentSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if deploymentSpec.Helm == nil {
+		return append(allErrs, field.Required(fldPath.Child("helm"), "helm deployment must be specified"))
+	}
+
+	if len(deploymentSpec.Helm.RawChart) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("helm", "rawChart"), "helm chart must be specified"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,197 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	. "github.com/gardener/gardener/pkg/apis/operator/v1alpha1/validation"
+)
+
+var _ = Describe("Extension Validation Tests", func() {
+	var extension *operatorv1alpha1.Extension
+
+	BeforeEach(func() {
+		extension = &operatorv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{Name: "extension-foo"},
+			Spec: operatorv1alpha1.ExtensionSpec{
+				Resources: []gardencorev1beta1.ControllerResource{
+					{Kind: "Infrastructure", Type: "foo"},
+					{Kind: "Extension", Type: "bar", GloballyEnabled: pointer.Bool(true)},
+				},
+				Deployment: &operatorv1alpha1.Deployment{
+					ExtensionDeployment: &operatorv1alpha1.ExtensionDeploymentSpec{
+						DeploymentSpec: operatorv1alpha1.DeploymentSpec{
+							Helm: &operatorv1alpha1.ExtensionHelm{RawChart: []byte("chart")},
+						},
+					},
+					AdmissionDeployment: &operatorv1alpha1.AdmissionDeploymentSpec{
+						RuntimeCluster: &operatorv1alpha1.DeploymentSpec{Helm: &operatorv1alpha1.ExtensionHelm{RawChart: []byte("runtime")}},
+						VirtualCluster: &operatorv1alpha1.DeploymentSpec{Helm: &operatorv1alpha1.ExtensionHelm{RawChart: []byte("virtual")}},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateExtension", func() {
+		It("should allow a valid extension", func() {
+			Expect(ValidateExtension(extension)).To(BeEmpty())
+		})
+
+		It("should allow an extension without deployment", func() {
+			extension.Spec.Deployment = nil
+
+			Expect(ValidateExtension(extension)).To(BeEmpty())
+		})
+
+		It("should forbid resources with empty kind or type", func() {
+			extension.Spec.Resources = []gardencorev1beta1.ControllerResource{{Kind: "Infrastructure"}, {Type: "foo"}}
+
+			Expect(ValidateExtension(extension)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.resources[0].type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.resources[1].kind"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.resources[1].kind"),
+				})),
+			))
+		})
+
+		It("should forbid duplicate resources", func() {
+			extension.Spec.Resources = append(extension.Spec.Resources, gardencorev1beta1.ControllerResource{Kind: "Infrastructure", Type: "foo"})
+
+			Expect(ValidateExtension(extension)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.resources[2]"),
+				})),
+			))
+		})
+
+		It("should forbid fields which are only allowed for kind Extension", func() {
+			extension.Spec.Resources[0].GloballyEnabled = pointer.Bool(true)
+
+			Expect(ValidateExtension(extension)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.resources[0].globallyEnabled"),
+				})),
+			))
+		})
+
+		It("should require a helm chart for the extension deployment", func() {
+			extension.Spec.Deployment.ExtensionDeployment.Helm = nil
+
+			Expect(ValidateExtension(extension)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.deployment.extension.helm"),
+				})),
+			))
+		})
+
+		It("should require a raw chart for the helm deployments", func() {
+			extension.Spec.Deployment.ExtensionDeployment.Helm.RawChart = nil
+			extension.Spec.Deployment.AdmissionDeployment.RuntimeCluster.Helm.RawChart = nil
+			extension.Spec.Deployment.AdmissionDeployment.VirtualCluster.Helm = nil
+
+			Expect(ValidateExtension(extension)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.deployment.extension.helm.rawChart"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.deployment.admission.runtimeCluster.helm.rawChart"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.deployment.admission.virtualCluster.helm"),
+				})),
+			))
+		})
+
+		It("should require at least one admission deployment", func() {
+			extension.Spec.Deployment.AdmissionDeployment = &operatorv1alpha1.AdmissionDeploymentSpec{}
+
+			Expect(ValidateExtension(extension)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.deployment.admission"),
+				})),
+			))
+		})
+
+		It("should forbid invalid seed selectors", func() {
+			extension.Spec.Deployment.ExtensionDeployment.SeedSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "invalid"}},
+			}
+
+			Expect(ValidateExtension(extension)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.deployment.extension.seedSelector.matchExpressions[0].operator"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateExtensionUpdate", func() {
+		It("should allow valid updates", func() {
+			newExtension := extension.DeepCopy()
+			newExtension.Spec.Resources = append(newExtension.Spec.Resources, gardencorev1beta1.ControllerResource{Kind: "Worker", Type: "foo"})
+
+			Expect(ValidateExtensionUpdate(extension, newExtension)).To(BeEmpty())
+		})
+
+		It("should forbid changing the primary field of a resource", func() {
+			newExtension := extension.DeepCopy()
+			newExtension.Spec.Resources[0].Primary = pointer.Bool(false)
+
+			Expect(ValidateExtensionUpdate(extension, newExtension)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.resources[0].primary"),
+				})),
+			))
+		})
+
+		It("should validate the new extension", func() {
+			newExtension := extension.DeepCopy()
+			newExtension.Spec.Deployment.ExtensionDeployment.Helm = nil
+
+			Expect(ValidateExtensionUpdate(extension, newExtension)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.deployment.extension.helm"),
+				})),
+			))
+		})
+	})
+})
@@ -19,6 +19,7 @@ import (
 	"net"
 	"strings"
 
+	"github.com/Masterminds/semver/v3"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
@@ -39,8 +40,14 @@ import (
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 	operatorv1alpha1conversion "github.com/gardener/gardener/pkg/apis/operator/v1alpha1/conversion"
 	"github.com/gardener/gardener/pkg/apis/operator/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement/encoding"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	seedmanagementvalidation "github.com/gardener/gardener/pkg/apis/seedmanagement/validation"
 	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/features"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
+	gardenletvalidation "github.com/gardener/gardener/pkg/gardenlet/apis/config/validation"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
@@ -109,10 +116,49 @@ func validateVirtualClusterUpdate(oldGarden, newGarden *operatorv1alpha1.Garden)
 
 	allErrs = append(allErrs, gardencorevalidation.ValidateKubernetesVersionUpdate(newVirtualCluster.Kubernetes.Version, oldVirtualCluster.Kubernetes.Version, fldPath.Child("kubernetes", "version"))...)
 	allErrs = append(allErrs, validateEncryptionConfigUpdate(oldGarden, newGarden)...)
+	allErrs = append(allErrs, validateETCDBackupUpdate(oldGarden, newGarden)...)
 
 	return allErrs
 }
 
+func validateETCDBackupUpdate(oldGarden, newGarden *operatorv1alpha1.Garden) field.ErrorList {
+	var (
+		allErrs   = field.ErrorList{}
+		oldBackup = getMainETCDBackup(oldGarden)
+		newBackup = getMainETCDBackup(newGarden)
+		fldPath   = field.NewPath("spec", "virtualCluster", "etcd", "main", "backup")
+	)
+
+	if oldBackup == nil || apiequality.Semantic.DeepEqual(oldBackup, newBackup) {
+		return allErrs
+	}
+
+	// While a migration is in progress, the backup configuration may only be reverted to the object store which is
+	// still used by the main ETCD. This aborts the migration.
+	if etcdBackup := oldGarden.Status.ETCDBackup; etcdBackup != nil && etcdBackup.Migration != nil && etcdBackup.Migration.Phase == operatorv1alpha1.ETCDBackupMigrationInProgress &&
+		(newBackup == nil || !helper.IsSameETCDBackupObjectStore(*newBackup, etcdBackup.Backup)) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "the backup configuration cannot be changed while a migration to a new object store is in progress, it can only be reverted to the object store in status.etcdBackup.backup to abort the migration"))
+	}
+
+	if newBackup == nil {
+		return allErrs
+	}
+
+	// The credentials of one provider cannot be valid for another provider.
+	if newBackup.Provider != oldBackup.Provider && newBackup.SecretRef.Name == oldBackup.SecretRef.Name {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("secretRef", "name"), newBackup.SecretRef.Name, "secret must be changed together with the provider"))
+	}
+
+	return allErrs
+}
+
+func getMainETCDBackup(garden *operatorv1alpha1.Garden) *operatorv1alpha1.Backup {
+	if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Main != nil {
+		return etcd.Main.Backup
+	}
+	return nil
+}
+
 func validateRuntimeCluster(runtimeCluster operatorv1alpha1.RuntimeCluster, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -128,6 +174,54 @@ func validateRuntimeCluster(runtimeCluster operatorv1alpha1.RuntimeCluster, fldP
 		}
 	}
 
+	if runtimeCluster.Seed != nil {
+		allErrs = append(allErrs, validateRuntimeSeed(runtimeCluster.Seed, fldPath.Child("seed"))...)
+	}
+
+	return allErrs
+}
+
+func validateRuntimeSeed(seed *operatorv1alpha1.RuntimeSeed, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if seed.Deployment != nil {
+		deployment := &seedmanagement.GardenletDeployment{}
+		if err := seedmanagementv1alpha1.Convert_v1alpha1_GardenletDeployment_To_seedmanagement_GardenletDeployment(seed.Deployment, deployment, nil); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("deployment"), seed.Deployment, fmt.Sprintf("could not convert gardenlet deployment: %v", err)))
+		} else {
+			allErrs = append(allErrs, seedmanagementvalidation.ValidateGardenletDeployment(deployment, fldPath.Child("deployment"))...)
+		}
+	}
+
+	configPath := fldPath.Child("config")
+
+	gardenletConfigExternal, err := encoding.DecodeGardenletConfiguration(&seed.Config, false)
+	if err != nil {
+		return append(allErrs, field.Invalid(configPath, string(seed.Config.Raw), fmt.Sprintf("could not decode gardenlet config: %v", err)))
+	}
+
+	gardenletConfig, err := gardenlethelper.ConvertGardenletConfiguration(gardenletConfigExternal)
+	if err != nil {
+		return append(allErrs, field.Invalid(configPath, string(seed.Config.Raw), fmt.Sprintf("could not convert gardenlet config: %v", err)))
+	}
+
+	// The name of the seed is always the name of the Garden, hence it must not be specified.
+	if gardenletConfig.SeedConfig != nil && gardenletConfig.SeedConfig.Name != "" {
+		allErrs = append(allErrs, field.Forbidden(configPath.Child("seedConfig", "metadata", "name"), "seed name is forbidden"))
+	}
+
+	// The garden client connection is always bootstrapped by gardener-operator.
+	if gcc := gardenletConfig.GardenClientConnection; gcc != nil {
+		if gcc.Kubeconfig != "" {
+			allErrs = append(allErrs, field.Forbidden(configPath.Child("gardenClientConnection", "kubeconfig"), "kubeconfig is forbidden"))
+		}
+		if gcc.BootstrapKubeconfig != nil {
+			allErrs = append(allErrs, field.Forbidden(configPath.Child("gardenClientConnection", "bootstrapKubeconfig"), "bootstrap kubeconfig is forbidden"))
+		}
+	}
+
+	allErrs = append(allErrs, gardenletvalidation.ValidateGardenletConfiguration(gardenletConfig, configPath, false)...)
+
 	return allErrs
 }
 
@@ -147,6 +241,10 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("kubernetes", "version"), virtualCluster.Kubernetes.Version, kubernetesversion.SupportedVersions))
 	}
 
+	if autoUpdate := virtualCluster.Maintenance.AutoUpdate; autoUpdate != nil {
+		allErrs = append(allErrs, validateMaintenanceAutoUpdate(autoUpdate, fldPath.Child("maintenance", "autoUpdate"))...)
+	}
+
 	if kubeAPIServer := virtualCluster.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.KubeAPIServerConfig != nil {
 		path := fldPath.Child("kubernetes", "kubeAPIServer")
 
@@ -187,6 +285,33 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 	return allErrs
 }
 
+func validateMaintenanceAutoUpdate(autoUpdate *operatorv1alpha1.MaintenanceAutoUpdate, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if autoUpdate.KubernetesVersion && len(autoUpdate.KubernetesVersions) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("kubernetesVersions"), "at least one version must be specified when automatic Kubernetes version updates are enabled"))
+	}
+
+	versions := sets.New[string]()
+	for i, version := range autoUpdate.KubernetesVersions {
+		idxPath := fldPath.Child("kubernetesVersions").Index(i)
+
+		if _, err := semver.StrictNewVersion(version); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, version, fmt.Sprintf("must be a semantic version: %v", err)))
+			continue
+		}
+		if err := kubernetesversion.CheckIfSupported(version); err != nil {
+			allErrs = append(allErrs, field.NotSupported(idxPath, version, kubernetesversion.SupportedVersions))
+		}
+		if versions.Has(version) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, version))
+		}
+		versions.Insert(version)
+	}
+
+	return allErrs
+}
+
 func validateGardener(config operatorv1alpha1.Gardener, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
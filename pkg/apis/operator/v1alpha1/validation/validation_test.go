@@ -16,6 +16,7 @@ package validation_test
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
@@ -27,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/component-base/featuregate"
@@ -35,6 +37,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 	. "github.com/gardener/gardener/pkg/apis/operator/v1alpha1/validation"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	"github.com/gardener/gardener/pkg/features"
 )
 
@@ -803,6 +806,72 @@ var _ = Describe("Validation Tests", func() {
 				})
 			})
 
+			Context("seed", func() {
+				seedConfig := func(extra string) runtime.RawExtension {
+					return runtime.RawExtension{Raw: []byte(`{"apiVersion":"gardenlet.config.gardener.cloud/v1alpha1","kind":"GardenletConfiguration",` + extra + `"seedConfig":{"spec":{"provider":{"type":"foo","region":"bar"},"networks":{"pods":"100.96.0.0/11","services":"100.64.0.0/13"}}}}`)}
+				}
+
+				It("should allow a valid seed configuration", func() {
+					garden.Spec.RuntimeCluster.Seed = &operatorv1alpha1.RuntimeSeed{
+						Deployment: &seedmanagementv1alpha1.GardenletDeployment{ReplicaCount: pointer.Int32(1)},
+						Config:     seedConfig(""),
+					}
+
+					Expect(ValidateGarden(garden)).To(BeEmpty())
+				})
+
+				It("should complain when the gardenlet config cannot be decoded", func() {
+					garden.Spec.RuntimeCluster.Seed = &operatorv1alpha1.RuntimeSeed{Config: runtime.RawExtension{Raw: []byte(`{"foo`)}}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.runtimeCluster.seed.config"),
+					}))))
+				})
+
+				It("should complain when the seed config is missing", func() {
+					garden.Spec.RuntimeCluster.Seed = &operatorv1alpha1.RuntimeSeed{Config: runtime.RawExtension{Raw: []byte(`{"apiVersion":"gardenlet.config.gardener.cloud/v1alpha1","kind":"GardenletConfiguration"}`)}}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.runtimeCluster.seed.config.seedConfig"),
+					}))))
+				})
+
+				It("should forbid specifying the seed name or garden client kubeconfigs", func() {
+					raw := seedConfig(`"gardenClientConnection":{"kubeconfig":"/foo","bootstrapKubeconfig":{"name":"foo"}},`)
+					raw.Raw = []byte(strings.Replace(string(raw.Raw), `"seedConfig":{`, `"seedConfig":{"metadata":{"name":"foo"},`, 1))
+					garden.Spec.RuntimeCluster.Seed = &operatorv1alpha1.RuntimeSeed{Config: raw}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.runtimeCluster.seed.config.seedConfig.metadata.name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.runtimeCluster.seed.config.gardenClientConnection.kubeconfig"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.runtimeCluster.seed.config.gardenClientConnection.bootstrapKubeconfig"),
+						})),
+					))
+				})
+
+				It("should complain about an invalid gardenlet deployment", func() {
+					garden.Spec.RuntimeCluster.Seed = &operatorv1alpha1.RuntimeSeed{
+						Deployment: &seedmanagementv1alpha1.GardenletDeployment{ReplicaCount: pointer.Int32(-1)},
+						Config:     seedConfig(""),
+					}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.runtimeCluster.seed.deployment.replicaCount"),
+					}))))
+				})
+			})
+
 			Context("topology-aware routing field", func() {
 				It("should prevent enabling topology-aware routing on single-zone cluster", func() {
 					garden.Spec.RuntimeCluster.Provider.Zones = []string{"a"}
@@ -921,6 +990,48 @@ var _ = Describe("Validation Tests", func() {
 				})
 			})
 
+			Context("Maintenance", func() {
+				It("should allow a valid auto update configuration", func() {
+					garden.Spec.VirtualCluster.Maintenance.AutoUpdate = &operatorv1alpha1.MaintenanceAutoUpdate{
+						KubernetesVersion:             true,
+						KubernetesVersions:            []string{"1.26.3", "1.26.5", "1.27.2"},
+						CredentialsRotationCompletion: true,
+					}
+
+					Expect(ValidateGarden(garden)).To(BeEmpty())
+				})
+
+				It("should complain when auto updates are enabled but no versions are specified", func() {
+					garden.Spec.VirtualCluster.Maintenance.AutoUpdate = &operatorv1alpha1.MaintenanceAutoUpdate{KubernetesVersion: true}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.virtualCluster.maintenance.autoUpdate.kubernetesVersions"),
+					}))))
+				})
+
+				It("should complain about invalid, unsupported or duplicate versions", func() {
+					garden.Spec.VirtualCluster.Maintenance.AutoUpdate = &operatorv1alpha1.MaintenanceAutoUpdate{
+						KubernetesVersions: []string{"1.26", "1.10.0", "1.26.5", "1.26.5"},
+					}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.virtualCluster.maintenance.autoUpdate.kubernetesVersions[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.virtualCluster.maintenance.autoUpdate.kubernetesVersions[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.virtualCluster.maintenance.autoUpdate.kubernetesVersions[3]"),
+						})),
+					))
+				})
+			})
+
 			Context("Gardener", func() {
 				Context("APIServer", func() {
 					BeforeEach(func() {
@@ -1678,6 +1789,102 @@ var _ = Describe("Validation Tests", func() {
 				})
 			})
 
+			Context("etcd backup", func() {
+				BeforeEach(func() {
+					oldGarden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+						Main: &operatorv1alpha1.ETCDMain{
+							Backup: &operatorv1alpha1.Backup{
+								Provider:   "provider",
+								BucketName: "bucket",
+								SecretRef:  corev1.LocalObjectReference{Name: "secret"},
+							},
+						},
+					}
+					newGarden = oldGarden.DeepCopy()
+				})
+
+				It("should allow changing the backup configuration", func() {
+					newGarden.Spec.VirtualCluster.ETCD.Main.Backup.Provider = "other-provider"
+					newGarden.Spec.VirtualCluster.ETCD.Main.Backup.BucketName = "other-bucket"
+					newGarden.Spec.VirtualCluster.ETCD.Main.Backup.SecretRef.Name = "other-secret"
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(BeEmpty())
+				})
+
+				It("should allow changing the backup configuration when the last migration is completed", func() {
+					oldGarden.Status.ETCDBackup = &operatorv1alpha1.ETCDBackupStatus{
+						Backup:    *oldGarden.Spec.VirtualCluster.ETCD.Main.Backup,
+						Migration: &operatorv1alpha1.ETCDBackupMigration{Phase: operatorv1alpha1.ETCDBackupMigrationCompleted},
+					}
+					newGarden.Spec.VirtualCluster.ETCD.Main.Backup.BucketName = "other-bucket"
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(BeEmpty())
+				})
+
+				It("should forbid changing the backup configuration while a migration is in progress", func() {
+					oldGarden.Status.ETCDBackup = &operatorv1alpha1.ETCDBackupStatus{
+						Backup:    *oldGarden.Spec.VirtualCluster.ETCD.Main.Backup,
+						Migration: &operatorv1alpha1.ETCDBackupMigration{Phase: operatorv1alpha1.ETCDBackupMigrationInProgress},
+					}
+					newGarden.Spec.VirtualCluster.ETCD.Main.Backup.BucketName = "other-bucket"
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.virtualCluster.etcd.main.backup"),
+					}))))
+				})
+
+				Context("migration in progress", func() {
+					BeforeEach(func() {
+						oldGarden.Status.ETCDBackup = &operatorv1alpha1.ETCDBackupStatus{
+							Backup: operatorv1alpha1.Backup{
+								Provider:   "source-provider",
+								BucketName: "source-bucket",
+								SecretRef:  corev1.LocalObjectReference{Name: "source-secret"},
+							},
+							Migration: &operatorv1alpha1.ETCDBackupMigration{Phase: operatorv1alpha1.ETCDBackupMigrationInProgress},
+						}
+					})
+
+					It("should allow reverting the backup configuration to abort the migration", func() {
+						newGarden.Spec.VirtualCluster.ETCD.Main.Backup = oldGarden.Status.ETCDBackup.Backup.DeepCopy()
+
+						Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(BeEmpty())
+					})
+
+					It("should allow reverting the object store with other credentials to abort the migration", func() {
+						newGarden.Spec.VirtualCluster.ETCD.Main.Backup = oldGarden.Status.ETCDBackup.Backup.DeepCopy()
+						newGarden.Spec.VirtualCluster.ETCD.Main.Backup.SecretRef.Name = "new-source-secret"
+
+						Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(BeEmpty())
+					})
+
+					It("should forbid removing the backup configuration", func() {
+						newGarden.Spec.VirtualCluster.ETCD.Main.Backup = nil
+
+						Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.virtualCluster.etcd.main.backup"),
+						}))))
+					})
+				})
+
+				It("should forbid changing the provider without changing the secret", func() {
+					newGarden.Spec.VirtualCluster.ETCD.Main.Backup.Provider = "other-provider"
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.virtualCluster.etcd.main.backup.secretRef.name"),
+					}))))
+				})
+
+				It("should allow changing only the secret", func() {
+					newGarden.Spec.VirtualCluster.ETCD.Main.Backup.SecretRef.Name = "other-secret"
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(BeEmpty())
+				})
+			})
+
 			Context("kubernetes", func() {
 				It("should not not allow version downgrade", func() {
 					version := semver.MustParse(newGarden.Spec.VirtualCluster.Kubernetes.Version)
@@ -23,11 +23,39 @@ package v1alpha1
 
 import (
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionDeploymentSpec) DeepCopyInto(out *AdmissionDeploymentSpec) {
+	*out = *in
+	if in.RuntimeCluster != nil {
+		in, out := &in.RuntimeCluster, &out.RuntimeCluster
+		*out = new(DeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VirtualCluster != nil {
+		in, out := &in.VirtualCluster, &out.VirtualCluster
+		*out = new(DeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionDeploymentSpec.
+func (in *AdmissionDeploymentSpec) DeepCopy() *AdmissionDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuditWebhook) DeepCopyInto(out *AuditWebhook) {
 	*out = *in
@@ -217,6 +245,53 @@ func (in *DNS) DeepCopy() *DNS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Deployment) DeepCopyInto(out *Deployment) {
+	*out = *in
+	if in.ExtensionDeployment != nil {
+		in, out := &in.ExtensionDeployment, &out.ExtensionDeployment
+		*out = new(ExtensionDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdmissionDeployment != nil {
+		in, out := &in.AdmissionDeployment, &out.AdmissionDeployment
+		*out = new(AdmissionDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deployment.
+func (in *Deployment) DeepCopy() *Deployment {
+	if in == nil {
+		return nil
+	}
+	out := new(Deployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
+	*out = *in
+	if in.Helm != nil {
+		in, out := &in.Helm, &out.Helm
+		*out = new(ExtensionHelm)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSpec.
+func (in *DeploymentSpec) DeepCopy() *DeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ETCD) DeepCopyInto(out *ETCD) {
 	*out = *in
@@ -243,6 +318,54 @@ func (in *ETCD) DeepCopy() *ETCD {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDBackupMigration) DeepCopyInto(out *ETCDBackupMigration) {
+	*out = *in
+	out.Source = in.Source
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SourceRetainedUntil != nil {
+		in, out := &in.SourceRetainedUntil, &out.SourceRetainedUntil
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDBackupMigration.
+func (in *ETCDBackupMigration) DeepCopy() *ETCDBackupMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDBackupMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDBackupStatus) DeepCopyInto(out *ETCDBackupStatus) {
+	*out = *in
+	out.Backup = in.Backup
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(ETCDBackupMigration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDBackupStatus.
+func (in *ETCDBackupStatus) DeepCopy() *ETCDBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ETCDEvents) DeepCopyInto(out *ETCDEvents) {
 	*out = *in
@@ -290,6 +413,171 @@ func (in *ETCDMain) DeepCopy() *ETCDMain {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Extension) DeepCopyInto(out *Extension) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Extension.
+func (in *Extension) DeepCopy() *Extension {
+	if in == nil {
+		return nil
+	}
+	out := new(Extension)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Extension) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionDeploymentSpec) DeepCopyInto(out *ExtensionDeploymentSpec) {
+	*out = *in
+	in.DeploymentSpec.DeepCopyInto(&out.DeploymentSpec)
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(v1beta1.ControllerDeploymentPolicy)
+		**out = **in
+	}
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionDeploymentSpec.
+func (in *ExtensionDeploymentSpec) DeepCopy() *ExtensionDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionHelm) DeepCopyInto(out *ExtensionHelm) {
+	*out = *in
+	if in.RawChart != nil {
+		in, out := &in.RawChart, &out.RawChart
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionHelm.
+func (in *ExtensionHelm) DeepCopy() *ExtensionHelm {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionHelm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionList) DeepCopyInto(out *ExtensionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Extension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionList.
+func (in *ExtensionList) DeepCopy() *ExtensionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExtensionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionSpec) DeepCopyInto(out *ExtensionSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]v1beta1.ControllerResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(Deployment)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionSpec.
+func (in *ExtensionSpec) DeepCopy() *ExtensionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionStatus) DeepCopyInto(out *ExtensionStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1beta1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionStatus.
+func (in *ExtensionStatus) DeepCopy() *ExtensionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Garden) DeepCopyInto(out *Garden) {
 	*out = *in
@@ -399,6 +687,16 @@ func (in *GardenStatus) DeepCopyInto(out *GardenStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastMaintenance != nil {
+		in, out := &in.LastMaintenance, &out.LastMaintenance
+		*out = new(v1beta1.LastMaintenance)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ETCDBackup != nil {
+		in, out := &in.ETCDBackup, &out.ETCDBackup
+		*out = new(ETCDBackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -708,6 +1006,11 @@ func (in *Kubernetes) DeepCopy() *Kubernetes {
 func (in *Maintenance) DeepCopyInto(out *Maintenance) {
 	*out = *in
 	out.TimeWindow = in.TimeWindow
+	if in.AutoUpdate != nil {
+		in, out := &in.AutoUpdate, &out.AutoUpdate
+		*out = new(MaintenanceAutoUpdate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -721,6 +1024,27 @@ func (in *Maintenance) DeepCopy() *Maintenance {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceAutoUpdate) DeepCopyInto(out *MaintenanceAutoUpdate) {
+	*out = *in
+	if in.KubernetesVersions != nil {
+		in, out := &in.KubernetesVersions, &out.KubernetesVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceAutoUpdate.
+func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceAutoUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Networking) DeepCopyInto(out *Networking) {
 	*out = *in
@@ -856,6 +1180,11 @@ func (in *RuntimeCluster) DeepCopyInto(out *RuntimeCluster) {
 		*out = new(Settings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(RuntimeSeed)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -895,6 +1224,28 @@ func (in *RuntimeNetworking) DeepCopy() *RuntimeNetworking {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeSeed) DeepCopyInto(out *RuntimeSeed) {
+	*out = *in
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(seedmanagementv1alpha1.GardenletDeployment)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Config.DeepCopyInto(&out.Config)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeSeed.
+func (in *RuntimeSeed) DeepCopy() *RuntimeSeed {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeSeed)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SNI) DeepCopyInto(out *SNI) {
 	*out = *in
@@ -1049,7 +1400,7 @@ func (in *VirtualCluster) DeepCopyInto(out *VirtualCluster) {
 	}
 	in.Gardener.DeepCopyInto(&out.Gardener)
 	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
-	out.Maintenance = in.Maintenance
+	in.Maintenance.DeepCopyInto(&out.Maintenance)
 	out.Networking = in.Networking
 	return
 }
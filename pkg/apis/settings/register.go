@@ -47,8 +47,16 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&ClusterOpenIDConnectPreset{},
 		&ClusterOpenIDConnectPresetList{},
+		&ClusterShootDefaults{},
+		&ClusterShootDefaultsList{},
+		&ClusterShootPolicy{},
+		&ClusterShootPolicyList{},
 		&OpenIDConnectPreset{},
 		&OpenIDConnectPresetList{},
+		&ShootDefaults{},
+		&ShootDefaultsList{},
+		&ShootPolicy{},
+		&ShootPolicyList{},
 	)
 	return nil
 }
@@ -0,0 +1,52 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settings
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterShootPolicy is a set of CEL rules which are evaluated against Shoots cluster-wide.
+type ClusterShootPolicy struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec is the specification of this ClusterShootPolicy.
+	Spec ClusterShootPolicySpec
+}
+
+// ClusterShootPolicySpec contains the ShootPolicy specification and the project selector matching Shoots in Projects.
+type ClusterShootPolicySpec struct {
+	ShootPolicySpec
+
+	// ProjectSelector decides whether to evaluate the rules if the Shoot is in a Project matching the label selector.
+	// Defaults to the empty LabelSelector, which matches everything.
+	ProjectSelector *metav1.LabelSelector
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterShootPolicyList is a collection of ClusterShootPolicies.
+type ClusterShootPolicyList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of ClusterShootPolicies.
+	Items []ClusterShootPolicy
+}
@@ -0,0 +1,84 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settings
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootDefaults contains default values which are applied to new Shoots in a namespace.
+type ShootDefaults struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec is the specification of this ShootDefaults.
+	Spec ShootDefaultsSpec
+}
+
+// ShootDefaultsSpec contains the Shoot selector and the default values of a ShootDefaults.
+type ShootDefaultsSpec struct {
+	// ShootSelector decides whether to apply the defaults if the Shoot has matching labels.
+	// Defaults to the empty LabelSelector, which matches everything.
+	ShootSelector *metav1.LabelSelector
+	// Weight associated with matching the corresponding defaults, in the range 1-100.
+	// Defaults with a higher weight take precedence over defaults with a lower weight.
+	Weight int32
+	// Template contains the default values for the Shoot.
+	Template ShootDefaultsTemplate
+}
+
+// ShootDefaultsTemplate contains the default values which are applied to a Shoot. Values which are already set in the
+// Shoot are never overwritten.
+type ShootDefaultsTemplate struct {
+	// Kubelet contains default kubelet settings which are applied to `.spec.kubernetes.kubelet`. Only the top-level
+	// fields which are not set in the Shoot are defaulted.
+	Kubelet *core.KubeletConfig
+	// MaintenanceTimeWindow is the default maintenance time window. It is only applied if the Shoot does not specify one.
+	MaintenanceTimeWindow *core.MaintenanceTimeWindow
+	// HibernationSchedules are the default hibernation schedules. They are only applied if the Shoot does not specify
+	// any hibernation schedule.
+	HibernationSchedules []core.HibernationSchedule
+	// Extensions are added to the Shoot unless it already configures an extension of the same type.
+	Extensions []core.Extension
+	// Worker contains default values which are applied to all worker pools of the Shoot.
+	Worker *ShootDefaultsWorker
+}
+
+// ShootDefaultsWorker contains default values for the worker pools of a Shoot.
+type ShootDefaultsWorker struct {
+	// MachineImage is the default machine image. It is only applied if the worker pool does not specify one.
+	MachineImage *core.ShootMachineImage
+	// Labels are added to the worker pool unless it already specifies a label with the same key.
+	Labels map[string]string
+	// Taints are added to the worker pool unless it already specifies a taint with the same key.
+	Taints []corev1.Taint
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootDefaultsList is a collection of ShootDefaults.
+type ShootDefaultsList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of ShootDefaults.
+	Items []ShootDefaults
+}
@@ -0,0 +1,77 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settings
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootPolicy is a set of CEL rules which are evaluated against Shoots in a namespace.
+type ShootPolicy struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec is the specification of this ShootPolicy.
+	Spec ShootPolicySpec
+}
+
+// ShootPolicySpec contains the Shoot selector and the rules of a ShootPolicy.
+type ShootPolicySpec struct {
+	// ShootSelector decides whether to evaluate the rules if the Shoot has matching labels.
+	// Defaults to the empty LabelSelector, which matches everything.
+	ShootSelector *metav1.LabelSelector
+	// Rules is a list of rules which are evaluated against the Shoot.
+	Rules []ShootPolicyRule
+}
+
+// ShootPolicyRule is a CEL rule which is evaluated against a Shoot.
+type ShootPolicyRule struct {
+	// Name is the name of the rule.
+	Name string
+	// Expression is a CEL expression which must evaluate to true for a compliant Shoot. The Shoot is available as
+	// `object` in its v1beta1 representation. For updates, the existing Shoot is available as `oldObject`, otherwise it
+	// is `null`.
+	Expression string
+	// Message is the message which is returned if the expression evaluates to false.
+	Message string
+	// Action is the action which is taken if the expression evaluates to false.
+	Action ShootPolicyAction
+}
+
+// ShootPolicyAction is the action which is taken if a rule of a ShootPolicy is violated.
+type ShootPolicyAction string
+
+const (
+	// ShootPolicyActionDeny denies the request.
+	ShootPolicyActionDeny ShootPolicyAction = "Deny"
+	// ShootPolicyActionWarn admits the request but returns a warning to the client.
+	ShootPolicyActionWarn ShootPolicyAction = "Warn"
+	// ShootPolicyActionAudit admits the request but adds an annotation to the audit event.
+	ShootPolicyActionAudit ShootPolicyAction = "Audit"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootPolicyList is a collection of ShootPolicies.
+type ShootPolicyList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of ShootPolicies.
+	Items []ShootPolicy
+}
@@ -0,0 +1,39 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:revive
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/conversion"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+func Convert_v1beta1_KubeletConfig_To_core_KubeletConfig(in *gardencorev1beta1.KubeletConfig, out *gardencore.KubeletConfig, s conversion.Scope) error {
+	return gardencorev1beta1.Convert_v1beta1_KubeletConfig_To_core_KubeletConfig(in, out, s)
+}
+
+func Convert_core_KubeletConfig_To_v1beta1_KubeletConfig(in *gardencore.KubeletConfig, out *gardencorev1beta1.KubeletConfig, s conversion.Scope) error {
+	return gardencorev1beta1.Convert_core_KubeletConfig_To_v1beta1_KubeletConfig(in, out, s)
+}
+
+func Convert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(in *gardencorev1beta1.ShootMachineImage, out *gardencore.ShootMachineImage, s conversion.Scope) error {
+	return gardencorev1beta1.Convert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(in, out, s)
+}
+
+func Convert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(in *gardencore.ShootMachineImage, out *gardencorev1beta1.ShootMachineImage, s conversion.Scope) error {
+	return gardencorev1beta1.Convert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(in, out, s)
+}
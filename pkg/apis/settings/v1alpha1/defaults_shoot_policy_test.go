@@ -0,0 +1,85 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+)
+
+var _ = Describe("ShootPolicy defaulting", func() {
+	It("should default ShootPolicy correctly", func() {
+		obj := &ShootPolicy{Spec: ShootPolicySpec{Rules: []ShootPolicyRule{{Name: "foo"}}}}
+		expected := &ShootPolicy{
+			Spec: ShootPolicySpec{
+				ShootSelector: &metav1.LabelSelector{},
+				Rules:         []ShootPolicyRule{{Name: "foo", Action: "Deny"}},
+			},
+		}
+		SetObjectDefaults_ShootPolicy(obj)
+
+		Expect(obj).To(Equal(expected))
+	})
+
+	It("should not default ShootPolicy if it is already set", func() {
+		obj := &ShootPolicy{
+			Spec: ShootPolicySpec{
+				ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+				Rules:         []ShootPolicyRule{{Name: "foo", Action: "Warn"}},
+			},
+		}
+		expected := obj.DeepCopy()
+		SetObjectDefaults_ShootPolicy(obj)
+
+		Expect(obj).To(Equal(expected))
+	})
+})
+
+var _ = Describe("ClusterShootPolicy defaulting", func() {
+	It("should default ClusterShootPolicy correctly", func() {
+		obj := &ClusterShootPolicy{Spec: ClusterShootPolicySpec{ShootPolicySpec: ShootPolicySpec{Rules: []ShootPolicyRule{{Name: "foo"}}}}}
+		expected := &ClusterShootPolicy{
+			Spec: ClusterShootPolicySpec{
+				ShootPolicySpec: ShootPolicySpec{
+					ShootSelector: &metav1.LabelSelector{},
+					Rules:         []ShootPolicyRule{{Name: "foo", Action: "Deny"}},
+				},
+				ProjectSelector: &metav1.LabelSelector{},
+			},
+		}
+		SetObjectDefaults_ClusterShootPolicy(obj)
+
+		Expect(obj).To(Equal(expected))
+	})
+
+	It("should not default ClusterShootPolicy if it is already set", func() {
+		obj := &ClusterShootPolicy{
+			Spec: ClusterShootPolicySpec{
+				ShootPolicySpec: ShootPolicySpec{
+					ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					Rules:         []ShootPolicyRule{{Name: "foo", Action: "Audit"}},
+				},
+				ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+			},
+		}
+		expected := obj.DeepCopy()
+		SetObjectDefaults_ClusterShootPolicy(obj)
+
+		Expect(obj).To(Equal(expected))
+	})
+})
@@ -21,10 +21,13 @@ package v1alpha1
 import (
 	fmt "fmt"
 
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+
 	io "io"
 
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	v11 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
@@ -128,10 +131,178 @@ func (m *ClusterOpenIDConnectPresetSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterOpenIDConnectPresetSpec proto.InternalMessageInfo
 
+func (m *ClusterShootDefaults) Reset()      { *m = ClusterShootDefaults{} }
+func (*ClusterShootDefaults) ProtoMessage() {}
+func (*ClusterShootDefaults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{3}
+}
+func (m *ClusterShootDefaults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterShootDefaults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterShootDefaults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterShootDefaults.Merge(m, src)
+}
+func (m *ClusterShootDefaults) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterShootDefaults) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterShootDefaults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterShootDefaults proto.InternalMessageInfo
+
+func (m *ClusterShootDefaultsList) Reset()      { *m = ClusterShootDefaultsList{} }
+func (*ClusterShootDefaultsList) ProtoMessage() {}
+func (*ClusterShootDefaultsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{4}
+}
+func (m *ClusterShootDefaultsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterShootDefaultsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterShootDefaultsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterShootDefaultsList.Merge(m, src)
+}
+func (m *ClusterShootDefaultsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterShootDefaultsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterShootDefaultsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterShootDefaultsList proto.InternalMessageInfo
+
+func (m *ClusterShootDefaultsSpec) Reset()      { *m = ClusterShootDefaultsSpec{} }
+func (*ClusterShootDefaultsSpec) ProtoMessage() {}
+func (*ClusterShootDefaultsSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{5}
+}
+func (m *ClusterShootDefaultsSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterShootDefaultsSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterShootDefaultsSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterShootDefaultsSpec.Merge(m, src)
+}
+func (m *ClusterShootDefaultsSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterShootDefaultsSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterShootDefaultsSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterShootDefaultsSpec proto.InternalMessageInfo
+
+func (m *ClusterShootPolicy) Reset()      { *m = ClusterShootPolicy{} }
+func (*ClusterShootPolicy) ProtoMessage() {}
+func (*ClusterShootPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{6}
+}
+func (m *ClusterShootPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterShootPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterShootPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterShootPolicy.Merge(m, src)
+}
+func (m *ClusterShootPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterShootPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterShootPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterShootPolicy proto.InternalMessageInfo
+
+func (m *ClusterShootPolicyList) Reset()      { *m = ClusterShootPolicyList{} }
+func (*ClusterShootPolicyList) ProtoMessage() {}
+func (*ClusterShootPolicyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{7}
+}
+func (m *ClusterShootPolicyList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterShootPolicyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterShootPolicyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterShootPolicyList.Merge(m, src)
+}
+func (m *ClusterShootPolicyList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterShootPolicyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterShootPolicyList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterShootPolicyList proto.InternalMessageInfo
+
+func (m *ClusterShootPolicySpec) Reset()      { *m = ClusterShootPolicySpec{} }
+func (*ClusterShootPolicySpec) ProtoMessage() {}
+func (*ClusterShootPolicySpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{8}
+}
+func (m *ClusterShootPolicySpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterShootPolicySpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterShootPolicySpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterShootPolicySpec.Merge(m, src)
+}
+func (m *ClusterShootPolicySpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterShootPolicySpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterShootPolicySpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterShootPolicySpec proto.InternalMessageInfo
+
 func (m *KubeAPIServerOpenIDConnect) Reset()      { *m = KubeAPIServerOpenIDConnect{} }
 func (*KubeAPIServerOpenIDConnect) ProtoMessage() {}
 func (*KubeAPIServerOpenIDConnect) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f0cd3f80cc90ed56, []int{3}
+	return fileDescriptor_f0cd3f80cc90ed56, []int{9}
 }
 func (m *KubeAPIServerOpenIDConnect) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,7 +330,7 @@ var xxx_messageInfo_KubeAPIServerOpenIDConnect proto.InternalMessageInfo
 func (m *OpenIDConnectClientAuthentication) Reset()      { *m = OpenIDConnectClientAuthentication{} }
 func (*OpenIDConnectClientAuthentication) ProtoMessage() {}
 func (*OpenIDConnectClientAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f0cd3f80cc90ed56, []int{4}
+	return fileDescriptor_f0cd3f80cc90ed56, []int{10}
 }
 func (m *OpenIDConnectClientAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,7 +358,7 @@ var xxx_messageInfo_OpenIDConnectClientAuthentication proto.InternalMessageInfo
 func (m *OpenIDConnectPreset) Reset()      { *m = OpenIDConnectPreset{} }
 func (*OpenIDConnectPreset) ProtoMessage() {}
 func (*OpenIDConnectPreset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f0cd3f80cc90ed56, []int{5}
+	return fileDescriptor_f0cd3f80cc90ed56, []int{11}
 }
 func (m *OpenIDConnectPreset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -215,7 +386,7 @@ var xxx_messageInfo_OpenIDConnectPreset proto.InternalMessageInfo
 func (m *OpenIDConnectPresetList) Reset()      { *m = OpenIDConnectPresetList{} }
 func (*OpenIDConnectPresetList) ProtoMessage() {}
 func (*OpenIDConnectPresetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f0cd3f80cc90ed56, []int{6}
+	return fileDescriptor_f0cd3f80cc90ed56, []int{12}
 }
 func (m *OpenIDConnectPresetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,7 +414,7 @@ var xxx_messageInfo_OpenIDConnectPresetList proto.InternalMessageInfo
 func (m *OpenIDConnectPresetSpec) Reset()      { *m = OpenIDConnectPresetSpec{} }
 func (*OpenIDConnectPresetSpec) ProtoMessage() {}
 func (*OpenIDConnectPresetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f0cd3f80cc90ed56, []int{7}
+	return fileDescriptor_f0cd3f80cc90ed56, []int{13}
 }
 func (m *OpenIDConnectPresetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,10 +439,268 @@ func (m *OpenIDConnectPresetSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OpenIDConnectPresetSpec proto.InternalMessageInfo
 
+func (m *ShootDefaults) Reset()      { *m = ShootDefaults{} }
+func (*ShootDefaults) ProtoMessage() {}
+func (*ShootDefaults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{14}
+}
+func (m *ShootDefaults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootDefaults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootDefaults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootDefaults.Merge(m, src)
+}
+func (m *ShootDefaults) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootDefaults) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootDefaults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootDefaults proto.InternalMessageInfo
+
+func (m *ShootDefaultsList) Reset()      { *m = ShootDefaultsList{} }
+func (*ShootDefaultsList) ProtoMessage() {}
+func (*ShootDefaultsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{15}
+}
+func (m *ShootDefaultsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootDefaultsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootDefaultsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootDefaultsList.Merge(m, src)
+}
+func (m *ShootDefaultsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootDefaultsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootDefaultsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootDefaultsList proto.InternalMessageInfo
+
+func (m *ShootDefaultsSpec) Reset()      { *m = ShootDefaultsSpec{} }
+func (*ShootDefaultsSpec) ProtoMessage() {}
+func (*ShootDefaultsSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{16}
+}
+func (m *ShootDefaultsSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootDefaultsSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootDefaultsSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootDefaultsSpec.Merge(m, src)
+}
+func (m *ShootDefaultsSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootDefaultsSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootDefaultsSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootDefaultsSpec proto.InternalMessageInfo
+
+func (m *ShootDefaultsTemplate) Reset()      { *m = ShootDefaultsTemplate{} }
+func (*ShootDefaultsTemplate) ProtoMessage() {}
+func (*ShootDefaultsTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{17}
+}
+func (m *ShootDefaultsTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootDefaultsTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootDefaultsTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootDefaultsTemplate.Merge(m, src)
+}
+func (m *ShootDefaultsTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootDefaultsTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootDefaultsTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootDefaultsTemplate proto.InternalMessageInfo
+
+func (m *ShootDefaultsWorker) Reset()      { *m = ShootDefaultsWorker{} }
+func (*ShootDefaultsWorker) ProtoMessage() {}
+func (*ShootDefaultsWorker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{18}
+}
+func (m *ShootDefaultsWorker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootDefaultsWorker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootDefaultsWorker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootDefaultsWorker.Merge(m, src)
+}
+func (m *ShootDefaultsWorker) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootDefaultsWorker) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootDefaultsWorker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootDefaultsWorker proto.InternalMessageInfo
+
+func (m *ShootPolicy) Reset()      { *m = ShootPolicy{} }
+func (*ShootPolicy) ProtoMessage() {}
+func (*ShootPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{19}
+}
+func (m *ShootPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootPolicy.Merge(m, src)
+}
+func (m *ShootPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootPolicy proto.InternalMessageInfo
+
+func (m *ShootPolicyList) Reset()      { *m = ShootPolicyList{} }
+func (*ShootPolicyList) ProtoMessage() {}
+func (*ShootPolicyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{20}
+}
+func (m *ShootPolicyList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootPolicyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootPolicyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootPolicyList.Merge(m, src)
+}
+func (m *ShootPolicyList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootPolicyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootPolicyList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootPolicyList proto.InternalMessageInfo
+
+func (m *ShootPolicyRule) Reset()      { *m = ShootPolicyRule{} }
+func (*ShootPolicyRule) ProtoMessage() {}
+func (*ShootPolicyRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{21}
+}
+func (m *ShootPolicyRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootPolicyRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootPolicyRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootPolicyRule.Merge(m, src)
+}
+func (m *ShootPolicyRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootPolicyRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootPolicyRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootPolicyRule proto.InternalMessageInfo
+
+func (m *ShootPolicySpec) Reset()      { *m = ShootPolicySpec{} }
+func (*ShootPolicySpec) ProtoMessage() {}
+func (*ShootPolicySpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0cd3f80cc90ed56, []int{22}
+}
+func (m *ShootPolicySpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootPolicySpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootPolicySpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootPolicySpec.Merge(m, src)
+}
+func (m *ShootPolicySpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootPolicySpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootPolicySpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootPolicySpec proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ClusterOpenIDConnectPreset)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ClusterOpenIDConnectPreset")
 	proto.RegisterType((*ClusterOpenIDConnectPresetList)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ClusterOpenIDConnectPresetList")
 	proto.RegisterType((*ClusterOpenIDConnectPresetSpec)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ClusterOpenIDConnectPresetSpec")
+	proto.RegisterType((*ClusterShootDefaults)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ClusterShootDefaults")
+	proto.RegisterType((*ClusterShootDefaultsList)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ClusterShootDefaultsList")
+	proto.RegisterType((*ClusterShootDefaultsSpec)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ClusterShootDefaultsSpec")
+	proto.RegisterType((*ClusterShootPolicy)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ClusterShootPolicy")
+	proto.RegisterType((*ClusterShootPolicyList)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ClusterShootPolicyList")
+	proto.RegisterType((*ClusterShootPolicySpec)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ClusterShootPolicySpec")
 	proto.RegisterType((*KubeAPIServerOpenIDConnect)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.KubeAPIServerOpenIDConnect")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.KubeAPIServerOpenIDConnect.RequiredClaimsEntry")
 	proto.RegisterType((*OpenIDConnectClientAuthentication)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.OpenIDConnectClientAuthentication")
@@ -279,6 +708,16 @@ func init() {
 	proto.RegisterType((*OpenIDConnectPreset)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.OpenIDConnectPreset")
 	proto.RegisterType((*OpenIDConnectPresetList)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.OpenIDConnectPresetList")
 	proto.RegisterType((*OpenIDConnectPresetSpec)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.OpenIDConnectPresetSpec")
+	proto.RegisterType((*ShootDefaults)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ShootDefaults")
+	proto.RegisterType((*ShootDefaultsList)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ShootDefaultsList")
+	proto.RegisterType((*ShootDefaultsSpec)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ShootDefaultsSpec")
+	proto.RegisterType((*ShootDefaultsTemplate)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ShootDefaultsTemplate")
+	proto.RegisterType((*ShootDefaultsWorker)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ShootDefaultsWorker")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ShootDefaultsWorker.LabelsEntry")
+	proto.RegisterType((*ShootPolicy)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ShootPolicy")
+	proto.RegisterType((*ShootPolicyList)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ShootPolicyList")
+	proto.RegisterType((*ShootPolicyRule)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ShootPolicyRule")
+	proto.RegisterType((*ShootPolicySpec)(nil), "github.com.gardener.gardener.pkg.apis.settings.v1alpha1.ShootPolicySpec")
 }
 
 func init() {
@@ -286,67 +725,110 @@ func init() {
 }
 
 var fileDescriptor_f0cd3f80cc90ed56 = []byte{
-	// 958 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0x93, 0x26, 0x24, 0x93, 0xfe, 0x63, 0x8a, 0x68, 0x94, 0x83, 0x53, 0x72, 0x40, 0x3d,
-	0x80, 0x43, 0x97, 0x15, 0x5d, 0xed, 0x01, 0x29, 0xc9, 0xae, 0xaa, 0x88, 0xa2, 0x8d, 0x26, 0xaa,
-	0x56, 0x5a, 0x81, 0x84, 0xe3, 0xbc, 0x3a, 0x43, 0x1c, 0xdb, 0x3b, 0x33, 0x0e, 0xad, 0x90, 0x10,
-	0x1f, 0x81, 0xcf, 0x00, 0x07, 0x0e, 0xec, 0xa7, 0xe0, 0xd4, 0xe3, 0x1e, 0xf7, 0x14, 0x51, 0x73,
-	0x83, 0x2f, 0x00, 0x37, 0x64, 0x7b, 0x52, 0xdb, 0x69, 0x0d, 0x4b, 0xd4, 0x6a, 0x6f, 0x9e, 0xf7,
-	0xef, 0xf7, 0x7b, 0xbf, 0x79, 0x99, 0xa7, 0xa0, 0x23, 0x93, 0x8a, 0xb1, 0x37, 0xd4, 0x0c, 0x67,
-	0xda, 0x32, 0x75, 0x36, 0x02, 0x1b, 0x58, 0xfc, 0xe1, 0x4e, 0xcc, 0x96, 0xee, 0x52, 0xde, 0xe2,
-	0x20, 0x04, 0xb5, 0x4d, 0xde, 0x9a, 0x1d, 0xe8, 0x96, 0x3b, 0xd6, 0x0f, 0x5a, 0x66, 0x10, 0xa0,
-	0x0b, 0x18, 0x69, 0x2e, 0x73, 0x84, 0x83, 0x0f, 0xe3, 0x42, 0xda, 0x22, 0x3f, 0xfe, 0x70, 0x27,
-	0xa6, 0x16, 0x14, 0xd2, 0x16, 0x85, 0xb4, 0x45, 0xa1, 0xfa, 0x87, 0x49, 0x06, 0x8e, 0xe9, 0xb4,
-	0xc2, 0x7a, 0x43, 0xef, 0x34, 0x3c, 0x85, 0x87, 0xf0, 0x2b, 0xc2, 0xa9, 0xdf, 0x9f, 0x3c, 0xe0,
-	0x1a, 0x75, 0x02, 0x5a, 0x53, 0xdd, 0x18, 0x53, 0x1b, 0xd8, 0x79, 0xcc, 0x73, 0x0a, 0x42, 0x6f,
-	0xcd, 0xae, 0xb1, 0xab, 0xb7, 0xb2, 0xb2, 0x98, 0x67, 0x0b, 0x3a, 0x85, 0x6b, 0x09, 0x9f, 0xfc,
-	0x57, 0x02, 0x37, 0xc6, 0x30, 0xd5, 0x97, 0xf3, 0x9a, 0x7f, 0x2b, 0xa8, 0xde, 0xb5, 0x3c, 0x2e,
-	0x80, 0x3d, 0x71, 0xc1, 0xee, 0x3d, 0xea, 0x3a, 0xb6, 0x0d, 0x86, 0xe8, 0x33, 0xe0, 0x20, 0xf0,
-	0x57, 0xa8, 0x1c, 0x50, 0x1c, 0xe9, 0x42, 0xaf, 0x29, 0x7b, 0xca, 0x7e, 0xf5, 0xde, 0x47, 0x5a,
-	0x84, 0xa4, 0x25, 0x91, 0x62, 0xbd, 0x82, 0x68, 0x6d, 0x76, 0xa0, 0x3d, 0x19, 0x7e, 0x0d, 0x86,
-	0xf8, 0x1c, 0x84, 0xde, 0xc1, 0x17, 0xf3, 0x46, 0xce, 0x9f, 0x37, 0x50, 0x6c, 0x23, 0x57, 0x55,
-	0xf1, 0x39, 0x5a, 0xe3, 0x2e, 0x18, 0xb5, 0x7c, 0x58, 0xfd, 0xa9, 0xb6, 0xe2, 0xb5, 0x68, 0xd9,
-	0x4d, 0x0c, 0x5c, 0x30, 0x3a, 0xeb, 0x92, 0xc4, 0x5a, 0x70, 0x22, 0x21, 0x64, 0xf3, 0x2f, 0x05,
-	0xa9, 0xd9, 0x69, 0xc7, 0x94, 0x0b, 0xfc, 0xc5, 0xb5, 0xfe, 0xb5, 0xd7, 0xeb, 0x3f, 0xc8, 0x0e,
-	0xbb, 0xdf, 0x96, 0xc0, 0xe5, 0x85, 0x25, 0xd1, 0xfb, 0x19, 0x2a, 0x52, 0x01, 0x53, 0x5e, 0xcb,
-	0xef, 0x15, 0xf6, 0xab, 0xf7, 0x06, 0x77, 0xd0, 0x7c, 0x67, 0x43, 0xe2, 0x17, 0x7b, 0x01, 0x12,
-	0x89, 0x00, 0x9b, 0xbf, 0xe6, 0xff, 0xad, 0xf5, 0x40, 0x23, 0xfc, 0x8b, 0x82, 0x76, 0x9d, 0x9b,
-	0x7d, 0x52, 0x8a, 0xfe, 0xca, 0x7c, 0xb3, 0x6e, 0xa9, 0x21, 0xc9, 0xee, 0x66, 0x04, 0x90, 0x2c,
-	0x46, 0x98, 0xa1, 0x2d, 0x97, 0x39, 0xc1, 0x7c, 0x0d, 0xc0, 0x02, 0x43, 0x38, 0x4c, 0x4e, 0xd4,
-	0xc7, 0xaf, 0x79, 0x5f, 0xfa, 0x10, 0xac, 0x45, 0x6a, 0x67, 0xc7, 0x9f, 0x37, 0xb6, 0xfa, 0xe9,
-	0x7a, 0x64, 0x19, 0xa0, 0xf9, 0x53, 0x11, 0xd5, 0x3f, 0xf3, 0x86, 0xd0, 0xee, 0xf7, 0x06, 0xc0,
-	0x66, 0x4b, 0x52, 0xe2, 0x7d, 0x54, 0x36, 0xf4, 0x8e, 0x67, 0x8f, 0x2c, 0x08, 0x05, 0xab, 0x74,
-	0xd6, 0x83, 0x39, 0xe8, 0xb6, 0x23, 0x1b, 0xb9, 0xf2, 0xe2, 0x0f, 0x50, 0xd9, 0xb0, 0x28, 0xd8,
-	0xa2, 0xf7, 0x28, 0x64, 0x5d, 0x89, 0xa7, 0xa6, 0x2b, 0xed, 0xe4, 0x2a, 0x02, 0x1f, 0xa0, 0xaa,
-	0xc9, 0x1c, 0xcf, 0xe5, 0x5d, 0x4b, 0xa7, 0xd3, 0x5a, 0x21, 0x4c, 0xd8, 0xf2, 0xe7, 0x8d, 0xea,
-	0x51, 0x6c, 0x26, 0xc9, 0x18, 0x7c, 0x1f, 0xad, 0x47, 0xc7, 0x3e, 0x83, 0x53, 0x7a, 0x56, 0x5b,
-	0x8b, 0x40, 0xfc, 0x79, 0x63, 0xfd, 0x28, 0x61, 0x27, 0xa9, 0x28, 0xdc, 0x42, 0x15, 0xca, 0xb9,
-	0x07, 0xec, 0x84, 0x1c, 0xd7, 0x8a, 0x61, 0xca, 0xdb, 0x92, 0x57, 0xa5, 0xb7, 0x70, 0x90, 0x38,
-	0x06, 0xff, 0xac, 0xa0, 0x4d, 0x06, 0xcf, 0x3d, 0xca, 0x60, 0x14, 0x02, 0xf3, 0x5a, 0x29, 0x9c,
-	0x6c, 0x73, 0xe5, 0x49, 0xc9, 0xd6, 0x57, 0x23, 0x29, 0xa4, 0xc7, 0xb6, 0x60, 0xe7, 0x9d, 0x77,
-	0x25, 0xbf, 0xcd, 0xb4, 0x93, 0x2c, 0xd1, 0x0a, 0x34, 0xe4, 0xd4, 0xb4, 0xa9, 0x6d, 0xb6, 0x2d,
-	0x93, 0xd7, 0xde, 0xda, 0x2b, 0x2c, 0x34, 0x1c, 0xc4, 0x66, 0x92, 0x8c, 0xc1, 0x87, 0x68, 0xc3,
-	0xe3, 0xc0, 0x6c, 0x7d, 0x0a, 0x91, 0xf0, 0xe5, 0x48, 0x11, 0x7f, 0xde, 0xd8, 0x38, 0x49, 0x3a,
-	0x48, 0x3a, 0x0e, 0x3f, 0x44, 0x9b, 0x0b, 0x83, 0x94, 0xbf, 0x12, 0x66, 0xe2, 0x80, 0xe7, 0x49,
-	0xca, 0x43, 0x96, 0x22, 0xeb, 0x6d, 0xb4, 0x73, 0x43, 0x9b, 0x78, 0x1b, 0x15, 0x26, 0x70, 0x1e,
-	0x4d, 0x15, 0x09, 0x3e, 0xf1, 0x3b, 0xa8, 0x38, 0xd3, 0x2d, 0x0f, 0xa2, 0xf9, 0x21, 0xd1, 0xe1,
-	0x61, 0xfe, 0x81, 0xd2, 0x7c, 0x91, 0x47, 0xef, 0xa5, 0x84, 0x8b, 0x46, 0xaa, 0xed, 0x89, 0x31,
-	0xd8, 0x82, 0x1a, 0xba, 0xa0, 0x8e, 0x8d, 0x9b, 0xa8, 0xc4, 0xc1, 0x60, 0x20, 0xe4, 0xa8, 0x22,
-	0x7f, 0xde, 0x28, 0x0d, 0x42, 0x0b, 0x91, 0x1e, 0xfc, 0xa3, 0x82, 0xaa, 0x70, 0x26, 0x98, 0xde,
-	0x75, 0xec, 0x53, 0x6a, 0xca, 0x57, 0x6b, 0x72, 0x3b, 0xaf, 0xc0, 0x4d, 0xac, 0xb4, 0xc7, 0x31,
-	0x5a, 0x74, 0xbf, 0x3b, 0xf2, 0x7e, 0xab, 0x09, 0x0f, 0x49, 0x92, 0xaa, 0x7f, 0x8a, 0xb6, 0x97,
-	0xb3, 0xfe, 0x97, 0x5c, 0x7f, 0x2a, 0x68, 0xe7, 0xcd, 0x6c, 0x42, 0x96, 0xda, 0x84, 0xb7, 0xff,
-	0xb8, 0xde, 0xb4, 0x02, 0xff, 0x50, 0xd0, 0xee, 0x9b, 0xd9, 0x7d, 0xcf, 0xd3, 0xbb, 0xef, 0xf8,
-	0x36, 0xdb, 0xcd, 0x58, 0x7a, 0x2f, 0x0a, 0x28, 0x6b, 0xb1, 0xe0, 0x6f, 0x83, 0xf9, 0x0f, 0xde,
-	0x18, 0xd9, 0xea, 0xe0, 0x0e, 0x5e, 0xac, 0xce, 0xa6, 0xa4, 0x55, 0x8a, 0x9c, 0x44, 0x42, 0xe2,
-	0xef, 0x50, 0x29, 0x7a, 0xdd, 0xe5, 0xdd, 0x3f, 0xbb, 0xbb, 0x9f, 0x54, 0xf4, 0xc3, 0x8e, 0x3c,
-	0x44, 0xa2, 0x62, 0x0b, 0x6d, 0xf0, 0xb1, 0xe3, 0xc4, 0xab, 0xb3, 0xb0, 0xfa, 0xea, 0x0c, 0xdf,
-	0xc3, 0x41, 0xb2, 0x1a, 0x49, 0x17, 0xc7, 0xef, 0xa3, 0xd2, 0x37, 0x40, 0xcd, 0xb1, 0x08, 0xd7,
-	0x50, 0x31, 0x56, 0xe5, 0x69, 0x68, 0x25, 0xd2, 0xdb, 0xf9, 0xf2, 0xe2, 0x52, 0xcd, 0xbd, 0xbc,
-	0x54, 0x73, 0xaf, 0x2e, 0xd5, 0xdc, 0xf7, 0xbe, 0xaa, 0x5c, 0xf8, 0xaa, 0xf2, 0xd2, 0x57, 0x95,
-	0x57, 0xbe, 0xaa, 0xfc, 0xe6, 0xab, 0xca, 0x0f, 0xbf, 0xab, 0xb9, 0x67, 0x87, 0x2b, 0xfe, 0x21,
-	0xf8, 0x27, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x4b, 0xe0, 0xb5, 0x4a, 0x0c, 0x00, 0x00,
+	// 1642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0xdb, 0x46,
+	0x16, 0x37, 0x25, 0x4b, 0xb6, 0x9f, 0xfc, 0x11, 0x8f, 0x9d, 0x58, 0x31, 0x16, 0x92, 0x57, 0x0b,
+	0x2c, 0xb2, 0xc0, 0x2e, 0xb5, 0x76, 0x83, 0x26, 0x4d, 0xd1, 0x02, 0x92, 0xed, 0x3a, 0x6e, 0xe2,
+	0xc4, 0x1d, 0x25, 0x70, 0x11, 0xb4, 0x40, 0x29, 0x7a, 0x2c, 0x31, 0xa2, 0x48, 0x99, 0x33, 0x74,
+	0x6c, 0xa4, 0x28, 0x72, 0xef, 0xa5, 0x40, 0x4e, 0xcd, 0xa5, 0x40, 0x5b, 0x20, 0x87, 0xe6, 0xaf,
+	0xe8, 0x29, 0xb7, 0xe6, 0x18, 0xb4, 0x80, 0xda, 0xa8, 0x97, 0x7e, 0x9f, 0xdb, 0x9e, 0x0a, 0x0e,
+	0x87, 0x26, 0x29, 0x51, 0xa9, 0xa3, 0x28, 0xf2, 0x4d, 0x7c, 0xdf, 0xef, 0xcd, 0xef, 0xbd, 0xc7,
+	0xa1, 0x60, 0xad, 0xa2, 0xb1, 0xaa, 0x5d, 0x96, 0x55, 0xb3, 0x9e, 0xaf, 0x28, 0xd6, 0x36, 0x31,
+	0x88, 0xe5, 0xff, 0x68, 0xd4, 0x2a, 0x79, 0xa5, 0xa1, 0xd1, 0x3c, 0x25, 0x8c, 0x69, 0x46, 0x85,
+	0xe6, 0xf7, 0x16, 0x15, 0xbd, 0x51, 0x55, 0x16, 0xf3, 0x15, 0x47, 0x40, 0x61, 0x64, 0x5b, 0x6e,
+	0x58, 0x26, 0x33, 0xd1, 0x39, 0xdf, 0x90, 0xec, 0xe9, 0xfb, 0x3f, 0x1a, 0xb5, 0x8a, 0xec, 0x18,
+	0x92, 0x3d, 0x43, 0xb2, 0x67, 0x68, 0xbe, 0x78, 0xb4, 0x08, 0x54, 0xd3, 0x22, 0xf9, 0xbd, 0xc5,
+	0x32, 0x61, 0x9d, 0xce, 0xe7, 0xff, 0x17, 0xb4, 0x61, 0x56, 0xcc, 0x3c, 0x27, 0x97, 0xed, 0x1d,
+	0xfe, 0xc4, 0x1f, 0xf8, 0x2f, 0x21, 0x9e, 0xab, 0x9d, 0xa7, 0xb2, 0x66, 0x3a, 0x86, 0x3d, 0xbb,
+	0x1d, 0x26, 0xcf, 0xfa, 0x32, 0x75, 0x45, 0xad, 0x6a, 0x06, 0xb1, 0x0e, 0xfc, 0x68, 0xea, 0x84,
+	0x29, 0x51, 0x5a, 0xf9, 0x6e, 0x5a, 0x96, 0x6d, 0x30, 0xad, 0x4e, 0x3a, 0x14, 0x5e, 0xfe, 0x3b,
+	0x05, 0xaa, 0x56, 0x49, 0x5d, 0x69, 0xd7, 0xcb, 0xfd, 0x21, 0xc1, 0xfc, 0xb2, 0x6e, 0x53, 0x46,
+	0xac, 0xab, 0x0d, 0x62, 0xac, 0xaf, 0x2c, 0x9b, 0x86, 0x41, 0x54, 0xb6, 0x69, 0x11, 0x4a, 0x18,
+	0x7a, 0x0f, 0x46, 0x9d, 0x10, 0xb7, 0x15, 0xa6, 0xa4, 0xa5, 0x05, 0xe9, 0x4c, 0x6a, 0xe9, 0xff,
+	0xb2, 0xeb, 0x49, 0x0e, 0x7a, 0xf2, 0xcf, 0xc5, 0x91, 0x96, 0xf7, 0x16, 0xe5, 0xab, 0xe5, 0x9b,
+	0x44, 0x65, 0x1b, 0x84, 0x29, 0x45, 0xf4, 0xb0, 0x99, 0x1d, 0x6a, 0x35, 0xb3, 0xe0, 0xd3, 0xf0,
+	0xa1, 0x55, 0x74, 0x00, 0xc3, 0xb4, 0x41, 0xd4, 0x74, 0x8c, 0x5b, 0xdf, 0x92, 0x7b, 0x3c, 0x7e,
+	0xb9, 0x7b, 0x12, 0xa5, 0x06, 0x51, 0x8b, 0xe3, 0x22, 0x88, 0x61, 0xe7, 0x09, 0x73, 0x97, 0xb9,
+	0xdf, 0x25, 0xc8, 0x74, 0x57, 0xbb, 0xac, 0x51, 0x86, 0xde, 0xe9, 0xc8, 0x5f, 0x3e, 0x5a, 0xfe,
+	0x8e, 0x36, 0xcf, 0xfe, 0x84, 0x70, 0x3c, 0xea, 0x51, 0x02, 0xb9, 0xef, 0x43, 0x42, 0x63, 0xa4,
+	0x4e, 0xd3, 0xb1, 0x85, 0xf8, 0x99, 0xd4, 0x52, 0xe9, 0x05, 0x24, 0x5f, 0x9c, 0x10, 0xfe, 0x13,
+	0xeb, 0x8e, 0x27, 0xec, 0x3a, 0xcc, 0x7d, 0x19, 0x7b, 0x5a, 0xea, 0x4e, 0x8d, 0xd0, 0x17, 0x12,
+	0xcc, 0x99, 0xd1, 0x3c, 0x51, 0x8a, 0xcd, 0x9e, 0xe3, 0xed, 0x76, 0x4a, 0x59, 0x11, 0xec, 0x5c,
+	0x17, 0x01, 0xdc, 0x2d, 0x22, 0x64, 0xc1, 0x54, 0xc3, 0x32, 0x1d, 0x7c, 0x95, 0x88, 0x4e, 0x54,
+	0x66, 0x5a, 0x02, 0x51, 0x2f, 0x1d, 0xf1, 0xbc, 0x94, 0x32, 0xd1, 0x3d, 0xd5, 0xe2, 0x4c, 0xab,
+	0x99, 0x9d, 0xda, 0x0c, 0xdb, 0xc3, 0xed, 0x0e, 0x72, 0xbf, 0x4a, 0x30, 0x2b, 0x8a, 0x58, 0xaa,
+	0x9a, 0x26, 0x5b, 0x21, 0x3b, 0x8a, 0xad, 0x33, 0x3a, 0x80, 0xae, 0xa1, 0xa1, 0xae, 0x79, 0xeb,
+	0x79, 0x81, 0x13, 0x0a, 0xbf, 0x6b, 0xbf, 0xfc, 0x22, 0x41, 0x3a, 0x4a, 0x61, 0x00, 0x9d, 0x62,
+	0x85, 0x3b, 0x65, 0xa3, 0xaf, 0x09, 0x77, 0xe9, 0x91, 0xcf, 0x63, 0xd1, 0xe9, 0x72, 0xbc, 0xdd,
+	0x95, 0x60, 0x9a, 0xb6, 0x53, 0x45, 0xe2, 0x6f, 0xf6, 0x1c, 0x5d, 0xe7, 0x39, 0x9c, 0x16, 0xa1,
+	0x4d, 0x77, 0xb0, 0x70, 0xa7, 0xff, 0x63, 0xe9, 0x82, 0x1f, 0x25, 0x40, 0xc1, 0x32, 0x6d, 0x9a,
+	0xba, 0xa6, 0x1e, 0x0c, 0xa0, 0x07, 0x76, 0x43, 0x3d, 0x70, 0xb5, 0x2f, 0x90, 0x70, 0x83, 0xef,
+	0xda, 0x01, 0x3f, 0x48, 0x70, 0xaa, 0x53, 0x7c, 0x00, 0xf8, 0x6f, 0x84, 0xf1, 0x7f, 0xa9, 0x8f,
+	0xc9, 0x76, 0x41, 0xff, 0xbd, 0x58, 0x54, 0xaa, 0x1c, 0x65, 0x1f, 0x4a, 0x30, 0x45, 0xc3, 0x34,
+	0x91, 0xf2, 0xc5, 0xe7, 0x43, 0x7e, 0xa0, 0xfa, 0x73, 0x22, 0xa8, 0xa9, 0x36, 0x06, 0x6e, 0xf7,
+	0x7c, 0x2c, 0x98, 0xff, 0x2c, 0x01, 0xf3, 0x97, 0xec, 0x32, 0x29, 0x6c, 0xae, 0x97, 0x88, 0xb5,
+	0xd7, 0xb6, 0x44, 0xd1, 0x19, 0x18, 0x55, 0x95, 0xa2, 0x6d, 0x6c, 0xeb, 0x84, 0x17, 0x66, 0xac,
+	0x38, 0xee, 0x9c, 0xeb, 0x72, 0xc1, 0xa5, 0xe1, 0x43, 0x2e, 0xfa, 0x2f, 0x8c, 0xaa, 0xba, 0x46,
+	0x0c, 0xb6, 0xbe, 0xc2, 0xa3, 0x1e, 0xf3, 0x51, 0xb0, 0x2c, 0xe8, 0xf8, 0x50, 0x02, 0x2d, 0x42,
+	0xaa, 0x62, 0x99, 0x76, 0x83, 0x2e, 0xeb, 0x8a, 0x56, 0x4f, 0xc7, 0xb9, 0xc2, 0x54, 0xab, 0x99,
+	0x4d, 0xad, 0xf9, 0x64, 0x1c, 0x94, 0x41, 0x67, 0x61, 0xdc, 0x7d, 0xdc, 0xb4, 0xc8, 0x8e, 0xb6,
+	0x9f, 0x1e, 0x76, 0x9d, 0xb4, 0x9a, 0xd9, 0xf1, 0xb5, 0x00, 0x1d, 0x87, 0xa4, 0x50, 0x1e, 0xc6,
+	0x34, 0x4a, 0x6d, 0x62, 0x5d, 0xc7, 0x97, 0xd3, 0x09, 0xae, 0x32, 0x2d, 0xe2, 0x1a, 0x5b, 0xf7,
+	0x18, 0xd8, 0x97, 0x41, 0xf7, 0x25, 0x98, 0xb4, 0xc8, 0xae, 0xad, 0x59, 0x64, 0x9b, 0x3b, 0xa6,
+	0xe9, 0x24, 0x47, 0x6a, 0xa5, 0x67, 0x44, 0x74, 0xaf, 0xaf, 0x8c, 0x43, 0x9e, 0x56, 0x0d, 0x66,
+	0x1d, 0x14, 0x4f, 0x89, 0xf8, 0x26, 0xc3, 0x4c, 0xdc, 0x16, 0x96, 0x53, 0x43, 0xaa, 0x55, 0x0c,
+	0xcd, 0xa8, 0x14, 0xf4, 0x0a, 0x4d, 0x8f, 0x2c, 0xc4, 0xbd, 0x1a, 0x96, 0x7c, 0x32, 0x0e, 0xca,
+	0xa0, 0x73, 0x30, 0x61, 0x53, 0x62, 0x19, 0x4a, 0x9d, 0xb8, 0x85, 0x1f, 0x75, 0x2b, 0xd2, 0x6a,
+	0x66, 0x27, 0xae, 0x07, 0x19, 0x38, 0x2c, 0x87, 0x2e, 0xc0, 0xa4, 0x47, 0x10, 0xe5, 0x1f, 0xe3,
+	0x9a, 0xc8, 0x89, 0xf3, 0x7a, 0x88, 0x83, 0xdb, 0x24, 0xe7, 0x0b, 0x30, 0x13, 0x91, 0x26, 0x3a,
+	0x01, 0xf1, 0x1a, 0x39, 0x70, 0x51, 0x85, 0x9d, 0x9f, 0x68, 0x16, 0x12, 0x7b, 0x8a, 0x6e, 0x13,
+	0x17, 0x3f, 0xd8, 0x7d, 0xb8, 0x10, 0x3b, 0x2f, 0xe5, 0x1e, 0xc4, 0xe0, 0x9f, 0xa1, 0xc2, 0xb9,
+	0x90, 0x2a, 0xd8, 0xac, 0x4a, 0x0c, 0xa6, 0xa9, 0x0a, 0xd3, 0x4c, 0x03, 0xe5, 0x20, 0x49, 0x89,
+	0x6a, 0x11, 0x26, 0xa0, 0x0a, 0xad, 0x66, 0x36, 0x59, 0xe2, 0x14, 0x2c, 0x38, 0xe8, 0x53, 0x09,
+	0x52, 0x64, 0x9f, 0x59, 0xca, 0xb2, 0x69, 0xec, 0x68, 0x15, 0x31, 0x85, 0x6a, 0xfd, 0x79, 0xff,
+	0x8b, 0x8a, 0x4a, 0x5e, 0xf5, 0xbd, 0xb9, 0xe7, 0x3b, 0x23, 0xce, 0x37, 0x15, 0xe0, 0xe0, 0x60,
+	0x50, 0xf3, 0xaf, 0xc3, 0x89, 0x76, 0xad, 0x67, 0x2a, 0xd7, 0xcf, 0x12, 0xcc, 0x1c, 0xcf, 0x1d,
+	0xc8, 0x0a, 0x6d, 0xb2, 0xfe, 0xbf, 0x56, 0x47, 0xad, 0xb2, 0x9f, 0x24, 0x98, 0x3b, 0x9e, 0x5b,
+	0xcf, 0x6e, 0x78, 0x97, 0x5d, 0xee, 0x67, 0xba, 0x5d, 0x96, 0xd9, 0x83, 0x38, 0x74, 0xbb, 0x52,
+	0xa0, 0xdb, 0x0e, 0xfe, 0x9d, 0x19, 0x23, 0x52, 0x2d, 0xbd, 0x80, 0x89, 0x55, 0x9c, 0x14, 0x61,
+	0x25, 0x5d, 0x26, 0x16, 0x2e, 0xd1, 0x07, 0x90, 0x74, 0xa7, 0xbb, 0x38, 0xfb, 0x1b, 0x2f, 0xae,
+	0xa5, 0xdc, 0xc6, 0x76, 0x39, 0x58, 0x78, 0x45, 0x3a, 0x4c, 0xf0, 0x7d, 0x7a, 0xb8, 0x3a, 0xe3,
+	0xbd, 0xaf, 0x4e, 0x3e, 0x0f, 0x4b, 0x41, 0x6b, 0x38, 0x6c, 0x1c, 0xfd, 0x1b, 0x92, 0xb7, 0x88,
+	0x56, 0xa9, 0x32, 0xbe, 0x86, 0x12, 0x7e, 0x55, 0xb6, 0x38, 0x15, 0x0b, 0x6e, 0xee, 0x5b, 0x09,
+	0x26, 0x06, 0x7d, 0xa3, 0xd2, 0x43, 0x3d, 0xd8, 0xcf, 0x57, 0xf8, 0xa8, 0xee, 0x6b, 0x4a, 0x30,
+	0x3d, 0xe8, 0x3b, 0x54, 0x2d, 0xdc, 0x77, 0x6f, 0xf4, 0x27, 0xc5, 0x2e, 0x1d, 0x77, 0x3f, 0x06,
+	0x9d, 0x57, 0x96, 0x4e, 0xb8, 0x49, 0x83, 0x81, 0x5b, 0xec, 0x69, 0x70, 0x43, 0xef, 0xc3, 0x28,
+	0x23, 0xf5, 0x86, 0xae, 0x30, 0x22, 0xf0, 0x7f, 0xa5, 0x3f, 0xb5, 0xb9, 0x26, 0xac, 0xfa, 0xc7,
+	0xe2, 0x51, 0xf0, 0xa1, 0xc7, 0xdc, 0x27, 0x09, 0x38, 0x19, 0xa9, 0x85, 0xaa, 0x30, 0x52, 0xb3,
+	0xcb, 0x44, 0x17, 0xab, 0x39, 0xb5, 0x54, 0x38, 0x62, 0x58, 0xaa, 0x69, 0x11, 0x59, 0x7c, 0xe3,
+	0xe4, 0x53, 0x49, 0x27, 0xcc, 0xdd, 0x8a, 0xc5, 0x54, 0xab, 0x99, 0x1d, 0x11, 0x24, 0xec, 0x99,
+	0x47, 0xf7, 0x24, 0x38, 0x59, 0x57, 0x34, 0x83, 0x11, 0x43, 0x31, 0x54, 0x72, 0x4d, 0xab, 0x93,
+	0x2d, 0xcd, 0xd8, 0x36, 0x6f, 0x89, 0x76, 0x58, 0xef, 0xc5, 0xf1, 0x46, 0x94, 0xc1, 0xe2, 0xe9,
+	0x56, 0x33, 0x7b, 0x32, 0x92, 0x85, 0xa3, 0x43, 0x40, 0x1f, 0x4b, 0x30, 0x5b, 0xd5, 0xca, 0xce,
+	0xdb, 0x91, 0x33, 0xc7, 0x4a, 0x6a, 0x95, 0x6c, 0xdb, 0x3a, 0xa1, 0xe9, 0x38, 0xc7, 0xf1, 0x5a,
+	0x2f, 0xb1, 0x5d, 0xec, 0xb4, 0x57, 0xfc, 0x87, 0x38, 0xa4, 0xd9, 0x08, 0x26, 0xc5, 0x91, 0x21,
+	0xa0, 0x5d, 0x00, 0xb2, 0xcf, 0x88, 0x41, 0x35, 0xd3, 0xa0, 0xe9, 0x61, 0x1e, 0xd0, 0x6b, 0xbd,
+	0x04, 0xb4, 0xea, 0x59, 0xf1, 0xc7, 0xd4, 0x21, 0x89, 0xe2, 0x80, 0x13, 0xd4, 0x80, 0xe4, 0x2d,
+	0xd3, 0xaa, 0x11, 0x8b, 0xbf, 0x98, 0x3f, 0xcf, 0xfe, 0x0c, 0xa1, 0x6e, 0x8b, 0xdb, 0x74, 0x97,
+	0x84, 0xfb, 0x1b, 0x0b, 0x3f, 0xb9, 0xbb, 0x71, 0x98, 0x89, 0x90, 0x45, 0xb7, 0x61, 0x5c, 0x74,
+	0xeb, 0x7a, 0x5d, 0xa9, 0x10, 0x01, 0xd2, 0xd5, 0x5e, 0xd2, 0xe7, 0xe6, 0x37, 0x02, 0xc6, 0xdc,
+	0x2b, 0x4a, 0x90, 0x82, 0x43, 0xce, 0xd0, 0x1d, 0x09, 0x92, 0xba, 0x33, 0x10, 0xbc, 0x79, 0xf6,
+	0x76, 0x3f, 0xeb, 0xe0, 0xce, 0x1a, 0x71, 0xb5, 0x38, 0x9c, 0x1b, 0x2e, 0x11, 0x0b, 0xbf, 0xa8,
+	0x00, 0x49, 0xe6, 0x20, 0xd6, 0x43, 0xe2, 0xe9, 0xc0, 0x18, 0xf3, 0xd2, 0x93, 0xaf, 0x39, 0x12,
+	0xbe, 0x09, 0xfe, 0x48, 0xb1, 0x50, 0x9c, 0x7f, 0x05, 0x52, 0x01, 0x4f, 0xcf, 0xf4, 0xba, 0xfa,
+	0x8d, 0x04, 0xa9, 0xc1, 0x7e, 0x70, 0xb9, 0x19, 0x5a, 0x91, 0xfd, 0xbb, 0xeb, 0x47, 0x2d, 0xc8,
+	0xaf, 0x25, 0x98, 0x1a, 0xec, 0x27, 0x16, 0x2d, 0xbc, 0x1e, 0x57, 0xfa, 0x91, 0x5e, 0x97, 0xe5,
+	0xf8, 0x55, 0x38, 0x39, 0x6c, 0xeb, 0x04, 0x2d, 0xc0, 0xb0, 0x73, 0xfb, 0xf3, 0xbe, 0x17, 0x78,
+	0x25, 0xb9, 0xa2, 0xd4, 0x09, 0xe6, 0x1c, 0xb4, 0xe4, 0xcc, 0x9a, 0x86, 0x45, 0xa8, 0x33, 0x07,
+	0xc4, 0xd7, 0x82, 0xc0, 0xb0, 0xf0, 0x38, 0x38, 0x20, 0x85, 0xfe, 0x03, 0x23, 0x75, 0x42, 0xa9,
+	0xd3, 0x9d, 0xe2, 0x6b, 0x81, 0x50, 0x18, 0xd9, 0x70, 0xc9, 0xd8, 0xe3, 0xa3, 0x57, 0x21, 0xa9,
+	0xa8, 0xce, 0x74, 0x13, 0xdf, 0x08, 0xfe, 0xe5, 0x41, 0xb6, 0xc0, 0xa9, 0x7f, 0x7a, 0xdf, 0x20,
+	0xdd, 0x98, 0x5d, 0x22, 0x16, 0x2a, 0xb9, 0xdf, 0xc2, 0x19, 0x1d, 0xc3, 0xb2, 0xaf, 0x43, 0xc2,
+	0xe2, 0x5b, 0xc1, 0x3d, 0xbe, 0xbe, 0xa0, 0xd3, 0x39, 0x18, 0xff, 0x08, 0x31, 0xdf, 0x03, 0xae,
+	0x97, 0xe2, 0xbb, 0x0f, 0x9f, 0x64, 0x86, 0x1e, 0x3d, 0xc9, 0x0c, 0x3d, 0x7e, 0x92, 0x19, 0xba,
+	0xd3, 0xca, 0x48, 0x0f, 0x5b, 0x19, 0xe9, 0x51, 0x2b, 0x23, 0x3d, 0x6e, 0x65, 0xa4, 0xef, 0x5a,
+	0x19, 0xe9, 0xa3, 0xef, 0x33, 0x43, 0x37, 0xce, 0xf5, 0xf8, 0xaf, 0xe8, 0x5f, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0x84, 0x07, 0x52, 0xea, 0x4f, 0x1d, 0x00, 0x00,
 }
 
 func (m *ClusterOpenIDConnectPreset) Marshal() (dAtA []byte, err error) {
@@ -484,7 +966,7 @@ func (m *ClusterOpenIDConnectPresetSpec) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *KubeAPIServerOpenIDConnect) Marshal() (dAtA []byte, err error) {
+func (m *ClusterShootDefaults) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -494,18 +976,288 @@ func (m *KubeAPIServerOpenIDConnect) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KubeAPIServerOpenIDConnect) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClusterShootDefaults) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KubeAPIServerOpenIDConnect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClusterShootDefaults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UsernamePrefix != nil {
-		i -= len(*m.UsernamePrefix)
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterShootDefaultsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterShootDefaultsList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterShootDefaultsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterShootDefaultsSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterShootDefaultsSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterShootDefaultsSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProjectSelector != nil {
+		{
+			size, err := m.ProjectSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ShootDefaultsSpec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterShootPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterShootPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterShootPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterShootPolicyList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterShootPolicyList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterShootPolicyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterShootPolicySpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterShootPolicySpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterShootPolicySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProjectSelector != nil {
+		{
+			size, err := m.ProjectSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ShootPolicySpec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *KubeAPIServerOpenIDConnect) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KubeAPIServerOpenIDConnect) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KubeAPIServerOpenIDConnect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UsernamePrefix != nil {
+		i -= len(*m.UsernamePrefix)
 		copy(dAtA[i:], *m.UsernamePrefix)
 		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.UsernamePrefix)))
 		i--
@@ -789,322 +1541,3223 @@ func (m *OpenIDConnectPresetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ShootDefaults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ClusterOpenIDConnectPreset) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+
+func (m *ShootDefaults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClusterOpenIDConnectPresetList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ShootDefaults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ClusterOpenIDConnectPresetSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.OpenIDConnectPresetSpec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.ProjectSelector != nil {
-		l = m.ProjectSelector.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *ShootDefaultsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *KubeAPIServerOpenIDConnect) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ShootDefaultsList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootDefaultsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CABundle != nil {
-		l = len(*m.CABundle)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.ClientID)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.GroupsClaim != nil {
-		l = len(*m.GroupsClaim)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.GroupsPrefix != nil {
-		l = len(*m.GroupsPrefix)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.IssuerURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.RequiredClaims) > 0 {
-		for k, v := range m.RequiredClaims {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.SigningAlgs) > 0 {
-		for _, s := range m.SigningAlgs {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.UsernameClaim != nil {
-		l = len(*m.UsernameClaim)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.UsernamePrefix != nil {
-		l = len(*m.UsernamePrefix)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *OpenIDConnectClientAuthentication) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShootDefaultsSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootDefaultsSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootDefaultsSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Secret != nil {
-		l = len(*m.Secret)
-		n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.ExtraConfig) > 0 {
-		for k, v := range m.ExtraConfig {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Weight))
+	i--
+	dAtA[i] = 0x10
+	if m.ShootSelector != nil {
+		{
+			size, err := m.ShootSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OpenIDConnectPreset) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShootDefaultsTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OpenIDConnectPresetList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
+func (m *ShootDefaultsTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OpenIDConnectPresetSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ShootDefaultsTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Server.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Client != nil {
-		l = m.Client.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Worker != nil {
+		{
+			size, err := m.Worker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.ShootSelector != nil {
-		l = m.ShootSelector.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Extensions) > 0 {
+		for iNdEx := len(m.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Extensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	n += 1 + sovGenerated(uint64(m.Weight))
-	return n
+	if len(m.HibernationSchedules) > 0 {
+		for iNdEx := len(m.HibernationSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HibernationSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaintenanceTimeWindow != nil {
+		{
+			size, err := m.MaintenanceTimeWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Kubelet != nil {
+		{
+			size, err := m.Kubelet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ShootDefaultsWorker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ShootDefaultsWorker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ClusterOpenIDConnectPreset) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ShootDefaultsWorker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Taints) > 0 {
+		for iNdEx := len(m.Taints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Taints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	s := strings.Join([]string{`&ClusterOpenIDConnectPreset{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ClusterOpenIDConnectPresetSpec", "ClusterOpenIDConnectPresetSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ClusterOpenIDConnectPresetList) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLabels[iNdEx])
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	repeatedStringForItems := "[]ClusterOpenIDConnectPreset{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterOpenIDConnectPreset", "ClusterOpenIDConnectPreset", 1), `&`, ``, 1) + ","
+	if m.MachineImage != nil {
+		{
+			size, err := m.MachineImage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ClusterOpenIDConnectPresetList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ClusterOpenIDConnectPresetSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ShootPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ClusterOpenIDConnectPresetSpec{`,
-		`OpenIDConnectPresetSpec:` + strings.Replace(strings.Replace(this.OpenIDConnectPresetSpec.String(), "OpenIDConnectPresetSpec", "OpenIDConnectPresetSpec", 1), `&`, ``, 1) + `,`,
-		`ProjectSelector:` + strings.Replace(fmt.Sprintf("%v", this.ProjectSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *KubeAPIServerOpenIDConnect) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForRequiredClaims := make([]string, 0, len(this.RequiredClaims))
-	for k := range this.RequiredClaims {
-		keysForRequiredClaims = append(keysForRequiredClaims, k)
+
+func (m *ShootPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForRequiredClaims)
-	mapStringForRequiredClaims := "map[string]string{"
-	for _, k := range keysForRequiredClaims {
-		mapStringForRequiredClaims += fmt.Sprintf("%v: %v,", k, this.RequiredClaims[k])
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	mapStringForRequiredClaims += "}"
-	s := strings.Join([]string{`&KubeAPIServerOpenIDConnect{`,
-		`CABundle:` + valueToStringGenerated(this.CABundle) + `,`,
-		`ClientID:` + fmt.Sprintf("%v", this.ClientID) + `,`,
-		`GroupsClaim:` + valueToStringGenerated(this.GroupsClaim) + `,`,
-		`GroupsPrefix:` + valueToStringGenerated(this.GroupsPrefix) + `,`,
-		`IssuerURL:` + fmt.Sprintf("%v", this.IssuerURL) + `,`,
-		`RequiredClaims:` + mapStringForRequiredClaims + `,`,
-		`SigningAlgs:` + fmt.Sprintf("%v", this.SigningAlgs) + `,`,
-		`UsernameClaim:` + valueToStringGenerated(this.UsernameClaim) + `,`,
-		`UsernamePrefix:` + valueToStringGenerated(this.UsernamePrefix) + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *OpenIDConnectClientAuthentication) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ShootPolicyList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	keysForExtraConfig := make([]string, 0, len(this.ExtraConfig))
-	for k := range this.ExtraConfig {
-		keysForExtraConfig = append(keysForExtraConfig, k)
+	return dAtA[:n], nil
+}
+
+func (m *ShootPolicyList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootPolicyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForExtraConfig)
-	mapStringForExtraConfig := "map[string]string{"
-	for _, k := range keysForExtraConfig {
-		mapStringForExtraConfig += fmt.Sprintf("%v: %v,", k, this.ExtraConfig[k])
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	mapStringForExtraConfig += "}"
-	s := strings.Join([]string{`&OpenIDConnectClientAuthentication{`,
-		`Secret:` + valueToStringGenerated(this.Secret) + `,`,
-		`ExtraConfig:` + mapStringForExtraConfig + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *OpenIDConnectPreset) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ShootPolicyRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&OpenIDConnectPreset{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "OpenIDConnectPresetSpec", "OpenIDConnectPresetSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *OpenIDConnectPresetList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ShootPolicyRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootPolicyRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ShootPolicySpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootPolicySpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootPolicySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ShootSelector != nil {
+		{
+			size, err := m.ShootSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClusterOpenIDConnectPreset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ClusterOpenIDConnectPresetList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterOpenIDConnectPresetSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OpenIDConnectPresetSpec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ProjectSelector != nil {
+		l = m.ProjectSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ClusterShootDefaults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ClusterShootDefaultsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterShootDefaultsSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShootDefaultsSpec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ProjectSelector != nil {
+		l = m.ProjectSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ClusterShootPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ClusterShootPolicyList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterShootPolicySpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShootPolicySpec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ProjectSelector != nil {
+		l = m.ProjectSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *KubeAPIServerOpenIDConnect) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CABundle != nil {
+		l = len(*m.CABundle)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ClientID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.GroupsClaim != nil {
+		l = len(*m.GroupsClaim)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.GroupsPrefix != nil {
+		l = len(*m.GroupsPrefix)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.IssuerURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RequiredClaims) > 0 {
+		for k, v := range m.RequiredClaims {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.SigningAlgs) > 0 {
+		for _, s := range m.SigningAlgs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.UsernameClaim != nil {
+		l = len(*m.UsernameClaim)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.UsernamePrefix != nil {
+		l = len(*m.UsernamePrefix)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *OpenIDConnectClientAuthentication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = len(*m.Secret)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ExtraConfig) > 0 {
+		for k, v := range m.ExtraConfig {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *OpenIDConnectPreset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *OpenIDConnectPresetList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OpenIDConnectPresetSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Server.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Client != nil {
+		l = m.Client.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ShootSelector != nil {
+		l = m.ShootSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Weight))
+	return n
+}
+
+func (m *ShootDefaults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ShootDefaultsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShootDefaultsSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShootSelector != nil {
+		l = m.ShootSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Weight))
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ShootDefaultsTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kubelet != nil {
+		l = m.Kubelet.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaintenanceTimeWindow != nil {
+		l = m.MaintenanceTimeWindow.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.HibernationSchedules) > 0 {
+		for _, e := range m.HibernationSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Extensions) > 0 {
+		for _, e := range m.Extensions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Worker != nil {
+		l = m.Worker.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ShootDefaultsWorker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MachineImage != nil {
+		l = m.MachineImage.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Taints) > 0 {
+		for _, e := range m.Taints {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShootPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ShootPolicyList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShootPolicyRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ShootPolicySpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShootSelector != nil {
+		l = m.ShootSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ClusterOpenIDConnectPreset) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterOpenIDConnectPreset{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ClusterOpenIDConnectPresetSpec", "ClusterOpenIDConnectPresetSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterOpenIDConnectPresetList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ClusterOpenIDConnectPreset{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterOpenIDConnectPreset", "ClusterOpenIDConnectPreset", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ClusterOpenIDConnectPresetList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterOpenIDConnectPresetSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterOpenIDConnectPresetSpec{`,
+		`OpenIDConnectPresetSpec:` + strings.Replace(strings.Replace(this.OpenIDConnectPresetSpec.String(), "OpenIDConnectPresetSpec", "OpenIDConnectPresetSpec", 1), `&`, ``, 1) + `,`,
+		`ProjectSelector:` + strings.Replace(fmt.Sprintf("%v", this.ProjectSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterShootDefaults) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterShootDefaults{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ClusterShootDefaultsSpec", "ClusterShootDefaultsSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterShootDefaultsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ClusterShootDefaults{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterShootDefaults", "ClusterShootDefaults", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ClusterShootDefaultsList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterShootDefaultsSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterShootDefaultsSpec{`,
+		`ShootDefaultsSpec:` + strings.Replace(strings.Replace(this.ShootDefaultsSpec.String(), "ShootDefaultsSpec", "ShootDefaultsSpec", 1), `&`, ``, 1) + `,`,
+		`ProjectSelector:` + strings.Replace(fmt.Sprintf("%v", this.ProjectSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterShootPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterShootPolicy{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ClusterShootPolicySpec", "ClusterShootPolicySpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterShootPolicyList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ClusterShootPolicy{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterShootPolicy", "ClusterShootPolicy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ClusterShootPolicyList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterShootPolicySpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterShootPolicySpec{`,
+		`ShootPolicySpec:` + strings.Replace(strings.Replace(this.ShootPolicySpec.String(), "ShootPolicySpec", "ShootPolicySpec", 1), `&`, ``, 1) + `,`,
+		`ProjectSelector:` + strings.Replace(fmt.Sprintf("%v", this.ProjectSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KubeAPIServerOpenIDConnect) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForRequiredClaims := make([]string, 0, len(this.RequiredClaims))
+	for k := range this.RequiredClaims {
+		keysForRequiredClaims = append(keysForRequiredClaims, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRequiredClaims)
+	mapStringForRequiredClaims := "map[string]string{"
+	for _, k := range keysForRequiredClaims {
+		mapStringForRequiredClaims += fmt.Sprintf("%v: %v,", k, this.RequiredClaims[k])
+	}
+	mapStringForRequiredClaims += "}"
+	s := strings.Join([]string{`&KubeAPIServerOpenIDConnect{`,
+		`CABundle:` + valueToStringGenerated(this.CABundle) + `,`,
+		`ClientID:` + fmt.Sprintf("%v", this.ClientID) + `,`,
+		`GroupsClaim:` + valueToStringGenerated(this.GroupsClaim) + `,`,
+		`GroupsPrefix:` + valueToStringGenerated(this.GroupsPrefix) + `,`,
+		`IssuerURL:` + fmt.Sprintf("%v", this.IssuerURL) + `,`,
+		`RequiredClaims:` + mapStringForRequiredClaims + `,`,
+		`SigningAlgs:` + fmt.Sprintf("%v", this.SigningAlgs) + `,`,
+		`UsernameClaim:` + valueToStringGenerated(this.UsernameClaim) + `,`,
+		`UsernamePrefix:` + valueToStringGenerated(this.UsernamePrefix) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OpenIDConnectClientAuthentication) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForExtraConfig := make([]string, 0, len(this.ExtraConfig))
+	for k := range this.ExtraConfig {
+		keysForExtraConfig = append(keysForExtraConfig, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExtraConfig)
+	mapStringForExtraConfig := "map[string]string{"
+	for _, k := range keysForExtraConfig {
+		mapStringForExtraConfig += fmt.Sprintf("%v: %v,", k, this.ExtraConfig[k])
+	}
+	mapStringForExtraConfig += "}"
+	s := strings.Join([]string{`&OpenIDConnectClientAuthentication{`,
+		`Secret:` + valueToStringGenerated(this.Secret) + `,`,
+		`ExtraConfig:` + mapStringForExtraConfig + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OpenIDConnectPreset) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OpenIDConnectPreset{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "OpenIDConnectPresetSpec", "OpenIDConnectPresetSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OpenIDConnectPresetList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]OpenIDConnectPreset{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "OpenIDConnectPreset", "OpenIDConnectPreset", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&OpenIDConnectPresetList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OpenIDConnectPresetSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OpenIDConnectPresetSpec{`,
+		`Server:` + strings.Replace(strings.Replace(this.Server.String(), "KubeAPIServerOpenIDConnect", "KubeAPIServerOpenIDConnect", 1), `&`, ``, 1) + `,`,
+		`Client:` + strings.Replace(this.Client.String(), "OpenIDConnectClientAuthentication", "OpenIDConnectClientAuthentication", 1) + `,`,
+		`ShootSelector:` + strings.Replace(fmt.Sprintf("%v", this.ShootSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootDefaults) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootDefaults{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ShootDefaultsSpec", "ShootDefaultsSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootDefaultsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ShootDefaults{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ShootDefaults", "ShootDefaults", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ShootDefaultsList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootDefaultsSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootDefaultsSpec{`,
+		`ShootSelector:` + strings.Replace(fmt.Sprintf("%v", this.ShootSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ShootDefaultsTemplate", "ShootDefaultsTemplate", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootDefaultsTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHibernationSchedules := "[]HibernationSchedule{"
+	for _, f := range this.HibernationSchedules {
+		repeatedStringForHibernationSchedules += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForHibernationSchedules += "}"
+	repeatedStringForExtensions := "[]Extension{"
+	for _, f := range this.Extensions {
+		repeatedStringForExtensions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForExtensions += "}"
+	s := strings.Join([]string{`&ShootDefaultsTemplate{`,
+		`Kubelet:` + strings.Replace(fmt.Sprintf("%v", this.Kubelet), "KubeletConfig", "v1beta1.KubeletConfig", 1) + `,`,
+		`MaintenanceTimeWindow:` + strings.Replace(fmt.Sprintf("%v", this.MaintenanceTimeWindow), "MaintenanceTimeWindow", "v1beta1.MaintenanceTimeWindow", 1) + `,`,
+		`HibernationSchedules:` + repeatedStringForHibernationSchedules + `,`,
+		`Extensions:` + repeatedStringForExtensions + `,`,
+		`Worker:` + strings.Replace(this.Worker.String(), "ShootDefaultsWorker", "ShootDefaultsWorker", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootDefaultsWorker) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTaints := "[]Taint{"
+	for _, f := range this.Taints {
+		repeatedStringForTaints += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForTaints += "}"
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&ShootDefaultsWorker{`,
+		`MachineImage:` + strings.Replace(fmt.Sprintf("%v", this.MachineImage), "ShootMachineImage", "v1beta1.ShootMachineImage", 1) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Taints:` + repeatedStringForTaints + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootPolicy{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ShootPolicySpec", "ShootPolicySpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootPolicyList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ShootPolicy{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ShootPolicy", "ShootPolicy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ShootPolicyList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootPolicyRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootPolicyRule{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootPolicySpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRules := "[]ShootPolicyRule{"
+	for _, f := range this.Rules {
+		repeatedStringForRules += strings.Replace(strings.Replace(f.String(), "ShootPolicyRule", "ShootPolicyRule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRules += "}"
+	s := strings.Join([]string{`&ShootPolicySpec{`,
+		`ShootSelector:` + strings.Replace(fmt.Sprintf("%v", this.ShootSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`Rules:` + repeatedStringForRules + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ClusterOpenIDConnectPreset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterOpenIDConnectPreset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterOpenIDConnectPreset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterOpenIDConnectPresetList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterOpenIDConnectPresetList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterOpenIDConnectPresetList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ClusterOpenIDConnectPreset{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterOpenIDConnectPresetSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterOpenIDConnectPresetSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterOpenIDConnectPresetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenIDConnectPresetSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenIDConnectPresetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProjectSelector == nil {
+				m.ProjectSelector = &v1.LabelSelector{}
+			}
+			if err := m.ProjectSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterShootDefaults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterShootDefaults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterShootDefaults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterShootDefaultsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterShootDefaultsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterShootDefaultsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ClusterShootDefaults{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterShootDefaultsSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterShootDefaultsSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterShootDefaultsSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShootDefaultsSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShootDefaultsSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProjectSelector == nil {
+				m.ProjectSelector = &v1.LabelSelector{}
+			}
+			if err := m.ProjectSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterShootPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterShootPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterShootPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterShootPolicyList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterShootPolicyList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterShootPolicyList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ClusterShootPolicy{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterShootPolicySpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterShootPolicySpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterShootPolicySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShootPolicySpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShootPolicySpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProjectSelector == nil {
+				m.ProjectSelector = &v1.LabelSelector{}
+			}
+			if err := m.ProjectSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeAPIServerOpenIDConnect) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeAPIServerOpenIDConnect: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeAPIServerOpenIDConnect: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.CABundle = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupsClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.GroupsClaim = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupsPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.GroupsPrefix = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequiredClaims == nil {
+				m.RequiredClaims = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RequiredClaims[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningAlgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningAlgs = append(m.SigningAlgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.UsernameClaim = &s
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernamePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.UsernamePrefix = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenIDConnectClientAuthentication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenIDConnectClientAuthentication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Secret = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtraConfig == nil {
+				m.ExtraConfig = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ExtraConfig[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpenIDConnectPreset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenIDConnectPreset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenIDConnectPreset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	repeatedStringForItems := "[]OpenIDConnectPreset{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "OpenIDConnectPreset", "OpenIDConnectPreset", 1), `&`, ``, 1) + ","
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&OpenIDConnectPresetList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *OpenIDConnectPresetSpec) String() string {
-	if this == nil {
-		return "nil"
+func (m *OpenIDConnectPresetList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenIDConnectPresetList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenIDConnectPresetList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, OpenIDConnectPreset{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&OpenIDConnectPresetSpec{`,
-		`Server:` + strings.Replace(strings.Replace(this.Server.String(), "KubeAPIServerOpenIDConnect", "KubeAPIServerOpenIDConnect", 1), `&`, ``, 1) + `,`,
-		`Client:` + strings.Replace(this.Client.String(), "OpenIDConnectClientAuthentication", "OpenIDConnectClientAuthentication", 1) + `,`,
-		`ShootSelector:` + strings.Replace(fmt.Sprintf("%v", this.ShootSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
-		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *ClusterOpenIDConnectPreset) Unmarshal(dAtA []byte) error {
+func (m *OpenIDConnectPresetSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1127,15 +4780,15 @@ func (m *ClusterOpenIDConnectPreset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterOpenIDConnectPreset: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpenIDConnectPresetSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterOpenIDConnectPreset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OpenIDConnectPresetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1162,13 +4815,13 @@ func (m *ClusterOpenIDConnectPreset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Server.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1195,10 +4848,68 @@ func (m *ClusterOpenIDConnectPreset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Client == nil {
+				m.Client = &OpenIDConnectClientAuthentication{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShootSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShootSelector == nil {
+				m.ShootSelector = &v1.LabelSelector{}
+			}
+			if err := m.ShootSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -1220,7 +4931,7 @@ func (m *ClusterOpenIDConnectPreset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterOpenIDConnectPresetList) Unmarshal(dAtA []byte) error {
+func (m *ShootDefaults) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1243,15 +4954,15 @@ func (m *ClusterOpenIDConnectPresetList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterOpenIDConnectPresetList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootDefaults: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterOpenIDConnectPresetList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootDefaults: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1278,13 +4989,13 @@ func (m *ClusterOpenIDConnectPresetList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1311,8 +5022,7 @@ func (m *ClusterOpenIDConnectPresetList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterOpenIDConnectPreset{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1337,7 +5047,7 @@ func (m *ClusterOpenIDConnectPresetList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterOpenIDConnectPresetSpec) Unmarshal(dAtA []byte) error {
+func (m *ShootDefaultsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1360,15 +5070,15 @@ func (m *ClusterOpenIDConnectPresetSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterOpenIDConnectPresetSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootDefaultsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterOpenIDConnectPresetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootDefaultsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenIDConnectPresetSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1395,13 +5105,13 @@ func (m *ClusterOpenIDConnectPresetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OpenIDConnectPresetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1428,10 +5138,8 @@ func (m *ClusterOpenIDConnectPresetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProjectSelector == nil {
-				m.ProjectSelector = &v1.LabelSelector{}
-			}
-			if err := m.ProjectSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ShootDefaults{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1456,7 +5164,7 @@ func (m *ClusterOpenIDConnectPresetSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubeAPIServerOpenIDConnect) Unmarshal(dAtA []byte) error {
+func (m *ShootDefaultsSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1479,17 +5187,17 @@ func (m *KubeAPIServerOpenIDConnect) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubeAPIServerOpenIDConnect: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootDefaultsSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubeAPIServerOpenIDConnect: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootDefaultsSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShootSelector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1499,30 +5207,33 @@ func (m *KubeAPIServerOpenIDConnect) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.CABundle = &s
+			if m.ShootSelector == nil {
+				m.ShootSelector = &v1.LabelSelector{}
+			}
+			if err := m.ShootSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var stringLen uint64
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1532,29 +5243,16 @@ func (m *KubeAPIServerOpenIDConnect) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Weight |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupsClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1564,63 +5262,80 @@ func (m *KubeAPIServerOpenIDConnect) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.GroupsClaim = &s
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupsPrefix", wireType)
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootDefaultsTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.GroupsPrefix = &s
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootDefaultsTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootDefaultsTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubelet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1630,27 +5345,31 @@ func (m *KubeAPIServerOpenIDConnect) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IssuerURL = string(dAtA[iNdEx:postIndex])
+			if m.Kubelet == nil {
+				m.Kubelet = &v1beta1.KubeletConfig{}
+			}
+			if err := m.Kubelet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredClaims", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceTimeWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1677,109 +5396,18 @@ func (m *KubeAPIServerOpenIDConnect) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RequiredClaims == nil {
-				m.RequiredClaims = make(map[string]string)
+			if m.MaintenanceTimeWindow == nil {
+				m.MaintenanceTimeWindow = &v1beta1.MaintenanceTimeWindow{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.MaintenanceTimeWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RequiredClaims[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningAlgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HibernationSchedules", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1789,29 +5417,31 @@ func (m *KubeAPIServerOpenIDConnect) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SigningAlgs = append(m.SigningAlgs, string(dAtA[iNdEx:postIndex]))
+			m.HibernationSchedules = append(m.HibernationSchedules, v1beta1.HibernationSchedule{})
+			if err := m.HibernationSchedules[len(m.HibernationSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1821,30 +5451,31 @@ func (m *KubeAPIServerOpenIDConnect) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.UsernameClaim = &s
+			m.Extensions = append(m.Extensions, v1beta1.Extension{})
+			if err := m.Extensions[len(m.Extensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernamePrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1854,24 +5485,27 @@ func (m *KubeAPIServerOpenIDConnect) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.UsernamePrefix = &s
+			if m.Worker == nil {
+				m.Worker = &ShootDefaultsWorker{}
+			}
+			if err := m.Worker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1894,7 +5528,7 @@ func (m *KubeAPIServerOpenIDConnect) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
+func (m *ShootDefaultsWorker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1917,17 +5551,17 @@ func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OpenIDConnectClientAuthentication: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootDefaultsWorker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OpenIDConnectClientAuthentication: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootDefaultsWorker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImage", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1937,28 +5571,31 @@ func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Secret = &s
+			if m.MachineImage == nil {
+				m.MachineImage = &v1beta1.ShootMachineImage{}
+			}
+			if err := m.MachineImage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1985,8 +5622,8 @@ func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExtraConfig == nil {
-				m.ExtraConfig = make(map[string]string)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -2081,7 +5718,41 @@ func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.ExtraConfig[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Taints = append(m.Taints, v11.Taint{})
+			if err := m.Taints[len(m.Taints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2104,7 +5775,7 @@ func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OpenIDConnectPreset) Unmarshal(dAtA []byte) error {
+func (m *ShootPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2127,10 +5798,10 @@ func (m *OpenIDConnectPreset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OpenIDConnectPreset: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OpenIDConnectPreset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2220,7 +5891,7 @@ func (m *OpenIDConnectPreset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OpenIDConnectPresetList) Unmarshal(dAtA []byte) error {
+func (m *ShootPolicyList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2243,10 +5914,10 @@ func (m *OpenIDConnectPresetList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OpenIDConnectPresetList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootPolicyList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OpenIDConnectPresetList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootPolicyList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2311,7 +5982,7 @@ func (m *OpenIDConnectPresetList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, OpenIDConnectPreset{})
+			m.Items = append(m.Items, ShootPolicy{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2337,7 +6008,7 @@ func (m *OpenIDConnectPresetList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OpenIDConnectPresetSpec) Unmarshal(dAtA []byte) error {
+func (m *ShootPolicyRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2360,17 +6031,17 @@ func (m *OpenIDConnectPresetSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OpenIDConnectPresetSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootPolicyRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OpenIDConnectPresetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootPolicyRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2380,30 +6051,29 @@ func (m *OpenIDConnectPresetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Server.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2413,29 +6083,139 @@ func (m *OpenIDConnectPresetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Client == nil {
-				m.Client = &OpenIDConnectClientAuthentication{}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = ShootPolicyAction(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootPolicySpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootPolicySpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootPolicySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ShootSelector", wireType)
 			}
@@ -2471,11 +6251,11 @@ func (m *OpenIDConnectPresetSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
-			m.Weight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2485,11 +6265,26 @@ func (m *OpenIDConnectPresetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, ShootPolicyRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -49,8 +49,16 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&ClusterOpenIDConnectPreset{},
 		&ClusterOpenIDConnectPresetList{},
+		&ClusterShootDefaults{},
+		&ClusterShootDefaultsList{},
+		&ClusterShootPolicy{},
+		&ClusterShootPolicyList{},
 		&OpenIDConnectPreset{},
 		&OpenIDConnectPresetList{},
+		&ShootDefaults{},
+		&ShootDefaultsList{},
+		&ShootPolicy{},
+		&ShootPolicyList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
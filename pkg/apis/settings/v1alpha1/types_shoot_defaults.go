@@ -0,0 +1,95 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootDefaults contains default values which are applied to new Shoots in a namespace.
+type ShootDefaults struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec is the specification of this ShootDefaults.
+	Spec ShootDefaultsSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// ShootDefaultsSpec contains the Shoot selector and the default values of a ShootDefaults.
+type ShootDefaultsSpec struct {
+	// ShootSelector decides whether to apply the defaults if the Shoot has matching labels.
+	// Defaults to the empty LabelSelector, which matches everything.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty" protobuf:"bytes,1,opt,name=shootSelector"`
+	// Weight associated with matching the corresponding defaults, in the range 1-100.
+	// Defaults with a higher weight take precedence over defaults with a lower weight.
+	// Required.
+	Weight int32 `json:"weight" protobuf:"varint,2,opt,name=weight"`
+	// Template contains the default values for the Shoot.
+	Template ShootDefaultsTemplate `json:"template" protobuf:"bytes,3,opt,name=template"`
+}
+
+// ShootDefaultsTemplate contains the default values which are applied to a Shoot. Values which are already set in the
+// Shoot are never overwritten.
+type ShootDefaultsTemplate struct {
+	// Kubelet contains default kubelet settings which are applied to `.spec.kubernetes.kubelet`. Only the top-level
+	// fields which are not set in the Shoot are defaulted.
+	// +optional
+	Kubelet *gardencorev1beta1.KubeletConfig `json:"kubelet,omitempty" protobuf:"bytes,1,opt,name=kubelet"`
+	// MaintenanceTimeWindow is the default maintenance time window. It is only applied if the Shoot does not specify one.
+	// +optional
+	MaintenanceTimeWindow *gardencorev1beta1.MaintenanceTimeWindow `json:"maintenanceTimeWindow,omitempty" protobuf:"bytes,2,opt,name=maintenanceTimeWindow"`
+	// HibernationSchedules are the default hibernation schedules. They are only applied if the Shoot does not specify
+	// any hibernation schedule.
+	// +optional
+	HibernationSchedules []gardencorev1beta1.HibernationSchedule `json:"hibernationSchedules,omitempty" protobuf:"bytes,3,rep,name=hibernationSchedules"`
+	// Extensions are added to the Shoot unless it already configures an extension of the same type.
+	// +optional
+	Extensions []gardencorev1beta1.Extension `json:"extensions,omitempty" protobuf:"bytes,4,rep,name=extensions"`
+	// Worker contains default values which are applied to all worker pools of the Shoot.
+	// +optional
+	Worker *ShootDefaultsWorker `json:"worker,omitempty" protobuf:"bytes,5,opt,name=worker"`
+}
+
+// ShootDefaultsWorker contains default values for the worker pools of a Shoot.
+type ShootDefaultsWorker struct {
+	// MachineImage is the default machine image. It is only applied if the worker pool does not specify one.
+	// +optional
+	MachineImage *gardencorev1beta1.ShootMachineImage `json:"machineImage,omitempty" protobuf:"bytes,1,opt,name=machineImage"`
+	// Labels are added to the worker pool unless it already specifies a label with the same key.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,2,rep,name=labels"`
+	// Taints are added to the worker pool unless it already specifies a taint with the same key.
+	// +optional
+	Taints []corev1.Taint `json:"taints,omitempty" protobuf:"bytes,3,rep,name=taints"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootDefaultsList is a collection of ShootDefaults.
+type ShootDefaultsList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of ShootDefaults.
+	Items []ShootDefaults `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
@@ -24,7 +24,10 @@ package v1alpha1
 import (
 	unsafe "unsafe"
 
+	core "github.com/gardener/gardener/pkg/apis/core"
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	settings "github.com/gardener/gardener/pkg/apis/settings"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -67,6 +70,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootDefaults)(nil), (*settings.ClusterShootDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootDefaults_To_settings_ClusterShootDefaults(a.(*ClusterShootDefaults), b.(*settings.ClusterShootDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootDefaults)(nil), (*ClusterShootDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootDefaults_To_v1alpha1_ClusterShootDefaults(a.(*settings.ClusterShootDefaults), b.(*ClusterShootDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootDefaultsList)(nil), (*settings.ClusterShootDefaultsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootDefaultsList_To_settings_ClusterShootDefaultsList(a.(*ClusterShootDefaultsList), b.(*settings.ClusterShootDefaultsList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootDefaultsList)(nil), (*ClusterShootDefaultsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootDefaultsList_To_v1alpha1_ClusterShootDefaultsList(a.(*settings.ClusterShootDefaultsList), b.(*ClusterShootDefaultsList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootDefaultsSpec)(nil), (*settings.ClusterShootDefaultsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootDefaultsSpec_To_settings_ClusterShootDefaultsSpec(a.(*ClusterShootDefaultsSpec), b.(*settings.ClusterShootDefaultsSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootDefaultsSpec)(nil), (*ClusterShootDefaultsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootDefaultsSpec_To_v1alpha1_ClusterShootDefaultsSpec(a.(*settings.ClusterShootDefaultsSpec), b.(*ClusterShootDefaultsSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPolicy)(nil), (*settings.ClusterShootPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy(a.(*ClusterShootPolicy), b.(*settings.ClusterShootPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPolicy)(nil), (*ClusterShootPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy(a.(*settings.ClusterShootPolicy), b.(*ClusterShootPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPolicyList)(nil), (*settings.ClusterShootPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList(a.(*ClusterShootPolicyList), b.(*settings.ClusterShootPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPolicyList)(nil), (*ClusterShootPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList(a.(*settings.ClusterShootPolicyList), b.(*ClusterShootPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPolicySpec)(nil), (*settings.ClusterShootPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(a.(*ClusterShootPolicySpec), b.(*settings.ClusterShootPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPolicySpec)(nil), (*ClusterShootPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(a.(*settings.ClusterShootPolicySpec), b.(*ClusterShootPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeAPIServerOpenIDConnect)(nil), (*settings.KubeAPIServerOpenIDConnect)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_KubeAPIServerOpenIDConnect_To_settings_KubeAPIServerOpenIDConnect(a.(*KubeAPIServerOpenIDConnect), b.(*settings.KubeAPIServerOpenIDConnect), scope)
 	}); err != nil {
@@ -117,6 +180,116 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootDefaults)(nil), (*settings.ShootDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootDefaults_To_settings_ShootDefaults(a.(*ShootDefaults), b.(*settings.ShootDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootDefaults)(nil), (*ShootDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootDefaults_To_v1alpha1_ShootDefaults(a.(*settings.ShootDefaults), b.(*ShootDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootDefaultsList)(nil), (*settings.ShootDefaultsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootDefaultsList_To_settings_ShootDefaultsList(a.(*ShootDefaultsList), b.(*settings.ShootDefaultsList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootDefaultsList)(nil), (*ShootDefaultsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootDefaultsList_To_v1alpha1_ShootDefaultsList(a.(*settings.ShootDefaultsList), b.(*ShootDefaultsList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootDefaultsSpec)(nil), (*settings.ShootDefaultsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootDefaultsSpec_To_settings_ShootDefaultsSpec(a.(*ShootDefaultsSpec), b.(*settings.ShootDefaultsSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootDefaultsSpec)(nil), (*ShootDefaultsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootDefaultsSpec_To_v1alpha1_ShootDefaultsSpec(a.(*settings.ShootDefaultsSpec), b.(*ShootDefaultsSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootDefaultsTemplate)(nil), (*settings.ShootDefaultsTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootDefaultsTemplate_To_settings_ShootDefaultsTemplate(a.(*ShootDefaultsTemplate), b.(*settings.ShootDefaultsTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootDefaultsTemplate)(nil), (*ShootDefaultsTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootDefaultsTemplate_To_v1alpha1_ShootDefaultsTemplate(a.(*settings.ShootDefaultsTemplate), b.(*ShootDefaultsTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootDefaultsWorker)(nil), (*settings.ShootDefaultsWorker)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootDefaultsWorker_To_settings_ShootDefaultsWorker(a.(*ShootDefaultsWorker), b.(*settings.ShootDefaultsWorker), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootDefaultsWorker)(nil), (*ShootDefaultsWorker)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootDefaultsWorker_To_v1alpha1_ShootDefaultsWorker(a.(*settings.ShootDefaultsWorker), b.(*ShootDefaultsWorker), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicy)(nil), (*settings.ShootPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicy_To_settings_ShootPolicy(a.(*ShootPolicy), b.(*settings.ShootPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicy)(nil), (*ShootPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicy_To_v1alpha1_ShootPolicy(a.(*settings.ShootPolicy), b.(*ShootPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicyList)(nil), (*settings.ShootPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList(a.(*ShootPolicyList), b.(*settings.ShootPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicyList)(nil), (*ShootPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList(a.(*settings.ShootPolicyList), b.(*ShootPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicyRule)(nil), (*settings.ShootPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicyRule_To_settings_ShootPolicyRule(a.(*ShootPolicyRule), b.(*settings.ShootPolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicyRule)(nil), (*ShootPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicyRule_To_v1alpha1_ShootPolicyRule(a.(*settings.ShootPolicyRule), b.(*ShootPolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicySpec)(nil), (*settings.ShootPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(a.(*ShootPolicySpec), b.(*settings.ShootPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicySpec)(nil), (*ShootPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(a.(*settings.ShootPolicySpec), b.(*ShootPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*core.KubeletConfig)(nil), (*v1beta1.KubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KubeletConfig_To_v1beta1_KubeletConfig(a.(*core.KubeletConfig), b.(*v1beta1.KubeletConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*core.ShootMachineImage)(nil), (*v1beta1.ShootMachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(a.(*core.ShootMachineImage), b.(*v1beta1.ShootMachineImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.KubeletConfig)(nil), (*core.KubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubeletConfig_To_core_KubeletConfig(a.(*v1beta1.KubeletConfig), b.(*core.KubeletConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.ShootMachineImage)(nil), (*core.ShootMachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(a.(*v1beta1.ShootMachineImage), b.(*core.ShootMachineImage), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -194,6 +367,174 @@ func Convert_settings_ClusterOpenIDConnectPresetSpec_To_v1alpha1_ClusterOpenIDCo
 	return autoConvert_settings_ClusterOpenIDConnectPresetSpec_To_v1alpha1_ClusterOpenIDConnectPresetSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_ClusterShootDefaults_To_settings_ClusterShootDefaults(in *ClusterShootDefaults, out *settings.ClusterShootDefaults, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ClusterShootDefaultsSpec_To_settings_ClusterShootDefaultsSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootDefaults_To_settings_ClusterShootDefaults is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootDefaults_To_settings_ClusterShootDefaults(in *ClusterShootDefaults, out *settings.ClusterShootDefaults, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootDefaults_To_settings_ClusterShootDefaults(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootDefaults_To_v1alpha1_ClusterShootDefaults(in *settings.ClusterShootDefaults, out *ClusterShootDefaults, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_settings_ClusterShootDefaultsSpec_To_v1alpha1_ClusterShootDefaultsSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_settings_ClusterShootDefaults_To_v1alpha1_ClusterShootDefaults is an autogenerated conversion function.
+func Convert_settings_ClusterShootDefaults_To_v1alpha1_ClusterShootDefaults(in *settings.ClusterShootDefaults, out *ClusterShootDefaults, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootDefaults_To_v1alpha1_ClusterShootDefaults(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterShootDefaultsList_To_settings_ClusterShootDefaultsList(in *ClusterShootDefaultsList, out *settings.ClusterShootDefaultsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]settings.ClusterShootDefaults, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_ClusterShootDefaults_To_settings_ClusterShootDefaults(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootDefaultsList_To_settings_ClusterShootDefaultsList is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootDefaultsList_To_settings_ClusterShootDefaultsList(in *ClusterShootDefaultsList, out *settings.ClusterShootDefaultsList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootDefaultsList_To_settings_ClusterShootDefaultsList(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootDefaultsList_To_v1alpha1_ClusterShootDefaultsList(in *settings.ClusterShootDefaultsList, out *ClusterShootDefaultsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterShootDefaults, len(*in))
+		for i := range *in {
+			if err := Convert_settings_ClusterShootDefaults_To_v1alpha1_ClusterShootDefaults(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_settings_ClusterShootDefaultsList_To_v1alpha1_ClusterShootDefaultsList is an autogenerated conversion function.
+func Convert_settings_ClusterShootDefaultsList_To_v1alpha1_ClusterShootDefaultsList(in *settings.ClusterShootDefaultsList, out *ClusterShootDefaultsList, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootDefaultsList_To_v1alpha1_ClusterShootDefaultsList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterShootDefaultsSpec_To_settings_ClusterShootDefaultsSpec(in *ClusterShootDefaultsSpec, out *settings.ClusterShootDefaultsSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ShootDefaultsSpec_To_settings_ShootDefaultsSpec(&in.ShootDefaultsSpec, &out.ShootDefaultsSpec, s); err != nil {
+		return err
+	}
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootDefaultsSpec_To_settings_ClusterShootDefaultsSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootDefaultsSpec_To_settings_ClusterShootDefaultsSpec(in *ClusterShootDefaultsSpec, out *settings.ClusterShootDefaultsSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootDefaultsSpec_To_settings_ClusterShootDefaultsSpec(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootDefaultsSpec_To_v1alpha1_ClusterShootDefaultsSpec(in *settings.ClusterShootDefaultsSpec, out *ClusterShootDefaultsSpec, s conversion.Scope) error {
+	if err := Convert_settings_ShootDefaultsSpec_To_v1alpha1_ShootDefaultsSpec(&in.ShootDefaultsSpec, &out.ShootDefaultsSpec, s); err != nil {
+		return err
+	}
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_settings_ClusterShootDefaultsSpec_To_v1alpha1_ClusterShootDefaultsSpec is an autogenerated conversion function.
+func Convert_settings_ClusterShootDefaultsSpec_To_v1alpha1_ClusterShootDefaultsSpec(in *settings.ClusterShootDefaultsSpec, out *ClusterShootDefaultsSpec, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootDefaultsSpec_To_v1alpha1_ClusterShootDefaultsSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy(in *ClusterShootPolicy, out *settings.ClusterShootPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy(in *ClusterShootPolicy, out *settings.ClusterShootPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy(in *settings.ClusterShootPolicy, out *ClusterShootPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy is an autogenerated conversion function.
+func Convert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy(in *settings.ClusterShootPolicy, out *ClusterShootPolicy, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList(in *ClusterShootPolicyList, out *settings.ClusterShootPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]settings.ClusterShootPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList(in *ClusterShootPolicyList, out *settings.ClusterShootPolicyList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList(in *settings.ClusterShootPolicyList, out *ClusterShootPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterShootPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList is an autogenerated conversion function.
+func Convert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList(in *settings.ClusterShootPolicyList, out *ClusterShootPolicyList, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(in *ClusterShootPolicySpec, out *settings.ClusterShootPolicySpec, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(&in.ShootPolicySpec, &out.ShootPolicySpec, s); err != nil {
+		return err
+	}
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(in *ClusterShootPolicySpec, out *settings.ClusterShootPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(in *settings.ClusterShootPolicySpec, out *ClusterShootPolicySpec, s conversion.Scope) error {
+	if err := Convert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(&in.ShootPolicySpec, &out.ShootPolicySpec, s); err != nil {
+		return err
+	}
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec is an autogenerated conversion function.
+func Convert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(in *settings.ClusterShootPolicySpec, out *ClusterShootPolicySpec, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_KubeAPIServerOpenIDConnect_To_settings_KubeAPIServerOpenIDConnect(in *KubeAPIServerOpenIDConnect, out *settings.KubeAPIServerOpenIDConnect, s conversion.Scope) error {
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
 	out.ClientID = in.ClientID
@@ -329,3 +670,295 @@ func autoConvert_settings_OpenIDConnectPresetSpec_To_v1alpha1_OpenIDConnectPrese
 func Convert_settings_OpenIDConnectPresetSpec_To_v1alpha1_OpenIDConnectPresetSpec(in *settings.OpenIDConnectPresetSpec, out *OpenIDConnectPresetSpec, s conversion.Scope) error {
 	return autoConvert_settings_OpenIDConnectPresetSpec_To_v1alpha1_OpenIDConnectPresetSpec(in, out, s)
 }
+
+func autoConvert_v1alpha1_ShootDefaults_To_settings_ShootDefaults(in *ShootDefaults, out *settings.ShootDefaults, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ShootDefaultsSpec_To_settings_ShootDefaultsSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootDefaults_To_settings_ShootDefaults is an autogenerated conversion function.
+func Convert_v1alpha1_ShootDefaults_To_settings_ShootDefaults(in *ShootDefaults, out *settings.ShootDefaults, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootDefaults_To_settings_ShootDefaults(in, out, s)
+}
+
+func autoConvert_settings_ShootDefaults_To_v1alpha1_ShootDefaults(in *settings.ShootDefaults, out *ShootDefaults, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_settings_ShootDefaultsSpec_To_v1alpha1_ShootDefaultsSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_settings_ShootDefaults_To_v1alpha1_ShootDefaults is an autogenerated conversion function.
+func Convert_settings_ShootDefaults_To_v1alpha1_ShootDefaults(in *settings.ShootDefaults, out *ShootDefaults, s conversion.Scope) error {
+	return autoConvert_settings_ShootDefaults_To_v1alpha1_ShootDefaults(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootDefaultsList_To_settings_ShootDefaultsList(in *ShootDefaultsList, out *settings.ShootDefaultsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]settings.ShootDefaults, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_ShootDefaults_To_settings_ShootDefaults(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootDefaultsList_To_settings_ShootDefaultsList is an autogenerated conversion function.
+func Convert_v1alpha1_ShootDefaultsList_To_settings_ShootDefaultsList(in *ShootDefaultsList, out *settings.ShootDefaultsList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootDefaultsList_To_settings_ShootDefaultsList(in, out, s)
+}
+
+func autoConvert_settings_ShootDefaultsList_To_v1alpha1_ShootDefaultsList(in *settings.ShootDefaultsList, out *ShootDefaultsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ShootDefaults, len(*in))
+		for i := range *in {
+			if err := Convert_settings_ShootDefaults_To_v1alpha1_ShootDefaults(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_settings_ShootDefaultsList_To_v1alpha1_ShootDefaultsList is an autogenerated conversion function.
+func Convert_settings_ShootDefaultsList_To_v1alpha1_ShootDefaultsList(in *settings.ShootDefaultsList, out *ShootDefaultsList, s conversion.Scope) error {
+	return autoConvert_settings_ShootDefaultsList_To_v1alpha1_ShootDefaultsList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootDefaultsSpec_To_settings_ShootDefaultsSpec(in *ShootDefaultsSpec, out *settings.ShootDefaultsSpec, s conversion.Scope) error {
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.Weight = in.Weight
+	if err := Convert_v1alpha1_ShootDefaultsTemplate_To_settings_ShootDefaultsTemplate(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootDefaultsSpec_To_settings_ShootDefaultsSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ShootDefaultsSpec_To_settings_ShootDefaultsSpec(in *ShootDefaultsSpec, out *settings.ShootDefaultsSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootDefaultsSpec_To_settings_ShootDefaultsSpec(in, out, s)
+}
+
+func autoConvert_settings_ShootDefaultsSpec_To_v1alpha1_ShootDefaultsSpec(in *settings.ShootDefaultsSpec, out *ShootDefaultsSpec, s conversion.Scope) error {
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.Weight = in.Weight
+	if err := Convert_settings_ShootDefaultsTemplate_To_v1alpha1_ShootDefaultsTemplate(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_settings_ShootDefaultsSpec_To_v1alpha1_ShootDefaultsSpec is an autogenerated conversion function.
+func Convert_settings_ShootDefaultsSpec_To_v1alpha1_ShootDefaultsSpec(in *settings.ShootDefaultsSpec, out *ShootDefaultsSpec, s conversion.Scope) error {
+	return autoConvert_settings_ShootDefaultsSpec_To_v1alpha1_ShootDefaultsSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootDefaultsTemplate_To_settings_ShootDefaultsTemplate(in *ShootDefaultsTemplate, out *settings.ShootDefaultsTemplate, s conversion.Scope) error {
+	if in.Kubelet != nil {
+		in, out := &in.Kubelet, &out.Kubelet
+		*out = new(core.KubeletConfig)
+		if err := Convert_v1beta1_KubeletConfig_To_core_KubeletConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Kubelet = nil
+	}
+	out.MaintenanceTimeWindow = (*core.MaintenanceTimeWindow)(unsafe.Pointer(in.MaintenanceTimeWindow))
+	out.HibernationSchedules = *(*[]core.HibernationSchedule)(unsafe.Pointer(&in.HibernationSchedules))
+	out.Extensions = *(*[]core.Extension)(unsafe.Pointer(&in.Extensions))
+	if in.Worker != nil {
+		in, out := &in.Worker, &out.Worker
+		*out = new(settings.ShootDefaultsWorker)
+		if err := Convert_v1alpha1_ShootDefaultsWorker_To_settings_ShootDefaultsWorker(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Worker = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootDefaultsTemplate_To_settings_ShootDefaultsTemplate is an autogenerated conversion function.
+func Convert_v1alpha1_ShootDefaultsTemplate_To_settings_ShootDefaultsTemplate(in *ShootDefaultsTemplate, out *settings.ShootDefaultsTemplate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootDefaultsTemplate_To_settings_ShootDefaultsTemplate(in, out, s)
+}
+
+func autoConvert_settings_ShootDefaultsTemplate_To_v1alpha1_ShootDefaultsTemplate(in *settings.ShootDefaultsTemplate, out *ShootDefaultsTemplate, s conversion.Scope) error {
+	if in.Kubelet != nil {
+		in, out := &in.Kubelet, &out.Kubelet
+		*out = new(v1beta1.KubeletConfig)
+		if err := Convert_core_KubeletConfig_To_v1beta1_KubeletConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Kubelet = nil
+	}
+	out.MaintenanceTimeWindow = (*v1beta1.MaintenanceTimeWindow)(unsafe.Pointer(in.MaintenanceTimeWindow))
+	out.HibernationSchedules = *(*[]v1beta1.HibernationSchedule)(unsafe.Pointer(&in.HibernationSchedules))
+	out.Extensions = *(*[]v1beta1.Extension)(unsafe.Pointer(&in.Extensions))
+	if in.Worker != nil {
+		in, out := &in.Worker, &out.Worker
+		*out = new(ShootDefaultsWorker)
+		if err := Convert_settings_ShootDefaultsWorker_To_v1alpha1_ShootDefaultsWorker(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Worker = nil
+	}
+	return nil
+}
+
+// Convert_settings_ShootDefaultsTemplate_To_v1alpha1_ShootDefaultsTemplate is an autogenerated conversion function.
+func Convert_settings_ShootDefaultsTemplate_To_v1alpha1_ShootDefaultsTemplate(in *settings.ShootDefaultsTemplate, out *ShootDefaultsTemplate, s conversion.Scope) error {
+	return autoConvert_settings_ShootDefaultsTemplate_To_v1alpha1_ShootDefaultsTemplate(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootDefaultsWorker_To_settings_ShootDefaultsWorker(in *ShootDefaultsWorker, out *settings.ShootDefaultsWorker, s conversion.Scope) error {
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		*out = new(core.ShootMachineImage)
+		if err := Convert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MachineImage = nil
+	}
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	return nil
+}
+
+// Convert_v1alpha1_ShootDefaultsWorker_To_settings_ShootDefaultsWorker is an autogenerated conversion function.
+func Convert_v1alpha1_ShootDefaultsWorker_To_settings_ShootDefaultsWorker(in *ShootDefaultsWorker, out *settings.ShootDefaultsWorker, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootDefaultsWorker_To_settings_ShootDefaultsWorker(in, out, s)
+}
+
+func autoConvert_settings_ShootDefaultsWorker_To_v1alpha1_ShootDefaultsWorker(in *settings.ShootDefaultsWorker, out *ShootDefaultsWorker, s conversion.Scope) error {
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		*out = new(v1beta1.ShootMachineImage)
+		if err := Convert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MachineImage = nil
+	}
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	return nil
+}
+
+// Convert_settings_ShootDefaultsWorker_To_v1alpha1_ShootDefaultsWorker is an autogenerated conversion function.
+func Convert_settings_ShootDefaultsWorker_To_v1alpha1_ShootDefaultsWorker(in *settings.ShootDefaultsWorker, out *ShootDefaultsWorker, s conversion.Scope) error {
+	return autoConvert_settings_ShootDefaultsWorker_To_v1alpha1_ShootDefaultsWorker(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPolicy_To_settings_ShootPolicy(in *ShootPolicy, out *settings.ShootPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicy_To_settings_ShootPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicy_To_settings_ShootPolicy(in *ShootPolicy, out *settings.ShootPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicy_To_settings_ShootPolicy(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicy_To_v1alpha1_ShootPolicy(in *settings.ShootPolicy, out *ShootPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_settings_ShootPolicy_To_v1alpha1_ShootPolicy is an autogenerated conversion function.
+func Convert_settings_ShootPolicy_To_v1alpha1_ShootPolicy(in *settings.ShootPolicy, out *ShootPolicy, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicy_To_v1alpha1_ShootPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList(in *ShootPolicyList, out *settings.ShootPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]settings.ShootPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList(in *ShootPolicyList, out *settings.ShootPolicyList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList(in *settings.ShootPolicyList, out *ShootPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ShootPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList is an autogenerated conversion function.
+func Convert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList(in *settings.ShootPolicyList, out *ShootPolicyList, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPolicyRule_To_settings_ShootPolicyRule(in *ShootPolicyRule, out *settings.ShootPolicyRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Expression = in.Expression
+	out.Message = in.Message
+	out.Action = settings.ShootPolicyAction(in.Action)
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicyRule_To_settings_ShootPolicyRule is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicyRule_To_settings_ShootPolicyRule(in *ShootPolicyRule, out *settings.ShootPolicyRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicyRule_To_settings_ShootPolicyRule(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicyRule_To_v1alpha1_ShootPolicyRule(in *settings.ShootPolicyRule, out *ShootPolicyRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Expression = in.Expression
+	out.Message = in.Message
+	out.Action = ShootPolicyAction(in.Action)
+	return nil
+}
+
+// Convert_settings_ShootPolicyRule_To_v1alpha1_ShootPolicyRule is an autogenerated conversion function.
+func Convert_settings_ShootPolicyRule_To_v1alpha1_ShootPolicyRule(in *settings.ShootPolicyRule, out *ShootPolicyRule, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicyRule_To_v1alpha1_ShootPolicyRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(in *ShootPolicySpec, out *settings.ShootPolicySpec, s conversion.Scope) error {
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.Rules = *(*[]settings.ShootPolicyRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(in *ShootPolicySpec, out *settings.ShootPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(in *settings.ShootPolicySpec, out *ShootPolicySpec, s conversion.Scope) error {
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.Rules = *(*[]ShootPolicyRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec is an autogenerated conversion function.
+func Convert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(in *settings.ShootPolicySpec, out *ShootPolicySpec, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(in, out, s)
+}
@@ -33,8 +33,16 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&ClusterOpenIDConnectPresetList{}, func(obj interface{}) {
 		SetObjectDefaults_ClusterOpenIDConnectPresetList(obj.(*ClusterOpenIDConnectPresetList))
 	})
+	scheme.AddTypeDefaultingFunc(&ClusterShootDefaults{}, func(obj interface{}) { SetObjectDefaults_ClusterShootDefaults(obj.(*ClusterShootDefaults)) })
+	scheme.AddTypeDefaultingFunc(&ClusterShootDefaultsList{}, func(obj interface{}) { SetObjectDefaults_ClusterShootDefaultsList(obj.(*ClusterShootDefaultsList)) })
+	scheme.AddTypeDefaultingFunc(&ClusterShootPolicy{}, func(obj interface{}) { SetObjectDefaults_ClusterShootPolicy(obj.(*ClusterShootPolicy)) })
+	scheme.AddTypeDefaultingFunc(&ClusterShootPolicyList{}, func(obj interface{}) { SetObjectDefaults_ClusterShootPolicyList(obj.(*ClusterShootPolicyList)) })
 	scheme.AddTypeDefaultingFunc(&OpenIDConnectPreset{}, func(obj interface{}) { SetObjectDefaults_OpenIDConnectPreset(obj.(*OpenIDConnectPreset)) })
 	scheme.AddTypeDefaultingFunc(&OpenIDConnectPresetList{}, func(obj interface{}) { SetObjectDefaults_OpenIDConnectPresetList(obj.(*OpenIDConnectPresetList)) })
+	scheme.AddTypeDefaultingFunc(&ShootDefaults{}, func(obj interface{}) { SetObjectDefaults_ShootDefaults(obj.(*ShootDefaults)) })
+	scheme.AddTypeDefaultingFunc(&ShootDefaultsList{}, func(obj interface{}) { SetObjectDefaults_ShootDefaultsList(obj.(*ShootDefaultsList)) })
+	scheme.AddTypeDefaultingFunc(&ShootPolicy{}, func(obj interface{}) { SetObjectDefaults_ShootPolicy(obj.(*ShootPolicy)) })
+	scheme.AddTypeDefaultingFunc(&ShootPolicyList{}, func(obj interface{}) { SetObjectDefaults_ShootPolicyList(obj.(*ShootPolicyList)) })
 	return nil
 }
 
@@ -51,6 +59,34 @@ func SetObjectDefaults_ClusterOpenIDConnectPresetList(in *ClusterOpenIDConnectPr
 	}
 }
 
+func SetObjectDefaults_ClusterShootDefaults(in *ClusterShootDefaults) {
+	SetDefaults_ClusterShootDefaultsSpec(&in.Spec)
+	SetDefaults_ShootDefaultsSpec(&in.Spec.ShootDefaultsSpec)
+}
+
+func SetObjectDefaults_ClusterShootDefaultsList(in *ClusterShootDefaultsList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ClusterShootDefaults(a)
+	}
+}
+
+func SetObjectDefaults_ClusterShootPolicy(in *ClusterShootPolicy) {
+	SetDefaults_ClusterShootPolicySpec(&in.Spec)
+	SetDefaults_ShootPolicySpec(&in.Spec.ShootPolicySpec)
+	for i := range in.Spec.ShootPolicySpec.Rules {
+		a := &in.Spec.ShootPolicySpec.Rules[i]
+		SetDefaults_ShootPolicyRule(a)
+	}
+}
+
+func SetObjectDefaults_ClusterShootPolicyList(in *ClusterShootPolicyList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ClusterShootPolicy(a)
+	}
+}
+
 func SetObjectDefaults_OpenIDConnectPreset(in *OpenIDConnectPreset) {
 	SetDefaults_OpenIDConnectPresetSpec(&in.Spec)
 	SetDefaults_KubeAPIServerOpenIDConnect(&in.Spec.Server)
@@ -62,3 +98,29 @@ func SetObjectDefaults_OpenIDConnectPresetList(in *OpenIDConnectPresetList) {
 		SetObjectDefaults_OpenIDConnectPreset(a)
 	}
 }
+
+func SetObjectDefaults_ShootDefaults(in *ShootDefaults) {
+	SetDefaults_ShootDefaultsSpec(&in.Spec)
+}
+
+func SetObjectDefaults_ShootDefaultsList(in *ShootDefaultsList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ShootDefaults(a)
+	}
+}
+
+func SetObjectDefaults_ShootPolicy(in *ShootPolicy) {
+	SetDefaults_ShootPolicySpec(&in.Spec)
+	for i := range in.Spec.Rules {
+		a := &in.Spec.Rules[i]
+		SetDefaults_ShootPolicyRule(a)
+	}
+}
+
+func SetObjectDefaults_ShootPolicyList(in *ShootPolicyList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ShootPolicy(a)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	. "github.com/gardener/gardener/pkg/apis/settings/validation"
+)
+
+var _ = Describe("ClusterShootDefaults", func() {
+	var defaults *settings.ClusterShootDefaults
+
+	BeforeEach(func() {
+		defaults = &settings.ClusterShootDefaults{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: settings.ClusterShootDefaultsSpec{
+				ShootDefaultsSpec: settings.ShootDefaultsSpec{
+					Weight: 1,
+					Template: settings.ShootDefaultsTemplate{
+						Extensions: []core.Extension{{Type: "shoot-cert-service"}},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateClusterShootDefaults", func() {
+		It("should allow valid defaults", func() {
+			Expect(ValidateClusterShootDefaults(defaults)).To(BeEmpty())
+		})
+
+		It("should forbid defaults with namespace", func() {
+			defaults.Namespace = "garden-dev"
+
+			Expect(ValidateClusterShootDefaults(defaults)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("metadata.namespace"),
+			}))))
+		})
+
+		It("should forbid an invalid project selector", func() {
+			defaults.Spec.ProjectSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "bar"}}}
+
+			Expect(ValidateClusterShootDefaults(defaults)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.projectSelector.matchExpressions[0].operator"),
+			}))))
+		})
+
+		It("should validate the template", func() {
+			defaults.Spec.Template.Extensions[0].Type = ""
+
+			Expect(ValidateClusterShootDefaults(defaults)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.template.extensions[0].type"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateClusterShootDefaultsUpdate", func() {
+		It("should allow a valid update", func() {
+			newDefaults := defaults.DeepCopy()
+			newDefaults.ResourceVersion = "1"
+			defaults.ResourceVersion = "1"
+			newDefaults.Spec.ProjectSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}
+
+			Expect(ValidateClusterShootDefaultsUpdate(newDefaults, defaults)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,92 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/settings"
+	. "github.com/gardener/gardener/pkg/apis/settings/validation"
+)
+
+var _ = Describe("ClusterShootPolicy", func() {
+	var policy *settings.ClusterShootPolicy
+
+	BeforeEach(func() {
+		policy = &settings.ClusterShootPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: settings.ClusterShootPolicySpec{
+				ShootPolicySpec: settings.ShootPolicySpec{
+					Rules: []settings.ShootPolicyRule{{
+						Name:       "max-cpu",
+						Expression: "object.spec.provider.workers.all(w, w.maximum <= 10)",
+						Message:    "worker pools must not have more than 10 nodes",
+						Action:     settings.ShootPolicyActionWarn,
+					}},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateClusterShootPolicy", func() {
+		It("should allow a valid policy", func() {
+			Expect(ValidateClusterShootPolicy(policy)).To(BeEmpty())
+		})
+
+		It("should forbid a policy with namespace", func() {
+			policy.Namespace = "garden-dev"
+
+			Expect(ValidateClusterShootPolicy(policy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("metadata.namespace"),
+			}))))
+		})
+
+		It("should forbid an invalid project selector", func() {
+			policy.Spec.ProjectSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "bar"}}}
+
+			Expect(ValidateClusterShootPolicy(policy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.projectSelector.matchExpressions[0].operator"),
+			}))))
+		})
+
+		It("should validate the rules", func() {
+			policy.Spec.Rules[0].Expression = "foo"
+
+			Expect(ValidateClusterShootPolicy(policy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.rules[0].expression"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateClusterShootPolicyUpdate", func() {
+		It("should allow a valid update", func() {
+			newPolicy := policy.DeepCopy()
+			newPolicy.ResourceVersion = "1"
+			policy.ResourceVersion = "1"
+			newPolicy.Spec.ProjectSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}
+
+			Expect(ValidateClusterShootPolicyUpdate(newPolicy, policy)).To(BeEmpty())
+		})
+	})
+})
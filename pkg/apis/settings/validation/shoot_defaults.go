@@ -0,0 +1,107 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+)
+
+// ValidateShootDefaults validates a ShootDefaults object.
+func ValidateShootDefaults(defaults *settings.ShootDefaults) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&defaults.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootDefaultsSpec(&defaults.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootDefaultsUpdate validates a ShootDefaults object before an update.
+func ValidateShootDefaultsUpdate(new, old *settings.ShootDefaults) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootDefaultsSpec(&new.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateShootDefaultsSpec(spec *settings.ShootDefaultsSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ShootSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("shootSelector"))...)
+	if spec.Weight <= 0 || spec.Weight > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("weight"), spec.Weight, "must be in the range 1-100"))
+	}
+	allErrs = append(allErrs, validateShootDefaultsTemplate(&spec.Template, fldPath.Child("template"))...)
+
+	return allErrs
+}
+
+func validateShootDefaultsTemplate(template *settings.ShootDefaultsTemplate, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if template.Kubelet == nil && template.MaintenanceTimeWindow == nil && len(template.HibernationSchedules) == 0 && len(template.Extensions) == 0 && template.Worker == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "must provide at least one default value"))
+	}
+
+	if tw := template.MaintenanceTimeWindow; tw != nil {
+		if _, err := timewindow.ParseMaintenanceTimeWindow(tw.Begin, tw.End); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maintenanceTimeWindow", "begin/end"), tw, err.Error()))
+		}
+	}
+
+	allErrs = append(allErrs, gardencorevalidation.ValidateHibernationSchedules(template.HibernationSchedules, fldPath.Child("hibernationSchedules"))...)
+
+	types := sets.New[string]()
+	for i, extension := range template.Extensions {
+		idxPath := fldPath.Child("extensions").Index(i)
+		if len(extension.Type) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "field must not be empty"))
+		} else if types.Has(extension.Type) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("type"), extension.Type))
+		}
+		types.Insert(extension.Type)
+	}
+
+	if worker := template.Worker; worker != nil {
+		workerPath := fldPath.Child("worker")
+
+		if worker.MachineImage != nil && len(worker.MachineImage.Name) == 0 {
+			allErrs = append(allErrs, field.Required(workerPath.Child("machineImage", "name"), "must provide a machine image name"))
+		}
+
+		allErrs = append(allErrs, metav1validation.ValidateLabels(worker.Labels, workerPath.Child("labels"))...)
+
+		keys := sets.New[string]()
+		for i, taint := range worker.Taints {
+			idxPath := workerPath.Child("taints").Index(i)
+			allErrs = append(allErrs, metav1validation.ValidateLabelName(taint.Key, idxPath.Child("key"))...)
+			if keys.Has(taint.Key) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("key"), taint.Key))
+			}
+			keys.Insert(taint.Key)
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,165 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	. "github.com/gardener/gardener/pkg/apis/settings/validation"
+)
+
+var _ = Describe("ShootDefaults", func() {
+	var defaults *settings.ShootDefaults
+
+	BeforeEach(func() {
+		defaults = &settings.ShootDefaults{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "garden-dev",
+			},
+			Spec: settings.ShootDefaultsSpec{
+				Weight: 10,
+				Template: settings.ShootDefaultsTemplate{
+					MaintenanceTimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+					HibernationSchedules:  []core.HibernationSchedule{{Start: pointer.String("00 20 * * 1,2,3,4,5")}},
+					Extensions:            []core.Extension{{Type: "shoot-dns-service"}},
+					Worker: &settings.ShootDefaultsWorker{
+						MachineImage: &core.ShootMachineImage{Name: "gardenlinux"},
+						Labels:       map[string]string{"team": "dev"},
+						Taints:       []corev1.Taint{{Key: "dedicated", Value: "dev", Effect: corev1.TaintEffectNoSchedule}},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateShootDefaults", func() {
+		It("should allow valid defaults", func() {
+			Expect(ValidateShootDefaults(defaults)).To(BeEmpty())
+		})
+
+		It("should forbid defaults without namespace", func() {
+			defaults.Namespace = ""
+
+			Expect(ValidateShootDefaults(defaults)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.namespace"),
+			}))))
+		})
+
+		It("should forbid an invalid shoot selector", func() {
+			defaults.Spec.ShootSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "bar"}}}
+
+			Expect(ValidateShootDefaults(defaults)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.shootSelector.matchExpressions[0].operator"),
+			}))))
+		})
+
+		DescribeTable("weight",
+			func(weight int32, matcher OmegaMatcher) {
+				defaults.Spec.Weight = weight
+				Expect(ValidateShootDefaults(defaults)).To(matcher)
+			},
+
+			Entry("should forbid zero", int32(0), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.weight")})))),
+			Entry("should forbid values greater than 100", int32(101), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.weight")})))),
+			Entry("should allow 1", int32(1), BeEmpty()),
+			Entry("should allow 100", int32(100), BeEmpty()),
+		)
+
+		It("should forbid an empty template", func() {
+			defaults.Spec.Template = settings.ShootDefaultsTemplate{}
+
+			Expect(ValidateShootDefaults(defaults)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.template"),
+			}))))
+		})
+
+		It("should forbid an invalid maintenance time window", func() {
+			defaults.Spec.Template.MaintenanceTimeWindow.Begin = "foo"
+
+			Expect(ValidateShootDefaults(defaults)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.template.maintenanceTimeWindow.begin/end"),
+			}))))
+		})
+
+		It("should forbid invalid hibernation schedules", func() {
+			defaults.Spec.Template.HibernationSchedules[0].Start = pointer.String("foo")
+
+			Expect(ValidateShootDefaults(defaults)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.template.hibernationSchedules[0].start"),
+			}))))
+		})
+
+		It("should forbid extensions without or with duplicate types", func() {
+			defaults.Spec.Template.Extensions = append(defaults.Spec.Template.Extensions, core.Extension{}, core.Extension{Type: "shoot-dns-service"})
+
+			Expect(ValidateShootDefaults(defaults)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.template.extensions[1].type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.template.extensions[2].type"),
+				})),
+			))
+		})
+
+		It("should forbid invalid worker defaults", func() {
+			defaults.Spec.Template.Worker.MachineImage.Name = ""
+			defaults.Spec.Template.Worker.Labels = map[string]string{"foo/bar/baz": "dev"}
+			defaults.Spec.Template.Worker.Taints = append(defaults.Spec.Template.Worker.Taints, corev1.Taint{Key: "dedicated", Effect: corev1.TaintEffectNoExecute})
+
+			Expect(ValidateShootDefaults(defaults)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.template.worker.machineImage.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.template.worker.labels"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.template.worker.taints[1].key"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateShootDefaultsUpdate", func() {
+		It("should allow a valid update", func() {
+			newDefaults := defaults.DeepCopy()
+			newDefaults.ResourceVersion = "1"
+			defaults.ResourceVersion = "1"
+			newDefaults.Spec.Weight = 50
+
+			Expect(ValidateShootDefaultsUpdate(newDefaults, defaults)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,91 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/settings"
+	"github.com/gardener/gardener/pkg/utils/cel"
+)
+
+var availableShootPolicyActions = sets.New(
+	string(settings.ShootPolicyActionDeny),
+	string(settings.ShootPolicyActionWarn),
+	string(settings.ShootPolicyActionAudit),
+)
+
+// ValidateShootPolicy validates a ShootPolicy object.
+func ValidateShootPolicy(policy *settings.ShootPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&policy.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootPolicySpec(&policy.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootPolicyUpdate validates a ShootPolicy object before an update.
+func ValidateShootPolicyUpdate(new, old *settings.ShootPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootPolicySpec(&new.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateShootPolicySpec(spec *settings.ShootPolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ShootSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("shootSelector"))...)
+
+	if len(spec.Rules) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("rules"), "must provide at least one rule"))
+	}
+
+	names := sets.New[string]()
+	for i, rule := range spec.Rules {
+		idxPath := fldPath.Child("rules").Index(i)
+
+		if len(rule.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(rule.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), rule.Name))
+		}
+		names.Insert(rule.Name)
+
+		if len(rule.Expression) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("expression"), "must provide an expression"))
+		} else if _, err := cel.Compile(rule.Expression); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("expression"), rule.Expression, fmt.Sprintf("invalid CEL expression: %v", err)))
+		}
+
+		if len(rule.Message) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("message"), "must provide a message"))
+		}
+
+		if !availableShootPolicyActions.Has(string(rule.Action)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("action"), rule.Action, sets.List(availableShootPolicyActions)))
+		}
+	}
+
+	return allErrs
+}
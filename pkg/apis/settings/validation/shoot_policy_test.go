@@ -0,0 +1,172 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/settings"
+	. "github.com/gardener/gardener/pkg/apis/settings/validation"
+)
+
+var _ = Describe("ShootPolicy", func() {
+	var policy *settings.ShootPolicy
+
+	BeforeEach(func() {
+		policy = &settings.ShootPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "garden-dev",
+			},
+			Spec: settings.ShootPolicySpec{
+				Rules: []settings.ShootPolicyRule{{
+					Name:       "ha-control-plane",
+					Expression: "has(object.spec.controlPlane) && has(object.spec.controlPlane.highAvailability)",
+					Message:    "production shoots need a highly available control plane",
+					Action:     settings.ShootPolicyActionDeny,
+				}},
+			},
+		}
+	})
+
+	Describe("#ValidateShootPolicy", func() {
+		It("should allow a valid policy", func() {
+			Expect(ValidateShootPolicy(policy)).To(BeEmpty())
+		})
+
+		It("should forbid a policy without namespace", func() {
+			policy.Namespace = ""
+
+			Expect(ValidateShootPolicy(policy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.namespace"),
+			}))))
+		})
+
+		It("should forbid an invalid shoot selector", func() {
+			policy.Spec.ShootSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "bar"}}}
+
+			Expect(ValidateShootPolicy(policy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.shootSelector.matchExpressions[0].operator"),
+			}))))
+		})
+
+		It("should forbid a policy without rules", func() {
+			policy.Spec.Rules = nil
+
+			Expect(ValidateShootPolicy(policy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.rules"),
+			}))))
+		})
+
+		It("should forbid rules with missing fields", func() {
+			policy.Spec.Rules = []settings.ShootPolicyRule{{}}
+
+			Expect(ValidateShootPolicy(policy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.rules[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.rules[0].expression"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.rules[0].message"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.rules[0].action"),
+				})),
+			))
+		})
+
+		It("should forbid duplicate rule names", func() {
+			policy.Spec.Rules = append(policy.Spec.Rules, policy.Spec.Rules[0])
+
+			Expect(ValidateShootPolicy(policy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.rules[1].name"),
+			}))))
+		})
+
+		It("should forbid invalid CEL expressions", func() {
+			policy.Spec.Rules[0].Expression = "object.spec.provider.workers.size() >"
+
+			Expect(ValidateShootPolicy(policy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.rules[0].expression"),
+			}))))
+		})
+
+		It("should forbid CEL expressions which do not evaluate to bool", func() {
+			policy.Spec.Rules[0].Expression = "object.spec.provider.workers.size()"
+
+			Expect(ValidateShootPolicy(policy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.rules[0].expression"),
+			}))))
+		})
+
+		It("should forbid CEL expressions whose estimated cost is too high", func() {
+			policy.Spec.Rules[0].Expression = "object.spec.provider.workers.all(a, object.spec.provider.workers.all(b, object.spec.provider.workers.all(c, a.name != b.name || b.name != c.name)))"
+
+			Expect(ValidateShootPolicy(policy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.rules[0].expression"),
+				"Detail": ContainSubstring("estimated cost of expression"),
+			}))))
+		})
+
+		It("should forbid unsupported actions", func() {
+			policy.Spec.Rules[0].Action = "Ignore"
+
+			Expect(ValidateShootPolicy(policy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.rules[0].action"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateShootPolicyUpdate", func() {
+		It("should allow a valid update", func() {
+			newPolicy := policy.DeepCopy()
+			newPolicy.ResourceVersion = "1"
+			policy.ResourceVersion = "1"
+			newPolicy.Spec.Rules[0].Action = settings.ShootPolicyActionWarn
+
+			Expect(ValidateShootPolicyUpdate(newPolicy, policy)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid update", func() {
+			newPolicy := policy.DeepCopy()
+			newPolicy.ResourceVersion = "1"
+			policy.ResourceVersion = "1"
+			newPolicy.Spec.Rules[0].Expression = ""
+
+			Expect(ValidateShootPolicyUpdate(newPolicy, policy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.rules[0].expression"),
+			}))))
+		})
+	})
+})
@@ -29,6 +29,7 @@ import (
 	managedseedvalidator "github.com/gardener/gardener/plugin/pkg/managedseed/validator"
 	projectvalidator "github.com/gardener/gardener/plugin/pkg/project/validator"
 	seedvalidator "github.com/gardener/gardener/plugin/pkg/seed/validator"
+	shootdefaults "github.com/gardener/gardener/plugin/pkg/shoot/defaults"
 	shootdns "github.com/gardener/gardener/plugin/pkg/shoot/dns"
 	shootdnsrewriting "github.com/gardener/gardener/plugin/pkg/shoot/dnsrewriting"
 	shootexposureclass "github.com/gardener/gardener/plugin/pkg/shoot/exposureclass"
@@ -36,6 +37,8 @@ import (
 	shootnodelocaldns "github.com/gardener/gardener/plugin/pkg/shoot/nodelocaldns"
 	"github.com/gardener/gardener/plugin/pkg/shoot/oidc/clusteropenidconnectpreset"
 	"github.com/gardener/gardener/plugin/pkg/shoot/oidc/openidconnectpreset"
+	shootpolicy "github.com/gardener/gardener/plugin/pkg/shoot/policy"
+	shootprojectrestrictions "github.com/gardener/gardener/plugin/pkg/shoot/projectrestrictions"
 	shootquotavalidator "github.com/gardener/gardener/plugin/pkg/shoot/quotavalidator"
 	shoottolerationrestriction "github.com/gardener/gardener/plugin/pkg/shoot/tolerationrestriction"
 	shootvalidator "github.com/gardener/gardener/plugin/pkg/shoot/validator"
@@ -48,9 +51,12 @@ func RegisterAllAdmissionPlugins(plugins *admission.Plugins) {
 	deletionconfirmation.Register(plugins)
 	extensionvalidation.Register(plugins)
 	extensionlabels.Register(plugins)
+	shootdefaults.Register(plugins)
 	shoottolerationrestriction.Register(plugins)
 	shootexposureclass.Register(plugins)
 	shootquotavalidator.Register(plugins)
+	shootprojectrestrictions.Register(plugins)
+	shootpolicy.Register(plugins)
 	shootdns.Register(plugins)
 	shootmanagedseed.Register(plugins)
 	shootnodelocaldns.Register(plugins)
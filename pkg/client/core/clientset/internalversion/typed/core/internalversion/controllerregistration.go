@@ -40,6 +40,7 @@ type ControllerRegistrationsGetter interface {
 type ControllerRegistrationInterface interface {
 	Create(ctx context.Context, controllerRegistration *core.ControllerRegistration, opts v1.CreateOptions) (*core.ControllerRegistration, error)
 	Update(ctx context.Context, controllerRegistration *core.ControllerRegistration, opts v1.UpdateOptions) (*core.ControllerRegistration, error)
+	UpdateStatus(ctx context.Context, controllerRegistration *core.ControllerRegistration, opts v1.UpdateOptions) (*core.ControllerRegistration, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*core.ControllerRegistration, error)
@@ -128,6 +129,21 @@ func (c *controllerRegistrations) Update(ctx context.Context, controllerRegistra
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *controllerRegistrations) UpdateStatus(ctx context.Context, controllerRegistration *core.ControllerRegistration, opts v1.UpdateOptions) (result *core.ControllerRegistration, err error) {
+	result = &core.ControllerRegistration{}
+	err = c.client.Put().
+		Resource("controllerregistrations").
+		Name(controllerRegistration.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(controllerRegistration).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the controllerRegistration and deletes it. Returns an error if one occurs.
 func (c *controllerRegistrations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -95,6 +95,17 @@ func (c *FakeControllerRegistrations) Update(ctx context.Context, controllerRegi
 	return obj.(*core.ControllerRegistration), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeControllerRegistrations) UpdateStatus(ctx context.Context, controllerRegistration *core.ControllerRegistration, opts v1.UpdateOptions) (*core.ControllerRegistration, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(controllerregistrationsResource, "status", controllerRegistration), &core.ControllerRegistration{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core.ControllerRegistration), err
+}
+
 // Delete takes name of the controllerRegistration and deletes it. Returns an error if one occurs.
 func (c *FakeControllerRegistrations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -95,6 +95,17 @@ func (c *FakeControllerRegistrations) Update(ctx context.Context, controllerRegi
 	return obj.(*v1beta1.ControllerRegistration), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeControllerRegistrations) UpdateStatus(ctx context.Context, controllerRegistration *v1beta1.ControllerRegistration, opts v1.UpdateOptions) (*v1beta1.ControllerRegistration, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(controllerregistrationsResource, "status", controllerRegistration), &v1beta1.ControllerRegistration{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ControllerRegistration), err
+}
+
 // Delete takes name of the controllerRegistration and deletes it. Returns an error if one occurs.
 func (c *FakeControllerRegistrations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
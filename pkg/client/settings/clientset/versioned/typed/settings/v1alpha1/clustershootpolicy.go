@@ -0,0 +1,168 @@
+/*
+Copyright SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	scheme "github.com/gardener/gardener/pkg/client/settings/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ClusterShootPoliciesGetter has a method to return a ClusterShootPolicyInterface.
+// A group's client should implement this interface.
+type ClusterShootPoliciesGetter interface {
+	ClusterShootPolicies() ClusterShootPolicyInterface
+}
+
+// ClusterShootPolicyInterface has methods to work with ClusterShootPolicy resources.
+type ClusterShootPolicyInterface interface {
+	Create(ctx context.Context, clusterShootPolicy *v1alpha1.ClusterShootPolicy, opts v1.CreateOptions) (*v1alpha1.ClusterShootPolicy, error)
+	Update(ctx context.Context, clusterShootPolicy *v1alpha1.ClusterShootPolicy, opts v1.UpdateOptions) (*v1alpha1.ClusterShootPolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ClusterShootPolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ClusterShootPolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterShootPolicy, err error)
+	ClusterShootPolicyExpansion
+}
+
+// clusterShootPolicies implements ClusterShootPolicyInterface
+type clusterShootPolicies struct {
+	client rest.Interface
+}
+
+// newClusterShootPolicies returns a ClusterShootPolicies
+func newClusterShootPolicies(c *SettingsV1alpha1Client) *clusterShootPolicies {
+	return &clusterShootPolicies{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the clusterShootPolicy, and returns the corresponding clusterShootPolicy object, and an error if there is any.
+func (c *clusterShootPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ClusterShootPolicy, err error) {
+	result = &v1alpha1.ClusterShootPolicy{}
+	err = c.client.Get().
+		Resource("clustershootpolicies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ClusterShootPolicies that match those selectors.
+func (c *clusterShootPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ClusterShootPolicyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ClusterShootPolicyList{}
+	err = c.client.Get().
+		Resource("clustershootpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested clusterShootPolicies.
+func (c *clusterShootPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("clustershootpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a clusterShootPolicy and creates it.  Returns the server's representation of the clusterShootPolicy, and an error, if there is any.
+func (c *clusterShootPolicies) Create(ctx context.Context, clusterShootPolicy *v1alpha1.ClusterShootPolicy, opts v1.CreateOptions) (result *v1alpha1.ClusterShootPolicy, err error) {
+	result = &v1alpha1.ClusterShootPolicy{}
+	err = c.client.Post().
+		Resource("clustershootpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterShootPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a clusterShootPolicy and updates it. Returns the server's representation of the clusterShootPolicy, and an error, if there is any.
+func (c *clusterShootPolicies) Update(ctx context.Context, clusterShootPolicy *v1alpha1.ClusterShootPolicy, opts v1.UpdateOptions) (result *v1alpha1.ClusterShootPolicy, err error) {
+	result = &v1alpha1.ClusterShootPolicy{}
+	err = c.client.Put().
+		Resource("clustershootpolicies").
+		Name(clusterShootPolicy.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterShootPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the clusterShootPolicy and deletes it. Returns an error if one occurs.
+func (c *clusterShootPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("clustershootpolicies").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *clusterShootPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("clustershootpolicies").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched clusterShootPolicy.
+func (c *clusterShootPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterShootPolicy, err error) {
+	result = &v1alpha1.ClusterShootPolicy{}
+	err = c.client.Patch(pt).
+		Resource("clustershootpolicies").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,121 @@
+/*
+Copyright SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeClusterShootDefaults implements ClusterShootDefaultsInterface
+type FakeClusterShootDefaults struct {
+	Fake *FakeSettingsV1alpha1
+}
+
+var clustershootdefaultsResource = v1alpha1.SchemeGroupVersion.WithResource("clustershootdefaults")
+
+var clustershootdefaultsKind = v1alpha1.SchemeGroupVersion.WithKind("ClusterShootDefaults")
+
+// Get takes name of the clusterShootDefaults, and returns the corresponding clusterShootDefaults object, and an error if there is any.
+func (c *FakeClusterShootDefaults) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ClusterShootDefaults, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(clustershootdefaultsResource, name), &v1alpha1.ClusterShootDefaults{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootDefaults), err
+}
+
+// List takes label and field selectors, and returns the list of ClusterShootDefaults that match those selectors.
+func (c *FakeClusterShootDefaults) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ClusterShootDefaultsList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(clustershootdefaultsResource, clustershootdefaultsKind, opts), &v1alpha1.ClusterShootDefaultsList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ClusterShootDefaultsList{ListMeta: obj.(*v1alpha1.ClusterShootDefaultsList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ClusterShootDefaultsList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested clusterShootDefaults.
+func (c *FakeClusterShootDefaults) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(clustershootdefaultsResource, opts))
+}
+
+// Create takes the representation of a clusterShootDefaults and creates it.  Returns the server's representation of the clusterShootDefaults, and an error, if there is any.
+func (c *FakeClusterShootDefaults) Create(ctx context.Context, clusterShootDefaults *v1alpha1.ClusterShootDefaults, opts v1.CreateOptions) (result *v1alpha1.ClusterShootDefaults, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(clustershootdefaultsResource, clusterShootDefaults), &v1alpha1.ClusterShootDefaults{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootDefaults), err
+}
+
+// Update takes the representation of a clusterShootDefaults and updates it. Returns the server's representation of the clusterShootDefaults, and an error, if there is any.
+func (c *FakeClusterShootDefaults) Update(ctx context.Context, clusterShootDefaults *v1alpha1.ClusterShootDefaults, opts v1.UpdateOptions) (result *v1alpha1.ClusterShootDefaults, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(clustershootdefaultsResource, clusterShootDefaults), &v1alpha1.ClusterShootDefaults{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootDefaults), err
+}
+
+// Delete takes name of the clusterShootDefaults and deletes it. Returns an error if one occurs.
+func (c *FakeClusterShootDefaults) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(clustershootdefaultsResource, name, opts), &v1alpha1.ClusterShootDefaults{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeClusterShootDefaults) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(clustershootdefaultsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ClusterShootDefaultsList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched clusterShootDefaults.
+func (c *FakeClusterShootDefaults) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterShootDefaults, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(clustershootdefaultsResource, name, pt, data, subresources...), &v1alpha1.ClusterShootDefaults{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootDefaults), err
+}
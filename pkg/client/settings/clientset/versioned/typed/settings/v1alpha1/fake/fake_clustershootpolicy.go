@@ -0,0 +1,121 @@
+/*
+Copyright SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeClusterShootPolicies implements ClusterShootPolicyInterface
+type FakeClusterShootPolicies struct {
+	Fake *FakeSettingsV1alpha1
+}
+
+var clustershootpoliciesResource = v1alpha1.SchemeGroupVersion.WithResource("clustershootpolicies")
+
+var clustershootpoliciesKind = v1alpha1.SchemeGroupVersion.WithKind("ClusterShootPolicy")
+
+// Get takes name of the clusterShootPolicy, and returns the corresponding clusterShootPolicy object, and an error if there is any.
+func (c *FakeClusterShootPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ClusterShootPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(clustershootpoliciesResource, name), &v1alpha1.ClusterShootPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootPolicy), err
+}
+
+// List takes label and field selectors, and returns the list of ClusterShootPolicies that match those selectors.
+func (c *FakeClusterShootPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ClusterShootPolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(clustershootpoliciesResource, clustershootpoliciesKind, opts), &v1alpha1.ClusterShootPolicyList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ClusterShootPolicyList{ListMeta: obj.(*v1alpha1.ClusterShootPolicyList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ClusterShootPolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested clusterShootPolicies.
+func (c *FakeClusterShootPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(clustershootpoliciesResource, opts))
+}
+
+// Create takes the representation of a clusterShootPolicy and creates it.  Returns the server's representation of the clusterShootPolicy, and an error, if there is any.
+func (c *FakeClusterShootPolicies) Create(ctx context.Context, clusterShootPolicy *v1alpha1.ClusterShootPolicy, opts v1.CreateOptions) (result *v1alpha1.ClusterShootPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(clustershootpoliciesResource, clusterShootPolicy), &v1alpha1.ClusterShootPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootPolicy), err
+}
+
+// Update takes the representation of a clusterShootPolicy and updates it. Returns the server's representation of the clusterShootPolicy, and an error, if there is any.
+func (c *FakeClusterShootPolicies) Update(ctx context.Context, clusterShootPolicy *v1alpha1.ClusterShootPolicy, opts v1.UpdateOptions) (result *v1alpha1.ClusterShootPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(clustershootpoliciesResource, clusterShootPolicy), &v1alpha1.ClusterShootPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootPolicy), err
+}
+
+// Delete takes name of the clusterShootPolicy and deletes it. Returns an error if one occurs.
+func (c *FakeClusterShootPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(clustershootpoliciesResource, name, opts), &v1alpha1.ClusterShootPolicy{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeClusterShootPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(clustershootpoliciesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ClusterShootPolicyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched clusterShootPolicy.
+func (c *FakeClusterShootPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterShootPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(clustershootpoliciesResource, name, pt, data, subresources...), &v1alpha1.ClusterShootPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootPolicy), err
+}
@@ -32,10 +32,26 @@ func (c *FakeSettingsV1alpha1) ClusterOpenIDConnectPresets() v1alpha1.ClusterOpe
 	return &FakeClusterOpenIDConnectPresets{c}
 }
 
+func (c *FakeSettingsV1alpha1) ClusterShootDefaults() v1alpha1.ClusterShootDefaultsInterface {
+	return &FakeClusterShootDefaults{c}
+}
+
+func (c *FakeSettingsV1alpha1) ClusterShootPolicies() v1alpha1.ClusterShootPolicyInterface {
+	return &FakeClusterShootPolicies{c}
+}
+
 func (c *FakeSettingsV1alpha1) OpenIDConnectPresets(namespace string) v1alpha1.OpenIDConnectPresetInterface {
 	return &FakeOpenIDConnectPresets{c, namespace}
 }
 
+func (c *FakeSettingsV1alpha1) ShootDefaults(namespace string) v1alpha1.ShootDefaultsInterface {
+	return &FakeShootDefaults{c, namespace}
+}
+
+func (c *FakeSettingsV1alpha1) ShootPolicies(namespace string) v1alpha1.ShootPolicyInterface {
+	return &FakeShootPolicies{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSettingsV1alpha1) RESTClient() rest.Interface {
@@ -0,0 +1,129 @@
+/*
+Copyright SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeShootDefaults implements ShootDefaultsInterface
+type FakeShootDefaults struct {
+	Fake *FakeSettingsV1alpha1
+	ns   string
+}
+
+var shootdefaultsResource = v1alpha1.SchemeGroupVersion.WithResource("shootdefaults")
+
+var shootdefaultsKind = v1alpha1.SchemeGroupVersion.WithKind("ShootDefaults")
+
+// Get takes name of the shootDefaults, and returns the corresponding shootDefaults object, and an error if there is any.
+func (c *FakeShootDefaults) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ShootDefaults, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(shootdefaultsResource, c.ns, name), &v1alpha1.ShootDefaults{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ShootDefaults), err
+}
+
+// List takes label and field selectors, and returns the list of ShootDefaults that match those selectors.
+func (c *FakeShootDefaults) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ShootDefaultsList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(shootdefaultsResource, shootdefaultsKind, c.ns, opts), &v1alpha1.ShootDefaultsList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ShootDefaultsList{ListMeta: obj.(*v1alpha1.ShootDefaultsList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ShootDefaultsList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested shootDefaults.
+func (c *FakeShootDefaults) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(shootdefaultsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a shootDefaults and creates it.  Returns the server's representation of the shootDefaults, and an error, if there is any.
+func (c *FakeShootDefaults) Create(ctx context.Context, shootDefaults *v1alpha1.ShootDefaults, opts v1.CreateOptions) (result *v1alpha1.ShootDefaults, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(shootdefaultsResource, c.ns, shootDefaults), &v1alpha1.ShootDefaults{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ShootDefaults), err
+}
+
+// Update takes the representation of a shootDefaults and updates it. Returns the server's representation of the shootDefaults, and an error, if there is any.
+func (c *FakeShootDefaults) Update(ctx context.Context, shootDefaults *v1alpha1.ShootDefaults, opts v1.UpdateOptions) (result *v1alpha1.ShootDefaults, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(shootdefaultsResource, c.ns, shootDefaults), &v1alpha1.ShootDefaults{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ShootDefaults), err
+}
+
+// Delete takes name of the shootDefaults and deletes it. Returns an error if one occurs.
+func (c *FakeShootDefaults) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(shootdefaultsResource, c.ns, name, opts), &v1alpha1.ShootDefaults{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeShootDefaults) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(shootdefaultsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ShootDefaultsList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched shootDefaults.
+func (c *FakeShootDefaults) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ShootDefaults, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(shootdefaultsResource, c.ns, name, pt, data, subresources...), &v1alpha1.ShootDefaults{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ShootDefaults), err
+}
@@ -20,4 +20,12 @@ package v1alpha1
 
 type ClusterOpenIDConnectPresetExpansion interface{}
 
+type ClusterShootDefaultsExpansion interface{}
+
+type ClusterShootPolicyExpansion interface{}
+
 type OpenIDConnectPresetExpansion interface{}
+
+type ShootDefaultsExpansion interface{}
+
+type ShootPolicyExpansion interface{}
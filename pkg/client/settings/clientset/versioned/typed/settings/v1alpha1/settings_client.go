@@ -29,7 +29,11 @@ import (
 type SettingsV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	ClusterOpenIDConnectPresetsGetter
+	ClusterShootDefaultsGetter
+	ClusterShootPoliciesGetter
 	OpenIDConnectPresetsGetter
+	ShootDefaultsGetter
+	ShootPoliciesGetter
 }
 
 // SettingsV1alpha1Client is used to interact with features provided by the settings.gardener.cloud group.
@@ -41,10 +45,26 @@ func (c *SettingsV1alpha1Client) ClusterOpenIDConnectPresets() ClusterOpenIDConn
 	return newClusterOpenIDConnectPresets(c)
 }
 
+func (c *SettingsV1alpha1Client) ClusterShootDefaults() ClusterShootDefaultsInterface {
+	return newClusterShootDefaults(c)
+}
+
+func (c *SettingsV1alpha1Client) ClusterShootPolicies() ClusterShootPolicyInterface {
+	return newClusterShootPolicies(c)
+}
+
 func (c *SettingsV1alpha1Client) OpenIDConnectPresets(namespace string) OpenIDConnectPresetInterface {
 	return newOpenIDConnectPresets(c, namespace)
 }
 
+func (c *SettingsV1alpha1Client) ShootDefaults(namespace string) ShootDefaultsInterface {
+	return newShootDefaults(c, namespace)
+}
+
+func (c *SettingsV1alpha1Client) ShootPolicies(namespace string) ShootPolicyInterface {
+	return newShootPolicies(c, namespace)
+}
+
 // NewForConfig creates a new SettingsV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -26,8 +26,16 @@ import (
 type Interface interface {
 	// ClusterOpenIDConnectPresets returns a ClusterOpenIDConnectPresetInformer.
 	ClusterOpenIDConnectPresets() ClusterOpenIDConnectPresetInformer
+	// ClusterShootDefaults returns a ClusterShootDefaultsInformer.
+	ClusterShootDefaults() ClusterShootDefaultsInformer
+	// ClusterShootPolicies returns a ClusterShootPolicyInformer.
+	ClusterShootPolicies() ClusterShootPolicyInformer
 	// OpenIDConnectPresets returns a OpenIDConnectPresetInformer.
 	OpenIDConnectPresets() OpenIDConnectPresetInformer
+	// ShootDefaults returns a ShootDefaultsInformer.
+	ShootDefaults() ShootDefaultsInformer
+	// ShootPolicies returns a ShootPolicyInformer.
+	ShootPolicies() ShootPolicyInformer
 }
 
 type version struct {
@@ -46,7 +54,27 @@ func (v *version) ClusterOpenIDConnectPresets() ClusterOpenIDConnectPresetInform
 	return &clusterOpenIDConnectPresetInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterShootDefaults returns a ClusterShootDefaultsInformer.
+func (v *version) ClusterShootDefaults() ClusterShootDefaultsInformer {
+	return &clusterShootDefaultsInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// ClusterShootPolicies returns a ClusterShootPolicyInformer.
+func (v *version) ClusterShootPolicies() ClusterShootPolicyInformer {
+	return &clusterShootPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // OpenIDConnectPresets returns a OpenIDConnectPresetInformer.
 func (v *version) OpenIDConnectPresets() OpenIDConnectPresetInformer {
 	return &openIDConnectPresetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ShootDefaults returns a ShootDefaultsInformer.
+func (v *version) ShootDefaults() ShootDefaultsInformer {
+	return &shootDefaultsInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ShootPolicies returns a ShootPolicyInformer.
+func (v *version) ShootPolicies() ShootPolicyInformer {
+	return &shootPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
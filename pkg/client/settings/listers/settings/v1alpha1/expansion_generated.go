@@ -22,6 +22,14 @@ package v1alpha1
 // ClusterOpenIDConnectPresetLister.
 type ClusterOpenIDConnectPresetListerExpansion interface{}
 
+// ClusterShootDefaultsListerExpansion allows custom methods to be added to
+// ClusterShootDefaultsLister.
+type ClusterShootDefaultsListerExpansion interface{}
+
+// ClusterShootPolicyListerExpansion allows custom methods to be added to
+// ClusterShootPolicyLister.
+type ClusterShootPolicyListerExpansion interface{}
+
 // OpenIDConnectPresetListerExpansion allows custom methods to be added to
 // OpenIDConnectPresetLister.
 type OpenIDConnectPresetListerExpansion interface{}
@@ -29,3 +37,19 @@ type OpenIDConnectPresetListerExpansion interface{}
 // OpenIDConnectPresetNamespaceListerExpansion allows custom methods to be added to
 // OpenIDConnectPresetNamespaceLister.
 type OpenIDConnectPresetNamespaceListerExpansion interface{}
+
+// ShootDefaultsListerExpansion allows custom methods to be added to
+// ShootDefaultsLister.
+type ShootDefaultsListerExpansion interface{}
+
+// ShootDefaultsNamespaceListerExpansion allows custom methods to be added to
+// ShootDefaultsNamespaceLister.
+type ShootDefaultsNamespaceListerExpansion interface{}
+
+// ShootPolicyListerExpansion allows custom methods to be added to
+// ShootPolicyLister.
+type ShootPolicyListerExpansion interface{}
+
+// ShootPolicyNamespaceListerExpansion allows custom methods to be added to
+// ShootPolicyNamespaceLister.
+type ShootPolicyNamespaceListerExpansion interface{}
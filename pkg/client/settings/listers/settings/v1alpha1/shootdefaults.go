@@ -0,0 +1,99 @@
+/*
+Copyright SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ShootDefaultsLister helps list ShootDefaults.
+// All objects returned here must be treated as read-only.
+type ShootDefaultsLister interface {
+	// List lists all ShootDefaults in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ShootDefaults, err error)
+	// ShootDefaults returns an object that can list and get ShootDefaults.
+	ShootDefaults(namespace string) ShootDefaultsNamespaceLister
+	ShootDefaultsListerExpansion
+}
+
+// shootDefaultsLister implements the ShootDefaultsLister interface.
+type shootDefaultsLister struct {
+	indexer cache.Indexer
+}
+
+// NewShootDefaultsLister returns a new ShootDefaultsLister.
+func NewShootDefaultsLister(indexer cache.Indexer) ShootDefaultsLister {
+	return &shootDefaultsLister{indexer: indexer}
+}
+
+// List lists all ShootDefaults in the indexer.
+func (s *shootDefaultsLister) List(selector labels.Selector) (ret []*v1alpha1.ShootDefaults, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ShootDefaults))
+	})
+	return ret, err
+}
+
+// ShootDefaults returns an object that can list and get ShootDefaults.
+func (s *shootDefaultsLister) ShootDefaults(namespace string) ShootDefaultsNamespaceLister {
+	return shootDefaultsNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ShootDefaultsNamespaceLister helps list and get ShootDefaults.
+// All objects returned here must be treated as read-only.
+type ShootDefaultsNamespaceLister interface {
+	// List lists all ShootDefaults in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ShootDefaults, err error)
+	// Get retrieves the ShootDefaults from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ShootDefaults, error)
+	ShootDefaultsNamespaceListerExpansion
+}
+
+// shootDefaultsNamespaceLister implements the ShootDefaultsNamespaceLister
+// interface.
+type shootDefaultsNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ShootDefaults in the indexer for a given namespace.
+func (s shootDefaultsNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.ShootDefaults, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ShootDefaults))
+	})
+	return ret, err
+}
+
+// Get retrieves the ShootDefaults from the indexer for a given namespace and name.
+func (s shootDefaultsNamespaceLister) Get(name string) (*v1alpha1.ShootDefaults, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("shootdefaults"), name)
+	}
+	return obj.(*v1alpha1.ShootDefaults), nil
+}
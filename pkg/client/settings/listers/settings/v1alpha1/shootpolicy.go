@@ -0,0 +1,99 @@
+/*
+Copyright SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ShootPolicyLister helps list ShootPolicies.
+// All objects returned here must be treated as read-only.
+type ShootPolicyLister interface {
+	// List lists all ShootPolicies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ShootPolicy, err error)
+	// ShootPolicies returns an object that can list and get ShootPolicies.
+	ShootPolicies(namespace string) ShootPolicyNamespaceLister
+	ShootPolicyListerExpansion
+}
+
+// shootPolicyLister implements the ShootPolicyLister interface.
+type shootPolicyLister struct {
+	indexer cache.Indexer
+}
+
+// NewShootPolicyLister returns a new ShootPolicyLister.
+func NewShootPolicyLister(indexer cache.Indexer) ShootPolicyLister {
+	return &shootPolicyLister{indexer: indexer}
+}
+
+// List lists all ShootPolicies in the indexer.
+func (s *shootPolicyLister) List(selector labels.Selector) (ret []*v1alpha1.ShootPolicy, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ShootPolicy))
+	})
+	return ret, err
+}
+
+// ShootPolicies returns an object that can list and get ShootPolicies.
+func (s *shootPolicyLister) ShootPolicies(namespace string) ShootPolicyNamespaceLister {
+	return shootPolicyNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ShootPolicyNamespaceLister helps list and get ShootPolicies.
+// All objects returned here must be treated as read-only.
+type ShootPolicyNamespaceLister interface {
+	// List lists all ShootPolicies in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ShootPolicy, err error)
+	// Get retrieves the ShootPolicy from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ShootPolicy, error)
+	ShootPolicyNamespaceListerExpansion
+}
+
+// shootPolicyNamespaceLister implements the ShootPolicyNamespaceLister
+// interface.
+type shootPolicyNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ShootPolicies in the indexer for a given namespace.
+func (s shootPolicyNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.ShootPolicy, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ShootPolicy))
+	})
+	return ret, err
+}
+
+// Get retrieves the ShootPolicy from the indexer for a given namespace and name.
+func (s shootPolicyNamespaceLister) Get(name string) (*v1alpha1.ShootPolicy, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("shootpolicy"), name)
+	}
+	return obj.(*v1alpha1.ShootPolicy), nil
+}
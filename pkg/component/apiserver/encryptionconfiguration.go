@@ -67,6 +67,15 @@ const (
 	volumeMountPathEtcdEncryptionConfig = "/etc/kubernetes/etcd-encryption-secret"
 )
 
+// ETCDEncryptionKeySecretConfig returns the configuration for the secret containing the ETCD encryption key with the
+// given name.
+func ETCDEncryptionKeySecretConfig(name string) *secretsutils.ETCDEncryptionKeySecretConfig {
+	return &secretsutils.ETCDEncryptionKeySecretConfig{
+		Name:         name,
+		SecretLength: 32,
+	}
+}
+
 // ReconcileSecretETCDEncryptionConfiguration reconciles the ETCD encryption secret configuration.
 func ReconcileSecretETCDEncryptionConfiguration(
 	ctx context.Context,
@@ -86,10 +95,7 @@ func ReconcileSecretETCDEncryptionConfiguration(
 		options = append(options, secretsmanager.IgnoreOldSecrets())
 	}
 
-	keySecret, err := secretsManager.Generate(ctx, &secretsutils.ETCDEncryptionKeySecretConfig{
-		Name:         secretNameETCDEncryptionKey,
-		SecretLength: 32,
-	}, options...)
+	keySecret, err := secretsManager.Generate(ctx, ETCDEncryptionKeySecretConfig(secretNameETCDEncryptionKey), options...)
 	if err != nil {
 		return err
 	}
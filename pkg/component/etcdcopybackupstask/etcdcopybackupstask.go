@@ -47,6 +47,8 @@ type Interface interface {
 	SetSourceStore(druidv1alpha1.StoreSpec)
 	// SetTargetStore sets the specifications for the object store provider to which backups will be copied.
 	SetTargetStore(druidv1alpha1.StoreSpec)
+	// SetMaxBackupAge sets the maximum age in days that a backup must have in order to be copied.
+	SetMaxBackupAge(*uint32)
 }
 
 // Values contains the values used to create an EtcdCopyBackupsTask resources.
@@ -151,6 +153,11 @@ func (e *etcdCopyBackupsTask) SetTargetStore(store druidv1alpha1.StoreSpec) {
 	e.values.TargetStore = store
 }
 
+// SetMaxBackupAge sets the maximum age in days that a backup must have in order to be copied.
+func (e *etcdCopyBackupsTask) SetMaxBackupAge(maxBackupAge *uint32) {
+	e.values.MaxBackupAge = maxBackupAge
+}
+
 // waitForConditions waits until the EtcdCopyBackupsTask conditions have been populated by the etcd-druid.
 func waitForConditions(obj client.Object) error {
 	task, ok := obj.(*druidv1alpha1.EtcdCopyBackupsTask)
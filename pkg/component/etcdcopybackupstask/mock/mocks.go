@@ -63,6 +63,18 @@ func (mr *MockInterfaceMockRecorder) Destroy(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockInterface)(nil).Destroy), arg0)
 }
 
+// SetMaxBackupAge mocks base method.
+func (m *MockInterface) SetMaxBackupAge(arg0 *uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMaxBackupAge", arg0)
+}
+
+// SetMaxBackupAge indicates an expected call of SetMaxBackupAge.
+func (mr *MockInterfaceMockRecorder) SetMaxBackupAge(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxBackupAge", reflect.TypeOf((*MockInterface)(nil).SetMaxBackupAge), arg0)
+}
+
 // SetSourceStore mocks base method.
 func (m *MockInterface) SetSourceStore(arg0 v1alpha1.StoreSpec) {
 	m.ctrl.T.Helper()
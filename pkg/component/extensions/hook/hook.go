@@ -0,0 +1,182 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
+)
+
+const (
+	// DefaultInterval is the default interval for retry operations.
+	DefaultInterval = 5 * time.Second
+	// DefaultSevereThreshold is the default threshold until an error reported by another component is treated as 'severe'.
+	DefaultSevereThreshold = 30 * time.Second
+	// DefaultTimeout is the default timeout for a hook if the hook does not specify one.
+	DefaultTimeout = 10 * time.Minute
+	// DefaultDeletionTimeout is the default timeout for waiting until the Extension resource of a hook is deleted.
+	DefaultDeletionTimeout = 3 * time.Minute
+
+	// namePrefix is the prefix of the names of the Extension resources created for hooks.
+	namePrefix = "hook-"
+)
+
+// TimeNow returns the current time. Exposed for testing.
+var TimeNow = time.Now
+
+// Interface contains functions to execute the hooks of a Shoot.
+type Interface interface {
+	// Execute executes all hooks registered for the given point sequentially in the order of their definition. For
+	// every hook, an Extension resource is created and the function waits until it was reconciled successfully.
+	// Afterwards, the Extension resource is deleted again. Failed hooks with failure policy 'Ignore' are only logged.
+	Execute(ctx context.Context, point gardencorev1beta1.HookPoint) error
+	// Has returns true if at least one hook is registered for the given point.
+	Has(point gardencorev1beta1.HookPoint) bool
+}
+
+// Values contains the values used to create the Extension resources for hooks.
+type Values struct {
+	// Namespace is the namespace into which the Extension resources should be deployed.
+	Namespace string
+	// Hooks are the hooks of the Shoot.
+	Hooks []gardencorev1beta1.Hook
+}
+
+type hook struct {
+	values              *Values
+	log                 logr.Logger
+	client              client.Client
+	waitInterval        time.Duration
+	waitSevereThreshold time.Duration
+	deletionTimeout     time.Duration
+}
+
+// New creates a new instance of Interface for executing hooks.
+func New(
+	log logr.Logger,
+	client client.Client,
+	values *Values,
+	waitInterval time.Duration,
+	waitSevereThreshold time.Duration,
+	deletionTimeout time.Duration,
+) Interface {
+	return &hook{
+		values:              values,
+		log:                 log,
+		client:              client,
+		waitInterval:        waitInterval,
+		waitSevereThreshold: waitSevereThreshold,
+		deletionTimeout:     deletionTimeout,
+	}
+}
+
+func (h *hook) Has(point gardencorev1beta1.HookPoint) bool {
+	for _, hk := range h.values.Hooks {
+		if hk.Point == point {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *hook) Execute(ctx context.Context, point gardencorev1beta1.HookPoint) error {
+	for _, hk := range h.values.Hooks {
+		if hk.Point != point {
+			continue
+		}
+
+		log := h.log.WithValues("hook", hk.Name, "point", point)
+		log.Info("Executing hook")
+
+		if err := h.execute(ctx, log, hk); err != nil {
+			if hk.FailurePolicy != nil && *hk.FailurePolicy == gardencorev1beta1.HookFailurePolicyIgnore {
+				log.Error(err, "Hook failed, ignoring error due to its failure policy")
+				continue
+			}
+			return fmt.Errorf("hook %q failed: %w", hk.Name, err)
+		}
+
+		log.Info("Hook executed successfully")
+	}
+
+	return nil
+}
+
+func (h *hook) execute(ctx context.Context, log logr.Logger, hk gardencorev1beta1.Hook) error {
+	timeout := DefaultTimeout
+	if hk.Timeout != nil {
+		timeout = hk.Timeout.Duration
+	}
+
+	ext := &extensionsv1alpha1.Extension{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      namePrefix + hk.Name,
+			Namespace: h.values.Namespace,
+		},
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, h.client, ext, func() error {
+		metav1.SetMetaDataLabel(&ext.ObjectMeta, extensionsv1alpha1.LabelHookPoint, string(hk.Point))
+		metav1.SetMetaDataAnnotation(&ext.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+		metav1.SetMetaDataAnnotation(&ext.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+		ext.Spec.Type = hk.Type
+		ext.Spec.ProviderConfig = hk.ProviderConfig
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	waitErr := extensions.WaitUntilExtensionObjectReady(
+		ctx,
+		h.client,
+		log,
+		ext,
+		extensionsv1alpha1.ExtensionResource,
+		h.waitInterval,
+		h.waitSevereThreshold,
+		timeout,
+		nil,
+	)
+
+	// The Extension resource is deleted after every execution, independent of the result, so that hooks do not leave
+	// any state behind, and the next execution starts from scratch.
+	if err := h.delete(ctx, log, ext); err != nil {
+		if waitErr != nil {
+			log.Error(err, "Failed deleting Extension resource of failed hook")
+			return waitErr
+		}
+		return err
+	}
+
+	return waitErr
+}
+
+func (h *hook) delete(ctx context.Context, log logr.Logger, ext *extensionsv1alpha1.Extension) error {
+	if err := extensions.DeleteExtensionObject(ctx, h.client, ext); err != nil {
+		return err
+	}
+	return extensions.WaitUntilExtensionObjectDeleted(ctx, h.client, log, ext, extensionsv1alpha1.ExtensionResource, h.waitInterval, h.deletionTimeout)
+}
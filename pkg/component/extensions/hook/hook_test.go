@@ -0,0 +1,182 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hook_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/component/extensions/hook"
+)
+
+var _ = Describe("Hook", func() {
+	const (
+		namespace   = "shoot--foo--bar"
+		failingType = "failing"
+	)
+
+	var (
+		ctx = context.TODO()
+		log = logr.Discard()
+
+		fakeClient client.WithWatch
+		c          client.WithWatch
+		reconciled []string
+
+		ignore = gardencorev1beta1.HookFailurePolicyIgnore
+		fail   = gardencorev1beta1.HookFailurePolicyFail
+
+		values *Values
+		hooks  Interface
+	)
+
+	BeforeEach(func() {
+		reconciled = nil
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		// The interceptor simulates an extension controller which reconciles the Extension resources of hooks. Extensions
+		// of type 'failing' report an error.
+		c = interceptor.NewClient(fakeClient, interceptor.Funcs{
+			Get: func(ctx context.Context, cl client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if err := cl.Get(ctx, key, obj, opts...); err != nil {
+					return err
+				}
+
+				ext, ok := obj.(*extensionsv1alpha1.Extension)
+				if !ok || ext.Annotations[v1beta1constants.GardenerOperation] != v1beta1constants.GardenerOperationReconcile {
+					return nil
+				}
+
+				delete(ext.Annotations, v1beta1constants.GardenerOperation)
+				ext.Status.ObservedGeneration = ext.Generation
+				ext.Status.LastOperation = &gardencorev1beta1.LastOperation{
+					State:          gardencorev1beta1.LastOperationStateSucceeded,
+					LastUpdateTime: metav1.NewTime(time.Now().Add(time.Second)),
+				}
+				if ext.Spec.Type == failingType {
+					ext.Status.LastOperation.State = gardencorev1beta1.LastOperationStateError
+					ext.Status.LastError = &gardencorev1beta1.LastError{Description: "some error"}
+				}
+				reconciled = append(reconciled, ext.Name)
+				return nil
+			},
+		})
+
+		values = &Values{
+			Namespace: namespace,
+			Hooks: []gardencorev1beta1.Hook{
+				{Name: "first", Type: "foo", Point: gardencorev1beta1.HookPointPreHibernation},
+				{Name: "second", Type: "bar", Point: gardencorev1beta1.HookPointPreHibernation},
+				{Name: "third", Type: "baz", Point: gardencorev1beta1.HookPointPreDeletion},
+			},
+		}
+		hooks = New(log, c, values, time.Millisecond, 10*time.Millisecond, time.Second)
+	})
+
+	expectNoHookExtensions := func() {
+		extensionList := &extensionsv1alpha1.ExtensionList{}
+		ExpectWithOffset(1, fakeClient.List(ctx, extensionList, client.InNamespace(namespace))).To(Succeed())
+		ExpectWithOffset(1, extensionList.Items).To(BeEmpty())
+	}
+
+	Describe("#Has", func() {
+		It("should return true if hooks are registered for the point", func() {
+			Expect(hooks.Has(gardencorev1beta1.HookPointPreHibernation)).To(BeTrue())
+			Expect(hooks.Has(gardencorev1beta1.HookPointPreDeletion)).To(BeTrue())
+		})
+
+		It("should return false if no hooks are registered for the point", func() {
+			Expect(hooks.Has(gardencorev1beta1.HookPointPostHibernation)).To(BeFalse())
+			Expect(hooks.Has(gardencorev1beta1.HookPointPreKubernetesUpgrade)).To(BeFalse())
+		})
+	})
+
+	Describe("#Execute", func() {
+		It("should do nothing if no hooks are registered for the point", func() {
+			Expect(hooks.Execute(ctx, gardencorev1beta1.HookPointPostKubernetesUpgrade)).To(Succeed())
+			Expect(reconciled).To(BeEmpty())
+			expectNoHookExtensions()
+		})
+
+		It("should execute all hooks of the point in order and delete their Extension resources", func() {
+			Expect(hooks.Execute(ctx, gardencorev1beta1.HookPointPreHibernation)).To(Succeed())
+			Expect(reconciled).To(Equal([]string{"hook-first", "hook-second"}))
+			expectNoHookExtensions()
+		})
+
+		It("should create the Extension resource with the expected spec", func() {
+			c = interceptor.NewClient(c, interceptor.Funcs{
+				Delete: func(ctx context.Context, cl client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+					ext := &extensionsv1alpha1.Extension{}
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), ext)).To(Succeed())
+					Expect(ext.Labels).To(HaveKeyWithValue("extensions.gardener.cloud/hook-point", "PreDeletion"))
+					Expect(ext.Spec.Type).To(Equal("baz"))
+					return cl.Delete(ctx, obj, opts...)
+				},
+			})
+			hooks = New(log, c, values, time.Millisecond, 10*time.Millisecond, time.Second)
+
+			Expect(hooks.Execute(ctx, gardencorev1beta1.HookPointPreDeletion)).To(Succeed())
+			Expect(reconciled).To(Equal([]string{"hook-third"}))
+			expectNoHookExtensions()
+		})
+
+		It("should fail and stop if a hook with failure policy 'Fail' fails", func() {
+			values.Hooks[0].Type = failingType
+			values.Hooks[0].Timeout = &metav1.Duration{Duration: 50 * time.Millisecond}
+			values.Hooks[0].FailurePolicy = &fail
+
+			Expect(hooks.Execute(ctx, gardencorev1beta1.HookPointPreHibernation)).To(MatchError(ContainSubstring(`hook "first" failed`)))
+			Expect(reconciled).NotTo(ContainElement("hook-second"))
+			expectNoHookExtensions()
+		})
+
+		It("should continue if a hook with failure policy 'Ignore' fails", func() {
+			values.Hooks[0].Type = failingType
+			values.Hooks[0].Timeout = &metav1.Duration{Duration: 50 * time.Millisecond}
+			values.Hooks[0].FailurePolicy = &ignore
+
+			Expect(hooks.Execute(ctx, gardencorev1beta1.HookPointPreHibernation)).To(Succeed())
+			Expect(reconciled).To(ContainElement("hook-second"))
+			expectNoHookExtensions()
+		})
+
+		It("should fail if the Extension resource cannot be deleted", func() {
+			c = interceptor.NewClient(c, interceptor.Funcs{
+				Delete: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.DeleteOption) error {
+					return apierrors.NewInternalError(errors.New("fake"))
+				},
+			})
+			values.Hooks[0].FailurePolicy = &fail
+			hooks = New(log, c, values, time.Millisecond, 10*time.Millisecond, time.Second)
+
+			Expect(hooks.Execute(ctx, gardencorev1beta1.HookPointPreHibernation)).To(MatchError(ContainSubstring(`hook "first" failed`)))
+		})
+	})
+})
@@ -142,6 +142,18 @@ func (mr *MockInterfaceMockRecorder) SetSSHPublicKeys(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSSHPublicKeys", reflect.TypeOf((*MockInterface)(nil).SetSSHPublicKeys), arg0)
 }
 
+// SetSSHUserCAPublicKeys mocks base method.
+func (m *MockInterface) SetSSHUserCAPublicKeys(arg0 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSSHUserCAPublicKeys", arg0)
+}
+
+// SetSSHUserCAPublicKeys indicates an expected call of SetSSHUserCAPublicKeys.
+func (mr *MockInterfaceMockRecorder) SetSSHUserCAPublicKeys(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSSHUserCAPublicKeys", reflect.TypeOf((*MockInterface)(nil).SetSSHUserCAPublicKeys), arg0)
+}
+
 // Wait mocks base method.
 func (m *MockInterface) Wait(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -80,6 +80,8 @@ type Interface interface {
 	SetCABundle(*string)
 	// SetSSHPublicKeys sets the SSHPublicKeys value.
 	SetSSHPublicKeys([]string)
+	// SetSSHUserCAPublicKeys sets the SSHUserCAPublicKeys value.
+	SetSSHUserCAPublicKeys([]string)
 	// WorkerNameToOperatingSystemConfigsMap returns a map whose key is a worker name and whose value is a structure
 	// containing both the downloader and the original operating system config data.
 	WorkerNameToOperatingSystemConfigsMap() map[string]*OperatingSystemConfigs
@@ -124,6 +126,9 @@ type OriginalValues struct {
 	MachineTypes []gardencorev1beta1.MachineType
 	// SSHPublicKeys is a list of public SSH keys.
 	SSHPublicKeys []string
+	// SSHUserCAPublicKeys is a list of public SSH keys of certificate authorities which are trusted for signing user
+	// certificates.
+	SSHUserCAPublicKeys []string
 	// SSHAccessEnabled states whether sshd.service service in systemd should be enabled and running for the worker nodes.
 	SSHAccessEnabled bool
 	// ValitailEnabled states whether Valitail shall be enabled.
@@ -475,6 +480,11 @@ func (o *operatingSystemConfig) SetSSHPublicKeys(keys []string) {
 	o.values.SSHPublicKeys = keys
 }
 
+// SetSSHUserCAPublicKeys sets the SSHUserCAPublicKeys value.
+func (o *operatingSystemConfig) SetSSHUserCAPublicKeys(keys []string) {
+	o.values.SSHUserCAPublicKeys = keys
+}
+
 // WorkerNameToOperatingSystemConfigsMap returns a map whose key is a worker name and whose value is a structure
 // containing both the downloader as well as the original operating system config data.
 func (o *operatingSystemConfig) WorkerNameToOperatingSystemConfigsMap() map[string]*OperatingSystemConfigs {
@@ -551,6 +561,7 @@ func (o *operatingSystemConfig) newDeployer(osc *extensionsv1alpha1.OperatingSys
 		kubeletDataVolumeName:   worker.KubeletDataVolumeName,
 		kubernetesVersion:       kubernetesVersion,
 		sshPublicKeys:           o.values.SSHPublicKeys,
+		sshUserCAPublicKeys:     o.values.SSHUserCAPublicKeys,
 		sshAccessEnabled:        o.values.SSHAccessEnabled,
 		valiIngressHostName:     o.values.ValiIngressHostName,
 		valitailEnabled:         o.values.ValitailEnabled,
@@ -615,6 +626,7 @@ type deployer struct {
 	kubeletDataVolumeName   *string
 	kubernetesVersion       *semver.Version
 	sshPublicKeys           []string
+	sshUserCAPublicKeys     []string
 	sshAccessEnabled        bool
 	valiIngressHostName     string
 	valitailEnabled         bool
@@ -688,6 +700,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 			KubeletDataVolumeName:   d.kubeletDataVolumeName,
 			KubernetesVersion:       d.kubernetesVersion,
 			SSHPublicKeys:           d.sshPublicKeys,
+			SSHUserCAPublicKeys:     d.sshUserCAPublicKeys,
 			SSHAccessEnabled:        d.sshAccessEnabled,
 			ValitailEnabled:         d.valitailEnabled,
 			ValiIngress:             d.valiIngressHostName,
@@ -45,6 +45,7 @@ type Context struct {
 	KubeletDataVolumeName   *string
 	KubernetesVersion       *semver.Version
 	SSHPublicKeys           []string
+	SSHUserCAPublicKeys     []string
 	SSHAccessEnabled        bool
 	ValiIngress             string
 	ValitailEnabled         bool
@@ -55,6 +55,10 @@ const (
 
 	// pathAuthorizedSSHKeys is the new file that can contain multiple SSH public keys.
 	pathAuthorizedSSHKeys = "/var/lib/gardener-user-authorized-keys"
+
+	// pathTrustedUserCAKeys is the file that contains the public keys of the SSH certificate authorities which are
+	// trusted by sshd for signing user certificates.
+	pathTrustedUserCAKeys = "/etc/ssh/gardener-trusted-user-ca-keys.pub"
 )
 
 type component struct{}
@@ -69,16 +73,57 @@ func (component) Name() string {
 }
 
 func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
-	var script bytes.Buffer
-	if err := tpl.Execute(&script, map[string]interface{}{
+	values := map[string]interface{}{
 		"pathPublicSSHKey":      pathPublicSSHKey,
 		"pathAuthorizedSSHKeys": pathAuthorizedSSHKeys,
-	}); err != nil {
+	}
+	if len(ctx.SSHUserCAPublicKeys) > 0 {
+		values["pathTrustedUserCAKeys"] = pathTrustedUserCAKeys
+	}
+
+	var script bytes.Buffer
+	if err := tpl.Execute(&script, values); err != nil {
 		return nil, nil, err
 	}
 
 	authorizedKeys := strings.Join(ctx.SSHPublicKeys, "\n")
 
+	files := []extensionsv1alpha1.File{
+		{
+			Path:        pathAuthorizedSSHKeys,
+			Permissions: pointer.Int32(0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64([]byte(authorizedKeys)),
+				},
+			},
+		},
+		{
+			Path:        pathScript,
+			Permissions: pointer.Int32(0755),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64(script.Bytes()),
+				},
+			},
+		},
+	}
+
+	if len(ctx.SSHUserCAPublicKeys) > 0 {
+		files = append(files, extensionsv1alpha1.File{
+			Path:        pathTrustedUserCAKeys,
+			Permissions: pointer.Int32(0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64([]byte(strings.Join(ctx.SSHUserCAPublicKeys, "\n"))),
+				},
+			},
+		})
+	}
+
 	return []extensionsv1alpha1.Unit{
 			{
 				Name:   "gardener-user.service",
@@ -93,27 +138,6 @@ ExecStart=` + pathScript + `
 `),
 			},
 		},
-		[]extensionsv1alpha1.File{
-			{
-				Path:        pathAuthorizedSSHKeys,
-				Permissions: pointer.Int32(0644),
-				Content: extensionsv1alpha1.FileContent{
-					Inline: &extensionsv1alpha1.FileContentInline{
-						Encoding: "b64",
-						Data:     utils.EncodeBase64([]byte(authorizedKeys)),
-					},
-				},
-			},
-			{
-				Path:        pathScript,
-				Permissions: pointer.Int32(0755),
-				Content: extensionsv1alpha1.FileContent{
-					Inline: &extensionsv1alpha1.FileContentInline{
-						Encoding: "b64",
-						Data:     utils.EncodeBase64(script.Bytes()),
-					},
-				},
-			},
-		},
+		files,
 		nil
 }
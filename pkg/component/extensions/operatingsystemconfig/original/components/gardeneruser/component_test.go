@@ -86,6 +86,46 @@ ExecStart=/var/lib/gardener-user/run.sh
 				},
 			))
 		})
+
+		It("should configure the trusted SSH user certificate authorities", func() {
+			ctx.SSHUserCAPublicKeys = []string{"ca-key", "old-ca-key"}
+
+			_, files, err := component.Config(ctx)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(ConsistOf(
+				extensionsv1alpha1.File{
+					Path:        "/var/lib/gardener-user-authorized-keys",
+					Permissions: pointer.Int32(0644),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{
+							Encoding: "b64",
+							Data:     utils.EncodeBase64([]byte(strings.Join(sshPublicKeys, "\n"))),
+						},
+					},
+				},
+				extensionsv1alpha1.File{
+					Path:        "/var/lib/gardener-user/run.sh",
+					Permissions: pointer.Int32(0755),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{
+							Encoding: "b64",
+							Data:     utils.EncodeBase64([]byte(script + scriptTrustedUserCAKeys)),
+						},
+					},
+				},
+				extensionsv1alpha1.File{
+					Path:        "/etc/ssh/gardener-trusted-user-ca-keys.pub",
+					Permissions: pointer.Int32(0644),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{
+							Encoding: "b64",
+							Data:     utils.EncodeBase64([]byte("ca-key\nold-ca-key")),
+						},
+					},
+				},
+			))
+		})
 	})
 })
 
@@ -114,3 +154,12 @@ if [ ! -f "$PATH_SUDOERS" ]; then
   echo "$USERNAME ALL=(ALL) NOPASSWD:ALL" > $PATH_SUDOERS
 fi
 `
+
+const scriptTrustedUserCAKeys = `
+# trust the SSH certificate authorities for user certificates, the first occurrence of an option wins in sshd_config
+PATH_SSHD_CONFIG="/etc/ssh/sshd_config"
+if ! grep -q "^TrustedUserCAKeys /etc/ssh/gardener-trusted-user-ca-keys.pub$" $PATH_SSHD_CONFIG; then
+  sed -i '1i TrustedUserCAKeys /etc/ssh/gardener-trusted-user-ca-keys.pub' $PATH_SSHD_CONFIG
+  systemctl reload sshd || systemctl reload ssh || true
+fi
+`
@@ -22,3 +22,12 @@ fi
 if [ ! -f "$PATH_SUDOERS" ]; then
   echo "$USERNAME ALL=(ALL) NOPASSWD:ALL" > $PATH_SUDOERS
 fi
+{{- if .pathTrustedUserCAKeys }}
+
+# trust the SSH certificate authorities for user certificates, the first occurrence of an option wins in sshd_config
+PATH_SSHD_CONFIG="/etc/ssh/sshd_config"
+if ! grep -q "^TrustedUserCAKeys {{ .pathTrustedUserCAKeys }}$" $PATH_SSHD_CONFIG; then
+  sed -i '1i TrustedUserCAKeys {{ .pathTrustedUserCAKeys }}' $PATH_SSHD_CONFIG
+  systemctl reload sshd || systemctl reload ssh || true
+fi
+{{- end }}
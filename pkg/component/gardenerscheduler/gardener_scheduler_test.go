@@ -229,6 +229,7 @@ var _ = Describe("GardenerScheduler", func() {
 				{
 					APIGroups: []string{gardencorev1beta1.GroupName},
 					Resources: []string{
+						"backupentries",
 						"cloudprofiles",
 						"seeds",
 					},
@@ -48,6 +48,7 @@ func (g *gardenerScheduler) clusterRole() *rbacv1.ClusterRole {
 			{
 				APIGroups: []string{gardencorev1beta1.GroupName},
 				Resources: []string{
+					"backupentries",
 					"cloudprofiles",
 					"seeds",
 				},
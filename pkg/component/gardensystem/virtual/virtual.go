@@ -417,7 +417,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 				},
 				{
 					APIGroups: []string{settingsv1alpha1.GroupName},
-					Resources: []string{"openidconnectpresets"},
+					Resources: []string{"openidconnectpresets", "shootpolicies", "shootdefaults"},
 					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
 				{
@@ -517,7 +517,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 				},
 				{
 					APIGroups: []string{settingsv1alpha1.GroupName},
-					Resources: []string{"openidconnectpresets"},
+					Resources: []string{"openidconnectpresets", "shootpolicies", "shootdefaults"},
 					Verbs:     []string{"get", "list", "watch"},
 				},
 				{
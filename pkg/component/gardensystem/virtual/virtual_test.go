@@ -435,7 +435,7 @@ var _ = Describe("Virtual", func() {
 				},
 				{
 					APIGroups: []string{"settings.gardener.cloud"},
-					Resources: []string{"openidconnectpresets"},
+					Resources: []string{"openidconnectpresets", "shootpolicies", "shootdefaults"},
 					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
 				{
@@ -533,7 +533,7 @@ var _ = Describe("Virtual", func() {
 				},
 				{
 					APIGroups: []string{"settings.gardener.cloud"},
-					Resources: []string{"openidconnectpresets"},
+					Resources: []string{"openidconnectpresets", "shootpolicies", "shootdefaults"},
 					Verbs:     []string{"get", "list", "watch"},
 				},
 				{
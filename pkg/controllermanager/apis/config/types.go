@@ -75,6 +75,8 @@ type ControllerManagerControllerConfiguration struct {
 	SeedExtensionsCheck *SeedExtensionsCheckControllerConfiguration
 	// SeedBackupBucketsCheck defines the configuration of the SeedBackupBucketsCheck controller.
 	SeedBackupBucketsCheck *SeedBackupBucketsCheckControllerConfiguration
+	// SeedDrain defines the configuration of the SeedDrain controller.
+	SeedDrain *SeedDrainControllerConfiguration
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration
 	// ShootQuota defines the configuration of the ShootQuota controller.
@@ -172,6 +174,16 @@ type ProjectControllerConfiguration struct {
 	StaleExpirationTimeDays *int
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	StaleSyncPeriod *metav1.Duration
+	// StalePolicies contains the policies for stale Projects which are still in use by Shoots. Projects without a
+	// matching policy are not considered stale as long as they contain Shoots.
+	StalePolicies []StaleProjectPolicy
+	// MemberInactivityThresholdDays is the number of days a member of a `Project` may not use the project before it is
+	// flagged for removal in the access review.
+	MemberInactivityThresholdDays *int
+	// AccessReviewSyncPeriod is the duration how often the access of the `Project` members is reviewed.
+	AccessReviewSyncPeriod *metav1.Duration
+	// UsageSyncPeriod is the duration how often the resource usage of the `Project`s is accumulated.
+	UsageSyncPeriod *metav1.Duration
 }
 
 // QuotaConfiguration defines quota configurations.
@@ -184,6 +196,28 @@ type QuotaConfiguration struct {
 	ProjectSelector *metav1.LabelSelector
 }
 
+// StaleProjectPolicy defines how stale Projects which are still in use by Shoots are handled.
+type StaleProjectPolicy struct {
+	// Purposes is the list of Project purposes (`.spec.purpose`) this policy applies to. If empty, the policy applies to
+	// all Projects. The first matching policy is used.
+	Purposes []string
+	// HibernationGracePeriodDays is the number of days after which the Shoots of a stale Project are hibernated.
+	HibernationGracePeriodDays *int
+	// DeletionGracePeriodDays is the number of days after which a stale Project and its Shoots are deleted once the
+	// owner of the Project confirmed the deletion.
+	DeletionGracePeriodDays *int
+	// Notification contains the configuration for notifications sent when a stale Project enters a new stage.
+	Notification *StaleProjectNotification
+}
+
+// StaleProjectNotification contains the configuration for notifications about stale Projects.
+type StaleProjectNotification struct {
+	// URL is the URL of the webhook the notifications are posted to.
+	URL string
+	// CABundle is a PEM encoded CA bundle which will be used to validate the webhook's server certificate.
+	CABundle []byte
+}
+
 // QuotaControllerConfiguration defines the configuration of the Quota controller.
 type QuotaControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
@@ -216,6 +250,15 @@ type SeedControllerConfiguration struct {
 	SyncPeriod *metav1.Duration
 }
 
+// SeedDrainControllerConfiguration defines the configuration of the SeedDrain controller.
+type SeedDrainControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the drain progress of draining Seeds is checked.
+	SyncPeriod *metav1.Duration
+}
+
 // SeedExtensionsCheckControllerConfiguration defines the configuration of the SeedExtensionsCheck
 // controller.
 type SeedExtensionsCheckControllerConfiguration struct {
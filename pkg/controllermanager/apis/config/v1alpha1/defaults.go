@@ -112,11 +112,32 @@ func SetDefaults_ProjectControllerConfiguration(obj *ProjectControllerConfigurat
 			Duration: 12 * time.Hour,
 		}
 	}
+	if obj.MemberInactivityThresholdDays == nil {
+		obj.MemberInactivityThresholdDays = pointer.Int(90)
+	}
+	if obj.AccessReviewSyncPeriod == nil {
+		obj.AccessReviewSyncPeriod = &metav1.Duration{
+			Duration: 24 * time.Hour,
+		}
+	}
+	if obj.UsageSyncPeriod == nil {
+		obj.UsageSyncPeriod = &metav1.Duration{
+			Duration: time.Hour,
+		}
+	}
 	for i, quota := range obj.Quotas {
 		if quota.ProjectSelector == nil {
 			obj.Quotas[i].ProjectSelector = &metav1.LabelSelector{}
 		}
 	}
+	for i, policy := range obj.StalePolicies {
+		if policy.HibernationGracePeriodDays == nil {
+			obj.StalePolicies[i].HibernationGracePeriodDays = pointer.Int(7)
+		}
+		if policy.DeletionGracePeriodDays == nil {
+			obj.StalePolicies[i].DeletionGracePeriodDays = pointer.Int(30)
+		}
+	}
 }
 
 // SetDefaults_ServerConfiguration sets defaults for the ServerConfiguration.
@@ -215,6 +236,16 @@ func SetDefaults_SeedBackupBucketsCheckControllerConfiguration(obj *SeedBackupBu
 	}
 }
 
+// SetDefaults_SeedDrainControllerConfiguration sets defaults for the SeedDrainControllerConfiguration.
+func SetDefaults_SeedDrainControllerConfiguration(obj *SeedDrainControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = pointer.Int(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+}
+
 // SetDefaults_ShootHibernationControllerConfiguration sets defaults for the ShootHibernationControllerConfiguration.
 func SetDefaults_ShootHibernationControllerConfiguration(obj *ShootHibernationControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -326,6 +357,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.SeedBackupBucketsCheck == nil {
 		obj.SeedBackupBucketsCheck = &SeedBackupBucketsCheckControllerConfiguration{}
 	}
+	if obj.SeedDrain == nil {
+		obj.SeedDrain = &SeedDrainControllerConfiguration{}
+	}
 	if obj.ShootQuota == nil {
 		obj.ShootQuota = &ShootQuotaControllerConfiguration{}
 	}
@@ -193,6 +193,13 @@ var _ = Describe("Defaults", func() {
 				StaleSyncPeriod: &metav1.Duration{
 					Duration: 12 * time.Hour,
 				},
+				MemberInactivityThresholdDays: pointer.Int(90),
+				AccessReviewSyncPeriod: &metav1.Duration{
+					Duration: 24 * time.Hour,
+				},
+				UsageSyncPeriod: &metav1.Duration{
+					Duration: time.Hour,
+				},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -223,6 +230,26 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.Project.Quotas).To(Equal(expected.Quotas))
 		})
 
+		It("should default ProjectControllerConfiguration unset StaleProjectPolicy correctly", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					Project: &ProjectControllerConfiguration{
+						StalePolicies: []StaleProjectPolicy{
+							{Purposes: []string{"trial"}},
+							{HibernationGracePeriodDays: pointer.Int(1), DeletionGracePeriodDays: pointer.Int(2)},
+						},
+					},
+				},
+			}
+			expected := []StaleProjectPolicy{
+				{Purposes: []string{"trial"}, HibernationGracePeriodDays: pointer.Int(7), DeletionGracePeriodDays: pointer.Int(30)},
+				{HibernationGracePeriodDays: pointer.Int(1), DeletionGracePeriodDays: pointer.Int(2)},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Project.StalePolicies).To(Equal(expected))
+		})
+
 		It("should not default fields that are set", func() {
 			obj = &ControllerManagerConfiguration{
 				Controllers: ControllerManagerControllerConfiguration{
@@ -234,6 +261,13 @@ var _ = Describe("Defaults", func() {
 						StaleSyncPeriod: &metav1.Duration{
 							Duration: 12 * time.Hour,
 						},
+						MemberInactivityThresholdDays: pointer.Int(180),
+						AccessReviewSyncPeriod: &metav1.Duration{
+							Duration: 6 * time.Hour,
+						},
+						UsageSyncPeriod: &metav1.Duration{
+							Duration: 30 * time.Minute,
+						},
 					},
 				},
 			}
@@ -506,6 +540,33 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("SeedDrainControllerConfiguration defaulting", func() {
+		It("should default SeedDrainControllerConfiguration correctly", func() {
+			expected := &SeedDrainControllerConfiguration{
+				ConcurrentSyncs: pointer.Int(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedDrain).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedDrain: &SeedDrainControllerConfiguration{
+						ConcurrentSyncs: pointer.Int(10),
+						SyncPeriod:      &metav1.Duration{Duration: 5 * time.Minute},
+					},
+				},
+			}
+			expected := obj.Controllers.SeedDrain.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedDrain).To(Equal(expected))
+		})
+	})
+
 	Describe("SeedBackupBucketsCheckControllerConfiguration defaulting", func() {
 		It("should default SeedBackupBucketsCheckControllerConfiguration correctly", func() {
 			expected := &SeedBackupBucketsCheckControllerConfiguration{
@@ -91,6 +91,9 @@ type ControllerManagerControllerConfiguration struct {
 	// SeedBackupBucketsCheck defines the configuration of the SeedBackupBucketsCheck controller.
 	// +optional
 	SeedBackupBucketsCheck *SeedBackupBucketsCheckControllerConfiguration `json:"seedBackupBucketsCheck,omitempty"`
+	// SeedDrain defines the configuration of the SeedDrain controller.
+	// +optional
+	SeedDrain *SeedDrainControllerConfiguration `json:"seedDrain,omitempty"`
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration `json:"shootMaintenance"`
 	// ShootQuota defines the configuration of the ShootQuota controller.
@@ -209,6 +212,20 @@ type ProjectControllerConfiguration struct {
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	// +optional
 	StaleSyncPeriod *metav1.Duration `json:"staleSyncPeriod,omitempty"`
+	// StalePolicies contains the policies for stale Projects which are still in use by Shoots. Projects without a
+	// matching policy are not considered stale as long as they contain Shoots.
+	// +optional
+	StalePolicies []StaleProjectPolicy `json:"stalePolicies,omitempty"`
+	// MemberInactivityThresholdDays is the number of days a member of a `Project` may not use the project before it is
+	// flagged for removal in the access review.
+	// +optional
+	MemberInactivityThresholdDays *int `json:"memberInactivityThresholdDays,omitempty"`
+	// AccessReviewSyncPeriod is the duration how often the access of the `Project` members is reviewed.
+	// +optional
+	AccessReviewSyncPeriod *metav1.Duration `json:"accessReviewSyncPeriod,omitempty"`
+	// UsageSyncPeriod is the duration how often the resource usage of the `Project`s is accumulated.
+	// +optional
+	UsageSyncPeriod *metav1.Duration `json:"usageSyncPeriod,omitempty"`
 }
 
 // QuotaConfiguration defines quota configurations.
@@ -222,6 +239,34 @@ type QuotaConfiguration struct {
 	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
 }
 
+// StaleProjectPolicy defines how stale Projects which are still in use by Shoots are handled.
+type StaleProjectPolicy struct {
+	// Purposes is the list of Project purposes (`.spec.purpose`) this policy applies to. If empty, the policy applies to
+	// all Projects. The first matching policy is used.
+	// +optional
+	Purposes []string `json:"purposes,omitempty"`
+	// HibernationGracePeriodDays is the number of days after which the Shoots of a stale Project are hibernated.
+	// Defaults to 7.
+	// +optional
+	HibernationGracePeriodDays *int `json:"hibernationGracePeriodDays,omitempty"`
+	// DeletionGracePeriodDays is the number of days after which a stale Project and its Shoots are deleted once the
+	// owner of the Project confirmed the deletion. Defaults to 30.
+	// +optional
+	DeletionGracePeriodDays *int `json:"deletionGracePeriodDays,omitempty"`
+	// Notification contains the configuration for notifications sent when a stale Project enters a new stage.
+	// +optional
+	Notification *StaleProjectNotification `json:"notification,omitempty"`
+}
+
+// StaleProjectNotification contains the configuration for notifications about stale Projects.
+type StaleProjectNotification struct {
+	// URL is the URL of the webhook the notifications are posted to.
+	URL string `json:"url"`
+	// CABundle is a PEM encoded CA bundle which will be used to validate the webhook's server certificate.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+}
+
 // QuotaControllerConfiguration defines the configuration of the Quota controller.
 type QuotaControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
@@ -260,6 +305,17 @@ type SeedControllerConfiguration struct {
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
+// SeedDrainControllerConfiguration defines the configuration of the SeedDrain controller.
+type SeedDrainControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the drain progress of draining Seeds is checked.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // SeedExtensionsCheckControllerConfiguration defines the configuration of the SeedExtensionsCheck
 // controller.
 type SeedExtensionsCheckControllerConfiguration struct {
@@ -204,6 +204,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedDrainControllerConfiguration)(nil), (*config.SeedDrainControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedDrainControllerConfiguration_To_config_SeedDrainControllerConfiguration(a.(*SeedDrainControllerConfiguration), b.(*config.SeedDrainControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedDrainControllerConfiguration)(nil), (*SeedDrainControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedDrainControllerConfiguration_To_v1alpha1_SeedDrainControllerConfiguration(a.(*config.SeedDrainControllerConfiguration), b.(*SeedDrainControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedExtensionsCheckControllerConfiguration)(nil), (*config.SeedExtensionsCheckControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedExtensionsCheckControllerConfiguration_To_config_SeedExtensionsCheckControllerConfiguration(a.(*SeedExtensionsCheckControllerConfiguration), b.(*config.SeedExtensionsCheckControllerConfiguration), scope)
 	}); err != nil {
@@ -304,6 +314,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StaleProjectNotification)(nil), (*config.StaleProjectNotification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StaleProjectNotification_To_config_StaleProjectNotification(a.(*StaleProjectNotification), b.(*config.StaleProjectNotification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.StaleProjectNotification)(nil), (*StaleProjectNotification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_StaleProjectNotification_To_v1alpha1_StaleProjectNotification(a.(*config.StaleProjectNotification), b.(*StaleProjectNotification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StaleProjectPolicy)(nil), (*config.StaleProjectPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StaleProjectPolicy_To_config_StaleProjectPolicy(a.(*StaleProjectPolicy), b.(*config.StaleProjectPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.StaleProjectPolicy)(nil), (*StaleProjectPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_StaleProjectPolicy_To_v1alpha1_StaleProjectPolicy(a.(*config.StaleProjectPolicy), b.(*StaleProjectPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*QuotaConfiguration)(nil), (*config.QuotaConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_QuotaConfiguration_To_config_QuotaConfiguration(a.(*QuotaConfiguration), b.(*config.QuotaConfiguration), scope)
 	}); err != nil {
@@ -516,6 +546,7 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 	out.Seed = (*config.SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
 	out.SeedExtensionsCheck = (*config.SeedExtensionsCheckControllerConfiguration)(unsafe.Pointer(in.SeedExtensionsCheck))
 	out.SeedBackupBucketsCheck = (*config.SeedBackupBucketsCheckControllerConfiguration)(unsafe.Pointer(in.SeedBackupBucketsCheck))
+	out.SeedDrain = (*config.SeedDrainControllerConfiguration)(unsafe.Pointer(in.SeedDrain))
 	if err := Convert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_ShootMaintenanceControllerConfiguration(&in.ShootMaintenance, &out.ShootMaintenance, s); err != nil {
 		return err
 	}
@@ -558,6 +589,7 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 	out.Seed = (*SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
 	out.SeedExtensionsCheck = (*SeedExtensionsCheckControllerConfiguration)(unsafe.Pointer(in.SeedExtensionsCheck))
 	out.SeedBackupBucketsCheck = (*SeedBackupBucketsCheckControllerConfiguration)(unsafe.Pointer(in.SeedBackupBucketsCheck))
+	out.SeedDrain = (*SeedDrainControllerConfiguration)(unsafe.Pointer(in.SeedDrain))
 	if err := Convert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_ShootMaintenanceControllerConfiguration(&in.ShootMaintenance, &out.ShootMaintenance, s); err != nil {
 		return err
 	}
@@ -681,6 +713,10 @@ func autoConvert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectContro
 	out.StaleGracePeriodDays = (*int)(unsafe.Pointer(in.StaleGracePeriodDays))
 	out.StaleExpirationTimeDays = (*int)(unsafe.Pointer(in.StaleExpirationTimeDays))
 	out.StaleSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.StaleSyncPeriod))
+	out.StalePolicies = *(*[]config.StaleProjectPolicy)(unsafe.Pointer(&in.StalePolicies))
+	out.MemberInactivityThresholdDays = (*int)(unsafe.Pointer(in.MemberInactivityThresholdDays))
+	out.AccessReviewSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.AccessReviewSyncPeriod))
+	out.UsageSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.UsageSyncPeriod))
 	return nil
 }
 
@@ -706,6 +742,10 @@ func autoConvert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectContro
 	out.StaleGracePeriodDays = (*int)(unsafe.Pointer(in.StaleGracePeriodDays))
 	out.StaleExpirationTimeDays = (*int)(unsafe.Pointer(in.StaleExpirationTimeDays))
 	out.StaleSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.StaleSyncPeriod))
+	out.StalePolicies = *(*[]StaleProjectPolicy)(unsafe.Pointer(&in.StalePolicies))
+	out.MemberInactivityThresholdDays = (*int)(unsafe.Pointer(in.MemberInactivityThresholdDays))
+	out.AccessReviewSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.AccessReviewSyncPeriod))
+	out.UsageSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.UsageSyncPeriod))
 	return nil
 }
 
@@ -825,6 +865,28 @@ func Convert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfig
 	return autoConvert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedDrainControllerConfiguration_To_config_SeedDrainControllerConfiguration(in *SeedDrainControllerConfiguration, out *config.SeedDrainControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_SeedDrainControllerConfiguration_To_config_SeedDrainControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedDrainControllerConfiguration_To_config_SeedDrainControllerConfiguration(in *SeedDrainControllerConfiguration, out *config.SeedDrainControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedDrainControllerConfiguration_To_config_SeedDrainControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedDrainControllerConfiguration_To_v1alpha1_SeedDrainControllerConfiguration(in *config.SeedDrainControllerConfiguration, out *SeedDrainControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_SeedDrainControllerConfiguration_To_v1alpha1_SeedDrainControllerConfiguration is an autogenerated conversion function.
+func Convert_config_SeedDrainControllerConfiguration_To_v1alpha1_SeedDrainControllerConfiguration(in *config.SeedDrainControllerConfiguration, out *SeedDrainControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedDrainControllerConfiguration_To_v1alpha1_SeedDrainControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedExtensionsCheckControllerConfiguration_To_config_SeedExtensionsCheckControllerConfiguration(in *SeedExtensionsCheckControllerConfiguration, out *config.SeedExtensionsCheckControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
@@ -1044,3 +1106,51 @@ func autoConvert_config_ShootStatusLabelControllerConfiguration_To_v1alpha1_Shoo
 func Convert_config_ShootStatusLabelControllerConfiguration_To_v1alpha1_ShootStatusLabelControllerConfiguration(in *config.ShootStatusLabelControllerConfiguration, out *ShootStatusLabelControllerConfiguration, s conversion.Scope) error {
 	return autoConvert_config_ShootStatusLabelControllerConfiguration_To_v1alpha1_ShootStatusLabelControllerConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_StaleProjectNotification_To_config_StaleProjectNotification(in *StaleProjectNotification, out *config.StaleProjectNotification, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_v1alpha1_StaleProjectNotification_To_config_StaleProjectNotification is an autogenerated conversion function.
+func Convert_v1alpha1_StaleProjectNotification_To_config_StaleProjectNotification(in *StaleProjectNotification, out *config.StaleProjectNotification, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StaleProjectNotification_To_config_StaleProjectNotification(in, out, s)
+}
+
+func autoConvert_config_StaleProjectNotification_To_v1alpha1_StaleProjectNotification(in *config.StaleProjectNotification, out *StaleProjectNotification, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	return nil
+}
+
+// Convert_config_StaleProjectNotification_To_v1alpha1_StaleProjectNotification is an autogenerated conversion function.
+func Convert_config_StaleProjectNotification_To_v1alpha1_StaleProjectNotification(in *config.StaleProjectNotification, out *StaleProjectNotification, s conversion.Scope) error {
+	return autoConvert_config_StaleProjectNotification_To_v1alpha1_StaleProjectNotification(in, out, s)
+}
+
+func autoConvert_v1alpha1_StaleProjectPolicy_To_config_StaleProjectPolicy(in *StaleProjectPolicy, out *config.StaleProjectPolicy, s conversion.Scope) error {
+	out.Purposes = *(*[]string)(unsafe.Pointer(&in.Purposes))
+	out.HibernationGracePeriodDays = (*int)(unsafe.Pointer(in.HibernationGracePeriodDays))
+	out.DeletionGracePeriodDays = (*int)(unsafe.Pointer(in.DeletionGracePeriodDays))
+	out.Notification = (*config.StaleProjectNotification)(unsafe.Pointer(in.Notification))
+	return nil
+}
+
+// Convert_v1alpha1_StaleProjectPolicy_To_config_StaleProjectPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_StaleProjectPolicy_To_config_StaleProjectPolicy(in *StaleProjectPolicy, out *config.StaleProjectPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StaleProjectPolicy_To_config_StaleProjectPolicy(in, out, s)
+}
+
+func autoConvert_config_StaleProjectPolicy_To_v1alpha1_StaleProjectPolicy(in *config.StaleProjectPolicy, out *StaleProjectPolicy, s conversion.Scope) error {
+	out.Purposes = *(*[]string)(unsafe.Pointer(&in.Purposes))
+	out.HibernationGracePeriodDays = (*int)(unsafe.Pointer(in.HibernationGracePeriodDays))
+	out.DeletionGracePeriodDays = (*int)(unsafe.Pointer(in.DeletionGracePeriodDays))
+	out.Notification = (*StaleProjectNotification)(unsafe.Pointer(in.Notification))
+	return nil
+}
+
+// Convert_config_StaleProjectPolicy_To_v1alpha1_StaleProjectPolicy is an autogenerated conversion function.
+func Convert_config_StaleProjectPolicy_To_v1alpha1_StaleProjectPolicy(in *config.StaleProjectPolicy, out *StaleProjectPolicy, s conversion.Scope) error {
+	return autoConvert_config_StaleProjectPolicy_To_v1alpha1_StaleProjectPolicy(in, out, s)
+}
@@ -15,6 +15,9 @@
 package validation
 
 import (
+	"net/url"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -59,6 +62,35 @@ func validateProjectControllerConfiguration(conf *config.ProjectControllerConfig
 	for i, quotaConfig := range conf.Quotas {
 		allErrs = append(allErrs, validateProjectQuotaConfiguration(quotaConfig, fldPath.Child("quotas").Index(i))...)
 	}
+	for i, policy := range conf.StalePolicies {
+		allErrs = append(allErrs, validateStaleProjectPolicy(policy, fldPath.Child("stalePolicies").Index(i))...)
+	}
+	return allErrs
+}
+
+func validateStaleProjectPolicy(policy config.StaleProjectPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy.HibernationGracePeriodDays != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*policy.HibernationGracePeriodDays), fldPath.Child("hibernationGracePeriodDays"))...)
+	}
+	if policy.DeletionGracePeriodDays != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*policy.DeletionGracePeriodDays), fldPath.Child("deletionGracePeriodDays"))...)
+
+		if policy.HibernationGracePeriodDays != nil && *policy.DeletionGracePeriodDays < *policy.HibernationGracePeriodDays {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("deletionGracePeriodDays"), *policy.DeletionGracePeriodDays, "must not be less than hibernationGracePeriodDays"))
+		}
+	}
+
+	if policy.Notification != nil {
+		urlFldPath := fldPath.Child("notification", "url")
+		if len(policy.Notification.URL) == 0 {
+			allErrs = append(allErrs, field.Required(urlFldPath, "must provide a webhook URL"))
+		} else if u, err := url.Parse(policy.Notification.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(urlFldPath, policy.Notification.URL, "must be a valid http or https URL"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -21,6 +21,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	. "github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
@@ -114,5 +115,63 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 				))
 			})
 		})
+
+		Context("StaleProjectPolicy", func() {
+			BeforeEach(func() {
+				conf.Controllers.Project = &config.ProjectControllerConfiguration{
+					StalePolicies: []config.StaleProjectPolicy{{
+						Purposes:                   []string{"trial"},
+						HibernationGracePeriodDays: pointer.Int(7),
+						DeletionGracePeriodDays:    pointer.Int(30),
+						Notification:               &config.StaleProjectNotification{URL: "http://localhost:8080/notify"},
+					}},
+				}
+			})
+
+			It("should pass because the policy is valid", func() {
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should fail because the grace periods are invalid", func() {
+				conf.Controllers.Project.StalePolicies[0].HibernationGracePeriodDays = pointer.Int(-1)
+				conf.Controllers.Project.StalePolicies = append(conf.Controllers.Project.StalePolicies, config.StaleProjectPolicy{
+					HibernationGracePeriodDays: pointer.Int(14),
+					DeletionGracePeriodDays:    pointer.Int(7),
+				})
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.stalePolicies[0].hibernationGracePeriodDays"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.stalePolicies[1].deletionGracePeriodDays"),
+					})),
+				))
+			})
+
+			It("should fail because the notification URL is missing", func() {
+				conf.Controllers.Project.StalePolicies[0].Notification.URL = ""
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.project.stalePolicies[0].notification.url"),
+					})),
+				))
+			})
+
+			It("should fail because the notification URL is invalid", func() {
+				conf.Controllers.Project.StalePolicies[0].Notification.URL = "ftp://localhost"
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.stalePolicies[0].notification.url"),
+					})),
+				))
+			})
+		})
 	})
 })
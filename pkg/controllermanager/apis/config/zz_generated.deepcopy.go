@@ -246,6 +246,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(SeedBackupBucketsCheckControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeedDrain != nil {
+		in, out := &in.SeedDrain, &out.SeedDrain
+		*out = new(SeedDrainControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ShootMaintenance.DeepCopyInto(&out.ShootMaintenance)
 	if in.ShootQuota != nil {
 		in, out := &in.ShootQuota, &out.ShootQuota
@@ -421,6 +426,28 @@ func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerCon
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.StalePolicies != nil {
+		in, out := &in.StalePolicies, &out.StalePolicies
+		*out = make([]StaleProjectPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MemberInactivityThresholdDays != nil {
+		in, out := &in.MemberInactivityThresholdDays, &out.MemberInactivityThresholdDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.AccessReviewSyncPeriod != nil {
+		in, out := &in.AccessReviewSyncPeriod, &out.AccessReviewSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.UsageSyncPeriod != nil {
+		in, out := &in.UsageSyncPeriod, &out.UsageSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -567,6 +594,32 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedDrainControllerConfiguration) DeepCopyInto(out *SeedDrainControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDrainControllerConfiguration.
+func (in *SeedDrainControllerConfiguration) DeepCopy() *SeedDrainControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedDrainControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedExtensionsCheckControllerConfiguration) DeepCopyInto(out *SeedExtensionsCheckControllerConfiguration) {
 	*out = *in
@@ -816,3 +869,60 @@ func (in *ShootStatusLabelControllerConfiguration) DeepCopy() *ShootStatusLabelC
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaleProjectNotification) DeepCopyInto(out *StaleProjectNotification) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaleProjectNotification.
+func (in *StaleProjectNotification) DeepCopy() *StaleProjectNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(StaleProjectNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaleProjectPolicy) DeepCopyInto(out *StaleProjectPolicy) {
+	*out = *in
+	if in.Purposes != nil {
+		in, out := &in.Purposes, &out.Purposes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HibernationGracePeriodDays != nil {
+		in, out := &in.HibernationGracePeriodDays, &out.HibernationGracePeriodDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.DeletionGracePeriodDays != nil {
+		in, out := &in.DeletionGracePeriodDays, &out.DeletionGracePeriodDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.Notification != nil {
+		in, out := &in.Notification, &out.Notification
+		*out = new(StaleProjectNotification)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaleProjectPolicy.
+func (in *StaleProjectPolicy) DeepCopy() *StaleProjectPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(StaleProjectPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
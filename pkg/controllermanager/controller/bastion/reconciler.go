@@ -17,10 +17,14 @@ package bastion
 import (
 	"context"
 	"fmt"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -32,11 +36,29 @@ import (
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
+const (
+	// EventReasonShootGone is the reason of the event emitted when a Bastion is deleted because its Shoot is gone or
+	// in deletion.
+	EventReasonShootGone = "ShootGone"
+	// EventReasonShootMigrated is the reason of the event emitted when a Bastion is deleted because its Shoot has been
+	// migrated to another Seed.
+	EventReasonShootMigrated = "ShootMigrated"
+	// EventReasonExpired is the reason of the event emitted when a Bastion is deleted because it has expired.
+	EventReasonExpired = "Expired"
+	// EventReasonMaxLifetimeReached is the reason of the event emitted when a Bastion is deleted because it has reached
+	// its maximum lifetime.
+	EventReasonMaxLifetimeReached = "MaxLifetimeReached"
+	// EventReasonMaxSessionDurationReached is the reason of the event emitted when a Bastion is deleted because it has
+	// reached the maximum session duration configured in its Project.
+	EventReasonMaxSessionDurationReached = "MaxSessionDurationReached"
+)
+
 // Reconciler reconciles Bastions.
 type Reconciler struct {
-	Client client.Client
-	Config config.BastionControllerConfiguration
-	Clock  clock.Clock
+	Client   client.Client
+	Config   config.BastionControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
 }
 
 // Reconcile reacts to updates on Bastion resources and cleans up expired Bastions.
@@ -70,16 +92,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		// are removed. This is required because once a Shoot is gone, the Cluster resource
 		// is gone as well and without that, cleanly destroying a Bastion is not possible.
 		if apierrors.IsNotFound(err) {
-			log.Info("Deleting bastion because target shoot is gone")
-			return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
+			return reconcile.Result{}, r.deleteBastion(ctx, bastion, EventReasonShootGone, "Deleting bastion because target shoot is gone")
 		}
 		return reconcile.Result{}, fmt.Errorf("could not get shoot %v: %w", shootKey, err)
 	}
 
 	// delete the bastion if the shoot is marked for deletion
 	if shoot.DeletionTimestamp != nil {
-		log.Info("Deleting bastion because target shoot is in deletion")
-		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
+		return reconcile.Result{}, r.deleteBastion(ctx, bastion, EventReasonShootGone, "Deleting bastion because target shoot is in deletion")
 	}
 
 	// the Shoot for this bastion has been migrated to another Seed, we have to garbage-collect
@@ -90,24 +110,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	// under normal operations, shoots cannot be migrated to another seed while there are still
 	// bastions for it, so this check here is just a safety measure.
 	if !apiequality.Semantic.DeepEqual(shoot.Spec.SeedName, bastion.Spec.SeedName) {
-		log.Info("Deleting bastion because the referenced Shoot has been migrated to another Seed",
-			"oldSeedName", bastion.Spec.SeedName, "newSeedName", shoot.Spec.SeedName)
-		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
+		return reconcile.Result{}, r.deleteBastion(ctx, bastion, EventReasonShootMigrated, fmt.Sprintf("Deleting bastion because the referenced Shoot has been migrated from seed %q to seed %q",
+			pointer.StringDeref(bastion.Spec.SeedName, ""), pointer.StringDeref(shoot.Spec.SeedName, "")))
 	}
 
 	// delete the bastion once it has expired
 	if bastion.Status.ExpirationTimestamp != nil && r.Clock.Now().After(bastion.Status.ExpirationTimestamp.Time) {
-		log.Info("Deleting expired bastion", "expirationTimestamp", bastion.Status.ExpirationTimestamp.Time)
-		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
+		return reconcile.Result{}, r.deleteBastion(ctx, bastion, EventReasonExpired, fmt.Sprintf("Deleting expired bastion (expiration timestamp: %s)", bastion.Status.ExpirationTimestamp.UTC().Format(time.RFC3339)))
 	}
 
 	// delete the bastion once it has reached its maximum lifetime
 	if r.Clock.Since(bastion.CreationTimestamp.Time) > r.Config.MaxLifetime.Duration {
-		log.Info("Deleting bastion because it reached its maximum lifetime", "creationTimestamp", bastion.CreationTimestamp.Time, "maxLifetime", r.Config.MaxLifetime.Duration)
-		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
+		return reconcile.Result{}, r.deleteBastion(ctx, bastion, EventReasonMaxLifetimeReached, fmt.Sprintf("Deleting bastion because it reached its maximum lifetime of %s", r.Config.MaxLifetime.Duration))
+	}
+
+	// delete the bastion once it has reached the maximum session duration configured in the project
+	sessionEnd := getSessionEnd(bastion)
+	if sessionEnd != nil && r.Clock.Now().After(*sessionEnd) {
+		return reconcile.Result{}, r.deleteBastion(ctx, bastion, EventReasonMaxSessionDurationReached, fmt.Sprintf("Deleting bastion because it reached its maximum session duration of %s", bastion.Spec.MaxSessionDuration.Duration))
 	}
 
-	// requeue when the Bastion expires or reaches its lifetime, whichever is sooner
+	// requeue when the Bastion expires, reaches its lifetime or its session duration, whichever is sooner
 	requeueAfter := bastion.CreationTimestamp.Add(r.Config.MaxLifetime.Duration).Sub(r.Clock.Now())
 	if bastion.Status.ExpirationTimestamp != nil {
 		expiresIn := bastion.Status.ExpirationTimestamp.Sub(r.Clock.Now())
@@ -115,6 +138,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 			requeueAfter = expiresIn
 		}
 	}
+	if sessionEnd != nil {
+		sessionEndsIn := sessionEnd.Sub(r.Clock.Now())
+		if sessionEndsIn < requeueAfter {
+			requeueAfter = sessionEndsIn
+		}
+	}
 
 	if requeueAfter < 0 {
 		return reconcile.Result{}, fmt.Errorf("the bastion should already have been deleted")
@@ -123,3 +152,29 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	log.V(1).Info("Requeuing Bastion", "requeueAfter", requeueAfter)
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
+
+func (r *Reconciler) deleteBastion(ctx context.Context, bastion *operationsv1alpha1.Bastion, reason, message string) error {
+	logf.FromContext(ctx).Info(message)
+	r.Recorder.Event(bastion, corev1.EventTypeNormal, reason, message)
+	return client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
+}
+
+// getSessionEnd returns the point in time when the session of the given Bastion ends. The session starts with the
+// approval if the Bastion requires one, otherwise with its creation. It returns nil if there is no maximum session
+// duration or if the session has not yet started.
+func getSessionEnd(bastion *operationsv1alpha1.Bastion) *time.Time {
+	if bastion.Spec.MaxSessionDuration == nil {
+		return nil
+	}
+
+	sessionStart := bastion.CreationTimestamp.Time
+	if pointer.BoolDeref(bastion.Spec.ApprovalRequired, false) {
+		if bastion.Status.Approval == nil {
+			return nil
+		}
+		sessionStart = bastion.Status.Approval.ApprovalTimestamp.Time
+	}
+
+	sessionEnd := sessionStart.Add(bastion.Spec.MaxSessionDuration.Duration)
+	return &sessionEnd
+}
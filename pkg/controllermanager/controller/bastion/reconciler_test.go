@@ -25,7 +25,9 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -41,6 +43,7 @@ var _ = Describe("Controller", func() {
 	var (
 		mockCtrl   *gomock.Controller
 		mockClient *mockclient.MockClient
+		recorder   *record.FakeRecorder
 		reconciler reconcile.Reconciler
 
 		namespace   = "garden-dev"
@@ -54,12 +57,14 @@ var _ = Describe("Controller", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockClient = mockclient.NewMockClient(mockCtrl)
+		recorder = record.NewFakeRecorder(1)
 		reconciler = &Reconciler{
 			Client: mockClient,
 			Config: config.BastionControllerConfiguration{
 				MaxLifetime: &metav1.Duration{Duration: maxLifetime},
 			},
-			Clock: clock.RealClock{},
+			Clock:    clock.RealClock{},
+			Recorder: recorder,
 		}
 	})
 
@@ -214,6 +219,74 @@ var _ = Describe("Controller", func() {
 			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
 			Expect(result).To(Equal(reconcile.Result{}))
 			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonMaxLifetimeReached)))
+		})
+
+		Context("max session duration", func() {
+			var (
+				bastion         operationsv1alpha1.Bastion
+				sessionDuration = time.Hour
+			)
+
+			BeforeEach(func() {
+				created := time.Now().Add(-2 * sessionDuration)
+				expires := time.Now().Add(30 * time.Minute)
+				bastion = newBastion(namespace, bastionName, shootName, &seedName, &created, &expires)
+				bastion.Spec.MaxSessionDuration = &metav1.Duration{Duration: sessionDuration}
+
+				mockClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key(namespace, shootName), gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.Shoot, _ ...client.GetOption) error {
+					*obj = newShoot(namespace, shootName, &seedName)
+					return nil
+				})
+
+				mockClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key(namespace, bastionName), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *operationsv1alpha1.Bastion, _ ...client.GetOption) error {
+					*obj = bastion
+					return nil
+				})
+			})
+
+			It("should delete Bastions that have reached their max session duration", func() {
+				mockClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}))
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
+				Expect(result).To(Equal(reconcile.Result{}))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonMaxSessionDurationReached)))
+			})
+
+			It("should not start the session before the Bastion has been approved", func() {
+				bastion.Spec.ApprovalRequired = pointer.Bool(true)
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
+				Expect(result.RequeueAfter).To(BeNumerically("~", 30*time.Minute, 1*time.Second))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should requeue approved Bastions when their session ends", func() {
+				bastion.Spec.ApprovalRequired = pointer.Bool(true)
+				bastion.Status.Approval = &operationsv1alpha1.BastionApproval{
+					ApprovedBy:        "approver",
+					ApprovalTimestamp: metav1.NewTime(time.Now().Add(-50 * time.Minute)),
+				}
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
+				Expect(result.RequeueAfter).To(BeNumerically("~", 10*time.Minute, 1*time.Second))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should delete approved Bastions that have reached their max session duration", func() {
+				bastion.Spec.ApprovalRequired = pointer.Bool(true)
+				bastion.Status.Approval = &operationsv1alpha1.BastionApproval{
+					ApprovedBy:        "approver",
+					ApprovalTimestamp: metav1.NewTime(time.Now().Add(-61 * time.Minute)),
+				}
+
+				mockClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}))
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
+				Expect(result).To(Equal(reconcile.Result{}))
+				Expect(err).NotTo(HaveOccurred())
+			})
 		})
 
 		It("should delete Bastions with outdated seed information", func() {
@@ -23,6 +23,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration/controllerregistrationfinalizer"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration/extensionclusterrole"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration/rollout"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration/seed"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration/seedfinalizer"
 )
@@ -43,6 +44,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 		return fmt.Errorf("failed adding extension ClusterRole reconciler: %w", err)
 	}
 
+	if err := (&rollout.Reconciler{
+		Config: *cfg.Controllers.ControllerRegistration,
+	}).AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed adding rollout reconciler: %w", err)
+	}
+
 	if err := (&seedfinalizer.Reconciler{}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding Seed finalizer reconciler: %w", err)
 	}
@@ -0,0 +1,164 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "controllerregistration-rollout"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.ControllerRegistration{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: pointer.IntDeref(r.Config.ConcurrentSyncs, 0),
+		}).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &gardencorev1beta1.ControllerInstallation{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapControllerInstallationToControllerRegistration), mapper.UpdateWithNew, c.GetLogger()),
+		r.ControllerInstallationPredicate(),
+	); err != nil {
+		return err
+	}
+
+	// ControllerDeployments might be changed in-place, hence the ControllerRegistrations referencing them must be
+	// reconciled to roll out the new version progressively.
+	return c.Watch(
+		source.Kind(mgr.GetCache(), &gardencorev1beta1.ControllerDeployment{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapControllerDeploymentToControllerRegistrations), mapper.UpdateWithNew, c.GetLogger()),
+		predicate.ResourceVersionChangedPredicate{},
+	)
+}
+
+// ControllerInstallationPredicate returns true for all ControllerInstallation 'create' and 'delete' events. For updates,
+// it only returns true when the referenced ControllerDeployment (or its version) or the status of the Valid, Installed
+// or Healthy conditions have changed. For other events, false is returned.
+func (r *Reconciler) ControllerInstallationPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			controllerInstallation, ok := e.ObjectNew.(*gardencorev1beta1.ControllerInstallation)
+			if !ok {
+				return false
+			}
+
+			oldControllerInstallation, ok := e.ObjectOld.(*gardencorev1beta1.ControllerInstallation)
+			if !ok {
+				return false
+			}
+
+			if deploymentRef(oldControllerInstallation) != deploymentRef(controllerInstallation) {
+				return true
+			}
+
+			for _, conditionType := range []gardencorev1beta1.ConditionType{
+				gardencorev1beta1.ControllerInstallationValid,
+				gardencorev1beta1.ControllerInstallationInstalled,
+				gardencorev1beta1.ControllerInstallationHealthy,
+			} {
+				oldCondition := v1beta1helper.GetCondition(oldControllerInstallation.Status.Conditions, conditionType)
+				condition := v1beta1helper.GetCondition(controllerInstallation.Status.Conditions, conditionType)
+
+				if conditionStatusAndReason(oldCondition) != conditionStatusAndReason(condition) {
+					return true
+				}
+			}
+
+			return false
+		},
+		DeleteFunc:  func(e event.DeleteEvent) bool { return true },
+		GenericFunc: func(e event.GenericEvent) bool { return false },
+	}
+}
+
+// MapControllerInstallationToControllerRegistration returns a reconcile.Request object for the ControllerRegistration
+// specified in the .spec.registrationRef.name field.
+func (r *Reconciler) MapControllerInstallationToControllerRegistration(_ context.Context, _ logr.Logger, _ client.Reader, obj client.Object) []reconcile.Request {
+	controllerInstallation, ok := obj.(*gardencorev1beta1.ControllerInstallation)
+	if !ok {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: controllerInstallation.Spec.RegistrationRef.Name}}}
+}
+
+// MapControllerDeploymentToControllerRegistrations returns reconcile.Request objects for all ControllerRegistrations
+// which reference the ControllerDeployment and configure a rollout.
+func (r *Reconciler) MapControllerDeploymentToControllerRegistrations(ctx context.Context, log logr.Logger, reader client.Reader, obj client.Object) []reconcile.Request {
+	controllerRegistrationList := &gardencorev1beta1.ControllerRegistrationList{}
+	if err := reader.List(ctx, controllerRegistrationList); err != nil {
+		log.Error(err, "Failed to list ControllerRegistrations")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, controllerRegistration := range controllerRegistrationList.Items {
+		deployment := controllerRegistration.Spec.Deployment
+		if deployment == nil || deployment.Rollout == nil || len(deployment.DeploymentRefs) == 0 || deployment.DeploymentRefs[0].Name != obj.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: controllerRegistration.Name}})
+	}
+
+	return requests
+}
+
+func deploymentRef(controllerInstallation *gardencorev1beta1.ControllerInstallation) [2]string {
+	if controllerInstallation.Spec.DeploymentRef == nil {
+		return [2]string{}
+	}
+	return [2]string{controllerInstallation.Spec.DeploymentRef.Name, controllerInstallation.Spec.DeploymentRef.ResourceVersion}
+}
+
+func conditionStatusAndReason(condition *gardencorev1beta1.Condition) [2]string {
+	if condition == nil {
+		return [2]string{}
+	}
+	return [2]string{string(condition.Status), condition.Reason}
+}
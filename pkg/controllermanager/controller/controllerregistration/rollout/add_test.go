@@ -0,0 +1,193 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration/rollout"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler             *Reconciler
+		controllerInstallation *gardencorev1beta1.ControllerInstallation
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		controllerInstallation = &gardencorev1beta1.ControllerInstallation{
+			ObjectMeta: metav1.ObjectMeta{Name: "installation"},
+			Spec: gardencorev1beta1.ControllerInstallationSpec{
+				RegistrationRef: corev1.ObjectReference{Name: "registration"},
+				DeploymentRef:   &corev1.ObjectReference{Name: "deployment-v1"},
+				SeedRef:         corev1.ObjectReference{Name: "seed"},
+			},
+			Status: gardencorev1beta1.ControllerInstallationStatus{
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: gardencorev1beta1.ControllerInstallationInstalled, Status: gardencorev1beta1.ConditionTrue, Reason: "InstallationSuccessful"},
+					{Type: gardencorev1beta1.ControllerInstallationHealthy, Status: gardencorev1beta1.ConditionTrue, Reason: "ControllerHealthy"},
+				},
+			},
+		}
+	})
+
+	Describe("#ControllerInstallationPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ControllerInstallationPredicate()
+		})
+
+		Describe("#Create", func() {
+			It("should return true", func() {
+				Expect(p.Create(event.CreateEvent{Object: controllerInstallation})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because new object is no ControllerInstallation", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: controllerInstallation, ObjectNew: &corev1.Secret{}})).To(BeFalse())
+			})
+
+			It("should return false because old object is no ControllerInstallation", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: &corev1.Secret{}, ObjectNew: controllerInstallation})).To(BeFalse())
+			})
+
+			It("should return false because nothing relevant changed", func() {
+				oldControllerInstallation := controllerInstallation.DeepCopy()
+				controllerInstallation.Status.Conditions[0].Message = "new message"
+				controllerInstallation.Status.Conditions[0].LastUpdateTime = metav1.Now()
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldControllerInstallation, ObjectNew: controllerInstallation})).To(BeFalse())
+			})
+
+			It("should return true because the deployment reference changed", func() {
+				oldControllerInstallation := controllerInstallation.DeepCopy()
+				controllerInstallation.Spec.DeploymentRef.Name = "deployment-v2"
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldControllerInstallation, ObjectNew: controllerInstallation})).To(BeTrue())
+			})
+
+			It("should return true because the version of the referenced deployment changed", func() {
+				oldControllerInstallation := controllerInstallation.DeepCopy()
+				controllerInstallation.Spec.DeploymentRef.ResourceVersion = "2"
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldControllerInstallation, ObjectNew: controllerInstallation})).To(BeTrue())
+			})
+
+			It("should return true because a condition status changed", func() {
+				oldControllerInstallation := controllerInstallation.DeepCopy()
+				controllerInstallation.Status.Conditions[1].Status = gardencorev1beta1.ConditionFalse
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldControllerInstallation, ObjectNew: controllerInstallation})).To(BeTrue())
+			})
+
+			It("should return true because a condition reason changed", func() {
+				oldControllerInstallation := controllerInstallation.DeepCopy()
+				controllerInstallation.Status.Conditions[0].Reason = "InstallationPending"
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldControllerInstallation, ObjectNew: controllerInstallation})).To(BeTrue())
+			})
+
+			It("should return true because a condition was added", func() {
+				oldControllerInstallation := controllerInstallation.DeepCopy()
+				controllerInstallation.Status.Conditions = append(controllerInstallation.Status.Conditions, gardencorev1beta1.Condition{Type: gardencorev1beta1.ControllerInstallationValid, Status: gardencorev1beta1.ConditionTrue})
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldControllerInstallation, ObjectNew: controllerInstallation})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return true", func() {
+				Expect(p.Delete(event.DeleteEvent{Object: controllerInstallation})).To(BeTrue())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{Object: controllerInstallation})).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("#MapControllerInstallationToControllerRegistration", func() {
+		var (
+			ctx = context.TODO()
+			log = logr.Discard()
+		)
+
+		It("should return nil because object is no ControllerInstallation", func() {
+			Expect(reconciler.MapControllerInstallationToControllerRegistration(ctx, log, nil, &corev1.Secret{})).To(BeNil())
+		})
+
+		It("should map to the referenced ControllerRegistration", func() {
+			Expect(reconciler.MapControllerInstallationToControllerRegistration(ctx, log, nil, controllerInstallation)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "registration"}},
+			))
+		})
+	})
+
+	Describe("#MapControllerDeploymentToControllerRegistrations", func() {
+		var (
+			ctx        = context.TODO()
+			log        = logr.Discard()
+			fakeClient client.Client
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		})
+
+		It("should map to the ControllerRegistrations which reference the ControllerDeployment and configure a rollout", func() {
+			for _, controllerRegistration := range []*gardencorev1beta1.ControllerRegistration{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "with-rollout"},
+					Spec: gardencorev1beta1.ControllerRegistrationSpec{Deployment: &gardencorev1beta1.ControllerRegistrationDeployment{
+						DeploymentRefs: []gardencorev1beta1.DeploymentRef{{Name: "deployment-v1"}},
+						Rollout:        &gardencorev1beta1.ControllerRegistrationRollout{},
+					}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "without-rollout"},
+					Spec: gardencorev1beta1.ControllerRegistrationSpec{Deployment: &gardencorev1beta1.ControllerRegistrationDeployment{
+						DeploymentRefs: []gardencorev1beta1.DeploymentRef{{Name: "deployment-v1"}},
+					}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "other-deployment"},
+					Spec: gardencorev1beta1.ControllerRegistrationSpec{Deployment: &gardencorev1beta1.ControllerRegistrationDeployment{
+						DeploymentRefs: []gardencorev1beta1.DeploymentRef{{Name: "deployment-v2"}},
+						Rollout:        &gardencorev1beta1.ControllerRegistrationRollout{},
+					}},
+				},
+			} {
+				Expect(fakeClient.Create(ctx, controllerRegistration)).To(Succeed())
+			}
+
+			Expect(reconciler.MapControllerDeploymentToControllerRegistrations(ctx, log, fakeClient, &gardencorev1beta1.ControllerDeployment{ObjectMeta: metav1.ObjectMeta{Name: "deployment-v1"}})).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "with-rollout"}},
+			))
+		})
+	})
+})
@@ -0,0 +1,296 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// reasonInstallationPending is the reason of the Installed condition while the gardenlet waits for the controller
+// resources to be applied.
+const reasonInstallationPending = "InstallationPending"
+
+// Reconciler progressively rolls out the ControllerDeployment referenced by ControllerRegistrations which configure a
+// rollout. It switches the ControllerInstallations on the canary seeds first and the ones on the remaining seeds in
+// batches afterwards. The rollout is paused as long as the controller failed on any of the already updated seeds.
+type Reconciler struct {
+	Client client.Client
+	Config config.ControllerRegistrationControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile performs the progressive rollout and reports its progress in the status of the ControllerRegistration.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	controllerRegistration := &gardencorev1beta1.ControllerRegistration{}
+	if err := r.Client.Get(ctx, request.NamespacedName, controllerRegistration); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if controllerRegistration.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	deployment := controllerRegistration.Spec.Deployment
+	if deployment == nil || deployment.Rollout == nil || len(deployment.DeploymentRefs) == 0 {
+		if controllerRegistration.Status.Rollout != nil {
+			log.Info("Removing rollout status since no rollout is configured")
+			patch := client.MergeFrom(controllerRegistration.DeepCopy())
+			controllerRegistration.Status.Rollout = nil
+			return reconcile.Result{}, r.Client.Status().Patch(ctx, controllerRegistration, patch)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	// Today, only one DeploymentRef element is allowed, which is why can simply pick the first one from the slice.
+	controllerDeployment := &gardencorev1beta1.ControllerDeployment{}
+	if err := r.Client.Get(ctx, kubernetesutils.Key(deployment.DeploymentRefs[0].Name), controllerDeployment); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reading ControllerDeployment %s: %w", deployment.DeploymentRefs[0].Name, err)
+	}
+
+	canarySeedSelector := labels.Nothing()
+	if deployment.Rollout.CanarySeedSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(deployment.Rollout.CanarySeedSelector)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed parsing canary seed selector: %w", err)
+		}
+		canarySeedSelector = selector
+	}
+
+	installations, err := r.getInstallations(ctx, controllerRegistration.Name, controllerDeployment, canarySeedSelector)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var (
+		status = &gardencorev1beta1.ControllerRegistrationRolloutStatus{
+			DeploymentName: controllerDeployment.Name,
+			Seeds:          int32(len(installations)),
+		}
+
+		outdatedCanaries, outdated []*installation
+		canariesAvailable          = true
+		unavailable                int
+	)
+
+	for _, inst := range installations {
+		if !inst.updated {
+			if inst.canary {
+				outdatedCanaries = append(outdatedCanaries, inst)
+				canariesAvailable = false
+			} else {
+				outdated = append(outdated, inst)
+			}
+			continue
+		}
+
+		status.UpdatedSeeds++
+		if inst.failed {
+			status.FailedSeeds = append(status.FailedSeeds, inst.seedName)
+		}
+		if inst.available {
+			status.AvailableSeeds++
+			continue
+		}
+
+		if inst.canary {
+			canariesAvailable = false
+		} else {
+			unavailable++
+		}
+	}
+
+	var toUpdate []*installation
+	switch {
+	case len(status.FailedSeeds) > 0:
+		log.Info("Rollout is paused because the controller failed on updated seeds", "controllerDeploymentName", controllerDeployment.Name, "failedSeeds", status.FailedSeeds)
+	case len(outdatedCanaries) > 0:
+		toUpdate = outdatedCanaries
+	case canariesAvailable:
+		for i := 0; i < int(pointer.Int32Deref(deployment.Rollout.MaxUnavailable, 1))-unavailable && i < len(outdated); i++ {
+			toUpdate = append(toUpdate, outdated[i])
+		}
+	}
+
+	for _, inst := range toUpdate {
+		if err := r.rollOut(ctx, log, inst, controllerDeployment); err != nil {
+			return reconcile.Result{}, err
+		}
+		status.UpdatedSeeds++
+	}
+
+	switch {
+	case len(status.FailedSeeds) > 0:
+		status.Phase = gardencorev1beta1.ControllerRegistrationRolloutPaused
+	case status.AvailableSeeds == status.Seeds:
+		status.Phase = gardencorev1beta1.ControllerRegistrationRolloutCompleted
+	default:
+		status.Phase = gardencorev1beta1.ControllerRegistrationRolloutProgressing
+	}
+
+	return reconcile.Result{}, r.updateStatus(ctx, controllerRegistration, status)
+}
+
+type installation struct {
+	obj       *gardencorev1beta1.ControllerInstallation
+	seedName  string
+	canary    bool
+	updated   bool
+	available bool
+	failed    bool
+}
+
+// getInstallations returns the ControllerInstallations of the given ControllerRegistration sorted by the names of their
+// seeds. For each of them, it determines whether its seed is a canary and whether it was already updated to the given
+// ControllerDeployment. An installation is only considered as updated if it references the current version of the
+// ControllerDeployment, i.e., in-place changes of the ControllerDeployment are rolled out progressively as well.
+func (r *Reconciler) getInstallations(ctx context.Context, controllerRegistrationName string, controllerDeployment *gardencorev1beta1.ControllerDeployment, canarySeedSelector labels.Selector) ([]*installation, error) {
+	controllerInstallationList := &gardencorev1beta1.ControllerInstallationList{}
+	if err := r.Client.List(ctx, controllerInstallationList, client.MatchingFields{core.RegistrationRefName: controllerRegistrationName}); err != nil {
+		return nil, err
+	}
+
+	var installations []*installation
+	for i := range controllerInstallationList.Items {
+		controllerInstallation := &controllerInstallationList.Items[i]
+		if controllerInstallation.DeletionTimestamp != nil {
+			continue
+		}
+
+		seed := &gardencorev1beta1.Seed{}
+		if err := r.Client.Get(ctx, kubernetesutils.Key(controllerInstallation.Spec.SeedRef.Name), seed); client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+
+		inst := &installation{
+			obj:      controllerInstallation,
+			seedName: controllerInstallation.Spec.SeedRef.Name,
+			canary:   canarySeedSelector.Matches(labels.Set(seed.Labels)),
+			updated:  isUpdated(controllerInstallation, controllerDeployment),
+		}
+		if inst.updated {
+			inst.available = isAvailable(controllerInstallation)
+			inst.failed = isFailed(controllerInstallation)
+		}
+
+		installations = append(installations, inst)
+	}
+
+	sort.Slice(installations, func(i, j int) bool {
+		return installations[i].seedName < installations[j].seedName
+	})
+
+	return installations, nil
+}
+
+// rollOut switches the given ControllerInstallation to the given ControllerDeployment. Its Installed and Healthy
+// conditions are reset so that they do not reflect the previously deployed controller anymore.
+func (r *Reconciler) rollOut(ctx context.Context, log logr.Logger, inst *installation, controllerDeployment *gardencorev1beta1.ControllerDeployment) error {
+	log.Info("Rolling out ControllerDeployment to seed", "controllerDeploymentName", controllerDeployment.Name, "resourceVersion", controllerDeployment.ResourceVersion, "seedName", inst.seedName, "canary", inst.canary, "controllerInstallationName", inst.obj.Name)
+
+	patch := client.MergeFrom(inst.obj.DeepCopy())
+	inst.obj.Spec.DeploymentRef = &corev1.ObjectReference{
+		Name:            controllerDeployment.Name,
+		ResourceVersion: controllerDeployment.ResourceVersion,
+	}
+	if err := r.Client.Patch(ctx, inst.obj, patch); err != nil {
+		return fmt.Errorf("failed rolling out ControllerDeployment %s to ControllerInstallation %s: %w", controllerDeployment.Name, inst.obj.Name, err)
+	}
+
+	msg := fmt.Sprintf("ControllerDeployment %q is being rolled out.", controllerDeployment.Name)
+
+	statusPatch := client.StrategicMergeFrom(inst.obj.DeepCopy())
+	inst.obj.Status.Conditions = v1beta1helper.MergeConditions(inst.obj.Status.Conditions,
+		v1beta1helper.UpdatedConditionWithClock(r.Clock, v1beta1helper.GetOrInitConditionWithClock(r.Clock, inst.obj.Status.Conditions, gardencorev1beta1.ControllerInstallationInstalled), gardencorev1beta1.ConditionUnknown, "RolloutPending", msg),
+		v1beta1helper.UpdatedConditionWithClock(r.Clock, v1beta1helper.GetOrInitConditionWithClock(r.Clock, inst.obj.Status.Conditions, gardencorev1beta1.ControllerInstallationHealthy), gardencorev1beta1.ConditionUnknown, "RolloutPending", msg),
+	)
+	return r.Client.Status().Patch(ctx, inst.obj, statusPatch)
+}
+
+func (r *Reconciler) updateStatus(ctx context.Context, controllerRegistration *gardencorev1beta1.ControllerRegistration, status *gardencorev1beta1.ControllerRegistrationRolloutStatus) error {
+	if oldStatus := controllerRegistration.Status.Rollout; oldStatus != nil {
+		status.LastUpdateTime = oldStatus.LastUpdateTime
+		if apiequality.Semantic.DeepEqual(oldStatus, status) {
+			return nil
+		}
+	}
+	status.LastUpdateTime = &metav1.Time{Time: r.Clock.Now()}
+
+	patch := client.MergeFrom(controllerRegistration.DeepCopy())
+	controllerRegistration.Status.Rollout = status
+	return r.Client.Status().Patch(ctx, controllerRegistration, patch)
+}
+
+// isUpdated returns true if the ControllerInstallation references the current version of the given ControllerDeployment.
+func isUpdated(controllerInstallation *gardencorev1beta1.ControllerInstallation, controllerDeployment *gardencorev1beta1.ControllerDeployment) bool {
+	deploymentRef := controllerInstallation.Spec.DeploymentRef
+	return deploymentRef != nil && deploymentRef.Name == controllerDeployment.Name && deploymentRef.ResourceVersion == controllerDeployment.ResourceVersion
+}
+
+// isAvailable returns true if the controller is installed and healthy.
+func isAvailable(controllerInstallation *gardencorev1beta1.ControllerInstallation) bool {
+	for _, conditionType := range []gardencorev1beta1.ConditionType{gardencorev1beta1.ControllerInstallationInstalled, gardencorev1beta1.ControllerInstallationHealthy} {
+		condition := v1beta1helper.GetCondition(controllerInstallation.Status.Conditions, conditionType)
+		if condition == nil || condition.Status != gardencorev1beta1.ConditionTrue {
+			return false
+		}
+	}
+	return true
+}
+
+// isFailed returns true if the controller is invalid, failed to be installed or is unhealthy. A pending installation is
+// not considered as failure.
+func isFailed(controllerInstallation *gardencorev1beta1.ControllerInstallation) bool {
+	for _, conditionType := range []gardencorev1beta1.ConditionType{gardencorev1beta1.ControllerInstallationValid, gardencorev1beta1.ControllerInstallationInstalled, gardencorev1beta1.ControllerInstallationHealthy} {
+		condition := v1beta1helper.GetCondition(controllerInstallation.Status.Conditions, conditionType)
+		if condition == nil || condition.Status != gardencorev1beta1.ConditionFalse {
+			continue
+		}
+		if conditionType == gardencorev1beta1.ControllerInstallationInstalled && condition.Reason == reasonInstallationPending {
+			continue
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,275 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration/rollout"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		oldDeploymentName = "deployment-v1"
+		newDeploymentName = "deployment-v2"
+	)
+
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		controllerRegistration *gardencorev1beta1.ControllerRegistration
+		controllerDeployment   *gardencorev1beta1.ControllerDeployment
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.ControllerRegistration{}, &gardencorev1beta1.ControllerInstallation{}).
+			WithIndex(&gardencorev1beta1.ControllerInstallation{}, core.RegistrationRefName, indexer.ControllerInstallationRegistrationRefNameIndexerFunc).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock}
+
+		controllerDeployment = &gardencorev1beta1.ControllerDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: newDeploymentName},
+			Type:       "helm",
+		}
+		Expect(fakeClient.Create(ctx, controllerDeployment)).To(Succeed())
+
+		controllerRegistration = &gardencorev1beta1.ControllerRegistration{
+			ObjectMeta: metav1.ObjectMeta{Name: "registration"},
+			Spec: gardencorev1beta1.ControllerRegistrationSpec{
+				Deployment: &gardencorev1beta1.ControllerRegistrationDeployment{
+					DeploymentRefs: []gardencorev1beta1.DeploymentRef{{Name: newDeploymentName}},
+					Rollout: &gardencorev1beta1.ControllerRegistrationRollout{
+						CanarySeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+						MaxUnavailable:     pointer.Int32(1),
+					},
+				},
+			},
+		}
+	})
+
+	createSeed := func(name string, canary bool) {
+		seed := &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if canary {
+			seed.Labels = map[string]string{"canary": "true"}
+		}
+		ExpectWithOffset(1, fakeClient.Create(ctx, seed)).To(Succeed())
+	}
+
+	createInstallation := func(seedName, deploymentName string, installed, healthy gardencorev1beta1.ConditionStatus) {
+		deploymentRef := &corev1.ObjectReference{Name: deploymentName}
+		if deploymentName == newDeploymentName {
+			deploymentRef.ResourceVersion = controllerDeployment.ResourceVersion
+		}
+
+		controllerInstallation := &gardencorev1beta1.ControllerInstallation{
+			ObjectMeta: metav1.ObjectMeta{Name: "installation-" + seedName},
+			Spec: gardencorev1beta1.ControllerInstallationSpec{
+				RegistrationRef: corev1.ObjectReference{Name: controllerRegistration.Name},
+				DeploymentRef:   deploymentRef,
+				SeedRef:         corev1.ObjectReference{Name: seedName},
+			},
+		}
+		ExpectWithOffset(1, fakeClient.Create(ctx, controllerInstallation)).To(Succeed())
+
+		controllerInstallation.Status.Conditions = []gardencorev1beta1.Condition{
+			{Type: gardencorev1beta1.ControllerInstallationValid, Status: gardencorev1beta1.ConditionTrue},
+			{Type: gardencorev1beta1.ControllerInstallationInstalled, Status: installed},
+			{Type: gardencorev1beta1.ControllerInstallationHealthy, Status: healthy},
+		}
+		ExpectWithOffset(1, fakeClient.Status().Update(ctx, controllerInstallation)).To(Succeed())
+	}
+
+	reconcileAndGetRegistration := func() *gardencorev1beta1.ControllerRegistration {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(controllerRegistration)})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(controllerRegistration), controllerRegistration)).To(Succeed())
+		return controllerRegistration
+	}
+
+	expectDeployment := func(seedName, deploymentName string) {
+		controllerInstallation := &gardencorev1beta1.ControllerInstallation{}
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKey{Name: "installation-" + seedName}, controllerInstallation)).To(Succeed())
+		ExpectWithOffset(1, controllerInstallation.Spec.DeploymentRef.Name).To(Equal(deploymentName))
+		if deploymentName == newDeploymentName {
+			ExpectWithOffset(1, controllerInstallation.Spec.DeploymentRef.ResourceVersion).To(Equal(controllerDeployment.ResourceVersion))
+		}
+	}
+
+	It("should do nothing if the ControllerRegistration is gone", func() {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(controllerRegistration)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+	})
+
+	It("should remove the rollout status if no rollout is configured", func() {
+		controllerRegistration.Spec.Deployment.Rollout = nil
+		Expect(fakeClient.Create(ctx, controllerRegistration)).To(Succeed())
+		controllerRegistration.Status.Rollout = &gardencorev1beta1.ControllerRegistrationRolloutStatus{DeploymentName: oldDeploymentName}
+		Expect(fakeClient.Status().Update(ctx, controllerRegistration)).To(Succeed())
+
+		Expect(reconcileAndGetRegistration().Status.Rollout).To(BeNil())
+	})
+
+	Context("with rollout configuration", func() {
+		BeforeEach(func() {
+			Expect(fakeClient.Create(ctx, controllerRegistration)).To(Succeed())
+
+			createSeed("canary", true)
+			createSeed("seed-a", false)
+			createSeed("seed-b", false)
+			createSeed("seed-c", false)
+		})
+
+		It("should switch the canary seeds first", func() {
+			createInstallation("canary", oldDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+			createInstallation("seed-a", oldDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+			createInstallation("seed-b", oldDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+
+			Expect(reconcileAndGetRegistration().Status.Rollout).To(Equal(&gardencorev1beta1.ControllerRegistrationRolloutStatus{
+				DeploymentName: newDeploymentName,
+				Phase:          gardencorev1beta1.ControllerRegistrationRolloutProgressing,
+				Seeds:          3,
+				UpdatedSeeds:   1,
+				LastUpdateTime: &metav1.Time{Time: fakeClock.Now()},
+			}))
+
+			expectDeployment("canary", newDeploymentName)
+			expectDeployment("seed-a", oldDeploymentName)
+			expectDeployment("seed-b", oldDeploymentName)
+
+			controllerInstallation := &gardencorev1beta1.ControllerInstallation{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "installation-canary"}, controllerInstallation)).To(Succeed())
+			Expect(v1beta1helper.GetCondition(controllerInstallation.Status.Conditions, gardencorev1beta1.ControllerInstallationInstalled).Status).To(Equal(gardencorev1beta1.ConditionUnknown))
+			Expect(v1beta1helper.GetCondition(controllerInstallation.Status.Conditions, gardencorev1beta1.ControllerInstallationHealthy).Status).To(Equal(gardencorev1beta1.ConditionUnknown))
+		})
+
+		It("should not switch further seeds while the canaries are not available", func() {
+			createInstallation("canary", newDeploymentName, gardencorev1beta1.ConditionFalse, gardencorev1beta1.ConditionUnknown)
+			createInstallation("seed-a", oldDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+
+			controllerInstallation := &gardencorev1beta1.ControllerInstallation{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "installation-canary"}, controllerInstallation)).To(Succeed())
+			controllerInstallation.Status.Conditions[1].Reason = "InstallationPending"
+			Expect(fakeClient.Status().Update(ctx, controllerInstallation)).To(Succeed())
+
+			Expect(reconcileAndGetRegistration().Status.Rollout.Phase).To(Equal(gardencorev1beta1.ControllerRegistrationRolloutProgressing))
+			expectDeployment("seed-a", oldDeploymentName)
+		})
+
+		It("should switch at most maxUnavailable seeds once the canaries are available", func() {
+			createInstallation("canary", newDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+			createInstallation("seed-a", oldDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+			createInstallation("seed-b", oldDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+			createInstallation("seed-c", oldDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+			controllerRegistration.Spec.Deployment.Rollout.MaxUnavailable = pointer.Int32(2)
+			Expect(fakeClient.Update(ctx, controllerRegistration)).To(Succeed())
+
+			rollout := reconcileAndGetRegistration().Status.Rollout
+			Expect(rollout.UpdatedSeeds).To(Equal(int32(3)))
+			Expect(rollout.AvailableSeeds).To(Equal(int32(1)))
+
+			expectDeployment("seed-a", newDeploymentName)
+			expectDeployment("seed-b", newDeploymentName)
+			expectDeployment("seed-c", oldDeploymentName)
+		})
+
+		It("should take unavailable updated seeds into account", func() {
+			createInstallation("canary", newDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+			createInstallation("seed-a", newDeploymentName, gardencorev1beta1.ConditionUnknown, gardencorev1beta1.ConditionUnknown)
+			createInstallation("seed-b", oldDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+
+			Expect(reconcileAndGetRegistration().Status.Rollout.UpdatedSeeds).To(Equal(int32(2)))
+			expectDeployment("seed-b", oldDeploymentName)
+		})
+
+		It("should pause the rollout if the controller failed on an updated seed", func() {
+			createInstallation("canary", newDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionFalse)
+			createInstallation("seed-a", oldDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+			createInstallation("seed-b", oldDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+			controllerRegistration.Spec.Deployment.Rollout.CanarySeedSelector = nil
+			Expect(fakeClient.Update(ctx, controllerRegistration)).To(Succeed())
+
+			rollout := reconcileAndGetRegistration().Status.Rollout
+			Expect(rollout.Phase).To(Equal(gardencorev1beta1.ControllerRegistrationRolloutPaused))
+			Expect(rollout.FailedSeeds).To(ConsistOf("canary"))
+
+			expectDeployment("seed-a", oldDeploymentName)
+			expectDeployment("seed-b", oldDeploymentName)
+		})
+
+		It("should roll out in-place changes of the ControllerDeployment progressively", func() {
+			createInstallation("canary", newDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+			createInstallation("seed-a", newDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+			createInstallation("seed-b", newDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+
+			oldResourceVersion := controllerDeployment.ResourceVersion
+			controllerDeployment.Type = "other"
+			Expect(fakeClient.Update(ctx, controllerDeployment)).To(Succeed())
+			Expect(controllerDeployment.ResourceVersion).NotTo(Equal(oldResourceVersion))
+
+			rollout := reconcileAndGetRegistration().Status.Rollout
+			Expect(rollout.Phase).To(Equal(gardencorev1beta1.ControllerRegistrationRolloutProgressing))
+			Expect(rollout.UpdatedSeeds).To(Equal(int32(1)))
+
+			expectDeployment("canary", newDeploymentName)
+
+			controllerInstallation := &gardencorev1beta1.ControllerInstallation{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "installation-seed-a"}, controllerInstallation)).To(Succeed())
+			Expect(controllerInstallation.Spec.DeploymentRef.ResourceVersion).To(Equal(oldResourceVersion))
+		})
+
+		It("should report the rollout as completed and not touch the status afterwards", func() {
+			createInstallation("canary", newDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+			createInstallation("seed-a", newDeploymentName, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionTrue)
+
+			Expect(reconcileAndGetRegistration().Status.Rollout).To(Equal(&gardencorev1beta1.ControllerRegistrationRolloutStatus{
+				DeploymentName: newDeploymentName,
+				Phase:          gardencorev1beta1.ControllerRegistrationRolloutCompleted,
+				Seeds:          2,
+				UpdatedSeeds:   2,
+				AvailableSeeds: 2,
+				LastUpdateTime: &metav1.Time{Time: fakeClock.Now()},
+			}))
+
+			lastUpdateTime := fakeClock.Now()
+			fakeClock.Step(time.Minute)
+			Expect(reconcileAndGetRegistration().Status.Rollout.LastUpdateTime.Time).To(Equal(lastUpdateTime))
+		})
+	})
+})
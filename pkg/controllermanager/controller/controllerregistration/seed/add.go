@@ -64,7 +64,7 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 	if err := c.Watch(
 		source.Kind(mgr.GetCache(), &gardencorev1beta1.ControllerRegistration{}),
 		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapToAllSeeds), mapper.UpdateWithNew, c.GetLogger()),
-		predicateutils.ForEventTypes(predicateutils.Create, predicateutils.Update),
+		r.ControllerRegistrationPredicate(),
 	); err != nil {
 		return err
 	}
@@ -131,6 +131,32 @@ func (r *Reconciler) SeedPredicate() predicate.Predicate {
 	}
 }
 
+// ControllerRegistrationPredicate returns true for all ControllerRegistration 'create' events. For updates, it only
+// returns true when the generation, annotations or labels have changed, i.e., it ignores updates of the status. For
+// other events, false is returned.
+func (r *Reconciler) ControllerRegistrationPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			controllerRegistration, ok := e.ObjectNew.(*gardencorev1beta1.ControllerRegistration)
+			if !ok {
+				return false
+			}
+
+			oldControllerRegistration, ok := e.ObjectOld.(*gardencorev1beta1.ControllerRegistration)
+			if !ok {
+				return false
+			}
+
+			return oldControllerRegistration.Generation != controllerRegistration.Generation ||
+				!apiequality.Semantic.DeepEqual(oldControllerRegistration.Annotations, controllerRegistration.Annotations) ||
+				!apiequality.Semantic.DeepEqual(oldControllerRegistration.Labels, controllerRegistration.Labels)
+		},
+		DeleteFunc:  func(e event.DeleteEvent) bool { return false },
+		GenericFunc: func(e event.GenericEvent) bool { return false },
+	}
+}
+
 // BackupBucketPredicate returns true for all BackupBucket events when there is a non-nil .spec.seedName. For updates,
 // it only returns true when there is a change in the .spec.seedName or .spec.provider.type fields.
 func (r *Reconciler) BackupBucketPredicate() predicate.Predicate {
@@ -232,6 +258,7 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 			return !apiequality.Semantic.DeepEqual(oldShoot.Spec.SeedName, shoot.Spec.SeedName) ||
 				!apiequality.Semantic.DeepEqual(oldShoot.Spec.Provider.Workers, shoot.Spec.Provider.Workers) ||
 				!apiequality.Semantic.DeepEqual(oldShoot.Spec.Extensions, shoot.Spec.Extensions) ||
+				!apiequality.Semantic.DeepEqual(oldShoot.Spec.Hooks, shoot.Spec.Hooks) ||
 				!apiequality.Semantic.DeepEqual(oldShoot.Spec.DNS, shoot.Spec.DNS) ||
 				shootNetworkingTypeHasChanged(oldShoot.Spec.Networking, shoot.Spec.Networking) ||
 				oldShoot.Spec.Provider.Type != shoot.Spec.Provider.Type
@@ -115,6 +115,76 @@ var _ = Describe("Add", func() {
 		})
 	})
 
+	Describe("ControllerRegistrationPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ControllerRegistrationPredicate()
+		})
+
+		Describe("#Create", func() {
+			It("should return true", func() {
+				Expect(p.Create(event.CreateEvent{})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			var controllerRegistration *gardencorev1beta1.ControllerRegistration
+
+			BeforeEach(func() {
+				controllerRegistration = &gardencorev1beta1.ControllerRegistration{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+			})
+
+			It("should return false because new object is not a ControllerRegistration", func() {
+				Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+			})
+
+			It("should return false because old object is not a ControllerRegistration", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectNew: controllerRegistration})).To(BeFalse())
+			})
+
+			It("should return false because only the status has changed", func() {
+				oldControllerRegistration := controllerRegistration.DeepCopy()
+				controllerRegistration.Status.Rollout = &gardencorev1beta1.ControllerRegistrationRolloutStatus{DeploymentName: "foo"}
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: controllerRegistration, ObjectOld: oldControllerRegistration})).To(BeFalse())
+			})
+
+			It("should return true because generation has changed", func() {
+				oldControllerRegistration := controllerRegistration.DeepCopy()
+				controllerRegistration.Generation++
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: controllerRegistration, ObjectOld: oldControllerRegistration})).To(BeTrue())
+			})
+
+			It("should return true because annotations have changed", func() {
+				oldControllerRegistration := controllerRegistration.DeepCopy()
+				metav1.SetMetaDataAnnotation(&controllerRegistration.ObjectMeta, "foo", "bar")
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: controllerRegistration, ObjectOld: oldControllerRegistration})).To(BeTrue())
+			})
+
+			It("should return true because labels have changed", func() {
+				oldControllerRegistration := controllerRegistration.DeepCopy()
+				metav1.SetMetaDataLabel(&controllerRegistration.ObjectMeta, "foo", "bar")
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: controllerRegistration, ObjectOld: oldControllerRegistration})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{})).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("BackupBucketPredicate", func() {
 		var (
 			p            predicate.Predicate
@@ -304,6 +374,12 @@ var _ = Describe("Add", func() {
 				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
 			})
 
+			It("should return true because hooks changed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Hooks = []gardencorev1beta1.Hook{{}}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
 			It("should return true because DNS changed", func() {
 				oldShoot := shoot.DeepCopy()
 				shoot.Spec.DNS = &gardencorev1beta1.DNS{}
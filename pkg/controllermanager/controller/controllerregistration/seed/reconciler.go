@@ -427,7 +427,29 @@ func deployNeededInstallations(
 			return fmt.Errorf("cannot deploy new ControllerInstallation for %q because the deletion of the old ControllerInstallation is still pending", registrationName)
 		}
 
-		if err := deployNeededInstallation(ctx, c, seed, controllerDeployment, controllerRegistration, existingControllerInstallation); err != nil {
+		// If the ControllerRegistration is rolled out progressively, existing installations keep the ControllerDeployment
+		// (and the version of it) they currently reference. Switching them to the new ControllerDeployment or to a
+		// version changed in-place is up to the rollout reconciler.
+		var pinnedDeploymentRef *corev1.ObjectReference
+		if controllerDeployment != nil && controllerRegistration.Spec.Deployment.Rollout != nil &&
+			existingControllerInstallation != nil && existingControllerInstallation.Spec.DeploymentRef != nil {
+			if currentDeploymentRef := existingControllerInstallation.Spec.DeploymentRef; currentDeploymentRef.Name != controllerDeployment.Name {
+				currentControllerDeployment := &gardencorev1beta1.ControllerDeployment{}
+				if err := c.Get(ctx, kubernetesutils.Key(currentDeploymentRef.Name), currentControllerDeployment); client.IgnoreNotFound(err) != nil {
+					return fmt.Errorf("cannot deploy ControllerInstallation because the currently referenced ControllerDeployment cannot be retrieved: %w", err)
+				} else if err == nil {
+					pinnedDeploymentRef = currentDeploymentRef.DeepCopy()
+				}
+			} else if currentDeploymentRef.ResourceVersion != controllerDeployment.ResourceVersion {
+				pinnedDeploymentRef = currentDeploymentRef.DeepCopy()
+			}
+		}
+
+		if pinnedDeploymentRef != nil {
+			registrationLog.Info("Keeping currently referenced ControllerDeployment until it is rolled out", "controllerDeploymentName", pinnedDeploymentRef.Name, "resourceVersion", pinnedDeploymentRef.ResourceVersion)
+		}
+
+		if err := deployNeededInstallation(ctx, c, seed, controllerDeployment, pinnedDeploymentRef, controllerRegistration, existingControllerInstallation); err != nil {
 			return err
 		}
 	}
@@ -440,6 +462,7 @@ func deployNeededInstallation(
 	c client.Client,
 	seed *gardencorev1beta1.Seed,
 	controllerDeployment *gardencorev1beta1.ControllerDeployment,
+	pinnedDeploymentRef *corev1.ObjectReference,
 	controllerRegistration *gardencorev1beta1.ControllerRegistration,
 	existingControllerInstallation *gardencorev1beta1.ControllerInstallation,
 ) error {
@@ -454,7 +477,9 @@ func deployNeededInstallation(
 		},
 	}
 
-	if controllerDeployment != nil {
+	if pinnedDeploymentRef != nil {
+		installationSpec.DeploymentRef = pinnedDeploymentRef
+	} else if controllerDeployment != nil {
 		installationSpec.DeploymentRef = &corev1.ObjectReference{
 			Name:            controllerDeployment.Name,
 			ResourceVersion: controllerDeployment.ResourceVersion,
@@ -477,7 +502,8 @@ func deployNeededInstallation(
 		registrationSpecHash := utils.HashForMap(registrationSpecMap)[:16]
 		metav1.SetMetaDataLabel(&controllerInstallation.ObjectMeta, RegistrationSpecHash, registrationSpecHash)
 
-		if controllerDeployment != nil {
+		// The hash of a pinned ControllerDeployment is kept until the new version is rolled out.
+		if controllerDeployment != nil && pinnedDeploymentRef == nil {
 			// Add all fields that are relevant for the hash calculation as `ControllerDeployment`s don't have a `spec` field.
 			hashFields := map[string]interface{}{
 				"type":           controllerDeployment.Type,
@@ -24,8 +24,10 @@ import (
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -949,6 +951,105 @@ var _ = Describe("Reconciler", func() {
 
 				Expect(err).NotTo(HaveOccurred())
 			})
+			Context("progressive rollout", func() {
+				var (
+					newControllerDeployment *gardencorev1beta1.ControllerDeployment
+					registration2           *gardencorev1beta1.ControllerRegistration
+					registrations           map[string]controllerRegistration
+				)
+
+				BeforeEach(func() {
+					newControllerDeployment = &gardencorev1beta1.ControllerDeployment{
+						ObjectMeta:     metav1.ObjectMeta{Name: "newDeployment"},
+						Type:           "helm",
+						ProviderConfig: runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+					}
+
+					registration2 = controllerRegistration2.DeepCopy()
+					registration2.Spec.Deployment.DeploymentRefs = []gardencorev1beta1.DeploymentRef{{Name: newControllerDeployment.Name}}
+					registration2.Spec.Deployment.Rollout = &gardencorev1beta1.ControllerRegistrationRollout{MaxUnavailable: pointer.Int32(1)}
+					registrations = map[string]controllerRegistration{registration2.Name: {obj: registration2}}
+
+					k8sClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key(newControllerDeployment.Name), gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerDeployment{})).DoAndReturn(
+						func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.ControllerDeployment, _ ...client.GetOption) error {
+							*obj = *newControllerDeployment
+							return nil
+						},
+					).AnyTimes()
+				})
+
+				It("should keep the currently referenced controller deployment for existing controller installations", func() {
+					installation2 := controllerInstallation2.DeepCopy()
+					installation2.Labels = map[string]string{ControllerDeploymentHash: "d37bba62f222c81b"}
+
+					k8sClient.EXPECT().Get(ctx, kubernetesutils.Key(installation2.Name), gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerInstallation{})).DoAndReturn(
+						func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.ControllerInstallation, _ ...client.GetOption) error {
+							*obj = *installation2.DeepCopy()
+							return nil
+						},
+					)
+					k8sClient.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerInstallation{}), gomock.Any()).DoAndReturn(
+						func(_ context.Context, obj *gardencorev1beta1.ControllerInstallation, _ client.Patch, _ ...client.PatchOption) error {
+							Expect(obj.Spec.DeploymentRef).To(Equal(installation2.Spec.DeploymentRef))
+							Expect(obj.Labels).To(HaveKeyWithValue(ControllerDeploymentHash, "d37bba62f222c81b"))
+							return nil
+						},
+					)
+
+					Expect(deployNeededInstallations(ctx, nopLogger, k8sClient, seed, sets.New(registration2.Name), registrations, map[string]*gardencorev1beta1.ControllerInstallation{
+						registration2.Name: installation2,
+					})).To(Succeed())
+				})
+
+				It("should keep the currently referenced version of a controller deployment which was changed in-place", func() {
+					newControllerDeployment.ResourceVersion = "2"
+					installation2 := controllerInstallation2.DeepCopy()
+					installation2.Spec.DeploymentRef = &corev1.ObjectReference{Name: newControllerDeployment.Name, ResourceVersion: "1"}
+
+					k8sClient.EXPECT().Get(ctx, kubernetesutils.Key(installation2.Name), gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerInstallation{}))
+					k8sClient.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerInstallation{}), gomock.Any()).DoAndReturn(
+						func(_ context.Context, obj *gardencorev1beta1.ControllerInstallation, _ client.Patch, _ ...client.PatchOption) error {
+							Expect(obj.Spec.DeploymentRef).To(Equal(&corev1.ObjectReference{Name: newControllerDeployment.Name, ResourceVersion: "1"}))
+							return nil
+						},
+					)
+
+					Expect(deployNeededInstallations(ctx, nopLogger, k8sClient, seed, sets.New(registration2.Name), registrations, map[string]*gardencorev1beta1.ControllerInstallation{
+						registration2.Name: installation2,
+					})).To(Succeed())
+				})
+
+				It("should reference the new controller deployment for new controller installations", func() {
+					k8sClient.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerInstallation{})).DoAndReturn(
+						func(_ context.Context, obj *gardencorev1beta1.ControllerInstallation, _ ...client.CreateOption) error {
+							Expect(obj.Spec.DeploymentRef.Name).To(Equal(newControllerDeployment.Name))
+							return nil
+						},
+					)
+
+					Expect(deployNeededInstallations(ctx, nopLogger, k8sClient, seed, sets.New(registration2.Name), registrations, map[string]*gardencorev1beta1.ControllerInstallation{
+						registration2.Name: nil,
+					})).To(Succeed())
+				})
+
+				It("should reference the new controller deployment if the currently referenced one is gone", func() {
+					installation2 := controllerInstallation2.DeepCopy()
+					installation2.Spec.DeploymentRef.Name = "goneDeployment"
+
+					k8sClient.EXPECT().Get(gomock.Any(), kubernetesutils.Key("goneDeployment"), gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerDeployment{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "goneDeployment"))
+					k8sClient.EXPECT().Get(ctx, kubernetesutils.Key(installation2.Name), gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerInstallation{}))
+					k8sClient.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerInstallation{}), gomock.Any()).DoAndReturn(
+						func(_ context.Context, obj *gardencorev1beta1.ControllerInstallation, _ client.Patch, _ ...client.PatchOption) error {
+							Expect(obj.Spec.DeploymentRef.Name).To(Equal(newControllerDeployment.Name))
+							return nil
+						},
+					)
+
+					Expect(deployNeededInstallations(ctx, nopLogger, k8sClient, seed, sets.New(registration2.Name), registrations, map[string]*gardencorev1beta1.ControllerInstallation{
+						registration2.Name: installation2,
+					})).To(Succeed())
+				})
+			})
 		})
 
 		Describe("#deleteUnneededInstallations", func() {
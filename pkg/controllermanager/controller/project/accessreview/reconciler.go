@@ -0,0 +1,186 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accessreview
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles Projects and periodically reviews the access of their members. Members who have not used the
+// project for longer than the configured inactivity threshold are flagged for removal.
+type Reconciler struct {
+	Client client.Client
+	Config config.ProjectControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile reconciles Projects and periodically reviews the access of their members.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.DeletionTimestamp != nil || project.Spec.Namespace == nil {
+		return reconcile.Result{}, nil
+	}
+
+	// Do not review the access again before the sync period has passed, e.g., after a restart of the controller.
+	if review := project.Status.AccessReview; review != nil {
+		if nextReview := review.LastReviewTimestamp.Add(r.Config.AccessReviewSyncPeriod.Duration); r.Clock.Now().Before(nextReview) {
+			return reconcile.Result{RequeueAfter: nextReview.Sub(r.Clock.Now())}, nil
+		}
+	}
+
+	if err := r.reviewAccess(ctx, log, project); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.AccessReviewSyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) reviewAccess(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project) error {
+	lastActivities, err := r.lastActivitiesOfUsers(ctx, *project.Spec.Namespace)
+	if err != nil {
+		return err
+	}
+
+	var (
+		now                 = metav1.NewTime(r.Clock.Now())
+		inactivityThreshold = time.Hour * 24 * time.Duration(*r.Config.MemberInactivityThresholdDays)
+		review              = &gardencorev1beta1.ProjectAccessReview{LastReviewTimestamp: now}
+	)
+
+	for _, member := range project.Spec.Members {
+		// The activity of groups cannot be attributed to the group, hence, they are not reviewed.
+		if member.Kind == rbacv1.GroupKind {
+			continue
+		}
+
+		memberReview := gardencorev1beta1.ProjectMemberAccessReview{
+			Subject:              member.Subject,
+			FirstReviewTimestamp: now,
+		}
+
+		if previous := previousReview(project.Status.AccessReview, member.Subject); previous != nil {
+			memberReview.FirstReviewTimestamp = previous.FirstReviewTimestamp
+		}
+
+		// Members are not flagged before they had the chance to use the project for the full inactivity threshold.
+		lastSeen := memberReview.FirstReviewTimestamp.Time
+		if lastActivity, ok := lastActivities[username(member.Subject)]; ok {
+			memberReview.LastActivityTimestamp = &metav1.Time{Time: lastActivity}
+			if lastActivity.After(lastSeen) {
+				lastSeen = lastActivity
+			}
+		}
+
+		if now.Sub(lastSeen) > inactivityThreshold {
+			log.Info("Flagging inactive member for removal", "member", member.Name, "kind", member.Kind, "lastSeen", lastSeen)
+			memberReview.FlaggedForRemoval = true
+		}
+
+		review.Members = append(review.Members, memberReview)
+	}
+
+	patch := client.MergeFrom(project.DeepCopy())
+	project.Status.AccessReview = review
+	return r.Client.Status().Patch(ctx, project, patch)
+}
+
+// lastActivitiesOfUsers determines the last activity of the users in the given project namespace. Similar to the
+// activity of the project, the creation of Shoots and Bastions is considered. These resources are annotated with the
+// name of the user who created them.
+func (r *Reconciler) lastActivitiesOfUsers(ctx context.Context, namespace string) (map[string]time.Time, error) {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing Shoots: %w", err)
+	}
+
+	bastionList := &operationsv1alpha1.BastionList{}
+	if err := r.Client.List(ctx, bastionList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing Bastions: %w", err)
+	}
+
+	objects := make([]metav1.ObjectMeta, 0, len(shootList.Items)+len(bastionList.Items))
+	for _, shoot := range shootList.Items {
+		objects = append(objects, shoot.ObjectMeta)
+	}
+	for _, bastion := range bastionList.Items {
+		objects = append(objects, bastion.ObjectMeta)
+	}
+
+	lastActivities := make(map[string]time.Time)
+	for _, obj := range objects {
+		user, ok := obj.Annotations[v1beta1constants.GardenCreatedBy]
+		if !ok {
+			continue
+		}
+
+		if lastActivity, ok := lastActivities[user]; !ok || obj.CreationTimestamp.After(lastActivity) {
+			lastActivities[user] = obj.CreationTimestamp.Time
+		}
+	}
+
+	return lastActivities, nil
+}
+
+func previousReview(review *gardencorev1beta1.ProjectAccessReview, subject rbacv1.Subject) *gardencorev1beta1.ProjectMemberAccessReview {
+	if review == nil {
+		return nil
+	}
+
+	for _, memberReview := range review.Members {
+		if memberReview.Subject == subject {
+			return &memberReview
+		}
+	}
+
+	return nil
+}
+
+func username(subject rbacv1.Subject) string {
+	if subject.Kind == rbacv1.ServiceAccountKind {
+		return serviceaccount.MakeUsername(subject.Namespace, subject.Name)
+	}
+	return subject.Name
+}
@@ -0,0 +1,180 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accessreview_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/accessreview"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+		request    reconcile.Request
+
+		namespace = "garden-foo"
+		day       = 24 * time.Hour
+		project   *gardencorev1beta1.Project
+
+		alice = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "alice"}
+		bob   = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "bob"}
+		robot = rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "robot", Namespace: namespace}
+		group = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "team"}
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Project{}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC))
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Clock:  fakeClock,
+			Config: config.ProjectControllerConfiguration{
+				MemberInactivityThresholdDays: pointer.Int(90),
+				AccessReviewSyncPeriod:        &metav1.Duration{Duration: day},
+			},
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Namespace: &namespace,
+				Members: []gardencorev1beta1.ProjectMember{
+					{Subject: alice, Role: "admin"},
+					{Subject: bob, Role: "viewer"},
+					{Subject: robot, Role: "admin"},
+					{Subject: group, Role: "viewer"},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)}
+
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+	})
+
+	createObject := func(obj client.Object, createdBy string, creationTime time.Time) {
+		obj.SetNamespace(namespace)
+		obj.SetAnnotations(map[string]string{"gardener.cloud/created-by": createdBy})
+		obj.SetCreationTimestamp(metav1.Time{Time: creationTime})
+		Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+	}
+
+	It("should do nothing if the project namespace is not yet set", func() {
+		project.Spec.Namespace = nil
+		Expect(fakeClient.Update(ctx, project)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, project)).To(Succeed())
+		Expect(project.Status.AccessReview).To(BeNil())
+	})
+
+	It("should review all members except groups and not flag them on the first review", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: day}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, project)).To(Succeed())
+		Expect(project.Status.AccessReview).NotTo(BeNil())
+		Expect(project.Status.AccessReview.LastReviewTimestamp.Time).To(BeTemporally("==", fakeClock.Now()))
+		Expect(project.Status.AccessReview.Members).To(HaveExactElements(
+			And(HaveField("Subject", alice), HaveField("FlaggedForRemoval", false)),
+			And(HaveField("Subject", bob), HaveField("FlaggedForRemoval", false)),
+			And(HaveField("Subject", robot), HaveField("FlaggedForRemoval", false)),
+		))
+	})
+
+	It("should not review the access again before the sync period has passed", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: day}))
+
+		fakeClock.Step(time.Hour)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: day - time.Hour}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, project)).To(Succeed())
+		Expect(project.Status.AccessReview.LastReviewTimestamp.Time).To(BeTemporally("==", fakeClock.Now().Add(-time.Hour)))
+	})
+
+	It("should flag members who have not used the project for longer than the inactivity threshold", func() {
+		firstReview := fakeClock.Now()
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: day}))
+
+		fakeClock.Step(100 * day)
+		createObject(&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "old"}}, "alice", firstReview.Add(-day))
+		createObject(&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "new"}}, "alice", fakeClock.Now().Add(-20*day))
+		createObject(&operationsv1alpha1.Bastion{ObjectMeta: metav1.ObjectMeta{Name: "bastion"}}, "system:serviceaccount:garden-foo:robot", fakeClock.Now().Add(-95*day))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: day}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, project)).To(Succeed())
+		Expect(project.Status.AccessReview.Members).To(HaveExactElements(
+			And(
+				HaveField("Subject", alice),
+				HaveField("FirstReviewTimestamp.Time", BeTemporally("==", firstReview)),
+				HaveField("LastActivityTimestamp.Time", BeTemporally("==", fakeClock.Now().Add(-20*day))),
+				HaveField("FlaggedForRemoval", false),
+			),
+			And(
+				HaveField("Subject", bob),
+				HaveField("LastActivityTimestamp", BeNil()),
+				HaveField("FlaggedForRemoval", true),
+			),
+			And(
+				HaveField("Subject", robot),
+				HaveField("LastActivityTimestamp.Time", BeTemporally("==", fakeClock.Now().Add(-95*day))),
+				HaveField("FlaggedForRemoval", true),
+			),
+		))
+	})
+
+	It("should not flag members who were added recently", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: day}))
+
+		fakeClock.Step(100 * day)
+		Expect(fakeClient.Get(ctx, request.NamespacedName, project)).To(Succeed())
+		project.Spec.Members = append(project.Spec.Members, gardencorev1beta1.ProjectMember{
+			Subject: rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "carol"},
+			Role:    "viewer",
+		})
+		Expect(fakeClient.Update(ctx, project)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: day}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, project)).To(Succeed())
+		Expect(project.Status.AccessReview.Members).To(ContainElement(And(
+			HaveField("Subject.Name", "carol"),
+			HaveField("FirstReviewTimestamp.Time", BeTemporally("==", fakeClock.Now())),
+			HaveField("FlaggedForRemoval", false),
+		)))
+	})
+})
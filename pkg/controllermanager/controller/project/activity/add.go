@@ -70,6 +70,7 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapObjectToProject), mapper.UpdateWithNew, c.GetLogger()),
 		r.OnlyNewlyCreatedObjects(),
 		predicate.GenerationChangedPredicate{},
+		r.IgnoreStaleProjectHibernation(),
 	); err != nil {
 		return err
 	}
@@ -115,6 +116,27 @@ func (r *Reconciler) OnlyNewlyCreatedObjects() predicate.Predicate {
 	}
 }
 
+// IgnoreStaleProjectHibernation returns a predicate which returns false for updates of Shoots which were hibernated
+// by the stale project reconciler, i.e., which changed the project.gardener.cloud/stale-hibernation-timestamp annotation.
+// Such updates are no activity of the Project's members.
+func (r *Reconciler) IgnoreStaleProjectHibernation() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldObjMeta, err := meta.Accessor(e.ObjectOld)
+			if err != nil {
+				return false
+			}
+
+			objMeta, err := meta.Accessor(e.ObjectNew)
+			if err != nil {
+				return false
+			}
+
+			return oldObjMeta.GetAnnotations()[v1beta1constants.ShootStaleProjectHibernationTimestamp] == objMeta.GetAnnotations()[v1beta1constants.ShootStaleProjectHibernationTimestamp]
+		},
+	}
+}
+
 // NeedsSecretBindingReferenceLabelPredicate returns a predicate which only returns true when the objects have the
 // reference.gardener.cloud/secretbinding label.
 func (r *Reconciler) NeedsSecretBindingReferenceLabelPredicate() predicate.Predicate {
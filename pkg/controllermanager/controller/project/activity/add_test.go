@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -161,6 +162,51 @@ var _ = Describe("Add", func() {
 		})
 	})
 
+	Describe("IgnoreStaleProjectHibernation", func() {
+		var (
+			p     predicate.Predicate
+			shoot *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			p = reconciler.IgnoreStaleProjectHibernation()
+			shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-project"}}
+		})
+
+		Describe("#Update", func() {
+			It("should return true when the annotation is unchanged", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: pointer.Bool(true)}
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+			})
+
+			It("should return false when the annotation was added", func() {
+				oldShoot := shoot.DeepCopy()
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "project.gardener.cloud/stale-hibernation-timestamp", "2023-01-01T00:00:00Z")
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+			})
+
+			It("should return false when the annotation was changed", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "project.gardener.cloud/stale-hibernation-timestamp", "2023-01-01T00:00:00Z")
+				oldShoot := shoot.DeepCopy()
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "project.gardener.cloud/stale-hibernation-timestamp", "2023-02-01T00:00:00Z")
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+			})
+		})
+
+		Describe("#Create", func() {
+			It("should return true", func() {
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return true", func() {
+				Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeTrue())
+			})
+		})
+	})
+
 	Describe("#MapObjectToProject", func() {
 		var (
 			ctx        = context.TODO()
@@ -21,13 +21,21 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/accessreview"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/activity"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/project"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/stale"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/usage"
 )
 
 // AddToManager adds all Project controllers to the given manager.
 func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.ControllerManagerConfiguration) error {
+	if err := (&accessreview.Reconciler{
+		Config: *cfg.Controllers.Project,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding access review reconciler: %w", err)
+	}
+
 	if err := (&activity.Reconciler{
 		Config: *cfg.Controllers.Project,
 	}).AddToManager(ctx, mgr); err != nil {
@@ -46,5 +54,11 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 		return fmt.Errorf("failed adding stale reconciler: %w", err)
 	}
 
+	if err := (&usage.Reconciler{
+		Config: *cfg.Controllers.Project,
+	}).AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed adding usage reconciler: %w", err)
+	}
+
 	return nil
 }
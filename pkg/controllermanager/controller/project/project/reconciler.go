@@ -27,7 +27,9 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -50,6 +52,7 @@ import (
 type Reconciler struct {
 	Client   client.Client
 	Config   config.ProjectControllerConfiguration
+	Clock    clock.Clock
 	Recorder record.EventRecorder
 
 	// RateLimiter allows limiting exponential backoff for testing purposes
@@ -130,6 +133,16 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 		}
 	}
 
+	// Remove expired members and roles, this also removes their RBAC bindings below.
+	nextExpiration, err := r.removeExpiredMembers(ctx, log, project)
+	if err != nil {
+		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while removing expired members: %+v", err)
+		if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
+			log.Error(err, "Failed to update Project status")
+		}
+		return reconcile.Result{}, err
+	}
+
 	// Create ResourceQuota for project if configured.
 	quotaConfig, err := quotaConfigurationForProject(r.Config, project)
 	if err != nil {
@@ -182,9 +195,97 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 		return reconcile.Result{}, err
 	}
 
+	if nextExpiration != nil {
+		return reconcile.Result{RequeueAfter: nextExpiration.Sub(r.Clock.Now())}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
+// removeExpiredMembers removes the members and roles from the project whose expiration date has passed. It returns
+// the next time a member or role expires, if any.
+func (r *Reconciler) removeExpiredMembers(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project) (*time.Time, error) {
+	var (
+		now            = r.Clock.Now()
+		nextExpiration *time.Time
+		members        = make([]gardencorev1beta1.ProjectMember, 0, len(project.Spec.Members))
+		events         []struct{ reason, message string }
+	)
+
+	observeExpiration := func(expirationDate time.Time) bool {
+		if !now.Before(expirationDate) {
+			return true
+		}
+		if nextExpiration == nil || expirationDate.Before(*nextExpiration) {
+			nextExpiration = &expirationDate
+		}
+		return false
+	}
+
+	for _, member := range project.Spec.Members {
+		if member.ExpirationDate != nil && observeExpiration(member.ExpirationDate.Time) {
+			log.Info("Removing expired member", "member", member.Name, "kind", member.Kind)
+			events = append(events, struct{ reason, message string }{gardencorev1beta1.ProjectEventMemberExpired, fmt.Sprintf("Removed member %s %q because it expired at %s", member.Kind, member.Name, member.ExpirationDate.UTC().Format(time.RFC3339))})
+			continue
+		}
+
+		var (
+			expiredRoles    = sets.New[string]()
+			roleExpirations []gardencorev1beta1.ProjectMemberRoleExpiration
+		)
+
+		for _, roleExpiration := range member.RoleExpirations {
+			if observeExpiration(roleExpiration.ExpirationDate.Time) {
+				expiredRoles.Insert(roleExpiration.Role)
+				continue
+			}
+			roleExpirations = append(roleExpirations, roleExpiration)
+		}
+
+		if expiredRoles.Len() == 0 {
+			members = append(members, member)
+			continue
+		}
+
+		var roles []string
+		for _, role := range append([]string{member.Role}, member.Roles...) {
+			if role != "" && !expiredRoles.Has(role) {
+				roles = append(roles, role)
+			}
+		}
+
+		log.Info("Removing expired roles from member", "member", member.Name, "kind", member.Kind, "roles", sets.List(expiredRoles))
+		events = append(events, struct{ reason, message string }{gardencorev1beta1.ProjectEventMemberRoleExpired, fmt.Sprintf("Removed roles %v from member %s %q because they expired", sets.List(expiredRoles), member.Kind, member.Name)})
+
+		if len(roles) == 0 {
+			log.Info("Removing member without remaining roles", "member", member.Name, "kind", member.Kind)
+			continue
+		}
+
+		member.Role, member.Roles, member.RoleExpirations = roles[0], nil, roleExpirations
+		if len(roles) > 1 {
+			member.Roles = roles[1:]
+		}
+		members = append(members, member)
+	}
+
+	if len(events) == 0 {
+		return nextExpiration, nil
+	}
+
+	patch := client.MergeFromWithOptions(project.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	project.Spec.Members = members
+	if err := r.Client.Patch(ctx, project, patch); err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		r.Recorder.Event(project, corev1.EventTypeNormal, event.reason, event.message)
+	}
+
+	return nextExpiration, nil
+}
+
 func (r *Reconciler) reconcileNamespaceForProject(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project, ownerReference *metav1.OwnerReference) (*corev1.Namespace, error) {
 	var (
 		namespaceName = pointer.StringDeref(project.Spec.Namespace, "")
@@ -16,17 +16,25 @@ package project
 
 import (
 	"context"
+	"time"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -268,4 +276,95 @@ var _ = Describe("Default Resource Quota", func() {
 			Expect(createOrUpdateResourceQuota(ctx, c, namespace, ownerRef, config)).NotTo(Succeed())
 		})
 	})
+
+	Describe("#removeExpiredMembers", func() {
+		var (
+			fakeClient client.Client
+			fakeClock  *testclock.FakeClock
+			recorder   *record.FakeRecorder
+			reconciler *Reconciler
+			project    *gardencorev1beta1.Project
+
+			now = time.Date(2023, 11, 1, 10, 0, 0, 0, time.UTC)
+		)
+
+		member := func(name string, expirationDate *metav1.Time, roles ...string) gardencorev1beta1.ProjectMember {
+			m := gardencorev1beta1.ProjectMember{
+				Subject: rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: name},
+				Role:    roles[0],
+				Roles:   roles[1:],
+			}
+			if len(m.Roles) == 0 {
+				m.Roles = nil
+			}
+			m.ExpirationDate = expirationDate
+			return m
+		}
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			fakeClock = testclock.NewFakeClock(now)
+			recorder = record.NewFakeRecorder(10)
+			reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock, Recorder: recorder}
+
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: projectName},
+				Spec: gardencorev1beta1.ProjectSpec{
+					Members: []gardencorev1beta1.ProjectMember{
+						member("owner", nil, "owner", "admin"),
+						member("expired", &metav1.Time{Time: now.Add(-time.Minute)}, "admin"),
+						member("expiring", &metav1.Time{Time: now.Add(2 * time.Hour)}, "viewer"),
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		})
+
+		It("should do nothing if no member or role is expired", func() {
+			project.Spec.Members = project.Spec.Members[:1]
+
+			Expect(reconciler.removeExpiredMembers(ctx, logr.Discard(), project)).To(BeNil())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should remove expired members and return the next expiration", func() {
+			Expect(reconciler.removeExpiredMembers(ctx, logr.Discard(), project)).To(PointTo(Equal(now.Add(2 * time.Hour))))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Spec.Members).To(HaveExactElements(
+				HaveField("Name", "owner"),
+				HaveField("Name", "expiring"),
+			))
+			Expect(recorder.Events).To(Receive(ContainSubstring("MemberExpired Removed member User \"expired\"")))
+		})
+
+		It("should remove expired roles and members without remaining roles", func() {
+			project.Spec.Members[0].RoleExpirations = []gardencorev1beta1.ProjectMemberRoleExpiration{
+				{Role: "admin", ExpirationDate: metav1.Time{Time: now.Add(-time.Minute)}},
+			}
+			project.Spec.Members[2].ExpirationDate = nil
+			project.Spec.Members[2].RoleExpirations = []gardencorev1beta1.ProjectMemberRoleExpiration{
+				{Role: "viewer", ExpirationDate: metav1.Time{Time: now}},
+			}
+			project.Spec.Members = append(project.Spec.Members, member("multiple-roles", nil, "viewer", "admin", "uam"))
+			project.Spec.Members[3].RoleExpirations = []gardencorev1beta1.ProjectMemberRoleExpiration{
+				{Role: "viewer", ExpirationDate: metav1.Time{Time: now.Add(-time.Hour)}},
+				{Role: "admin", ExpirationDate: metav1.Time{Time: now.Add(time.Hour)}},
+			}
+
+			Expect(reconciler.removeExpiredMembers(ctx, logr.Discard(), project)).To(PointTo(Equal(now.Add(time.Hour))))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Spec.Members).To(HaveExactElements(
+				And(HaveField("Name", "owner"), HaveField("Role", "owner"), HaveField("Roles", BeEmpty()), HaveField("RoleExpirations", BeEmpty())),
+				And(HaveField("Name", "multiple-roles"), HaveField("Role", "admin"), HaveField("Roles", ConsistOf("uam")), HaveField("RoleExpirations", ConsistOf(
+					HaveField("Role", "admin"),
+				))),
+			))
+			Expect(recorder.Events).To(HaveLen(4))
+		})
+	})
 })
@@ -0,0 +1,109 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stale
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+)
+
+// notificationTimeout is the timeout for posting a notification to the configured webhook.
+const notificationTimeout = 30 * time.Second
+
+// Notification is the payload posted to the webhook configured in a stale project policy when a stale Project enters a
+// new stage.
+type Notification struct {
+	// Project is the name of the Project.
+	Project string `json:"project"`
+	// Namespace is the namespace of the Project.
+	Namespace string `json:"namespace"`
+	// Purpose is the purpose of the Project.
+	Purpose string `json:"purpose,omitempty"`
+	// Owner is the name of the owner of the Project.
+	Owner string `json:"owner,omitempty"`
+	// Stage is the stage the Project entered.
+	Stage string `json:"stage"`
+	// StaleSinceTimestamp is the time since when the Project is stale.
+	StaleSinceTimestamp metav1.Time `json:"staleSinceTimestamp"`
+	// HibernationTimestamp is the time when the Shoots of the Project are hibernated.
+	HibernationTimestamp metav1.Time `json:"hibernationTimestamp"`
+	// DeletionTimestamp is the earliest time when the Project is deleted if its owner confirmed the deletion.
+	DeletionTimestamp metav1.Time `json:"deletionTimestamp"`
+}
+
+func newNotification(project *gardencorev1beta1.Project, stage string, hibernationTime, deletionTime time.Time) Notification {
+	notification := Notification{
+		Project:              project.Name,
+		Namespace:            pointer.StringDeref(project.Spec.Namespace, ""),
+		Purpose:              pointer.StringDeref(project.Spec.Purpose, ""),
+		Stage:                stage,
+		StaleSinceTimestamp:  *project.Status.StaleSinceTimestamp,
+		HibernationTimestamp: metav1.NewTime(hibernationTime),
+		DeletionTimestamp:    metav1.NewTime(deletionTime),
+	}
+
+	if project.Spec.Owner != nil {
+		notification.Owner = project.Spec.Owner.Name
+	}
+
+	return notification
+}
+
+// sendNotification posts the given notification to the webhook in the given configuration.
+func sendNotification(ctx context.Context, conf *config.StaleProjectNotification, notification Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	httpClient := &http.Client{Timeout: notificationTimeout}
+	if len(conf.CABundle) > 0 {
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(conf.CABundle) {
+			return fmt.Errorf("failed parsing CA bundle of notification webhook")
+		}
+		httpClient.Transport = &http.Transport{TLSClientConfig: &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}}
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, conf.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("notification webhook responded with unexpected status code %d", response.StatusCode)
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,15 +36,27 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
+const (
+	// StageNotified is the stage of a stale Project which still contains Shoots after its members have been notified.
+	StageNotified = "notified"
+	// StageShootsHibernated is the stage of a stale Project whose Shoots are hibernated.
+	StageShootsHibernated = "shoots-hibernated"
+	// StageDeletionPending is the stage of a stale Project which is deleted once its owner confirmed the deletion.
+	StageDeletionPending = "deletion-pending"
+)
+
 // Reconciler reconciles Projects, marks them as stale and auto-deletes them after a certain time if not in-use.
+// Stale Projects which still contain Shoots are handled in stages according to the configured stale policies.
 type Reconciler struct {
-	Client client.Client
-	Config config.ProjectControllerConfiguration
-	Clock  clock.Clock
+	Client   client.Client
+	Config   config.ProjectControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
 }
 
 // Reconcile reconciles Projects, marks them as stale and auto-deletes them after a certain time if not in-use.
@@ -105,6 +118,8 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 		return r.markProjectAsNotStale(ctx, project)
 	}
 
+	policy := r.policyForProject(project)
+
 	for _, check := range []struct {
 		resource  string
 		checkFunc func(context.Context, string) (bool, error)
@@ -119,13 +134,23 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 			return err
 		}
 		if projectInUse {
+			// The other resources are typically in use by the Shoots as well, hence, they are not checked if a stale
+			// policy applies to the Project.
+			if check.resource == "Shoots" && policy != nil {
+				log.Info("Project was not used recently but still contains Shoots, marking Project as stale according to the stale policy")
+				if err := r.markProjectAsStale(ctx, project, policy); err != nil {
+					return err
+				}
+				return r.reconcileStaleProjectWithShoots(ctx, log, project, policy)
+			}
+
 			log.Info("Project is in use by resource, marking Project as not stale", "resource", check.resource)
 			return r.markProjectAsNotStale(ctx, project)
 		}
 	}
 
 	log.Info("Project is not in use by any resource, marking Project as stale")
-	if err := r.markProjectAsStale(ctx, project); err != nil {
+	if err := r.markProjectAsStale(ctx, project, nil); err != nil {
 		return err
 	}
 
@@ -150,6 +175,154 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 	return client.IgnoreNotFound(r.Client.Delete(ctx, project))
 }
 
+// policyForProject returns the first stale policy matching the purpose of the given Project or nil if there is none.
+func (r *Reconciler) policyForProject(project *gardencorev1beta1.Project) *config.StaleProjectPolicy {
+	for i, policy := range r.Config.StalePolicies {
+		if len(policy.Purposes) == 0 || (project.Spec.Purpose != nil && sets.New(policy.Purposes...).Has(*project.Spec.Purpose)) {
+			return &r.Config.StalePolicies[i]
+		}
+	}
+	return nil
+}
+
+// reconcileStaleProjectWithShoots handles stale Projects which still contain Shoots in stages: First, the members are
+// notified. After the hibernation grace period, all Shoots are hibernated. After the deletion grace period, the Shoots
+// and the Project are deleted, but only if the owner of the Project confirmed the deletion.
+func (r *Reconciler) reconcileStaleProjectWithShoots(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project, policy *config.StaleProjectPolicy) error {
+	var (
+		now             = r.Clock.Now().UTC()
+		staleSince      = project.Status.StaleSinceTimestamp.UTC()
+		hibernationTime = staleSince.Add(time.Hour * 24 * time.Duration(*policy.HibernationGracePeriodDays))
+		deletionTime    = staleSince.Add(time.Hour * 24 * time.Duration(*policy.DeletionGracePeriodDays))
+		stage           = StageNotified
+	)
+
+	switch {
+	case !now.Before(deletionTime):
+		stage = StageDeletionPending
+	case !now.Before(hibernationTime):
+		stage = StageShootsHibernated
+	}
+
+	log = log.WithValues("stage", stage)
+
+	if project.Annotations[v1beta1constants.ProjectStaleStage] != stage {
+		if err := r.notify(ctx, log, project, policy, stage, hibernationTime, deletionTime); err != nil {
+			return err
+		}
+
+		patch := client.MergeFrom(project.DeepCopy())
+		metav1.SetMetaDataAnnotation(&project.ObjectMeta, v1beta1constants.ProjectStaleStage, stage)
+		if err := r.Client.Patch(ctx, project, patch); err != nil {
+			return err
+		}
+	}
+
+	if stage == StageNotified {
+		log.Info("Project is stale, but its Shoots will not be hibernated now", "hibernationTime", hibernationTime)
+		return nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+		return err
+	}
+
+	if stage == StageShootsHibernated || !deletionConfirmed(project) {
+		if stage == StageDeletionPending {
+			log.Info("Project is stale, but will not be deleted before its owner confirmed the deletion", "annotation", v1beta1constants.ProjectConfirmStaleDeletion)
+		}
+		return r.hibernateShoots(ctx, log, shootList.Items)
+	}
+
+	log.Info("Deleting Shoots and Project now because the deletion grace period is exceeded and the owner confirmed the deletion")
+	if err := r.deleteShoots(ctx, shootList.Items); err != nil {
+		return err
+	}
+
+	if err := gardenerutils.ConfirmDeletion(ctx, r.Client, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Project already gone")
+			return nil
+		}
+		return err
+	}
+	return client.IgnoreNotFound(r.Client.Delete(ctx, project))
+}
+
+func (r *Reconciler) notify(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project, policy *config.StaleProjectPolicy, stage string, hibernationTime, deletionTime time.Time) error {
+	switch stage {
+	case StageNotified:
+		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventStale, "Project is stale, its Shoots will be hibernated after %s", hibernationTime.Format(time.RFC3339))
+	case StageShootsHibernated:
+		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventStaleShootsHibernated, "Project is stale, its Shoots are hibernated and will be deleted after %s if the owner confirms the deletion", deletionTime.Format(time.RFC3339))
+	case StageDeletionPending:
+		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventStaleDeletionPending, "Project is stale and will be deleted once the owner confirms the deletion with the %q annotation", v1beta1constants.ProjectConfirmStaleDeletion)
+	}
+
+	if policy.Notification == nil {
+		return nil
+	}
+
+	log.Info("Sending notification for stale Project", "url", policy.Notification.URL)
+	if err := sendNotification(ctx, policy.Notification, newNotification(project, stage, hibernationTime, deletionTime)); err != nil {
+		return fmt.Errorf("failed sending notification for stale project: %w", err)
+	}
+	return nil
+}
+
+func (r *Reconciler) hibernateShoots(ctx context.Context, log logr.Logger, shoots []gardencorev1beta1.Shoot) error {
+	var fns []flow.TaskFn
+
+	for _, s := range shoots {
+		shoot := s.DeepCopy()
+		if shoot.DeletionTimestamp != nil || (shoot.Spec.Hibernation != nil && pointer.BoolDeref(shoot.Spec.Hibernation.Enabled, false)) {
+			continue
+		}
+
+		fns = append(fns, func(ctx context.Context) error {
+			log.Info("Hibernating Shoot of stale Project", "shoot", client.ObjectKeyFromObject(shoot))
+
+			// The annotation allows the activity reconciler to ignore this update. Otherwise, the hibernation would be
+			// considered as activity in the Project which would reset its stale timeline.
+			patch := client.MergeFrom(shoot.DeepCopy())
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootStaleProjectHibernationTimestamp, r.Clock.Now().UTC().Format(time.RFC3339))
+			if shoot.Spec.Hibernation == nil {
+				shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{}
+			}
+			shoot.Spec.Hibernation.Enabled = pointer.Bool(true)
+			return client.IgnoreNotFound(r.Client.Patch(ctx, shoot, patch))
+		})
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
+
+func (r *Reconciler) deleteShoots(ctx context.Context, shoots []gardencorev1beta1.Shoot) error {
+	var fns []flow.TaskFn
+
+	for _, s := range shoots {
+		shoot := s.DeepCopy()
+		if shoot.DeletionTimestamp != nil {
+			continue
+		}
+
+		fns = append(fns, func(ctx context.Context) error {
+			if err := gardenerutils.ConfirmDeletion(ctx, r.Client, shoot); err != nil {
+				return client.IgnoreNotFound(err)
+			}
+			return client.IgnoreNotFound(r.Client.Delete(ctx, shoot))
+		})
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
+
+func deletionConfirmed(project *gardencorev1beta1.Project) bool {
+	confirmed, _ := strconv.ParseBool(project.Annotations[v1beta1constants.ProjectConfirmStaleDeletion])
+	return confirmed
+}
+
 func (r *Reconciler) projectInUseDueToShoots(ctx context.Context, namespace string) (bool, error) {
 	return kubernetesutils.ResourcesExist(ctx, r.Client, &gardencorev1beta1.ShootList{}, r.Client.Scheme(), client.InNamespace(namespace))
 }
@@ -226,20 +399,37 @@ func (r *Reconciler) relevantSecretBindingsInUse(ctx context.Context, isSecretBi
 }
 
 func (r *Reconciler) markProjectAsNotStale(ctx context.Context, project *gardencorev1beta1.Project) error {
+	// A confirmation of the deletion only applies to the stale period in which it was given, hence it is removed
+	// together with the stage.
+	if err := r.removeAnnotations(ctx, project, v1beta1constants.ProjectStaleStage, v1beta1constants.ProjectConfirmStaleDeletion); err != nil {
+		return err
+	}
+
 	patch := client.MergeFrom(project.DeepCopy())
 	project.Status.StaleSinceTimestamp = nil
 	project.Status.StaleAutoDeleteTimestamp = nil
 	return r.Client.Status().Patch(ctx, project, patch)
 }
 
-func (r *Reconciler) markProjectAsStale(ctx context.Context, project *gardencorev1beta1.Project) error {
+func (r *Reconciler) markProjectAsStale(ctx context.Context, project *gardencorev1beta1.Project, policy *config.StaleProjectPolicy) error {
+	if project.Status.StaleSinceTimestamp == nil {
+		// Only a confirmation of the deletion given after the project got stale is accepted.
+		if err := r.removeAnnotations(ctx, project, v1beta1constants.ProjectConfirmStaleDeletion); err != nil {
+			return err
+		}
+	}
+
 	patch := client.MergeFrom(project.DeepCopy())
 
 	if project.Status.StaleSinceTimestamp == nil {
 		project.Status.StaleSinceTimestamp = &metav1.Time{Time: r.Clock.Now()}
 	}
 
-	if project.Status.StaleSinceTimestamp.UTC().Add(time.Hour * 24 * time.Duration(*r.Config.StaleGracePeriodDays)).After(r.Clock.Now().UTC()) {
+	if policy != nil {
+		// Projects which still contain Shoots are deleted after the deletion grace period of the stale policy, but only
+		// if their owner confirmed the deletion.
+		project.Status.StaleAutoDeleteTimestamp = &metav1.Time{Time: project.Status.StaleSinceTimestamp.Add(time.Hour * 24 * time.Duration(*policy.DeletionGracePeriodDays))}
+	} else if project.Status.StaleSinceTimestamp.UTC().Add(time.Hour * 24 * time.Duration(*r.Config.StaleGracePeriodDays)).After(r.Clock.Now().UTC()) {
 		// We reset the potentially set auto-delete timestamp here to allow changing the StaleExpirationTimeDays
 		// configuration value and correctly applying the changes to all Projects that had already been assigned
 		// such a timestamp.
@@ -260,6 +450,23 @@ func (r *Reconciler) markProjectAsStale(ctx context.Context, project *gardencore
 	return r.Client.Status().Patch(ctx, project, patch)
 }
 
+func (r *Reconciler) removeAnnotations(ctx context.Context, project *gardencorev1beta1.Project, keys ...string) error {
+	patch := client.MergeFrom(project.DeepCopy())
+
+	var removed bool
+	for _, key := range keys {
+		if _, ok := project.Annotations[key]; ok {
+			delete(project.Annotations, key)
+			removed = true
+		}
+	}
+
+	if !removed {
+		return nil
+	}
+	return r.Client.Patch(ctx, project, patch)
+}
+
 func (r *Reconciler) secretBindingInUse(ctx context.Context, namespaceToSecretBindingNames map[string]sets.Set[string]) (bool, error) {
 	if len(namespaceToSecretBindingNames) == 0 {
 		return false, nil
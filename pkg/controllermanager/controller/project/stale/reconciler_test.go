@@ -16,28 +16,38 @@ package stale_test
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock/testing"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/activity"
 	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/stale"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 var _ = Describe("Reconciler", func() {
@@ -492,6 +502,308 @@ var _ = Describe("Reconciler", func() {
 	})
 })
 
+var _ = Describe("Reconciler with stale policies", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testing.FakeClock
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+		request    reconcile.Request
+
+		server        *httptest.Server
+		notifications []Notification
+		statusCode    int
+
+		namespaceName = "garden-foo"
+		staleSince    = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		project *gardencorev1beta1.Project
+		shoot1  *gardencorev1beta1.Shoot
+		shoot2  *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		notifications = nil
+		statusCode = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			var notification Notification
+			Expect(json.NewDecoder(r.Body).Decode(&notification)).To(Succeed())
+			notifications = append(notifications, notification)
+			w.WriteHeader(statusCode)
+		}))
+		DeferCleanup(server.Close)
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Project{}).
+			Build()
+		fakeClock = testing.NewFakeClock(staleSince)
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: config.ProjectControllerConfiguration{
+				MinimumLifetimeDays:     pointer.Int(5),
+				StaleGracePeriodDays:    pointer.Int(10),
+				StaleExpirationTimeDays: pointer.Int(15),
+				StaleSyncPeriod:         &metav1.Duration{Duration: time.Hour},
+				StalePolicies: []config.StaleProjectPolicy{
+					{
+						Purposes:                   []string{"production"},
+						HibernationGracePeriodDays: pointer.Int(60),
+						DeletionGracePeriodDays:    pointer.Int(120),
+					},
+					{
+						Purposes:                   []string{"trial"},
+						HibernationGracePeriodDays: pointer.Int(7),
+						DeletionGracePeriodDays:    pointer.Int(30),
+						Notification:               &config.StaleProjectNotification{URL: server.URL},
+					},
+				},
+			},
+			Clock:    fakeClock,
+			Recorder: recorder,
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "foo",
+				CreationTimestamp: metav1.Time{Time: staleSince.Add(-365 * 24 * time.Hour)},
+			},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Namespace: &namespaceName,
+				Purpose:   pointer.String("trial"),
+				Owner:     &rbacv1.Subject{Kind: rbacv1.UserKind, Name: "owner"},
+			},
+		}
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: project.Name}}
+		shoot1 = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot1", Namespace: namespaceName}}
+		shoot2 = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot2", Namespace: namespaceName},
+			Spec:       gardencorev1beta1.ShootSpec{Hibernation: &gardencorev1beta1.Hibernation{Enabled: pointer.Bool(true)}},
+		}
+
+		Expect(fakeClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespaceName}})).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot1)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot2)).To(Succeed())
+	})
+
+	createProject := func() {
+		status := project.Status
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		project.Status = status
+		Expect(fakeClient.Status().Update(ctx, project)).To(Succeed())
+	}
+
+	expectHibernated := func(shoot *gardencorev1beta1.Shoot, hibernated bool) {
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		ExpectWithOffset(1, shoot.Spec.Hibernation != nil && pointer.BoolDeref(shoot.Spec.Hibernation.Enabled, false)).To(Equal(hibernated))
+	}
+
+	It("should mark the project as 'not stale' if no policy matches its purpose", func() {
+		project.Spec.Purpose = pointer.String("infrastructure")
+		createProject()
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.StaleSinceTimestamp).To(BeNil())
+		Expect(project.Annotations).NotTo(HaveKey(v1beta1constants.ProjectStaleStage))
+		Expect(notifications).To(BeEmpty())
+	})
+
+	It("should remove the stage annotation if the project is not stale anymore", func() {
+		project.Annotations = map[string]string{v1beta1constants.ProjectStaleStage: StageNotified}
+		project.Status.LastActivityTimestamp = &metav1.Time{Time: staleSince}
+		createProject()
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Annotations).NotTo(HaveKey(v1beta1constants.ProjectStaleStage))
+	})
+
+	It("should remove the deletion confirmation if the project is not stale anymore", func() {
+		project.Annotations = map[string]string{
+			v1beta1constants.ProjectStaleStage:           StageDeletionPending,
+			v1beta1constants.ProjectConfirmStaleDeletion: "true",
+		}
+		project.Status.LastActivityTimestamp = &metav1.Time{Time: staleSince}
+		project.Status.StaleSinceTimestamp = &metav1.Time{Time: staleSince.Add(-60 * 24 * time.Hour)}
+		createProject()
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.StaleSinceTimestamp).To(BeNil())
+		Expect(project.Annotations).NotTo(HaveKey(v1beta1constants.ProjectStaleStage))
+		Expect(project.Annotations).NotTo(HaveKey(v1beta1constants.ProjectConfirmStaleDeletion))
+	})
+
+	It("should remove a deletion confirmation which was given before the project got stale", func() {
+		project.Annotations = map[string]string{v1beta1constants.ProjectConfirmStaleDeletion: "true"}
+		createProject()
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.StaleSinceTimestamp.Time).To(BeTemporally("==", staleSince))
+		Expect(project.Annotations).NotTo(HaveKey(v1beta1constants.ProjectConfirmStaleDeletion))
+		Expect(project.Annotations).To(HaveKeyWithValue(v1beta1constants.ProjectStaleStage, StageNotified))
+	})
+
+	It("should mark the project as stale and notify about it", func() {
+		createProject()
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.StaleSinceTimestamp.Time).To(BeTemporally("==", staleSince))
+		Expect(project.Status.StaleAutoDeleteTimestamp.Time).To(BeTemporally("==", staleSince.Add(30*24*time.Hour)))
+		Expect(project.Annotations).To(HaveKeyWithValue(v1beta1constants.ProjectStaleStage, StageNotified))
+		Expect(recorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.ProjectEventStale)))
+
+		Expect(notifications).To(HaveLen(1))
+		Expect(notifications[0].Project).To(Equal(project.Name))
+		Expect(notifications[0].Owner).To(Equal("owner"))
+		Expect(notifications[0].Stage).To(Equal(StageNotified))
+		Expect(notifications[0].HibernationTimestamp.Time).To(BeTemporally("==", staleSince.Add(7*24*time.Hour)))
+
+		expectHibernated(shoot1, false)
+
+		By("not notifying again in the same stage")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(notifications).To(HaveLen(1))
+	})
+
+	It("should not proceed to the next stage if the notification fails", func() {
+		statusCode = http.StatusInternalServerError
+		createProject()
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("unexpected status code 500")))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Annotations).NotTo(HaveKey(v1beta1constants.ProjectStaleStage))
+	})
+
+	It("should not send notifications if none are configured for the policy", func() {
+		project.Spec.Purpose = pointer.String("production")
+		createProject()
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Annotations).To(HaveKeyWithValue(v1beta1constants.ProjectStaleStage, StageNotified))
+		Expect(notifications).To(BeEmpty())
+	})
+
+	Context("project stale for longer than the hibernation grace period", func() {
+		BeforeEach(func() {
+			project.Annotations = map[string]string{v1beta1constants.ProjectStaleStage: StageNotified}
+			project.Status.StaleSinceTimestamp = &metav1.Time{Time: staleSince}
+			fakeClock.Step(10 * 24 * time.Hour)
+		})
+
+		It("should hibernate the shoots", func() {
+			createProject()
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Annotations).To(HaveKeyWithValue(v1beta1constants.ProjectStaleStage, StageShootsHibernated))
+			Expect(recorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.ProjectEventStaleShootsHibernated)))
+			Expect(notifications).To(ConsistOf(HaveField("Stage", StageShootsHibernated)))
+
+			expectHibernated(shoot1, true)
+			expectHibernated(shoot2, true)
+			Expect(shoot1.Annotations).To(HaveKeyWithValue(v1beta1constants.ShootStaleProjectHibernationTimestamp, fakeClock.Now().UTC().Format(time.RFC3339)))
+			Expect(shoot2.Annotations).NotTo(HaveKey(v1beta1constants.ShootStaleProjectHibernationTimestamp))
+		})
+
+		It("should not consider the hibernation as activity in the project", func() {
+			createProject()
+
+			oldShoot1 := shoot1.DeepCopy()
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+			expectHibernated(shoot1, true)
+			// The fake client does not increase the generation on spec changes.
+			shoot1.Generation = oldShoot1.Generation + 1
+
+			activityReconciler := &activity.Reconciler{Client: fakeClient, Clock: fakeClock}
+			updateEvent := event.UpdateEvent{ObjectOld: oldShoot1, ObjectNew: shoot1}
+			Expect(predicate.GenerationChangedPredicate{}.Update(updateEvent)).To(BeTrue())
+			Expect(activityReconciler.IgnoreStaleProjectHibernation().Update(updateEvent)).To(BeFalse())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Status.LastActivityTimestamp).To(BeNil())
+
+			By("staying in the same stage in the next reconciliation")
+			fakeClock.Step(time.Hour)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Status.StaleSinceTimestamp.Time).To(BeTemporally("==", staleSince))
+			Expect(project.Annotations).To(HaveKeyWithValue(v1beta1constants.ProjectStaleStage, StageShootsHibernated))
+
+			By("considering the wake-up by the owner as activity in the project")
+			oldShoot1 = shoot1.DeepCopy()
+			shoot1.Spec.Hibernation.Enabled = pointer.Bool(false)
+			Expect(fakeClient.Update(ctx, shoot1)).To(Succeed())
+			shoot1.Generation = oldShoot1.Generation + 1
+
+			updateEvent = event.UpdateEvent{ObjectOld: oldShoot1, ObjectNew: shoot1}
+			Expect(predicate.GenerationChangedPredicate{}.Update(updateEvent)).To(BeTrue())
+			Expect(activityReconciler.IgnoreStaleProjectHibernation().Update(updateEvent)).To(BeTrue())
+			Expect(activityReconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Status.StaleSinceTimestamp).To(BeNil())
+			Expect(project.Annotations).NotTo(HaveKey(v1beta1constants.ProjectStaleStage))
+		})
+	})
+
+	Context("project stale for longer than the deletion grace period", func() {
+		BeforeEach(func() {
+			project.Annotations = map[string]string{v1beta1constants.ProjectStaleStage: StageShootsHibernated}
+			project.Status.StaleSinceTimestamp = &metav1.Time{Time: staleSince}
+			fakeClock.Step(30 * 24 * time.Hour)
+		})
+
+		It("should not delete the project if the owner did not confirm the deletion", func() {
+			createProject()
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.DeletionTimestamp).To(BeNil())
+			Expect(project.Annotations).To(HaveKeyWithValue(v1beta1constants.ProjectStaleStage, StageDeletionPending))
+			Expect(recorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.ProjectEventStaleDeletionPending)))
+			Expect(notifications).To(ConsistOf(HaveField("Stage", StageDeletionPending)))
+
+			expectHibernated(shoot1, true)
+		})
+
+		It("should delete the shoots and the project if the owner confirmed the deletion", func() {
+			project.Annotations[v1beta1constants.ProjectStaleStage] = StageDeletionPending
+			project.Annotations[v1beta1constants.ProjectConfirmStaleDeletion] = "true"
+			createProject()
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot1), shoot1)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot2), shoot2)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(BeNotFoundError())
+			Expect(notifications).To(BeEmpty())
+		})
+	})
+})
+
 func expectNonStaleMarking(k8sGardenRuntimeClient *mockclient.MockClient, mockStatusWriter *mockclient.MockStatusWriter, project *gardencorev1beta1.Project) {
 	k8sGardenRuntimeClient.EXPECT().Status().Return(mockStatusWriter)
 
@@ -0,0 +1,123 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/usage"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+	})
+
+	Describe("#ShootPredicate", func() {
+		var (
+			p     predicate.Predicate
+			shoot *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+			shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+		})
+
+		It("should return true for create and delete events", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for updates without changes of generation or hibernation status", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: shoot.DeepCopy()})).To(BeFalse())
+		})
+
+		It("should return true if the generation changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Generation++
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+
+		It("should return true if the hibernation status changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Status.IsHibernated = true
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapShootToProject", func() {
+		var (
+			ctx        = context.TODO()
+			log        logr.Logger
+			fakeClient client.Client
+
+			project *gardencorev1beta1.Project
+			shoot   *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			log = logr.Discard()
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				Build()
+
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "project",
+				},
+			}
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: "garden-project",
+				},
+			}
+		})
+
+		It("should do nothing if no related Project can be found", func() {
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.MapShootToProject(ctx, log, fakeClient, shoot)).To(BeEmpty())
+		})
+
+		It("should map the Shoot to the Project", func() {
+			project.Spec.Namespace = &shoot.Namespace
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.MapShootToProject(ctx, log, fakeClient, shoot)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: project.Name}},
+			))
+		})
+	})
+})
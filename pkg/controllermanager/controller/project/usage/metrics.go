@@ -0,0 +1,65 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "gardener_controller_manager"
+
+	// boundLower is the value of the bound label for metrics which are based on the minimum number of nodes of each
+	// worker pool.
+	boundLower = "lower"
+	// boundUpper is the value of the bound label for metrics which are based on the maximum number of nodes of each
+	// worker pool.
+	boundUpper = "upper"
+)
+
+var (
+	// Usage defines the gauge project_usage which reports the resources currently consumed by all Shoots of a project.
+	// As the actual number of nodes is not known, the bound label distinguishes between the lower bound (based on the
+	// minimum number of nodes) and the upper bound (based on the maximum number of nodes).
+	Usage = promauto.With(runtimemetrics.Registry).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "project_usage",
+			Help:      "Resources currently consumed by all Shoots of a project (lower or upper bound).",
+		},
+		[]string{
+			"project",
+			"resource",
+			"bound",
+		},
+	)
+
+	// UsageHours defines the gauge project_usage_hours which reports the resources consumed by all Shoots of a project
+	// accumulated over time in hours. Like for Usage, the bound label distinguishes between the lower and upper bound.
+	UsageHours = promauto.With(runtimemetrics.Registry).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "project_usage_hours",
+			Help:      "Resources consumed by all Shoots of a project accumulated over time in hours (lower or upper bound).",
+		},
+		[]string{
+			"project",
+			"resource",
+			"bound",
+		},
+	)
+)
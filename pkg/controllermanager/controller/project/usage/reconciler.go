@@ -0,0 +1,295 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles Projects and updates the resource usage of their Shoots in the status. Additionally, it
+// periodically accumulates the resources consumed over time and exports them as metrics.
+type Reconciler struct {
+	Client client.Client
+	Config config.ProjectControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile reconciles Projects and updates the resource usage of their Shoots in the status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			Usage.DeletePartialMatch(prometheus.Labels{"project": request.Name})
+			UsageHours.DeletePartialMatch(prometheus.Labels{"project": request.Name})
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.Spec.Namespace == nil {
+		log.V(1).Info("Project namespace is not yet set, nothing to be done")
+		return reconcile.Result{}, nil
+	}
+
+	usage, rates, maximumRates, err := r.computeUsage(ctx, log, *project.Spec.Namespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed computing resource usage: %w", err)
+	}
+
+	var (
+		now              = r.Clock.Now()
+		accumulatedUsage = project.Status.AccumulatedUsage
+		requeueAfter     = r.Config.UsageSyncPeriod.Duration
+	)
+
+	// Do not accumulate the usage again before the sync period has passed unless the rates have changed, e.g., when
+	// this reconciliation was triggered by a changed Shoot.
+	if accumulatedUsage != nil &&
+		apiequality.Semantic.DeepEqual(accumulatedUsage.Rates, rates) &&
+		apiequality.Semantic.DeepEqual(accumulatedUsage.MaximumRates, maximumRates) {
+		if nextUpdate := accumulatedUsage.LastUpdateTimestamp.Add(r.Config.UsageSyncPeriod.Duration); now.Before(nextUpdate) {
+			requeueAfter = nextUpdate.Sub(now)
+		} else {
+			accumulatedUsage = accumulateUsage(accumulatedUsage, rates, maximumRates, now)
+		}
+	} else {
+		accumulatedUsage = accumulateUsage(accumulatedUsage, rates, maximumRates, now)
+	}
+
+	reportMetrics(project.Name, accumulatedUsage)
+
+	if apiequality.Semantic.DeepEqual(project.Status.Usage, usage) && apiequality.Semantic.DeepEqual(project.Status.AccumulatedUsage, accumulatedUsage) {
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	log.Info("Updating Project's resource usage", "usage", usage, "rates", accumulatedUsage.Rates, "maximumRates", accumulatedUsage.MaximumRates)
+
+	patch := client.MergeFrom(project.DeepCopy())
+	project.Status.Usage = usage
+	project.Status.AccumulatedUsage = accumulatedUsage
+	if err := r.Client.Status().Patch(ctx, project, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating Project's resource usage: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// computeUsage sums up the resources of all Shoots in the given namespace. Like for the quota checks during admission,
+// the maximum number of nodes of each worker pool is considered for the usage. The returned rates are used for
+// accumulating the consumed resources over time. As the actual number of nodes is not known, the rates consider the
+// minimum number of nodes of each worker pool, which are always running (lower bound), while the maximum rates consider
+// the maximum number of nodes (upper bound). Additionally, the worker nodes and load balancers of hibernated Shoots are
+// not considered for both rates but the Shoots are counted as hibernated.
+func (r *Reconciler) computeUsage(ctx context.Context, log logr.Logger, namespace string) (corev1.ResourceList, corev1.ResourceList, corev1.ResourceList, error) {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(namespace)); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	var (
+		usage         = newResourceList()
+		rates         = newResourceList()
+		maximumRates  = newResourceList()
+		cloudProfiles = make(map[string]*gardencorev1beta1.CloudProfile)
+	)
+
+	for _, list := range []corev1.ResourceList{rates, maximumRates} {
+		list[gardencorev1beta1.ProjectUsageMetricHibernatedShoots] = *resource.NewQuantity(0, resource.DecimalSI)
+	}
+
+	for _, shoot := range shootList.Items {
+		var (
+			shootUsage = newResourceList()
+			shootRates = newResourceList()
+		)
+
+		for _, list := range []corev1.ResourceList{shootUsage, shootRates} {
+			addQuantity(list, gardencorev1beta1.ProjectQuotaMetricShoots, resource.MustParse("1"))
+
+			addQuantity(list, gardencorev1beta1.QuotaMetricLoadbalancer, resource.MustParse("1"))
+			if v1beta1helper.NginxIngressEnabled(shoot.Spec.Addons) {
+				addQuantity(list, gardencorev1beta1.QuotaMetricLoadbalancer, resource.MustParse("1"))
+			}
+		}
+
+		if err := r.addWorkerUsage(ctx, log, &shoot, cloudProfiles, shootUsage, shootRates); err != nil {
+			return nil, nil, nil, err
+		}
+
+		addResourceList(usage, shootUsage)
+
+		if shoot.Status.IsHibernated {
+			for _, list := range []corev1.ResourceList{rates, maximumRates} {
+				addQuantity(list, gardencorev1beta1.ProjectQuotaMetricShoots, resource.MustParse("1"))
+				addQuantity(list, gardencorev1beta1.ProjectUsageMetricHibernatedShoots, resource.MustParse("1"))
+			}
+			continue
+		}
+		addResourceList(rates, shootRates)
+		// The usage of a Shoot considers the maximum number of nodes, hence it equals its maximum rates.
+		addResourceList(maximumRates, shootUsage)
+	}
+
+	return usage, rates, maximumRates, nil
+}
+
+// addWorkerUsage adds the resources of the maximum number of nodes of the Shoot's worker pools to the given usage and
+// the resources of the minimum number of nodes to the given rates.
+func (r *Reconciler) addWorkerUsage(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfiles map[string]*gardencorev1beta1.CloudProfile, usage, rates corev1.ResourceList) error {
+	if len(shoot.Spec.Provider.Workers) == 0 {
+		return nil
+	}
+
+	cloudProfile, ok := cloudProfiles[shoot.Spec.CloudProfileName]
+	if !ok {
+		cloudProfile = &gardencorev1beta1.CloudProfile{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: shoot.Spec.CloudProfileName}, cloudProfile); err != nil {
+			return fmt.Errorf("failed reading cloud profile %q of shoot %q: %w", shoot.Spec.CloudProfileName, client.ObjectKeyFromObject(shoot), err)
+		}
+		cloudProfiles[shoot.Spec.CloudProfileName] = cloudProfile
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		machineType := v1beta1helper.FindMachineTypeByName(cloudProfile.Spec.MachineTypes, worker.Machine.Type)
+		if machineType == nil {
+			log.Info("Machine type not found in cloud profile, skipping worker pool for usage computation", "shoot", client.ObjectKeyFromObject(shoot), "worker", worker.Name, "machineType", worker.Machine.Type)
+			continue
+		}
+
+		addMachines(usage, machineType, worker.Maximum)
+		addMachines(rates, machineType, worker.Minimum)
+	}
+
+	return nil
+}
+
+// accumulateUsage adds the resources consumed with the previous rates and maximum rates since the last update to the
+// accumulated hours and maximum hours, and returns the accumulated usage with the given rates and maximum rates.
+func accumulateUsage(previous *gardencorev1beta1.ProjectAccumulatedUsage, rates, maximumRates corev1.ResourceList, now time.Time) *gardencorev1beta1.ProjectAccumulatedUsage {
+	if previous == nil {
+		previous = &gardencorev1beta1.ProjectAccumulatedUsage{
+			Since:               metav1.NewTime(now),
+			LastUpdateTimestamp: metav1.NewTime(now),
+		}
+	}
+
+	elapsedHours := now.Sub(previous.LastUpdateTimestamp.Time).Hours()
+
+	return &gardencorev1beta1.ProjectAccumulatedUsage{
+		Since:               previous.Since,
+		LastUpdateTimestamp: metav1.NewTime(now),
+		Rates:               rates,
+		Hours:               accumulateHours(previous.Hours, previous.Rates, rates, elapsedHours),
+		MaximumRates:        maximumRates,
+		MaximumHours:        accumulateHours(previous.MaximumHours, previous.MaximumRates, maximumRates, elapsedHours),
+	}
+}
+
+// accumulateHours adds the resources consumed with the previous rates in the elapsed hours to the previous hours. All
+// resources of the given rates are contained in the result.
+func accumulateHours(previousHours, previousRates, rates corev1.ResourceList, elapsedHours float64) corev1.ResourceList {
+	accumulated := corev1.ResourceList{}
+
+	for name := range rates {
+		accumulated[name] = *resource.NewQuantity(0, rates[name].Format)
+	}
+	for name, hours := range previousHours {
+		accumulated[name] = hours.DeepCopy()
+	}
+
+	if elapsedHours <= 0 {
+		return accumulated
+	}
+
+	for name, rate := range previousRates {
+		hours := accumulated[name]
+		value := hours.AsApproximateFloat64() + rate.AsApproximateFloat64()*elapsedHours
+		if rate.Format == resource.BinarySI {
+			accumulated[name] = *resource.NewQuantity(int64(value), resource.BinarySI)
+		} else {
+			accumulated[name] = *resource.NewMilliQuantity(int64(value*1000), resource.DecimalSI)
+		}
+	}
+
+	return accumulated
+}
+
+func reportMetrics(projectName string, accumulatedUsage *gardencorev1beta1.ProjectAccumulatedUsage) {
+	for bound, rates := range map[string]corev1.ResourceList{boundLower: accumulatedUsage.Rates, boundUpper: accumulatedUsage.MaximumRates} {
+		for name, rate := range rates {
+			Usage.WithLabelValues(projectName, string(name), bound).Set(rate.AsApproximateFloat64())
+		}
+	}
+	for bound, hours := range map[string]corev1.ResourceList{boundLower: accumulatedUsage.Hours, boundUpper: accumulatedUsage.MaximumHours} {
+		for name, value := range hours {
+			UsageHours.WithLabelValues(projectName, string(name), bound).Set(value.AsApproximateFloat64())
+		}
+	}
+}
+
+func newResourceList() corev1.ResourceList {
+	return corev1.ResourceList{
+		gardencorev1beta1.ProjectQuotaMetricShoots: *resource.NewQuantity(0, resource.DecimalSI),
+		gardencorev1beta1.QuotaMetricCPU:           *resource.NewQuantity(0, resource.DecimalSI),
+		gardencorev1beta1.QuotaMetricGPU:           *resource.NewQuantity(0, resource.DecimalSI),
+		gardencorev1beta1.QuotaMetricMemory:        *resource.NewQuantity(0, resource.BinarySI),
+		gardencorev1beta1.QuotaMetricLoadbalancer:  *resource.NewQuantity(0, resource.DecimalSI),
+	}
+}
+
+func addMachines(list corev1.ResourceList, machineType *gardencorev1beta1.MachineType, count int32) {
+	for i := int32(0); i < count; i++ {
+		addQuantity(list, gardencorev1beta1.QuotaMetricCPU, machineType.CPU)
+		addQuantity(list, gardencorev1beta1.QuotaMetricGPU, machineType.GPU)
+		addQuantity(list, gardencorev1beta1.QuotaMetricMemory, machineType.Memory)
+	}
+}
+
+func addQuantity(list corev1.ResourceList, name corev1.ResourceName, quantity resource.Quantity) {
+	total := list[name]
+	total.Add(quantity)
+	list[name] = total
+}
+
+func addResourceList(list, other corev1.ResourceList) {
+	for name, quantity := range other {
+		addQuantity(list, name, quantity)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/usage"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		syncPeriod = time.Hour
+
+		namespace    = "garden-foo"
+		project      *gardencorev1beta1.Project
+		cloudProfile *gardencorev1beta1.CloudProfile
+		shoot1       *gardencorev1beta1.Shoot
+		shoot2       *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Project{}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC))
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: config.ProjectControllerConfiguration{UsageSyncPeriod: &metav1.Duration{Duration: syncPeriod}},
+			Clock:  fakeClock,
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: &namespace},
+		}
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{
+					{Name: "small", CPU: resource.MustParse("2"), GPU: resource.MustParse("0"), Memory: resource.MustParse("4Gi")},
+					{Name: "gpu", CPU: resource.MustParse("8"), GPU: resource.MustParse("1"), Memory: resource.MustParse("32Gi")},
+				},
+			},
+		}
+		shoot1 = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot1", Namespace: namespace},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: cloudProfile.Name,
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{
+						{Name: "worker1", Machine: gardencorev1beta1.Machine{Type: "small"}, Minimum: 1, Maximum: 3},
+						{Name: "worker2", Machine: gardencorev1beta1.Machine{Type: "gpu"}, Minimum: 1, Maximum: 2},
+					},
+				},
+				Addons: &gardencorev1beta1.Addons{
+					NginxIngress: &gardencorev1beta1.NginxIngress{Addon: gardencorev1beta1.Addon{Enabled: true}},
+				},
+			},
+		}
+		shoot2 = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot2", Namespace: namespace},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: cloudProfile.Name,
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{
+						{Name: "worker1", Machine: gardencorev1beta1.Machine{Type: "small"}, Maximum: 1},
+						{Name: "worker2", Machine: gardencorev1beta1.Machine{Type: "unknown"}, Maximum: 5},
+					},
+				},
+			},
+		}
+
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+	})
+
+	It("should do nothing if the project is gone", func() {
+		Expect(fakeClient.Delete(ctx, project)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the project namespace is not yet set", func() {
+		project.Spec.Namespace = nil
+		Expect(fakeClient.Update(ctx, project)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.Usage).To(BeNil())
+	})
+
+	It("should report zero usage if there are no shoots", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		expectUsage(project.Status.Usage, "0", "0", "0", "0", "0")
+	})
+
+	It("should sum up the resources of all shoots in the project", func() {
+		Expect(fakeClient.Create(ctx, shoot1)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot2)).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot3", Namespace: "other"}})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		expectUsage(project.Status.Usage, "2", "24", "2", "80Gi", "3")
+	})
+
+	It("should update the usage if a shoot was removed", func() {
+		project.Status.Usage = corev1.ResourceList{"shoots": resource.MustParse("2")}
+		Expect(fakeClient.Status().Update(ctx, project)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot2)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		expectUsage(project.Status.Usage, "1", "2", "0", "4Gi", "1")
+	})
+
+	It("should fail if the cloud profile of a shoot does not exist", func() {
+		shoot1.Spec.CloudProfileName = "does-not-exist"
+		Expect(fakeClient.Create(ctx, shoot1)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})
+		Expect(err).To(MatchError(ContainSubstring("failed reading cloud profile")))
+	})
+
+	It("should not count workers of workerless shoots", func() {
+		shoot1.Spec.Provider.Workers = nil
+		shoot1.Spec.CloudProfileName = "does-not-exist"
+		shoot1.Spec.Addons = nil
+		Expect(fakeClient.Create(ctx, shoot1)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		expectUsage(project.Status.Usage, "1", "0", "0", "0", "1")
+	})
+
+	Context("accumulated usage", func() {
+		var (
+			start   metav1.Time
+			request reconcile.Request
+		)
+
+		BeforeEach(func() {
+			start = metav1.NewTime(fakeClock.Now())
+			request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)}
+
+			shoot2.Status.IsHibernated = true
+			Expect(fakeClient.Create(ctx, shoot1)).To(Succeed())
+			Expect(fakeClient.Create(ctx, shoot2)).To(Succeed())
+		})
+
+		It("should start accumulating with the minimum and maximum number of nodes and not consider the resources of hibernated shoots", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			expectUsage(project.Status.Usage, "2", "24", "2", "80Gi", "3")
+
+			accumulatedUsage := project.Status.AccumulatedUsage
+			Expect(accumulatedUsage).NotTo(BeNil())
+			Expect(accumulatedUsage.Since.Time).To(BeTemporally("==", start.Time))
+			Expect(accumulatedUsage.LastUpdateTimestamp.Time).To(BeTemporally("==", start.Time))
+			expectAccumulatedUsage(accumulatedUsage.Rates, "2", "10", "1", "36Gi", "2", "1")
+			expectAccumulatedUsage(accumulatedUsage.Hours, "0", "0", "0", "0", "0", "0")
+			expectAccumulatedUsage(accumulatedUsage.MaximumRates, "2", "22", "2", "76Gi", "2", "1")
+			expectAccumulatedUsage(accumulatedUsage.MaximumHours, "0", "0", "0", "0", "0", "0")
+		})
+
+		It("should not accumulate again before the sync period has passed", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			fakeClock.Step(10 * time.Minute)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 50 * time.Minute}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Status.AccumulatedUsage.LastUpdateTimestamp.Time).To(BeTemporally("==", start.Time))
+			expectAccumulatedUsage(project.Status.AccumulatedUsage.Hours, "0", "0", "0", "0", "0", "0")
+		})
+
+		It("should accumulate the usage after the sync period has passed", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			fakeClock.Step(2 * time.Hour)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Status.AccumulatedUsage.Since.Time).To(BeTemporally("==", start.Time))
+			Expect(project.Status.AccumulatedUsage.LastUpdateTimestamp.Time).To(BeTemporally("==", fakeClock.Now()))
+			expectAccumulatedUsage(project.Status.AccumulatedUsage.Hours, "4", "20", "2", "72Gi", "4", "2")
+			expectAccumulatedUsage(project.Status.AccumulatedUsage.MaximumHours, "4", "44", "4", "152Gi", "4", "2")
+		})
+
+		It("should report the lower and upper bound as metrics", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			fakeClock.Step(2 * time.Hour)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(testutil.ToFloat64(Usage.WithLabelValues(project.Name, "cpu", "lower"))).To(Equal(float64(10)))
+			Expect(testutil.ToFloat64(Usage.WithLabelValues(project.Name, "cpu", "upper"))).To(Equal(float64(22)))
+			Expect(testutil.ToFloat64(UsageHours.WithLabelValues(project.Name, "cpu", "lower"))).To(Equal(float64(20)))
+			Expect(testutil.ToFloat64(UsageHours.WithLabelValues(project.Name, "cpu", "upper"))).To(Equal(float64(44)))
+		})
+
+		It("should accumulate the usage with the previous rates when the rates change", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			fakeClock.Step(30 * time.Minute)
+			shoot1.Status.IsHibernated = true
+			Expect(fakeClient.Update(ctx, shoot1)).To(Succeed())
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			expectAccumulatedUsage(project.Status.AccumulatedUsage.Rates, "2", "0", "0", "0", "0", "2")
+			expectAccumulatedUsage(project.Status.AccumulatedUsage.Hours, "1", "5", "500m", "18Gi", "1", "500m")
+			expectAccumulatedUsage(project.Status.AccumulatedUsage.MaximumRates, "2", "0", "0", "0", "0", "2")
+			expectAccumulatedUsage(project.Status.AccumulatedUsage.MaximumHours, "1", "11", "1", "38Gi", "1", "500m")
+		})
+	})
+})
+
+func expectUsage(usage corev1.ResourceList, shoots, cpu, gpu, memory, loadBalancers string) {
+	ExpectWithOffset(1, usage).To(HaveLen(5))
+	for name, expected := range map[corev1.ResourceName]string{
+		"shoots":       shoots,
+		"cpu":          cpu,
+		"gpu":          gpu,
+		"memory":       memory,
+		"loadbalancer": loadBalancers,
+	} {
+		actual := usage[name]
+		ExpectWithOffset(1, actual.Cmp(resource.MustParse(expected))).To(BeZero(), "usage of %s is %s, expected %s", name, actual.String(), expected)
+	}
+}
+
+func expectAccumulatedUsage(usage corev1.ResourceList, shoots, cpu, gpu, memory, loadBalancers, hibernatedShoots string) {
+	ExpectWithOffset(1, usage).To(HaveLen(6))
+	for name, expected := range map[corev1.ResourceName]string{
+		"shoots":            shoots,
+		"cpu":               cpu,
+		"gpu":               gpu,
+		"memory":            memory,
+		"loadbalancer":      loadBalancers,
+		"hibernated-shoots": hibernatedShoots,
+	} {
+		actual := usage[name]
+		ExpectWithOffset(1, actual.Cmp(resource.MustParse(expected))).To(BeZero(), "usage of %s is %s, expected %s", name, actual.String(), expected)
+	}
+}
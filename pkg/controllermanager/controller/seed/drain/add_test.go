@@ -0,0 +1,94 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/drain"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		seed       *gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		seed = &gardencorev1beta1.Seed{
+			Spec: gardencorev1beta1.SeedSpec{
+				Settings: &gardencorev1beta1.SeedSettings{
+					Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+				},
+			},
+		}
+	})
+
+	Describe("#SeedPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.SeedPredicate()
+		})
+
+		It("should return true for create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: seed})).To(BeTrue())
+		})
+
+		It("should return false for update events when the drain settings did not change", func() {
+			oldSeed := seed.DeepCopy()
+			seed.Labels = map[string]string{"foo": "bar"}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldSeed, ObjectNew: seed})).To(BeFalse())
+		})
+
+		It("should return true for update events when the seed starts being drained", func() {
+			oldSeed := seed.DeepCopy()
+			seed.Spec.Settings.Scheduling.Drain = &gardencorev1beta1.SeedSettingSchedulingDrain{}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldSeed, ObjectNew: seed})).To(BeTrue())
+		})
+
+		It("should return true for update events when the drain settings changed", func() {
+			seed.Spec.Settings.Scheduling.Drain = &gardencorev1beta1.SeedSettingSchedulingDrain{MaxParallelMigrations: pointer.Int32(1)}
+			oldSeed := seed.DeepCopy()
+			seed.Spec.Settings.Scheduling.Drain.MaxParallelMigrations = pointer.Int32(2)
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldSeed, ObjectNew: seed})).To(BeTrue())
+		})
+
+		It("should return true for update events when the seed is no longer drained", func() {
+			seed.Spec.Settings.Scheduling.Drain = &gardencorev1beta1.SeedSettingSchedulingDrain{}
+			oldSeed := seed.DeepCopy()
+			seed.Spec.Settings.Scheduling.Drain = nil
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldSeed, ObjectNew: seed})).To(BeTrue())
+		})
+
+		It("should return false for delete events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: seed})).To(BeFalse())
+		})
+
+		It("should return false for generic events", func() {
+			Expect(p.Generic(event.GenericEvent{Object: seed})).To(BeFalse())
+		})
+	})
+})
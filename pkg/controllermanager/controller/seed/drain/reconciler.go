@@ -0,0 +1,198 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reconciles drained Seeds. It hands the Shoots hosted by such Seeds over to the gardener-scheduler for
+// rescheduling to other Seeds during their maintenance time windows and reports the drain progress in the Seed status.
+type Reconciler struct {
+	Client   client.Client
+	Config   config.SeedDrainControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
+}
+
+// Reconcile reconciles drained Seeds. It hands the Shoots hosted by such Seeds over to the gardener-scheduler for
+// rescheduling to other Seeds during their maintenance time windows and reports the drain progress in the Seed status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, r.Config.SyncPeriod.Duration)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if !v1beta1helper.SeedSettingDrainEnabled(seed.Spec.Settings) {
+		if seed.Status.Drain != nil {
+			log.Info("Seed is no longer drained, removing drain status")
+			patch := client.MergeFrom(seed.DeepCopy())
+			seed.Status.Drain = nil
+			return reconcile.Result{}, r.Client.Status().Patch(ctx, seed, patch)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	hostedShoots, err := r.getHostedShoots(ctx, seed.Name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var (
+		migratingShoots = sets.New[string]()
+		candidates      []*gardencorev1beta1.Shoot
+	)
+
+	for _, key := range sets.List(sets.KeySet(hostedShoots)) {
+		shoot := hostedShoots[key]
+
+		switch {
+		case pointer.StringDeref(shoot.Spec.SeedName, "") != seed.Name,
+			shoot.Annotations[v1beta1constants.AnnotationSchedulingReschedule] == "true":
+			migratingShoots.Insert(key)
+		case r.isMigrationCandidate(shoot, seed.Name):
+			candidates = append(candidates, shoot)
+		}
+	}
+
+	if len(candidates) > 0 {
+		if err := r.triggerMigrations(ctx, log, seed, candidates, migratingShoots); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if err := r.updateDrainStatus(ctx, log, seed, len(hostedShoots), migratingShoots); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// getHostedShoots returns the Shoots whose control planes are hosted by the Seed with the given name or which are
+// scheduled to it, keyed by '<namespace>/<name>'.
+func (r *Reconciler) getHostedShoots(ctx context.Context, seedName string) (map[string]*gardencorev1beta1.Shoot, error) {
+	hostedShoots := make(map[string]*gardencorev1beta1.Shoot)
+
+	for _, field := range []string{core.ShootSeedName, core.ShootStatusSeedName} {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, shootList, client.MatchingFields{field: seedName}); err != nil {
+			return nil, err
+		}
+
+		for i := range shootList.Items {
+			shoot := &shootList.Items[i]
+			hostedShoots[client.ObjectKeyFromObject(shoot).String()] = shoot
+		}
+	}
+
+	return hostedShoots, nil
+}
+
+// isMigrationCandidate returns true if the control plane of the given Shoot is hosted by the Seed with the given name
+// and can be migrated now, i.e., the Shoot is not being deleted and is in its maintenance time window.
+func (r *Reconciler) isMigrationCandidate(shoot *gardencorev1beta1.Shoot, seedName string) bool {
+	return shoot.DeletionTimestamp == nil &&
+		pointer.StringDeref(shoot.Status.SeedName, "") == seedName &&
+		gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, r.Clock)
+}
+
+// triggerMigrations annotates as many of the given candidates with the reschedule annotation as the maximum number of
+// parallel migrations of the Seed allows. The gardener-scheduler then picks new Seeds for them.
+func (r *Reconciler) triggerMigrations(ctx context.Context, log logr.Logger, seed *gardencorev1beta1.Seed, candidates []*gardencorev1beta1.Shoot, migratingShoots sets.Set[string]) error {
+	// Migrating a shoot control plane requires the gardenlet of the source seed to be available. Its heartbeats are
+	// checked by the seed lifecycle controller which maintains the GardenletReady condition.
+	if condition := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedGardenletReady); condition == nil || condition.Status != gardencorev1beta1.ConditionTrue {
+		log.Info("Not triggering further shoot migrations because gardenlet is not ready", "pendingShoots", len(candidates))
+		r.Recorder.Eventf(seed, corev1.EventTypeWarning, gardencorev1beta1.SeedEventDrainPaused, "Not migrating further shoots off the seed because its gardenlet is not ready")
+		return nil
+	}
+
+	maxParallelMigrations := int(pointer.Int32Deref(seed.Spec.Settings.Scheduling.Drain.MaxParallelMigrations, 1))
+
+	for _, shoot := range candidates {
+		if migratingShoots.Len() >= maxParallelMigrations {
+			break
+		}
+
+		key := client.ObjectKeyFromObject(shoot).String()
+		log.Info("Triggering migration of shoot off drained seed", "shoot", key)
+
+		patch := client.MergeFrom(shoot.DeepCopy())
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationSchedulingReschedule, "true")
+		if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+			return fmt.Errorf("failed triggering migration of shoot %s: %w", key, err)
+		}
+
+		r.Recorder.Eventf(seed, corev1.EventTypeNormal, gardencorev1beta1.SeedEventShootMigrationTriggered, "Triggered migration of shoot %s off the drained seed", key)
+		migratingShoots.Insert(key)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) updateDrainStatus(ctx context.Context, log logr.Logger, seed *gardencorev1beta1.Seed, remainingShoots int, migratingShoots sets.Set[string]) error {
+	drainStatus := &gardencorev1beta1.SeedDrainStatus{
+		StartTimestamp:  metav1.Time{Time: r.Clock.Now()},
+		RemainingShoots: int32(remainingShoots),
+		MigratingShoots: sets.List(migratingShoots),
+	}
+	if seed.Status.Drain != nil {
+		drainStatus.StartTimestamp = seed.Status.Drain.StartTimestamp
+	}
+
+	if apiequality.Semantic.DeepEqual(seed.Status.Drain, drainStatus) {
+		return nil
+	}
+
+	if remainingShoots == 0 && (seed.Status.Drain == nil || seed.Status.Drain.RemainingShoots > 0) {
+		log.Info("All shoots have been migrated off drained seed")
+		r.Recorder.Eventf(seed, corev1.EventTypeNormal, gardencorev1beta1.SeedEventDrained, "All shoots have been migrated off the seed")
+	}
+
+	patch := client.MergeFrom(seed.DeepCopy())
+	seed.Status.Drain = drainStatus
+	return r.Client.Status().Patch(ctx, seed, patch)
+}
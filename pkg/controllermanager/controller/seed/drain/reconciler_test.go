@@ -0,0 +1,264 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/drain"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		seedName           = "seed"
+		namespace          = "garden-dev"
+		syncPeriodDuration = time.Minute
+	)
+
+	var (
+		ctx context.Context
+		c   client.Client
+
+		fakeClock    *testclock.FakeClock
+		fakeRecorder *record.FakeRecorder
+
+		seed   *gardencorev1beta1.Seed
+		shoots []*gardencorev1beta1.Shoot
+
+		reconciler reconcile.Reconciler
+		request    reconcile.Request
+
+		newShoot = func(name, timeWindowBegin, timeWindowEnd string) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec: gardencorev1beta1.ShootSpec{
+					SeedName: pointer.String(seedName),
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: timeWindowBegin, End: timeWindowEnd},
+					},
+				},
+				Status: gardencorev1beta1.ShootStatus{SeedName: pointer.String(seedName)},
+			}
+		}
+		inMaintenanceTimeWindow = func(name string) *gardencorev1beta1.Shoot {
+			return newShoot(name, "020000+0000", "040000+0000")
+		}
+		outOfMaintenanceTimeWindow = func(name string) *gardencorev1beta1.Shoot {
+			return newShoot(name, "100000+0000", "110000+0000")
+		}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 3, 1, 3, 0, 0, 0, time.UTC))
+		fakeRecorder = record.NewFakeRecorder(10)
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: seedName},
+			Spec: gardencorev1beta1.SeedSpec{
+				Settings: &gardencorev1beta1.SeedSettings{
+					Scheduling: &gardencorev1beta1.SeedSettingScheduling{
+						Visible: true,
+						Drain:   &gardencorev1beta1.SeedSettingSchedulingDrain{MaxParallelMigrations: pointer.Int32(1)},
+					},
+				},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				Conditions: []gardencorev1beta1.Condition{{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue}},
+			},
+		}
+		shoots = nil
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+	})
+
+	JustBeforeEach(func() {
+		builder := fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(seed).
+			WithStatusSubresource(&gardencorev1beta1.Seed{}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootSeedName, indexer.ShootSeedNameIndexerFunc).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, indexer.ShootStatusSeedNameIndexerFunc)
+		for _, shoot := range shoots {
+			builder.WithObjects(shoot)
+		}
+		c = builder.Build()
+
+		reconciler = &Reconciler{
+			Client:   c,
+			Config:   config.SeedDrainControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: syncPeriodDuration}},
+			Clock:    fakeClock,
+			Recorder: fakeRecorder,
+		}
+	})
+
+	It("should do nothing if the Seed is gone", func() {
+		Expect(c.Delete(ctx, seed)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	Context("seed is not drained", func() {
+		BeforeEach(func() {
+			seed.Spec.Settings.Scheduling.Drain = nil
+			seed.Status.Drain = &gardencorev1beta1.SeedDrainStatus{RemainingShoots: 1}
+			shoots = []*gardencorev1beta1.Shoot{inMaintenanceTimeWindow("shoot1")}
+		})
+
+		It("should remove the drain status and not trigger any migration", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+			Expect(seed.Status.Drain).To(BeNil())
+
+			shoot := &gardencorev1beta1.Shoot{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shoots[0]), shoot)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingReschedule))
+		})
+	})
+
+	Context("seed is drained", func() {
+		It("should report the seed as drained if it does not host any shoots", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+			Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+			Expect(seed.Status.Drain).NotTo(BeNil())
+			Expect(seed.Status.Drain.StartTimestamp.Time).To(BeTemporally("==", fakeClock.Now()))
+			Expect(seed.Status.Drain.RemainingShoots).To(BeZero())
+			Expect(seed.Status.Drain.MigratingShoots).To(BeEmpty())
+			Expect(fakeRecorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.SeedEventDrained)))
+		})
+
+		Context("with hosted shoots", func() {
+			BeforeEach(func() {
+				shoots = []*gardencorev1beta1.Shoot{
+					inMaintenanceTimeWindow("shoot1"),
+					inMaintenanceTimeWindow("shoot2"),
+					outOfMaintenanceTimeWindow("shoot3"),
+				}
+			})
+
+			It("should only trigger as many migrations of shoots in their maintenance time window as allowed", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				expectRescheduleAnnotation(ctx, c, shoots[0], true)
+				expectRescheduleAnnotation(ctx, c, shoots[1], false)
+				expectRescheduleAnnotation(ctx, c, shoots[2], false)
+				Expect(fakeRecorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.SeedEventShootMigrationTriggered)))
+
+				Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+				Expect(seed.Status.Drain).NotTo(BeNil())
+				Expect(seed.Status.Drain.RemainingShoots).To(Equal(int32(3)))
+				Expect(seed.Status.Drain.MigratingShoots).To(ConsistOf(namespace + "/shoot1"))
+			})
+
+			It("should trigger further migrations if more parallel migrations are allowed", func() {
+				seed.Spec.Settings.Scheduling.Drain.MaxParallelMigrations = pointer.Int32(5)
+				Expect(c.Update(ctx, seed)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				expectRescheduleAnnotation(ctx, c, shoots[0], true)
+				expectRescheduleAnnotation(ctx, c, shoots[1], true)
+				expectRescheduleAnnotation(ctx, c, shoots[2], false)
+			})
+
+			It("should not trigger further migrations while a shoot is still being migrated", func() {
+				migratingShoot := &gardencorev1beta1.Shoot{}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(shoots[2]), migratingShoot)).To(Succeed())
+				migratingShoot.Spec.SeedName = pointer.String("other-seed")
+				Expect(c.Update(ctx, migratingShoot)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				expectRescheduleAnnotation(ctx, c, shoots[0], false)
+				expectRescheduleAnnotation(ctx, c, shoots[1], false)
+
+				Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+				Expect(seed.Status.Drain.RemainingShoots).To(Equal(int32(3)))
+				Expect(seed.Status.Drain.MigratingShoots).To(ConsistOf(namespace + "/shoot3"))
+			})
+
+			It("should not trigger migrations of shoots which are being deleted", func() {
+				for _, shoot := range shoots[:2] {
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					shoot.Finalizers = []string{"gardener"}
+					Expect(c.Update(ctx, shoot)).To(Succeed())
+					Expect(c.Delete(ctx, shoot)).To(Succeed())
+				}
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				expectRescheduleAnnotation(ctx, c, shoots[0], false)
+				expectRescheduleAnnotation(ctx, c, shoots[1], false)
+
+				Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+				Expect(seed.Status.Drain.RemainingShoots).To(Equal(int32(3)))
+				Expect(seed.Status.Drain.MigratingShoots).To(BeEmpty())
+			})
+
+			It("should pause the drain if the gardenlet is not ready", func() {
+				seed.Status.Conditions[0].Status = gardencorev1beta1.ConditionUnknown
+				Expect(c.Status().Update(ctx, seed)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				expectRescheduleAnnotation(ctx, c, shoots[0], false)
+				expectRescheduleAnnotation(ctx, c, shoots[1], false)
+				Expect(fakeRecorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.SeedEventDrainPaused)))
+
+				Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+				Expect(seed.Status.Drain.RemainingShoots).To(Equal(int32(3)))
+				Expect(seed.Status.Drain.MigratingShoots).To(BeEmpty())
+			})
+
+			It("should keep the start timestamp of an ongoing drain", func() {
+				startTimestamp := metav1.NewTime(fakeClock.Now().Add(-time.Hour))
+				seed.Status.Drain = &gardencorev1beta1.SeedDrainStatus{StartTimestamp: startTimestamp, RemainingShoots: 3}
+				Expect(c.Status().Update(ctx, seed)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+				Expect(seed.Status.Drain.StartTimestamp.Time).To(BeTemporally("==", startTimestamp.Time))
+				Expect(seed.Status.Drain.MigratingShoots).To(ConsistOf(namespace + "/shoot1"))
+			})
+		})
+	})
+})
+
+func expectRescheduleAnnotation(ctx context.Context, c client.Client, shoot *gardencorev1beta1.Shoot, expected bool) {
+	ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+	if expected {
+		ExpectWithOffset(1, shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationSchedulingReschedule, "true"))
+	} else {
+		ExpectWithOffset(1, shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingReschedule))
+	}
+}
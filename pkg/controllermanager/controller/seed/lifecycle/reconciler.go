@@ -22,6 +22,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -40,6 +41,7 @@ import (
 // Reconciler reconciles Seeds and checks whether the responsible gardenlet is regularly sending heartbeats. If not, it
 // sets the GardenletReady condition of the Seed to Unknown after some grace period passed. If the gardenlet still did
 // not send heartbeats and another grace period passed then also all shoot conditions and constraints are set to Unknown.
+// For drained Seeds, this includes the Shoots whose control planes are still being migrated off the Seed.
 type Reconciler struct {
 	Client         client.Client
 	Config         config.SeedControllerConfiguration
@@ -152,10 +154,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	if err := r.Client.List(ctx, shootList, client.MatchingFields{core.ShootSeedName: seed.Name}); err != nil {
 		return reconcile.Result{}, err
 	}
+	shoots := shootList.Items
+
+	// Shoots which are currently migrated off a drained seed are already scheduled to their new seed, however their
+	// control planes are still hosted by this seed until the migration completed. Hence, their status is not checked
+	// by any gardenlet either.
+	if v1beta1helper.SeedSettingDrainEnabled(seed.Spec.Settings) {
+		migratingShootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, migratingShootList, client.MatchingFields{core.ShootStatusSeedName: seed.Name}); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		for _, shoot := range migratingShootList.Items {
+			if pointer.StringDeref(shoot.Spec.SeedName, "") != seed.Name {
+				shoots = append(shoots, shoot)
+			}
+		}
+	}
 
 	var fns []flow.TaskFn
 
-	for _, s := range shootList.Items {
+	for _, s := range shoots {
 		shoot := s
 		fns = append(fns, func(ctx context.Context) error {
 			return setShootStatusToUnknown(ctx, r.Clock, r.Client, &shoot)
@@ -86,6 +86,12 @@ const (
 	// owner: @rfranzke @oliver-goetz
 	// alpha: v1.82.0
 	UseGardenerNodeAgent featuregate.Feature = "UseGardenerNodeAgent"
+
+	// BastionSSHCertificates enables a per-shoot SSH certificate authority which is trusted by the sshd of the worker
+	// nodes and which is used to issue short-lived user certificates for Bastions.
+	// owner: @gardener/gardener-maintainers
+	// alpha: v1.88.0
+	BastionSSHCertificates featuregate.Feature = "BastionSSHCertificates"
 )
 
 // DefaultFeatureGate is the central feature gate map used by all gardener components.
@@ -123,6 +129,7 @@ var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	MachineControllerManagerDeployment: {Default: true, PreRelease: featuregate.GA, LockToDefault: true},
 	APIServerFastRollout:               {Default: true, PreRelease: featuregate.Beta},
 	UseGardenerNodeAgent:               {Default: false, PreRelease: featuregate.Alpha},
+	BastionSSHCertificates:             {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GetFeatures returns a feature gate map with the respective specifications. Non-existing feature gates are ignored.
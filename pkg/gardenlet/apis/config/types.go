@@ -158,6 +158,8 @@ type GardenletControllerConfiguration struct {
 	ManagedSeed *ManagedSeedControllerConfiguration
 	// TokenRequestorControllerConfiguration defines the configuration of the TokenRequestor controller.
 	TokenRequestor *TokenRequestorControllerConfiguration
+	// ShootWebhooks defines the configuration of the ShootWebhooks controller.
+	ShootWebhooks *ShootWebhooksControllerConfiguration
 }
 
 // BackupBucketControllerConfiguration defines the configuration of the BackupBucket
@@ -351,6 +353,12 @@ type TokenRequestorControllerConfiguration struct {
 	ConcurrentSyncs *int
 }
 
+// ShootWebhooksControllerConfiguration defines the configuration of the ShootWebhooks controller.
+type ShootWebhooksControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	ConcurrentSyncs *int
+}
+
 // ResourcesConfiguration defines the total capacity for seed resources and the amount reserved for use by Gardener.
 type ResourcesConfiguration struct {
 	// Capacity defines the total resources of a seed.
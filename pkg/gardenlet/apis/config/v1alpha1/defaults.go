@@ -180,6 +180,9 @@ func SetDefaults_GardenletControllerConfiguration(obj *GardenletControllerConfig
 	if obj.TokenRequestor == nil {
 		obj.TokenRequestor = &TokenRequestorControllerConfiguration{}
 	}
+	if obj.ShootWebhooks == nil {
+		obj.ShootWebhooks = &ShootWebhooksControllerConfiguration{}
+	}
 }
 
 // SetDefaults_ClientConnectionConfiguration sets defaults for the client connection objects.
@@ -419,6 +422,13 @@ func SetDefaults_TokenRequestorControllerConfiguration(obj *TokenRequestorContro
 	}
 }
 
+// SetDefaults_ShootWebhooksControllerConfiguration sets defaults for the ShootWebhooks controller.
+func SetDefaults_ShootWebhooksControllerConfiguration(obj *ShootWebhooksControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = pointer.Int(5)
+	}
+}
+
 // SetDefaults_SNI sets defaults for SNI.
 func SetDefaults_SNI(obj *SNI) {
 	if obj.Ingress == nil {
@@ -519,6 +519,23 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootWebhooksControllerConfiguration defaulting", func() {
+		It("should default the shoot webhooks controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootWebhooks.ConcurrentSyncs).To(PointTo(Equal(5)))
+		})
+
+		It("should not overwrite already set values for the shoot webhooks controller configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootWebhooks: &ShootWebhooksControllerConfiguration{ConcurrentSyncs: pointer.Int(10)},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootWebhooks.ConcurrentSyncs).To(PointTo(Equal(10)))
+		})
+	})
+
 	Describe("LeaderElectionConfiguration defaulting", func() {
 		It("should correctly default the leader election configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -197,6 +197,9 @@ type GardenletControllerConfiguration struct {
 	// TokenRequestorControllerConfiguration defines the configuration of the TokenRequestor controller.
 	// +optional
 	TokenRequestor *TokenRequestorControllerConfiguration `json:"tokenRequestor,omitempty"`
+	// ShootWebhooks defines the configuration of the ShootWebhooks controller.
+	// +optional
+	ShootWebhooks *ShootWebhooksControllerConfiguration `json:"shootWebhooks,omitempty"`
 }
 
 // BackupBucketControllerConfiguration defines the configuration of the BackupBucket
@@ -427,6 +430,13 @@ type TokenRequestorControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// ShootWebhooksControllerConfiguration defines the configuration of the ShootWebhooks controller.
+type ShootWebhooksControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // ResourcesConfiguration defines the total capacity for seed resources and the amount reserved for use by Gardener.
 type ResourcesConfiguration struct {
 	// Capacity defines the total resources of a seed.
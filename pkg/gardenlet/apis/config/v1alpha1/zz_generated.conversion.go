@@ -453,6 +453,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootWebhooksControllerConfiguration)(nil), (*config.ShootWebhooksControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootWebhooksControllerConfiguration_To_config_ShootWebhooksControllerConfiguration(a.(*ShootWebhooksControllerConfiguration), b.(*config.ShootWebhooksControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootWebhooksControllerConfiguration)(nil), (*ShootWebhooksControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootWebhooksControllerConfiguration_To_v1alpha1_ShootWebhooksControllerConfiguration(a.(*config.ShootWebhooksControllerConfiguration), b.(*ShootWebhooksControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*StaleExtensionHealthChecks)(nil), (*config.StaleExtensionHealthChecks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_StaleExtensionHealthChecks_To_config_StaleExtensionHealthChecks(a.(*StaleExtensionHealthChecks), b.(*config.StaleExtensionHealthChecks), scope)
 	}); err != nil {
@@ -1015,6 +1025,7 @@ func autoConvert_v1alpha1_GardenletControllerConfiguration_To_config_GardenletCo
 	out.NetworkPolicy = (*config.NetworkPolicyControllerConfiguration)(unsafe.Pointer(in.NetworkPolicy))
 	out.ManagedSeed = (*config.ManagedSeedControllerConfiguration)(unsafe.Pointer(in.ManagedSeed))
 	out.TokenRequestor = (*config.TokenRequestorControllerConfiguration)(unsafe.Pointer(in.TokenRequestor))
+	out.ShootWebhooks = (*config.ShootWebhooksControllerConfiguration)(unsafe.Pointer(in.ShootWebhooks))
 	return nil
 }
 
@@ -1038,6 +1049,7 @@ func autoConvert_config_GardenletControllerConfiguration_To_v1alpha1_GardenletCo
 	out.NetworkPolicy = (*NetworkPolicyControllerConfiguration)(unsafe.Pointer(in.NetworkPolicy))
 	out.ManagedSeed = (*ManagedSeedControllerConfiguration)(unsafe.Pointer(in.ManagedSeed))
 	out.TokenRequestor = (*TokenRequestorControllerConfiguration)(unsafe.Pointer(in.TokenRequestor))
+	out.ShootWebhooks = (*ShootWebhooksControllerConfiguration)(unsafe.Pointer(in.ShootWebhooks))
 	return nil
 }
 
@@ -1610,6 +1622,26 @@ func Convert_config_ShootStateControllerConfiguration_To_v1alpha1_ShootStateCont
 	return autoConvert_config_ShootStateControllerConfiguration_To_v1alpha1_ShootStateControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootWebhooksControllerConfiguration_To_config_ShootWebhooksControllerConfiguration(in *ShootWebhooksControllerConfiguration, out *config.ShootWebhooksControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_v1alpha1_ShootWebhooksControllerConfiguration_To_config_ShootWebhooksControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootWebhooksControllerConfiguration_To_config_ShootWebhooksControllerConfiguration(in *ShootWebhooksControllerConfiguration, out *config.ShootWebhooksControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootWebhooksControllerConfiguration_To_config_ShootWebhooksControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootWebhooksControllerConfiguration_To_v1alpha1_ShootWebhooksControllerConfiguration(in *config.ShootWebhooksControllerConfiguration, out *ShootWebhooksControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_config_ShootWebhooksControllerConfiguration_To_v1alpha1_ShootWebhooksControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootWebhooksControllerConfiguration_To_v1alpha1_ShootWebhooksControllerConfiguration(in *config.ShootWebhooksControllerConfiguration, out *ShootWebhooksControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootWebhooksControllerConfiguration_To_v1alpha1_ShootWebhooksControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_StaleExtensionHealthChecks_To_config_StaleExtensionHealthChecks(in *StaleExtensionHealthChecks, out *config.StaleExtensionHealthChecks, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.Threshold = (*v1.Duration)(unsafe.Pointer(in.Threshold))
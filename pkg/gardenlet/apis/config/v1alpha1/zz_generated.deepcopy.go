@@ -607,6 +607,11 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(TokenRequestorControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootWebhooks != nil {
+		in, out := &in.ShootWebhooks, &out.ShootWebhooks
+		*out = new(ShootWebhooksControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1289,6 +1294,27 @@ func (in *ShootStateControllerConfiguration) DeepCopy() *ShootStateControllerCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootWebhooksControllerConfiguration) DeepCopyInto(out *ShootWebhooksControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootWebhooksControllerConfiguration.
+func (in *ShootWebhooksControllerConfiguration) DeepCopy() *ShootWebhooksControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootWebhooksControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaleExtensionHealthChecks) DeepCopyInto(out *StaleExtensionHealthChecks) {
 	*out = *in
@@ -95,6 +95,9 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		if in.Controllers.TokenRequestor != nil {
 			SetDefaults_TokenRequestorControllerConfiguration(in.Controllers.TokenRequestor)
 		}
+		if in.Controllers.ShootWebhooks != nil {
+			SetDefaults_ShootWebhooksControllerConfiguration(in.Controllers.ShootWebhooks)
+		}
 	}
 	if in.LeaderElection != nil {
 		SetDefaults_LeaderElectionConfiguration(in.LeaderElection)
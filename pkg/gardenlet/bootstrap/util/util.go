@@ -349,6 +349,8 @@ func ServiceAccountName(name string) string {
 const (
 	// KindManagedSeed is a constant for the "managed seed" kind.
 	KindManagedSeed = "managed seed"
+	// KindGarden is a constant for the "garden" kind.
+	KindGarden = "garden"
 	// ServiceAccountNamePrefix is the prefix used for service account names.
 	ServiceAccountNamePrefix = "gardenlet-bootstrap-"
 	// ClusterRoleBindingNamePrefix is the prefix used for cluster role binding names.
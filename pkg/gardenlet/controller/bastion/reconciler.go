@@ -22,10 +22,12 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -39,14 +41,28 @@ import (
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 // RequeueDurationWhenResourceDeletionStillPresent is the duration used for requeueing when owned resources are still in
 // the process of being deleted when deleting a Bastion.
 var RequeueDurationWhenResourceDeletionStillPresent = 5 * time.Second
 
+var (
+	// SSHCertificateValidity is the validity of the SSH user certificates issued for Bastions.
+	SSHCertificateValidity = time.Hour
+	// SSHCertificateRenewalThreshold is the duration before the expiration of an SSH user certificate after which a
+	// new certificate is issued.
+	SSHCertificateRenewalThreshold = 10 * time.Minute
+)
+
+// sshCertificatePrincipal is the user on the worker nodes for which the SSH user certificates are issued.
+const sshCertificatePrincipal = "gardener"
+
 // Reconciler reconciles Bastions and deploys them into the seed cluster.
 type Reconciler struct {
 	GardenClient client.Client
@@ -110,6 +126,13 @@ func (r *Reconciler) reconcileBastion(
 		}
 	}
 
+	// do not create the bastion host before the Bastion has been approved, the reconciliation is triggered again by
+	// the generation increment caused by the approval
+	if pointer.BoolDeref(bastion.Spec.ApprovalRequired, false) && bastion.Status.Approval == nil {
+		log.Info("Bastion has not been approved yet")
+		return patchReadyCondition(gardenCtx, r.GardenClient, r.Clock, bastion, gardencorev1beta1.ConditionFalse, "ApprovalPending", "The bastion must be approved by another project member having the admin role.")
+	}
+
 	extensionBastion := newBastionExtension(bastion, shoot)
 	extensionIngress := make([]extensionsv1alpha1.BastionIngressPolicy, len(bastion.Spec.Ingress))
 	for i, ingress := range bastion.Spec.Ingress {
@@ -185,19 +208,123 @@ func (r *Reconciler) reconcileBastion(
 	}
 
 	if extensionBastion.Status.LastOperation != nil && extensionBastion.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded {
+		var sshCertificate *operationsv1alpha1.BastionSSHCertificate
+		if features.DefaultFeatureGate.Enabled(features.BastionSSHCertificates) {
+			var err error
+			if sshCertificate, err = r.ensureSSHCertificate(seedCtx, bastion, shoot); err != nil {
+				if patchErr := patchReadyCondition(gardenCtx, r.GardenClient, r.Clock, bastion, gardencorev1beta1.ConditionFalse, "FailedIssuingSSHCertificate", err.Error()); patchErr != nil {
+					log.Error(patchErr, "Failed patching ready condition")
+				}
+				return fmt.Errorf("failed issuing SSH certificate: %w", err)
+			}
+		}
+
 		// copy over the extension's status to the operation bastion and set the condition
 		patch := client.MergeFrom(bastion.DeepCopy())
 		setReadyCondition(r.Clock, bastion, gardencorev1beta1.ConditionTrue, "SuccessfullyReconciled", "The bastion has been reconciled successfully.")
 		bastion.Status.Ingress = extensionBastion.Status.Ingress.DeepCopy()
 		bastion.Status.ObservedGeneration = &bastion.Generation
+		bastion.Status.SSHCertificate = sshCertificate
 		if err := r.GardenClient.Status().Patch(gardenCtx, bastion, patch); err != nil {
 			return fmt.Errorf("failed patching ready condition of Bastion: %w", err)
 		}
+
+		// renew the SSH certificate shortly before it expires
+		if sshCertificate != nil {
+			if renewAfter := sshCertificate.ValidUntil.Sub(r.Clock.Now()) - SSHCertificateRenewalThreshold; renewAfter > 0 {
+				return &reconcilerutils.RequeueAfterError{RequeueAfter: renewAfter}
+			}
+		}
 	}
 
 	return nil
 }
 
+// ensureSSHCertificate returns an SSH user certificate for the public key of the given Bastion which is signed by the
+// SSH certificate authority of the shoot. The existing certificate is returned if it is not about to expire or if it
+// cannot be extended because the session of the Bastion ends. The certificate is bound to the identity which requested
+// the Bastion.
+func (r *Reconciler) ensureSSHCertificate(ctx context.Context, bastion *operationsv1alpha1.Bastion, shoot *gardencorev1beta1.Shoot) (*operationsv1alpha1.BastionSSHCertificate, error) {
+	now := r.Clock.Now()
+
+	validUntil := now.Add(SSHCertificateValidity)
+	if sessionEnd := getSessionEnd(bastion); sessionEnd != nil && sessionEnd.Before(validUntil) {
+		validUntil = *sessionEnd
+	}
+
+	if existing := bastion.Status.SSHCertificate; existing != nil &&
+		(existing.ValidUntil.Sub(now) > SSHCertificateRenewalThreshold || !existing.ValidUntil.Time.Before(validUntil)) {
+		return existing, nil
+	}
+
+	if !validUntil.After(now) {
+		return nil, nil
+	}
+
+	caSecret, err := r.getSSHUserCASecret(ctx, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := secretsutils.SignSSHUserCertificate(caSecret.Data[secretsutils.DataKeyRSAPrivateKey], secretsutils.SSHUserCertificateConfig{
+		PublicKey:  []byte(bastion.Spec.SSHPublicKey),
+		KeyID:      bastion.Annotations[v1beta1constants.GardenCreatedBy],
+		Principals: []string{sshCertificatePrincipal},
+		// tolerate a small clock skew between gardenlet and the worker nodes
+		ValidAfter:  now.Add(-time.Minute),
+		ValidBefore: validUntil,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &operationsv1alpha1.BastionSSHCertificate{
+		Certificate: string(certificate),
+		ValidUntil:  metav1.NewTime(validUntil),
+	}, nil
+}
+
+// getSSHUserCASecret returns the most recent secret of the SSH certificate authority of the given shoot.
+func (r *Reconciler) getSSHUserCASecret(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*corev1.Secret, error) {
+	secretList := &corev1.SecretList{}
+	if err := r.SeedClient.List(ctx, secretList, client.InNamespace(shoot.Status.TechnicalID), client.MatchingLabels{
+		secretsmanager.LabelKeyName:            v1beta1constants.SecretNameSSHUserCA,
+		secretsmanager.LabelKeyManagedBy:       secretsmanager.LabelValueSecretsManager,
+		secretsmanager.LabelKeyManagerIdentity: v1beta1constants.SecretManagerIdentityGardenlet,
+	}); err != nil {
+		return nil, fmt.Errorf("failed listing secrets of SSH certificate authority: %w", err)
+	}
+
+	if len(secretList.Items) == 0 {
+		return nil, fmt.Errorf("secret of SSH certificate authority %q not found in namespace %q, the shoot might not have been reconciled yet", v1beta1constants.SecretNameSSHUserCA, shoot.Status.TechnicalID)
+	}
+
+	newest := &secretList.Items[0]
+	for i := range secretList.Items {
+		if newest.CreationTimestamp.Before(&secretList.Items[i].CreationTimestamp) {
+			newest = &secretList.Items[i]
+		}
+	}
+
+	return newest, nil
+}
+
+// getSessionEnd returns the time when the session of the given Bastion ends. It returns nil if the Bastion does not
+// have a maximum session duration.
+func getSessionEnd(bastion *operationsv1alpha1.Bastion) *time.Time {
+	if bastion.Spec.MaxSessionDuration == nil {
+		return nil
+	}
+
+	sessionStart := bastion.CreationTimestamp.Time
+	if bastion.Status.Approval != nil {
+		sessionStart = bastion.Status.Approval.ApprovalTimestamp.Time
+	}
+
+	sessionEnd := sessionStart.Add(bastion.Spec.MaxSessionDuration.Duration)
+	return &sessionEnd
+}
+
 func (r *Reconciler) cleanupBastion(
 	gardenCtx context.Context,
 	seedCtx context.Context,
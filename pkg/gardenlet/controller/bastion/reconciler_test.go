@@ -0,0 +1,207 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bastion_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/features"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/bastion"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		reconciler   *Reconciler
+
+		caPublicKey ssh.PublicKey
+		shoot       *gardencorev1beta1.Shoot
+		bastion     *operationsv1alpha1.Bastion
+		request     reconcile.Request
+
+		shootTechnicalID = "shoot--" + projectName + "--shootName"
+		projectNamespace = "garden-" + projectName
+	)
+
+	BeforeEach(func() {
+		DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.BastionSSHCertificates, true))
+
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		caPrivateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		caPublicKey, err = ssh.NewPublicKey(&caPrivateKey.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		userPrivateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		userPublicKey, err := ssh.NewPublicKey(&userPrivateKey.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shootName", Namespace: projectNamespace},
+			Status:     gardencorev1beta1.ShootStatus{TechnicalID: shootTechnicalID},
+		}
+		bastion = &operationsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              bastionName,
+				Namespace:         projectNamespace,
+				Finalizers:        []string{"gardener"},
+				Annotations:       map[string]string{"gardener.cloud/created-by": "john.doe@example.com"},
+				CreationTimestamp: metav1.NewTime(fakeClock.Now()),
+			},
+			Spec: operationsv1alpha1.BastionSpec{
+				ShootRef:     corev1.LocalObjectReference{Name: shoot.Name},
+				SSHPublicKey: string(ssh.MarshalAuthorizedKey(userPublicKey)),
+				ProviderType: pointer.String("local"),
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(bastion)}
+
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&operationsv1alpha1.Bastion{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&extensionsv1alpha1.Bastion{}).Build()
+
+		Expect(seedClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ssh-user-ca-1234",
+				Namespace: shootTechnicalID,
+				Labels: map[string]string{
+					"name":             "ssh-user-ca",
+					"managed-by":       "secrets-manager",
+					"manager-identity": "gardenlet",
+				},
+			},
+			Data: map[string][]byte{"id_rsa": utils.EncodePrivateKey(caPrivateKey)},
+		})).To(Succeed())
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Clock:        fakeClock,
+		}
+	})
+
+	JustBeforeEach(func() {
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+		Expect(gardenClient.Create(ctx, bastion)).To(Succeed())
+
+		By("Create extension Bastion and mark it as succeeded")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		extensionBastion := &extensionsv1alpha1.Bastion{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: bastionName, Namespace: shootTechnicalID}, extensionBastion)).To(Succeed())
+		extensionBastion.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded}
+		Expect(seedClient.Status().Update(ctx, extensionBastion)).To(Succeed())
+	})
+
+	parseCertificate := func() *ssh.Certificate {
+		Expect(gardenClient.Get(ctx, request.NamespacedName, bastion)).To(Succeed())
+		Expect(bastion.Status.SSHCertificate).NotTo(BeNil())
+
+		publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(bastion.Status.SSHCertificate.Certificate))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(publicKey).To(BeAssignableToTypeOf(&ssh.Certificate{}))
+		return publicKey.(*ssh.Certificate)
+	}
+
+	It("should issue a short-lived SSH certificate bound to the requesting identity", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true, RequeueAfter: SSHCertificateValidity - SSHCertificateRenewalThreshold}))
+
+		certificate := parseCertificate()
+		Expect(certificate.SignatureKey.Marshal()).To(Equal(caPublicKey.Marshal()))
+		Expect(certificate.KeyId).To(Equal("john.doe@example.com"))
+		Expect(certificate.ValidPrincipals).To(ConsistOf("gardener"))
+		Expect(certificate.ValidBefore).To(BeEquivalentTo(fakeClock.Now().Add(SSHCertificateValidity).Unix()))
+		Expect(bastion.Status.SSHCertificate.ValidUntil.Time).To(BeTemporally("==", fakeClock.Now().Add(SSHCertificateValidity)))
+	})
+
+	It("should renew the SSH certificate before it expires", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true, RequeueAfter: SSHCertificateValidity - SSHCertificateRenewalThreshold}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, bastion)).To(Succeed())
+		oldCertificate := bastion.Status.SSHCertificate.DeepCopy()
+		Expect(oldCertificate).NotTo(BeNil())
+
+		By("Keep the certificate if it is not about to expire")
+		fakeClock.Step(time.Minute)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true, RequeueAfter: SSHCertificateValidity - SSHCertificateRenewalThreshold - time.Minute}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, bastion)).To(Succeed())
+		Expect(bastion.Status.SSHCertificate).To(Equal(oldCertificate))
+
+		By("Renew the certificate if it is about to expire")
+		fakeClock.Step(SSHCertificateValidity - SSHCertificateRenewalThreshold)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true, RequeueAfter: SSHCertificateValidity - SSHCertificateRenewalThreshold}))
+		Expect(parseCertificate().ValidBefore).To(BeEquivalentTo(fakeClock.Now().Add(SSHCertificateValidity).Unix()))
+	})
+
+	Context("maximum session duration", func() {
+		BeforeEach(func() {
+			bastion.Spec.MaxSessionDuration = &metav1.Duration{Duration: 30 * time.Minute}
+		})
+
+		It("should limit the validity of the SSH certificate to the end of the session", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true, RequeueAfter: 20 * time.Minute}))
+			Expect(parseCertificate().ValidBefore).To(BeEquivalentTo(fakeClock.Now().Add(30 * time.Minute).Unix()))
+
+			By("Do not renew the certificate if the session ends with it")
+			fakeClock.Step(25 * time.Minute)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(parseCertificate().ValidBefore).To(BeEquivalentTo(fakeClock.Now().Add(5 * time.Minute).Unix()))
+		})
+	})
+
+	It("should not issue an SSH certificate if the feature gate is disabled", func() {
+		DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.BastionSSHCertificates, false))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, bastion)).To(Succeed())
+		Expect(bastion.Status.SSHCertificate).To(BeNil())
+	})
+
+	It("should fail if the SSH certificate authority does not exist", func() {
+		Expect(seedClient.DeleteAllOf(ctx, &corev1.Secret{}, client.InNamespace(shootTechnicalID))).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("secret of SSH certificate authority \"ssh-user-ca\" not found")))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, bastion)).To(Succeed())
+		Expect(bastion.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", operationsv1alpha1.BastionReady),
+			HaveField("Reason", "FailedIssuingSSHCertificate"),
+		)))
+	})
+})
@@ -32,6 +32,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // ControllerName is the name of this controller.
@@ -48,6 +49,9 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, gard
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.HelmRegistry == nil {
+		r.HelmRegistry = oci.NewHelmRegistry()
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
@@ -59,6 +59,7 @@ import (
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/gardener/gardener/pkg/utils/oci"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
@@ -77,6 +78,7 @@ type Reconciler struct {
 	Clock                 clock.Clock
 	Identity              *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
+	HelmRegistry          oci.HelmRegistry
 }
 
 // Reconcile reconciles ControllerInstallations and deploys them into the seed cluster.
@@ -156,6 +158,15 @@ func (r *Reconciler) reconcile(
 		if err := r.GardenClient.Get(gardenCtx, kubernetesutils.Key(deploymentRef.Name), controllerDeployment); err != nil {
 			return reconcile.Result{}, err
 		}
+
+		// With a configured rollout, the referenced resource version is only bumped by gardener-controller-manager once
+		// it is this seed's turn. Until then, the currently deployed version of the controller is kept.
+		if controllerRegistration.Spec.Deployment != nil && controllerRegistration.Spec.Deployment.Rollout != nil &&
+			deploymentRef.ResourceVersion != "" && deploymentRef.ResourceVersion != controllerDeployment.ResourceVersion {
+			log.Info("Referenced version of ControllerDeployment was not rolled out to this seed yet, keeping the deployed controller", "controllerDeploymentName", controllerDeployment.Name, "resourceVersion", deploymentRef.ResourceVersion)
+			return reconcile.Result{}, nil
+		}
+
 		providerConfig = &controllerDeployment.ProviderConfig
 	}
 
@@ -164,6 +175,8 @@ func (r *Reconciler) reconcile(
 		Chart []byte `json:"chart,omitempty"`
 		// Values is a map of values for the given chart.
 		Values map[string]interface{} `json:"values,omitempty"`
+		// OCIRepository references a Helm chart stored as OCI artifact in a registry.
+		OCIRepository *ociRepository `json:"ociRepository,omitempty"`
 	}
 
 	if err := json.Unmarshal(providerConfig.Raw, &helmDeployment); err != nil {
@@ -171,6 +184,21 @@ func (r *Reconciler) reconcile(
 		return reconcile.Result{}, err
 	}
 
+	if helmDeployment.OCIRepository != nil {
+		if len(helmDeployment.Chart) > 0 {
+			err := fmt.Errorf("only one of chart and ociRepository may be specified")
+			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "ChartInformationInvalid", err.Error())
+			return reconcile.Result{}, err
+		}
+
+		chart, err := r.pullChart(seedCtx, helmDeployment.OCIRepository)
+		if err != nil {
+			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "ChartCannotBePulled", fmt.Sprintf("chart could not be pulled from OCI repository: %+v", err))
+			return reconcile.Result{}, err
+		}
+		helmDeployment.Chart = chart
+	}
+
 	namespace := getNamespaceForControllerInstallation(controllerInstallation)
 	if _, err := controllerutils.GetAndCreateOrMergePatch(seedCtx, r.SeedClientSet.Client(), namespace, func() error {
 		metav1.SetMetaDataLabel(&namespace.ObjectMeta, v1beta1constants.GardenRole, v1beta1constants.GardenRoleExtension)
@@ -289,6 +317,33 @@ func (r *Reconciler) reconcile(
 	return reconcile.Result{}, nil
 }
 
+// ociRepository references a Helm chart stored as OCI artifact in a registry.
+type ociRepository struct {
+	// Repository is the OCI repository of the Helm chart, e.g. `registry.example.com/charts/foo`.
+	Repository string `json:"repository"`
+	// Digest is the digest of the manifest of the Helm chart artifact.
+	Digest string `json:"digest"`
+	// PullSecretRef references a secret of type `kubernetes.io/dockerconfigjson` in the garden namespace of the seed
+	// cluster containing the credentials for the registry.
+	PullSecretRef *corev1.LocalObjectReference `json:"pullSecretRef,omitempty"`
+}
+
+func (r *Reconciler) pullChart(ctx context.Context, repository *ociRepository) ([]byte, error) {
+	if repository.Repository == "" || repository.Digest == "" {
+		return nil, fmt.Errorf("repository and digest must be specified")
+	}
+
+	var pullSecret *corev1.Secret
+	if repository.PullSecretRef != nil {
+		pullSecret = &corev1.Secret{}
+		if err := r.SeedClientSet.APIReader().Get(ctx, client.ObjectKey{Namespace: v1beta1constants.GardenNamespace, Name: repository.PullSecretRef.Name}, pullSecret); err != nil {
+			return nil, fmt.Errorf("failed reading pull secret: %w", err)
+		}
+	}
+
+	return r.HelmRegistry.Pull(ctx, repository.Repository, repository.Digest, pullSecret)
+}
+
 func (r *Reconciler) delete(
 	gardenCtx context.Context,
 	seedCtx context.Context,
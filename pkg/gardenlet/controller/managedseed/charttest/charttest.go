@@ -791,6 +791,9 @@ func ComputeExpectedGardenletConfiguration(
 			TokenRequestor: &gardenletv1alpha1.TokenRequestorControllerConfiguration{
 				ConcurrentSyncs: &five,
 			},
+			ShootWebhooks: &gardenletv1alpha1.ShootWebhooksControllerConfiguration{
+				ConcurrentSyncs: &five,
+			},
 			ControllerInstallation: &gardenletv1alpha1.ControllerInstallationControllerConfiguration{
 				ConcurrentSyncs: &twenty,
 			},
@@ -33,6 +33,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/webhooks"
 )
 
 // AddToManager adds all Shoot controllers to the given manager.
@@ -79,6 +80,12 @@ func AddToManager(
 		return fmt.Errorf("failed adding care reconciler: %w", err)
 	}
 
+	if err := (&webhooks.Reconciler{
+		Config: *cfg.Controllers.ShootWebhooks,
+	}).AddToManager(ctx, mgr, seedCluster); err != nil {
+		return fmt.Errorf("failed adding webhooks reconciler: %w", err)
+	}
+
 	// If gardenlet is responsible for an unmanaged seed we want to add the state reconciler which performs periodic
 	// backups of shoot states (see GEP-22).
 	if shootStateControllerEnabled {
@@ -0,0 +1,105 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiextensions "github.com/gardener/gardener/pkg/api/extensions"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+)
+
+// Availability contains information needed to compute the availability of a Shoot.
+type Availability struct {
+	log        logr.Logger
+	shoot      *shoot.Shoot
+	seedClient client.Client
+}
+
+// NewAvailability creates a new Availability instance with the given parameters.
+func NewAvailability(log logr.Logger, shoot *shoot.Shoot, seedClient client.Client) *Availability {
+	return &Availability{
+		log:        log,
+		shoot:      shoot,
+		seedClient: seedClient,
+	}
+}
+
+// Compute rolls up the health check availability reported by the extension resources of the Shoot. For each window,
+// the lowest availability reported by any health check is used. It returns nil if no extension reports its
+// availability.
+func (a *Availability) Compute(ctx context.Context) (*gardencorev1beta1.ShootAvailability, error) {
+	objs, err := retrieveExtensions(ctx, a.seedClient, a.shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var last24Hours, last7Days *string
+	for _, obj := range objs {
+		acc, err := apiextensions.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, availability := range acc.GetExtensionStatus().GetHealthCheckAvailability() {
+			if last24Hours, err = minAvailability(last24Hours, availability.Last24Hours); err != nil {
+				return nil, fmt.Errorf("failed parsing availability of condition %s of %T %s: %w", availability.Type, obj, client.ObjectKeyFromObject(acc), err)
+			}
+			if last7Days, err = minAvailability(last7Days, availability.Last7Days); err != nil {
+				return nil, fmt.Errorf("failed parsing availability of condition %s of %T %s: %w", availability.Type, obj, client.ObjectKeyFromObject(acc), err)
+			}
+		}
+	}
+
+	if last24Hours == nil && last7Days == nil {
+		return nil, nil
+	}
+
+	return &gardencorev1beta1.ShootAvailability{
+		Last24Hours: last24Hours,
+		Last7Days:   last7Days,
+	}, nil
+}
+
+func minAvailability(current, value *string) (*string, error) {
+	if value == nil {
+		return current, nil
+	}
+
+	v, err := strconv.ParseFloat(*value, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	if current == nil {
+		return value, nil
+	}
+
+	c, err := strconv.ParseFloat(*current, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	if v < c {
+		return value, nil
+	}
+	return current, nil
+}
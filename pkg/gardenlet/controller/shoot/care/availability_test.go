@@ -0,0 +1,109 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package care_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+)
+
+var _ = Describe("Availability", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient client.Client
+		shoot      *shootpkg.Shoot
+
+		availability *Availability
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		shoot = &shootpkg.Shoot{
+			SeedNamespace:   "shoot--foo--bar",
+			BackupEntryName: "shoot--foo--bar--uid",
+		}
+
+		availability = NewAvailability(logr.Discard(), shoot, fakeClient)
+	})
+
+	Describe("#Compute", func() {
+		It("should return nil if no extension reports its availability", func() {
+			Expect(fakeClient.Create(ctx, &extensionsv1alpha1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: shoot.SeedNamespace}})).To(Succeed())
+
+			Expect(availability.Compute(ctx)).To(BeNil())
+		})
+
+		It("should return the lowest availability per window", func() {
+			Expect(fakeClient.Create(ctx, &extensionsv1alpha1.ControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: shoot.SeedNamespace},
+				Status: extensionsv1alpha1.ControlPlaneStatus{DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					HealthCheckAvailability: []extensionsv1alpha1.HealthCheckAvailability{
+						{Type: gardencorev1beta1.ShootControlPlaneHealthy, Last24Hours: pointer.String("100.00"), Last7Days: pointer.String("99.50")},
+						{Type: gardencorev1beta1.ShootSystemComponentsHealthy, Last24Hours: pointer.String("98.25"), Last7Days: pointer.String("99.90")},
+					},
+				}},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: shoot.SeedNamespace},
+				Status: extensionsv1alpha1.WorkerStatus{DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					HealthCheckAvailability: []extensionsv1alpha1.HealthCheckAvailability{
+						{Type: gardencorev1beta1.ShootEveryNodeReady, Last7Days: pointer.String("97.00")},
+					},
+				}},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &extensionsv1alpha1.Network{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "other-namespace"},
+				Status: extensionsv1alpha1.NetworkStatus{DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					HealthCheckAvailability: []extensionsv1alpha1.HealthCheckAvailability{
+						{Type: gardencorev1beta1.ShootSystemComponentsHealthy, Last24Hours: pointer.String("10.00")},
+					},
+				}},
+			})).To(Succeed())
+
+			Expect(availability.Compute(ctx)).To(Equal(&gardencorev1beta1.ShootAvailability{
+				Last24Hours: pointer.String("98.25"),
+				Last7Days:   pointer.String("97.00"),
+			}))
+		})
+
+		It("should fail if an availability cannot be parsed", func() {
+			Expect(fakeClient.Create(ctx, &extensionsv1alpha1.ControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: shoot.SeedNamespace},
+				Status: extensionsv1alpha1.ControlPlaneStatus{DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					HealthCheckAvailability: []extensionsv1alpha1.HealthCheckAvailability{
+						{Type: gardencorev1beta1.ShootControlPlaneHealthy, Last24Hours: pointer.String("foo")},
+					},
+				}},
+			})).To(Succeed())
+
+			_, err := availability.Compute(ctx)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing availability of condition ControlPlaneHealthy")))
+		})
+	})
+})
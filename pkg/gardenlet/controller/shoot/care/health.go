@@ -201,7 +201,7 @@ func (h *Health) Check(
 }
 
 func (h *Health) getAllExtensionConditions(ctx context.Context) ([]healthchecker.ExtensionCondition, []healthchecker.ExtensionCondition, []healthchecker.ExtensionCondition, error) {
-	objs, err := h.retrieveExtensions(ctx)
+	objs, err := retrieveExtensions(ctx, h.seedClient.Client(), h.shoot)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -276,7 +276,8 @@ func (h *Health) getAllExtensionConditions(ctx context.Context) ([]healthchecker
 	return conditionsControlPlaneHealthy, conditionsEveryNodeReady, conditionsSystemComponentsHealthy, nil
 }
 
-func (h *Health) retrieveExtensions(ctx context.Context) ([]runtime.Object, error) {
+// retrieveExtensions returns all extension resources of the given Shoot in the seed.
+func retrieveExtensions(ctx context.Context, seedClient client.Client, shoot *shoot.Shoot) ([]runtime.Object, error) {
 	var (
 		allExtensions       []runtime.Object
 		extensionObjectList = []client.ObjectList{
@@ -284,7 +285,7 @@ func (h *Health) retrieveExtensions(ctx context.Context) ([]runtime.Object, erro
 		}
 	)
 
-	if !h.shoot.IsWorkerless {
+	if !shoot.IsWorkerless {
 		extensionObjectList = append(extensionObjectList,
 			&extensionsv1alpha1.ContainerRuntimeList{},
 			&extensionsv1alpha1.ControlPlaneList{},
@@ -296,7 +297,7 @@ func (h *Health) retrieveExtensions(ctx context.Context) ([]runtime.Object, erro
 	}
 
 	for _, listObj := range extensionObjectList {
-		if err := h.seedClient.Client().List(ctx, listObj, client.InNamespace(h.shoot.SeedNamespace)); err != nil {
+		if err := seedClient.List(ctx, listObj, client.InNamespace(shoot.SeedNamespace)); err != nil {
 			return nil, err
 		}
 
@@ -311,7 +312,7 @@ func (h *Health) retrieveExtensions(ctx context.Context) ([]runtime.Object, erro
 	// Get BackupEntries separately as they are not namespaced i.e., they cannot be narrowed down
 	// to a shoot namespace like other extension resources above.
 	be := &extensionsv1alpha1.BackupEntry{}
-	if err := h.seedClient.Client().Get(ctx, kubernetesutils.Key(h.shoot.BackupEntryName), be); err == nil {
+	if err := seedClient.Get(ctx, kubernetesutils.Key(shoot.BackupEntryName), be); err == nil {
 		allExtensions = append(allExtensions, be)
 	} else if !apierrors.IsNotFound(err) {
 		return nil, err
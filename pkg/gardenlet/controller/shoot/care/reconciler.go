@@ -21,6 +21,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
@@ -47,6 +48,8 @@ var (
 	NewHealthCheck = defaultNewHealthCheck
 	// NewConstraintCheck is used to create a new Constraint check instance.
 	NewConstraintCheck = defaultNewConstraintCheck
+	// NewAvailabilityCheck is used to create a new Availability check instance.
+	NewAvailabilityCheck = defaultNewAvailabilityCheck
 	// NewGarbageCollector is used to create a new garbage collection instance.
 	NewGarbageCollector = defaultNewGarbageCollector
 	// NewWebhookRemediator is used to create a new webhook remediation instance.
@@ -138,6 +141,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		staleExtensionHealthCheckThreshold    = gardenlethelper.StaleExtensionHealthChecksThreshold(r.Config.Controllers.ShootCare.StaleExtensionHealthChecks)
 		initializeShootClients                = shootClientInitializer(careCtx, o)
 		updatedConditions, updatedConstraints []gardencorev1beta1.Condition
+		updatedAvailability                   = shoot.Status.Availability
 	)
 
 	if err := flow.Parallel(
@@ -173,6 +177,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			)
 			return nil
 		},
+		// Compute availability
+		func(ctx context.Context) error {
+			availability, err := NewAvailabilityCheck(log, o.Shoot, r.SeedClientSet.Client()).Compute(ctx)
+			if err != nil {
+				// errors during availability computation are only being logged, the previous availability is kept
+				log.Error(err, "Failed computing availability")
+				return nil
+			}
+			updatedAvailability = availability
+			return nil
+		},
 		// Trigger garbage collection
 		func(ctx context.Context) error {
 			NewGarbageCollector(o, initializeShootClients).Collect(ctx)
@@ -191,16 +206,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, err
 	}
 
-	// Update Shoot status (conditions, constraints) if necessary
+	// Update Shoot status (conditions, constraints, availability) if necessary
 	if v1beta1helper.ConditionsNeedUpdate(shootConditions.ConvertToSlice(), updatedConditions) ||
-		v1beta1helper.ConditionsNeedUpdate(shootConstraints.ConvertToSlice(), updatedConstraints) {
-		log.V(1).Info("Updating status conditions and constraints")
+		v1beta1helper.ConditionsNeedUpdate(shootConstraints.ConvertToSlice(), updatedConstraints) ||
+		!apiequality.Semantic.DeepEqual(shoot.Status.Availability, updatedAvailability) {
+		log.V(1).Info("Updating status conditions, constraints and availability")
 		// Rebuild shoot conditions and constraints to ensure that only the conditions and constraints with the
 		// correct types will be updated, and any other conditions will remain intact
 		conditions := v1beta1helper.BuildConditions(shoot.Status.Conditions, updatedConditions, shootConditions.ConditionTypes())
 		constraints := v1beta1helper.BuildConditions(shoot.Status.Constraints, updatedConstraints, shootConstraints.ConstraintTypes())
 
-		if err := r.patchStatus(ctx, shoot, conditions, constraints); err != nil {
+		if err := r.patchStatus(ctx, shoot, conditions, constraints, updatedAvailability); err != nil {
 			log.Error(err, "Error when trying to update the shoot status")
 			return reconcile.Result{}, err
 		}
@@ -217,10 +233,11 @@ func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1b
 	return out
 }
 
-func (r *Reconciler) patchStatus(ctx context.Context, shoot *gardencorev1beta1.Shoot, conditions, constraints []gardencorev1beta1.Condition) error {
+func (r *Reconciler) patchStatus(ctx context.Context, shoot *gardencorev1beta1.Shoot, conditions, constraints []gardencorev1beta1.Condition, availability *gardencorev1beta1.ShootAvailability) error {
 	patch := client.StrategicMergeFrom(shoot.DeepCopy())
 	shoot.Status.Conditions = conditions
 	shoot.Status.Constraints = constraints
+	shoot.Status.Availability = availability
 	return r.GardenClient.Status().Patch(ctx, shoot, patch)
 }
 
@@ -239,7 +256,7 @@ func (r *Reconciler) patchStatusToUnknown(ctx context.Context, shoot *gardencore
 		return nil
 	}
 
-	return r.patchStatus(ctx, shoot, updatedConditions, updatedConstraints)
+	return r.patchStatus(ctx, shoot, updatedConditions, updatedConstraints, shoot.Status.Availability)
 }
 
 func shootClientInitializer(ctx context.Context, o *operation.Operation) func() (kubernetes.Interface, bool, error) {
@@ -28,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -199,11 +200,18 @@ var _ = Describe("Shoot Care Control", func() {
 
 		Context("when health check setup is successful", func() {
 			var (
-				shootClientMap clientmap.ClientMap
-				managedSeed    *seedmanagementv1alpha1.ManagedSeed
-				operationFunc  NewOperationFunc
+				shootClientMap  clientmap.ClientMap
+				managedSeed     *seedmanagementv1alpha1.ManagedSeed
+				operationFunc   NewOperationFunc
+				availability    *gardencorev1beta1.ShootAvailability
+				availabilityErr error
 			)
 
+			BeforeEach(func() {
+				availability = nil
+				availabilityErr = nil
+			})
+
 			JustBeforeEach(func() {
 				shootClientMap = fakeclientmap.NewClientMapBuilder().Build()
 
@@ -220,6 +228,7 @@ var _ = Describe("Shoot Care Control", func() {
 				DeferCleanup(test.WithVars(
 					&NewOperation, operationFunc,
 					&NewGarbageCollector, nopGarbageCollectorFunc(),
+					&NewAvailabilityCheck, availabilityCheckFunc(func() (*gardencorev1beta1.ShootAvailability, error) { return availability, availabilityErr }),
 				))
 				reconciler = &Reconciler{
 					GardenClient:   gardenClient,
@@ -235,6 +244,40 @@ var _ = Describe("Shoot Care Control", func() {
 				shoot = nil
 			})
 
+			Context("when an availability is computed", func() {
+				BeforeEach(func() {
+					availability = &gardencorev1beta1.ShootAvailability{
+						Last24Hours: pointer.String("99.50"),
+						Last7Days:   pointer.String("99.90"),
+					}
+
+					DeferCleanup(test.WithVars(
+						&NewHealthCheck, healthCheckFunc(func(_ ShootConditions) []gardencorev1beta1.Condition { return nil }),
+						&NewConstraintCheck, constraintCheckFunc(func(_ ShootConstraints) []gardencorev1beta1.Condition { return nil }),
+					))
+				})
+
+				It("should set the availability", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Availability).To(Equal(availability))
+				})
+
+				It("should keep the previous availability if it cannot be computed", func() {
+					shoot.Status.Availability = availability
+					Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+					availabilityErr = errors.New("fake")
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Availability).To(Equal(availability))
+				})
+			})
+
 			Context("when no conditions / constraints are returned", func() {
 				BeforeEach(func() {
 					DeferCleanup(test.WithVars(
@@ -550,6 +593,22 @@ func constraintCheckFunc(fn resultingConstraintFunc) NewConstraintCheckFunc {
 	}
 }
 
+type resultingAvailabilityFunc func() (*gardencorev1beta1.ShootAvailability, error)
+
+func (a resultingAvailabilityFunc) Compute(_ context.Context) (*gardencorev1beta1.ShootAvailability, error) {
+	return a()
+}
+
+func availabilityCheckFunc(fn resultingAvailabilityFunc) NewAvailabilityCheckFunc {
+	return func(
+		log logr.Logger,
+		shoot *shootpkg.Shoot,
+		seedClient client.Client,
+	) AvailabilityCheck {
+		return fn
+	}
+}
+
 func opFunc(op *operation.Operation, err error) NewOperationFunc {
 	return func(
 		ctx context.Context,
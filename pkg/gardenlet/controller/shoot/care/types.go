@@ -103,6 +103,27 @@ var defaultNewConstraintCheck = func(
 	)
 }
 
+// AvailabilityCheck is an interface used to compute the availability of a Shoot.
+type AvailabilityCheck interface {
+	Compute(ctx context.Context) (*gardencorev1beta1.ShootAvailability, error)
+}
+
+// NewAvailabilityCheckFunc is a function used to create a new instance for computing the availability.
+type NewAvailabilityCheckFunc func(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+) AvailabilityCheck
+
+// defaultNewAvailabilityCheck is the default function to create a new instance for computing the availability.
+var defaultNewAvailabilityCheck NewAvailabilityCheckFunc = func(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+) AvailabilityCheck {
+	return NewAvailability(log, shoot, seedClient)
+}
+
 // GarbageCollector is an interface used to perform garbage collection.
 type GarbageCollector interface {
 	Collect(ctx context.Context)
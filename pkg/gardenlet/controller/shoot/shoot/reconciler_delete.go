@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -137,6 +138,14 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			controlPlaneDeploymentNeeded, err = needsControlPlaneDeployment(ctx, o, kubeAPIServerDeploymentFound, infrastructure)
 			return err
 		}),
+		// Pre-deletion hooks are only executed as long as the control plane of the shoot cluster still exists since they
+		// typically need to access it.
+		errors.ToExecute("Execute pre-deletion hooks", func() error {
+			if !kubeAPIServerDeploymentFound || botanist.SeedNamespaceObject.UID == "" || botanist.SeedNamespaceObject.Status.Phase == corev1.NamespaceTerminating {
+				return nil
+			}
+			return botanist.ExecuteHooks(ctx, gardencorev1beta1.HookPointPreDeletion)
+		}),
 	)
 
 	if err != nil {
@@ -56,6 +56,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		botanist                *botanistpkg.Botanist
 		err                     error
 		isCopyOfBackupsRequired bool
+		isHibernationTransition bool
+		isKubernetesUpgrade     bool
 		tasksWithErrors         []string
 
 		isRestoring   = operationType == gardencorev1beta1.LastOperationTypeRestore
@@ -92,6 +94,26 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			isCopyOfBackupsRequired, err = botanist.IsCopyOfBackupsRequired(ctx)
 			return err
 		}),
+		errors.ToExecute("Check if Kubernetes version is upgraded", func() error {
+			isHibernationTransition = botanist.IsHibernationTransition()
+			isKubernetesUpgrade, err = botanist.IsKubernetesUpgrade(ctx)
+			if err != nil || !isKubernetesUpgrade {
+				return err
+			}
+			return botanist.RequirePostKubernetesUpgradeHooks(ctx)
+		}),
+		errors.ToExecute("Execute pre-hibernation hooks", func() error {
+			if !isHibernationTransition {
+				return nil
+			}
+			return botanist.ExecuteHooks(ctx, gardencorev1beta1.HookPointPreHibernation)
+		}),
+		errors.ToExecute("Execute pre-Kubernetes-upgrade hooks", func() error {
+			if !isKubernetesUpgrade {
+				return nil
+			}
+			return botanist.ExecuteHooks(ctx, gardencorev1beta1.HookPointPreKubernetesUpgrade)
+		}),
 	)
 	if err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
@@ -104,6 +126,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 
 	var (
 		allowBackup                     = o.Seed.GetInfo().Spec.Backup != nil
+		isCloning                       = botanist.IsClonePhase()
 		staticNodesCIDR                 = o.Shoot.GetInfo().Spec.Networking != nil && o.Shoot.GetInfo().Spec.Networking.Nodes != nil
 		useDNS                          = botanist.ShootUsesDNS()
 		generation                      = o.Shoot.GetInfo().Generation
@@ -228,12 +251,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		deploySourceBackupEntry = g.Add(flow.Task{
 			Name:   "Deploying source backup entry",
 			Fn:     botanist.DeploySourceBackupEntry,
-			SkipIf: !isCopyOfBackupsRequired,
+			SkipIf: !isCopyOfBackupsRequired || !isRestoring,
 		})
 		waitUntilSourceBackupEntryInGardenReconciled = g.Add(flow.Task{
 			Name:         "Waiting until the source backup entry has been reconciled",
 			Fn:           botanist.Shoot.Components.SourceBackupEntry.Wait,
-			SkipIf:       skipReadiness || !isCopyOfBackupsRequired,
+			SkipIf:       skipReadiness || !isCopyOfBackupsRequired || !isRestoring,
 			Dependencies: flow.NewTaskIDs(deploySourceBackupEntry),
 		})
 		deployBackupEntryInGarden = g.Add(flow.Task{
@@ -334,20 +357,6 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       v1beta1helper.GetShootServiceAccountKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) != gardencorev1beta1.RotationPreparing,
 			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady, waitUntilGardenerResourceManagerReady),
 		})
-		deployControlPlane = g.Add(flow.Task{
-			Name:         "Deploying shoot control plane components",
-			Fn:           flow.TaskFn(botanist.DeployControlPlane).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			SkipIf:       o.Shoot.IsWorkerless,
-			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady, waitUntilGardenerResourceManagerReady),
-		})
-		waitUntilControlPlaneReady = g.Add(flow.Task{
-			Name: "Waiting until shoot control plane has been reconciled",
-			Fn: flow.TaskFn(func(ctx context.Context) error {
-				return botanist.Shoot.Components.Extensions.ControlPlane.Wait(ctx)
-			}),
-			SkipIf:       o.Shoot.IsWorkerless || skipReadiness,
-			Dependencies: flow.NewTaskIDs(deployControlPlane),
-		})
 		deploySeedLogging = g.Add(flow.Task{
 			Name:         "Deploying shoot logging stack in Seed",
 			Fn:           flow.TaskFn(botanist.DeployLogging).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -410,6 +419,26 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Fn:           flow.TaskFn(botanist.InitializeDesiredShootClients).RetryUntilTimeout(defaultInterval, 2*time.Minute),
 			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady, waitUntilControlPlaneExposureReady, waitUntilControlPlaneExposureDeleted, deployInternalDomainDNSRecord, deployGardenerAccess),
 		})
+		resetNodeRelatedStateOfClone = g.Add(flow.Task{
+			Name:         "Resetting node-related state of cloned shoot",
+			Fn:           flow.TaskFn(botanist.ResetNodeRelatedStateOfClone).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       !isCloning || o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(initializeShootClients),
+		})
+		deployControlPlane = g.Add(flow.Task{
+			Name:         "Deploying shoot control plane components",
+			Fn:           flow.TaskFn(botanist.DeployControlPlane).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless,
+			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady, waitUntilGardenerResourceManagerReady).InsertIf(isCloning, resetNodeRelatedStateOfClone),
+		})
+		waitUntilControlPlaneReady = g.Add(flow.Task{
+			Name: "Waiting until shoot control plane has been reconciled",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.Shoot.Components.Extensions.ControlPlane.Wait(ctx)
+			}),
+			SkipIf:       o.Shoot.IsWorkerless || skipReadiness,
+			Dependencies: flow.NewTaskIDs(deployControlPlane),
+		})
 		rewriteResourcesAddLabel = g.Add(flow.Task{
 			Name: "Labeling resources after modification of encryption config or to encrypt them with new ETCD encryption key",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
@@ -478,7 +507,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		deployKubeControllerManager = g.Add(flow.Task{
 			Name:         "Deploying Kubernetes controller manager",
 			Fn:           flow.TaskFn(botanist.DeployKubeControllerManager).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilKubeAPIServerIsReady),
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilKubeAPIServerIsReady).InsertIf(isCloning, resetNodeRelatedStateOfClone),
 		})
 		waitUntilKubeControllerManagerReady = g.Add(flow.Task{
 			Name:         "Waiting until kube-controller-manager reports readiness",
@@ -955,6 +984,20 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		}
 	}
 
+	if isHibernationTransition {
+		if err := botanist.ExecuteHooks(ctx, gardencorev1beta1.HookPointPostHibernation); err != nil {
+			err = fmt.Errorf("failed to execute post-hibernation hooks: %w", err)
+			return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
+		}
+	}
+
+	if botanist.ArePostKubernetesUpgradeHooksPending() {
+		if err := botanist.ExecutePostKubernetesUpgradeHooks(ctx); err != nil {
+			err = fmt.Errorf("failed to execute post-Kubernetes-upgrade hooks: %w", err)
+			return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
+		}
+	}
+
 	if _, ok := o.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootSkipReadiness]; ok {
 		o.Logger.Info("Removing skip-readiness annotation")
 
@@ -0,0 +1,149 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "shoot-webhooks"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, seedCluster cluster.Cluster) error {
+	if r.SeedClient == nil {
+		r.SeedClient = seedCluster.GetClient()
+	}
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{MaxConcurrentReconciles: *r.Config.ConcurrentSyncs}).
+		WatchesRawSource(
+			source.Kind(seedCluster.GetCache(), &corev1.Namespace{}),
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(r.IsShootNamespace(), r.NamespaceLabelsChanged()),
+		).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Watch(
+		source.Kind(seedCluster.GetCache(), &extensionsv1alpha1.Cluster{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapClusterToNamespace), mapper.UpdateWithNew, c.GetLogger()),
+		r.ClusterPredicate(),
+	); err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Kind(seedCluster.GetCache(), &corev1.ConfigMap{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapDeclarationToAllShootNamespaces), mapper.UpdateWithNew, c.GetLogger()),
+		r.IsWebhookDeclaration(),
+	)
+}
+
+// IsShootNamespace returns a predicate which evaluates to true in case the gardener.cloud/role=shoot label is present.
+func (r *Reconciler) IsShootNamespace() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetLabels()[v1beta1constants.GardenRole] == v1beta1constants.GardenRoleShoot
+	})
+}
+
+// NamespaceLabelsChanged returns a predicate which returns true for create events, and for update events in case the
+// labels of the namespace have changed.
+func (r *Reconciler) NamespaceLabelsChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !reflect.DeepEqual(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// ClusterPredicate returns a predicate which returns true for create events, and for update events in case the
+// deletion timestamp of the shoot stored in the Cluster resource has changed.
+func (r *Reconciler) ClusterPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			cluster, ok := e.ObjectNew.(*extensionsv1alpha1.Cluster)
+			if !ok {
+				return false
+			}
+
+			oldCluster, ok := e.ObjectOld.(*extensionsv1alpha1.Cluster)
+			if !ok {
+				return false
+			}
+
+			return shootDeletionRequested(oldCluster) != shootDeletionRequested(cluster)
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// IsWebhookDeclaration returns a predicate which evaluates to true in case the
+// extensions.gardener.cloud/shoot-webhooks=true label is present.
+func (r *Reconciler) IsWebhookDeclaration() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetLabels()[v1beta1constants.LabelShootWebhooks] == "true"
+	})
+}
+
+// MapClusterToNamespace is a mapper.MapFunc for mapping a Cluster to the shoot namespace with the same name.
+func (r *Reconciler) MapClusterToNamespace(_ context.Context, _ logr.Logger, _ client.Reader, obj client.Object) []reconcile.Request {
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: obj.GetName()}}}
+}
+
+// MapDeclarationToAllShootNamespaces is a mapper.MapFunc for mapping a shoot webhook declaration to all shoot
+// namespaces in the seed cluster.
+func (r *Reconciler) MapDeclarationToAllShootNamespaces(ctx context.Context, log logr.Logger, reader client.Reader, _ client.Object) []reconcile.Request {
+	namespaceList := &corev1.NamespaceList{}
+	if err := reader.List(ctx, namespaceList, client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}); err != nil {
+		log.Error(fmt.Errorf("failed listing shoot namespaces: %w", err), "Could not map shoot webhook declaration")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(namespaceList.Items))
+	for _, namespace := range namespaceList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: namespace.Name}})
+	}
+	return requests
+}
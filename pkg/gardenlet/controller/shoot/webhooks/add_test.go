@@ -0,0 +1,161 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhooks_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/webhooks"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+	})
+
+	Describe("#IsShootNamespace", func() {
+		It("should return true for shoot namespaces", func() {
+			Expect(reconciler.IsShootNamespace().Generic(event.GenericEvent{Object: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"gardener.cloud/role": "shoot"}}}})).To(BeTrue())
+		})
+
+		It("should return false for other namespaces", func() {
+			Expect(reconciler.IsShootNamespace().Generic(event.GenericEvent{Object: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"gardener.cloud/role": "extension"}}}})).To(BeFalse())
+		})
+	})
+
+	Describe("#NamespaceLabelsChanged", func() {
+		var (
+			p         predicate.Predicate
+			namespace *corev1.Namespace
+		)
+
+		BeforeEach(func() {
+			p = reconciler.NamespaceLabelsChanged()
+			namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"foo": "bar"}}}
+		})
+
+		It("should return true for create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: namespace})).To(BeTrue())
+		})
+
+		It("should return false when labels are unchanged", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: namespace, ObjectNew: namespace})).To(BeFalse())
+		})
+
+		It("should return true when labels changed", func() {
+			newNamespace := namespace.DeepCopy()
+			newNamespace.Labels["foo"] = "baz"
+			Expect(p.Update(event.UpdateEvent{ObjectOld: namespace, ObjectNew: newNamespace})).To(BeTrue())
+		})
+
+		It("should return false for delete and generic events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: namespace})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: namespace})).To(BeFalse())
+		})
+	})
+
+	Describe("#ClusterPredicate", func() {
+		var (
+			p       predicate.Predicate
+			cluster *extensionsv1alpha1.Cluster
+		)
+
+		BeforeEach(func() {
+			p = reconciler.ClusterPredicate()
+			cluster = &extensionsv1alpha1.Cluster{Spec: extensionsv1alpha1.ClusterSpec{Shoot: runtime.RawExtension{Raw: encode(&gardencorev1beta1.Shoot{})}}}
+		})
+
+		It("should return true for create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: cluster})).To(BeTrue())
+		})
+
+		It("should return false because new object is no cluster", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: cluster})).To(BeFalse())
+		})
+
+		It("should return false because old object is no cluster", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectNew: cluster})).To(BeFalse())
+		})
+
+		It("should return false because shoot deletion state did not change", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: cluster, ObjectNew: cluster})).To(BeFalse())
+		})
+
+		It("should return true because shoot deletion was requested", func() {
+			newCluster := cluster.DeepCopy()
+			now := metav1.Now()
+			newCluster.Spec.Shoot.Raw = encode(&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now}})
+			Expect(p.Update(event.UpdateEvent{ObjectOld: cluster, ObjectNew: newCluster})).To(BeTrue())
+		})
+	})
+
+	Describe("#IsWebhookDeclaration", func() {
+		It("should return true for labeled config maps", func() {
+			Expect(reconciler.IsWebhookDeclaration().Generic(event.GenericEvent{Object: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"extensions.gardener.cloud/shoot-webhooks": "true"}}}})).To(BeTrue())
+		})
+
+		It("should return false for other config maps", func() {
+			Expect(reconciler.IsWebhookDeclaration().Generic(event.GenericEvent{Object: &corev1.ConfigMap{}})).To(BeFalse())
+		})
+	})
+
+	Describe("#MapClusterToNamespace", func() {
+		It("should map to the namespace with the same name", func() {
+			Expect(reconciler.MapClusterToNamespace(context.Background(), logr.Discard(), nil, &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"}})).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "shoot--foo--bar"}},
+			))
+		})
+	})
+
+	Describe("#MapDeclarationToAllShootNamespaces", func() {
+		It("should map to all shoot namespaces", func() {
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar", Labels: map[string]string{"gardener.cloud/role": "shoot"}}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--baz", Labels: map[string]string{"gardener.cloud/role": "shoot"}}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "garden"}},
+			).Build()
+
+			Expect(reconciler.MapDeclarationToAllShootNamespaces(context.Background(), logr.Discard(), fakeClient, &corev1.ConfigMap{})).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "shoot--foo--bar"}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "shoot--foo--baz"}},
+			))
+		})
+	})
+})
+
+func encode(shoot *gardencorev1beta1.Shoot) []byte {
+	shoot.TypeMeta = metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"}
+	data, err := json.Marshal(shoot)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return data
+}
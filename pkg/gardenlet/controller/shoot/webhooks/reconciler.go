@@ -0,0 +1,263 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/go-multierror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+// ManagedResourceNamePrefix is the prefix of the names of the ManagedResources deploying the webhook configurations
+// declared by extensions into the shoot clusters.
+const ManagedResourceNamePrefix = "shoot-webhooks-"
+
+// Reconciler deploys the admission webhook configurations declared by extensions into all matching shoot clusters.
+type Reconciler struct {
+	SeedClient client.Client
+	Config     config.ShootWebhooksControllerConfiguration
+}
+
+// Reconcile reads the shoot webhook declarations of all extensions and deploys the webhook configurations into the
+// shoot cluster belonging to the reconciled namespace. Webhooks which are no longer declared are removed.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	namespace := &corev1.Namespace{}
+	if err := r.SeedClient.Get(ctx, request.NamespacedName, namespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if namespace.DeletionTimestamp != nil || namespace.Labels[v1beta1constants.GardenRole] != v1beta1constants.GardenRoleShoot {
+		return reconcile.Result{}, nil
+	}
+
+	cluster := &extensionsv1alpha1.Cluster{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: namespace.Name}, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Cluster does not exist yet, skipping")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed reading Cluster %s: %w", namespace.Name, err)
+	}
+
+	if shootDeletionRequested(cluster) {
+		// The ManagedResources are cleaned up as part of the shoot deletion flow.
+		log.V(1).Info("Shoot is being deleted, skipping")
+		return reconcile.Result{}, nil
+	}
+
+	declarations, err := r.declarations(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var (
+		result  error
+		desired = sets.New[string]()
+	)
+
+	for _, declaration := range declarations {
+		managedResourceName := ManagedResourceName(declaration)
+
+		selector, err := NamespaceSelector(declaration)
+		if err != nil {
+			// Keep what was deployed last time until the declaration is fixed.
+			desired.Insert(managedResourceName)
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		if !selector.Matches(labels.Set(namespace.Labels)) {
+			continue
+		}
+		desired.Insert(managedResourceName)
+
+		data, err := WebhookConfigurations(declaration)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		log.V(1).Info("Deploying shoot webhooks", "declaration", client.ObjectKeyFromObject(declaration), "managedResourceName", managedResourceName)
+		if err := managedresources.Create(
+			ctx,
+			r.SeedClient,
+			namespace.Name,
+			managedResourceName,
+			map[string]string{
+				managedresources.LabelKeyOrigin:     managedresources.LabelValueGardener,
+				v1beta1constants.LabelShootWebhooks: "true",
+			},
+			true,
+			"",
+			data,
+			pointer.Bool(false),
+			map[string]string{v1beta1constants.ShootNoCleanup: "true"},
+			nil,
+		); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed deploying shoot webhooks of %s: %w", client.ObjectKeyFromObject(declaration), err))
+		}
+	}
+
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := r.SeedClient.List(ctx, managedResourceList, client.InNamespace(namespace.Name), client.MatchingLabels{v1beta1constants.LabelShootWebhooks: "true"}); err != nil {
+		return reconcile.Result{}, multierror.Append(result, fmt.Errorf("failed listing shoot webhook ManagedResources: %w", err))
+	}
+
+	for _, managedResource := range managedResourceList.Items {
+		if desired.Has(managedResource.Name) {
+			continue
+		}
+
+		log.Info("Deleting shoot webhooks which are no longer declared", "managedResourceName", managedResource.Name)
+		if err := managedresources.Delete(ctx, r.SeedClient, namespace.Name, managedResource.Name, true); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed deleting ManagedResource %s: %w", client.ObjectKeyFromObject(&managedResource), err))
+		}
+	}
+
+	return reconcile.Result{}, result
+}
+
+// declarations returns all shoot webhook declarations which are not being deleted and which are located in extension
+// namespaces.
+func (r *Reconciler) declarations(ctx context.Context) ([]*corev1.ConfigMap, error) {
+	configMapList := &corev1.ConfigMapList{}
+	if err := r.SeedClient.List(ctx, configMapList, client.MatchingLabels{v1beta1constants.LabelShootWebhooks: "true"}); err != nil {
+		return nil, fmt.Errorf("failed listing shoot webhook declarations: %w", err)
+	}
+
+	isExtensionNamespace := map[string]bool{}
+
+	var declarations []*corev1.ConfigMap
+	for i := range configMapList.Items {
+		configMap := &configMapList.Items[i]
+		if configMap.DeletionTimestamp != nil {
+			continue
+		}
+
+		ok, checked := isExtensionNamespace[configMap.Namespace]
+		if !checked {
+			namespace := &corev1.Namespace{}
+			if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: configMap.Namespace}, namespace); err != nil {
+				return nil, fmt.Errorf("failed reading namespace %s: %w", configMap.Namespace, err)
+			}
+			ok = namespace.Labels[v1beta1constants.GardenRole] == v1beta1constants.GardenRoleExtension
+			isExtensionNamespace[configMap.Namespace] = ok
+		}
+
+		if ok {
+			declarations = append(declarations, configMap)
+		}
+	}
+
+	return declarations, nil
+}
+
+// ManagedResourceName returns the name of the ManagedResource deploying the webhooks of the given declaration.
+func ManagedResourceName(declaration *corev1.ConfigMap) string {
+	return ManagedResourceNamePrefix + declaration.Namespace + "-" + declaration.Name
+}
+
+// NamespaceSelector returns the selector for the shoot namespaces the webhooks of the given declaration shall be
+// deployed for. Without the extensions.gardener.cloud/shoot-namespace-selector annotation, all shoots are selected.
+func NamespaceSelector(declaration *corev1.ConfigMap) (labels.Selector, error) {
+	value, ok := declaration.Annotations[v1beta1constants.AnnotationShootWebhooksNamespaceSelector]
+	if !ok {
+		return labels.Everything(), nil
+	}
+
+	var matchLabels map[string]string
+	if err := json.Unmarshal([]byte(value), &matchLabels); err != nil {
+		return nil, fmt.Errorf("failed parsing annotation %s of %s: %w", v1beta1constants.AnnotationShootWebhooksNamespaceSelector, client.ObjectKeyFromObject(declaration), err)
+	}
+
+	selector, err := labels.ValidatedSelectorFromSet(matchLabels)
+	if err != nil {
+		return nil, fmt.Errorf("invalid annotation %s of %s: %w", v1beta1constants.AnnotationShootWebhooksNamespaceSelector, client.ObjectKeyFromObject(declaration), err)
+	}
+	return selector, nil
+}
+
+// WebhookConfigurations returns the data of the given declaration after verifying that it only contains
+// MutatingWebhookConfigurations and ValidatingWebhookConfigurations.
+func WebhookConfigurations(declaration *corev1.ConfigMap) (map[string][]byte, error) {
+	data := make(map[string][]byte, len(declaration.Data))
+
+	for key, value := range declaration.Data {
+		decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(value), 1024)
+
+		for {
+			obj := &unstructured.Unstructured{}
+			if err := decoder.Decode(&obj.Object); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return nil, fmt.Errorf("failed decoding key %q of %s: %w", key, client.ObjectKeyFromObject(declaration), err)
+			}
+
+			if obj.Object == nil {
+				continue
+			}
+
+			if gvk := obj.GroupVersionKind(); gvk.Group != "admissionregistration.k8s.io" ||
+				(gvk.Kind != "MutatingWebhookConfiguration" && gvk.Kind != "ValidatingWebhookConfiguration") {
+				return nil, fmt.Errorf("key %q of %s contains unsupported object %s %q, only webhook configurations are allowed", key, client.ObjectKeyFromObject(declaration), gvk, obj.GetName())
+			}
+		}
+
+		data[key] = []byte(value)
+	}
+
+	return data, nil
+}
+
+func shootDeletionRequested(cluster *extensionsv1alpha1.Cluster) bool {
+	shoot, err := extensions.ShootFromCluster(cluster)
+	if err != nil || shoot == nil {
+		return false
+	}
+	return shoot.DeletionTimestamp != nil
+}
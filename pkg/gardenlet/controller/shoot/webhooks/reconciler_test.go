@@ -0,0 +1,225 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhooks_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/webhooks"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		shootNamespaceName     = "shoot--foo--bar"
+		extensionNamespaceName = "extension-provider-foo"
+
+		webhookConfiguration = `apiVersion: admissionregistration.k8s.io/v1
+kind: MutatingWebhookConfiguration
+metadata:
+  name: gardener-extension-provider-foo-shoot
+webhooks:
+- name: foo.provider.extensions.gardener.cloud
+  clientConfig:
+    url: https://gardener-extension-provider-foo.extension-provider-foo:443/webhooks/foo
+    caBundle: Y2EtYnVuZGxl
+  admissionReviewVersions: ["v1"]
+  sideEffects: None
+`
+	)
+
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		reconciler *Reconciler
+		request    reconcile.Request
+
+		shootNamespace     *corev1.Namespace
+		extensionNamespace *corev1.Namespace
+		cluster            *extensionsv1alpha1.Cluster
+		declaration        *corev1.ConfigMap
+		managedResource    *resourcesv1alpha1.ManagedResource
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		reconciler = &Reconciler{
+			SeedClient: fakeClient,
+			Config:     config.ShootWebhooksControllerConfiguration{ConcurrentSyncs: pointer.Int(1)},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKey{Name: shootNamespaceName}}
+
+		shootNamespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: shootNamespaceName,
+			Labels: map[string]string{
+				"gardener.cloud/role":                    "shoot",
+				"provider.extensions.gardener.cloud/foo": "true",
+			},
+		}}
+		extensionNamespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   extensionNamespaceName,
+			Labels: map[string]string{"gardener.cloud/role": "extension"},
+		}}
+		cluster = &extensionsv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: shootNamespaceName},
+			Spec:       extensionsv1alpha1.ClusterSpec{Shoot: runtime.RawExtension{Raw: encode(&gardencorev1beta1.Shoot{})}},
+		}
+		declaration = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "shoot-webhooks",
+				Namespace:   extensionNamespaceName,
+				Labels:      map[string]string{"extensions.gardener.cloud/shoot-webhooks": "true"},
+				Annotations: map[string]string{"extensions.gardener.cloud/shoot-namespace-selector": `{"provider.extensions.gardener.cloud/foo":"true"}`},
+			},
+			Data: map[string]string{"webhooks.yaml": webhookConfiguration},
+		}
+		managedResource = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{
+			Name:      "shoot-webhooks-" + extensionNamespaceName + "-shoot-webhooks",
+			Namespace: shootNamespaceName,
+		}}
+
+		Expect(fakeClient.Create(ctx, shootNamespace)).To(Succeed())
+		Expect(fakeClient.Create(ctx, extensionNamespace)).To(Succeed())
+	})
+
+	It("should do nothing if the namespace is gone", func() {
+		Expect(fakeClient.Delete(ctx, shootNamespace)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the Cluster does not exist yet", func() {
+		Expect(fakeClient.Create(ctx, declaration)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+	})
+
+	Context("when the Cluster exists", func() {
+		BeforeEach(func() {
+			Expect(fakeClient.Create(ctx, cluster)).To(Succeed())
+		})
+
+		It("should deploy the declared webhooks", func() {
+			Expect(fakeClient.Create(ctx, declaration)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Labels).To(Equal(map[string]string{
+				"origin": "gardener",
+				"extensions.gardener.cloud/shoot-webhooks": "true",
+			}))
+			Expect(managedResource.Spec.Class).To(BeNil())
+			Expect(managedResource.Spec.InjectLabels).To(HaveKeyWithValue("shoot.gardener.cloud/no-cleanup", "true"))
+			Expect(managedResource.Spec.SecretRefs).To(HaveLen(1))
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: shootNamespaceName, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue("webhooks.yaml", []byte(webhookConfiguration)))
+		})
+
+		It("should update the deployed webhooks when the declaration changes", func() {
+			Expect(fakeClient.Create(ctx, declaration)).To(Succeed())
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			oldSecretName := managedResource.Spec.SecretRefs[0].Name
+
+			declaration.Data["webhooks.yaml"] = webhookConfiguration + "  timeoutSeconds: 5\n"
+			Expect(fakeClient.Update(ctx, declaration)).To(Succeed())
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Spec.SecretRefs[0].Name).NotTo(Equal(oldSecretName))
+		})
+
+		It("should not deploy webhooks if the namespace does not match the selector", func() {
+			delete(shootNamespace.Labels, "provider.extensions.gardener.cloud/foo")
+			Expect(fakeClient.Update(ctx, shootNamespace)).To(Succeed())
+			Expect(fakeClient.Create(ctx, declaration)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+
+		It("should ignore declarations outside of extension namespaces", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}})).To(Succeed())
+			declaration.Namespace = "other"
+			Expect(fakeClient.Create(ctx, declaration)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+			Expect(fakeClient.List(ctx, managedResourceList, client.InNamespace(shootNamespaceName))).To(Succeed())
+			Expect(managedResourceList.Items).To(BeEmpty())
+		})
+
+		It("should reject declarations containing other objects than webhook configurations", func() {
+			declaration.Data["other.yaml"] = "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: foo\n"
+			Expect(fakeClient.Create(ctx, declaration)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).To(MatchError(ContainSubstring("only webhook configurations are allowed")))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+
+		It("should delete webhooks which are no longer declared", func() {
+			Expect(fakeClient.Create(ctx, declaration)).To(Succeed())
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+
+			Expect(fakeClient.Delete(ctx, declaration)).To(Succeed())
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+
+		It("should keep deployed webhooks if the namespace selector of the declaration is invalid", func() {
+			Expect(fakeClient.Create(ctx, declaration)).To(Succeed())
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			declaration.Annotations["extensions.gardener.cloud/shoot-namespace-selector"] = "{"
+			Expect(fakeClient.Update(ctx, declaration)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing annotation")))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+		})
+
+		It("should do nothing if the shoot is being deleted", func() {
+			now := metav1.Now()
+			cluster.Spec.Shoot.Raw = encode(&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now}})
+			Expect(fakeClient.Update(ctx, cluster)).To(Succeed())
+			Expect(fakeClient.Create(ctx, declaration)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+	})
+})
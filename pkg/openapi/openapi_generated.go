@@ -75,7 +75,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistration":                     schema_pkg_apis_core_v1beta1_ControllerRegistration(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationDeployment":           schema_pkg_apis_core_v1beta1_ControllerRegistrationDeployment(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationList":                 schema_pkg_apis_core_v1beta1_ControllerRegistrationList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationRollout":              schema_pkg_apis_core_v1beta1_ControllerRegistrationRollout(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationRolloutStatus":        schema_pkg_apis_core_v1beta1_ControllerRegistrationRolloutStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationSpec":                 schema_pkg_apis_core_v1beta1_ControllerRegistrationSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationStatus":               schema_pkg_apis_core_v1beta1_ControllerRegistrationStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerResource":                         schema_pkg_apis_core_v1beta1_ControllerResource(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerResourceLifecycle":                schema_pkg_apis_core_v1beta1_ControllerResourceLifecycle(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNS":                                    schema_pkg_apis_core_v1beta1_CoreDNS(ref),
@@ -100,6 +103,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation":                                schema_pkg_apis_core_v1beta1_Hibernation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule":                        schema_pkg_apis_core_v1beta1_HibernationSchedule(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability":                           schema_pkg_apis_core_v1beta1_HighAvailability(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Hook":                                       schema_pkg_apis_core_v1beta1_Hook(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig":              schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Ingress":                                    schema_pkg_apis_core_v1beta1_Ingress(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.IngressController":                          schema_pkg_apis_core_v1beta1_IngressController(ref),
@@ -140,8 +144,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation":                      schema_pkg_apis_core_v1beta1_ObservabilityRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OpenIDConnectClientAuthentication":          schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Project":                                    schema_pkg_apis_core_v1beta1_Project(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectAccessReview":                        schema_pkg_apis_core_v1beta1_ProjectAccessReview(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectAccumulatedUsage":                    schema_pkg_apis_core_v1beta1_ProjectAccumulatedUsage(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectBastionSettings":                     schema_pkg_apis_core_v1beta1_ProjectBastionSettings(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectList":                                schema_pkg_apis_core_v1beta1_ProjectList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMachineImageRestriction":             schema_pkg_apis_core_v1beta1_ProjectMachineImageRestriction(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember":                              schema_pkg_apis_core_v1beta1_ProjectMember(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMemberAccessReview":                  schema_pkg_apis_core_v1beta1_ProjectMemberAccessReview(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMemberRoleExpiration":                schema_pkg_apis_core_v1beta1_ProjectMemberRoleExpiration(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectQuota":                               schema_pkg_apis_core_v1beta1_ProjectQuota(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectRestrictions":                        schema_pkg_apis_core_v1beta1_ProjectRestrictions(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectSpec":                                schema_pkg_apis_core_v1beta1_ProjectSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectStatus":                              schema_pkg_apis_core_v1beta1_ProjectStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations":                         schema_pkg_apis_core_v1beta1_ProjectTolerations(ref),
@@ -160,6 +172,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedBackup":                                 schema_pkg_apis_core_v1beta1_SeedBackup(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNS":                                    schema_pkg_apis_core_v1beta1_SeedDNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNSProvider":                            schema_pkg_apis_core_v1beta1_SeedDNSProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDrainStatus":                            schema_pkg_apis_core_v1beta1_SeedDrainStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedList":                                   schema_pkg_apis_core_v1beta1_SeedList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedNetworks":                               schema_pkg_apis_core_v1beta1_SeedNetworks(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedProvider":                               schema_pkg_apis_core_v1beta1_SeedProvider(ref),
@@ -172,6 +185,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices":            schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServices(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServicesZones":       schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServicesZones(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling":                      schema_pkg_apis_core_v1beta1_SeedSettingScheduling(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingSchedulingDrain":                 schema_pkg_apis_core_v1beta1_SeedSettingSchedulingDrain(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting":            schema_pkg_apis_core_v1beta1_SeedSettingTopologyAwareRouting(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler":           schema_pkg_apis_core_v1beta1_SeedSettingVerticalPodAutoscaler(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettings":                               schema_pkg_apis_core_v1beta1_SeedSettings(ref),
@@ -185,6 +199,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountKeyRotation":                  schema_pkg_apis_core_v1beta1_ServiceAccountKeyRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Shoot":                                      schema_pkg_apis_core_v1beta1_Shoot(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress":                     schema_pkg_apis_core_v1beta1_ShootAdvertisedAddress(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAvailability":                          schema_pkg_apis_core_v1beta1_ShootAvailability(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials":                           schema_pkg_apis_core_v1beta1_ShootCredentials(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation":                   schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootKubeconfigRotation":                    schema_pkg_apis_core_v1beta1_ShootKubeconfigRotation(ref),
@@ -192,6 +207,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMachineImage":                          schema_pkg_apis_core_v1beta1_ShootMachineImage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworks":                              schema_pkg_apis_core_v1beta1_ShootNetworks(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSSHKeypairRotation":                    schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSource":                                schema_pkg_apis_core_v1beta1_ShootSource(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec":                                  schema_pkg_apis_core_v1beta1_ShootSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootState":                                 schema_pkg_apis_core_v1beta1_ShootState(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateList":                             schema_pkg_apis_core_v1beta1_ShootStateList(ref),
@@ -209,8 +225,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents":                     schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkersSettings":                            schema_pkg_apis_core_v1beta1_WorkersSettings(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion":                             schema_pkg_apis_operations_v1alpha1_Bastion(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionApproval":                     schema_pkg_apis_operations_v1alpha1_BastionApproval(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy":                schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionList":                         schema_pkg_apis_operations_v1alpha1_BastionList(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSSHCertificate":               schema_pkg_apis_operations_v1alpha1_BastionSSHCertificate(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec":                         schema_pkg_apis_operations_v1alpha1_BastionSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus":                       schema_pkg_apis_operations_v1alpha1_BastionStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Gardenlet":                       schema_pkg_apis_seedmanagement_v1alpha1_Gardenlet(ref),
@@ -232,11 +250,26 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPreset":            schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPreset(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetList":        schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetList(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetSpec":        schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootDefaults":                  schema_pkg_apis_settings_v1alpha1_ClusterShootDefaults(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootDefaultsList":              schema_pkg_apis_settings_v1alpha1_ClusterShootDefaultsList(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootDefaultsSpec":              schema_pkg_apis_settings_v1alpha1_ClusterShootDefaultsSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootPolicy":                    schema_pkg_apis_settings_v1alpha1_ClusterShootPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootPolicyList":                schema_pkg_apis_settings_v1alpha1_ClusterShootPolicyList(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootPolicySpec":                schema_pkg_apis_settings_v1alpha1_ClusterShootPolicySpec(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect":            schema_pkg_apis_settings_v1alpha1_KubeAPIServerOpenIDConnect(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication":     schema_pkg_apis_settings_v1alpha1_OpenIDConnectClientAuthentication(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPreset":                   schema_pkg_apis_settings_v1alpha1_OpenIDConnectPreset(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetList":               schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetList(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetSpec":               schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaults":                         schema_pkg_apis_settings_v1alpha1_ShootDefaults(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaultsList":                     schema_pkg_apis_settings_v1alpha1_ShootDefaultsList(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaultsSpec":                     schema_pkg_apis_settings_v1alpha1_ShootDefaultsSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaultsTemplate":                 schema_pkg_apis_settings_v1alpha1_ShootDefaultsTemplate(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaultsWorker":                   schema_pkg_apis_settings_v1alpha1_ShootDefaultsWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicy":                           schema_pkg_apis_settings_v1alpha1_ShootPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicyList":                       schema_pkg_apis_settings_v1alpha1_ShootPolicyList(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicyRule":                       schema_pkg_apis_settings_v1alpha1_ShootPolicyRule(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicySpec":                       schema_pkg_apis_settings_v1alpha1_ShootPolicySpec(ref),
 		"k8s.io/api/autoscaling/v1.ContainerResourceMetricSource":                                       schema_k8sio_api_autoscaling_v1_ContainerResourceMetricSource(ref),
 		"k8s.io/api/autoscaling/v1.ContainerResourceMetricStatus":                                       schema_k8sio_api_autoscaling_v1_ContainerResourceMetricStatus(ref),
 		"k8s.io/api/autoscaling/v1.CrossVersionObjectReference":                                         schema_k8sio_api_autoscaling_v1_CrossVersionObjectReference(ref),
@@ -2310,11 +2343,18 @@ func schema_pkg_apis_core_v1beta1_ControllerRegistration(ref common.ReferenceCal
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationSpec"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status of this registration.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -2352,11 +2392,17 @@ func schema_pkg_apis_core_v1beta1_ControllerRegistrationDeployment(ref common.Re
 							},
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout configures how a switch to another `ControllerDeployment` is rolled out to the seeds. If not set, the referenced `ControllerDeployment` is rolled out to all seeds at once.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationRollout"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.DeploymentRef", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationRollout", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DeploymentRef", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -2411,6 +2457,111 @@ func schema_pkg_apis_core_v1beta1_ControllerRegistrationList(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ControllerRegistrationRollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerRegistrationRollout configures the progressive rollout of a `ControllerDeployment` to the seeds.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"canarySeedSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanarySeedSelector selects the seeds to which the `ControllerDeployment` is rolled out first. The rollout only continues with the other seeds once the controller is installed and healthy on all canary seeds.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of seeds (besides the canary seeds) on which the rolled out controller may not be installed and healthy at the same time. It defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ControllerRegistrationRolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerRegistrationRolloutStatus contains information about the progressive rollout of a `ControllerDeployment`.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deploymentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeploymentName is the name of the `ControllerDeployment` which is rolled out.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the rollout.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"seeds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Seeds is the number of seeds the controller is installed on.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"updatedSeeds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatedSeeds is the number of seeds on which the `ControllerInstallation` references the rolled out `ControllerDeployment`.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"availableSeeds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailableSeeds is the number of updated seeds on which the controller is installed and healthy.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failedSeeds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedSeeds is the list of updated seeds on which the controller is not valid, failed to install or is unhealthy. The rollout is paused as long as this list is not empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the last time the rollout status was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"deploymentName", "phase", "seeds", "updatedSeeds", "availableSeeds"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ControllerRegistrationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2446,6 +2597,27 @@ func schema_pkg_apis_core_v1beta1_ControllerRegistrationSpec(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ControllerRegistrationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerRegistrationStatus is the status of a ControllerRegistration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout contains information about the progressive rollout of the referenced `ControllerDeployment`.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationRolloutStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerRegistrationRolloutStatus"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ControllerResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3381,6 +3553,65 @@ func schema_pkg_apis_core_v1beta1_HighAvailability(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_Hook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Hook contains information about a hook which is executed at a well-defined point of the reconciliation or deletion flow of the Shoot. For every execution, an Extension resource of the hook's type is created in the shoot namespace in the seed, and the flow waits until it was reconciled successfully.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the hook. It must be unique within the Shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the Extension resource which implements the hook.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"point": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Point is the point in the flow at which the hook is executed. Possible values are PreHibernation, PostHibernation, PreKubernetesUpgrade, PostKubernetesUpgrade, and PreDeletion.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the configuration passed to the Extension resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the maximum duration to wait for the hook to succeed. Defaults to 10m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"failurePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailurePolicy defines how a failed or timed out hook is handled. Possible values are Fail (the flow fails) and Ignore (the flow continues). Defaults to Fail.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "type", "point"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5481,536 +5712,536 @@ func schema_pkg_apis_core_v1beta1_Project(ref common.ReferenceCallback) common.O
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ProjectAccessReview(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectList is a collection of Projects.",
+				Description: "ProjectAccessReview contains the result of a review of the access of the project members.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
+					"lastReviewTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
+							Description: "LastReviewTimestamp is the time when the access of the project members was last reviewed.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"items": {
+					"members": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Projects.",
+							Description: "Members contains the review results for the members of kind `User` and `ServiceAccount`.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Project"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMemberAccessReview"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"lastReviewTimestamp"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Project", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMemberAccessReview", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ProjectMember(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ProjectAccumulatedUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectMember is a member of a project.",
+				Description: "ProjectAccumulatedUsage contains the resources consumed by all Shoots of a project over time.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"since": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind of object being referenced. Values defined by this API group are \"User\", \"Group\", and \"ServiceAccount\". If the Authorizer does not recognized the kind value, the Authorizer should report an error.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Since is the time from which on the usage has been accumulated.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"apiGroup": {
+					"lastUpdateTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIGroup holds the API group of the referenced subject. Defaults to \"\" for ServiceAccount subjects. Defaults to \"rbac.authorization.k8s.io\" for User and Group subjects.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastUpdateTimestamp is the time until which the usage has been accumulated.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"name": {
+					"rates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the object being referenced.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Rates contains the resources consumed by all Shoots of the project at the time of the last update. Other than in `.status.usage`, only the minimum number of nodes of each worker pool is considered, i.e., the rates are a lower bound of the actual consumption. The worker nodes and load balancers of hibernated Shoots are not considered. Instead, the number of hibernated Shoots is reported as `hibernated-shoots`.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
-					"namespace": {
+					"hours": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace of the referenced object.  If the object kind is non-namespace, such as \"User\" or \"Group\", and this value is not empty the Authorizer should report an error.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Hours contains the resources accumulated over time in hours based on the `rates`, e.g., `cpu` contains the vCPU-hours, `memory` the memory-hours (in bytes) and `hibernated-shoots` the hours Shoots of the project have been hibernated. As the `rates`, the hours are a lower bound of the actual consumption.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
-					"role": {
+					"maximumRates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Role represents the role of this member. IMPORTANT: Be aware that this field will be removed in the `v1` version of this API in favor of the `roles` list.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaximumRates contains the same resources as `rates` but considers the maximum number of nodes of each worker pool, i.e., they are an upper bound of the actual consumption.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
-					"roles": {
+					"maximumHours": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Roles represents the list of roles of this member.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "MaximumHours contains the resources accumulated over time in hours based on the `maximumRates`, i.e., they are an upper bound of the actual consumption.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"kind", "name", "role"},
+				Required: []string{"since", "lastUpdateTimestamp"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ProjectBastionSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectSpec is the specification of a Project.",
+				Description: "ProjectBastionSettings contains settings for the Bastions of a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"createdBy": {
+					"requireApproval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CreatedBy is a subject representing a user name, an email address, or any other identifier of a user who created the project. This field is immutable.",
-							Ref:         ref("k8s.io/api/rbac/v1.Subject"),
+							Description: "RequireApproval specifies whether Bastions must be approved by another member of the project having the `admin` role before the bastion hosts are created.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"description": {
+					"maxSessionDuration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description is a human-readable description of what the project is used for.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxSessionDuration is the maximum duration for which Bastions may be used. If approvals are required, the duration starts with the approval.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"owner": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectList is a collection of Projects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Owner is a subject representing a user name, an email address, or any other identifier of a user owning the project. IMPORTANT: Be aware that this field will be removed in the `v1` version of this API in favor of the `owner` role. The only way to change the owner will be by moving the `owner` role. In this API version the only way to change the owner is to use this field.",
-							Ref:         ref("k8s.io/api/rbac/v1.Subject"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"purpose": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Purpose is a human-readable explanation of the project's purpose.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"members": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Members is a list of subjects representing a user name, an email address, or any other identifier of a user, group, or service account that has a certain role.",
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Projects.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Project"),
 									},
 								},
 							},
 						},
 					},
-					"namespace": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Project", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ProjectMachineImageRestriction(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectMachineImageRestriction contains a machine image which may be used by worker pools.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the name of the namespace that has been created for the Project object. A nil value means that Gardener will determine the name of the namespace. This field is immutable.",
+							Description: "Name is the name of the machine image.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tolerations": {
+					"versions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tolerations contains the tolerations for taints on seed clusters.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations"),
+							Description: "Versions is the list of versions of the machine image which may be used. If empty, all versions are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations", "k8s.io/api/rbac/v1.Subject"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ProjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ProjectMember(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectStatus holds the most recently observed status of the project.",
+				Description: "ProjectMember is a member of a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this project.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Kind of object being referenced. Values defined by this API group are \"User\", \"Group\", and \"ServiceAccount\". If the Authorizer does not recognized the kind value, the Authorizer should report an error.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"phase": {
+					"apiGroup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current phase of the project.",
+							Description: "APIGroup holds the API group of the referenced subject. Defaults to \"\" for ServiceAccount subjects. Defaults to \"rbac.authorization.k8s.io\" for User and Group subjects.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"staleSinceTimestamp": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "StaleSinceTimestamp contains the timestamp when the project was first discovered to be stale/unused.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Name of the object being referenced.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"staleAutoDeleteTimestamp": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "StaleAutoDeleteTimestamp contains the timestamp when the project will be garbage-collected/automatically deleted because it's stale/unused.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Namespace of the referenced object.  If the object kind is non-namespace, such as \"User\" or \"Group\", and this value is not empty the Authorizer should report an error.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastActivityTimestamp": {
+					"role": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastActivityTimestamp contains the timestamp from the last activity performed in this project.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Role represents the role of this member. IMPORTANT: Be aware that this field will be removed in the `v1` version of this API in favor of the `roles` list.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_ProjectTolerations(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ProjectTolerations contains the tolerations for taints on seed clusters.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"defaults": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "key",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"roles": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Defaults contains a list of tolerations that are added to the shoots in this project by default.",
+							Description: "Roles represents the list of roles of this member.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"whitelist": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "key",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
+					"expirationDate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationDate is the time after which the member is removed from the project.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
+					},
+					"roleExpirations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Whitelist contains a list of tolerations that are allowed to be added to the shoots in this project. Please note that this list may only be added by users having the `spec-tolerations-whitelist` verb for project resources.",
+							Description: "RoleExpirations contains the times after which single roles are removed from the member.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMemberRoleExpiration"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"kind", "name", "role"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMemberRoleExpiration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Provider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ProjectMemberAccessReview(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Provider contains provider-specific information that are handed-over to the provider-specific extension controller.",
+				Description: "ProjectMemberAccessReview contains the review result for a project member.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the provider. This field is immutable.",
+							Description: "Kind of object being referenced. Values defined by this API group are \"User\", \"Group\", and \"ServiceAccount\". If the Authorizer does not recognized the kind value, the Authorizer should report an error.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"controlPlaneConfig": {
+					"apiGroup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ControlPlaneConfig contains the provider-specific control plane config blob. Please look up the concrete definition in the documentation of your provider extension.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "APIGroup holds the API group of the referenced subject. Defaults to \"\" for ServiceAccount subjects. Defaults to \"rbac.authorization.k8s.io\" for User and Group subjects.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"infrastructureConfig": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InfrastructureConfig contains the provider-specific infrastructure config blob. Please look up the concrete definition in the documentation of your provider extension.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Name of the object being referenced.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"workers": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "name",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the referenced object.  If the object kind is non-namespace, such as \"User\" or \"Group\", and this value is not empty the Authorizer should report an error.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"firstReviewTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a list of worker groups.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker"),
-									},
-								},
-							},
+							Description: "FirstReviewTimestamp is the time when the member was reviewed for the first time.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"workersSettings": {
+					"lastActivityTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WorkersSettings contains settings for all workers.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkersSettings"),
+							Description: "LastActivityTimestamp is the time when the member was last seen using the project.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"flaggedForRemoval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlaggedForRemoval indicates that the member has not used the project for longer than the configured inactivity threshold and should be removed from the project.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"kind", "name", "firstReviewTimestamp"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkersSettings", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Quota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ProjectMemberRoleExpiration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Quota represents a quota on resources consumed by shoot clusters either per project or per provider secret.",
+				Description: "ProjectMemberRoleExpiration contains the time after which a role is removed from a project member.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"role": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Role is the name of the role.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
+					"expirationDate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the Quota constraints.",
+							Description: "ExpirationDate is the time after which the role is removed from the member.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec"),
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"role", "expirationDate"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_QuotaList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ProjectQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "QuotaList is a collection of Quotas.",
+				Description: "ProjectQuota contains limits for the resources consumed by all Shoots of a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"limits": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Quotas.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Limits is the maximum amount of resources which may be consumed by all Shoots of the project. Supported resources are `shoots`, `cpu`, `gpu`, `memory` and `loadbalancer`.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota"),
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_QuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ProjectRestrictions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "QuotaSpec is the specification of a Quota.",
+				Description: "ProjectRestrictions contains restrictions for the Shoots of a project. Empty lists do not restrict the respective settings.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"clusterLifetimeDays": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ClusterLifetimeDays is the lifetime of a Shoot cluster in days before it will be terminated automatically.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"metrics": {
+					"regions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Metrics is a list of resources which will be put under constraints.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Regions is the list of regions which may be used by Shoots.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"scope": {
+					"machineTypes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Scope is the scope of the Quota object, either 'project' or 'secret'. This field is immutable.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+							Description: "MachineTypes is the list of machine types which may be used by worker pools.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-				},
-				Required: []string{"metrics", "scope"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_Region(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Region contains certain properties of a region.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"architectures": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is a region name.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"zones": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "name",
-								"x-kubernetes-patch-strategy":  "merge",
+							Description: "Architectures is the list of CPU architectures which may be used by worker pools.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
 							},
 						},
+					},
+					"machineImages": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones is a list of availability zones in this region.",
+							Description: "MachineImages is the list of machine images (and optionally their versions) which may be used by worker pools.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityZone"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMachineImageRestriction"),
 									},
 								},
 							},
 						},
 					},
-					"labels": {
+					"volumeTypes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is an optional set of key-value pairs that contain certain administrator-controlled labels for this region. It can be used by Gardener administrators/operators to provide additional information about a region, e.g. wrt quality, reliability, access restrictions, etc.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "VolumeTypes is the list of volume types which may be used by worker pools.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -6022,407 +6253,338 @@ func schema_pkg_apis_core_v1beta1_Region(ref common.ReferenceCallback) common.Op
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityZone"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMachineImageRestriction"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ResourceData(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceData holds the data of a resource referred to by an extension controller state.",
+				Description: "ProjectSpec is the specification of a Project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"createdBy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "kind is the kind of the referent; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "CreatedBy is a subject representing a user name, an email address, or any other identifier of a user who created the project. This field is immutable.",
+							Ref:         ref("k8s.io/api/rbac/v1.Subject"),
 						},
 					},
-					"name": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name is the name of the referent; More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
-							Default:     "",
+							Description: "Description is a human-readable description of what the project is used for.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"owner": {
 						SchemaProps: spec.SchemaProps{
-							Description: "apiVersion is the API version of the referent",
+							Description: "Owner is a subject representing a user name, an email address, or any other identifier of a user owning the project. IMPORTANT: Be aware that this field will be removed in the `v1` version of this API in favor of the `owner` role. The only way to change the owner will be by moving the `owner` role. In this API version the only way to change the owner is to use this field.",
+							Ref:         ref("k8s.io/api/rbac/v1.Subject"),
+						},
+					},
+					"purpose": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Purpose is a human-readable explanation of the project's purpose.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"data": {
+					"members": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data of the resource",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Members is a list of subjects representing a user name, an email address, or any other identifier of a user, group, or service account that has a certain role.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember"),
+									},
+								},
+							},
 						},
 					},
-				},
-				Required: []string{"kind", "name", "data"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ResourceWatchCacheSize contains configuration of the API server's watch cache size for one specific resource.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"apiGroup": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIGroup is the API group of the resource for which the watch cache size should be configured. An unset value is used to specify the legacy core API (e.g. for `secrets`).",
+							Description: "Namespace is the name of the namespace that has been created for the Project object. A nil value means that Gardener will determine the name of the namespace. This field is immutable.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resource": {
+					"tolerations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resource is the name of the resource for which the watch cache size should be configured (in lowercase plural form, e.g. `secrets`).",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Tolerations contains the tolerations for taints on seed clusters.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations"),
 						},
 					},
-					"size": {
+					"quota": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CacheSize specifies the watch cache size that should be configured for the specified resource.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Quota contains limits for the resources consumed by all Shoots of the project independent of the used credentials. Please note that this field may only be changed by users having the `modify-spec-quota` verb for project resources.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectQuota"),
 						},
 					},
-				},
-				Required: []string{"resource", "size"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_SSHAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SSHAccess contains settings regarding ssh access to the worker nodes.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"enabled": {
+					"restrictions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the SSH access to the worker nodes is ensured to be enabled or disabled in systemd. Defaults to true.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Restrictions narrows down the settings offered by the CloudProfiles which may be used by the Shoots of the project. Please note that this field may only be changed by users having the `modify-spec-restrictions` verb for project resources.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectRestrictions"),
+						},
+					},
+					"bastion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bastion contains settings for the Bastions of the project.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectBastionSettings"),
 						},
 					},
 				},
-				Required: []string{"enabled"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectBastionSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectQuota", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectRestrictions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations", "k8s.io/api/rbac/v1.Subject"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ProjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretBinding represents a binding to a secret in the same or another namespace.",
+				Description: "ProjectStatus holds the most recently observed status of the project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation observed for this project.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"apiVersion": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Phase is the current phase of the project.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"staleSinceTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "StaleSinceTimestamp contains the timestamp when the project was first discovered to be stale/unused.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"secretRef": {
+					"staleAutoDeleteTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef is a reference to a secret object in the same or another namespace. This field is immutable.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+							Description: "StaleAutoDeleteTimestamp contains the timestamp when the project will be garbage-collected/automatically deleted because it's stale/unused.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"quotas": {
+					"lastActivityTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Quotas is a list of references to Quota objects in the same or another namespace. This field is immutable.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
+							Description: "LastActivityTimestamp contains the timestamp from the last activity performed in this project.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"usage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usage contains the resources currently consumed by all Shoots of the project. The same metrics which can be limited via `.spec.quota.limits` are reported.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.ObjectReference"),
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
-					"provider": {
+					"accessReview": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Provider defines the provider type of the SecretBinding. This field is immutable.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingProvider"),
+							Description: "AccessReview contains the result of the last review of the access of the project members.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectAccessReview"),
+						},
+					},
+					"accumulatedUsage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccumulatedUsage contains the resources consumed by all Shoots of the project over time, e.g., for chargeback.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectAccumulatedUsage"),
 						},
 					},
 				},
-				Required: []string{"secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingProvider", "k8s.io/api/core/v1.ObjectReference", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectAccessReview", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectAccumulatedUsage", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SecretBindingList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ProjectTolerations(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretBindingList is a collection of SecretBindings.",
+				Description: "ProjectTolerations contains the tolerations for taints on seed clusters.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+					"defaults": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "key",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
 						},
-					},
-					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Defaults contains a list of tolerations that are added to the shoots in this project by default.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"),
+									},
+								},
+							},
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+					"whitelist": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "key",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
 						},
-					},
-					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of SecretBindings.",
+							Description: "Whitelist contains a list of tolerations that are allowed to be added to the shoots in this project. Please note that this list may only be added by users having the `spec-tolerations-whitelist` verb for project resources.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBinding"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBinding", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SecretBindingProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_Provider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretBindingProvider defines the provider type of the SecretBinding.",
+				Description: "Provider contains provider-specific information that are handed-over to the provider-specific extension controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the provider.\n\nFor backwards compatibility, the field can contain multiple providers separated by a comma. However the usage of single SecretBinding (hence Secret) for different cloud providers is strongly discouraged.",
+							Description: "Type is the type of the provider. This field is immutable.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"type"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_Seed(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Seed represents an installation request for an external controller.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"controlPlaneConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ControlPlaneConfig contains the provider-specific control plane config blob. Please look up the concrete definition in the documentation of your provider extension.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
-					"apiVersion": {
+					"infrastructureConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "InfrastructureConfig contains the provider-specific infrastructure config blob. Please look up the concrete definition in the documentation of your provider extension.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+					"workers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
 						},
-					},
-					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification of this installation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSpec"),
+							Description: "Workers is a list of worker groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker"),
+									},
+								},
+							},
 						},
 					},
-					"status": {
+					"workersSettings": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status of this installation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedStatus"),
+							Description: "WorkersSettings contains settings for all workers.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkersSettings"),
 						},
 					},
 				},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkersSettings", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_Quota(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedBackup contains the object store configuration for backups for shoot (currently only etcd).",
+				Description: "Quota represents a quota on resources consumed by shoot clusters either per project or per provider secret.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"provider": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Provider is a provider name. This field is immutable.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"providerConfig": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig is the configuration passed to BackupBucket resource.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
-						},
-					},
-					"region": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Region is a region name. This field is immutable.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef is a reference to a Secret object containing the cloud provider credentials for the object store where backups should be stored. It should have enough privileges to manipulate the objects as well as buckets.",
+							Description: "Standard object metadata.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
-						},
-					},
-				},
-				Required: []string{"provider", "secretRef"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_SeedDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SeedDNS contains DNS-relevant information about this seed cluster.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"provider": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Provider configures a DNSProvider",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNSProvider"),
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNSProvider"},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_SeedDNSProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SeedDNSProvider configures a DNSProvider for Seeds",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type describes the type of the dns-provider, for example `aws-route53`",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"secretRef": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef is a reference to a Secret object containing cloud provider credentials used for registering external domains.",
+							Description: "Spec defines the Quota constraints.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec"),
 						},
 					},
 				},
-				Required: []string{"type", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretReference"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_QuotaList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedList is a collection of Seeds.",
+				Description: "QuotaList is a collection of Quotas.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -6448,13 +6610,13 @@ func schema_pkg_apis_core_v1beta1_SeedList(ref common.ReferenceCallback) common.
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Seeds.",
+							Description: "Items is the list of Quotas.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Seed"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota"),
 									},
 								},
 							},
@@ -6465,180 +6627,96 @@ func schema_pkg_apis_core_v1beta1_SeedList(ref common.ReferenceCallback) common.
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Seed", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_QuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.",
+				Description: "QuotaSpec is the specification of a Quota.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nodes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Nodes is the CIDR of the node network. This field is immutable.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"pods": {
+					"clusterLifetimeDays": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network. This field is immutable.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ClusterLifetimeDays is the lifetime of a Shoot cluster in days before it will be terminated automatically.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"services": {
+					"metrics": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network. This field is immutable.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"shootDefaults": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ShootDefaults contains the default networks CIDRs for shoots.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworks"),
-						},
-					},
-					"blockCIDRs": {
-						SchemaProps: spec.SchemaProps{
-							Description: "BlockCIDRs is a list of network addresses that should be blocked for shoot control plane components running in the seed cluster.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Metrics is a list of resources which will be put under constraints.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
-					"ipFamilies": {
+					"scope": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPFamilies specifies the IP protocol versions to use for seed networking. This field is immutable. See https://github.com/gardener/gardener/blob/master/docs/usage/ipv6.md. Defaults to [\"IPv4\"].",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Scope is the scope of the Quota object, either 'project' or 'secret'. This field is immutable.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
 						},
 					},
 				},
-				Required: []string{"pods", "services"},
+				Required: []string{"metrics", "scope"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworks"},
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_Region(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedProvider defines the provider-specific information of this Seed cluster.",
+				Description: "Region contains certain properties of a region.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type is the name of the provider.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"providerConfig": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig is the configuration passed to Seed resource.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
-						},
-					},
-					"region": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Region is a name of a region.",
+							Description: "Name is a region name.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"zones": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Zones is the list of availability zones the seed cluster is deployed to.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-				},
-				Required: []string{"type", "region"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_SeedSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SeedSelector contains constraints for selecting seed to be usable for shoots using a profile",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"matchLabels": {
-						SchemaProps: spec.SchemaProps{
-							Description: "matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
 							},
 						},
-					},
-					"matchExpressions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "matchExpressions is a list of label selector requirements. The requirements are ANDed.",
+							Description: "Zones is a list of availability zones in this region.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityZone"),
 									},
 								},
 							},
 						},
 					},
-					"providerTypes": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Providers is optional and can be used by restricting seeds by their provider type. '*' can be used to enable seeds regardless of their provider type.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Labels is an optional set of key-value pairs that contain certain administrator-controlled labels for this region. It can be used by Gardener administrators/operators to provide additional information about a region, e.g. wrt quality, reliability, access restrictions, etc.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -6650,72 +6728,107 @@ func schema_pkg_apis_core_v1beta1_SeedSelector(ref common.ReferenceCallback) com
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityZone"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdog(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ResourceData(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedSettingDependencyWatchdog controls the dependency-watchdog settings for the seed.",
+				Description: "ResourceData holds the data of a resource referred to by an extension controller state.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"weeder": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Weeder controls the weeder settings for the dependency-watchdog for the seed.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogWeeder"),
+							Description: "kind is the kind of the referent; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"prober": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Prober controls the prober settings for the dependency-watchdog for the seed.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogProber"),
+							Description: "name is the name of the referent; More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "apiVersion is the API version of the referent",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data of the resource",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
 				},
+				Required: []string{"kind", "name", "data"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogProber", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogWeeder"},
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogProber(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedSettingDependencyWatchdogProber controls the prober settings for the dependency-watchdog for the seed.",
+				Description: "ResourceWatchCacheSize contains configuration of the API server's watch cache size for one specific resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"apiGroup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled controls whether the probe controller(prober) of the dependency-watchdog should be enabled. This controller scales down the kube-controller-manager, machine-controller-manager and cluster-autoscaler of shoot clusters in case their respective kube-apiserver is not reachable via its external ingress in order to avoid melt-down situations.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "APIGroup is the API group of the resource for which the watch cache size should be configured. An unset value is used to specify the legacy core API (e.g. for `secrets`).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resource is the name of the resource for which the watch cache size should be configured (in lowercase plural form, e.g. `secrets`).",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheSize specifies the watch cache size that should be configured for the specified resource.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"resource", "size"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogWeeder(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SSHAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedSettingDependencyWatchdogWeeder controls the weeder settings for the dependency-watchdog for the seed.",
+				Description: "SSHAccess contains settings regarding ssh access to the worker nodes.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled controls whether the endpoint controller(weeder) of the dependency-watchdog should be enabled. This controller helps to alleviate the delay where control plane components remain unavailable by finding the respective pods in CrashLoopBackoff status and restarting them once their dependants become ready and available again.",
+							Description: "Enabled indicates whether the SSH access to the worker nodes is ensured to be enabled or disabled in systemd. Defaults to true.",
 							Default:     false,
 							Type:        []string{"boolean"},
 							Format:      "",
@@ -6728,584 +6841,668 @@ func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogWeeder(ref common
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in the seed.",
+				Description: "SecretBinding represents a binding to a secret in the same or another namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled controls whether the default excess capacity reservation should be enabled. When not specified, the functionality is enabled.",
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"configs": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configs configures excess capacity reservation deployments for shoot control planes in the seed.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a secret object in the same or another namespace. This field is immutable.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+					"quotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quotas is a list of references to Quota objects in the same or another namespace. This field is immutable.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservationConfig"),
+										Ref:     ref("k8s.io/api/core/v1.ObjectReference"),
 									},
 								},
 							},
 						},
 					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider defines the provider type of the SecretBinding. This field is immutable.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingProvider"),
+						},
+					},
 				},
+				Required: []string{"secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservationConfig"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingProvider", "k8s.io/api/core/v1.ObjectReference", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservationConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SecretBindingList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedSettingExcessCapacityReservationConfig configures excess capacity reservation deployments for shoot control planes in the seed.",
+				Description: "SecretBindingList is a collection of SecretBindings.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"resources": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources specify the resource requests and limits of the excess-capacity-reservation pod.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nodeSelector": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector specifies the node where the excess-capacity-reservation pod should run.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tolerations": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tolerations specify the tolerations for the the excess-capacity-reservation pod.",
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of SecretBindings.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBinding"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"resources"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBinding", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServices(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SecretBindingProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedSettingLoadBalancerServices controls certain settings for services of type load balancer that are created in the seed.",
+				Description: "SecretBindingProvider defines the provider type of the SecretBinding.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"annotations": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of annotations that will be injected/merged into every load balancer service object.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Type is the type of the provider.\n\nFor backwards compatibility, the field can contain multiple providers separated by a comma. However the usage of single SecretBinding (hence Secret) for different cloud providers is strongly discouraged.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"externalTrafficPolicy": {
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_Seed(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Seed represents an installation request for an external controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExternalTrafficPolicy describes how nodes distribute service traffic they receive on one of the service's \"externally-facing\" addresses. Defaults to \"Cluster\".\n\nPossible enum values:\n - `\"Cluster\"`\n - `\"Cluster\"` routes traffic to all endpoints.\n - `\"Local\"`\n - `\"Local\"` preserves the source IP of the traffic by routing only to endpoints on the same node as the traffic was received on (dropping the traffic if there are no local endpoints).",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
-							Enum:        []interface{}{"Cluster", "Cluster", "Local", "Local"},
 						},
 					},
-					"zones": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones controls settings, which are specific to the single-zone load balancers in a multi-zonal setup. Can be empty for single-zone seeds. Each specified zone has to relate to one of the zones in seed.spec.provider.zones.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServicesZones"),
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification of this installation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status of this installation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServicesZones"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServicesZones(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedSettingLoadBalancerServicesZones controls settings, which are specific to the single-zone load balancers in a multi-zonal setup.",
+				Description: "SeedBackup contains the object store configuration for backups for shoot (currently only etcd).",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"provider": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the zone as specified in seed.spec.provider.zones.",
+							Description: "Provider is a provider name. This field is immutable.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"annotations": {
+					"providerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of annotations that will be injected/merged into the zone-specific load balancer service object.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ProviderConfig is the configuration passed to BackupBucket resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
-					"externalTrafficPolicy": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExternalTrafficPolicy describes how nodes distribute service traffic they receive on one of the service's \"externally-facing\" addresses. Defaults to \"Cluster\".\n\nPossible enum values:\n - `\"Cluster\"`\n - `\"Cluster\"` routes traffic to all endpoints.\n - `\"Local\"`\n - `\"Local\"` preserves the source IP of the traffic by routing only to endpoints on the same node as the traffic was received on (dropping the traffic if there are no local endpoints).",
+							Description: "Region is a region name. This field is immutable.",
 							Type:        []string{"string"},
 							Format:      "",
-							Enum:        []interface{}{"Cluster", "Cluster", "Local", "Local"},
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a Secret object containing the cloud provider credentials for the object store where backups should be stored. It should have enough privileges to manipulate the objects as well as buckets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"provider", "secretRef"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedSettingScheduling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedSettingScheduling controls settings for scheduling decisions for the seed.",
+				Description: "SeedDNS contains DNS-relevant information about this seed cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"visible": {
+					"provider": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Visible controls whether the gardener-scheduler shall consider this seed when scheduling shoots. Invisible seeds are not considered by the scheduler.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Provider configures a DNSProvider",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNSProvider"),
 						},
 					},
 				},
-				Required: []string{"visible"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNSProvider"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedSettingTopologyAwareRouting(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedDNSProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedSettingTopologyAwareRouting controls certain settings for topology-aware traffic routing in the seed. See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.",
+				Description: "SeedDNSProvider configures a DNSProvider for Seeds",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled controls whether certain Services deployed in the seed cluster should be topology-aware. These Services are etcd-main-client, etcd-events-client, kube-apiserver, gardener-resource-manager and vpa-webhook.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Type describes the type of the dns-provider, for example `aws-route53`",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a Secret object containing cloud provider credentials used for registering external domains.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"type", "secretRef"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretReference"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedSettingVerticalPodAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedDrainStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedSettingVerticalPodAutoscaler controls certain settings for the vertical pod autoscaler components deployed in the seed.",
+				Description: "SeedDrainStatus contains information about the progress of draining a seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"startTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled controls whether the VPA components shall be deployed into the garden namespace in the seed cluster. It is enabled by default because Gardener heavily relies on a VPA being deployed. You should only disable this if your seed cluster already has another, manually/custom managed VPA deployment.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "StartTimestamp is the time when the drain of the seed was started.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"remainingShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemainingShoots is the number of shoots whose control planes are still hosted by the seed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"migratingShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigratingShoots is the list of shoots (in the format <namespace>/<name>) which are currently migrated off the seed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"startTimestamp", "remainingShoots"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedSettings contains certain settings for this seed cluster.",
+				Description: "SeedList is a collection of Seeds.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"excessCapacityReservation": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExcessCapacityReservation controls the excess capacity reservation for shoot control planes in the seed.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservation"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"scheduling": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Scheduling controls settings for scheduling decisions for the seed.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"loadBalancerServices": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LoadBalancerServices controls certain settings for services of type load balancer that are created in the seed.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices"),
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"verticalPodAutoscaler": {
-						SchemaProps: spec.SchemaProps{
-							Description: "VerticalPodAutoscaler controls certain settings for the vertical pod autoscaler components deployed in the seed.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler"),
-						},
-					},
-					"dependencyWatchdog": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DependencyWatchdog controls certain settings for the dependency-watchdog components deployed in the seed.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog"),
-						},
-					},
-					"topologyAwareRouting": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TopologyAwareRouting controls certain settings for topology-aware traffic routing in the seed. See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting"),
+							Description: "Items is the list of Seeds.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Seed"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Seed", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedSpec is the specification of a Seed.",
+				Description: "SeedNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"backup": {
+					"nodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Backup holds the object store configuration for the backups of shoot (currently only etcd). If it is not specified, then there won't be any backups taken for shoots associated with this seed. If backup field is present in seed, then backups of the etcd from shoot control plane will be stored under the configured object store.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedBackup"),
+							Description: "Nodes is the CIDR of the node network. This field is immutable.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"dns": {
+					"pods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNS contains DNS-relevant information about this seed cluster.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNS"),
+							Description: "Pods is the CIDR of the pod network. This field is immutable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"networks": {
+					"services": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Networks defines the pod, service and worker network of the Seed cluster.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedNetworks"),
+							Description: "Services is the CIDR of the service network. This field is immutable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"provider": {
+					"shootDefaults": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Provider defines the provider type and region for this Seed cluster.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedProvider"),
+							Description: "ShootDefaults contains the default networks CIDRs for shoots.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworks"),
 						},
 					},
-					"taints": {
+					"blockCIDRs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Taints describes taints on the seed.",
+							Description: "BlockCIDRs is a list of network addresses that should be blocked for shoot control plane components running in the seed cluster.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedTaint"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"volume": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Volume contains settings for persistentvolumes created in the seed cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolume"),
-						},
-					},
-					"settings": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Settings contains certain settings for this seed cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettings"),
-						},
-					},
-					"ingress": {
+					"ipFamilies": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ingress configures Ingress specific settings of the Seed cluster. This field is immutable.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Ingress"),
+							Description: "IPFamilies specifies the IP protocol versions to use for seed networking. This field is immutable. See https://github.com/gardener/gardener/blob/master/docs/usage/ipv6.md. Defaults to [\"IPv4\"].",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"dns", "networks", "provider"},
+				Required: []string{"pods", "services"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Ingress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedBackup", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedNetworks", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedProvider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedTaint", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolume"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworks"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedStatus is the status of a Seed.",
+				Description: "SeedProvider defines the provider-specific information of this Seed cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"gardener": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener"),
+							Description: "Type is the name of the provider.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"kubernetesVersion": {
+					"providerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubernetesVersion is the Kubernetes version of the seed cluster.",
+							Description: "ProviderConfig is the configuration passed to Seed resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is a name of a region.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"zones": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of a Seed's current state.",
+							Description: "Zones is the list of availability zones the seed cluster is deployed to.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this Seed. It corresponds to the Seed's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"clusterIdentity": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ClusterIdentity is the identity of the Seed cluster. This field is immutable.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"capacity": {
+				},
+				Required: []string{"type", "region"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSelector contains constraints for selecting seed to be usable for shoots using a profile",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"matchLabels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Capacity represents the total resources of a seed.",
+							Description: "matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"allocatable": {
+					"matchExpressions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allocatable represents the resources of a seed that are available for scheduling. Defaults to Capacity.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "matchExpressions is a list of label selector requirements. The requirements are ANDed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement"),
 									},
 								},
 							},
 						},
 					},
-					"clientCertificateExpirationTimestamp": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ClientCertificateExpirationTimestamp is the timestamp at which gardenlet's client certificate expires.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastOperation": {
+					"providerTypes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastOperation holds information about the last operation on the Seed.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation"),
+							Description: "Providers is optional and can be used by restricting seeds by their provider type. '*' can be used to enable seeds regardless of their provider type.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedTaint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdog(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedTaint describes a taint on a seed.",
+				Description: "SeedSettingDependencyWatchdog controls the dependency-watchdog settings for the seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"key": {
+					"weeder": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the taint key to be applied to a seed.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Weeder controls the weeder settings for the dependency-watchdog for the seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogWeeder"),
 						},
 					},
-					"value": {
+					"prober": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value is the taint value corresponding to the taint key.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Prober controls the prober settings for the dependency-watchdog for the seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogProber"),
 						},
 					},
 				},
-				Required: []string{"key"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogProber", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogWeeder"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogProber(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedTemplate is a template for creating a Seed object.",
+				Description: "SeedSettingDependencyWatchdogProber controls the prober settings for the dependency-watchdog for the seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"metadata": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the desired behavior of the Seed.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSpec"),
+							Description: "Enabled controls whether the probe controller(prober) of the dependency-watchdog should be enabled. This controller scales down the kube-controller-manager, machine-controller-manager and cluster-autoscaler of shoot clusters in case their respective kube-apiserver is not reachable via its external ingress in order to avoid melt-down situations.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"enabled"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedVolume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogWeeder(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedVolume contains settings for persistentvolumes created in the seed cluster.",
+				Description: "SeedSettingDependencyWatchdogWeeder controls the weeder settings for the dependency-watchdog for the seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"minimumSize": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MinimumSize defines the minimum size that should be used for PVCs in the seed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "Enabled controls whether the endpoint controller(weeder) of the dependency-watchdog should be enabled. This controller helps to alleviate the delay where control plane components remain unavailable by finding the respective pods in CrashLoopBackoff status and restarting them once their dependants become ready and available again.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"providers": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "name",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in the seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled controls whether the default excess capacity reservation should be enabled. When not specified, the functionality is enabled.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
+					},
+					"configs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Providers is a list of storage class provisioner types for the seed.",
+							Description: "Configs configures excess capacity reservation deployments for shoot control planes in the seed.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolumeProvider"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservationConfig"),
 									},
 								},
 							},
@@ -7315,77 +7512,111 @@ func schema_pkg_apis_core_v1beta1_SeedVolume(ref common.ReferenceCallback) commo
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolumeProvider", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservationConfig"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedVolumeProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservationConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedVolumeProvider is a storage class provisioner type.",
+				Description: "SeedSettingExcessCapacityReservationConfig configures excess capacity reservation deployments for shoot control planes in the seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"purpose": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Purpose is the purpose of this provider.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Resources specify the resource requests and limits of the excess-capacity-reservation pod.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
-					"name": {
+					"nodeSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the storage class provisioner type.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "NodeSelector specifies the node where the excess-capacity-reservation pod should run.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerations specify the tolerations for the the excess-capacity-reservation pod.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"purpose", "name"},
+				Required: []string{"resources"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ServiceAccountConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServices(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ServiceAccountConfig is the kube-apiserver configuration for service accounts.",
+				Description: "SeedSettingLoadBalancerServices controls certain settings for services of type load balancer that are created in the seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"issuer": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Issuer is the identifier of the service account token issuer. The issuer will assert this identifier in \"iss\" claim of issued tokens. This value is used to generate new service account tokens. This value is a string or URI. Defaults to URI of the API server.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Annotations is a map of annotations that will be injected/merged into every load balancer service object.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"extendTokenExpiration": {
+					"externalTrafficPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExtendTokenExpiration turns on projected service account expiration extension during token generation, which helps safe transition from legacy token to bound service account token feature. If this flag is enabled, admission injected tokens would be extended up to 1 year to prevent unexpected failure during transition, ignoring value of service-account-max-token-expiration.",
-							Type:        []string{"boolean"},
+							Description: "ExternalTrafficPolicy describes how nodes distribute service traffic they receive on one of the service's \"externally-facing\" addresses. Defaults to \"Cluster\".\n\nPossible enum values:\n - `\"Cluster\"`\n - `\"Cluster\"` routes traffic to all endpoints.\n - `\"Local\"`\n - `\"Local\"` preserves the source IP of the traffic by routing only to endpoints on the same node as the traffic was received on (dropping the traffic if there are no local endpoints).",
+							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"Cluster", "Cluster", "Local", "Local"},
 						},
 					},
-					"maxTokenExpiration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MaxTokenExpiration is the maximum validity duration of a token created by the service account token issuer. If an otherwise valid TokenRequest with a validity duration larger than this value is requested, a token will be issued with a validity duration of this value. This field must be within [30d,90d].",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"acceptedIssuers": {
+					"zones": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AcceptedIssuers is an additional set of issuers that are used to determine which service account tokens are accepted. These values are not used to generate new service account tokens. Only useful when service account tokens are also issued by another external system or a change of the current issuer that is used for generating tokens is being performed.",
+							Description: "Zones controls settings, which are specific to the single-zone load balancers in a multi-zonal setup. Can be empty for single-zone seeds. Each specified zone has to relate to one of the zones in seed.spec.provider.zones.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServicesZones"),
 									},
 								},
 							},
@@ -7395,567 +7626,618 @@ func schema_pkg_apis_core_v1beta1_ServiceAccountConfig(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServicesZones"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ServiceAccountKeyRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServicesZones(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ServiceAccountKeyRotation contains information about the service account key credential rotation.",
+				Description: "SeedSettingLoadBalancerServicesZones controls settings, which are specific to the single-zone load balancers in a multi-zonal setup.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase describes the phase of the service account key credential rotation.",
+							Description: "Name is the name of the zone as specified in seed.spec.provider.zones.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastCompletionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastCompletionTime is the most recent time when the service account key credential rotation was successfully completed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastInitiationTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastInitiationTime is the most recent time when the service account key credential rotation was initiated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastInitiationFinishedTime": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastInitiationFinishedTime is the recent time when the certificate authority credential rotation initiation was completed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Annotations is a map of annotations that will be injected/merged into the zone-specific load balancer service object.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"lastCompletionTriggeredTime": {
+					"externalTrafficPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastCompletionTriggeredTime is the recent time when the certificate authority credential rotation completion was triggered.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "ExternalTrafficPolicy describes how nodes distribute service traffic they receive on one of the service's \"externally-facing\" addresses. Defaults to \"Cluster\".\n\nPossible enum values:\n - `\"Cluster\"`\n - `\"Cluster\"` routes traffic to all endpoints.\n - `\"Local\"`\n - `\"Local\"` preserves the source IP of the traffic by routing only to endpoints on the same node as the traffic was received on (dropping the traffic if there are no local endpoints).",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Cluster", "Cluster", "Local", "Local"},
 						},
 					},
 				},
-				Required: []string{"phase"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Shoot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedSettingScheduling(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Shoot represents a Shoot cluster created and managed by Gardener.",
+				Description: "SeedSettingScheduling controls settings for scheduling decisions for the seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"visible": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
+							Description: "Visible controls whether the gardener-scheduler shall consider this seed when scheduling shoots. Invisible seeds are not considered by the scheduler.",
+							Default:     false,
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the Shoot cluster. If the object's deletion timestamp is set, this field is immutable.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec"),
-						},
-					},
-					"status": {
+					"drain": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the Shoot cluster.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStatus"),
+							Description: "Drain controls whether the seed is drained. Draining seeds are not considered by the scheduler, and the shoots hosted by them are migrated to other seeds during their maintenance time windows.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingSchedulingDrain"),
 						},
 					},
 				},
+				Required: []string{"visible"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingSchedulingDrain"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootAdvertisedAddress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedSettingSchedulingDrain(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootAdvertisedAddress contains information for the shoot's Kube API server.",
+				Description: "SeedSettingSchedulingDrain controls settings for draining the seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"maxParallelMigrations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the advertised address. e.g. external",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The URL of the API Server. e.g. https://api.foo.bar or https://1.2.3.4",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxParallelMigrations is the maximum number of shoots which are migrated off the seed at the same time. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"name", "url"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedSettingTopologyAwareRouting(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootCredentials contains information about the shoot credentials.",
+				Description: "SeedSettingTopologyAwareRouting controls certain settings for topology-aware traffic routing in the seed. See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"rotation": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Rotation contains information about the credential rotations.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation"),
+							Description: "Enabled controls whether certain Services deployed in the seed cluster should be topology-aware. These Services are etcd-main-client, etcd-events-client, kube-apiserver, gardener-resource-manager and vpa-webhook.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"enabled"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedSettingVerticalPodAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootCredentialsRotation contains information about the rotation of credentials.",
+				Description: "SeedSettingVerticalPodAutoscaler controls certain settings for the vertical pod autoscaler components deployed in the seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"certificateAuthorities": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CertificateAuthorities contains information about the certificate authority credential rotation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation"),
+							Description: "Enabled controls whether the VPA components shall be deployed into the garden namespace in the seed cluster. It is enabled by default because Gardener heavily relies on a VPA being deployed. You should only disable this if your seed cluster already has another, manually/custom managed VPA deployment.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"kubeconfig": {
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettings contains certain settings for this seed cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"excessCapacityReservation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubeconfig contains information about the kubeconfig credential rotation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootKubeconfigRotation"),
+							Description: "ExcessCapacityReservation controls the excess capacity reservation for shoot control planes in the seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservation"),
 						},
 					},
-					"sshKeypair": {
+					"scheduling": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SSHKeypair contains information about the ssh-keypair credential rotation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSSHKeypairRotation"),
+							Description: "Scheduling controls settings for scheduling decisions for the seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling"),
 						},
 					},
-					"observability": {
+					"loadBalancerServices": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Observability contains information about the observability credential rotation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation"),
+							Description: "LoadBalancerServices controls certain settings for services of type load balancer that are created in the seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices"),
 						},
 					},
-					"serviceAccountKey": {
+					"verticalPodAutoscaler": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServiceAccountKey contains information about the service account key credential rotation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountKeyRotation"),
+							Description: "VerticalPodAutoscaler controls certain settings for the vertical pod autoscaler components deployed in the seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler"),
 						},
 					},
-					"etcdEncryptionKey": {
+					"dependencyWatchdog": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ETCDEncryptionKey contains information about the ETCD encryption key credential rotation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDEncryptionKeyRotation"),
+							Description: "DependencyWatchdog controls certain settings for the dependency-watchdog components deployed in the seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog"),
+						},
+					},
+					"topologyAwareRouting": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologyAwareRouting controls certain settings for topology-aware traffic routing in the seed. See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDEncryptionKeyRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountKeyRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootKubeconfigRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSSHKeypairRotation"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootKubeconfigRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootKubeconfigRotation contains information about the kubeconfig credential rotation.",
+				Description: "SeedSpec is the specification of a Seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"lastInitiationTime": {
+					"backup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastInitiationTime is the most recent time when the kubeconfig credential rotation was initiated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Backup holds the object store configuration for the backups of shoot (currently only etcd). If it is not specified, then there won't be any backups taken for shoots associated with this seed. If backup field is present in seed, then backups of the etcd from shoot control plane will be stored under the configured object store.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedBackup"),
 						},
 					},
-					"lastCompletionTime": {
+					"dns": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastCompletionTime is the most recent time when the kubeconfig credential rotation was successfully completed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "DNS contains DNS-relevant information about this seed cluster.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNS"),
+						},
+					},
+					"networks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Networks defines the pod, service and worker network of the Seed cluster.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedNetworks"),
+						},
+					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider defines the provider type and region for this Seed cluster.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedProvider"),
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Taints describes taints on the seed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedTaint"),
+									},
+								},
+							},
+						},
+					},
+					"volume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volume contains settings for persistentvolumes created in the seed cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolume"),
+						},
+					},
+					"settings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Settings contains certain settings for this seed cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettings"),
+						},
+					},
+					"ingress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ingress configures Ingress specific settings of the Seed cluster. This field is immutable.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Ingress"),
 						},
 					},
 				},
+				Required: []string{"dns", "networks", "provider"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Ingress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedBackup", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedNetworks", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedProvider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedTaint", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolume"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootList is a list of Shoot objects.",
+				Description: "SeedStatus is the status of a Seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"gardener": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener"),
+						},
+					},
+					"kubernetesVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesVersion is the Kubernetes version of the seed cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Conditions represents the latest available observations of a Seed's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this Seed. It corresponds to the Seed's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"clusterIdentity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterIdentity is the identity of the Seed cluster. This field is immutable.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"capacity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Capacity represents the total resources of a seed.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"allocatable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Shoots.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Allocatable represents the resources of a seed that are available for scheduling. Defaults to Capacity.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Shoot"),
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
+					"clientCertificateExpirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientCertificateExpirationTimestamp is the timestamp at which gardenlet's client certificate expires.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastOperation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastOperation holds information about the last operation on the Seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation"),
+						},
+					},
+					"drain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Drain contains information about the progress of draining the Seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDrainStatus"),
+						},
+					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Shoot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDrainStatus", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedTaint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootMachineImage defines the name and the version of the shoot's machine image in any environment. Has to be defined in the respective CloudProfile.",
+				Description: "SeedTaint describes a taint on a seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the image.",
+							Description: "Key is the taint key to be applied to a seed.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"providerConfig": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig is the shoot's individual configuration passed to an extension resource.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
-						},
-					},
-					"version": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the shoot's image. If version is not provided, it will be defaulted to the latest version from the CloudProfile.",
+							Description: "Value is the taint value corresponding to the taint key.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"key"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootNetworks contains the default networks CIDRs for shoots.",
+				Description: "SeedTemplate is a template for creating a Seed object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"pods": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"services": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Specification of the desired behavior of the Seed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSpec"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedVolume(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootSSHKeypairRotation contains information about the ssh-keypair credential rotation.",
+				Description: "SeedVolume contains settings for persistentvolumes created in the seed cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"lastInitiationTime": {
+					"minimumSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastInitiationTime is the most recent time when the ssh-keypair credential rotation was initiated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "MinimumSize defines the minimum size that should be used for PVCs in the seed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"lastCompletionTime": {
+					"providers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "LastCompletionTime is the most recent time when the ssh-keypair credential rotation was successfully completed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Providers is a list of storage class provisioner types for the seed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolumeProvider"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolumeProvider", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedVolumeProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootSpec is the specification of a Shoot.",
+				Description: "SeedVolumeProvider is a storage class provisioner type.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"addons": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Addons contains information about enabled/disabled addons and their configuration.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons"),
-						},
-					},
-					"cloudProfileName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CloudProfileName is a name of a CloudProfile object. This field is immutable.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"dns": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DNS contains information about the DNS settings of the Shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS"),
-						},
-					},
-					"extensions": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Extensions contain type and provider information for Shoot extensions.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension"),
-									},
-								},
-							},
-						},
-					},
-					"hibernation": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Hibernation contains information whether the Shoot is suspended or not.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation"),
-						},
-					},
-					"kubernetes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kubernetes contains the version and configuration settings of the control plane components.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes"),
-						},
-					},
-					"networking": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Networking contains information about cluster networking such as CNI Plugin type, CIDRs, ...etc.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking"),
-						},
-					},
-					"maintenance": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance"),
-						},
-					},
-					"monitoring": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Monitoring contains information about custom monitoring configurations for the shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring"),
-						},
-					},
-					"provider": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Provider contains all provider-specific and provider-relevant information.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider"),
-						},
-					},
 					"purpose": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Purpose is the purpose class for this cluster.",
+							Description: "Purpose is the purpose of this provider.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"region": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Region is a name of a region. This field is immutable.",
+							Description: "Name is the name of the storage class provisioner type.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretBindingName": {
+				},
+				Required: []string{"purpose", "name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ServiceAccountConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceAccountConfig is the kube-apiserver configuration for service accounts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"issuer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretBindingName is the name of the a SecretBinding that has a reference to the provider secret. The credentials inside the provider secret will be used to create the shoot in the respective account. This field is immutable.",
+							Description: "Issuer is the identifier of the service account token issuer. The issuer will assert this identifier in \"iss\" claim of issued tokens. This value is used to generate new service account tokens. This value is a string or URI. Defaults to URI of the API server.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"seedName": {
+					"extendTokenExpiration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SeedName is the name of the seed cluster that runs the control plane of the Shoot.",
-							Type:        []string{"string"},
+							Description: "ExtendTokenExpiration turns on projected service account expiration extension during token generation, which helps safe transition from legacy token to bound service account token feature. If this flag is enabled, admission injected tokens would be extended up to 1 year to prevent unexpected failure during transition, ignoring value of service-account-max-token-expiration.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"seedSelector": {
+					"maxTokenExpiration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SeedSelector is an optional selector which must match a seed's labels for the shoot to be scheduled on that seed.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector"),
+							Description: "MaxTokenExpiration is the maximum validity duration of a token created by the service account token issuer. If an otherwise valid TokenRequest with a validity duration larger than this value is requested, a token will be issued with a validity duration of this value. This field must be within [30d,90d].",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"resources": {
+					"acceptedIssuers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources holds a list of named resource references that can be referred to in extension configs by their names.",
+							Description: "AcceptedIssuers is an additional set of issuers that are used to determine which service account tokens are accepted. These values are not used to generate new service account tokens. Only useful when service account tokens are also issued by another external system or a change of the current issuer that is used for generating tokens is being performed.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"tolerations": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "key",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ServiceAccountKeyRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceAccountKeyRotation contains information about the service account key credential rotation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tolerations contains the tolerations for taints on seed clusters.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"),
-									},
-								},
-							},
+							Description: "Phase describes the phase of the service account key credential rotation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"exposureClassName": {
+					"lastCompletionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExposureClassName is the optional name of an exposure class to apply a control plane endpoint exposure strategy. This field is immutable.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastCompletionTime is the most recent time when the service account key credential rotation was successfully completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"systemComponents": {
+					"lastInitiationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SystemComponents contains the settings of system components in the control or data plane of the Shoot cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents"),
+							Description: "LastInitiationTime is the most recent time when the service account key credential rotation was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"controlPlane": {
+					"lastInitiationFinishedTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ControlPlane contains general settings for the control plane of the shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane"),
+							Description: "LastInitiationFinishedTime is the recent time when the certificate authority credential rotation initiation was completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"schedulerName": {
+					"lastCompletionTriggeredTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SchedulerName is the name of the responsible scheduler which schedules the shoot. If not specified, the default scheduler takes over. This field is immutable.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastCompletionTriggeredTime is the recent time when the certificate authority credential rotation completion was triggered.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"cloudProfileName", "kubernetes", "provider", "region"},
+				Required: []string{"phase"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_Shoot(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootState contains a snapshot of the Shoot's state required to migrate the Shoot's control plane to a new Seed.",
+				Description: "Shoot represents a Shoot cluster created and managed by Gardener.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7981,305 +8263,1342 @@ func schema_pkg_apis_core_v1beta1_ShootState(ref common.ReferenceCallback) commo
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the ShootState.",
+							Description: "Specification of the Shoot cluster. If the object's deletion timestamp is set, this field is immutable.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateSpec"),
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the Shoot cluster.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootStateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ShootAdvertisedAddress(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootStateList is a list of ShootState objects.",
+				Description: "ShootAdvertisedAddress contains information for the shoot's Kube API server.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name of the advertised address. e.g. external",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "The URL of the API Server. e.g. https://api.foo.bar or https://1.2.3.4",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ShootStates.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootState"),
-									},
-								},
-							},
-						},
-					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name", "url"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootState", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootStateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ShootAvailability(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootStateSpec is the specification of the ShootState.",
+				Description: "ShootAvailability contains the availability of the Shoot during the last 24 hours and 7 days.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"gardener": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "name",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"last24Hours": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Gardener holds the data required to generate resources deployed by the gardenlet",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.GardenerResourceData"),
-									},
-								},
-							},
+							Description: "Last24Hours is the percentage of successful health checks during the last 24 hours. It is the minimum of the availabilities reported by the extensions.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"extensions": {
+					"last7Days": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Extensions holds the state of custom resources reconciled by extension controllers in the seed",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ExtensionResourceState"),
-									},
-								},
-							},
+							Description: "Last7Days is the percentage of successful health checks during the last 7 days. It is the minimum of the availabilities reported by the extensions.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"resources": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootCredentials contains information about the shoot credentials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rotation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources holds the data of resources referred to by extension controller states",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceData"),
-									},
-								},
-							},
+							Description: "Rotation contains information about the credential rotations.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExtensionResourceState", "github.com/gardener/gardener/pkg/apis/core/v1beta1.GardenerResourceData", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceData"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootStatus holds the most recently observed status of the Shoot cluster.",
+				Description: "ShootCredentialsRotation contains information about the rotation of credentials.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"certificateAuthorities": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of a Shoots's current state.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
-									},
-								},
-							},
+							Description: "CertificateAuthorities contains information about the certificate authority credential rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation"),
 						},
 					},
-					"constraints": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"kubeconfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Constraints represents conditions of a Shoot's current state that constraint some operations on it.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
-									},
-								},
-							},
+							Description: "Kubeconfig contains information about the kubeconfig credential rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootKubeconfigRotation"),
 						},
 					},
-					"gardener": {
+					"sshKeypair": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener"),
+							Description: "SSHKeypair contains information about the ssh-keypair credential rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSSHKeypairRotation"),
 						},
 					},
-					"hibernated": {
+					"observability": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IsHibernated indicates whether the Shoot is currently hibernated.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Observability contains information about the observability credential rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation"),
 						},
 					},
-					"lastOperation": {
+					"serviceAccountKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastOperation holds information about the last operation on the Shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation"),
+							Description: "ServiceAccountKey contains information about the service account key credential rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountKeyRotation"),
 						},
 					},
-					"lastErrors": {
+					"etcdEncryptionKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastErrors holds information about the last occurred error(s) during an operation.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError"),
-									},
-								},
-							},
+							Description: "ETCDEncryptionKey contains information about the ETCD encryption key credential rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDEncryptionKeyRotation"),
 						},
 					},
-					"observedGeneration": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDEncryptionKeyRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountKeyRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootKubeconfigRotation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSSHKeypairRotation"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootKubeconfigRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootKubeconfigRotation contains information about the kubeconfig credential rotation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastInitiationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this Shoot. It corresponds to the Shoot's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "LastInitiationTime is the most recent time when the kubeconfig credential rotation was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"retryCycleStartTime": {
+					"lastCompletionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCycleStartTime is the start time of the last retry cycle (used to determine how often an operation must be retried until we give up).",
+							Description: "LastCompletionTime is the most recent time when the kubeconfig credential rotation was successfully completed.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"seedName": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootList is a list of Shoot objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SeedName is the name of the seed cluster that runs the control plane of the Shoot. This value is only written after a successful create/reconcile operation. It will be used when control planes are moved between Seeds.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"technicalID": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TechnicalID is the name that is used for creating the Seed namespace, the infrastructure resources, and basically everything that is related to this particular Shoot. This field is immutable.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"uid": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters. It is used to compute unique hashes. This field is immutable.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"clusterIdentity": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClusterIdentity is the identity of the Shoot cluster. This field is immutable.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"advertisedAddresses": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "name",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+							Description: "Items is the list of Shoots.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Shoot"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Shoot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootMachineImage defines the name and the version of the shoot's machine image in any environment. Has to be defined in the respective CloudProfile.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the image.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the shoot's individual configuration passed to an extension resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the shoot's image. If version is not provided, it will be defaulted to the latest version from the CloudProfile.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootNetworks contains the default networks CIDRs for shoots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pods is the CIDR of the pod network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"services": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Services is the CIDR of the service network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootSSHKeypairRotation contains information about the ssh-keypair credential rotation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastInitiationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastInitiationTime is the most recent time when the ssh-keypair credential rotation was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastCompletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCompletionTime is the most recent time when the ssh-keypair credential rotation was successfully completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootSource contains information about the source a shoot cluster is cloned from.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"backupEntryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupEntryName is the name of the BackupEntry in the namespace of the shoot whose latest ETCD backup is used to initialize the ETCD of the shoot. Selecting an earlier snapshot is not supported. The BackupEntry must belong to an existing shoot whose control plane runs on the same seed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxBackupAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBackupAge is the maximum age in days of the ETCD backups of the source shoot which are copied for the shoot. Older backups are not copied. If not set, all backups are copied.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"backupEntryName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootSpec is the specification of a Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"addons": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Addons contains information about enabled/disabled addons and their configuration.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons"),
+						},
+					},
+					"cloudProfileName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudProfileName is a name of a CloudProfile object. This field is immutable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNS contains information about the DNS settings of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS"),
+						},
+					},
+					"extensions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Extensions contain type and provider information for Shoot extensions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension"),
+									},
+								},
+							},
+						},
+					},
+					"hibernation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hibernation contains information whether the Shoot is suspended or not.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation"),
+						},
+					},
+					"kubernetes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubernetes contains the version and configuration settings of the control plane components.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes"),
+						},
+					},
+					"networking": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Networking contains information about cluster networking such as CNI Plugin type, CIDRs, ...etc.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking"),
+						},
+					},
+					"maintenance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance"),
+						},
+					},
+					"monitoring": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Monitoring contains information about custom monitoring configurations for the shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring"),
+						},
+					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider contains all provider-specific and provider-relevant information.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider"),
+						},
+					},
+					"purpose": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Purpose is the purpose class for this cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is a name of a region. This field is immutable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretBindingName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretBindingName is the name of the a SecretBinding that has a reference to the provider secret. The credentials inside the provider secret will be used to create the shoot in the respective account. This field is immutable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"seedName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedName is the name of the seed cluster that runs the control plane of the Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"seedSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedSelector is an optional selector which must match a seed's labels for the shoot to be scheduled on that seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector"),
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources holds a list of named resource references that can be referred to in extension configs by their names.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference"),
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "key",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerations contains the tolerations for taints on seed clusters.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"exposureClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExposureClassName is the optional name of an exposure class to apply a control plane endpoint exposure strategy. This field is immutable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"systemComponents": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SystemComponents contains the settings of system components in the control or data plane of the Shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents"),
+						},
+					},
+					"controlPlane": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlane contains general settings for the control plane of the shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane"),
+						},
+					},
+					"schedulerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SchedulerName is the name of the responsible scheduler which schedules the shoot. If not specified, the default scheduler takes over. This field is immutable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks contains hooks which are executed at well-defined points of the reconciliation and deletion flows of the Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Hook"),
+									},
+								},
+							},
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source contains information about the source the shoot cluster is cloned from when it is created. The ETCD of the shoot is initialized with the latest backup of the source before it is started for the first time, and node-related state is reset afterwards. This field is immutable.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSource"),
+						},
+					},
+				},
+				Required: []string{"cloudProfileName", "kubernetes", "provider", "region"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hook", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSource", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootState contains a snapshot of the Shoot's state required to migrate the Shoot's control plane to a new Seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the ShootState.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootStateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootStateList is a list of ShootState objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ShootStates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootState"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootState", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootStateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootStateSpec is the specification of the ShootState.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"gardener": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Gardener holds the data required to generate resources deployed by the gardenlet",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.GardenerResourceData"),
+									},
+								},
+							},
+						},
+					},
+					"extensions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Extensions holds the state of custom resources reconciled by extension controllers in the seed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ExtensionResourceState"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources holds the data of resources referred to by extension controller states",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceData"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExtensionResourceState", "github.com/gardener/gardener/pkg/apis/core/v1beta1.GardenerResourceData", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceData"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootStatus holds the most recently observed status of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of a Shoots's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"constraints": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Constraints represents conditions of a Shoot's current state that constraint some operations on it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"gardener": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener"),
+						},
+					},
+					"hibernated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IsHibernated indicates whether the Shoot is currently hibernated.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lastOperation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastOperation holds information about the last operation on the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation"),
+						},
+					},
+					"lastErrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastErrors holds information about the last occurred error(s) during an operation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError"),
+									},
+								},
+							},
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this Shoot. It corresponds to the Shoot's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"retryCycleStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCycleStartTime is the start time of the last retry cycle (used to determine how often an operation must be retried until we give up).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"seedName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedName is the name of the seed cluster that runs the control plane of the Shoot. This value is only written after a successful create/reconcile operation. It will be used when control planes are moved between Seeds.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"technicalID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TechnicalID is the name that is used for creating the Seed namespace, the infrastructure resources, and basically everything that is related to this particular Shoot. This field is immutable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters. It is used to compute unique hashes. This field is immutable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusterIdentity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterIdentity is the identity of the Shoot cluster. This field is immutable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"advertisedAddresses": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "List of addresses on which the Kube API server can be reached.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress"),
+									},
+								},
+							},
+						},
+					},
+					"migrationStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationStartTime is the time when a migration to a different seed was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"credentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Credentials contains information about the shoot credentials.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials"),
+						},
+					},
+					"lastHibernationTriggerTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastHibernationTriggerTime indicates the last time when the hibernation controller managed to change the hibernation settings of the cluster",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastMaintenance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastMaintenance holds information about the last maintenance operations on the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance"),
+						},
+					},
+					"encryptedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EncryptedResources is the list of resources in the Shoot which are currently encrypted. Secrets are encrypted by default and are not part of the list. See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"availability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Availability contains the availability of the Shoot computed from the health check history reported by the extensions.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAvailability"),
+						},
+					},
+				},
+				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAvailability", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootTemplate is a template for creating a Shoot object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the desired behavior of the Shoot.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SystemComponents contains the settings of system components in the control or data plane of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"coreDNS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNS"),
+						},
+					},
+					"nodeLocalDNS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_Toleration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Toleration is a toleration for a seed taint.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the toleration key to be applied to a project or shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the toleration value corresponding to the toleration key.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"key"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VerticalPodAutoscaler contains the configuration flags for the Kubernetes vertical pod autoscaler.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled specifies whether the Kubernetes VPA shall be enabled for the shoot cluster.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"evictAfterOOMThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictAfterOOMThreshold defines the threshold that will lead to pod eviction in case it OOMed in less than the given threshold since its start and if it has only one container (default: 10m0s).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"evictionRateBurst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionRateBurst defines the burst of pods that can be evicted (default: 1)",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"evictionRateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionRateLimit defines the number of pods that can be evicted per second. A rate limit set to 0 or -1 will disable the rate limiter (default: -1).",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"evictionTolerance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionTolerance defines the fraction of replica count that can be evicted for update in case more than one pod can be evicted (default: 0.5).",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"recommendationMarginFraction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecommendationMarginFraction is the fraction of usage added as the safety margin to the recommended request (default: 0.15).",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"updaterInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdaterInterval is the interval how often the updater should run (default: 1m0s).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"recommenderInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecommenderInterval is the interval how often metrics should be fetched (default: 1m0s).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_Volume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Volume contains information about the volume type, size, and encryption.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the volume to make it referencable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeSize is the size of the volume.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"encrypted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encrypted determines if the volume should be encrypted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"size"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_VolumeType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeType contains certain properties of a volume type.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"class": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Class is the class of the volume type.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the volume type.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usable defines if the volume type can be used for shoot clusters.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"minSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinSize is the minimal supported storage size.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"class", "name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WatchCacheSizes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WatchCacheSizes contains configuration of the API server's watch cache sizes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"default": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Default configures the default watch cache size of the kube-apiserver (flag `--default-watch-cache-size`, defaults to 100). See: https://kubernetes.io/docs/reference/command-line-tools-reference/kube-apiserver/",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of addresses on which the Kube API server can be reached.",
+							Description: "Resources configures the watch cache size of the kube-apiserver per resource (flag `--watch-cache-sizes`). See: https://kubernetes.io/docs/reference/command-line-tools-reference/kube-apiserver/",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceWatchCacheSize"),
 									},
 								},
 							},
 						},
 					},
-					"migrationStartTime": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceWatchCacheSize"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Worker is the base definition of a worker group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MigrationStartTime is the time when a migration to a different seed was initiated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"credentials": {
+					"caBundle": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Credentials contains information about the shoot credentials.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials"),
+							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastHibernationTriggerTime": {
+					"cri": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastHibernationTriggerTime indicates the last time when the hibernation controller managed to change the hibernation settings of the cluster",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "CRI contains configurations of CRI support of every machine in the worker pool. Defaults to a CRI with name `containerd`.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI"),
 						},
 					},
-					"lastMaintenance": {
+					"kubernetes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastMaintenance holds information about the last maintenance operations on the Shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance"),
+							Description: "Kubernetes contains configuration for Kubernetes components related to this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes"),
 						},
 					},
-					"encryptedResources": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EncryptedResources is the list of resources in the Shoot which are currently encrypted. Secrets are encrypted by default and are not part of the list. See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.",
+							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker group.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machine": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Machine contains information about the machine type and image.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine"),
+						},
+					},
+					"maximum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum is the maximum number of machines to create. This value is divided by the number of configured zones for a fair distribution.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"minimum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Minimum is the minimum number of machines to create. This value is divided by the number of configured zones for a fair distribution.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge is maximum number of machines that are created during an update. This value is divided by the number of configured zones for a fair distribution.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of machines that can be unavailable during an update. This value is divided by the number of configured zones for a fair distribution.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the provider-specific configuration for this worker pool.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
+					"volume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volume contains information about the volume type and size.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume"),
+						},
+					},
+					"dataVolumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumes contains a list of additional worker volumes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume"),
+									},
+								},
+							},
+						},
+					},
+					"kubeletDataVolumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletDataVolumeName contains the name of a dataVolume that should be used for storing kubelet state.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is a list of availability zones that are used to evenly distribute this worker pool. Optional as not every provider may support availability zones.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -8292,22 +9611,135 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"systemComponents": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SystemComponents contains configuration for system components related to this worker pool",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents"),
+						},
+					},
+					"machineControllerManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineControllerManagerSettings contains configurations for different worker-pools. Eg. MachineDrainTimeout, MachineHealthTimeout.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings"),
+						},
+					},
+					"sysctls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sysctls is a map of kernel settings to apply on all machines in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "machine", "maximum", "minimum"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/runtime.RawExtension", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerKubernetes contains configuration for Kubernetes components related to this worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration settings for all kubelets of this worker pool. If set, all `spec.kubernetes.kubelet` settings will be overwritten for this worker pool (no merge of settings).",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig"),
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the semantic Kubernetes version to use for the Kubelet in this Worker Group. If not specified the kubelet version is derived from the global shoot cluster kubernetes version. version must be equal or lower than the version of the shoot kubernetes version. Only one minor version difference to other worker groups and global kubernetes version is allowed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerSystemComponents contains configuration for system components related to this worker pool",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allow determines whether the pool should be allowed to host system components or not (defaults to true)",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"allow"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkersSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkersSettings contains settings for all workers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sshAccess": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHAccess contains settings regarding ssh access to the worker nodes.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SSHAccess"),
+						},
+					},
 				},
-				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SSHAccess"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_Bastion(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootTemplate is a template for creating a Shoot object.",
+				Description: "Bastion holds details about an SSH bastion for a shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Standard object metadata.",
@@ -8317,314 +9749,392 @@ func schema_pkg_apis_core_v1beta1_ShootTemplate(ref common.ReferenceCallback) co
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the desired behavior of the Shoot.",
+							Description: "Specification of the Bastion.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec"),
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the Bastion.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus"),
 						},
 					},
 				},
+				Required: []string{"metadata", "spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec", "github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_BastionApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SystemComponents contains the settings of system components in the control or data plane of the Shoot cluster.",
+				Description: "BastionApproval contains information about the approval of a Bastion.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"coreDNS": {
+					"approvedBy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNS"),
+							Description: "ApprovedBy is the name of the user who approved the Bastion.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nodeLocalDNS": {
+					"approvalTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS"),
+							Description: "ApprovalTimestamp is the time when the Bastion was approved.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"approvedBy", "approvalTimestamp"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Toleration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Toleration is a toleration for a seed taint.",
+				Description: "BastionIngressPolicy represents an ingress policy for SSH bastion hosts.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"key": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Key is the toleration key to be applied to a project or shoot.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"value": {
+					"ipBlock": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value is the toleration value corresponding to the toleration key.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "IPBlock defines an IP block that is allowed to access the bastion.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/networking/v1.IPBlock"),
 						},
 					},
 				},
-				Required: []string{"key"},
+				Required: []string{"ipBlock"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/networking/v1.IPBlock"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_BastionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VerticalPodAutoscaler contains the configuration flags for the Kubernetes vertical pod autoscaler.",
+				Description: "BastionList is a list of Bastion objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled specifies whether the Kubernetes VPA shall be enabled for the shoot cluster.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"evictAfterOOMThreshold": {
-						SchemaProps: spec.SchemaProps{
-							Description: "EvictAfterOOMThreshold defines the threshold that will lead to pod eviction in case it OOMed in less than the given threshold since its start and if it has only one container (default: 10m0s).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"evictionRateBurst": {
-						SchemaProps: spec.SchemaProps{
-							Description: "EvictionRateBurst defines the burst of pods that can be evicted (default: 1)",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"evictionRateLimit": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EvictionRateLimit defines the number of pods that can be evicted per second. A rate limit set to 0 or -1 will disable the rate limiter (default: -1).",
-							Type:        []string{"number"},
-							Format:      "double",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"evictionTolerance": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EvictionTolerance defines the fraction of replica count that can be evicted for update in case more than one pod can be evicted (default: 0.5).",
-							Type:        []string{"number"},
-							Format:      "double",
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"recommendationMarginFraction": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RecommendationMarginFraction is the fraction of usage added as the safety margin to the recommended request (default: 0.15).",
-							Type:        []string{"number"},
-							Format:      "double",
+							Description: "Items is the list of Bastion.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion"),
+									},
+								},
+							},
 						},
 					},
-					"updaterInterval": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_BastionSSHCertificate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionSSHCertificate contains a short-lived SSH user certificate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"certificate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdaterInterval is the interval how often the updater should run (default: 1m0s).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "Certificate is the SSH user certificate in the authorized keys format.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"recommenderInterval": {
+					"validUntil": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RecommenderInterval is the interval how often metrics should be fetched (default: 1m0s).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "ValidUntil is the time when the certificate expires.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"certificate", "validUntil"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Volume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_BastionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Volume contains information about the volume type, size, and encryption.",
+				Description: "BastionSpec is the specification of a Bastion.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"shootRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the volume to make it referencable.",
+							Description: "ShootRef defines the target shoot for a Bastion. The name field of the ShootRef is immutable.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"seedName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedName is the name of the seed to which this Bastion is currently scheduled. This field is populated at the beginning of a create/reconcile operation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"providerType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the volume.",
+							Description: "ProviderType is cloud provider used by the referenced Shoot.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"size": {
+					"sshPublicKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeSize is the size of the volume.",
+							Description: "SSHPublicKey is the user's public key. This field is immutable.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"encrypted": {
+					"ingress": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Encrypted determines if the volume should be encrypted.",
+							Description: "Ingress controls from where the created bastion host should be reachable.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy"),
+									},
+								},
+							},
+						},
+					},
+					"approvalRequired": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovalRequired specifies whether the Bastion must be approved by another admin of the project before the bastion host is created. This field is populated by the API server based on the `.spec.bastion` settings of the Project and is immutable.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
+					"maxSessionDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSessionDuration is the maximum duration for which the Bastion may be used. This field is populated by the API server based on the `.spec.bastion` settings of the Project and is immutable.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
-				Required: []string{"size"},
+				Required: []string{"shootRef", "sshPublicKey", "ingress"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_VolumeType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_BastionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VolumeType contains certain properties of a volume type.",
+				Description: "BastionStatus holds the most recently observed status of the Bastion.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"class": {
+					"ingress": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Class is the class of the volume type.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Ingress holds the public IP and/or hostname of the bastion instance.",
+							Ref:         ref("k8s.io/api/core/v1.LoadBalancerIngress"),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of a Bastion's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"lastHeartbeatTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastHeartbeatTimestamp is the time when the bastion was last marked as not to be deleted. When this is set, the ExpirationTimestamp is advanced as well.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the time after which a Bastion is supposed to be garbage collected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"name": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the volume type.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation observed for this Bastion. It corresponds to the Bastion's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"usable": {
+					"approval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Usable defines if the volume type can be used for shoot clusters.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Approval contains information about the approval of the Bastion.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionApproval"),
 						},
 					},
-					"minSize": {
+					"sshCertificate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MinSize is the minimal supported storage size.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "SSHCertificate contains a short-lived SSH user certificate for the public key of the Bastion which is trusted by the worker nodes of the shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSSHCertificate"),
 						},
 					},
 				},
-				Required: []string{"class", "name"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionApproval", "github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSSHCertificate", "k8s.io/api/core/v1.LoadBalancerIngress", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_WatchCacheSizes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_seedmanagement_v1alpha1_Gardenlet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "WatchCacheSizes contains configuration of the API server's watch cache sizes.",
+				Description: "Gardenlet specifies gardenlet deployment parameters and the GardenletConfiguration used to configure gardenlet.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"default": {
+					"deployment": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Default configures the default watch cache size of the kube-apiserver (flag `--default-watch-cache-size`, defaults to 100). See: https://kubernetes.io/docs/reference/command-line-tools-reference/kube-apiserver/",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Deployment specifies certain gardenlet deployment parameters, such as the number of replicas, the image, etc.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletDeployment"),
 						},
 					},
-					"resources": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources configures the watch cache size of the kube-apiserver per resource (flag `--watch-cache-sizes`). See: https://kubernetes.io/docs/reference/command-line-tools-reference/kube-apiserver/",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceWatchCacheSize"),
-									},
-								},
-							},
+							Description: "Config is the GardenletConfiguration used to configure gardenlet.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"bootstrap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bootstrap is the mechanism that should be used for bootstrapping gardenlet connection to the Garden cluster. One of ServiceAccount, BootstrapToken, None. If set to ServiceAccount or BootstrapToken, a service account or a bootstrap token will be created in the garden cluster and used to compute the bootstrap kubeconfig. If set to None, the gardenClientConnection.kubeconfig field will be used to connect to the Garden cluster. Defaults to BootstrapToken. This field is immutable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mergeWithParent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MergeWithParent specifies whether the GardenletConfiguration of the parent gardenlet should be merged with the specified GardenletConfiguration. Defaults to true. This field is immutable.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceWatchCacheSize"},
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletDeployment", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_seedmanagement_v1alpha1_GardenletDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Worker is the base definition of a worker group.",
+				Description: "GardenletDeployment specifies certain gardenlet deployment parameters, such as the number of replicas, the image, etc.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"annotations": {
+					"replicaCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ReplicaCount is the number of gardenlet replicas. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"caBundle": {
+					"revisionHistoryLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
+							Description: "RevisionHistoryLimit is the number of old gardenlet ReplicaSets to retain to allow rollback. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"serviceAccountName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountName is the name of the ServiceAccount to use to run gardenlet pods.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"cri": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CRI contains configurations of CRI support of every machine in the worker pool. Defaults to a CRI with name `containerd`.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI"),
+							Description: "Image is the gardenlet container image.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Image"),
 						},
 					},
-					"kubernetes": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubernetes contains configuration for Kubernetes components related to this worker pool.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes"),
+							Description: "Resources are the compute resources required by the gardenlet container.",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
 						},
 					},
-					"labels": {
+					"podLabels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Description: "PodLabels are the labels on gardenlet pods.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -8638,224 +10148,270 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 							},
 						},
 					},
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the worker group.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"machine": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Machine contains information about the machine type and image.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine"),
-						},
-					},
-					"maximum": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Maximum is the maximum number of machines to create. This value is divided by the number of configured zones for a fair distribution.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"minimum": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Minimum is the minimum number of machines to create. This value is divided by the number of configured zones for a fair distribution.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"maxSurge": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MaxSurge is maximum number of machines that are created during an update. This value is divided by the number of configured zones for a fair distribution.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
-						},
-					},
-					"maxUnavailable": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MaxUnavailable is the maximum number of machines that can be unavailable during an update. This value is divided by the number of configured zones for a fair distribution.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
-						},
-					},
-					"providerConfig": {
+					"podAnnotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig is the provider-specific configuration for this worker pool.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "PodAnnotations are the annotations on gardenlet pods.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"taints": {
+					"additionalVolumes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Description: "AdditionalVolumes is the list of additional volumes that should be mounted by gardenlet containers.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Taint"),
+										Ref:     ref("k8s.io/api/core/v1.Volume"),
 									},
 								},
 							},
 						},
 					},
-					"volume": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Volume contains information about the volume type and size.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume"),
-						},
-					},
-					"dataVolumes": {
+					"additionalVolumeMounts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataVolumes contains a list of additional worker volumes.",
+							Description: "AdditionalVolumeMounts is the list of additional pod volumes to mount into the gardenlet container's filesystem.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume"),
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
 									},
 								},
 							},
 						},
 					},
-					"kubeletDataVolumeName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "KubeletDataVolumeName contains the name of a dataVolume that should be used for storing kubelet state.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"zones": {
+					"env": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones is a list of availability zones that are used to evenly distribute this worker pool. Optional as not every provider may support availability zones.",
+							Description: "Env is the list of environment variables to set in the gardenlet container.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
 									},
 								},
 							},
 						},
 					},
-					"systemComponents": {
+					"vpa": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SystemComponents contains configuration for system components related to this worker pool",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents"),
+							Description: "VPA specifies whether to enable VPA for gardenlet. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"machineControllerManager": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Image", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_pkg_apis_seedmanagement_v1alpha1_Image(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Image specifies container image parameters.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repository": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineControllerManagerSettings contains configurations for different worker-pools. Eg. MachineDrainTimeout, MachineHealthTimeout.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings"),
+							Description: "Repository is the image repository.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"sysctls": {
+					"tag": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Sysctls is a map of kernel settings to apply on all machines in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Tag is the image tag.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PullPolicy is the image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if latest tag is specified, or IfNotPresent otherwise.\n\nPossible enum values:\n - `\"Always\"` means that kubelet always attempts to pull the latest image. Container will fail If the pull fails.\n - `\"IfNotPresent\"` means that kubelet pulls if the image isn't present on disk. Container will fail if the image isn't present and the pull fails.\n - `\"Never\"` means that kubelet never pulls an image, but only uses a local image. Container will fail if the image isn't present",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Always", "IfNotPresent", "Never"},
 						},
 					},
 				},
-				Required: []string{"name", "machine", "maximum", "minimum"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/runtime.RawExtension", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeed(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "WorkerKubernetes contains configuration for Kubernetes components related to this worker pool.",
+				Description: "ManagedSeed represents a Shoot that is registered as Seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kubelet": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet contains configuration settings for all kubelets of this worker pool. If set, all `spec.kubernetes.kubelet` settings will be overwritten for this worker pool (no merge of settings).",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"version": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the semantic Kubernetes version to use for the Kubelet in this Worker Group. If not specified the kubelet version is derived from the global shoot cluster kubernetes version. version must be equal or lower than the version of the shoot kubernetes version. Only one minor version difference to other worker groups and global kubernetes version is allowed.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the ManagedSeed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the ManagedSeed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig"},
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSpec", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "WorkerSystemComponents contains configuration for system components related to this worker pool",
+				Description: "ManagedSeedList is a list of ManagedSeed objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"allow": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allow determines whether the pool should be allowed to host system components or not (defaults to true)",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ManagedSeeds.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeed"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"allow"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeed", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_WorkersSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "WorkersSettings contains settings for all workers.",
+				Description: "ManagedSeedSet represents a set of identical ManagedSeeds.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"sshAccess": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SSHAccess contains settings regarding ssh access to the worker nodes.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SSHAccess"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of ManagedSeeds and Shoots in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the current status of ManagedSeeds and Shoots in this ManagedSeedSet.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SSHAccess"},
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetSpec", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_operations_v1alpha1_Bastion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Bastion holds details about an SSH bastion for a shoot cluster.",
+				Description: "ManagedSeedSetList is a list of ManagedSeed objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8874,180 +10430,231 @@ func schema_pkg_apis_operations_v1alpha1_Bastion(ref common.ReferenceCallback) c
 					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the Bastion.",
+							Description: "Standard list object metadata.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec"),
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"status": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the Bastion.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus"),
+							Description: "Items is the list of ManagedSeedSets.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSet"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"metadata", "spec"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec", "github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BastionIngressPolicy represents an ingress policy for SSH bastion hosts.",
+				Description: "ManagedSeedSetSpec is the specification of a ManagedSeedSet.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ipBlock": {
+					"replicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPBlock defines an IP block that is allowed to access the bastion.",
+							Description: "Replicas is the desired number of replicas of the given Template. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector is a label query over ManagedSeeds and Shoots that should match the replica count. It must match the ManagedSeeds and Shoots template's labels. This field is immutable.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/networking/v1.IPBlock"),
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template describes the ManagedSeed that will be created if insufficient replicas are detected. Each ManagedSeed created / updated by the ManagedSeedSet will fulfill this template.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedTemplate"),
+						},
+					},
+					"shootTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootTemplate describes the Shoot that will be created if insufficient replicas are detected for hosting the corresponding ManagedSeed. Each Shoot created / updated by the ManagedSeedSet will fulfill this template.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootTemplate"),
+						},
+					},
+					"updateStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateStrategy specifies the UpdateStrategy that will be employed to update ManagedSeeds / Shoots in the ManagedSeedSet when a revision is made to Template / ShootTemplate.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.UpdateStrategy"),
+						},
+					},
+					"revisionHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RevisionHistoryLimit is the maximum number of revisions that will be maintained in the ManagedSeedSet's revision history. Defaults to 10. This field is immutable.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"ipBlock"},
+				Required: []string{"selector", "template", "shootTemplate"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/networking/v1.IPBlock"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootTemplate", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedTemplate", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.UpdateStrategy", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_pkg_apis_operations_v1alpha1_BastionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BastionList is a list of Bastion objects.",
+				Description: "ManagedSeedSetStatus represents the current state of a ManagedSeedSet.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this ManagedSeedSet. It corresponds to the ManagedSeedSet's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of replicas (ManagedSeeds and their corresponding Shoots) created by the ManagedSeedSet controller.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyReplicas is the number of ManagedSeeds created by the ManagedSeedSet controller that have a Ready Condition.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nextReplicaNumber": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextReplicaNumber is the ordinal number that will be assigned to the next replica of the ManagedSeedSet.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"currentReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentReplicas is the number of ManagedSeeds created by the ManagedSeedSet controller from the ManagedSeedSet version indicated by CurrentRevision.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"updatedReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatedReplicas is the number of ManagedSeeds created by the ManagedSeedSet controller from the ManagedSeedSet version indicated by UpdateRevision.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"currentRevision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "CurrentRevision, if not empty, indicates the version of the ManagedSeedSet used to generate ManagedSeeds with smaller ordinal numbers during updates.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"updateRevision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "UpdateRevision, if not empty, indicates the version of the ManagedSeedSet used to generate ManagedSeeds with larger ordinal numbers during updates",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"collisionCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "CollisionCount is the count of hash collisions for the ManagedSeedSet. The ManagedSeedSet controller uses this field as a collision avoidance mechanism when it needs to create the name for the newest ControllerRevision.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"items": {
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Bastion.",
+							Description: "Conditions represents the latest available observations of a ManagedSeedSet's current state.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
 									},
 								},
 							},
 						},
 					},
+					"pendingReplica": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingReplica, if not empty, indicates the replica that is currently pending creation, update, or deletion. This replica is in a state that requires the controller to wait for it to change before advancing to the next replica.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.PendingReplica"),
+						},
+					},
 				},
-				Required: []string{"items"},
+				Required: []string{"replicas"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.PendingReplica"},
 	}
 }
 
-func schema_pkg_apis_operations_v1alpha1_BastionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BastionSpec is the specification of a Bastion.",
+				Description: "ManagedSeedSpec is the specification of a ManagedSeed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"shootRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ShootRef defines the target shoot for a Bastion. The name field of the ShootRef is immutable.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
-						},
-					},
-					"seedName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SeedName is the name of the seed to which this Bastion is currently scheduled. This field is populated at the beginning of a create/reconcile operation.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"providerType": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ProviderType is cloud provider used by the referenced Shoot.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"sshPublicKey": {
+					"shoot": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SSHPublicKey is the user's public key. This field is immutable.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Shoot references a Shoot that should be registered as Seed. This field is immutable.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Shoot"),
 						},
 					},
-					"ingress": {
+					"gardenlet": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ingress controls from where the created bastion host should be reachable.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy"),
-									},
-								},
-							},
+							Description: "Gardenlet specifies that the ManagedSeed controller should deploy a gardenlet into the cluster with the given deployment parameters and GardenletConfiguration.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Gardenlet"),
 						},
 					},
 				},
-				Required: []string{"shootRef", "sshPublicKey", "ingress"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy", "k8s.io/api/core/v1.LocalObjectReference"},
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Gardenlet", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Shoot"},
 	}
 }
 
-func schema_pkg_apis_operations_v1alpha1_BastionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BastionStatus holds the most recently observed status of the Bastion.",
+				Description: "ManagedSeedStatus is the status of a ManagedSeed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ingress": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Ingress holds the public IP and/or hostname of the bastion instance.",
-							Ref:         ref("k8s.io/api/core/v1.LoadBalancerIngress"),
-						},
-					},
 					"conditions": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -9056,7 +10663,7 @@ func schema_pkg_apis_operations_v1alpha1_BastionStatus(ref common.ReferenceCallb
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of a Bastion's current state.",
+							Description: "Conditions represents the latest available observations of a ManagedSeed's current state.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -9068,21 +10675,9 @@ func schema_pkg_apis_operations_v1alpha1_BastionStatus(ref common.ReferenceCallb
 							},
 						},
 					},
-					"lastHeartbeatTimestamp": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastHeartbeatTimestamp is the time when the bastion was last marked as not to be deleted. When this is set, the ExpirationTimestamp is advanced as well.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"expirationTimestamp": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExpirationTimestamp is the time after which a Bastion is supposed to be garbage collected.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this Bastion. It corresponds to the Bastion's generation, which is updated on mutation by the API Server.",
+							Description: "ObservedGeneration is the most recent generation observed for this ManagedSeed. It corresponds to the ManagedSeed's generation, which is updated on mutation by the API Server.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
@@ -9091,221 +10686,160 @@ func schema_pkg_apis_operations_v1alpha1_BastionStatus(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "k8s.io/api/core/v1.LoadBalancerIngress", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_Gardenlet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Gardenlet specifies gardenlet deployment parameters and the GardenletConfiguration used to configure gardenlet.",
+				Description: "ManagedSeedTemplate is a template for creating a ManagedSeed object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"deployment": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Deployment specifies certain gardenlet deployment parameters, such as the number of replicas, the image, etc.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletDeployment"),
-						},
-					},
-					"config": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Config is the GardenletConfiguration used to configure gardenlet.",
+							Description: "Standard object metadata.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
-						},
-					},
-					"bootstrap": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Bootstrap is the mechanism that should be used for bootstrapping gardenlet connection to the Garden cluster. One of ServiceAccount, BootstrapToken, None. If set to ServiceAccount or BootstrapToken, a service account or a bootstrap token will be created in the garden cluster and used to compute the bootstrap kubeconfig. If set to None, the gardenClientConnection.kubeconfig field will be used to connect to the Garden cluster. Defaults to BootstrapToken. This field is immutable.",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"mergeWithParent": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MergeWithParent specifies whether the GardenletConfiguration of the parent gardenlet should be merged with the specified GardenletConfiguration. Defaults to true. This field is immutable.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Specification of the desired behavior of the ManagedSeed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletDeployment", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_GardenletDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_seedmanagement_v1alpha1_PendingReplica(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GardenletDeployment specifies certain gardenlet deployment parameters, such as the number of replicas, the image, etc.",
+				Description: "PendingReplica contains information about a replica that is currently pending creation, update, or deletion.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"replicaCount": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ReplicaCount is the number of gardenlet replicas. Defaults to 2.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"revisionHistoryLimit": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RevisionHistoryLimit is the number of old gardenlet ReplicaSets to retain to allow rollback. Defaults to 2.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"serviceAccountName": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServiceAccountName is the name of the ServiceAccount to use to run gardenlet pods.",
+							Description: "Name is the replica name.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"image": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Image is the gardenlet container image.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Image"),
-						},
-					},
-					"resources": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Resources are the compute resources required by the gardenlet container.",
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
-						},
-					},
-					"podLabels": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PodLabels are the labels on gardenlet pods.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"podAnnotations": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PodAnnotations are the annotations on gardenlet pods.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"additionalVolumes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AdditionalVolumes is the list of additional volumes that should be mounted by gardenlet containers.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Volume"),
-									},
-								},
-							},
-						},
-					},
-					"additionalVolumeMounts": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AdditionalVolumeMounts is the list of additional pod volumes to mount into the gardenlet container's filesystem.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
-									},
-								},
-							},
-						},
-					},
-					"env": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Env is the list of environment variables to set in the gardenlet container.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
-									},
-								},
-							},
+							Description: "Reason is the reason for the replica to be pending.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"vpa": {
+					"since": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VPA specifies whether to enable VPA for gardenlet. Defaults to true.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Since is the moment in time since the replica is pending with the specified reason.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"retries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retries is the number of times the shoot operation (reconcile or delete) has been retried after having failed. Only applicable if Reason is ShootReconciling or ShootDeleting.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"name", "reason", "since"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Image", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_Image(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Image specifies container image parameters.",
+				Description: "RollingUpdateStrategy is used to communicate parameters for RollingUpdateStrategyType.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repository": {
+					"partition": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Repository is the image repository.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Partition indicates the ordinal at which the ManagedSeedSet should be partitioned. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"tag": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_seedmanagement_v1alpha1_Shoot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Shoot identifies the Shoot that should be registered as Seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tag is the image tag.",
+							Description: "Name is the name of the Shoot that will be registered as Seed.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"pullPolicy": {
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_seedmanagement_v1alpha1_UpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UpdateStrategy specifies the strategy that the ManagedSeedSet controller will use to perform updates. It includes any additional parameters necessary to perform the update for the indicated strategy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PullPolicy is the image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if latest tag is specified, or IfNotPresent otherwise.\n\nPossible enum values:\n - `\"Always\"` means that kubelet always attempts to pull the latest image. Container will fail If the pull fails.\n - `\"IfNotPresent\"` means that kubelet pulls if the image isn't present on disk. Container will fail if the image isn't present and the pull fails.\n - `\"Never\"` means that kubelet never pulls an image, but only uses a local image. Container will fail if the image isn't present",
+							Description: "Type indicates the type of the UpdateStrategy. Defaults to RollingUpdate.",
 							Type:        []string{"string"},
 							Format:      "",
-							Enum:        []interface{}{"Always", "IfNotPresent", "Never"},
+						},
+					},
+					"rollingUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RollingUpdate is used to communicate parameters when Type is RollingUpdateStrategyType.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.RollingUpdateStrategy"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.RollingUpdateStrategy"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeed(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPreset(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ManagedSeed represents a Shoot that is registered as Seed.",
+				Description: "ClusterOpenIDConnectPreset is a OpenID Connect configuration that is applied to a Shoot objects cluster-wide.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -9331,31 +10865,25 @@ func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeed(ref common.ReferenceCal
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the ManagedSeed.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the ManagedSeed.",
+							Description: "Spec is the specification of this OpenIDConnect preset.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedStatus"),
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetSpec"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSpec", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ManagedSeedList is a list of ManagedSeed objects.",
+				Description: "ClusterOpenIDConnectPresetList is a collection of ClusterOpenIDConnectPresets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -9381,13 +10909,13 @@ func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedList(ref common.Referenc
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ManagedSeeds.",
+							Description: "Items is the list of ClusterOpenIDConnectPresets.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeed"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPreset"),
 									},
 								},
 							},
@@ -9398,15 +10926,64 @@ func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedList(ref common.Referenc
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeed", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPreset", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ManagedSeedSet represents a set of identical ManagedSeeds.",
+				Description: "ClusterOpenIDConnectPresetSpec contains the OpenIDConnect specification and project selector matching Shoots in Projects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"server": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Server contains the kube-apiserver's OpenID Connect configuration. This configuration is not overwriting any existing OpenID Connect configuration already set on the Shoot object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect"),
+						},
+					},
+					"client": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Client contains the configuration used for client OIDC authentication of Shoot clusters. This configuration is not overwriting any existing OpenID Connect client authentication already set on the Shoot object.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication"),
+						},
+					},
+					"shootSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootSelector decides whether to apply the configuration if the Shoot has matching labels. Use the selector only if the OIDC Preset is opt-in, because end users may skip the admission by setting the labels. Default to the empty LabelSelector, which matches everything.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight associated with matching the corresponding preset, in the range 1-100. Required.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"projectSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Project decides whether to apply the configuration if the Shoot is in a specific Project matching the label selector. Use the selector only if the OIDC Preset is opt-in, because end users may skip the admission by setting the labels. Defaults to the empty LabelSelector, which matches everything.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+				Required: []string{"server", "weight"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect", "github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ClusterShootDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterShootDefaults contains default values which are applied to new Shoots cluster-wide.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -9432,31 +11009,25 @@ func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSet(ref common.Reference
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of ManagedSeeds and Shoots in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Status is the current status of ManagedSeeds and Shoots in this ManagedSeedSet.",
+							Description: "Spec is the specification of this ClusterShootDefaults.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetStatus"),
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootDefaultsSpec"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetSpec", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootDefaultsSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ClusterShootDefaultsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ManagedSeedSetList is a list of ManagedSeed objects.",
+				Description: "ClusterShootDefaultsList is a collection of ClusterShootDefaults.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -9482,13 +11053,13 @@ func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetList(ref common.Refer
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ManagedSeedSets.",
+							Description: "Items is the list of ClusterShootDefaults.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSet"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootDefaults"),
 									},
 								},
 							},
@@ -9499,392 +11070,465 @@ func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetList(ref common.Refer
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootDefaults", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ClusterShootDefaultsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ManagedSeedSetSpec is the specification of a ManagedSeedSet.",
+				Description: "ClusterShootDefaultsSpec contains the ShootDefaults specification and the project selector matching Shoots in Projects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"replicas": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Replicas is the desired number of replicas of the given Template. Defaults to 1.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"selector": {
+					"shootSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selector is a label query over ManagedSeeds and Shoots that should match the replica count. It must match the ManagedSeeds and Shoots template's labels. This field is immutable.",
-							Default:     map[string]interface{}{},
+							Description: "ShootSelector decides whether to apply the defaults if the Shoot has matching labels. Defaults to the empty LabelSelector, which matches everything.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"template": {
+					"weight": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template describes the ManagedSeed that will be created if insufficient replicas are detected. Each ManagedSeed created / updated by the ManagedSeedSet will fulfill this template.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedTemplate"),
+							Description: "Weight associated with matching the corresponding defaults, in the range 1-100. Defaults with a higher weight take precedence over defaults with a lower weight. Required.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"shootTemplate": {
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootTemplate describes the Shoot that will be created if insufficient replicas are detected for hosting the corresponding ManagedSeed. Each Shoot created / updated by the ManagedSeedSet will fulfill this template.",
+							Description: "Template contains the default values for the Shoot.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootTemplate"),
-						},
-					},
-					"updateStrategy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "UpdateStrategy specifies the UpdateStrategy that will be employed to update ManagedSeeds / Shoots in the ManagedSeedSet when a revision is made to Template / ShootTemplate.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.UpdateStrategy"),
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaultsTemplate"),
 						},
 					},
-					"revisionHistoryLimit": {
+					"projectSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RevisionHistoryLimit is the maximum number of revisions that will be maintained in the ManagedSeedSet's revision history. Defaults to 10. This field is immutable.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "ProjectSelector decides whether to apply the defaults if the Shoot is in a Project matching the label selector. Defaults to the empty LabelSelector, which matches everything.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
 				},
-				Required: []string{"selector", "template", "shootTemplate"},
+				Required: []string{"weight", "template"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootTemplate", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedTemplate", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.UpdateStrategy", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaultsTemplate", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ClusterShootPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ManagedSeedSetStatus represents the current state of a ManagedSeedSet.",
+				Description: "ClusterShootPolicy is a set of CEL rules which are evaluated against Shoots cluster-wide.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this ManagedSeedSet. It corresponds to the ManagedSeedSet's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"replicas": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Replicas is the number of replicas (ManagedSeeds and their corresponding Shoots) created by the ManagedSeedSet controller.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"readyReplicas": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ReadyReplicas is the number of ManagedSeeds created by the ManagedSeedSet controller that have a Ready Condition.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nextReplicaNumber": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NextReplicaNumber is the ordinal number that will be assigned to the next replica of the ManagedSeedSet.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"currentReplicas": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CurrentReplicas is the number of ManagedSeeds created by the ManagedSeedSet controller from the ManagedSeedSet version indicated by CurrentRevision.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"updatedReplicas": {
-						SchemaProps: spec.SchemaProps{
-							Description: "UpdatedReplicas is the number of ManagedSeeds created by the ManagedSeedSet controller from the ManagedSeedSet version indicated by UpdateRevision.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of this ClusterShootPolicy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootPolicySpec"),
 						},
 					},
-					"currentRevision": {
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootPolicySpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ClusterShootPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterShootPolicyList is a collection of ClusterShootPolicies.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CurrentRevision, if not empty, indicates the version of the ManagedSeedSet used to generate ManagedSeeds with smaller ordinal numbers during updates.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"updateRevision": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateRevision, if not empty, indicates the version of the ManagedSeedSet used to generate ManagedSeeds with larger ordinal numbers during updates",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"collisionCount": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CollisionCount is the count of hash collisions for the ManagedSeedSet. The ManagedSeedSet controller uses this field as a collision avoidance mechanism when it needs to create the name for the newest ControllerRevision.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of a ManagedSeedSet's current state.",
+							Description: "Items is the list of ClusterShootPolicies.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootPolicy"),
 									},
 								},
 							},
 						},
 					},
-					"pendingReplica": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PendingReplica, if not empty, indicates the replica that is currently pending creation, update, or deletion. This replica is in a state that requires the controller to wait for it to change before advancing to the next replica.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.PendingReplica"),
-						},
-					},
 				},
-				Required: []string{"replicas"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.PendingReplica"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootPolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ClusterShootPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ManagedSeedSpec is the specification of a ManagedSeed.",
+				Description: "ClusterShootPolicySpec contains the ShootPolicy specification and the project selector matching Shoots in Projects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"shoot": {
+					"shootSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Shoot references a Shoot that should be registered as Seed. This field is immutable.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Shoot"),
+							Description: "ShootSelector decides whether to evaluate the rules if the Shoot has matching labels. Defaults to the empty LabelSelector, which matches everything.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"gardenlet": {
+					"rules": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Gardenlet specifies that the ManagedSeed controller should deploy a gardenlet into the cluster with the given deployment parameters and GardenletConfiguration.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Gardenlet"),
+							Description: "Rules is a list of rules which are evaluated against the Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicyRule"),
+									},
+								},
+							},
+						},
+					},
+					"projectSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProjectSelector decides whether to evaluate the rules if the Shoot is in a Project matching the label selector. Defaults to the empty LabelSelector, which matches everything.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
 				},
+				Required: []string{"rules"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Gardenlet", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Shoot"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicyRule", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_KubeAPIServerOpenIDConnect(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ManagedSeedStatus is the status of a ManagedSeed.",
+				Description: "KubeAPIServerOpenIDConnect contains configuration settings for the OIDC provider. Note: Descriptions were taken from the Kubernetes documentation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
+					"caBundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, the OpenID server's certificate will be verified by one of the authorities in the oidc-ca-file, otherwise the host's root CA set will be used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The client ID for the OpenID Connect client. Required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"groupsClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If provided, the name of a custom OpenID Connect claim for specifying user groups. The claim value is expected to be a string or array of strings. This field is experimental, please see the authentication documentation for further details.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"groupsPrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If provided, all groups will be prefixed with this value to prevent conflicts with other authentication strategies.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"issuerURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The URL of the OpenID issuer, only HTTPS scheme will be accepted. If set, it will be used to verify the OIDC JSON Web Token (JWT). Required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requiredClaims": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key=value pairs that describes a required claim in the ID Token. If set, the claim is verified to be present in the ID Token with a matching value.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
 							},
 						},
+					},
+					"signingAlgs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of a ManagedSeed's current state.",
+							Description: "List of allowed JOSE asymmetric signing algorithms. JWTs with a 'alg' header value not in this list will be rejected. Values are defined by RFC 7518 https://tools.ietf.org/html/rfc7518#section-3.1 Defaults to [RS256]",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"observedGeneration": {
+					"usernameClaim": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this ManagedSeed. It corresponds to the ManagedSeed's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "The OpenID claim to use as the user name. Note that claims other than the default ('sub') is not guaranteed to be unique and immutable. This field is experimental, please see the authentication documentation for further details. Defaults to \"sub\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usernamePrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If provided, all usernames will be prefixed with this value. If not provided, username claims other than 'email' are prefixed by the issuer URL to avoid clashes. To skip any prefixing, provide the value '-'.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"clientID", "issuerURL"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_OpenIDConnectClientAuthentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ManagedSeedTemplate is a template for creating a ManagedSeed object.",
+				Description: "OpenIDConnectClientAuthentication contains configuration for OIDC clients.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"metadata": {
+					"secret": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "The client Secret for the OpenID Connect client.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"extraConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the desired behavior of the ManagedSeed.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSpec"),
+							Description: "Extra configuration added to kubeconfig's auth-provider. Must not be any of idp-issuer-url, client-id, client-secret, idp-certificate-authority, idp-certificate-authority-data, id-token or refresh-token",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_PendingReplica(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPreset(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PendingReplica contains information about a replica that is currently pending creation, update, or deletion.",
+				Description: "OpenIDConnectPreset is a OpenID Connect configuration that is applied to a Shoot in a namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the replica name.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is the reason for the replica to be pending.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"since": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Since is the moment in time since the replica is pending with the specified reason.",
+							Description: "Standard object metadata.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"retries": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Retries is the number of times the shoot operation (reconcile or delete) has been retried after having failed. Only applicable if Reason is ShootReconciling or ShootDeleting.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Spec is the specification of this OpenIDConnect preset.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetSpec"),
 						},
 					},
 				},
-				Required: []string{"name", "reason", "since"},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RollingUpdateStrategy is used to communicate parameters for RollingUpdateStrategyType.",
+				Description: "OpenIDConnectPresetList is a collection of OpenIDConnectPresets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"partition": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Partition indicates the ordinal at which the ManagedSeedSet should be partitioned. Defaults to 0.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of OpenIDConnectPresets.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPreset"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPreset", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_seedmanagement_v1alpha1_Shoot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Shoot identifies the Shoot that should be registered as Seed.",
+				Description: "OpenIDConnectPresetSpec contains the Shoot selector for which a specific OpenID Connect configuration is applied.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"server": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the Shoot that will be registered as Seed.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Server contains the kube-apiserver's OpenID Connect configuration. This configuration is not overwriting any existing OpenID Connect configuration already set on the Shoot object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect"),
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_seedmanagement_v1alpha1_UpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "UpdateStrategy specifies the strategy that the ManagedSeedSet controller will use to perform updates. It includes any additional parameters necessary to perform the update for the indicated strategy.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
+					"client": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type indicates the type of the UpdateStrategy. Defaults to RollingUpdate.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Client contains the configuration used for client OIDC authentication of Shoot clusters. This configuration is not overwriting any existing OpenID Connect client authentication already set on the Shoot object.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication"),
 						},
 					},
-					"rollingUpdate": {
+					"shootSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RollingUpdate is used to communicate parameters when Type is RollingUpdateStrategyType.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.RollingUpdateStrategy"),
+							Description: "ShootSelector decides whether to apply the configuration if the Shoot has matching labels. Use the selector only if the OIDC Preset is opt-in, because end users may skip the admission by setting the labels. Default to the empty LabelSelector, which matches everything.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight associated with matching the corresponding preset, in the range 1-100. Required.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"server", "weight"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.RollingUpdateStrategy"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect", "github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPreset(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ShootDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterOpenIDConnectPreset is a OpenID Connect configuration that is applied to a Shoot objects cluster-wide.",
+				Description: "ShootDefaults contains default values which are applied to new Shoots in a namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -9910,9 +11554,9 @@ func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPreset(ref common.Ref
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec is the specification of this OpenIDConnect preset.",
+							Description: "Spec is the specification of this ShootDefaults.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetSpec"),
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaultsSpec"),
 						},
 					},
 				},
@@ -9920,15 +11564,15 @@ func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPreset(ref common.Ref
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaultsSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ShootDefaultsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterOpenIDConnectPresetList is a collection of ClusterOpenIDConnectPresets.",
+				Description: "ShootDefaultsList is a collection of ShootDefaults.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -9954,13 +11598,13 @@ func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetList(ref common
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ClusterOpenIDConnectPresets.",
+							Description: "Items is the list of ShootDefaults.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPreset"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaults"),
 									},
 								},
 							},
@@ -9971,172 +11615,124 @@ func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetList(ref common
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPreset", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaults", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ShootDefaultsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterOpenIDConnectPresetSpec contains the OpenIDConnect specification and project selector matching Shoots in Projects.",
+				Description: "ShootDefaultsSpec contains the Shoot selector and the default values of a ShootDefaults.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"server": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Server contains the kube-apiserver's OpenID Connect configuration. This configuration is not overwriting any existing OpenID Connect configuration already set on the Shoot object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect"),
-						},
-					},
-					"client": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Client contains the configuration used for client OIDC authentication of Shoot clusters. This configuration is not overwriting any existing OpenID Connect client authentication already set on the Shoot object.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication"),
-						},
-					},
 					"shootSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootSelector decides whether to apply the configuration if the Shoot has matching labels. Use the selector only if the OIDC Preset is opt-in, because end users may skip the admission by setting the labels. Default to the empty LabelSelector, which matches everything.",
+							Description: "ShootSelector decides whether to apply the defaults if the Shoot has matching labels. Defaults to the empty LabelSelector, which matches everything.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
 					"weight": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Weight associated with matching the corresponding preset, in the range 1-100. Required.",
+							Description: "Weight associated with matching the corresponding defaults, in the range 1-100. Defaults with a higher weight take precedence over defaults with a lower weight. Required.",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"projectSelector": {
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Project decides whether to apply the configuration if the Shoot is in a specific Project matching the label selector. Use the selector only if the OIDC Preset is opt-in, because end users may skip the admission by setting the labels. Defaults to the empty LabelSelector, which matches everything.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+							Description: "Template contains the default values for the Shoot.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaultsTemplate"),
 						},
 					},
 				},
-				Required: []string{"server", "weight"},
+				Required: []string{"weight", "template"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect", "github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaultsTemplate", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_KubeAPIServerOpenIDConnect(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ShootDefaultsTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeAPIServerOpenIDConnect contains configuration settings for the OIDC provider. Note: Descriptions were taken from the Kubernetes documentation.",
+				Description: "ShootDefaultsTemplate contains the default values which are applied to a Shoot. Values which are already set in the Shoot are never overwritten.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"caBundle": {
-						SchemaProps: spec.SchemaProps{
-							Description: "If set, the OpenID server's certificate will be verified by one of the authorities in the oidc-ca-file, otherwise the host's root CA set will be used.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"clientID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The client ID for the OpenID Connect client. Required.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"groupsClaim": {
-						SchemaProps: spec.SchemaProps{
-							Description: "If provided, the name of a custom OpenID Connect claim for specifying user groups. The claim value is expected to be a string or array of strings. This field is experimental, please see the authentication documentation for further details.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"groupsPrefix": {
+					"kubelet": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If provided, all groups will be prefixed with this value to prevent conflicts with other authentication strategies.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Kubelet contains default kubelet settings which are applied to `.spec.kubernetes.kubelet`. Only the top-level fields which are not set in the Shoot are defaulted.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig"),
 						},
 					},
-					"issuerURL": {
+					"maintenanceTimeWindow": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The URL of the OpenID issuer, only HTTPS scheme will be accepted. If set, it will be used to verify the OIDC JSON Web Token (JWT). Required.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaintenanceTimeWindow is the default maintenance time window. It is only applied if the Shoot does not specify one.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow"),
 						},
 					},
-					"requiredClaims": {
+					"hibernationSchedules": {
 						SchemaProps: spec.SchemaProps{
-							Description: "key=value pairs that describes a required claim in the ID Token. If set, the claim is verified to be present in the ID Token with a matching value.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "HibernationSchedules are the default hibernation schedules. They are only applied if the Shoot does not specify any hibernation schedule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule"),
 									},
 								},
 							},
 						},
 					},
-					"signingAlgs": {
+					"extensions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of allowed JOSE asymmetric signing algorithms. JWTs with a 'alg' header value not in this list will be rejected. Values are defined by RFC 7518 https://tools.ietf.org/html/rfc7518#section-3.1 Defaults to [RS256]",
+							Description: "Extensions are added to the Shoot unless it already configures an extension of the same type.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension"),
 									},
 								},
 							},
 						},
 					},
-					"usernameClaim": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The OpenID claim to use as the user name. Note that claims other than the default ('sub') is not guaranteed to be unique and immutable. This field is experimental, please see the authentication documentation for further details. Defaults to \"sub\".",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"usernamePrefix": {
+					"worker": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If provided, all usernames will be prefixed with this value. If not provided, username claims other than 'email' are prefixed by the issuer URL to avoid clashes. To skip any prefixing, provide the value '-'.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Worker contains default values which are applied to all worker pools of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaultsWorker"),
 						},
 					},
 				},
-				Required: []string{"clientID", "issuerURL"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow", "github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaultsWorker"},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_OpenIDConnectClientAuthentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ShootDefaultsWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenIDConnectClientAuthentication contains configuration for OIDC clients.",
+				Description: "ShootDefaultsWorker contains default values for the worker pools of a Shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"secret": {
+					"machineImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The client Secret for the OpenID Connect client.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MachineImage is the default machine image. It is only applied if the worker pool does not specify one.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMachineImage"),
 						},
 					},
-					"extraConfig": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Extra configuration added to kubeconfig's auth-provider. Must not be any of idp-issuer-url, client-id, client-secret, idp-certificate-authority, idp-certificate-authority-data, id-token or refresh-token",
+							Description: "Labels are added to the worker pool unless it already specifies a label with the same key.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -10150,17 +11746,33 @@ func schema_pkg_apis_settings_v1alpha1_OpenIDConnectClientAuthentication(ref com
 							},
 						},
 					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Taints are added to the worker pool unless it already specifies a taint with the same key.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint"},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPreset(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ShootPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenIDConnectPreset is a OpenID Connect configuration that is applied to a Shoot in a namespace.",
+				Description: "ShootPolicy is a set of CEL rules which are evaluated against Shoots in a namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -10186,9 +11798,9 @@ func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPreset(ref common.ReferenceC
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec is the specification of this OpenIDConnect preset.",
+							Description: "Spec is the specification of this ShootPolicy.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetSpec"),
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicySpec"),
 						},
 					},
 				},
@@ -10196,15 +11808,15 @@ func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPreset(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicySpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ShootPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenIDConnectPresetList is a collection of OpenIDConnectPresets.",
+				Description: "ShootPolicyList is a collection of ShootPolicies.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -10230,13 +11842,13 @@ func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetList(ref common.Refere
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of OpenIDConnectPresets.",
+							Description: "Items is the list of ShootPolicies.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPreset"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicy"),
 									},
 								},
 							},
@@ -10247,50 +11859,88 @@ func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetList(ref common.Refere
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPreset", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ShootPolicyRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenIDConnectPresetSpec contains the Shoot selector for which a specific OpenID Connect configuration is applied.",
+				Description: "ShootPolicyRule is a CEL rule which is evaluated against a Shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"server": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Server contains the kube-apiserver's OpenID Connect configuration. This configuration is not overwriting any existing OpenID Connect configuration already set on the Shoot object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect"),
+							Description: "Name is the name of the rule.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"client": {
+					"expression": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Client contains the configuration used for client OIDC authentication of Shoot clusters. This configuration is not overwriting any existing OpenID Connect client authentication already set on the Shoot object.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication"),
+							Description: "Expression is a CEL expression which must evaluate to true for a compliant Shoot. The Shoot is available as `object` in its v1beta1 representation. For updates, the existing Shoot is available as `oldObject`, otherwise it is `null`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the message which is returned if the expression evaluates to false.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is the action which is taken if the expression evaluates to false. Possible values are `Deny`, `Warn`, and `Audit`. Defaults to `Deny`.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
+				},
+				Required: []string{"name", "expression", "message"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ShootPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootPolicySpec contains the Shoot selector and the rules of a ShootPolicy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
 					"shootSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootSelector decides whether to apply the configuration if the Shoot has matching labels. Use the selector only if the OIDC Preset is opt-in, because end users may skip the admission by setting the labels. Default to the empty LabelSelector, which matches everything.",
+							Description: "ShootSelector decides whether to evaluate the rules if the Shoot has matching labels. Defaults to the empty LabelSelector, which matches everything.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"weight": {
+					"rules": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Weight associated with matching the corresponding preset, in the range 1-100. Required.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Rules is a list of rules which are evaluated against the Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicyRule"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"server", "weight"},
+				Required: []string{"rules"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect", "github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicyRule", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
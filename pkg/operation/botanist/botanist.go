@@ -89,6 +89,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 	if err != nil {
 		return nil, err
 	}
+	o.Shoot.Components.Extensions.Hook = b.DefaultHook()
 	if !o.Shoot.IsWorkerless {
 		o.Shoot.Components.Extensions.ContainerRuntime = b.DefaultContainerRuntime()
 		o.Shoot.Components.Extensions.ControlPlane = b.DefaultControlPlane(extensionsv1alpha1.Normal)
@@ -0,0 +1,215 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/component/apiserver"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// IsClonePhase returns true when the shoot is cloned from the ETCD backup of another shoot, i.e., it references a
+// source BackupEntry and is currently being created.
+func (b *Botanist) IsClonePhase() bool {
+	return b.Shoot.GetInfo().Spec.Source != nil && v1beta1helper.ShootHasOperationType(b.Shoot.GetInfo().Status.LastOperation, gardencorev1beta1.LastOperationTypeCreate)
+}
+
+// getCloneSourceBackupEntry returns the source BackupEntry of a cloned shoot. The backups of the source shoot can only
+// be copied if the source BackupEntry is scheduled to the same seed and stored in the same backup bucket as the
+// backups of the cloned shoot.
+func (b *Botanist) getCloneSourceBackupEntry(ctx context.Context) (*gardencorev1beta1.BackupEntry, error) {
+	backupEntry := &gardencorev1beta1.BackupEntry{}
+	if err := b.GardenClient.Get(ctx, kubernetesutils.Key(b.Shoot.GetInfo().Namespace, b.Shoot.GetInfo().Spec.Source.BackupEntryName), backupEntry); err != nil {
+		return nil, fmt.Errorf("failed reading source BackupEntry: %w", err)
+	}
+
+	if pointer.StringDeref(backupEntry.Spec.SeedName, "") != b.Seed.GetInfo().Name || backupEntry.Spec.BucketName != string(b.Seed.GetInfo().UID) {
+		return nil, fmt.Errorf("source BackupEntry %s must be scheduled to seed %s and stored in its backup bucket", client.ObjectKeyFromObject(backupEntry), b.Seed.GetInfo().Name)
+	}
+
+	return backupEntry, nil
+}
+
+// deployEtcdCopyBackupsTaskForClone deploys the EtcdCopyBackupsTask resource which copies the backups of the source
+// shoot to the backup location of the cloned shoot. Both are stored in the backup bucket of the seed, hence the backup
+// secret of the cloned shoot is used for both stores. Backups older than the maximum backup age of the source are not
+// copied.
+func (b *Botanist) deployEtcdCopyBackupsTaskForClone(ctx context.Context) error {
+	sourceBackupEntry, err := b.getCloneSourceBackupEntry(ctx)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{}
+	if err := b.SeedClientSet.Client().Get(ctx, kubernetesutils.Key(b.Shoot.SeedNamespace, v1beta1constants.BackupSecretName), secret); err != nil {
+		return err
+	}
+
+	var (
+		provider  = druidv1alpha1.StorageProvider(b.Seed.GetInfo().Spec.Backup.Provider)
+		container = string(secret.Data[v1beta1constants.DataKeyBackupBucketName])
+	)
+
+	b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.SetSourceStore(druidv1alpha1.StoreSpec{
+		Provider:  &provider,
+		SecretRef: &corev1.SecretReference{Name: secret.Name},
+		Prefix:    fmt.Sprintf("%s/etcd-%s", sourceBackupEntry.Name, v1beta1constants.ETCDRoleMain),
+		Container: &container,
+	})
+	b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.SetTargetStore(druidv1alpha1.StoreSpec{
+		Provider:  &provider,
+		SecretRef: &corev1.SecretReference{Name: secret.Name},
+		Prefix:    fmt.Sprintf("%s/etcd-%s", b.Shoot.BackupEntryName, v1beta1constants.ETCDRoleMain),
+		Container: &container,
+	})
+
+	var maxBackupAge *uint32
+	if age := b.Shoot.GetInfo().Spec.Source.MaxBackupAge; age != nil {
+		maxBackupAge = pointer.Uint32(uint32(*age))
+	}
+	b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.SetMaxBackupAge(maxBackupAge)
+
+	return b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.Deploy(ctx)
+}
+
+// restoreETCDEncryptionKeyFromCloneSourceForSecretsManagerAdoption copies the ETCD encryption key of the source shoot
+// to the shoot namespace in the seed before the secrets manager generates a new one. Without it, the kube-apiserver of
+// the cloned shoot could not decrypt the resources restored from the backup of the source shoot.
+func (b *Botanist) restoreETCDEncryptionKeyFromCloneSourceForSecretsManagerAdoption(ctx context.Context) error {
+	sourceBackupEntry, err := b.getCloneSourceBackupEntry(ctx)
+	if err != nil {
+		return err
+	}
+	sourceSeedNamespace, _ := gardenerutils.ExtractShootDetailsFromBackupEntryName(sourceBackupEntry.Name)
+
+	secretList := &corev1.SecretList{}
+	if err := b.SeedClientSet.Client().List(ctx, secretList, client.InNamespace(sourceSeedNamespace), client.MatchingLabels{
+		secretsmanager.LabelKeyName:            v1beta1constants.SecretNameETCDEncryptionKey,
+		secretsmanager.LabelKeyManagedBy:       secretsmanager.LabelValueSecretsManager,
+		secretsmanager.LabelKeyManagerIdentity: v1beta1constants.SecretManagerIdentityGardenlet,
+	}); err != nil {
+		return err
+	}
+
+	switch len(secretList.Items) {
+	case 0:
+		return fmt.Errorf("ETCD encryption key of source shoot not found in namespace %s, the source shoot must still exist on seed %s", sourceSeedNamespace, b.Seed.GetInfo().Name)
+	case 1:
+	default:
+		return fmt.Errorf("source shoot in namespace %s has %d ETCD encryption keys, the shoot cannot be cloned while the ETCD encryption key of the source shoot is being rotated", sourceSeedNamespace, len(secretList.Items))
+	}
+
+	// The secret is created with the name and labels the secrets manager computes for the cloned shoot so that it is
+	// adopted as the current ETCD encryption key, independent of whether the key of the source shoot was rotated.
+	objectMeta, err := secretsmanager.ObjectMeta(
+		b.Shoot.SeedNamespace,
+		v1beta1constants.SecretManagerIdentityGardenlet,
+		apiserver.ETCDEncryptionKeySecretConfig(v1beta1constants.SecretNameETCDEncryptionKey),
+		false,
+		"",
+		nil,
+		pointer.Bool(true),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	return client.IgnoreAlreadyExists(b.SeedClientSet.Client().Create(ctx, secretsmanager.Secret(objectMeta, secretList.Items[0].Data)))
+}
+
+// ResetNodeRelatedStateOfClone resets the node-related state which was restored from the backup of the source shoot.
+// All objects which were created before the cloned shoot belong to the source shoot: Nodes and VolumeAttachments are
+// deleted since they refer to machines of the source shoot, and the reclaim policy of PersistentVolumes is set to
+// 'Retain' so that volumes which are still used by the source shoot are never deleted by the cloned shoot.
+func (b *Botanist) ResetNodeRelatedStateOfClone(ctx context.Context) error {
+	var (
+		c                 = b.ShootClientSet.Client()
+		creationTimestamp = b.Shoot.GetInfo().CreationTimestamp
+		taskFns           []flow.TaskFn
+	)
+
+	persistentVolumeList := &corev1.PersistentVolumeList{}
+	if err := c.List(ctx, persistentVolumeList); err != nil {
+		return err
+	}
+
+	for _, pv := range persistentVolumeList.Items {
+		persistentVolume := pv.DeepCopy()
+		if !persistentVolume.CreationTimestamp.Before(&creationTimestamp) || persistentVolume.Spec.PersistentVolumeReclaimPolicy == corev1.PersistentVolumeReclaimRetain {
+			continue
+		}
+
+		taskFns = append(taskFns, func(ctx context.Context) error {
+			patch := client.MergeFrom(persistentVolume.DeepCopy())
+			persistentVolume.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+			return client.IgnoreNotFound(c.Patch(ctx, persistentVolume, patch))
+		})
+	}
+
+	volumeAttachmentList := &storagev1.VolumeAttachmentList{}
+	if err := c.List(ctx, volumeAttachmentList); err != nil {
+		return err
+	}
+
+	for _, va := range volumeAttachmentList.Items {
+		volumeAttachment := va.DeepCopy()
+		if !volumeAttachment.CreationTimestamp.Before(&creationTimestamp) {
+			continue
+		}
+
+		// The finalizers are removed so that the CSI attacher of the cloned shoot never detaches volumes from machines
+		// of the source shoot.
+		taskFns = append(taskFns, func(ctx context.Context) error {
+			if err := controllerutils.RemoveAllFinalizers(ctx, c, volumeAttachment); err != nil {
+				return client.IgnoreNotFound(err)
+			}
+			return client.IgnoreNotFound(c.Delete(ctx, volumeAttachment))
+		})
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := c.List(ctx, nodeList); err != nil {
+		return err
+	}
+
+	for _, n := range nodeList.Items {
+		node := n.DeepCopy()
+		if !node.CreationTimestamp.Before(&creationTimestamp) {
+			continue
+		}
+
+		taskFns = append(taskFns, func(ctx context.Context) error {
+			return client.IgnoreNotFound(c.Delete(ctx, node))
+		})
+	}
+
+	return flow.Parallel(taskFns...)(ctx)
+}
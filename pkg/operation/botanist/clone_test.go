@@ -0,0 +1,158 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Clone", func() {
+	var (
+		ctx = context.TODO()
+
+		shootClient client.Client
+		botanist    *Botanist
+
+		shootCreationTimestamp = metav1.NewTime(time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC))
+		beforeShootCreation    = metav1.NewTime(shootCreationTimestamp.Add(-time.Hour))
+		afterShootCreation     = metav1.NewTime(shootCreationTimestamp.Add(time.Hour))
+	)
+
+	BeforeEach(func() {
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				ShootClientSet: kubernetesfake.NewClientSetBuilder().WithClient(shootClient).Build(),
+				Shoot:          &shootpkg.Shoot{},
+			},
+		}
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "bar",
+				Namespace:         "garden-foo",
+				CreationTimestamp: shootCreationTimestamp,
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Source: &gardencorev1beta1.ShootSource{BackupEntryName: "shoot--foo--source--source-uid"},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeCreate},
+			},
+		})
+	})
+
+	Describe("#IsClonePhase", func() {
+		It("should return true if the shoot references a source and is being created", func() {
+			Expect(botanist.IsClonePhase()).To(BeTrue())
+		})
+
+		It("should return false if the shoot does not reference a source", func() {
+			shoot := botanist.Shoot.GetInfo()
+			shoot.Spec.Source = nil
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(botanist.IsClonePhase()).To(BeFalse())
+		})
+
+		It("should return false if the shoot has already been created", func() {
+			shoot := botanist.Shoot.GetInfo()
+			shoot.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(botanist.IsClonePhase()).To(BeFalse())
+		})
+	})
+
+	Describe("#ResetNodeRelatedStateOfClone", func() {
+		var (
+			sourceNode, node                         *corev1.Node
+			sourcePV, sourceRetainedPV, pv           *corev1.PersistentVolume
+			sourceVolumeAttachment, volumeAttachment *storagev1.VolumeAttachment
+		)
+
+		BeforeEach(func() {
+			sourceNode = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "source-node", CreationTimestamp: beforeShootCreation}}
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node", CreationTimestamp: afterShootCreation}}
+
+			sourcePV = &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "source-pv", CreationTimestamp: beforeShootCreation},
+				Spec:       corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete},
+			}
+			sourceRetainedPV = &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "source-retained-pv", CreationTimestamp: beforeShootCreation},
+				Spec:       corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain},
+			}
+			pv = &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "pv", CreationTimestamp: afterShootCreation},
+				Spec:       corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete},
+			}
+
+			sourceVolumeAttachment = &storagev1.VolumeAttachment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "source-volume-attachment",
+					CreationTimestamp: beforeShootCreation,
+					Finalizers:        []string{"external-attacher/foo"},
+				},
+			}
+			volumeAttachment = &storagev1.VolumeAttachment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "volume-attachment",
+					CreationTimestamp: afterShootCreation,
+					Finalizers:        []string{"external-attacher/foo"},
+				},
+			}
+
+			for _, obj := range []client.Object{sourceNode, node, sourcePV, sourceRetainedPV, pv, sourceVolumeAttachment, volumeAttachment} {
+				Expect(shootClient.Create(ctx, obj)).To(Succeed())
+			}
+		})
+
+		It("should reset the state which was restored from the source shoot", func() {
+			Expect(botanist.ResetNodeRelatedStateOfClone(ctx)).To(Succeed())
+
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(sourceNode), sourceNode)).To(BeNotFoundError())
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(sourceVolumeAttachment), sourceVolumeAttachment)).To(BeNotFoundError())
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(volumeAttachment), volumeAttachment)).To(Succeed())
+			Expect(volumeAttachment.Finalizers).To(ConsistOf("external-attacher/foo"))
+
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(sourcePV), sourcePV)).To(Succeed())
+			Expect(sourcePV.Spec.PersistentVolumeReclaimPolicy).To(Equal(corev1.PersistentVolumeReclaimRetain))
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(sourceRetainedPV), sourceRetainedPV)).To(Succeed())
+			Expect(sourceRetainedPV.Spec.PersistentVolumeReclaimPolicy).To(Equal(corev1.PersistentVolumeReclaimRetain))
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(pv), pv)).To(Succeed())
+			Expect(pv.Spec.PersistentVolumeReclaimPolicy).To(Equal(corev1.PersistentVolumeReclaimDelete))
+		})
+	})
+})
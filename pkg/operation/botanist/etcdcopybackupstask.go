@@ -33,17 +33,23 @@ var NewEtcdCopyBackupsTask = etcdcopybackupstask.New
 
 // DefaultEtcdCopyBackupsTask creates the default deployer for the EtcdCopyBackupsTask resource.
 func (b *Botanist) DefaultEtcdCopyBackupsTask() etcdcopybackupstask.Interface {
+	values := &etcdcopybackupstask.Values{
+		Name:      b.Shoot.GetInfo().Name,
+		Namespace: b.Shoot.SeedNamespace,
+	}
+
+	// The source shoot of a cloned shoot keeps running, hence there is no final snapshot to wait for.
+	if !b.IsClonePhase() {
+		values.WaitForFinalSnapshot = &druidv1alpha1.WaitForFinalSnapshotSpec{
+			Enabled: true,
+			Timeout: &metav1.Duration{Duration: etcdcopybackupstask.DefaultTimeout},
+		}
+	}
+
 	return NewEtcdCopyBackupsTask(
 		b.Logger,
 		b.SeedClientSet.Client(),
-		&etcdcopybackupstask.Values{
-			Name:      b.Shoot.GetInfo().Name,
-			Namespace: b.Shoot.SeedNamespace,
-			WaitForFinalSnapshot: &druidv1alpha1.WaitForFinalSnapshotSpec{
-				Enabled: true,
-				Timeout: &metav1.Duration{Duration: etcdcopybackupstask.DefaultTimeout},
-			},
-		},
+		values,
 		etcdcopybackupstask.DefaultInterval,
 		etcdcopybackupstask.DefaultSevereThreshold,
 		etcdcopybackupstask.DefaultTimeout,
@@ -59,6 +65,10 @@ func (b *Botanist) DeployEtcdCopyBackupsTask(ctx context.Context) error {
 		return err
 	}
 
+	if b.IsClonePhase() {
+		return b.deployEtcdCopyBackupsTaskForClone(ctx)
+	}
+
 	sourceBackupEntryName := fmt.Sprintf("%s-%s", v1beta1constants.BackupSourcePrefix, b.Shoot.BackupEntryName)
 	sourceBackupEntry := &extensionsv1alpha1.BackupEntry{}
 	if err := b.SeedClientSet.Client().Get(ctx, kubernetesutils.Key(sourceBackupEntryName), sourceBackupEntry); err != nil {
@@ -29,6 +29,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -125,6 +126,30 @@ var _ = Describe("EtcdCopyBackupsTask", func() {
 			etcdCopyBackupsTask := botanist.DefaultEtcdCopyBackupsTask()
 			Expect(etcdCopyBackupsTask).NotTo(BeNil())
 		})
+
+		It("should not wait for a final snapshot if the shoot is cloned", func() {
+			shoot := botanist.Shoot.GetInfo()
+			shoot.Spec.Source = &gardencorev1beta1.ShootSource{BackupEntryName: "source-backup-entry"}
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeCreate}
+			botanist.Shoot.SetInfo(shoot)
+
+			validator := &newEtcdCopyBackupsTaskValidator{
+				expectedClient: Equal(c),
+				expectedLogger: BeAssignableToTypeOf(logr.Logger{}),
+				expectedValues: Equal(&etcdcopybackupstask.Values{
+					Name:      botanist.Shoot.GetInfo().Name,
+					Namespace: botanist.Shoot.SeedNamespace,
+				}),
+				expectedWaitInterval:       Equal(etcdcopybackupstask.DefaultInterval),
+				expectedWaitSevereTreshold: Equal(etcdcopybackupstask.DefaultSevereThreshold),
+				expectedWaitTimeout:        Equal(etcdcopybackupstask.DefaultTimeout),
+			}
+
+			defer test.WithVars(&NewEtcdCopyBackupsTask, validator.NewEtcdCopyBackupsTask)()
+
+			etcdCopyBackupsTask := botanist.DefaultEtcdCopyBackupsTask()
+			Expect(etcdCopyBackupsTask).NotTo(BeNil())
+		})
 	})
 
 	Describe("#DeployEtcdCopyBackupsTask", func() {
@@ -233,6 +258,115 @@ var _ = Describe("EtcdCopyBackupsTask", func() {
 			etcdCopyBackupsTask.EXPECT().Deploy(ctx).Return(fakeErr)
 			Expect(botanist.DeployEtcdCopyBackupsTask(ctx)).To(MatchError(fakeErr))
 		})
+
+		Context("when shoot is cloned", func() {
+			var (
+				gardenClient           *mockclient.MockClient
+				cloneSourceBackupEntry *gardencorev1beta1.BackupEntry
+			)
+
+			BeforeEach(func() {
+				gardenClient = mockclient.NewMockClient(ctrl)
+				botanist.GardenClient = gardenClient
+
+				seed := botanist.Seed.GetInfo()
+				seed.UID = "seed-uid"
+				botanist.Seed.SetInfo(seed)
+
+				cloneSourceBackupEntry = &gardencorev1beta1.BackupEntry{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "shoot--foo--source--source-uid",
+						Namespace: projectName,
+					},
+					Spec: gardencorev1beta1.BackupEntrySpec{
+						BucketName: "seed-uid",
+						SeedName:   pointer.String(seedName),
+					},
+				}
+
+				shoot := botanist.Shoot.GetInfo()
+				shoot.Spec.Source = &gardencorev1beta1.ShootSource{BackupEntryName: cloneSourceBackupEntry.Name}
+				shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeCreate}
+				botanist.Shoot.SetInfo(shoot)
+
+				etcdBackupSecret.Data = map[string][]byte{"bucketName": []byte("bucket")}
+			})
+
+			It("should copy the backups of the source shoot within the backup bucket of the seed", func() {
+				var (
+					provider  = druidv1alpha1.StorageProvider("gcp")
+					container = "bucket"
+				)
+
+				etcdCopyBackupsTask.EXPECT().Destroy(ctx)
+				etcdCopyBackupsTask.EXPECT().WaitCleanup(ctx)
+				gardenClient.EXPECT().Get(ctx, client.ObjectKeyFromObject(cloneSourceBackupEntry), gomock.AssignableToTypeOf(cloneSourceBackupEntry)).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.BackupEntry, _ ...client.GetOption) error {
+						cloneSourceBackupEntry.DeepCopyInto(obj)
+						return nil
+					})
+				c.EXPECT().Get(ctx, client.ObjectKeyFromObject(etcdBackupSecret), gomock.AssignableToTypeOf(etcdBackupSecret)).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+						etcdBackupSecret.DeepCopyInto(obj)
+						return nil
+					})
+				etcdCopyBackupsTask.EXPECT().SetSourceStore(druidv1alpha1.StoreSpec{
+					Provider:  &provider,
+					SecretRef: &corev1.SecretReference{Name: "etcd-backup"},
+					Prefix:    "shoot--foo--source--source-uid/etcd-main",
+					Container: &container,
+				})
+				etcdCopyBackupsTask.EXPECT().SetTargetStore(druidv1alpha1.StoreSpec{
+					Provider:  &provider,
+					SecretRef: &corev1.SecretReference{Name: "etcd-backup"},
+					Prefix:    backupEntryName + "/etcd-main",
+					Container: &container,
+				})
+				etcdCopyBackupsTask.EXPECT().SetMaxBackupAge(nil)
+				etcdCopyBackupsTask.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeployEtcdCopyBackupsTask(ctx)).To(Succeed())
+			})
+
+			It("should only copy the backups of the source shoot which are not older than the maximum backup age", func() {
+				shoot := botanist.Shoot.GetInfo()
+				shoot.Spec.Source.MaxBackupAge = pointer.Int32(3)
+				botanist.Shoot.SetInfo(shoot)
+
+				etcdCopyBackupsTask.EXPECT().Destroy(ctx)
+				etcdCopyBackupsTask.EXPECT().WaitCleanup(ctx)
+				gardenClient.EXPECT().Get(ctx, client.ObjectKeyFromObject(cloneSourceBackupEntry), gomock.AssignableToTypeOf(cloneSourceBackupEntry)).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.BackupEntry, _ ...client.GetOption) error {
+						cloneSourceBackupEntry.DeepCopyInto(obj)
+						return nil
+					})
+				c.EXPECT().Get(ctx, client.ObjectKeyFromObject(etcdBackupSecret), gomock.AssignableToTypeOf(etcdBackupSecret)).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+						etcdBackupSecret.DeepCopyInto(obj)
+						return nil
+					})
+				etcdCopyBackupsTask.EXPECT().SetSourceStore(gomock.AssignableToTypeOf(druidv1alpha1.StoreSpec{}))
+				etcdCopyBackupsTask.EXPECT().SetTargetStore(gomock.AssignableToTypeOf(druidv1alpha1.StoreSpec{}))
+				etcdCopyBackupsTask.EXPECT().SetMaxBackupAge(pointer.Uint32(3))
+				etcdCopyBackupsTask.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeployEtcdCopyBackupsTask(ctx)).To(Succeed())
+			})
+
+			It("should return an error if the source backup entry is stored in a different bucket", func() {
+				cloneSourceBackupEntry.Spec.BucketName = "other-seed-uid"
+
+				etcdCopyBackupsTask.EXPECT().Destroy(ctx)
+				etcdCopyBackupsTask.EXPECT().WaitCleanup(ctx)
+				gardenClient.EXPECT().Get(ctx, client.ObjectKeyFromObject(cloneSourceBackupEntry), gomock.AssignableToTypeOf(cloneSourceBackupEntry)).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.BackupEntry, _ ...client.GetOption) error {
+						cloneSourceBackupEntry.DeepCopyInto(obj)
+						return nil
+					})
+
+				Expect(botanist.DeployEtcdCopyBackupsTask(ctx)).To(MatchError(ContainSubstring("must be scheduled to seed seed and stored in its backup bucket")))
+			})
+		})
 	})
 })
 
@@ -0,0 +1,112 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	"github.com/gardener/gardener/pkg/component/extensions/hook"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+)
+
+// DefaultHook creates the default deployer for the Extension custom resources of the Shoot's hooks.
+func (b *Botanist) DefaultHook() hook.Interface {
+	return hook.New(
+		b.Logger,
+		b.SeedClientSet.Client(),
+		&hook.Values{
+			Namespace: b.Shoot.SeedNamespace,
+			Hooks:     b.Shoot.GetInfo().Spec.Hooks,
+		},
+		hook.DefaultInterval,
+		hook.DefaultSevereThreshold,
+		hook.DefaultDeletionTimeout,
+	)
+}
+
+// ExecuteHooks executes the hooks of the Shoot registered for the given point.
+func (b *Botanist) ExecuteHooks(ctx context.Context, point gardencorev1beta1.HookPoint) error {
+	return b.Shoot.Components.Extensions.Hook.Execute(ctx, point)
+}
+
+// IsHibernationTransition returns true if the Shoot is about to be hibernated, i.e., hibernation is enabled but the
+// Shoot is not hibernated yet.
+func (b *Botanist) IsHibernationTransition() bool {
+	return b.Shoot.HibernationEnabled && !b.Shoot.GetInfo().Status.IsHibernated
+}
+
+// IsKubernetesUpgrade returns true if the running kube-apiserver of the Shoot has a lower version than the desired
+// Kubernetes version. The shoot cluster is only contacted if hooks are registered for the upgrade points.
+func (b *Botanist) IsKubernetesUpgrade(ctx context.Context) (bool, error) {
+	if b.Shoot.HibernationEnabled ||
+		(!b.Shoot.Components.Extensions.Hook.Has(gardencorev1beta1.HookPointPreKubernetesUpgrade) &&
+			!b.Shoot.Components.Extensions.Hook.Has(gardencorev1beta1.HookPointPostKubernetesUpgrade)) {
+		return false, nil
+	}
+
+	apiServerRunning, err := b.IsAPIServerRunning(ctx)
+	if err != nil || !apiServerRunning {
+		return false, err
+	}
+
+	shootClient, err := b.ShootClientMap.GetClient(ctx, keys.ForShoot(b.Shoot.GetInfo()))
+	if err != nil {
+		return false, err
+	}
+
+	return versionutils.CompareVersions(shootClient.Version(), "<", b.Shoot.GetInfo().Spec.Kubernetes.Version)
+}
+
+// RequirePostKubernetesUpgradeHooks records in the tasks annotation of the Shoot that its PostKubernetesUpgrade hooks
+// have to be executed. This way, they are still executed if the reconciliation fails after the kube-apiserver was
+// upgraded, i.e., when IsKubernetesUpgrade does no longer detect the upgrade in the next reconciliation.
+func (b *Botanist) RequirePostKubernetesUpgradeHooks(ctx context.Context) error {
+	if !b.Shoot.Components.Extensions.Hook.Has(gardencorev1beta1.HookPointPostKubernetesUpgrade) ||
+		controllerutils.HasTask(b.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskExecutePostKubernetesUpgradeHooks) {
+		return nil
+	}
+
+	return b.Shoot.UpdateInfo(ctx, b.GardenClient, false, func(shoot *gardencorev1beta1.Shoot) error {
+		if shoot.Annotations == nil {
+			shoot.Annotations = map[string]string{}
+		}
+		controllerutils.AddTasks(shoot.Annotations, v1beta1constants.ShootTaskExecutePostKubernetesUpgradeHooks)
+		return nil
+	})
+}
+
+// ArePostKubernetesUpgradeHooksPending returns true if the PostKubernetesUpgrade hooks of the Shoot still have to be
+// executed. They are kept pending while the Shoot is hibernated.
+func (b *Botanist) ArePostKubernetesUpgradeHooksPending() bool {
+	return !b.Shoot.HibernationEnabled &&
+		controllerutils.HasTask(b.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskExecutePostKubernetesUpgradeHooks)
+}
+
+// ExecutePostKubernetesUpgradeHooks executes the PostKubernetesUpgrade hooks of the Shoot and removes the corresponding
+// task from its tasks annotation once they succeeded.
+func (b *Botanist) ExecutePostKubernetesUpgradeHooks(ctx context.Context) error {
+	if err := b.ExecuteHooks(ctx, gardencorev1beta1.HookPointPostKubernetesUpgrade); err != nil {
+		return err
+	}
+
+	return b.Shoot.UpdateInfo(ctx, b.GardenClient, false, func(shoot *gardencorev1beta1.Shoot) error {
+		controllerutils.RemoveTasks(shoot.Annotations, v1beta1constants.ShootTaskExecutePostKubernetesUpgradeHooks)
+		return nil
+	})
+}
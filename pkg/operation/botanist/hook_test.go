@@ -0,0 +1,204 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	mockhook "github.com/gardener/gardener/pkg/component/extensions/hook/mock"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+)
+
+var _ = Describe("Hook", func() {
+	var (
+		ctrl     *gomock.Controller
+		hook     *mockhook.MockInterface
+		botanist *Botanist
+
+		ctx          = context.TODO()
+		fakeErr      = fmt.Errorf("fake")
+		gardenClient client.Client
+		shoot        *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		hook = mockhook.NewMockInterface(ctrl)
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Hooks: []gardencorev1beta1.Hook{{Name: "foo", Type: "bar", Point: gardencorev1beta1.HookPointPreDeletion}},
+			},
+		}
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(shoot).Build()
+		seedClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		botanist = &Botanist{Operation: &operation.Operation{
+			GardenClient:  gardenClient,
+			SeedClientSet: kubernetesfake.NewClientSetBuilder().WithAPIReader(seedClient).WithClient(seedClient).Build(),
+			Shoot: &shootpkg.Shoot{
+				Components: &shootpkg.Components{
+					Extensions: &shootpkg.Extensions{
+						Hook: hook,
+					},
+				},
+				SeedNamespace: "shoot--name--space",
+			},
+		}}
+		botanist.Shoot.SetInfo(shoot)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#DefaultHook", func() {
+		It("should create a component for the hooks of the shoot", func() {
+			hooks := botanist.DefaultHook()
+			Expect(hooks.Has(gardencorev1beta1.HookPointPreDeletion)).To(BeTrue())
+			Expect(hooks.Has(gardencorev1beta1.HookPointPreHibernation)).To(BeFalse())
+		})
+	})
+
+	Describe("#ExecuteHooks", func() {
+		It("should execute the hooks of the given point", func() {
+			hook.EXPECT().Execute(ctx, gardencorev1beta1.HookPointPreDeletion)
+			Expect(botanist.ExecuteHooks(ctx, gardencorev1beta1.HookPointPreDeletion)).To(Succeed())
+		})
+
+		It("should return the error of the hook execution", func() {
+			hook.EXPECT().Execute(ctx, gardencorev1beta1.HookPointPreDeletion).Return(fakeErr)
+			Expect(botanist.ExecuteHooks(ctx, gardencorev1beta1.HookPointPreDeletion)).To(MatchError(fakeErr))
+		})
+	})
+
+	Describe("#IsHibernationTransition", func() {
+		It("should return false if hibernation is not enabled", func() {
+			Expect(botanist.IsHibernationTransition()).To(BeFalse())
+		})
+
+		It("should return true if hibernation is enabled but the shoot is not hibernated yet", func() {
+			botanist.Shoot.HibernationEnabled = true
+			Expect(botanist.IsHibernationTransition()).To(BeTrue())
+		})
+
+		It("should return false if the shoot is already hibernated", func() {
+			botanist.Shoot.HibernationEnabled = true
+			shoot.Status.IsHibernated = true
+			Expect(botanist.IsHibernationTransition()).To(BeFalse())
+		})
+	})
+
+	Describe("#IsKubernetesUpgrade", func() {
+		It("should return false if hibernation is enabled", func() {
+			botanist.Shoot.HibernationEnabled = true
+			Expect(botanist.IsKubernetesUpgrade(ctx)).To(BeFalse())
+		})
+
+		It("should return false if no hooks are registered for the upgrade points", func() {
+			hook.EXPECT().Has(gardencorev1beta1.HookPointPreKubernetesUpgrade).Return(false)
+			hook.EXPECT().Has(gardencorev1beta1.HookPointPostKubernetesUpgrade).Return(false)
+			Expect(botanist.IsKubernetesUpgrade(ctx)).To(BeFalse())
+		})
+
+		It("should return false if the kube-apiserver is not running", func() {
+			hook.EXPECT().Has(gardencorev1beta1.HookPointPreKubernetesUpgrade).Return(true)
+			Expect(botanist.IsKubernetesUpgrade(ctx)).To(BeFalse())
+		})
+	})
+
+	Describe("PostKubernetesUpgrade hooks", func() {
+		expectTask := func(present bool) {
+			ExpectWithOffset(1, gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			if present {
+				ExpectWithOffset(1, shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.ShootTasks, "executePostKubernetesUpgradeHooks"))
+			} else {
+				ExpectWithOffset(1, shoot.Annotations).NotTo(HaveKey(v1beta1constants.ShootTasks))
+			}
+		}
+
+		Describe("#RequirePostKubernetesUpgradeHooks", func() {
+			It("should do nothing if no hooks are registered for the point", func() {
+				hook.EXPECT().Has(gardencorev1beta1.HookPointPostKubernetesUpgrade).Return(false)
+
+				Expect(botanist.RequirePostKubernetesUpgradeHooks(ctx)).To(Succeed())
+				Expect(botanist.ArePostKubernetesUpgradeHooksPending()).To(BeFalse())
+				expectTask(false)
+			})
+
+			It("should record that the hooks have to be executed", func() {
+				hook.EXPECT().Has(gardencorev1beta1.HookPointPostKubernetesUpgrade).Return(true)
+
+				Expect(botanist.RequirePostKubernetesUpgradeHooks(ctx)).To(Succeed())
+				Expect(botanist.ArePostKubernetesUpgradeHooksPending()).To(BeTrue())
+				expectTask(true)
+			})
+		})
+
+		Describe("#ArePostKubernetesUpgradeHooksPending", func() {
+			It("should keep the hooks pending while the shoot is hibernated", func() {
+				shoot.Annotations = map[string]string{v1beta1constants.ShootTasks: "executePostKubernetesUpgradeHooks"}
+				botanist.Shoot.SetInfo(shoot)
+				botanist.Shoot.HibernationEnabled = true
+
+				Expect(botanist.ArePostKubernetesUpgradeHooksPending()).To(BeFalse())
+			})
+		})
+
+		Describe("#ExecutePostKubernetesUpgradeHooks", func() {
+			BeforeEach(func() {
+				hook.EXPECT().Has(gardencorev1beta1.HookPointPostKubernetesUpgrade).Return(true)
+				Expect(botanist.RequirePostKubernetesUpgradeHooks(ctx)).To(Succeed())
+			})
+
+			It("should execute the hooks and remove the task", func() {
+				hook.EXPECT().Execute(ctx, gardencorev1beta1.HookPointPostKubernetesUpgrade)
+
+				Expect(botanist.ExecutePostKubernetesUpgradeHooks(ctx)).To(Succeed())
+				Expect(botanist.ArePostKubernetesUpgradeHooksPending()).To(BeFalse())
+				expectTask(false)
+			})
+
+			It("should execute the hooks in the retry of a failed reconciliation after the upgrade", func() {
+				hook.EXPECT().Execute(ctx, gardencorev1beta1.HookPointPostKubernetesUpgrade).Return(fakeErr)
+				Expect(botanist.ExecutePostKubernetesUpgradeHooks(ctx)).To(MatchError(fakeErr))
+				expectTask(true)
+
+				// In the retry, the kube-apiserver already runs with the new version, i.e., the upgrade is no longer
+				// detected, but the hooks are still pending.
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				botanist.Shoot.SetInfo(shoot)
+				Expect(botanist.ArePostKubernetesUpgradeHooksPending()).To(BeTrue())
+
+				hook.EXPECT().Execute(ctx, gardencorev1beta1.HookPointPostKubernetesUpgrade)
+				Expect(botanist.ExecutePostKubernetesUpgradeHooks(ctx)).To(Succeed())
+				expectTask(false)
+			})
+		})
+	})
+})
@@ -79,8 +79,23 @@ func (b *Botanist) runParallelTaskForEachComponent(ctx context.Context, componen
 	return flow.Parallel(fns...)(ctx)
 }
 
-// IsCopyOfBackupsRequired check if etcd backups need to be copied between seeds.
+// IsCopyOfBackupsRequired check if etcd backups need to be copied between seeds or, for cloned shoots, from the source
+// shoot.
 func (b *Botanist) IsCopyOfBackupsRequired(ctx context.Context) (bool, error) {
+	if b.IsClonePhase() {
+		if b.Seed.GetInfo().Spec.Backup == nil {
+			return false, fmt.Errorf("shoot cannot be cloned from backup entry %s because backup is not configured for seed %s", b.Shoot.GetInfo().Spec.Source.BackupEntryName, b.Seed.GetInfo().Name)
+		}
+
+		// Backups of the source shoot are only copied as long as the etcd-main Etcd resource has not been created.
+		if _, err := b.Shoot.Components.ControlPlane.EtcdMain.Get(ctx); client.IgnoreNotFound(err) != nil {
+			return false, err
+		} else if err == nil {
+			return false, nil
+		}
+		return true, nil
+	}
+
 	if b.Seed.GetInfo().Spec.Backup == nil || !b.IsRestorePhase() {
 		return false, nil
 	}
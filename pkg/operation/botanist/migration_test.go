@@ -350,6 +350,34 @@ var _ = Describe("migration", func() {
 				Expect(copyRequired).To(BeTrue())
 			})
 		})
+
+		Context("Shoot is cloned", func() {
+			BeforeEach(func() {
+				botanist.Shoot.GetInfo().Spec.Source = &gardencorev1beta1.ShootSource{BackupEntryName: "source-backupentry"}
+				botanist.Shoot.GetInfo().Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeCreate
+			})
+
+			It("should return true if etcd main resource has not been deployed", func() {
+				etcdMain.EXPECT().Get(ctx).Return(nil, apierrors.NewNotFound(schema.GroupResource{}, "etcd-main"))
+				copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copyRequired).To(BeTrue())
+			})
+
+			It("should return false if etcd main resource has been deployed", func() {
+				etcdMain.EXPECT().Get(ctx)
+				copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copyRequired).To(BeFalse())
+			})
+
+			It("should return an error if seed backup is not set", func() {
+				botanist.Seed.GetInfo().Spec.Backup = nil
+				copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+				Expect(err).To(HaveOccurred())
+				Expect(copyRequired).To(BeFalse())
+			})
+		})
 	})
 
 	Describe("#IsRestorePhase", func() {
@@ -267,5 +267,9 @@ func (b *Botanist) getShootRequiredExtensionTypes(ctx context.Context) (sets.Set
 		}
 	}
 
+	for _, hook := range b.Shoot.GetInfo().Spec.Hooks {
+		types.Insert(hook.Type)
+	}
+
 	return types, nil
 }
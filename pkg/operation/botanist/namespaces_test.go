@@ -237,6 +237,18 @@ var _ = Describe("Namespaces", func() {
 			))
 		})
 
+		It("should successfully deploy the namespace with labels for the types of hooks", func() {
+			defaultShootInfo.Spec.Hooks = []gardencorev1beta1.Hook{
+				{Name: "backup", Type: extensionType1, Point: gardencorev1beta1.HookPointPreHibernation},
+			}
+			botanist.Shoot.SetInfo(defaultShootInfo)
+
+			Expect(botanist.DeploySeedNamespace(ctx)).To(Succeed())
+
+			defaultExpectations("", 1)
+			Expect(botanist.SeedNamespaceObject.Labels).To(HaveKeyWithValue("extensions.gardener.cloud/"+extensionType1, "true"))
+		})
+
 		It("should successfully deploy the namespace when failure tolerance type is zone", func() {
 			defaultShootInfo.Spec.ControlPlane = &gardencorev1beta1.ControlPlane{
 				HighAvailability: &gardencorev1beta1.HighAvailability{
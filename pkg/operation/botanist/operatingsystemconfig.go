@@ -132,6 +132,20 @@ func (b *Botanist) DeployOperatingSystemConfig(ctx context.Context) error {
 		}
 
 		b.Shoot.Components.Extensions.OperatingSystemConfig.SetSSHPublicKeys(publicKeys)
+
+		if features.DefaultFeatureGate.Enabled(features.BastionSSHCertificates) {
+			sshUserCASecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameSSHUserCA)
+			if !found {
+				return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameSSHUserCA)
+			}
+			caPublicKeys := []string{string(sshUserCASecret.Data[secretsutils.DataKeySSHAuthorizedKeys])}
+
+			if sshUserCASecretOld, found := b.SecretsManager.Get(v1beta1constants.SecretNameSSHUserCA, secretsmanager.Old); found {
+				caPublicKeys = append(caPublicKeys, string(sshUserCASecretOld.Data[secretsutils.DataKeySSHAuthorizedKeys]))
+			}
+
+			b.Shoot.Components.Extensions.OperatingSystemConfig.SetSSHUserCAPublicKeys(caPublicKeys)
+		}
 	}
 
 	if b.IsRestorePhase() {
@@ -167,6 +167,20 @@ var _ = Describe("operatingsystemconfig", func() {
 				Expect(botanist.DeployOperatingSystemConfig(ctx)).To(Succeed())
 			})
 
+			It("should deploy successfully with the public keys of the SSH user certificate authorities", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.BastionSSHCertificates, true))
+				Expect(fakeClient.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "ssh-user-ca", Namespace: namespace},
+					Data:       map[string][]byte{"id_rsa.pub": []byte("ssh-rsa AAAA")},
+				})).To(Succeed())
+
+				operatingSystemConfig.EXPECT().SetSSHUserCAPublicKeys([]string{"ssh-rsa AAAA"})
+				operatingSystemConfig.EXPECT().SetCABundle(nil)
+
+				operatingSystemConfig.EXPECT().Deploy(ctx)
+				Expect(botanist.DeployOperatingSystemConfig(ctx)).To(Succeed())
+			})
+
 			It("should return the error during deployment", func() {
 				operatingSystemConfig.EXPECT().SetCABundle(nil)
 
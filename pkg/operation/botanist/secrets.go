@@ -32,6 +32,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/kubeapiserver"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -55,6 +56,14 @@ func (b *Botanist) InitializeSecretsManagement(ctx context.Context) error {
 		}
 	}
 
+	// Cloned shoots must use the ETCD encryption key of their source shoot, otherwise the resources restored from its
+	// backup could not be decrypted.
+	if b.IsClonePhase() {
+		if err := b.restoreETCDEncryptionKeyFromCloneSourceForSecretsManagerAdoption(ctx); err != nil {
+			return err
+		}
+	}
+
 	taskFns := []flow.TaskFn{
 		b.generateCertificateAuthorities,
 		b.generateGenericTokenKubeconfig,
@@ -63,6 +72,10 @@ func (b *Botanist) InitializeSecretsManagement(ctx context.Context) error {
 
 	if v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) {
 		taskFns = append(taskFns, b.generateSSHKeypair)
+
+		if features.DefaultFeatureGate.Enabled(features.BastionSSHCertificates) {
+			taskFns = append(taskFns, b.generateSSHUserCA)
+		}
 	} else {
 		taskFns = append(taskFns, b.deleteSSHKeypair)
 	}
@@ -96,6 +109,7 @@ func (b *Botanist) lastSecretRotationStartTimes() map[string]time.Time {
 
 		if shootStatus.Credentials.Rotation.SSHKeypair != nil && shootStatus.Credentials.Rotation.SSHKeypair.LastInitiationTime != nil {
 			rotation[v1beta1constants.SecretNameSSHKeyPair] = shootStatus.Credentials.Rotation.SSHKeypair.LastInitiationTime.Time
+			rotation[v1beta1constants.SecretNameSSHUserCA] = shootStatus.Credentials.Rotation.SSHKeypair.LastInitiationTime.Time
 		}
 
 		if shootStatus.Credentials.Rotation.Observability != nil && shootStatus.Credentials.Rotation.Observability.LastInitiationTime != nil {
@@ -346,6 +360,17 @@ func (b *Botanist) syncInternalSecretToGarden(
 	return err
 }
 
+func (b *Botanist) generateSSHUserCA(ctx context.Context) error {
+	// The SSH user CA is rotated together with the SSH keypair. The old CA is kept so that certificates issued before
+	// the rotation stay valid until they expire.
+	_, err := b.SecretsManager.Generate(ctx, &secretsutils.RSASecretConfig{
+		Name:       v1beta1constants.SecretNameSSHUserCA,
+		Bits:       4096,
+		UsedForSSH: true,
+	}, secretsmanager.Persist(), secretsmanager.Rotate(secretsmanager.KeepOld))
+	return err
+}
+
 func (b *Botanist) deleteSSHKeypair(ctx context.Context) error {
 	return b.deleteShootCredentialFromGarden(ctx, gardenerutils.ShootProjectSecretSuffixSSHKeypair, gardenerutils.ShootProjectSecretSuffixOldSSHKeypair)
 }
@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -34,6 +35,7 @@ import (
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/operation"
 	. "github.com/gardener/gardener/pkg/operation/botanist"
+	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -302,6 +304,79 @@ var _ = Describe("Secrets", func() {
 				Expect(seedClient.Get(ctx, kubernetesutils.Key(seedNamespace, "some-other-data"), &corev1.Secret{})).To(BeNotFoundError())
 			})
 		})
+
+		Context("when shoot is cloned", func() {
+			var (
+				sourceSeedNamespace = "shoot--foo--source"
+				sourceBackupEntry   *gardencorev1beta1.BackupEntry
+			)
+
+			BeforeEach(func() {
+				botanist.Seed = &seedpkg.Seed{}
+				botanist.Seed.SetInfo(&gardencorev1beta1.Seed{
+					ObjectMeta: metav1.ObjectMeta{Name: "seed", UID: "seed-uid"},
+				})
+
+				sourceBackupEntry = &gardencorev1beta1.BackupEntry{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      sourceSeedNamespace + "--source-uid",
+						Namespace: gardenNamespace,
+					},
+					Spec: gardencorev1beta1.BackupEntrySpec{
+						BucketName: "seed-uid",
+						SeedName:   pointer.String("seed"),
+					},
+				}
+				Expect(gardenClient.Create(ctx, sourceBackupEntry)).To(Succeed())
+
+				shoot := botanist.Shoot.GetInfo()
+				shoot.Spec.Source = &gardencorev1beta1.ShootSource{BackupEntryName: sourceBackupEntry.Name}
+				shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeCreate}
+				botanist.Shoot.SetInfo(shoot)
+			})
+
+			It("should copy the ETCD encryption key of the source shoot", func() {
+				Expect(seedClient.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "kube-apiserver-etcd-encryption-key-abcdef12",
+						Namespace: sourceSeedNamespace,
+						Labels: map[string]string{
+							"name":             "kube-apiserver-etcd-encryption-key",
+							"managed-by":       "secrets-manager",
+							"manager-identity": "gardenlet",
+						},
+					},
+					Data: map[string][]byte{"key": []byte("source-key")},
+				})).To(Succeed())
+
+				Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
+
+				secretList := &corev1.SecretList{}
+				Expect(seedClient.List(ctx, secretList, client.InNamespace(seedNamespace), client.MatchingLabels{
+					"name":       "kube-apiserver-etcd-encryption-key",
+					"managed-by": "secrets-manager",
+				})).To(Succeed())
+				Expect(secretList.Items).To(HaveLen(1))
+				Expect(secretList.Items[0].Name).NotTo(Equal("kube-apiserver-etcd-encryption-key-abcdef12"))
+				Expect(secretList.Items[0].Labels).To(And(
+					HaveKeyWithValue("manager-identity", "gardenlet"),
+					HaveKeyWithValue("persist", "true"),
+					HaveKey("checksum-of-config"),
+				))
+				Expect(secretList.Items[0].Data).To(Equal(map[string][]byte{"key": []byte("source-key")}))
+			})
+
+			It("should fail if the ETCD encryption key of the source shoot does not exist", func() {
+				Expect(botanist.InitializeSecretsManagement(ctx)).To(MatchError(ContainSubstring("ETCD encryption key of source shoot not found")))
+			})
+
+			It("should fail if the source backup entry is not scheduled to the same seed", func() {
+				sourceBackupEntry.Spec.SeedName = pointer.String("other-seed")
+				Expect(gardenClient.Update(ctx, sourceBackupEntry)).To(Succeed())
+
+				Expect(botanist.InitializeSecretsManagement(ctx)).To(MatchError(ContainSubstring("must be scheduled to seed seed")))
+			})
+		})
 	})
 })
 
@@ -38,6 +38,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/controlplane"
 	"github.com/gardener/gardener/pkg/component/extensions/dnsrecord"
 	"github.com/gardener/gardener/pkg/component/extensions/extension"
+	"github.com/gardener/gardener/pkg/component/extensions/hook"
 	"github.com/gardener/gardener/pkg/component/extensions/infrastructure"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/component/extensions/worker"
@@ -159,6 +160,7 @@ type Extensions struct {
 	InternalDNSRecord     dnsrecord.Interface
 	IngressDNSRecord      dnsrecord.Interface
 	Extension             extension.Interface
+	Hook                  hook.Interface
 	Infrastructure        infrastructure.Interface
 	Network               component.DeployMigrateWaiter
 	OperatingSystemConfig operatingsystemconfig.Interface
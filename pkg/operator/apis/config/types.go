@@ -64,8 +64,12 @@ type ConditionThreshold struct {
 type ControllerConfiguration struct {
 	// Garden is the configuration for the garden controller.
 	Garden GardenControllerConfig
+	// Extension is the configuration for the extension controller.
+	Extension ExtensionControllerConfiguration
 	// GardenCare is the configuration for the garden care controller
 	GardenCare GardenCareControllerConfiguration
+	// GardenGardenlet is the configuration for the garden-gardenlet controller.
+	GardenGardenlet GardenGardenletControllerConfiguration
 	// NetworkPolicy is the configuration for the NetworkPolicy controller.
 	NetworkPolicy NetworkPolicyControllerConfiguration
 }
@@ -79,6 +83,13 @@ type GardenCareControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold
 }
 
+// GardenGardenletControllerConfiguration defines the configuration of the garden-gardenlet controller.
+type GardenGardenletControllerConfiguration struct {
+	// SyncPeriod is the duration how often the controller performs its reconciliation (and checks the readiness of the
+	// seed).
+	SyncPeriod *metav1.Duration
+}
+
 // GardenControllerConfig is the configuration for the garden controller.
 type GardenControllerConfig struct {
 	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
@@ -88,6 +99,17 @@ type GardenControllerConfig struct {
 	// ETCDConfig contains an optional configuration for the
 	// backup compaction feature of ETCD backup-restore functionality.
 	ETCDConfig *gardenletconfig.ETCDConfig
+	// ETCDBackupMigrationRetentionPeriod is the duration for which the source object store is retained after the
+	// backups of the virtual garden's main ETCD were migrated to a new object store.
+	ETCDBackupMigrationRetentionPeriod *metav1.Duration
+}
+
+// ExtensionControllerConfiguration is the configuration for the extension controller.
+type ExtensionControllerConfiguration struct {
+	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the controller performs its reconciliation (and health checks).
+	SyncPeriod *metav1.Duration
 }
 
 // NetworkPolicyControllerConfiguration defines the configuration of the NetworkPolicy controller.
@@ -95,12 +95,32 @@ func SetDefaults_GardenControllerConfig(obj *GardenControllerConfig) {
 	if obj.ETCDConfig == nil {
 		obj.ETCDConfig = &gardenletv1alpha1.ETCDConfig{}
 	}
+	if obj.ETCDBackupMigrationRetentionPeriod == nil {
+		obj.ETCDBackupMigrationRetentionPeriod = &metav1.Duration{Duration: 7 * 24 * time.Hour}
+	}
 	gardenletv1alpha1.SetDefaults_ETCDConfig(obj.ETCDConfig)
 	gardenletv1alpha1.SetDefaults_ETCDController(obj.ETCDConfig.ETCDController)
 	gardenletv1alpha1.SetDefaults_CustodianController(obj.ETCDConfig.CustodianController)
 	gardenletv1alpha1.SetDefaults_BackupCompactionController(obj.ETCDConfig.BackupCompactionController)
 }
 
+// SetDefaults_ExtensionControllerConfiguration sets defaults for the ExtensionControllerConfiguration object.
+func SetDefaults_ExtensionControllerConfiguration(obj *ExtensionControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = pointer.Int(5)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+}
+
+// SetDefaults_GardenGardenletControllerConfiguration sets defaults for the GardenGardenletControllerConfiguration object.
+func SetDefaults_GardenGardenletControllerConfiguration(obj *GardenGardenletControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+}
+
 // SetDefaults_GardenCareControllerConfiguration sets defaults for the GardenCareControllerConfiguration object.
 func SetDefaults_GardenCareControllerConfiguration(obj *GardenCareControllerConfiguration) {
 	if obj.SyncPeriod == nil {
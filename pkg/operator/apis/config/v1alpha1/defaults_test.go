@@ -214,14 +214,16 @@ var _ = Describe("Defaults", func() {
 				Expect(obj.Controllers.Garden.ETCDConfig.BackupCompactionController.EnableBackupCompaction).To(PointTo(Equal(false)))
 				Expect(obj.Controllers.Garden.ETCDConfig.BackupCompactionController.EventsThreshold).To(PointTo(Equal(int64(1000000))))
 				Expect(obj.Controllers.Garden.ETCDConfig.BackupCompactionController.MetricsScrapeWaitDuration).To(PointTo(Equal(metav1.Duration{Duration: 60 * time.Second})))
+				Expect(obj.Controllers.Garden.ETCDBackupMigrationRetentionPeriod).To(PointTo(Equal(metav1.Duration{Duration: 7 * 24 * time.Hour})))
 			})
 
 			It("should not overwrite already set values for Garden controller config", func() {
 				obj = &OperatorConfiguration{
 					Controllers: ControllerConfiguration{
 						Garden: GardenControllerConfig{
-							ConcurrentSyncs: pointer.Int(5),
-							SyncPeriod:      &metav1.Duration{Duration: time.Second},
+							ConcurrentSyncs:                    pointer.Int(5),
+							SyncPeriod:                         &metav1.Duration{Duration: time.Second},
+							ETCDBackupMigrationRetentionPeriod: &metav1.Duration{Duration: time.Hour},
 							ETCDConfig: &v1alpha1.ETCDConfig{
 								ETCDController:      &v1alpha1.ETCDController{Workers: pointer.Int64(5)},
 								CustodianController: &v1alpha1.CustodianController{Workers: pointer.Int64(5)},
@@ -246,6 +248,32 @@ var _ = Describe("Defaults", func() {
 				Expect(obj.Controllers.Garden.ETCDConfig.BackupCompactionController.EnableBackupCompaction).To(PointTo(Equal(true)))
 				Expect(obj.Controllers.Garden.ETCDConfig.BackupCompactionController.EventsThreshold).To(PointTo(Equal(int64(900000))))
 				Expect(obj.Controllers.Garden.ETCDConfig.BackupCompactionController.MetricsScrapeWaitDuration).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Second})))
+				Expect(obj.Controllers.Garden.ETCDBackupMigrationRetentionPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			})
+		})
+
+		Describe("Extension controller defaulting", func() {
+			It("should default the Extension controller config", func() {
+				SetObjectDefaults_OperatorConfiguration(obj)
+
+				Expect(obj.Controllers.Extension.ConcurrentSyncs).To(PointTo(Equal(5)))
+				Expect(obj.Controllers.Extension.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			})
+
+			It("should not overwrite already set values for Extension controller config", func() {
+				obj = &OperatorConfiguration{
+					Controllers: ControllerConfiguration{
+						Extension: ExtensionControllerConfiguration{
+							ConcurrentSyncs: pointer.Int(2),
+							SyncPeriod:      &metav1.Duration{Duration: time.Second},
+						},
+					},
+				}
+
+				SetObjectDefaults_OperatorConfiguration(obj)
+
+				Expect(obj.Controllers.Extension.ConcurrentSyncs).To(PointTo(Equal(2)))
+				Expect(obj.Controllers.Extension.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 			})
 		})
 
@@ -270,5 +298,27 @@ var _ = Describe("Defaults", func() {
 				Expect(obj.Controllers.GardenCare.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 			})
 		})
+
+		Describe("GardenGardenlet controller defaulting", func() {
+			It("should default the GardenGardenlet controller config", func() {
+				SetObjectDefaults_OperatorConfiguration(obj)
+
+				Expect(obj.Controllers.GardenGardenlet.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			})
+
+			It("should not overwrite already set values for GardenGardenlet controller config", func() {
+				obj = &OperatorConfiguration{
+					Controllers: ControllerConfiguration{
+						GardenGardenlet: GardenGardenletControllerConfiguration{
+							SyncPeriod: &metav1.Duration{Duration: time.Second},
+						},
+					},
+				}
+
+				SetObjectDefaults_OperatorConfiguration(obj)
+
+				Expect(obj.Controllers.GardenGardenlet.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+			})
+		})
 	})
 })
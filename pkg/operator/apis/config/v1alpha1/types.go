@@ -67,8 +67,14 @@ type ConditionThreshold struct {
 type ControllerConfiguration struct {
 	// Garden is the configuration for the garden controller.
 	Garden GardenControllerConfig `json:"garden"`
+	// Extension is the configuration for the extension controller.
+	// +optional
+	Extension ExtensionControllerConfiguration `json:"extension"`
 	// GardenCare is the configuration for the garden care controller
 	GardenCare GardenCareControllerConfiguration `json:"gardenCare"`
+	// GardenGardenlet is the configuration for the garden-gardenlet controller.
+	// +optional
+	GardenGardenlet GardenGardenletControllerConfiguration `json:"gardenGardenlet"`
 	// NetworkPolicy is the configuration for the NetworkPolicy controller.
 	NetworkPolicy NetworkPolicyControllerConfiguration `json:"networkPolicy"`
 }
@@ -84,6 +90,14 @@ type GardenCareControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
 }
 
+// GardenGardenletControllerConfiguration defines the configuration of the garden-gardenlet controller.
+type GardenGardenletControllerConfiguration struct {
+	// SyncPeriod is the duration how often the controller performs its reconciliation (and checks the readiness of the
+	// seed).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // GardenControllerConfig is the configuration for the garden controller.
 type GardenControllerConfig struct {
 	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
@@ -96,6 +110,20 @@ type GardenControllerConfig struct {
 	// backup compaction feature of ETCD backup-restore functionality.
 	// +optional
 	ETCDConfig *gardenletv1alpha1.ETCDConfig `json:"etcdConfig,omitempty"`
+	// ETCDBackupMigrationRetentionPeriod is the duration for which the source object store is retained after the
+	// backups of the virtual garden's main ETCD were migrated to a new object store.
+	// +optional
+	ETCDBackupMigrationRetentionPeriod *metav1.Duration `json:"etcdBackupMigrationRetentionPeriod,omitempty"`
+}
+
+// ExtensionControllerConfiguration is the configuration for the extension controller.
+type ExtensionControllerConfiguration struct {
+	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the controller performs its reconciliation (and health checks).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
 // NetworkPolicyControllerConfiguration defines the configuration of the NetworkPolicy controller.
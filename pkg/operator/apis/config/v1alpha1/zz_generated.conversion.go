@@ -61,6 +61,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExtensionControllerConfiguration)(nil), (*config.ExtensionControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExtensionControllerConfiguration_To_config_ExtensionControllerConfiguration(a.(*ExtensionControllerConfiguration), b.(*config.ExtensionControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ExtensionControllerConfiguration)(nil), (*ExtensionControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ExtensionControllerConfiguration_To_v1alpha1_ExtensionControllerConfiguration(a.(*config.ExtensionControllerConfiguration), b.(*ExtensionControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GardenCareControllerConfiguration)(nil), (*config.GardenCareControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GardenCareControllerConfiguration_To_config_GardenCareControllerConfiguration(a.(*GardenCareControllerConfiguration), b.(*config.GardenCareControllerConfiguration), scope)
 	}); err != nil {
@@ -81,6 +91,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GardenGardenletControllerConfiguration)(nil), (*config.GardenGardenletControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GardenGardenletControllerConfiguration_To_config_GardenGardenletControllerConfiguration(a.(*GardenGardenletControllerConfiguration), b.(*config.GardenGardenletControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.GardenGardenletControllerConfiguration)(nil), (*GardenGardenletControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_GardenGardenletControllerConfiguration_To_v1alpha1_GardenGardenletControllerConfiguration(a.(*config.GardenGardenletControllerConfiguration), b.(*GardenGardenletControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkPolicyControllerConfiguration)(nil), (*config.NetworkPolicyControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkPolicyControllerConfiguration_To_config_NetworkPolicyControllerConfiguration(a.(*NetworkPolicyControllerConfiguration), b.(*config.NetworkPolicyControllerConfiguration), scope)
 	}); err != nil {
@@ -160,9 +180,15 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfigurat
 	if err := Convert_v1alpha1_GardenControllerConfig_To_config_GardenControllerConfig(&in.Garden, &out.Garden, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_ExtensionControllerConfiguration_To_config_ExtensionControllerConfiguration(&in.Extension, &out.Extension, s); err != nil {
+		return err
+	}
 	if err := Convert_v1alpha1_GardenCareControllerConfiguration_To_config_GardenCareControllerConfiguration(&in.GardenCare, &out.GardenCare, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_GardenGardenletControllerConfiguration_To_config_GardenGardenletControllerConfiguration(&in.GardenGardenlet, &out.GardenGardenlet, s); err != nil {
+		return err
+	}
 	if err := Convert_v1alpha1_NetworkPolicyControllerConfiguration_To_config_NetworkPolicyControllerConfiguration(&in.NetworkPolicy, &out.NetworkPolicy, s); err != nil {
 		return err
 	}
@@ -178,9 +204,15 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	if err := Convert_config_GardenControllerConfig_To_v1alpha1_GardenControllerConfig(&in.Garden, &out.Garden, s); err != nil {
 		return err
 	}
+	if err := Convert_config_ExtensionControllerConfiguration_To_v1alpha1_ExtensionControllerConfiguration(&in.Extension, &out.Extension, s); err != nil {
+		return err
+	}
 	if err := Convert_config_GardenCareControllerConfiguration_To_v1alpha1_GardenCareControllerConfiguration(&in.GardenCare, &out.GardenCare, s); err != nil {
 		return err
 	}
+	if err := Convert_config_GardenGardenletControllerConfiguration_To_v1alpha1_GardenGardenletControllerConfiguration(&in.GardenGardenlet, &out.GardenGardenlet, s); err != nil {
+		return err
+	}
 	if err := Convert_config_NetworkPolicyControllerConfiguration_To_v1alpha1_NetworkPolicyControllerConfiguration(&in.NetworkPolicy, &out.NetworkPolicy, s); err != nil {
 		return err
 	}
@@ -192,6 +224,28 @@ func Convert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(
 	return autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ExtensionControllerConfiguration_To_config_ExtensionControllerConfiguration(in *ExtensionControllerConfiguration, out *config.ExtensionControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ExtensionControllerConfiguration_To_config_ExtensionControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ExtensionControllerConfiguration_To_config_ExtensionControllerConfiguration(in *ExtensionControllerConfiguration, out *config.ExtensionControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExtensionControllerConfiguration_To_config_ExtensionControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ExtensionControllerConfiguration_To_v1alpha1_ExtensionControllerConfiguration(in *config.ExtensionControllerConfiguration, out *ExtensionControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_ExtensionControllerConfiguration_To_v1alpha1_ExtensionControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ExtensionControllerConfiguration_To_v1alpha1_ExtensionControllerConfiguration(in *config.ExtensionControllerConfiguration, out *ExtensionControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ExtensionControllerConfiguration_To_v1alpha1_ExtensionControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_GardenCareControllerConfiguration_To_config_GardenCareControllerConfiguration(in *GardenCareControllerConfiguration, out *config.GardenCareControllerConfiguration, s conversion.Scope) error {
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
@@ -218,6 +272,7 @@ func autoConvert_v1alpha1_GardenControllerConfig_To_config_GardenControllerConfi
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.ETCDConfig = (*apisconfig.ETCDConfig)(unsafe.Pointer(in.ETCDConfig))
+	out.ETCDBackupMigrationRetentionPeriod = (*v1.Duration)(unsafe.Pointer(in.ETCDBackupMigrationRetentionPeriod))
 	return nil
 }
 
@@ -230,6 +285,7 @@ func autoConvert_config_GardenControllerConfig_To_v1alpha1_GardenControllerConfi
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.ETCDConfig = (*configv1alpha1.ETCDConfig)(unsafe.Pointer(in.ETCDConfig))
+	out.ETCDBackupMigrationRetentionPeriod = (*v1.Duration)(unsafe.Pointer(in.ETCDBackupMigrationRetentionPeriod))
 	return nil
 }
 
@@ -238,6 +294,26 @@ func Convert_config_GardenControllerConfig_To_v1alpha1_GardenControllerConfig(in
 	return autoConvert_config_GardenControllerConfig_To_v1alpha1_GardenControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_GardenGardenletControllerConfiguration_To_config_GardenGardenletControllerConfiguration(in *GardenGardenletControllerConfiguration, out *config.GardenGardenletControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_GardenGardenletControllerConfiguration_To_config_GardenGardenletControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_GardenGardenletControllerConfiguration_To_config_GardenGardenletControllerConfiguration(in *GardenGardenletControllerConfiguration, out *config.GardenGardenletControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GardenGardenletControllerConfiguration_To_config_GardenGardenletControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_GardenGardenletControllerConfiguration_To_v1alpha1_GardenGardenletControllerConfiguration(in *config.GardenGardenletControllerConfiguration, out *GardenGardenletControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_GardenGardenletControllerConfiguration_To_v1alpha1_GardenGardenletControllerConfiguration is an autogenerated conversion function.
+func Convert_config_GardenGardenletControllerConfiguration_To_v1alpha1_GardenGardenletControllerConfiguration(in *config.GardenGardenletControllerConfiguration, out *GardenGardenletControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_GardenGardenletControllerConfiguration_To_v1alpha1_GardenGardenletControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkPolicyControllerConfiguration_To_config_NetworkPolicyControllerConfiguration(in *NetworkPolicyControllerConfiguration, out *config.NetworkPolicyControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.AdditionalNamespaceSelectors = *(*[]v1.LabelSelector)(unsafe.Pointer(&in.AdditionalNamespaceSelectors))
@@ -40,5 +40,7 @@ func SetObjectDefaults_OperatorConfiguration(in *OperatorConfiguration) {
 	SetDefaults_LeaderElectionConfiguration(&in.LeaderElection)
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_GardenControllerConfig(&in.Controllers.Garden)
+	SetDefaults_ExtensionControllerConfiguration(&in.Controllers.Extension)
 	SetDefaults_GardenCareControllerConfiguration(&in.Controllers.GardenCare)
+	SetDefaults_GardenGardenletControllerConfiguration(&in.Controllers.GardenGardenlet)
 }
@@ -51,6 +51,8 @@ func validateControllerConfiguration(conf config.ControllerConfiguration, fldPat
 
 	allErrs = append(allErrs, validateGardenControllerConfiguration(conf.Garden, fldPath.Child("garden"))...)
 	allErrs = append(allErrs, validateGardenCareControllerConfiguration(conf.GardenCare, fldPath.Child("gardenCare"))...)
+	allErrs = append(allErrs, validateGardenGardenletControllerConfiguration(conf.GardenGardenlet, fldPath.Child("gardenGardenlet"))...)
+	allErrs = append(allErrs, validateExtensionControllerConfiguration(conf.Extension, fldPath.Child("extension"))...)
 	allErrs = append(allErrs, validateNetworkPolicyControllerConfiguration(conf.NetworkPolicy, fldPath.Child("networkPolicy"))...)
 
 	return allErrs
@@ -62,6 +64,10 @@ func validateGardenControllerConfiguration(conf config.GardenControllerConfig, f
 	allErrs = append(allErrs, validateConcurrentSyncs(conf.ConcurrentSyncs, fldPath)...)
 	allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
 
+	if conf.ETCDBackupMigrationRetentionPeriod != nil && conf.ETCDBackupMigrationRetentionPeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("etcdBackupMigrationRetentionPeriod"), conf.ETCDBackupMigrationRetentionPeriod.Duration, "must not be negative"))
+	}
+
 	return allErrs
 }
 
@@ -73,6 +79,23 @@ func validateGardenCareControllerConfiguration(conf config.GardenCareControllerC
 	return allErrs
 }
 
+func validateGardenGardenletControllerConfiguration(conf config.GardenGardenletControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
+
+	return allErrs
+}
+
+func validateExtensionControllerConfiguration(conf config.ExtensionControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateConcurrentSyncs(conf.ConcurrentSyncs, fldPath)...)
+	allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
+
+	return allErrs
+}
+
 func validateNetworkPolicyControllerConfiguration(conf config.NetworkPolicyControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
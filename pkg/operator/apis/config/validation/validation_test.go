@@ -52,6 +52,13 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 				GardenCare: config.GardenCareControllerConfiguration{
 					SyncPeriod: &metav1.Duration{Duration: time.Minute},
 				},
+				GardenGardenlet: config.GardenGardenletControllerConfiguration{
+					SyncPeriod: &metav1.Duration{Duration: time.Minute},
+				},
+				Extension: config.ExtensionControllerConfiguration{
+					ConcurrentSyncs: pointer.Int(5),
+					SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+				},
 				NetworkPolicy: config.NetworkPolicyControllerConfiguration{
 					ConcurrentSyncs: pointer.Int(5),
 				},
@@ -120,6 +127,19 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 					})),
 				))
 			})
+
+			It("should return errors because ETCD backup migration retention period is negative", func() {
+				conf.Controllers.Garden.ConcurrentSyncs = pointer.Int(5)
+				conf.Controllers.Garden.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+				conf.Controllers.Garden.ETCDBackupMigrationRetentionPeriod = &metav1.Duration{Duration: -time.Hour}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.garden.etcdBackupMigrationRetentionPeriod"),
+					})),
+				))
+			})
 		})
 
 		Context("GardenCare", func() {
@@ -146,6 +166,54 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 			})
 		})
 
+		Context("GardenGardenlet", func() {
+			It("should return errors because sync period is nil", func() {
+				conf.Controllers.GardenGardenlet.SyncPeriod = nil
+
+				Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.gardenGardenlet.syncPeriod"),
+					})),
+				))
+			})
+
+			It("should return errors because sync period is < 15s", func() {
+				conf.Controllers.GardenGardenlet.SyncPeriod = &metav1.Duration{Duration: time.Second}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.gardenGardenlet.syncPeriod"),
+					})),
+				))
+			})
+		})
+
+		Context("extension", func() {
+			It("should return errors because concurrent syncs are <= 0", func() {
+				conf.Controllers.Extension.ConcurrentSyncs = pointer.Int(0)
+
+				Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.extension.concurrentSyncs"),
+					})),
+				))
+			})
+
+			It("should return errors because sync period is < 15s", func() {
+				conf.Controllers.Extension.SyncPeriod = &metav1.Duration{Duration: time.Second}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.extension.syncPeriod"),
+					})),
+				))
+			})
+		})
+
 		Context("network policy", func() {
 			It("should return errors because concurrent syncs are <= 0", func() {
 				conf.Controllers.NetworkPolicy.ConcurrentSyncs = pointer.Int(0)
@@ -49,7 +49,9 @@ func (in *ConditionThreshold) DeepCopy() *ConditionThreshold {
 func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
 	in.Garden.DeepCopyInto(&out.Garden)
+	in.Extension.DeepCopyInto(&out.Extension)
 	in.GardenCare.DeepCopyInto(&out.GardenCare)
+	in.GardenGardenlet.DeepCopyInto(&out.GardenGardenlet)
 	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
 	return
 }
@@ -64,6 +66,32 @@ func (in *ControllerConfiguration) DeepCopy() *ControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionControllerConfiguration) DeepCopyInto(out *ExtensionControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionControllerConfiguration.
+func (in *ExtensionControllerConfiguration) DeepCopy() *ExtensionControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GardenCareControllerConfiguration) DeepCopyInto(out *GardenCareControllerConfiguration) {
 	*out = *in
@@ -108,6 +136,11 @@ func (in *GardenControllerConfig) DeepCopyInto(out *GardenControllerConfig) {
 		*out = new(apisconfig.ETCDConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ETCDBackupMigrationRetentionPeriod != nil {
+		in, out := &in.ETCDBackupMigrationRetentionPeriod, &out.ETCDBackupMigrationRetentionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -121,6 +154,27 @@ func (in *GardenControllerConfig) DeepCopy() *GardenControllerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenGardenletControllerConfiguration) DeepCopyInto(out *GardenGardenletControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenGardenletControllerConfiguration.
+func (in *GardenGardenletControllerConfiguration) DeepCopy() *GardenGardenletControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenGardenletControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicyControllerConfiguration) DeepCopyInto(out *NetworkPolicyControllerConfiguration) {
 	*out = *in
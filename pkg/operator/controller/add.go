@@ -23,9 +23,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	clientmapbuilder "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/builder"
 	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/controller/service"
 	"github.com/gardener/gardener/pkg/operator/apis/config"
+	"github.com/gardener/gardener/pkg/operator/controller/extension"
 	"github.com/gardener/gardener/pkg/operator/controller/garden"
 	"github.com/gardener/gardener/pkg/operator/controller/networkpolicyregistrar"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -38,10 +40,29 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.Operator
 		return err
 	}
 
-	if err := garden.AddToManager(ctx, mgr, cfg, identity); err != nil {
+	gardenClientMap, err := clientmapbuilder.
+		NewGardenClientMapBuilder().
+		WithRuntimeClient(mgr.GetClient()).
+		WithClientConnectionConfig(&cfg.VirtualClientConnection).
+		Build(mgr.GetLogger())
+	if err != nil {
+		return fmt.Errorf("failed to build garden ClientMap: %w", err)
+	}
+	if err := mgr.Add(gardenClientMap); err != nil {
+		return err
+	}
+
+	if err := garden.AddToManager(ctx, mgr, cfg, identity, gardenClientMap); err != nil {
 		return err
 	}
 
+	if err := (&extension.Reconciler{
+		Config:          *cfg,
+		GardenClientMap: gardenClientMap,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding Extension controller: %w", err)
+	}
+
 	if err := (&networkpolicyregistrar.Reconciler{
 		Config: cfg.Controllers.NetworkPolicy,
 	}).AddToManager(mgr); err != nil {
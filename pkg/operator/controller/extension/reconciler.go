@@ -0,0 +1,403 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/operator/apis/config"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// finalizerName is the name of the finalizer used by the extension controller.
+	finalizerName = "gardener.cloud/operator-extension"
+
+	// managedResourcePrefixAdmissionRuntime is the prefix for the names of ManagedResources containing the resources
+	// of an extension admission controller for the runtime cluster.
+	managedResourcePrefixAdmissionRuntime = "extension-admission-runtime-"
+	// managedResourcePrefixAdmissionVirtual is the prefix for the names of ManagedResources containing the resources
+	// of an extension admission controller for the virtual garden cluster.
+	managedResourcePrefixAdmissionVirtual = "extension-admission-virtual-"
+
+	// controllerDeploymentTypeHelm is the type of ControllerDeployments which are deployed via Helm.
+	controllerDeploymentTypeHelm = "helm"
+)
+
+// RequeueDurationWhenResourceDeletionStillPresent is the duration after which a reconciliation is requeued when
+// resources of an extension are still being deleted.
+var RequeueDurationWhenResourceDeletionStillPresent = 5 * time.Second
+
+// Reconciler reconciles Extensions. It deploys the ControllerRegistration and ControllerDeployment into the virtual
+// garden cluster and the admission controller of the extension into the runtime and virtual garden cluster.
+type Reconciler struct {
+	RuntimeClientSet kubernetes.Interface
+	Config           config.OperatorConfiguration
+	Clock            clock.Clock
+	GardenClientMap  clientmap.ClientMap
+	GardenNamespace  string
+}
+
+// Reconcile performs the main reconciliation logic.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	extension := &operatorv1alpha1.Extension{}
+	if err := r.RuntimeClientSet.Client().Get(ctx, request.NamespacedName, extension); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	garden, err := r.getGarden(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if extension.DeletionTimestamp != nil {
+		return r.delete(ctx, log, extension, garden)
+	}
+
+	if garden == nil || garden.DeletionTimestamp != nil {
+		log.Info("No Garden found or Garden is being deleted, requeueing")
+		return reconcile.Result{RequeueAfter: r.Config.Controllers.Extension.SyncPeriod.Duration}, r.updateConditions(ctx, extension,
+			v1beta1helper.UpdatedConditionWithClock(r.Clock, v1beta1helper.GetOrInitConditionWithClock(r.Clock, extension.Status.Conditions, operatorv1alpha1.ExtensionInstalled), gardencorev1beta1.ConditionFalse, "GardenNotReady", "There is no Garden which is ready for installing extensions."),
+		)
+	}
+
+	return r.reconcile(ctx, log, extension, garden)
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, extension *operatorv1alpha1.Extension, garden *operatorv1alpha1.Garden) (reconcile.Result, error) {
+	if !controllerutil.ContainsFinalizer(extension, finalizerName) {
+		log.Info("Adding finalizer")
+		if err := controllerutils.AddFinalizers(ctx, r.RuntimeClientSet.Client(), extension, finalizerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+	}
+
+	var (
+		conditionInstalled = v1beta1helper.GetOrInitConditionWithClock(r.Clock, extension.Status.Conditions, operatorv1alpha1.ExtensionInstalled)
+		conditionHealthy   = v1beta1helper.GetOrInitConditionWithClock(r.Clock, extension.Status.Conditions, operatorv1alpha1.ExtensionHealthy)
+	)
+
+	virtualClientSet, err := r.GardenClientMap.GetClient(ctx, keys.ForGarden(garden))
+	if err != nil {
+		conditionInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionInstalled, gardencorev1beta1.ConditionUnknown, "VirtualGardenAccessFailed", fmt.Sprintf("Virtual garden cluster cannot be accessed: %v", err))
+		return reconcile.Result{}, r.updateConditionsWithError(ctx, extension, err, conditionInstalled)
+	}
+
+	log.Info("Reconciling extension resources in virtual garden cluster")
+	if err := r.reconcileControllerDeployment(ctx, virtualClientSet.Client(), extension); err != nil {
+		conditionInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionInstalled, gardencorev1beta1.ConditionFalse, "ControllerDeploymentFailed", err.Error())
+		return reconcile.Result{}, r.updateConditionsWithError(ctx, extension, err, conditionInstalled)
+	}
+	if err := r.reconcileControllerRegistration(ctx, virtualClientSet.Client(), extension); err != nil {
+		conditionInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionInstalled, gardencorev1beta1.ConditionFalse, "ControllerRegistrationFailed", err.Error())
+		return reconcile.Result{}, r.updateConditionsWithError(ctx, extension, err, conditionInstalled)
+	}
+
+	log.Info("Reconciling extension admission controller")
+	if err := r.reconcileAdmission(ctx, r.RuntimeClientSet.ChartRenderer(), extension, admissionRuntimeDeployment(extension), managedResourcePrefixAdmissionRuntime, false); err != nil {
+		conditionInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionInstalled, gardencorev1beta1.ConditionFalse, "AdmissionRuntimeDeploymentFailed", err.Error())
+		return reconcile.Result{}, r.updateConditionsWithError(ctx, extension, err, conditionInstalled)
+	}
+	if err := r.reconcileAdmission(ctx, virtualClientSet.ChartRenderer(), extension, admissionVirtualDeployment(extension), managedResourcePrefixAdmissionVirtual, true); err != nil {
+		conditionInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionInstalled, gardencorev1beta1.ConditionFalse, "AdmissionVirtualDeploymentFailed", err.Error())
+		return reconcile.Result{}, r.updateConditionsWithError(ctx, extension, err, conditionInstalled)
+	}
+
+	conditionInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionInstalled, gardencorev1beta1.ConditionTrue, "InstallationSucceeded", "All resources of the extension have been applied successfully.")
+	conditionHealthy = r.checkHealth(ctx, virtualClientSet.Client(), extension, conditionHealthy)
+
+	return reconcile.Result{RequeueAfter: r.Config.Controllers.Extension.SyncPeriod.Duration}, r.updateConditions(ctx, extension, conditionInstalled, conditionHealthy)
+}
+
+func (r *Reconciler) delete(ctx context.Context, log logr.Logger, extension *operatorv1alpha1.Extension, garden *operatorv1alpha1.Garden) (reconcile.Result, error) {
+	if !controllerutil.ContainsFinalizer(extension, finalizerName) {
+		return reconcile.Result{}, nil
+	}
+
+	conditionInstalled := v1beta1helper.GetOrInitConditionWithClock(r.Clock, extension.Status.Conditions, operatorv1alpha1.ExtensionInstalled)
+
+	if garden != nil {
+		virtualClientSet, err := r.GardenClientMap.GetClient(ctx, keys.ForGarden(garden))
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed getting virtual garden client: %w", err)
+		}
+
+		log.Info("Deleting extension resources in virtual garden cluster")
+		// The ControllerRegistration is protected by a finalizer as long as there are still ControllerInstallations
+		// referencing it, hence we have to wait until it is gone before the ControllerDeployment can be deleted.
+		if stillPresent, err := deleteAndCheckIfGone(ctx, virtualClientSet.Client(), &gardencorev1beta1.ControllerRegistration{}, extension.Name); err != nil {
+			return reconcile.Result{}, err
+		} else if stillPresent {
+			conditionInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionInstalled, gardencorev1beta1.ConditionFalse, "DeletionPending", fmt.Sprintf("Deletion of ControllerRegistration %q is still pending.", extension.Name))
+			return reconcile.Result{RequeueAfter: RequeueDurationWhenResourceDeletionStillPresent}, r.updateConditions(ctx, extension, conditionInstalled)
+		}
+
+		if _, err := deleteAndCheckIfGone(ctx, virtualClientSet.Client(), &gardencorev1beta1.ControllerDeployment{}, extension.Name); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	log.Info("Deleting extension admission controller")
+	for _, name := range []string{managedResourcePrefixAdmissionRuntime + extension.Name, managedResourcePrefixAdmissionVirtual + extension.Name} {
+		if err := managedresources.Delete(ctx, r.RuntimeClientSet.Client(), r.GardenNamespace, name, true); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed deleting ManagedResource %q: %w", name, err)
+		}
+
+		if err := r.RuntimeClientSet.Client().Get(ctx, client.ObjectKey{Namespace: r.GardenNamespace, Name: name}, &resourcesv1alpha1.ManagedResource{}); err == nil {
+			conditionInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionInstalled, gardencorev1beta1.ConditionFalse, "DeletionPending", fmt.Sprintf("Deletion of ManagedResource %q is still pending.", name))
+			return reconcile.Result{RequeueAfter: RequeueDurationWhenResourceDeletionStillPresent}, r.updateConditions(ctx, extension, conditionInstalled)
+		} else if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+	}
+
+	log.Info("Removing finalizer")
+	if err := controllerutils.RemoveFinalizers(ctx, r.RuntimeClientSet.Client(), extension, finalizerName); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) getGarden(ctx context.Context) (*operatorv1alpha1.Garden, error) {
+	gardenList := &operatorv1alpha1.GardenList{}
+	if err := r.RuntimeClientSet.Client().List(ctx, gardenList, client.Limit(1)); err != nil {
+		return nil, fmt.Errorf("failed listing gardens: %w", err)
+	}
+
+	if len(gardenList.Items) == 0 {
+		return nil, nil
+	}
+	return &gardenList.Items[0], nil
+}
+
+func (r *Reconciler) reconcileControllerDeployment(ctx context.Context, virtualClient client.Client, extension *operatorv1alpha1.Extension) error {
+	controllerDeployment := &gardencorev1beta1.ControllerDeployment{}
+	controllerDeployment.Name = extension.Name
+
+	helm := extensionHelm(extension)
+	if helm == nil {
+		return client.IgnoreNotFound(virtualClient.Delete(ctx, controllerDeployment))
+	}
+
+	values, err := valuesToMap(helm.Values)
+	if err != nil {
+		return err
+	}
+
+	providerConfig, err := json.Marshal(map[string]interface{}{
+		"chart":  helm.RawChart,
+		"values": values,
+	})
+	if err != nil {
+		return fmt.Errorf("failed marshalling provider config of ControllerDeployment: %w", err)
+	}
+
+	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, virtualClient, controllerDeployment, func() error {
+		controllerDeployment.Type = controllerDeploymentTypeHelm
+		controllerDeployment.ProviderConfig = runtime.RawExtension{Raw: providerConfig}
+		return nil
+	})
+	return err
+}
+
+func (r *Reconciler) reconcileControllerRegistration(ctx context.Context, virtualClient client.Client, extension *operatorv1alpha1.Extension) error {
+	controllerRegistration := &gardencorev1beta1.ControllerRegistration{}
+	controllerRegistration.Name = extension.Name
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, virtualClient, controllerRegistration, func() error {
+		controllerRegistration.Spec.Resources = extension.Spec.Resources
+		controllerRegistration.Spec.Deployment = nil
+
+		if helm := extensionHelm(extension); helm != nil {
+			deploymentSpec := extension.Spec.Deployment.ExtensionDeployment
+			controllerRegistration.Spec.Deployment = &gardencorev1beta1.ControllerRegistrationDeployment{
+				Policy:         deploymentSpec.Policy,
+				SeedSelector:   deploymentSpec.SeedSelector,
+				DeploymentRefs: []gardencorev1beta1.DeploymentRef{{Name: extension.Name}},
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+func (r *Reconciler) reconcileAdmission(ctx context.Context, renderer chartrenderer.Interface, extension *operatorv1alpha1.Extension, deploymentSpec *operatorv1alpha1.DeploymentSpec, managedResourcePrefix string, forVirtualCluster bool) error {
+	managedResourceName := managedResourcePrefix + extension.Name
+
+	if deploymentSpec == nil || deploymentSpec.Helm == nil {
+		return managedresources.Delete(ctx, r.RuntimeClientSet.Client(), r.GardenNamespace, managedResourceName, true)
+	}
+
+	values, err := valuesToMap(deploymentSpec.Helm.Values)
+	if err != nil {
+		return err
+	}
+
+	// Mix-in some standard values so that the chart can decide which resources to render for which cluster.
+	gardenerValues := map[string]interface{}{
+		"gardener": map[string]interface{}{
+			"runtimeCluster": map[string]interface{}{
+				"enabled": !forVirtualCluster,
+			},
+			"virtualCluster": map[string]interface{}{
+				"enabled": forVirtualCluster,
+			},
+		},
+	}
+
+	release, err := renderer.RenderArchive(deploymentSpec.Helm.RawChart, extension.Name, r.GardenNamespace, utils.MergeMaps(values, gardenerValues))
+	if err != nil {
+		return fmt.Errorf("failed rendering chart: %w", err)
+	}
+
+	if forVirtualCluster {
+		return managedresources.CreateForShoot(ctx, r.RuntimeClientSet.Client(), r.GardenNamespace, managedResourceName, managedresources.LabelValueGardener, false, release.AsSecretData())
+	}
+	return managedresources.CreateForSeed(ctx, r.RuntimeClientSet.Client(), r.GardenNamespace, managedResourceName, false, release.AsSecretData())
+}
+
+func (r *Reconciler) checkHealth(ctx context.Context, virtualClient client.Client, extension *operatorv1alpha1.Extension, condition gardencorev1beta1.Condition) gardencorev1beta1.Condition {
+	for _, name := range []string{managedResourcePrefixAdmissionRuntime + extension.Name, managedResourcePrefixAdmissionVirtual + extension.Name} {
+		managedResource := &resourcesv1alpha1.ManagedResource{}
+		if err := r.RuntimeClientSet.Client().Get(ctx, client.ObjectKey{Namespace: r.GardenNamespace, Name: name}, managedResource); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return v1beta1helper.UpdatedConditionUnknownErrorWithClock(r.Clock, condition, err)
+		}
+
+		if err := health.CheckManagedResource(managedResource); err != nil {
+			return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "AdmissionUnhealthy", fmt.Sprintf("ManagedResource %q is unhealthy: %v", name, err))
+		}
+	}
+
+	controllerInstallationList := &gardencorev1beta1.ControllerInstallationList{}
+	if err := virtualClient.List(ctx, controllerInstallationList); err != nil {
+		return v1beta1helper.UpdatedConditionUnknownErrorWithClock(r.Clock, condition, err)
+	}
+
+	notHealthy := make(map[string]string)
+	for _, controllerInstallation := range controllerInstallationList.Items {
+		if controllerInstallation.Spec.RegistrationRef.Name != extension.Name {
+			continue
+		}
+
+		if !v1beta1helper.IsControllerInstallationSuccessful(controllerInstallation) {
+			notHealthy[controllerInstallation.Spec.SeedRef.Name] = controllerInstallation.Name
+		}
+	}
+
+	if len(notHealthy) > 0 {
+		return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "ControllerInstallationsUnhealthy", fmt.Sprintf("Some ControllerInstallations are not installed successfully or unhealthy (seed name -> installation name): %+v", notHealthy))
+	}
+
+	return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, "ExtensionHealthy", "All components of the extension are healthy.")
+}
+
+func (r *Reconciler) updateConditions(ctx context.Context, extension *operatorv1alpha1.Extension, conditions ...gardencorev1beta1.Condition) error {
+	patch := client.MergeFrom(extension.DeepCopy())
+	extension.Status.ObservedGeneration = extension.Generation
+	extension.Status.Conditions = v1beta1helper.MergeConditions(extension.Status.Conditions, conditions...)
+	return r.RuntimeClientSet.Client().Status().Patch(ctx, extension, patch)
+}
+
+func (r *Reconciler) updateConditionsWithError(ctx context.Context, extension *operatorv1alpha1.Extension, err error, conditions ...gardencorev1beta1.Condition) error {
+	if err2 := r.updateConditions(ctx, extension, conditions...); err2 != nil {
+		return fmt.Errorf("failed updating conditions (due to %s): %w", err.Error(), err2)
+	}
+	return err
+}
+
+func deleteAndCheckIfGone(ctx context.Context, c client.Client, obj client.Object, name string) (bool, error) {
+	obj.SetName(name)
+	if err := c.Delete(ctx, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed deleting %T %q: %w", obj, name, err)
+	}
+
+	if err := c.Get(ctx, client.ObjectKey{Name: name}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func extensionHelm(extension *operatorv1alpha1.Extension) *operatorv1alpha1.ExtensionHelm {
+	if extension.Spec.Deployment == nil || extension.Spec.Deployment.ExtensionDeployment == nil {
+		return nil
+	}
+	return extension.Spec.Deployment.ExtensionDeployment.Helm
+}
+
+func admissionRuntimeDeployment(extension *operatorv1alpha1.Extension) *operatorv1alpha1.DeploymentSpec {
+	if extension.Spec.Deployment == nil || extension.Spec.Deployment.AdmissionDeployment == nil {
+		return nil
+	}
+	return extension.Spec.Deployment.AdmissionDeployment.RuntimeCluster
+}
+
+func admissionVirtualDeployment(extension *operatorv1alpha1.Extension) *operatorv1alpha1.DeploymentSpec {
+	if extension.Spec.Deployment == nil || extension.Spec.Deployment.AdmissionDeployment == nil {
+		return nil
+	}
+	return extension.Spec.Deployment.AdmissionDeployment.VirtualCluster
+}
+
+func valuesToMap(values *apiextensionsv1.JSON) (map[string]interface{}, error) {
+	out := make(map[string]interface{})
+	if values == nil || len(values.Raw) == 0 {
+		return out, nil
+	}
+
+	if err := json.Unmarshal(values.Raw, &out); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling Helm values: %w", err)
+	}
+	return out, nil
+}
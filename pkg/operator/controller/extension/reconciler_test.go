@@ -0,0 +1,312 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extension_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	fakeclientmap "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/fake"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/operator/apis/config"
+	operatorclient "github.com/gardener/gardener/pkg/operator/client"
+	. "github.com/gardener/gardener/pkg/operator/controller/extension"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		gardenNamespace = "garden"
+		syncPeriod      = time.Minute
+
+		runtimeClient client.Client
+		virtualClient client.Client
+		fakeClock     *testclock.FakeClock
+		reconciler    *Reconciler
+
+		garden    *operatorv1alpha1.Garden
+		extension *operatorv1alpha1.Extension
+		request   reconcile.Request
+	)
+
+	BeforeEach(func() {
+		runtimeClient = fakeclient.NewClientBuilder().WithScheme(operatorclient.RuntimeScheme).WithStatusSubresource(&operatorv1alpha1.Extension{}).Build()
+		virtualClient = fakeclient.NewClientBuilder().WithScheme(operatorclient.VirtualScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		garden = &operatorv1alpha1.Garden{ObjectMeta: metav1.ObjectMeta{Name: "garden"}}
+		extension = &operatorv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{Name: "provider-foo", Generation: 1},
+			Spec: operatorv1alpha1.ExtensionSpec{
+				Resources: []gardencorev1beta1.ControllerResource{{Kind: "Infrastructure", Type: "foo"}},
+				Deployment: &operatorv1alpha1.Deployment{
+					ExtensionDeployment: &operatorv1alpha1.ExtensionDeploymentSpec{
+						DeploymentSpec: operatorv1alpha1.DeploymentSpec{Helm: &operatorv1alpha1.ExtensionHelm{
+							RawChart: []byte("extension-chart"),
+							Values:   &apiextensionsv1.JSON{Raw: []byte(`{"foo":"bar"}`)},
+						}},
+						Policy: ptr(gardencorev1beta1.ControllerDeploymentPolicyAlways),
+					},
+					AdmissionDeployment: &operatorv1alpha1.AdmissionDeploymentSpec{
+						RuntimeCluster: &operatorv1alpha1.DeploymentSpec{Helm: &operatorv1alpha1.ExtensionHelm{RawChart: chartArchive()}},
+						VirtualCluster: &operatorv1alpha1.DeploymentSpec{Helm: &operatorv1alpha1.ExtensionHelm{RawChart: chartArchive()}},
+					},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: kubernetesutils.Key(extension.Name)}
+
+		renderer := chartrenderer.NewWithServerVersion(&version.Info{})
+		reconciler = &Reconciler{
+			RuntimeClientSet: fakekubernetes.NewClientSetBuilder().WithClient(runtimeClient).WithChartRenderer(renderer).Build(),
+			Config: config.OperatorConfiguration{
+				Controllers: config.ControllerConfiguration{
+					Extension: config.ExtensionControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: syncPeriod}},
+				},
+			},
+			Clock: fakeClock,
+			GardenClientMap: fakeclientmap.NewClientMapBuilder().WithClientSetForKey(keys.ForGarden(garden),
+				fakekubernetes.NewClientSetBuilder().WithClient(virtualClient).WithChartRenderer(renderer).Build(),
+			).Build(),
+			GardenNamespace: gardenNamespace,
+		}
+
+		Expect(runtimeClient.Create(ctx, extension)).To(Succeed())
+	})
+
+	It("should do nothing if the extension is gone", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key("foo")})).To(Equal(reconcile.Result{}))
+	})
+
+	It("should requeue and report the condition if there is no garden", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(extension), extension)).To(Succeed())
+		Expect(extension.Finalizers).To(BeEmpty())
+		Expect(extension.Status.Conditions).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{"Type": Equal(operatorv1alpha1.ExtensionInstalled), "Status": Equal(gardencorev1beta1.ConditionFalse), "Reason": Equal("GardenNotReady")}),
+		))
+	})
+
+	Context("when garden exists", func() {
+		BeforeEach(func() {
+			Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+		})
+
+		It("should deploy all resources and report the conditions", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(extension), extension)).To(Succeed())
+			Expect(extension.Finalizers).To(ConsistOf("gardener.cloud/operator-extension"))
+			Expect(extension.Status.ObservedGeneration).To(Equal(int64(1)))
+			Expect(extension.Status.Conditions).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{"Type": Equal(operatorv1alpha1.ExtensionInstalled), "Status": Equal(gardencorev1beta1.ConditionTrue), "Reason": Equal("InstallationSucceeded")}),
+				MatchFields(IgnoreExtras, Fields{"Type": Equal(operatorv1alpha1.ExtensionHealthy), "Status": Equal(gardencorev1beta1.ConditionFalse), "Reason": Equal("AdmissionUnhealthy")}),
+			))
+
+			controllerDeployment := &gardencorev1beta1.ControllerDeployment{}
+			Expect(virtualClient.Get(ctx, client.ObjectKey{Name: extension.Name}, controllerDeployment)).To(Succeed())
+			Expect(controllerDeployment.Type).To(Equal("helm"))
+			var providerConfig map[string]interface{}
+			Expect(json.Unmarshal(controllerDeployment.ProviderConfig.Raw, &providerConfig)).To(Succeed())
+			Expect(providerConfig).To(Equal(map[string]interface{}{
+				"chart":  "ZXh0ZW5zaW9uLWNoYXJ0",
+				"values": map[string]interface{}{"foo": "bar"},
+			}))
+
+			controllerRegistration := &gardencorev1beta1.ControllerRegistration{}
+			Expect(virtualClient.Get(ctx, client.ObjectKey{Name: extension.Name}, controllerRegistration)).To(Succeed())
+			Expect(controllerRegistration.Spec.Resources).To(Equal(extension.Spec.Resources))
+			Expect(controllerRegistration.Spec.Deployment).To(Equal(&gardencorev1beta1.ControllerRegistrationDeployment{
+				Policy:         ptr(gardencorev1beta1.ControllerDeploymentPolicyAlways),
+				DeploymentRefs: []gardencorev1beta1.DeploymentRef{{Name: extension.Name}},
+			}))
+
+			runtimeManagedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "extension-admission-runtime-" + extension.Name}, runtimeManagedResource)).To(Succeed())
+			Expect(runtimeManagedResource.Spec.Class).To(PointTo(Equal("seed")))
+
+			virtualManagedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "extension-admission-virtual-" + extension.Name}, virtualManagedResource)).To(Succeed())
+			Expect(virtualManagedResource.Spec.Class).To(BeNil())
+
+			secret := &corev1.Secret{}
+			Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: virtualManagedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue("test_templates_configmap.yaml", ContainSubstring("virtual: \"true\"")))
+		})
+
+		It("should report the extension as healthy when all components are healthy", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			for _, name := range []string{"extension-admission-runtime-" + extension.Name, "extension-admission-virtual-" + extension.Name} {
+				managedResource := &resourcesv1alpha1.ManagedResource{}
+				Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: name}, managedResource)).To(Succeed())
+				managedResource.Status = healthyManagedResourceStatus(managedResource.Generation)
+				Expect(runtimeClient.Update(ctx, managedResource)).To(Succeed())
+			}
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(extension), extension)).To(Succeed())
+			Expect(v1beta1helper.GetCondition(extension.Status.Conditions, operatorv1alpha1.ExtensionHealthy)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(gardencorev1beta1.ConditionTrue),
+				"Reason": Equal("ExtensionHealthy"),
+			})))
+		})
+
+		It("should report the extension as unhealthy when a ControllerInstallation is unhealthy", func() {
+			extension.Spec.Deployment.AdmissionDeployment = nil
+			Expect(runtimeClient.Update(ctx, extension)).To(Succeed())
+
+			Expect(virtualClient.Create(ctx, &gardencorev1beta1.ControllerInstallation{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: gardencorev1beta1.ControllerInstallationSpec{
+					RegistrationRef: corev1.ObjectReference{Name: extension.Name},
+					SeedRef:         corev1.ObjectReference{Name: "seed"},
+				},
+			})).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(extension), extension)).To(Succeed())
+			Expect(v1beta1helper.GetCondition(extension.Status.Conditions, operatorv1alpha1.ExtensionHealthy)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(gardencorev1beta1.ConditionFalse),
+				"Reason": Equal("ControllerInstallationsUnhealthy"),
+			})))
+		})
+
+		It("should remove resources which are no longer configured", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(extension), extension)).To(Succeed())
+			extension.Spec.Deployment = nil
+			Expect(runtimeClient.Update(ctx, extension)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(virtualClient.Get(ctx, client.ObjectKey{Name: extension.Name}, &gardencorev1beta1.ControllerDeployment{})).To(BeNotFoundError())
+			controllerRegistration := &gardencorev1beta1.ControllerRegistration{}
+			Expect(virtualClient.Get(ctx, client.ObjectKey{Name: extension.Name}, controllerRegistration)).To(Succeed())
+			Expect(controllerRegistration.Spec.Deployment).To(BeNil())
+			Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "extension-admission-runtime-" + extension.Name}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+			Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "extension-admission-virtual-" + extension.Name}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+		})
+
+		It("should delete all resources and remove the finalizer", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(extension), extension)).To(Succeed())
+			Expect(runtimeClient.Delete(ctx, extension)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(extension), extension)).To(BeNotFoundError())
+			Expect(virtualClient.Get(ctx, client.ObjectKey{Name: extension.Name}, &gardencorev1beta1.ControllerRegistration{})).To(BeNotFoundError())
+			Expect(virtualClient.Get(ctx, client.ObjectKey{Name: extension.Name}, &gardencorev1beta1.ControllerDeployment{})).To(BeNotFoundError())
+			Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "extension-admission-runtime-" + extension.Name}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+			Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "extension-admission-virtual-" + extension.Name}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+		})
+
+		It("should wait for the ControllerRegistration to be gone before deleting further resources", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			controllerRegistration := &gardencorev1beta1.ControllerRegistration{}
+			Expect(virtualClient.Get(ctx, client.ObjectKey{Name: extension.Name}, controllerRegistration)).To(Succeed())
+			controllerRegistration.Finalizers = []string{"core.gardener.cloud/controllerregistration"}
+			Expect(virtualClient.Update(ctx, controllerRegistration)).To(Succeed())
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(extension), extension)).To(Succeed())
+			Expect(runtimeClient.Delete(ctx, extension)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: RequeueDurationWhenResourceDeletionStillPresent}))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(extension), extension)).To(Succeed())
+			Expect(v1beta1helper.GetCondition(extension.Status.Conditions, operatorv1alpha1.ExtensionInstalled)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(gardencorev1beta1.ConditionFalse),
+				"Reason": Equal("DeletionPending"),
+			})))
+			Expect(virtualClient.Get(ctx, client.ObjectKey{Name: extension.Name}, &gardencorev1beta1.ControllerDeployment{})).To(Succeed())
+		})
+	})
+})
+
+func chartArchive() []byte {
+	var (
+		buf       bytes.Buffer
+		gzWriter  = gzip.NewWriter(&buf)
+		tarWriter = tar.NewWriter(gzWriter)
+		files     = map[string]string{
+			"test/Chart.yaml": "apiVersion: v2\nname: test\nversion: 0.1.0\n",
+			"test/templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test
+  namespace: {{ .Release.Namespace }}
+data:
+  runtime: "{{ .Values.gardener.runtimeCluster.enabled }}"
+  virtual: "{{ .Values.gardener.virtualCluster.enabled }}"
+`,
+		}
+	)
+
+	for name, content := range files {
+		ExpectWithOffset(1, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content))})).To(Succeed())
+		_, err := tarWriter.Write([]byte(content))
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+
+	ExpectWithOffset(1, tarWriter.Close()).To(Succeed())
+	ExpectWithOffset(1, gzWriter.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func healthyManagedResourceStatus(generation int64) resourcesv1alpha1.ManagedResourceStatus {
+	return resourcesv1alpha1.ManagedResourceStatus{
+		ObservedGeneration: generation,
+		Conditions: []gardencorev1beta1.Condition{
+			{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue},
+			{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionTrue},
+		},
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
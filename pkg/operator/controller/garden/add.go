@@ -23,10 +23,12 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
-	clientmapbuilder "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/builder"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/operator/apis/config"
 	"github.com/gardener/gardener/pkg/operator/controller/garden/care"
 	"github.com/gardener/gardener/pkg/operator/controller/garden/garden"
+	"github.com/gardener/gardener/pkg/operator/controller/garden/gardenlet"
+	"github.com/gardener/gardener/pkg/operator/controller/garden/maintenance"
 	"github.com/gardener/gardener/pkg/operator/controller/garden/reference"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
@@ -37,6 +39,7 @@ func AddToManager(
 	mgr manager.Manager,
 	cfg *config.OperatorConfiguration,
 	identity *gardencorev1beta1.Gardener,
+	gardenClientMap clientmap.ClientMap,
 ) error {
 	var (
 		componentImageVectors imagevectorutils.ComponentImageVectors
@@ -50,18 +53,6 @@ func AddToManager(
 		}
 	}
 
-	gardenClientMap, err := clientmapbuilder.
-		NewGardenClientMapBuilder().
-		WithRuntimeClient(mgr.GetClient()).
-		WithClientConnectionConfig(&cfg.VirtualClientConnection).
-		Build(mgr.GetLogger())
-	if err != nil {
-		return fmt.Errorf("failed to build garden ClientMap: %w", err)
-	}
-	if err := mgr.Add(gardenClientMap); err != nil {
-		return err
-	}
-
 	if err := (&garden.Reconciler{
 		Config:                *cfg,
 		Identity:              identity,
@@ -78,6 +69,18 @@ func AddToManager(
 		return fmt.Errorf("failed adding care reconciler: %w", err)
 	}
 
+	if err := (&gardenlet.Reconciler{
+		Config:          *cfg,
+		GardenClientMap: gardenClientMap,
+		Identity:        identity,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding gardenlet reconciler: %w", err)
+	}
+
+	if err := (&maintenance.Reconciler{}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding maintenance reconciler: %w", err)
+	}
+
 	if err := reference.AddToManager(mgr, v1beta1constants.GardenNamespace); err != nil {
 		return fmt.Errorf("failed adding reference reconciler: %w", err)
 	}
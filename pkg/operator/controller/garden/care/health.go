@@ -16,10 +16,13 @@ package care
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -157,6 +160,31 @@ func (h *health) Check(ctx context.Context, conditions GardenConditions) []garde
 			conditions.observabilityComponentsHealthy = v1beta1helper.NewConditionOrError(h.clock, conditions.observabilityComponentsHealthy, newObservabilityCondition, err)
 			return nil
 		},
+		func(ctx context.Context) error {
+			newGardenerAPIServerCondition, err := h.checkGardenerComponent(ctx, conditions.gardenerAPIServerHealthy, gardenerapiserver.ManagedResourceNameRuntime, gardenerapiserver.ManagedResourceNameVirtual, "GardenerAPIServerRunning", "gardener-apiserver is healthy.")
+			conditions.gardenerAPIServerHealthy = v1beta1helper.NewConditionOrError(h.clock, conditions.gardenerAPIServerHealthy, newGardenerAPIServerCondition, err)
+			return nil
+		},
+		func(ctx context.Context) error {
+			newGardenerAdmissionControllerCondition, err := h.checkGardenerComponent(ctx, conditions.gardenerAdmissionControllerHealthy, gardeneradmissioncontroller.ManagedResourceNameRuntime, gardeneradmissioncontroller.ManagedResourceNameVirtual, "GardenerAdmissionControllerRunning", "gardener-admission-controller is healthy.")
+			conditions.gardenerAdmissionControllerHealthy = v1beta1helper.NewConditionOrError(h.clock, conditions.gardenerAdmissionControllerHealthy, newGardenerAdmissionControllerCondition, err)
+			return nil
+		},
+		func(ctx context.Context) error {
+			newGardenerControllerManagerCondition, err := h.checkGardenerComponent(ctx, conditions.gardenerControllerManagerHealthy, gardenercontrollermanager.ManagedResourceNameRuntime, gardenercontrollermanager.ManagedResourceNameVirtual, "GardenerControllerManagerRunning", "gardener-controller-manager is healthy.")
+			conditions.gardenerControllerManagerHealthy = v1beta1helper.NewConditionOrError(h.clock, conditions.gardenerControllerManagerHealthy, newGardenerControllerManagerCondition, err)
+			return nil
+		},
+		func(ctx context.Context) error {
+			newGardenerSchedulerCondition, err := h.checkGardenerComponent(ctx, conditions.gardenerSchedulerHealthy, gardenerscheduler.ManagedResourceNameRuntime, gardenerscheduler.ManagedResourceNameVirtual, "GardenerSchedulerRunning", "gardener-scheduler is healthy.")
+			conditions.gardenerSchedulerHealthy = v1beta1helper.NewConditionOrError(h.clock, conditions.gardenerSchedulerHealthy, newGardenerSchedulerCondition, err)
+			return nil
+		},
+		func(ctx context.Context) error {
+			newAPIServicesCondition, err := h.checkAPIServices(ctx, conditions.virtualGardenAPIServicesAvailable)
+			conditions.virtualGardenAPIServicesAvailable = v1beta1helper.NewConditionOrError(h.clock, conditions.virtualGardenAPIServicesAvailable, newAPIServicesCondition, err)
+			return nil
+		},
 	}
 
 	_ = flow.Parallel(taskFns...)(ctx)
@@ -241,6 +269,50 @@ func (h *health) checkObservabilityComponents(ctx context.Context, condition gar
 	return h.checkManagedResources(ctx, condition, sets.List(requiredObservabilityManagedResources), "ObservabilityComponentsRunning", "All observability components are healthy.")
 }
 
+// checkGardenerComponent checks whether the ManagedResources of a Gardener control plane component deployed to the
+// runtime and the virtual garden cluster are healthy.
+func (h *health) checkGardenerComponent(
+	ctx context.Context,
+	condition gardencorev1beta1.Condition,
+	managedResourceNameRuntime string,
+	managedResourceNameVirtual string,
+	successReason string,
+	successMessage string,
+) (
+	*gardencorev1beta1.Condition,
+	error,
+) {
+	return h.checkManagedResources(ctx, condition, []string{managedResourceNameRuntime, managedResourceNameVirtual}, successReason, successMessage)
+}
+
+// checkAPIServices checks whether all APIServices registered in the virtual garden's API server are available.
+func (h *health) checkAPIServices(ctx context.Context, condition gardencorev1beta1.Condition) (*gardencorev1beta1.Condition, error) {
+	if h.gardenClientSet == nil {
+		exitCondition := v1beta1helper.FailedCondition(h.clock, h.garden.Status.LastOperation, h.conditionThresholds, condition, "VirtualGardenAPIServerDown", "Could not reach virtual garden API server during client initialization.")
+		return &exitCondition, nil
+	}
+
+	apiServiceList := &apiregistrationv1.APIServiceList{}
+	if err := h.gardenClientSet.Client().List(ctx, apiServiceList); err != nil {
+		return nil, err
+	}
+
+	var unavailableAPIServices []string
+	for _, apiService := range apiServiceList.Items {
+		if err := kuberneteshealth.CheckAPIService(&apiService); err != nil {
+			unavailableAPIServices = append(unavailableAPIServices, fmt.Sprintf("%s (%s)", apiService.Name, err.Error()))
+		}
+	}
+
+	if len(unavailableAPIServices) > 0 {
+		exitCondition := v1beta1helper.FailedCondition(h.clock, h.garden.Status.LastOperation, h.conditionThresholds, condition, "APIServiceUnavailable", fmt.Sprintf("The following APIServices are unavailable: %s", strings.Join(unavailableAPIServices, ", ")))
+		return &exitCondition, nil
+	}
+
+	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "APIServicesAvailable", "All APIServices are available.")
+	return &c, nil
+}
+
 func (h *health) isVPAEnabled() bool {
 	return h.garden.Spec.RuntimeCluster.Settings != nil &&
 		h.garden.Spec.RuntimeCluster.Settings.VerticalPodAutoscaler != nil &&
@@ -253,6 +325,12 @@ type GardenConditions struct {
 	runtimeComponentsHealthy        gardencorev1beta1.Condition
 	virtualComponentsHealthy        gardencorev1beta1.Condition
 	observabilityComponentsHealthy  gardencorev1beta1.Condition
+
+	gardenerAPIServerHealthy           gardencorev1beta1.Condition
+	gardenerAdmissionControllerHealthy gardencorev1beta1.Condition
+	gardenerControllerManagerHealthy   gardencorev1beta1.Condition
+	gardenerSchedulerHealthy           gardencorev1beta1.Condition
+	virtualGardenAPIServicesAvailable  gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the garden conditions as a slice.
@@ -262,6 +340,11 @@ func (g GardenConditions) ConvertToSlice() []gardencorev1beta1.Condition {
 		g.runtimeComponentsHealthy,
 		g.virtualComponentsHealthy,
 		g.observabilityComponentsHealthy,
+		g.gardenerAPIServerHealthy,
+		g.gardenerAdmissionControllerHealthy,
+		g.gardenerControllerManagerHealthy,
+		g.gardenerSchedulerHealthy,
+		g.virtualGardenAPIServicesAvailable,
 	}
 }
 
@@ -272,6 +355,11 @@ func (g GardenConditions) ConditionTypes() []gardencorev1beta1.ConditionType {
 		g.runtimeComponentsHealthy.Type,
 		g.virtualComponentsHealthy.Type,
 		g.observabilityComponentsHealthy.Type,
+		g.gardenerAPIServerHealthy.Type,
+		g.gardenerAdmissionControllerHealthy.Type,
+		g.gardenerControllerManagerHealthy.Type,
+		g.gardenerSchedulerHealthy.Type,
+		g.virtualGardenAPIServicesAvailable.Type,
 	}
 }
 
@@ -283,5 +371,11 @@ func NewGardenConditions(clock clock.Clock, status operatorv1alpha1.GardenStatus
 		runtimeComponentsHealthy:        v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, operatorv1alpha1.RuntimeComponentsHealthy),
 		virtualComponentsHealthy:        v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, operatorv1alpha1.VirtualComponentsHealthy),
 		observabilityComponentsHealthy:  v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, operatorv1alpha1.ObservabilityComponentsHealthy),
+
+		gardenerAPIServerHealthy:           v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, operatorv1alpha1.GardenerAPIServerHealthy),
+		gardenerAdmissionControllerHealthy: v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, operatorv1alpha1.GardenerAdmissionControllerHealthy),
+		gardenerControllerManagerHealthy:   v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, operatorv1alpha1.GardenerControllerManagerHealthy),
+		gardenerSchedulerHealthy:           v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, operatorv1alpha1.GardenerSchedulerHealthy),
+		virtualGardenAPIServicesAvailable:  v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, operatorv1alpha1.VirtualGardenAPIServicesAvailable),
 	}
 }
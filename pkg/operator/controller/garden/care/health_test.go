@@ -16,6 +16,8 @@ package care_test
 
 import (
 	"context"
+	"io"
+	"net/http"
 	"strings"
 	"time"
 
@@ -26,7 +28,10 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/sets"
+	fakerestclient "k8s.io/client-go/rest/fake"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,6 +42,7 @@ import (
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/component/etcd"
 	"github.com/gardener/gardener/pkg/component/gardeneraccess"
 	"github.com/gardener/gardener/pkg/component/gardeneradmissioncontroller"
@@ -678,6 +684,157 @@ var _ = Describe("Garden health", func() {
 		})
 	})
 
+	Describe("#Check Gardener components", func() {
+		It("should set the conditions of all Gardener components to true when their managed resources are healthy", func() {
+			for _, name := range virtualGardenManagedResources {
+				Expect(runtimeClient.Create(ctx, healthyManagedResource(name))).To(Succeed())
+			}
+
+			updatedConditions := NewHealth(
+				garden,
+				runtimeClient,
+				gardenClientSet,
+				fakeClock,
+				nil,
+				gardenNamespace,
+			).Check(ctx, gardenConditions)
+
+			Expect(updatedConditions).To(ContainElements(
+				And(OfType(operatorv1alpha1.GardenerAPIServerHealthy), beConditionWithStatusReasonAndMessage(gardencorev1beta1.ConditionTrue, "GardenerAPIServerRunning", "gardener-apiserver is healthy.")),
+				And(OfType(operatorv1alpha1.GardenerAdmissionControllerHealthy), beConditionWithStatusReasonAndMessage(gardencorev1beta1.ConditionTrue, "GardenerAdmissionControllerRunning", "gardener-admission-controller is healthy.")),
+				And(OfType(operatorv1alpha1.GardenerControllerManagerHealthy), beConditionWithStatusReasonAndMessage(gardencorev1beta1.ConditionTrue, "GardenerControllerManagerRunning", "gardener-controller-manager is healthy.")),
+				And(OfType(operatorv1alpha1.GardenerSchedulerHealthy), beConditionWithStatusReasonAndMessage(gardencorev1beta1.ConditionTrue, "GardenerSchedulerRunning", "gardener-scheduler is healthy.")),
+			))
+		})
+
+		It("should only set the condition of the affected Gardener component to false", func() {
+			for _, name := range virtualGardenManagedResources {
+				mr := healthyManagedResource(name)
+				if name == gardenerscheduler.ManagedResourceNameRuntime {
+					mr = notHealthyManagedResource(name)
+				}
+				Expect(runtimeClient.Create(ctx, mr)).To(Succeed())
+			}
+
+			updatedConditions := NewHealth(
+				garden,
+				runtimeClient,
+				gardenClientSet,
+				fakeClock,
+				nil,
+				gardenNamespace,
+			).Check(ctx, gardenConditions)
+
+			Expect(updatedConditions).To(ContainElements(
+				And(OfType(operatorv1alpha1.GardenerAPIServerHealthy), WithStatus(gardencorev1beta1.ConditionTrue)),
+				And(OfType(operatorv1alpha1.GardenerAdmissionControllerHealthy), WithStatus(gardencorev1beta1.ConditionTrue)),
+				And(OfType(operatorv1alpha1.GardenerControllerManagerHealthy), WithStatus(gardencorev1beta1.ConditionTrue)),
+				And(OfType(operatorv1alpha1.GardenerSchedulerHealthy), WithStatus(gardencorev1beta1.ConditionFalse), WithReason("NotHealthy")),
+			))
+		})
+
+		It("should set the condition of a Gardener component to false when its virtual managed resource is missing", func() {
+			Expect(runtimeClient.Create(ctx, healthyManagedResource(gardenerapiserver.ManagedResourceNameRuntime))).To(Succeed())
+
+			updatedConditions := NewHealth(
+				garden,
+				runtimeClient,
+				gardenClientSet,
+				fakeClock,
+				nil,
+				gardenNamespace,
+			).Check(ctx, gardenConditions)
+
+			Expect(updatedConditions).To(ContainCondition(OfType(operatorv1alpha1.GardenerAPIServerHealthy), WithStatus(gardencorev1beta1.ConditionFalse), WithReason("ResourceNotFound")))
+		})
+	})
+
+	Describe("#Check APIServices", func() {
+		var virtualClient client.Client
+
+		BeforeEach(func() {
+			virtualClient = fakeclient.NewClientBuilder().WithScheme(operatorclient.VirtualScheme).Build()
+			gardenClientSet = fakekubernetes.NewClientSetBuilder().WithClient(virtualClient).WithRESTClient(&fakerestclient.RESTClient{
+				NegotiatedSerializer: serializer.NewCodecFactory(operatorclient.VirtualScheme).WithoutConversion(),
+				Resp: &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader("")),
+				},
+			}).Build()
+			DeferCleanup(func() { gardenClientSet = nil })
+		})
+
+		It("should set the condition to false when the virtual garden client is not available", func() {
+			updatedConditions := NewHealth(
+				garden,
+				runtimeClient,
+				nil,
+				fakeClock,
+				nil,
+				gardenNamespace,
+			).Check(ctx, gardenConditions)
+
+			Expect(updatedConditions).To(ContainCondition(OfType(operatorv1alpha1.VirtualGardenAPIServicesAvailable), WithStatus(gardencorev1beta1.ConditionFalse), WithReason("VirtualGardenAPIServerDown")))
+		})
+
+		It("should set the condition to true when all APIServices are available", func() {
+			Expect(virtualClient.Create(ctx, newAPIService("v1beta1.core.gardener.cloud", apiregistrationv1.ConditionTrue))).To(Succeed())
+			Expect(virtualClient.Create(ctx, newAPIService("v1alpha1.seedmanagement.gardener.cloud", apiregistrationv1.ConditionTrue))).To(Succeed())
+
+			updatedConditions := NewHealth(
+				garden,
+				runtimeClient,
+				gardenClientSet,
+				fakeClock,
+				nil,
+				gardenNamespace,
+			).Check(ctx, gardenConditions)
+
+			Expect(updatedConditions).To(ContainCondition(OfType(operatorv1alpha1.VirtualGardenAPIServicesAvailable), WithStatus(gardencorev1beta1.ConditionTrue), WithReason("APIServicesAvailable"), WithMessage("All APIServices are available.")))
+		})
+
+		It("should set the condition to false when an APIService is unavailable", func() {
+			Expect(virtualClient.Create(ctx, newAPIService("v1beta1.core.gardener.cloud", apiregistrationv1.ConditionTrue))).To(Succeed())
+			Expect(virtualClient.Create(ctx, newAPIService("v1alpha1.seedmanagement.gardener.cloud", apiregistrationv1.ConditionFalse))).To(Succeed())
+
+			updatedConditions := NewHealth(
+				garden,
+				runtimeClient,
+				gardenClientSet,
+				fakeClock,
+				nil,
+				gardenNamespace,
+			).Check(ctx, gardenConditions)
+
+			Expect(updatedConditions).To(ContainCondition(
+				OfType(operatorv1alpha1.VirtualGardenAPIServicesAvailable),
+				WithStatus(gardencorev1beta1.ConditionFalse),
+				WithReason("APIServiceUnavailable"),
+				WithMessageSubstrings("v1alpha1.seedmanagement.gardener.cloud"),
+			))
+		})
+
+		It("should set the condition to Progressing when an APIService is unavailable within the threshold duration", func() {
+			garden.Status.LastOperation = nil
+			Expect(virtualClient.Create(ctx, newAPIService("v1beta1.core.gardener.cloud", apiregistrationv1.ConditionFalse))).To(Succeed())
+
+			gardenConditions = NewGardenConditions(fakeClock, operatorv1alpha1.GardenStatus{
+				Conditions: []gardencorev1beta1.Condition{{Type: operatorv1alpha1.VirtualGardenAPIServicesAvailable, Status: gardencorev1beta1.ConditionTrue}},
+			})
+
+			updatedConditions := NewHealth(
+				garden,
+				runtimeClient,
+				gardenClientSet,
+				fakeClock,
+				map[gardencorev1beta1.ConditionType]time.Duration{operatorv1alpha1.VirtualGardenAPIServicesAvailable: time.Minute},
+				gardenNamespace,
+			).Check(ctx, gardenConditions)
+
+			Expect(updatedConditions).To(ContainCondition(OfType(operatorv1alpha1.VirtualGardenAPIServicesAvailable), WithStatus(gardencorev1beta1.ConditionProgressing), WithReason("APIServiceUnavailable")))
+		})
+	})
+
 	Describe("GardenConditions", func() {
 		Describe("#NewGardenConditions", func() {
 			It("should initialize all conditions", func() {
@@ -688,6 +845,11 @@ var _ = Describe("Garden health", func() {
 					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 
@@ -704,6 +866,11 @@ var _ = Describe("Garden health", func() {
 					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 		})
@@ -717,6 +884,11 @@ var _ = Describe("Garden health", func() {
 					OfType("RuntimeComponentsHealthy"),
 					OfType("VirtualComponentsHealthy"),
 					OfType("ObservabilityComponentsHealthy"),
+					OfType("GardenerAPIServerHealthy"),
+					OfType("GardenerAdmissionControllerHealthy"),
+					OfType("GardenerControllerManagerHealthy"),
+					OfType("GardenerSchedulerHealthy"),
+					OfType("VirtualGardenAPIServicesAvailable"),
 				))
 			})
 		})
@@ -730,6 +902,11 @@ var _ = Describe("Garden health", func() {
 					gardencorev1beta1.ConditionType("RuntimeComponentsHealthy"),
 					gardencorev1beta1.ConditionType("VirtualComponentsHealthy"),
 					gardencorev1beta1.ConditionType("ObservabilityComponentsHealthy"),
+					gardencorev1beta1.ConditionType("GardenerAPIServerHealthy"),
+					gardencorev1beta1.ConditionType("GardenerAdmissionControllerHealthy"),
+					gardencorev1beta1.ConditionType("GardenerControllerManagerHealthy"),
+					gardencorev1beta1.ConditionType("GardenerSchedulerHealthy"),
+					gardencorev1beta1.ConditionType("VirtualGardenAPIServicesAvailable"),
 				))
 			})
 		})
@@ -839,6 +1016,18 @@ func managedResource(name string, conditions []gardencorev1beta1.Condition) *res
 	}
 }
 
+func newAPIService(name string, status apiregistrationv1.ConditionStatus) *apiregistrationv1.APIService {
+	return &apiregistrationv1.APIService{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: apiregistrationv1.APIServiceStatus{
+			Conditions: []apiregistrationv1.APIServiceCondition{{
+				Type:   apiregistrationv1.Available,
+				Status: status,
+			}},
+		},
+	}
+}
+
 func roleLabels(role string) map[string]string {
 	return map[string]string{v1beta1constants.GardenRole: role}
 }
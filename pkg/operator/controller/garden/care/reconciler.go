@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,6 +33,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/operator/apis/config"
+	operatormetrics "github.com/gardener/gardener/pkg/operator/metrics"
 )
 
 var (
@@ -61,6 +63,7 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 	if err := r.RuntimeClient.Get(reconcileCtx, req.NamespacedName, garden); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.V(1).Info("Object is gone, stop reconciling")
+			deleteMetrics(req.Name)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
@@ -91,6 +94,8 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 		gardenConditions,
 	)
 
+	recordMetrics(garden.Name, updatedConditions)
+
 	// Update Garden status conditions if necessary
 	if v1beta1helper.ConditionsNeedUpdate(gardenConditions.ConvertToSlice(), updatedConditions) {
 		log.Info("Updating garden status conditions")
@@ -108,6 +113,38 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 	return reconcile.Result{RequeueAfter: r.Config.Controllers.GardenCare.SyncPeriod.Duration}, nil
 }
 
+var conditionStatuses = []gardencorev1beta1.ConditionStatus{
+	gardencorev1beta1.ConditionTrue,
+	gardencorev1beta1.ConditionFalse,
+	gardencorev1beta1.ConditionProgressing,
+	gardencorev1beta1.ConditionUnknown,
+}
+
+func recordMetrics(gardenName string, conditions []gardencorev1beta1.Condition) {
+	healthy := 1.0
+
+	for _, condition := range conditions {
+		if condition.Status != gardencorev1beta1.ConditionTrue {
+			healthy = 0
+		}
+
+		for _, status := range conditionStatuses {
+			value := 0.0
+			if condition.Status == status {
+				value = 1
+			}
+			operatormetrics.GardenCondition.WithLabelValues(gardenName, string(condition.Type), string(status)).Set(value)
+		}
+	}
+
+	operatormetrics.GardenHealthy.WithLabelValues(gardenName).Set(healthy)
+}
+
+func deleteMetrics(gardenName string) {
+	operatormetrics.GardenCondition.DeletePartialMatch(prometheus.Labels{"name": gardenName})
+	operatormetrics.GardenHealthy.DeletePartialMatch(prometheus.Labels{"name": gardenName})
+}
+
 func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1beta1.ConditionType]time.Duration {
 	conditions := map[gardencorev1beta1.ConditionType]time.Duration{}
 	for _, condition := range r.Config.Controllers.GardenCare.ConditionThresholds {
@@ -21,6 +21,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
@@ -37,6 +38,7 @@ import (
 	"github.com/gardener/gardener/pkg/operator/apis/config"
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
 	. "github.com/gardener/gardener/pkg/operator/controller/garden/care"
+	operatormetrics "github.com/gardener/gardener/pkg/operator/metrics"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/test"
 )
@@ -201,6 +203,39 @@ var _ = Describe("Garden Care Control", func() {
 					Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), updatedGarden)).To(Succeed())
 					Expect(updatedGarden.Status.Conditions).To(ConsistOf(conditions))
 				})
+
+				It("should export the condition metrics", func() {
+					conditions = append(conditions, gardencorev1beta1.Condition{
+						Type:   operatorv1alpha1.GardenerAPIServerHealthy,
+						Status: gardencorev1beta1.ConditionFalse,
+						Reason: "bar",
+					})
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					Expect(testutil.ToFloat64(operatormetrics.GardenCondition.WithLabelValues(gardenName, "RuntimeComponentsHealthy", "True"))).To(Equal(1.0))
+					Expect(testutil.ToFloat64(operatormetrics.GardenCondition.WithLabelValues(gardenName, "RuntimeComponentsHealthy", "False"))).To(Equal(0.0))
+					Expect(testutil.ToFloat64(operatormetrics.GardenCondition.WithLabelValues(gardenName, "GardenerAPIServerHealthy", "True"))).To(Equal(0.0))
+					Expect(testutil.ToFloat64(operatormetrics.GardenCondition.WithLabelValues(gardenName, "GardenerAPIServerHealthy", "False"))).To(Equal(1.0))
+					Expect(testutil.ToFloat64(operatormetrics.GardenHealthy.WithLabelValues(gardenName))).To(Equal(0.0))
+				})
+
+				It("should report the garden as healthy if all conditions are true", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					Expect(testutil.ToFloat64(operatormetrics.GardenHealthy.WithLabelValues(gardenName))).To(Equal(1.0))
+				})
+
+				It("should delete the metrics when the garden is gone", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+					Expect(testutil.CollectAndCount(operatormetrics.GardenHealthy)).To(Equal(1))
+
+					Expect(runtimeClient.Delete(ctx, garden)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{}))
+					Expect(testutil.CollectAndCount(operatormetrics.GardenHealthy)).To(Equal(0))
+					Expect(testutil.CollectAndCount(operatormetrics.GardenCondition)).To(Equal(0))
+				})
 			})
 		})
 	})
@@ -49,6 +49,7 @@ import (
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/apiserver"
 	"github.com/gardener/gardener/pkg/component/etcd"
+	"github.com/gardener/gardener/pkg/component/etcdcopybackupstask"
 	"github.com/gardener/gardener/pkg/component/gardeneraccess"
 	"github.com/gardener/gardener/pkg/component/gardeneradmissioncontroller"
 	"github.com/gardener/gardener/pkg/component/gardenerapiserver"
@@ -101,6 +102,7 @@ type components struct {
 
 	etcdMain                             etcd.Interface
 	etcdEvents                           etcd.Interface
+	etcdCopyBackupsTask                  etcdcopybackupstask.Interface
 	kubeAPIServerService                 component.DeployWaiter
 	kubeAPIServerSNI                     component.Deployer
 	kubeAPIServer                        kubeapiserver.Interface
@@ -184,6 +186,7 @@ func (r *Reconciler) instantiateComponents(
 	if err != nil {
 		return
 	}
+	c.etcdCopyBackupsTask = r.newEtcdCopyBackupsTask(log)
 	c.kubeAPIServerService, err = r.newKubeAPIServerService(log, garden, c.istio.GetValues().IngressGateway)
 	if err != nil {
 		return
@@ -462,6 +465,21 @@ func (r *Reconciler) newEtcd(
 	), nil
 }
 
+func (r *Reconciler) newEtcdCopyBackupsTask(log logr.Logger) etcdcopybackupstask.Interface {
+	return etcdcopybackupstask.New(
+		log,
+		r.RuntimeClientSet.Client(),
+		&etcdcopybackupstask.Values{
+			Name:      namePrefix + v1beta1constants.ETCDMain,
+			Namespace: r.GardenNamespace,
+		},
+		etcdcopybackupstask.DefaultInterval,
+		etcdcopybackupstask.DefaultSevereThreshold,
+		// Copying all backups to the new object store might take longer than the default timeout.
+		30*time.Minute,
+	)
+}
+
 func (r *Reconciler) newKubeAPIServerService(log logr.Logger, garden *operatorv1alpha1.Garden, ingressGatewayValues []istio.IngressGatewayValues) (component.DeployWaiter, error) {
 	if len(ingressGatewayValues) != 1 {
 		return nil, fmt.Errorf("exactly one Istio Ingress Gateway is required for the SNI config")
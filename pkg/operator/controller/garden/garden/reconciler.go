@@ -108,10 +108,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if garden.DeletionTimestamp != nil {
-		if result, err := r.delete(ctx, log, garden, secretsManager, targetVersion); err != nil {
+		result, err := r.delete(ctx, log, garden, secretsManager, targetVersion)
+		if err != nil {
 			return result, r.updateStatusOperationError(ctx, garden, err, operationType)
 		}
-		return reconcile.Result{}, nil
+		return result, nil
 	}
 
 	if result, err := r.reconcile(ctx, log, garden, secretsManager, targetVersion); err != nil {
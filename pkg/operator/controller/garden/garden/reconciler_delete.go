@@ -34,10 +34,20 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
+var (
+	// RequeueDurationWhenGardenletStillPresent is the duration after which the deletion of a garden is requeued when the
+	// gardenlet and its seed are still being deleted.
+	RequeueDurationWhenGardenletStillPresent = 5 * time.Second
+	// RequeueDurationWhenExtensionsStillPresent is the duration after which the deletion of a garden is requeued when
+	// there are still Extensions.
+	RequeueDurationWhenExtensionsStillPresent = 5 * time.Second
+)
+
 func (r *Reconciler) delete(
 	ctx context.Context,
 	log logr.Logger,
@@ -48,6 +58,21 @@ func (r *Reconciler) delete(
 	reconcile.Result,
 	error,
 ) {
+	// The gardenlet (and the seed registered by it) must be removed before the virtual garden cluster can be torn down.
+	if controllerutil.ContainsFinalizer(garden, operatorv1alpha1.FinalizerNameGardenlet) {
+		log.Info("Waiting for gardenlet and seed to be deleted before the garden can be deleted", "seedName", garden.Name)
+		return reconcile.Result{RequeueAfter: RequeueDurationWhenGardenletStillPresent}, nil
+	}
+
+	// Extensions deploy resources (e.g., their admission controllers) into the virtual garden cluster, hence they must be
+	// removed before the virtual garden cluster can be torn down.
+	if extensionsExist, err := kubernetesutils.ResourcesExist(ctx, r.RuntimeClientSet.Client(), &operatorv1alpha1.ExtensionList{}, r.RuntimeClientSet.Client().Scheme()); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking whether Extensions exist: %w", err)
+	} else if extensionsExist {
+		log.Info("Waiting for all Extensions to be deleted before the garden can be deleted")
+		return reconcile.Result{RequeueAfter: RequeueDurationWhenExtensionsStillPresent}, nil
+	}
+
 	log.Info("Instantiating component destroyers")
 	c, err := r.instantiateComponents(ctx, log, garden, secretsManager, targetVersion, kubernetes.NewApplier(r.RuntimeClientSet.Client(), r.RuntimeClientSet.Client().RESTMapper()), nil, false)
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/Masterminds/semver/v3"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -40,6 +41,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/etcd"
+	"github.com/gardener/gardener/pkg/component/etcdcopybackupstask"
 	"github.com/gardener/gardener/pkg/component/gardenerapiserver"
 	"github.com/gardener/gardener/pkg/component/kubeapiserver"
 	"github.com/gardener/gardener/pkg/component/resourcemanager"
@@ -232,16 +234,30 @@ func (r *Reconciler) reconcile(
 			deployPrometheusOperator,
 		)
 
+		migrateETCDBackup = g.Add(flow.Task{
+			Name:         "Migrating backups of main ETCD of virtual garden to new object store",
+			Fn:           r.migrateETCDBackupFunc(garden, secretsManager, c.etcdMain, c.etcdCopyBackupsTask),
+			SkipIf:       !etcdBackupMigrationRequired(garden),
+			Dependencies: flow.NewTaskIDs(syncPointSystemComponents),
+		})
 		deployEtcds = g.Add(flow.Task{
 			Name:         "Deploying main and events ETCDs of virtual garden",
 			Fn:           r.deployEtcdsFunc(garden, c.etcdMain, c.etcdEvents),
-			Dependencies: flow.NewTaskIDs(syncPointSystemComponents),
+			Dependencies: flow.NewTaskIDs(syncPointSystemComponents, migrateETCDBackup),
 		})
 		waitUntilEtcdsReady = g.Add(flow.Task{
 			Name:         "Waiting until main and event ETCDs report readiness",
 			Fn:           flow.Parallel(c.etcdMain.Wait, c.etcdEvents.Wait),
 			Dependencies: flow.NewTaskIDs(deployEtcds),
 		})
+		_ = g.Add(flow.Task{
+			Name: "Updating ETCD backup information in Garden status",
+			Fn: func(ctx context.Context) error {
+				return r.updateETCDBackupStatus(ctx, garden)
+			},
+			SkipIf:       !allowBackup && garden.Status.ETCDBackup == nil,
+			Dependencies: flow.NewTaskIDs(waitUntilEtcdsReady),
+		})
 		deployKubeAPIServerService = g.Add(flow.Task{
 			Name:         "Deploying and waiting for kube-apiserver service in the runtime cluster",
 			Fn:           component.OpWait(c.kubeAPIServerService).Deploy,
@@ -472,11 +488,7 @@ func (r *Reconciler) deployEtcdsFunc(garden *operatorv1alpha1.Garden, etcdMain,
 				backupLeaderElection = r.Config.Controllers.Garden.ETCDConfig.BackupLeaderElection
 			}
 
-			container, prefix := etcdConfig.Main.Backup.BucketName, "virtual-garden-etcd-main"
-			if idx := strings.Index(etcdConfig.Main.Backup.BucketName, "/"); idx != -1 {
-				container = etcdConfig.Main.Backup.BucketName[:idx]
-				prefix = fmt.Sprintf("%s/%s", strings.TrimSuffix(etcdConfig.Main.Backup.BucketName[idx+1:], "/"), prefix)
-			}
+			container, prefix := backupContainerAndPrefix(etcdConfig.Main.Backup.BucketName)
 
 			etcdMain.SetBackupConfig(&etcd.BackupConfig{
 				Provider:             etcdConfig.Main.Backup.Provider,
@@ -504,6 +516,155 @@ func (r *Reconciler) deployEtcdsFunc(garden *operatorv1alpha1.Garden, etcdMain,
 	}
 }
 
+// backupContainerAndPrefix splits the given bucket name into the container and the prefix used for the backups of the
+// main ETCD.
+func backupContainerAndPrefix(bucketName string) (string, string) {
+	container, prefix := bucketName, "virtual-garden-etcd-main"
+	if idx := strings.Index(bucketName, "/"); idx != -1 {
+		container = bucketName[:idx]
+		prefix = fmt.Sprintf("%s/%s", strings.TrimSuffix(bucketName[idx+1:], "/"), prefix)
+	}
+	return container, prefix
+}
+
+// etcdBackupMigrationRequired returns true if the main ETCD currently uses another object store than the one configured
+// in the Garden specification, or if a previous migration has not been completed or has been aborted yet. Changing
+// only the credentials of the object store does not require a migration.
+func etcdBackupMigrationRequired(garden *operatorv1alpha1.Garden) bool {
+	if etcdConfig := garden.Spec.VirtualCluster.ETCD; etcdConfig == nil || etcdConfig.Main == nil || etcdConfig.Main.Backup == nil {
+		return false
+	}
+
+	// The status is only populated once the main ETCD was deployed with a backup configuration. If it is not yet
+	// populated, there is nothing which could be migrated.
+	status := garden.Status.ETCDBackup
+	if status == nil {
+		return false
+	}
+
+	if status.Migration != nil && status.Migration.Phase == operatorv1alpha1.ETCDBackupMigrationInProgress {
+		return true
+	}
+
+	return !helper.IsSameETCDBackupObjectStore(status.Backup, *garden.Spec.VirtualCluster.ETCD.Main.Backup)
+}
+
+func (r *Reconciler) migrateETCDBackupFunc(garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface, etcdMain etcd.Interface, etcdCopyBackupsTask etcdcopybackupstask.Interface) flow.TaskFn {
+	return func(ctx context.Context) error {
+		var (
+			source = garden.Status.ETCDBackup.Backup
+			target = *garden.Spec.VirtualCluster.ETCD.Main.Backup
+		)
+
+		// The migration is aborted if the backup configuration was reverted to the object store which is still used by
+		// the main ETCD, e.g., because the new object store is not reachable.
+		if helper.IsSameETCDBackupObjectStore(source, target) {
+			return r.abortETCDBackupMigration(ctx, garden, etcdCopyBackupsTask)
+		}
+
+		if migration := garden.Status.ETCDBackup.Migration; migration == nil || migration.Phase != operatorv1alpha1.ETCDBackupMigrationInProgress {
+			patch := client.MergeFrom(garden.DeepCopy())
+			garden.Status.ETCDBackup.Migration = &operatorv1alpha1.ETCDBackupMigration{
+				Phase:     operatorv1alpha1.ETCDBackupMigrationInProgress,
+				Source:    source,
+				StartTime: metav1.Time{Time: r.Clock.Now().UTC()},
+			}
+			if err := r.RuntimeClientSet.Client().Status().Patch(ctx, garden, patch); err != nil {
+				return fmt.Errorf("failed patching ETCD backup migration status: %w", err)
+			}
+		}
+
+		// Take a full snapshot first so that the most recent state of the main ETCD is part of the copied backups.
+		// At this point, etcd-backup-restore still uses the source object store.
+		sourceContainer, sourcePrefix := backupContainerAndPrefix(source.BucketName)
+		etcdMain.SetBackupConfig(&etcd.BackupConfig{
+			Provider:      source.Provider,
+			SecretRefName: source.SecretRef.Name,
+			Container:     sourceContainer,
+			Prefix:        sourcePrefix,
+		})
+		if err := shared.SnapshotEtcd(ctx, secretsManager, etcdMain); err != nil {
+			return fmt.Errorf("failed taking full snapshot of main ETCD: %w", err)
+		}
+
+		// Clean up leftovers of a previous (failed) attempt before copying the backups.
+		if err := component.OpDestroyAndWait(etcdCopyBackupsTask).Destroy(ctx); err != nil {
+			return err
+		}
+
+		etcdCopyBackupsTask.SetSourceStore(storeSpec(source))
+		etcdCopyBackupsTask.SetTargetStore(storeSpec(target))
+		if err := component.OpWait(etcdCopyBackupsTask).Deploy(ctx); err != nil {
+			return fmt.Errorf("failed copying backups of main ETCD to new object store: %w", err)
+		}
+
+		// etcd-backup-restore is repointed to the new object store in the subsequent deployment of the main ETCD.
+		return component.OpDestroyAndWait(etcdCopyBackupsTask).Destroy(ctx)
+	}
+}
+
+func (r *Reconciler) abortETCDBackupMigration(ctx context.Context, garden *operatorv1alpha1.Garden, etcdCopyBackupsTask etcdcopybackupstask.Interface) error {
+	if err := component.OpDestroyAndWait(etcdCopyBackupsTask).Destroy(ctx); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(garden.DeepCopy())
+	garden.Status.ETCDBackup.Migration = nil
+	if err := r.RuntimeClientSet.Client().Status().Patch(ctx, garden, patch); err != nil {
+		return fmt.Errorf("failed removing aborted ETCD backup migration from status: %w", err)
+	}
+
+	return nil
+}
+
+func storeSpec(backup operatorv1alpha1.Backup) druidv1alpha1.StoreSpec {
+	var (
+		provider          = druidv1alpha1.StorageProvider(backup.Provider)
+		container, prefix = backupContainerAndPrefix(backup.BucketName)
+	)
+
+	return druidv1alpha1.StoreSpec{
+		Provider:  &provider,
+		SecretRef: &corev1.SecretReference{Name: backup.SecretRef.Name},
+		Container: &container,
+		Prefix:    prefix,
+	}
+}
+
+// updateETCDBackupStatus records the object store currently used by the main ETCD in the Garden status. In case a
+// migration was in progress, it is marked as completed and the source object store is retained for the configured
+// retention period. Once this period has passed, the migration information is removed.
+func (r *Reconciler) updateETCDBackupStatus(ctx context.Context, garden *operatorv1alpha1.Garden) error {
+	patch := client.MergeFrom(garden.DeepCopy())
+
+	if etcdConfig := garden.Spec.VirtualCluster.ETCD; etcdConfig == nil || etcdConfig.Main == nil || etcdConfig.Main.Backup == nil {
+		garden.Status.ETCDBackup = nil
+		return r.RuntimeClientSet.Client().Status().Patch(ctx, garden, patch)
+	}
+
+	if garden.Status.ETCDBackup == nil {
+		garden.Status.ETCDBackup = &operatorv1alpha1.ETCDBackupStatus{}
+	}
+	garden.Status.ETCDBackup.Backup = *garden.Spec.VirtualCluster.ETCD.Main.Backup
+
+	if migration := garden.Status.ETCDBackup.Migration; migration != nil {
+		now := r.Clock.Now().UTC()
+
+		switch migration.Phase {
+		case operatorv1alpha1.ETCDBackupMigrationInProgress:
+			migration.Phase = operatorv1alpha1.ETCDBackupMigrationCompleted
+			migration.CompletionTime = &metav1.Time{Time: now}
+			migration.SourceRetainedUntil = &metav1.Time{Time: now.Add(r.Config.Controllers.Garden.ETCDBackupMigrationRetentionPeriod.Duration)}
+		case operatorv1alpha1.ETCDBackupMigrationCompleted:
+			if migration.SourceRetainedUntil == nil || !now.Before(migration.SourceRetainedUntil.Time) {
+				garden.Status.ETCDBackup.Migration = nil
+			}
+		}
+	}
+
+	return r.RuntimeClientSet.Client().Status().Patch(ctx, garden, patch)
+}
+
 func (r *Reconciler) deployKubeAPIServerFunc(garden *operatorv1alpha1.Garden, kubeAPIServer kubeapiserver.Interface) flow.TaskFn {
 	return func(ctx context.Context) error {
 		var (
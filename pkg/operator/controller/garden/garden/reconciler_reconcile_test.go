@@ -0,0 +1,127 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package garden
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	mocketcdcopybackupstask "github.com/gardener/gardener/pkg/component/etcdcopybackupstask/mock"
+	operatorclient "github.com/gardener/gardener/pkg/operator/client"
+)
+
+var _ = Describe("ETCD backup migration", func() {
+	var (
+		source = operatorv1alpha1.Backup{Provider: "source-provider", BucketName: "source-bucket", SecretRef: corev1.LocalObjectReference{Name: "source-secret"}}
+		target = operatorv1alpha1.Backup{Provider: "target-provider", BucketName: "target-bucket", SecretRef: corev1.LocalObjectReference{Name: "target-secret"}}
+
+		garden *operatorv1alpha1.Garden
+	)
+
+	BeforeEach(func() {
+		garden = &operatorv1alpha1.Garden{
+			ObjectMeta: metav1.ObjectMeta{Name: "garden"},
+			Spec: operatorv1alpha1.GardenSpec{
+				VirtualCluster: operatorv1alpha1.VirtualCluster{
+					ETCD: &operatorv1alpha1.ETCD{Main: &operatorv1alpha1.ETCDMain{Backup: target.DeepCopy()}},
+				},
+			},
+			Status: operatorv1alpha1.GardenStatus{
+				ETCDBackup: &operatorv1alpha1.ETCDBackupStatus{Backup: source},
+			},
+		}
+	})
+
+	Describe("#etcdBackupMigrationRequired", func() {
+		It("should return false if no backup is configured", func() {
+			garden.Spec.VirtualCluster.ETCD = nil
+			Expect(etcdBackupMigrationRequired(garden)).To(BeFalse())
+		})
+
+		It("should return false if the backup status is not yet populated", func() {
+			garden.Status.ETCDBackup = nil
+			Expect(etcdBackupMigrationRequired(garden)).To(BeFalse())
+		})
+
+		It("should return true if the object store changed", func() {
+			Expect(etcdBackupMigrationRequired(garden)).To(BeTrue())
+		})
+
+		It("should return false if only the credentials changed", func() {
+			garden.Spec.VirtualCluster.ETCD.Main.Backup = source.DeepCopy()
+			garden.Spec.VirtualCluster.ETCD.Main.Backup.SecretRef.Name = "new-secret"
+			Expect(etcdBackupMigrationRequired(garden)).To(BeFalse())
+		})
+
+		It("should return true if a migration in progress was aborted", func() {
+			garden.Spec.VirtualCluster.ETCD.Main.Backup = source.DeepCopy()
+			garden.Status.ETCDBackup.Migration = &operatorv1alpha1.ETCDBackupMigration{Phase: operatorv1alpha1.ETCDBackupMigrationInProgress, Source: source}
+			Expect(etcdBackupMigrationRequired(garden)).To(BeTrue())
+		})
+	})
+
+	Describe("#migrateETCDBackupFunc", func() {
+		var (
+			ctx = context.TODO()
+
+			ctrl                    *gomock.Controller
+			fakeClient              client.Client
+			reconciler              *Reconciler
+			mockEtcdCopyBackupsTask *mocketcdcopybackupstask.MockInterface
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			mockEtcdCopyBackupsTask = mocketcdcopybackupstask.NewMockInterface(ctrl)
+
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(operatorclient.RuntimeScheme).WithStatusSubresource(&operatorv1alpha1.Garden{}).Build()
+			reconciler = &Reconciler{
+				RuntimeClientSet: fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+				Clock:            testclock.NewFakeClock(metav1.Now().Time),
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should abort the migration if the backup configuration was reverted", func() {
+			garden.Spec.VirtualCluster.ETCD.Main.Backup = source.DeepCopy()
+			garden.Status.ETCDBackup.Migration = &operatorv1alpha1.ETCDBackupMigration{Phase: operatorv1alpha1.ETCDBackupMigrationInProgress, Source: source}
+			Expect(fakeClient.Create(ctx, garden)).To(Succeed())
+			Expect(fakeClient.Status().Update(ctx, garden)).To(Succeed())
+
+			gomock.InOrder(
+				mockEtcdCopyBackupsTask.EXPECT().Destroy(ctx),
+				mockEtcdCopyBackupsTask.EXPECT().WaitCleanup(ctx),
+			)
+
+			Expect(reconciler.migrateETCDBackupFunc(garden, nil, nil, mockEtcdCopyBackupsTask)(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			Expect(garden.Status.ETCDBackup).To(Equal(&operatorv1alpha1.ETCDBackupStatus{Backup: source}))
+		})
+	})
+})
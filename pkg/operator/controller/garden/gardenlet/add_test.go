@@ -0,0 +1,98 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardenlet_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	. "github.com/gardener/gardener/pkg/operator/controller/garden/gardenlet"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		garden     *operatorv1alpha1.Garden
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		garden = &operatorv1alpha1.Garden{ObjectMeta: metav1.ObjectMeta{Name: "garden", Generation: 1}}
+	})
+
+	Describe("#GardenPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.GardenPredicate()
+		})
+
+		Describe("#Create", func() {
+			It("should return true", func() {
+				Expect(p.Create(event.CreateEvent{})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because new object is no garden", func() {
+				Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+			})
+
+			It("should return false because old object is no garden", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectNew: garden})).To(BeFalse())
+			})
+
+			It("should return false because nothing relevant changed", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: garden.DeepCopy(), ObjectNew: garden})).To(BeFalse())
+			})
+
+			It("should return true because the generation changed", func() {
+				oldGarden := garden.DeepCopy()
+				garden.Generation++
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldGarden, ObjectNew: garden})).To(BeTrue())
+			})
+
+			It("should return true because the garden is being deleted", func() {
+				oldGarden := garden.DeepCopy()
+				garden.DeletionTimestamp = &metav1.Time{}
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldGarden, ObjectNew: garden})).To(BeTrue())
+			})
+
+			It("should return true because the reconciliation finished successfully", func() {
+				garden.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: gardencorev1beta1.LastOperationStateSucceeded}
+				oldGarden := garden.DeepCopy()
+				oldGarden.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldGarden, ObjectNew: garden})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{})).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,409 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardenlet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/charts"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement/encoding"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	gardenletbootstraputil "github.com/gardener/gardener/pkg/gardenlet/bootstrap/util"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/managedseed"
+	"github.com/gardener/gardener/pkg/operator/apis/config"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// managedResourceName is the name of the ManagedResource containing the resources of the gardenlet.
+	managedResourceName = "gardenlet"
+	// releaseName is the name of the Helm release used for rendering the gardenlet chart.
+	releaseName = "gardenlet"
+	// bootstrapTokenValidity is the validity of the bootstrap token used by the gardenlet for its initial registration.
+	bootstrapTokenValidity = 24 * time.Hour
+	// annotationKubeconfigSecret is the key of an annotation on the ManagedResource of the gardenlet which contains the
+	// reference (<namespace>/<name>) to the secret in which gardenlet stores its kubeconfig for the virtual garden
+	// cluster. It is needed for the deletion since the gardenlet configuration might not be part of the Garden anymore.
+	annotationKubeconfigSecret = "operator.gardener.cloud/gardenlet-kubeconfig-secret"
+)
+
+// RequeueDurationWhenResourceDeletionStillPresent is the duration after which a reconciliation is requeued when the
+// seed or the gardenlet are still being deleted.
+var RequeueDurationWhenResourceDeletionStillPresent = 5 * time.Second
+
+// Reconciler deploys gardenlet into the runtime cluster of the Garden so that the runtime cluster is registered as
+// seed cluster in the virtual garden cluster. It reports the readiness of this seed in the Garden status.
+type Reconciler struct {
+	RuntimeClientSet kubernetes.Interface
+	Config           config.OperatorConfiguration
+	Clock            clock.Clock
+	GardenClientMap  clientmap.ClientMap
+	GardenNamespace  string
+	Identity         *gardencorev1beta1.Gardener
+	ValuesHelper     managedseed.ValuesHelper
+}
+
+// Reconcile performs the main reconciliation logic.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	garden := &operatorv1alpha1.Garden{}
+	if err := r.RuntimeClientSet.Client().Get(ctx, request.NamespacedName, garden); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if garden.DeletionTimestamp != nil || garden.Spec.RuntimeCluster.Seed == nil {
+		return r.delete(ctx, log, garden)
+	}
+
+	return r.reconcile(ctx, log, garden)
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, garden *operatorv1alpha1.Garden) (reconcile.Result, error) {
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, garden.Status.Conditions, operatorv1alpha1.SeedReady)
+
+	// The virtual garden cluster must be available before gardenlet can register the seed. Once gardenlet was deployed,
+	// it is kept up-to-date regardless of the state of the Garden.
+	if !controllerutil.ContainsFinalizer(garden, operatorv1alpha1.FinalizerNameGardenlet) && !gardenReconciledSuccessfully(garden) {
+		log.Info("Garden has not been reconciled successfully yet, requeueing")
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "GardenNotReconciled", "The Garden has not been reconciled successfully yet.")
+		return reconcile.Result{RequeueAfter: r.Config.Controllers.GardenGardenlet.SyncPeriod.Duration}, r.updateCondition(ctx, garden, condition)
+	}
+
+	if !controllerutil.ContainsFinalizer(garden, operatorv1alpha1.FinalizerNameGardenlet) {
+		log.Info("Adding finalizer")
+		if err := controllerutils.AddFinalizers(ctx, r.RuntimeClientSet.Client(), garden, operatorv1alpha1.FinalizerNameGardenlet); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+	}
+
+	virtualClientSet, err := r.GardenClientMap.GetClient(ctx, keys.ForGarden(garden))
+	if err != nil {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionUnknown, "VirtualGardenAccessFailed", fmt.Sprintf("Virtual garden cluster cannot be accessed: %v", err))
+		return reconcile.Result{}, r.updateConditionWithError(ctx, garden, err, condition)
+	}
+
+	seed, err := r.getSeed(ctx, virtualClientSet.Client(), garden.Name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.Info("Deploying gardenlet")
+	if err := r.deployGardenlet(ctx, virtualClientSet, garden, seed); err != nil {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "GardenletDeploymentFailed", err.Error())
+		return reconcile.Result{}, r.updateConditionWithError(ctx, garden, err, condition)
+	}
+
+	condition = r.checkSeed(seed, condition)
+
+	return reconcile.Result{RequeueAfter: r.Config.Controllers.GardenGardenlet.SyncPeriod.Duration}, r.updateCondition(ctx, garden, condition)
+}
+
+func (r *Reconciler) delete(ctx context.Context, log logr.Logger, garden *operatorv1alpha1.Garden) (reconcile.Result, error) {
+	if !controllerutil.ContainsFinalizer(garden, operatorv1alpha1.FinalizerNameGardenlet) {
+		return reconcile.Result{}, r.removeCondition(ctx, garden)
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, garden.Status.Conditions, operatorv1alpha1.SeedReady)
+
+	virtualClientSet, err := r.GardenClientMap.GetClient(ctx, keys.ForGarden(garden))
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed getting virtual garden client: %w", err)
+	}
+
+	// The seed must be deleted first while gardenlet is still running since gardenlet is responsible for cleaning up
+	// the seed system components and for releasing the seed's finalizer.
+	log.Info("Deleting seed", "seedName", garden.Name)
+	seed := &gardencorev1beta1.Seed{}
+	seed.SetName(garden.Name)
+	if err := virtualClientSet.Client().Delete(ctx, seed); client.IgnoreNotFound(err) != nil {
+		return reconcile.Result{}, fmt.Errorf("failed deleting seed %q: %w", garden.Name, err)
+	}
+	if err := virtualClientSet.Client().Get(ctx, client.ObjectKeyFromObject(seed), seed); err == nil {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "SeedDeletionPending", fmt.Sprintf("Deletion of seed %q is still pending.", garden.Name))
+		return reconcile.Result{RequeueAfter: RequeueDurationWhenResourceDeletionStillPresent}, r.updateCondition(ctx, garden, condition)
+	} else if !apierrors.IsNotFound(err) {
+		return reconcile.Result{}, err
+	}
+
+	kubeconfigSecret, err := r.kubeconfigSecretReference(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.Info("Deleting gardenlet")
+	if err := managedresources.Delete(ctx, r.RuntimeClientSet.Client(), r.GardenNamespace, managedResourceName, true); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed deleting ManagedResource %q: %w", managedResourceName, err)
+	}
+	if err := r.RuntimeClientSet.Client().Get(ctx, client.ObjectKey{Namespace: r.GardenNamespace, Name: managedResourceName}, &resourcesv1alpha1.ManagedResource{}); err == nil {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "GardenletDeletionPending", "Deletion of gardenlet is still pending.")
+		return reconcile.Result{RequeueAfter: RequeueDurationWhenResourceDeletionStillPresent}, r.updateCondition(ctx, garden, condition)
+	} else if !apierrors.IsNotFound(err) {
+		return reconcile.Result{}, err
+	}
+
+	// The kubeconfig secret is created by gardenlet itself after the bootstrapping and is hence not part of the
+	// ManagedResource.
+	if err := kubernetesutils.DeleteSecretByReference(ctx, r.RuntimeClientSet.Client(), kubeconfigSecret); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed deleting gardenlet kubeconfig secret: %w", err)
+	}
+
+	if err := r.removeCondition(ctx, garden); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.Info("Removing finalizer")
+	if err := controllerutils.RemoveFinalizers(ctx, r.RuntimeClientSet.Client(), garden, operatorv1alpha1.FinalizerNameGardenlet); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) deployGardenlet(ctx context.Context, virtualClientSet kubernetes.Interface, garden *operatorv1alpha1.Garden, seed *gardencorev1beta1.Seed) error {
+	values, kubeconfigSecret, err := r.prepareGardenletChartValues(ctx, virtualClientSet, garden, seed)
+	if err != nil {
+		return err
+	}
+
+	renderedChart, err := r.RuntimeClientSet.ChartRenderer().RenderEmbeddedFS(charts.ChartGardenlet, charts.ChartPathGardenlet, releaseName, r.GardenNamespace, values)
+	if err != nil {
+		return fmt.Errorf("failed rendering gardenlet chart: %w", err)
+	}
+
+	var (
+		secretName, secret = managedresources.NewSecret(r.RuntimeClientSet.Client(), r.GardenNamespace, managedResourceName, renderedChart.AsSecretData(), true)
+		managedResource    = managedresources.NewForSeed(r.RuntimeClientSet.Client(), r.GardenNamespace, managedResourceName, false).
+					WithSecretRef(secretName).
+					WithAnnotations(map[string]string{annotationKubeconfigSecret: kubeconfigSecret.Namespace + "/" + kubeconfigSecret.Name})
+	)
+
+	if err := secret.Reconcile(ctx); err != nil {
+		return fmt.Errorf("could not create or update secret of managed resources: %w", err)
+	}
+	return managedResource.Reconcile(ctx)
+}
+
+func (r *Reconciler) prepareGardenletChartValues(ctx context.Context, virtualClientSet kubernetes.Interface, garden *operatorv1alpha1.Garden, seed *gardencorev1beta1.Seed) (map[string]interface{}, *corev1.SecretReference, error) {
+	deployment := garden.Spec.RuntimeCluster.Seed.Deployment
+	if deployment == nil {
+		deployment = &seedmanagementv1alpha1.GardenletDeployment{}
+	}
+
+	mergedDeployment, err := r.ValuesHelper.MergeGardenletDeployment(deployment, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed merging gardenlet deployment: %w", err)
+	}
+
+	gardenletConfig, err := encoding.DecodeGardenletConfiguration(&garden.Spec.RuntimeCluster.Seed.Config, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed decoding gardenlet configuration: %w", err)
+	}
+
+	if gardenletConfig.GardenClientConnection == nil {
+		gardenletConfig.GardenClientConnection = &gardenletv1alpha1.GardenClientConnection{}
+	}
+
+	bootstrapKubeconfig, err := r.prepareGardenClientConnectionWithBootstrap(ctx, virtualClientSet, gardenletConfig.GardenClientConnection, garden, seed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if gardenletConfig.SeedConfig == nil {
+		gardenletConfig.SeedConfig = &gardenletv1alpha1.SeedConfig{}
+	}
+	// The name of the seed is always the name of the Garden.
+	gardenletConfig.SeedConfig.SeedTemplate.Name = garden.Name
+
+	// The kubeconfig secret reference is defaulted by prepareGardenClientConnectionWithBootstrap.
+	kubeconfigSecret := gardenletConfig.GardenClientConnection.KubeconfigSecret.DeepCopy()
+
+	values, err := r.ValuesHelper.GetGardenletChartValues(mergedDeployment, gardenletConfig, bootstrapKubeconfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return values, kubeconfigSecret, nil
+}
+
+// prepareGardenClientConnectionWithBootstrap ensures that gardenlet can access the virtual garden cluster. If gardenlet
+// has not been bootstrapped yet (or its client certificate has expired), a kubeconfig containing a bootstrap token is
+// returned.
+func (r *Reconciler) prepareGardenClientConnectionWithBootstrap(
+	ctx context.Context,
+	virtualClientSet kubernetes.Interface,
+	gcc *gardenletv1alpha1.GardenClientConnection,
+	garden *operatorv1alpha1.Garden,
+	seed *gardencorev1beta1.Seed,
+) (
+	string,
+	error,
+) {
+	if gcc.KubeconfigSecret == nil {
+		gcc.KubeconfigSecret = &corev1.SecretReference{
+			Name:      managedseed.GardenletDefaultKubeconfigSecretName,
+			Namespace: r.GardenNamespace,
+		}
+	}
+
+	if seed != nil && seed.Status.ClientCertificateExpirationTimestamp != nil && seed.Status.ClientCertificateExpirationTimestamp.UTC().Before(r.Clock.Now().UTC()) {
+		// Check if client certificate is expired. If yes then delete the existing kubeconfig secret to make sure that the
+		// seed can be re-bootstrapped.
+		if err := kubernetesutils.DeleteSecretByReference(ctx, r.RuntimeClientSet.Client(), gcc.KubeconfigSecret); err != nil {
+			return "", err
+		}
+	} else {
+		secret, err := kubernetesutils.GetSecretByReference(ctx, r.RuntimeClientSet.Client(), gcc.KubeconfigSecret)
+		if client.IgnoreNotFound(err) != nil {
+			return "", err
+		}
+		if secret != nil {
+			return "", nil
+		}
+	}
+
+	gcc.Kubeconfig = ""
+	if gcc.BootstrapKubeconfig == nil {
+		gcc.BootstrapKubeconfig = &corev1.SecretReference{
+			Name:      managedseed.GardenletDefaultKubeconfigBootstrapSecretName,
+			Namespace: r.GardenNamespace,
+		}
+	}
+
+	var (
+		gardenClientRestConfig = gardenerutils.PrepareGardenClientRestConfig(virtualRESTConfig(virtualClientSet), gcc.GardenClusterAddress, gcc.GardenClusterCACert)
+		tokenID                = gardenletbootstraputil.TokenID(garden.ObjectMeta)
+		tokenDescription       = gardenletbootstraputil.Description(gardenletbootstraputil.KindGarden, "", garden.Name)
+	)
+
+	bootstrapKubeconfig, err := gardenletbootstraputil.ComputeGardenletKubeconfigWithBootstrapToken(ctx, virtualClientSet.Client(), gardenClientRestConfig, tokenID, tokenDescription, bootstrapTokenValidity)
+	if err != nil {
+		return "", fmt.Errorf("failed computing bootstrap kubeconfig: %w", err)
+	}
+
+	return string(bootstrapKubeconfig), nil
+}
+
+// kubeconfigSecretReference returns the reference to the secret in which gardenlet stores its kubeconfig for the
+// virtual garden cluster. It was recorded on the ManagedResource of the gardenlet when it was deployed.
+func (r *Reconciler) kubeconfigSecretReference(ctx context.Context) (*corev1.SecretReference, error) {
+	kubeconfigSecret := &corev1.SecretReference{Namespace: r.GardenNamespace, Name: managedseed.GardenletDefaultKubeconfigSecretName}
+
+	managedResource := &resourcesv1alpha1.ManagedResource{}
+	if err := r.RuntimeClientSet.Client().Get(ctx, client.ObjectKey{Namespace: r.GardenNamespace, Name: managedResourceName}, managedResource); err != nil {
+		if apierrors.IsNotFound(err) {
+			return kubeconfigSecret, nil
+		}
+		return nil, fmt.Errorf("failed reading ManagedResource %q: %w", managedResourceName, err)
+	}
+
+	if value, ok := managedResource.Annotations[annotationKubeconfigSecret]; ok {
+		namespace, name, found := strings.Cut(value, "/")
+		if !found {
+			return nil, fmt.Errorf("invalid value %q for annotation %q on ManagedResource %q", value, annotationKubeconfigSecret, managedResourceName)
+		}
+		kubeconfigSecret = &corev1.SecretReference{Namespace: namespace, Name: name}
+	}
+
+	return kubeconfigSecret, nil
+}
+
+func (r *Reconciler) checkSeed(seed *gardencorev1beta1.Seed, condition gardencorev1beta1.Condition) gardencorev1beta1.Condition {
+	if seed == nil {
+		return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "SeedNotRegistered", "Gardenlet has been deployed but the seed is not registered yet.")
+	}
+
+	if err := health.CheckSeedForMigration(seed, r.Identity); err != nil {
+		return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "SeedUnhealthy", fmt.Sprintf("Seed %q is not ready: %v", seed.Name, err))
+	}
+
+	return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, "SeedReady", fmt.Sprintf("Seed %q is registered and ready.", seed.Name))
+}
+
+func (r *Reconciler) getSeed(ctx context.Context, virtualClient client.Client, name string) (*gardencorev1beta1.Seed, error) {
+	seed := &gardencorev1beta1.Seed{}
+	if err := virtualClient.Get(ctx, client.ObjectKey{Name: name}, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading seed %q: %w", name, err)
+	}
+	return seed, nil
+}
+
+func (r *Reconciler) updateCondition(ctx context.Context, garden *operatorv1alpha1.Garden, condition gardencorev1beta1.Condition) error {
+	patch := client.MergeFrom(garden.DeepCopy())
+	garden.Status.Conditions = v1beta1helper.MergeConditions(garden.Status.Conditions, condition)
+	return r.RuntimeClientSet.Client().Status().Patch(ctx, garden, patch)
+}
+
+func (r *Reconciler) updateConditionWithError(ctx context.Context, garden *operatorv1alpha1.Garden, err error, condition gardencorev1beta1.Condition) error {
+	if err2 := r.updateCondition(ctx, garden, condition); err2 != nil {
+		return fmt.Errorf("failed updating condition (due to %s): %w", err.Error(), err2)
+	}
+	return err
+}
+
+func (r *Reconciler) removeCondition(ctx context.Context, garden *operatorv1alpha1.Garden) error {
+	if v1beta1helper.GetCondition(garden.Status.Conditions, operatorv1alpha1.SeedReady) == nil {
+		return nil
+	}
+
+	patch := client.MergeFrom(garden.DeepCopy())
+	garden.Status.Conditions = v1beta1helper.RemoveConditions(garden.Status.Conditions, operatorv1alpha1.SeedReady)
+	return r.RuntimeClientSet.Client().Status().Patch(ctx, garden, patch)
+}
+
+func gardenReconciledSuccessfully(garden *operatorv1alpha1.Garden) bool {
+	return garden.Status.LastOperation != nil &&
+		garden.Status.LastOperation.Type == gardencorev1beta1.LastOperationTypeReconcile &&
+		garden.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+}
+
+func virtualRESTConfig(virtualClientSet kubernetes.Interface) *rest.Config {
+	if cfg := virtualClientSet.RESTConfig(); cfg != nil {
+		return cfg
+	}
+	return &rest.Config{}
+}
@@ -0,0 +1,260 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardenlet_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/rest"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	fakeclientmap "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/fake"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/managedseed"
+	"github.com/gardener/gardener/pkg/operator/apis/config"
+	operatorclient "github.com/gardener/gardener/pkg/operator/client"
+	. "github.com/gardener/gardener/pkg/operator/controller/garden/gardenlet"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		gardenNamespace = "garden"
+		syncPeriod      = time.Minute
+
+		runtimeClient client.Client
+		virtualClient client.Client
+		fakeClock     *testclock.FakeClock
+		reconciler    *Reconciler
+
+		garden  *operatorv1alpha1.Garden
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		runtimeClient = fakeclient.NewClientBuilder().WithScheme(operatorclient.RuntimeScheme).WithStatusSubresource(&operatorv1alpha1.Garden{}).Build()
+		virtualClient = fakeclient.NewClientBuilder().WithScheme(operatorclient.VirtualScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		garden = &operatorv1alpha1.Garden{
+			ObjectMeta: metav1.ObjectMeta{Name: "garden"},
+			Spec: operatorv1alpha1.GardenSpec{
+				RuntimeCluster: operatorv1alpha1.RuntimeCluster{
+					Seed: &operatorv1alpha1.RuntimeSeed{
+						Config: runtime.RawExtension{Raw: []byte(`{"apiVersion":"gardenlet.config.gardener.cloud/v1alpha1","kind":"GardenletConfiguration","seedConfig":{"spec":{"provider":{"type":"foo","region":"bar"},"networks":{"pods":"100.96.0.0/11","services":"100.64.0.0/13"}}}}`)},
+					},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: kubernetesutils.Key(garden.Name)}
+
+		renderer := chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v1.27.0"})
+		reconciler = &Reconciler{
+			RuntimeClientSet: fakekubernetes.NewClientSetBuilder().WithClient(runtimeClient).WithChartRenderer(renderer).Build(),
+			Config: config.OperatorConfiguration{
+				Controllers: config.ControllerConfiguration{
+					GardenGardenlet: config.GardenGardenletControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: syncPeriod}},
+				},
+			},
+			Clock: fakeClock,
+			GardenClientMap: fakeclientmap.NewClientMapBuilder().WithClientSetForKey(keys.ForGarden(garden),
+				fakekubernetes.NewClientSetBuilder().WithClient(virtualClient).WithRESTConfig(&rest.Config{Host: "https://virtual-garden-kube-apiserver", TLSClientConfig: rest.TLSClientConfig{CAData: []byte("ca")}}).Build(),
+			).Build(),
+			GardenNamespace: gardenNamespace,
+			Identity:        &gardencorev1beta1.Gardener{Version: "1.2.3"},
+			ValuesHelper:    managedseed.NewValuesHelper(nil),
+		}
+	})
+
+	It("should do nothing if the garden is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should requeue if the garden was not yet reconciled successfully", func() {
+		Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+		Expect(garden.Finalizers).To(BeEmpty())
+		Expect(garden.Status.Conditions).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{"Type": Equal(operatorv1alpha1.SeedReady), "Status": Equal(gardencorev1beta1.ConditionFalse), "Reason": Equal("GardenNotReconciled")}),
+		))
+		Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "gardenlet"}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+	})
+
+	Context("when garden was reconciled successfully", func() {
+		BeforeEach(func() {
+			garden.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: gardencorev1beta1.LastOperationStateSucceeded}
+			Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+		})
+
+		It("should deploy gardenlet with a bootstrap kubeconfig", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			Expect(garden.Finalizers).To(ConsistOf("gardener.cloud/operator-gardenlet"))
+			Expect(garden.Status.Conditions).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{"Type": Equal(operatorv1alpha1.SeedReady), "Status": Equal(gardencorev1beta1.ConditionFalse), "Reason": Equal("SeedNotRegistered")}),
+			))
+
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "gardenlet"}, managedResource)).To(Succeed())
+			Expect(managedResource.Spec.Class).To(PointTo(Equal("seed")))
+			Expect(managedResource.Spec.SecretRefs).To(HaveLen(1))
+			Expect(managedResource.Annotations).To(HaveKeyWithValue("operator.gardener.cloud/gardenlet-kubeconfig-secret", gardenNamespace+"/gardenlet-kubeconfig"))
+
+			secret := &corev1.Secret{}
+			Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKey("gardenlet_templates_secret-kubeconfig-garden-bootstrap.yaml"))
+			Expect(string(secret.Data["gardenlet_templates_configmap-componentconfig.yaml"])).To(MatchRegexp(`seedConfig:\n\s+metadata:\n(\s+creationTimestamp: null\n)?\s+name: garden\n`))
+
+			bootstrapTokenSecretList := &corev1.SecretList{}
+			Expect(virtualClient.List(ctx, bootstrapTokenSecretList, client.InNamespace("kube-system"))).To(Succeed())
+			Expect(bootstrapTokenSecretList.Items).To(HaveLen(1))
+			Expect(string(bootstrapTokenSecretList.Items[0].Data["description"])).To(Equal("A bootstrap token for the Gardenlet for garden garden."))
+		})
+
+		It("should not create a bootstrap kubeconfig if gardenlet is already bootstrapped", func() {
+			Expect(runtimeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "gardenlet-kubeconfig", Namespace: gardenNamespace}})).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "gardenlet"}, managedResource)).To(Succeed())
+			secret := &corev1.Secret{}
+			Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			Expect(secret.Data).NotTo(HaveKey("gardenlet_templates_secret-kubeconfig-garden-bootstrap.yaml"))
+
+			bootstrapTokenSecretList := &corev1.SecretList{}
+			Expect(virtualClient.List(ctx, bootstrapTokenSecretList, client.InNamespace("kube-system"))).To(Succeed())
+			Expect(bootstrapTokenSecretList.Items).To(BeEmpty())
+		})
+
+		It("should report the seed as ready", func() {
+			Expect(virtualClient.Create(ctx, &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: garden.Name},
+				Status: gardencorev1beta1.SeedStatus{
+					Gardener: &gardencorev1beta1.Gardener{Version: "1.2.3"},
+					Conditions: []gardencorev1beta1.Condition{
+						{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue},
+						{Type: gardencorev1beta1.SeedSystemComponentsHealthy, Status: gardencorev1beta1.ConditionTrue},
+					},
+				},
+			})).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			Expect(garden.Status.Conditions).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{"Type": Equal(operatorv1alpha1.SeedReady), "Status": Equal(gardencorev1beta1.ConditionTrue), "Reason": Equal("SeedReady")}),
+			))
+		})
+
+		It("should report the seed as unhealthy", func() {
+			Expect(virtualClient.Create(ctx, &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: garden.Name},
+				Status: gardencorev1beta1.SeedStatus{
+					Gardener: &gardencorev1beta1.Gardener{Version: "1.2.3"},
+					Conditions: []gardencorev1beta1.Condition{
+						{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionFalse},
+					},
+				},
+			})).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			Expect(garden.Status.Conditions).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{"Type": Equal(operatorv1alpha1.SeedReady), "Status": Equal(gardencorev1beta1.ConditionFalse), "Reason": Equal("SeedUnhealthy")}),
+			))
+		})
+
+		Context("when the seed is no longer configured", func() {
+			BeforeEach(func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+				Expect(runtimeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "gardenlet-kubeconfig", Namespace: gardenNamespace}})).To(Succeed())
+
+				Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+				garden.Spec.RuntimeCluster.Seed = nil
+				Expect(runtimeClient.Update(ctx, garden)).To(Succeed())
+			})
+
+			It("should wait for the seed to be deleted", func() {
+				Expect(virtualClient.Create(ctx, &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: garden.Name, Finalizers: []string{"gardener"}}})).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: RequeueDurationWhenResourceDeletionStillPresent}))
+
+				Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+				Expect(garden.Finalizers).To(ConsistOf("gardener.cloud/operator-gardenlet"))
+				Expect(garden.Status.Conditions).To(ConsistOf(
+					MatchFields(IgnoreExtras, Fields{"Type": Equal(operatorv1alpha1.SeedReady), "Status": Equal(gardencorev1beta1.ConditionFalse), "Reason": Equal("SeedDeletionPending")}),
+				))
+				Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "gardenlet"}, &resourcesv1alpha1.ManagedResource{})).To(Succeed())
+			})
+
+			It("should delete gardenlet and remove the finalizer", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+				Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+				Expect(garden.Finalizers).To(BeEmpty())
+				Expect(garden.Status.Conditions).To(BeEmpty())
+				Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "gardenlet"}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+				Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "gardenlet-kubeconfig"}, &corev1.Secret{})).To(BeNotFoundError())
+			})
+		})
+
+		It("should delete the configured kubeconfig secret of gardenlet", func() {
+			garden.Spec.RuntimeCluster.Seed.Config = runtime.RawExtension{Raw: []byte(`{"apiVersion":"gardenlet.config.gardener.cloud/v1alpha1","kind":"GardenletConfiguration","gardenClientConnection":{"kubeconfigSecret":{"namespace":"` + gardenNamespace + `","name":"custom-kubeconfig"}},"seedConfig":{"spec":{"provider":{"type":"foo","region":"bar"},"networks":{"pods":"100.96.0.0/11","services":"100.64.0.0/13"}}}}`)}
+			Expect(runtimeClient.Update(ctx, garden)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "gardenlet"}, managedResource)).To(Succeed())
+			Expect(managedResource.Annotations).To(HaveKeyWithValue("operator.gardener.cloud/gardenlet-kubeconfig-secret", gardenNamespace+"/custom-kubeconfig"))
+
+			Expect(runtimeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "custom-kubeconfig", Namespace: gardenNamespace}})).To(Succeed())
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			garden.Spec.RuntimeCluster.Seed = nil
+			Expect(runtimeClient.Update(ctx, garden)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			Expect(garden.Finalizers).To(BeEmpty())
+			Expect(runtimeClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "custom-kubeconfig"}, &corev1.Secret{})).To(BeNotFoundError())
+		})
+	})
+})
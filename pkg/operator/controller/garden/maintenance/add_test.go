@@ -0,0 +1,93 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	. "github.com/gardener/gardener/pkg/operator/controller/garden/maintenance"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		garden     *operatorv1alpha1.Garden
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		garden = &operatorv1alpha1.Garden{ObjectMeta: metav1.ObjectMeta{Name: "garden", Generation: 1}}
+	})
+
+	Describe("#GardenPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.GardenPredicate()
+		})
+
+		Describe("#Create", func() {
+			It("should return true", func() {
+				Expect(p.Create(event.CreateEvent{})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because new object is no garden", func() {
+				Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+			})
+
+			It("should return false because old object is no garden", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectNew: garden})).To(BeFalse())
+			})
+
+			It("should return false because the maintenance configuration did not change", func() {
+				oldGarden := garden.DeepCopy()
+				garden.Generation++
+				garden.Spec.VirtualCluster.Kubernetes.Version = "1.27.5"
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldGarden, ObjectNew: garden})).To(BeFalse())
+			})
+
+			It("should return true because the maintenance time window changed", func() {
+				oldGarden := garden.DeepCopy()
+				garden.Spec.VirtualCluster.Maintenance.TimeWindow.Begin = "220000+0100"
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldGarden, ObjectNew: garden})).To(BeTrue())
+			})
+
+			It("should return true because the auto update configuration changed", func() {
+				oldGarden := garden.DeepCopy()
+				garden.Spec.VirtualCluster.Maintenance.AutoUpdate = &operatorv1alpha1.MaintenanceAutoUpdate{KubernetesVersion: true}
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldGarden, ObjectNew: garden})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{})).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,346 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+)
+
+const (
+	// EventKubernetesVersionMaintenance is an event reason for a Kubernetes version maintenance of the virtual garden.
+	EventKubernetesVersionMaintenance = "KubernetesVersionMaintenance"
+	// EventCredentialsRotationMaintenance is an event reason for the completion of credentials rotations during the
+	// maintenance of the virtual garden.
+	EventCredentialsRotationMaintenance = "CredentialsRotationMaintenance"
+	// EventMaintenanceFailed is an event reason for a failed maintenance of the Garden.
+	EventMaintenanceFailed = "MaintenanceFailed"
+
+	reasonKubernetesVersionAutoUpdate = "Automatic update of Kubernetes version configured"
+)
+
+// Reconciler reconciles Gardens and maintains them by updating the Kubernetes version of the virtual garden cluster
+// or by completing credentials rotations.
+type Reconciler struct {
+	RuntimeClient client.Client
+	Clock         clock.Clock
+	Recorder      record.EventRecorder
+}
+
+// Reconcile reconciles Gardens and maintains them by updating the Kubernetes version of the virtual garden cluster or
+// by completing credentials rotations.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	garden := &operatorv1alpha1.Garden{}
+	if err := r.RuntimeClient.Get(ctx, request.NamespacedName, garden); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if garden.DeletionTimestamp != nil {
+		log.V(1).Info("Skipping Garden because it is marked for deletion")
+		return reconcile.Result{}, nil
+	}
+
+	var (
+		now             = r.Clock.Now()
+		window          = effectiveMaintenanceTimeWindow(garden)
+		requeueAfter    = window.RandomDurationUntilNext(now, false)
+		nextMaintenance = now.UTC().Add(requeueAfter)
+	)
+
+	if !mustMaintainNow(garden, window, now) {
+		log.V(1).Info("Skipping Garden because it doesn't need to be maintained now")
+		log.V(1).Info("Scheduled next maintenance for Garden", "duration", requeueAfter.Round(time.Minute), "nextMaintenance", nextMaintenance.Round(time.Minute))
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	if err := r.reconcile(ctx, log, garden, window, now); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.V(1).Info("Scheduled next maintenance for Garden", "duration", requeueAfter.Round(time.Minute), "nextMaintenance", nextMaintenance.Round(time.Minute))
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// updateResult represents the result of a Kubernetes version maintenance operation.
+type updateResult struct {
+	description  string
+	reason       string
+	isSuccessful bool
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, garden *operatorv1alpha1.Garden, window *timewindow.MaintenanceTimeWindow, now time.Time) error {
+	log.Info("Maintaining Garden")
+
+	var (
+		maintainedGarden = garden.DeepCopy()
+		autoUpdate       = garden.Spec.VirtualCluster.Maintenance.AutoUpdate
+		operations       []string
+	)
+
+	kubernetesVersionUpdate, err := maintainKubernetesVersion(log, maintainedGarden, autoUpdate.KubernetesVersions)
+	if err != nil {
+		// continue execution to allow the completion of credentials rotations
+		log.Error(err, "Failed to maintain Kubernetes version")
+	}
+
+	if autoUpdate.CredentialsRotationCompletion {
+		if operation := maintainCredentialsRotation(maintainedGarden, window, now); operation != "" {
+			metav1.SetMetaDataAnnotation(&maintainedGarden.ObjectMeta, v1beta1constants.GardenerOperation, operation)
+			operations = append(operations, fmt.Sprintf("Added %q operation annotation", operation))
+		}
+	}
+
+	patch := client.MergeFrom(garden.DeepCopy())
+
+	description, failureReason := buildMaintenanceMessages(kubernetesVersionUpdate, operations)
+	garden.Status.LastMaintenance = &gardencorev1beta1.LastMaintenance{
+		Description:   description,
+		TriggeredTime: metav1.Time{Time: now},
+		State:         gardencorev1beta1.LastOperationStateProcessing,
+	}
+
+	// if any maintenance operation failed, set the status to 'Failed' and retry in the next maintenance cycle
+	if failureReason != "" {
+		garden.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateFailed
+		garden.Status.LastMaintenance.FailureReason = &failureReason
+	}
+
+	// First dry run the update call to check if it can be executed successfully (maintenance might yield a Garden
+	// configuration that is rejected by the validation). If the dry run fails, the maintenance is marked as failed and
+	// is retried only in the next maintenance window.
+	if err := r.RuntimeClient.Update(ctx, maintainedGarden.DeepCopy(), &client.UpdateOptions{
+		DryRun: []string{metav1.DryRunAll},
+	}); err != nil {
+		garden.Status.LastMaintenance.Description = "Maintenance failed"
+		garden.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateFailed
+		garden.Status.LastMaintenance.FailureReason = pointer.String(fmt.Sprintf("Updates to the Garden failed to be applied: %s", err.Error()))
+		if err := r.RuntimeClient.Status().Patch(ctx, garden, patch); err != nil {
+			return err
+		}
+
+		r.Recorder.Event(garden, corev1.EventTypeWarning, EventMaintenanceFailed, err.Error())
+		log.Info("Garden maintenance failed", "reason", err)
+		return nil
+	}
+
+	if err := r.RuntimeClient.Status().Patch(ctx, garden, patch); err != nil {
+		return err
+	}
+
+	// apply the maintained specification and annotations
+	garden.Annotations = maintainedGarden.Annotations
+	garden.Spec = *maintainedGarden.Spec.DeepCopy()
+
+	// try to maintain the garden, but don't retry on conflict, because a conflict means that we potentially operated on
+	// stale data (e.g. when calculating the updated Kubernetes version), so rather return error and backoff
+	if err := r.RuntimeClient.Update(ctx, garden); err != nil {
+		r.Recorder.Event(garden, corev1.EventTypeWarning, EventMaintenanceFailed, err.Error())
+		return err
+	}
+
+	if garden.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStateProcessing {
+		patch := client.MergeFrom(garden.DeepCopy())
+		garden.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
+
+		if err := r.RuntimeClient.Status().Patch(ctx, garden, patch); err != nil {
+			return err
+		}
+	}
+
+	// make sure to report (partial) maintenance failures
+	if kubernetesVersionUpdate != nil {
+		if kubernetesVersionUpdate.isSuccessful {
+			r.Recorder.Eventf(garden, corev1.EventTypeNormal, EventKubernetesVersionMaintenance, "%s. Reason: %s.", kubernetesVersionUpdate.description, kubernetesVersionUpdate.reason)
+		} else {
+			r.Recorder.Eventf(garden, corev1.EventTypeWarning, EventKubernetesVersionMaintenance, "Kubernetes version maintenance failed. Reason for update: %s. Error: %v", kubernetesVersionUpdate.reason, kubernetesVersionUpdate.description)
+		}
+	}
+
+	for _, operation := range operations {
+		r.Recorder.Event(garden, corev1.EventTypeNormal, EventCredentialsRotationMaintenance, operation)
+	}
+
+	log.Info("Garden maintenance completed")
+	return nil
+}
+
+// buildMaintenanceMessages builds a combined message containing the performed maintenance operations. If the
+// Kubernetes version maintenance failed, the description contains an indication for the failure and the reason the
+// update was triggered. Details for the failure are returned in the second return string.
+func buildMaintenanceMessages(kubernetesVersionUpdate *updateResult, operations []string) (string, string) {
+	var (
+		descriptions  []string
+		failureReason string
+	)
+
+	if kubernetesVersionUpdate != nil {
+		if kubernetesVersionUpdate.isSuccessful {
+			descriptions = append(descriptions, fmt.Sprintf("%s. Reason: %s", kubernetesVersionUpdate.description, kubernetesVersionUpdate.reason))
+		} else {
+			descriptions = append(descriptions, fmt.Sprintf("Kubernetes version update failed. Reason for update: %s", kubernetesVersionUpdate.reason))
+			failureReason = fmt.Sprintf("Kubernetes maintenance failure due to: %s", kubernetesVersionUpdate.description)
+		}
+	}
+
+	descriptions = append(descriptions, operations...)
+
+	if len(descriptions) == 0 {
+		return "No maintenance operations were necessary", ""
+	}
+
+	if failureReason != "" {
+		return fmt.Sprintf("Maintenance operations partially failed. %s", strings.Join(descriptions, ", ")), failureReason
+	}
+
+	return fmt.Sprintf("All maintenance operations successful. %s", strings.Join(descriptions, ", ")), ""
+}
+
+func maintainKubernetesVersion(log logr.Logger, garden *operatorv1alpha1.Garden, kubernetesVersions []string) (*updateResult, error) {
+	if !garden.Spec.VirtualCluster.Maintenance.AutoUpdate.KubernetesVersion {
+		return nil, nil
+	}
+
+	kubernetesVersion := garden.Spec.VirtualCluster.Kubernetes.Version
+
+	versions := make([]gardencorev1beta1.ExpirableVersion, 0, len(kubernetesVersions))
+	for _, version := range kubernetesVersions {
+		versions = append(versions, gardencorev1beta1.ExpirableVersion{Version: version})
+	}
+
+	found, updatedKubernetesVersion, err := v1beta1helper.GetLatestVersionForPatchAutoUpdate(versions, kubernetesVersion)
+	if err != nil {
+		return &updateResult{
+			description:  fmt.Sprintf("could not determine higher suitable version than %q: %v", kubernetesVersion, err),
+			reason:       reasonKubernetesVersionAutoUpdate,
+			isSuccessful: false,
+		}, err
+	}
+	// current version is already the latest
+	if !found {
+		return nil, nil
+	}
+
+	garden.Spec.VirtualCluster.Kubernetes.Version = updatedKubernetesVersion
+
+	log.Info("Kubernetes version will be updated", "version", kubernetesVersion, "newVersion", updatedKubernetesVersion, "reason", reasonKubernetesVersionAutoUpdate)
+	return &updateResult{
+		description:  fmt.Sprintf("Updated Kubernetes version from %q to %q", kubernetesVersion, updatedKubernetesVersion),
+		reason:       reasonKubernetesVersionAutoUpdate,
+		isSuccessful: true,
+	}, nil
+}
+
+// maintainCredentialsRotation returns the operation which completes the credentials rotations that are in phase
+// 'Prepared' and whose preparation has finished before the current maintenance time window. If all rotations are
+// prepared, they are completed together. Otherwise, the rotations are completed one after another in the order
+// certificate authorities, service account key, ETCD encryption key. An empty string is returned if no rotation must be
+// completed or if the Garden already has an operation annotation.
+func maintainCredentialsRotation(garden *operatorv1alpha1.Garden, window *timewindow.MaintenanceTimeWindow, now time.Time) string {
+	if _, ok := garden.Annotations[v1beta1constants.GardenerOperation]; ok {
+		return ""
+	}
+
+	if garden.Status.Credentials == nil || garden.Status.Credentials.Rotation == nil {
+		return ""
+	}
+
+	var (
+		rotation                                            = garden.Status.Credentials.Rotation
+		completeCA, completeServiceAccountKey, completeETCD bool
+	)
+
+	if rotation.CertificateAuthorities != nil {
+		completeCA = mustCompleteRotation(rotation.CertificateAuthorities.Phase, rotation.CertificateAuthorities.LastInitiationFinishedTime, window, now)
+	}
+	if rotation.ServiceAccountKey != nil {
+		completeServiceAccountKey = mustCompleteRotation(rotation.ServiceAccountKey.Phase, rotation.ServiceAccountKey.LastInitiationFinishedTime, window, now)
+	}
+	if rotation.ETCDEncryptionKey != nil {
+		completeETCD = mustCompleteRotation(rotation.ETCDEncryptionKey.Phase, rotation.ETCDEncryptionKey.LastInitiationFinishedTime, window, now)
+	}
+
+	switch {
+	case completeCA && completeServiceAccountKey && completeETCD:
+		return v1beta1constants.OperationRotateCredentialsComplete
+	case completeCA:
+		return v1beta1constants.OperationRotateCAComplete
+	case completeServiceAccountKey:
+		return v1beta1constants.OperationRotateServiceAccountKeyComplete
+	case completeETCD:
+		return v1beta1constants.OperationRotateETCDEncryptionKeyComplete
+	}
+
+	return ""
+}
+
+func mustCompleteRotation(phase gardencorev1beta1.CredentialsRotationPhase, lastInitiationFinishedTime *metav1.Time, window *timewindow.MaintenanceTimeWindow, now time.Time) bool {
+	return phase == gardencorev1beta1.RotationPrepared &&
+		lastInitiationFinishedTime != nil &&
+		!happenedInCurrentWindow(lastInitiationFinishedTime.Time, window, now)
+}
+
+func mustMaintainNow(garden *operatorv1alpha1.Garden, window *timewindow.MaintenanceTimeWindow, now time.Time) bool {
+	if garden.Spec.VirtualCluster.Maintenance.AutoUpdate == nil || !window.Contains(now) {
+		return false
+	}
+
+	// Maintain the Garden only once per maintenance time window.
+	lastMaintenance := garden.Status.LastMaintenance
+	return lastMaintenance == nil || !happenedInCurrentWindow(lastMaintenance.TriggeredTime.Time, window, now)
+}
+
+// happenedInCurrentWindow returns whether the given time lies within the maintenance time window which contains now.
+func happenedInCurrentWindow(t time.Time, window *timewindow.MaintenanceTimeWindow, now time.Time) bool {
+	return !t.After(now) && now.Sub(t) < window.Duration() && window.Contains(t)
+}
+
+func effectiveMaintenanceTimeWindow(garden *operatorv1alpha1.Garden) *timewindow.MaintenanceTimeWindow {
+	timeWindow, err := timewindow.ParseMaintenanceTimeWindow(garden.Spec.VirtualCluster.Maintenance.TimeWindow.Begin, garden.Spec.VirtualCluster.Maintenance.TimeWindow.End)
+	if err != nil {
+		return timewindow.AlwaysTimeWindow
+	}
+
+	return gardenerutils.EffectiveMaintenanceTimeWindow(timeWindow)
+}
@@ -0,0 +1,265 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	operatorclient "github.com/gardener/gardener/pkg/operator/client"
+	. "github.com/gardener/gardener/pkg/operator/controller/garden/maintenance"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		runtimeClient client.Client
+		fakeClock     *testclock.FakeClock
+		reconciler    *Reconciler
+
+		now           = time.Date(2023, 10, 18, 10, 30, 0, 0, time.UTC)
+		previousDay   = metav1.NewTime(now.Add(-24 * time.Hour))
+		currentWindow = metav1.NewTime(now.Add(-15 * time.Minute))
+
+		garden  *operatorv1alpha1.Garden
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		runtimeClient = fakeclient.NewClientBuilder().WithScheme(operatorclient.RuntimeScheme).WithStatusSubresource(&operatorv1alpha1.Garden{}).Build()
+		fakeClock = testclock.NewFakeClock(now)
+
+		garden = &operatorv1alpha1.Garden{
+			ObjectMeta: metav1.ObjectMeta{Name: "garden"},
+			Spec: operatorv1alpha1.GardenSpec{
+				VirtualCluster: operatorv1alpha1.VirtualCluster{
+					Kubernetes: operatorv1alpha1.Kubernetes{
+						Version: "1.27.2",
+					},
+					Maintenance: operatorv1alpha1.Maintenance{
+						TimeWindow: gardencorev1beta1.MaintenanceTimeWindow{
+							Begin: "100000+0000",
+							End:   "110000+0000",
+						},
+						AutoUpdate: &operatorv1alpha1.MaintenanceAutoUpdate{
+							KubernetesVersion:  true,
+							KubernetesVersions: []string{"1.26.9", "1.27.3", "1.27.5", "1.28.1"},
+						},
+					},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: kubernetesutils.Key(garden.Name)}
+
+		reconciler = &Reconciler{
+			RuntimeClient: runtimeClient,
+			Clock:         fakeClock,
+			Recorder:      record.NewFakeRecorder(10),
+		}
+	})
+
+	expectRequeueForNextMaintenance := func(result reconcile.Result, err error) {
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, result.RequeueAfter).To(BeNumerically(">", 0))
+		ExpectWithOffset(1, result.RequeueAfter).To(BeNumerically("<", 25*time.Hour))
+	}
+
+	It("should do nothing if the garden is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the garden is being deleted", func() {
+		garden.Finalizers = []string{operatorv1alpha1.FinalizerName}
+		Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+		Expect(runtimeClient.Delete(ctx, garden)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+		Expect(garden.Spec.VirtualCluster.Kubernetes.Version).To(Equal("1.27.2"))
+	})
+
+	It("should not maintain the garden if auto updates are not configured", func() {
+		garden.Spec.VirtualCluster.Maintenance.AutoUpdate = nil
+		Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+
+		expectRequeueForNextMaintenance(reconciler.Reconcile(ctx, request))
+
+		Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+		Expect(garden.Spec.VirtualCluster.Kubernetes.Version).To(Equal("1.27.2"))
+		Expect(garden.Status.LastMaintenance).To(BeNil())
+	})
+
+	It("should not maintain the garden outside of the maintenance time window", func() {
+		garden.Spec.VirtualCluster.Maintenance.TimeWindow = gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"}
+		Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">=", 11*time.Hour+30*time.Minute))
+		Expect(result.RequeueAfter).To(BeNumerically("<", 12*time.Hour+30*time.Minute))
+
+		Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+		Expect(garden.Spec.VirtualCluster.Kubernetes.Version).To(Equal("1.27.2"))
+		Expect(garden.Status.LastMaintenance).To(BeNil())
+	})
+
+	It("should not maintain the garden twice in the same maintenance time window", func() {
+		garden.Status.LastMaintenance = &gardencorev1beta1.LastMaintenance{TriggeredTime: currentWindow, State: gardencorev1beta1.LastOperationStateSucceeded}
+		Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+
+		expectRequeueForNextMaintenance(reconciler.Reconcile(ctx, request))
+
+		Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+		Expect(garden.Spec.VirtualCluster.Kubernetes.Version).To(Equal("1.27.2"))
+		Expect(garden.Status.LastMaintenance.TriggeredTime.Time).To(BeTemporally("==", currentWindow.Time))
+	})
+
+	Context("Kubernetes version", func() {
+		It("should update to the latest allowed patch version of the same minor version", func() {
+			garden.Status.LastMaintenance = &gardencorev1beta1.LastMaintenance{TriggeredTime: previousDay, State: gardencorev1beta1.LastOperationStateSucceeded}
+			Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+
+			expectRequeueForNextMaintenance(reconciler.Reconcile(ctx, request))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			Expect(garden.Spec.VirtualCluster.Kubernetes.Version).To(Equal("1.27.5"))
+			Expect(garden.Status.LastMaintenance).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Description":   ContainSubstring(`Updated Kubernetes version from "1.27.2" to "1.27.5"`),
+				"TriggeredTime": HaveField("Time", BeTemporally("==", now)),
+				"State":         Equal(gardencorev1beta1.LastOperationStateSucceeded),
+				"FailureReason": BeNil(),
+			})))
+		})
+
+		It("should not update the version if it is already the latest allowed patch version", func() {
+			garden.Spec.VirtualCluster.Kubernetes.Version = "1.27.5"
+			Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+
+			expectRequeueForNextMaintenance(reconciler.Reconcile(ctx, request))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			Expect(garden.Spec.VirtualCluster.Kubernetes.Version).To(Equal("1.27.5"))
+			Expect(garden.Status.LastMaintenance).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Description": Equal("No maintenance operations were necessary"),
+				"State":       Equal(gardencorev1beta1.LastOperationStateSucceeded),
+			})))
+		})
+
+		It("should not update the version if automatic updates are disabled", func() {
+			garden.Spec.VirtualCluster.Maintenance.AutoUpdate.KubernetesVersion = false
+			Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+
+			expectRequeueForNextMaintenance(reconciler.Reconcile(ctx, request))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			Expect(garden.Spec.VirtualCluster.Kubernetes.Version).To(Equal("1.27.2"))
+		})
+
+		It("should mark the maintenance as failed if an allowed version cannot be parsed", func() {
+			garden.Spec.VirtualCluster.Maintenance.AutoUpdate.KubernetesVersions = []string{"foo"}
+			Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+
+			expectRequeueForNextMaintenance(reconciler.Reconcile(ctx, request))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			Expect(garden.Spec.VirtualCluster.Kubernetes.Version).To(Equal("1.27.2"))
+			Expect(garden.Status.LastMaintenance).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"State":         Equal(gardencorev1beta1.LastOperationStateFailed),
+				"FailureReason": PointTo(ContainSubstring("could not determine higher suitable version")),
+			})))
+		})
+	})
+
+	Context("credentials rotation", func() {
+		BeforeEach(func() {
+			garden.Spec.VirtualCluster.Maintenance.AutoUpdate.KubernetesVersion = false
+			garden.Spec.VirtualCluster.Maintenance.AutoUpdate.CredentialsRotationCompletion = true
+			garden.Status.Credentials = &operatorv1alpha1.Credentials{
+				Rotation: &operatorv1alpha1.CredentialsRotation{
+					CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPrepared, LastInitiationFinishedTime: &previousDay},
+					ServiceAccountKey:      &gardencorev1beta1.ServiceAccountKeyRotation{Phase: gardencorev1beta1.RotationPrepared, LastInitiationFinishedTime: &previousDay},
+					ETCDEncryptionKey:      &gardencorev1beta1.ETCDEncryptionKeyRotation{Phase: gardencorev1beta1.RotationPrepared, LastInitiationFinishedTime: &previousDay},
+				},
+			}
+		})
+
+		It("should complete all rotations if all of them were prepared before the current window", func() {
+			Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+
+			expectRequeueForNextMaintenance(reconciler.Reconcile(ctx, request))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			Expect(garden.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.OperationRotateCredentialsComplete))
+			Expect(garden.Status.LastMaintenance.Description).To(ContainSubstring(`Added "rotate-credentials-complete" operation annotation`))
+		})
+
+		It("should only complete the rotations which were prepared before the current window", func() {
+			garden.Status.Credentials.Rotation.CertificateAuthorities.LastInitiationFinishedTime = &currentWindow
+			Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+
+			expectRequeueForNextMaintenance(reconciler.Reconcile(ctx, request))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			Expect(garden.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.OperationRotateServiceAccountKeyComplete))
+		})
+
+		It("should not complete rotations which are not prepared", func() {
+			garden.Status.Credentials.Rotation.CertificateAuthorities.Phase = gardencorev1beta1.RotationCompleted
+			garden.Status.Credentials.Rotation.ServiceAccountKey.Phase = gardencorev1beta1.RotationPreparing
+			Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+
+			expectRequeueForNextMaintenance(reconciler.Reconcile(ctx, request))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			Expect(garden.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.OperationRotateETCDEncryptionKeyComplete))
+		})
+
+		It("should not overwrite an existing operation annotation", func() {
+			metav1.SetMetaDataAnnotation(&garden.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+			Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+
+			expectRequeueForNextMaintenance(reconciler.Reconcile(ctx, request))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			Expect(garden.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+		})
+
+		It("should not complete rotations if the completion is disabled", func() {
+			garden.Spec.VirtualCluster.Maintenance.AutoUpdate.CredentialsRotationCompletion = false
+			Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+
+			expectRequeueForNextMaintenance(reconciler.Reconcile(ctx, request))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+			Expect(garden.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+		})
+	})
+})
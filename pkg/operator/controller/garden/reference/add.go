@@ -52,6 +52,7 @@ func Predicate(oldObj, newObj client.Object) bool {
 	return kubeAPIServerAuditPolicyConfigMapChanged(oldGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer, newGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer) ||
 		gardenerAPIServerAuditPolicyConfigMapChanged(oldGarden.Spec.VirtualCluster.Gardener.APIServer, newGarden.Spec.VirtualCluster.Gardener.APIServer) ||
 		etcdBackupSecretChanged(oldGarden.Spec.VirtualCluster.ETCD, newGarden.Spec.VirtualCluster.ETCD) ||
+		etcdBackupMigrationSourceSecretChanged(oldGarden.Status.ETCDBackup, newGarden.Status.ETCDBackup) ||
 		authenticationWebhookSecretChanged(oldGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer, newGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer) ||
 		sniSecretChanged(oldGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer, newGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer) ||
 		kubeAPIServerAuditWebhookSecretChanged(oldGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer, newGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer) ||
@@ -100,6 +101,20 @@ func etcdBackupSecretChanged(oldETCD, newETCD *operatorv1alpha1.ETCD) bool {
 	return oldSecret != newSecret
 }
 
+func etcdBackupMigrationSourceSecretChanged(oldETCDBackup, newETCDBackup *operatorv1alpha1.ETCDBackupStatus) bool {
+	var oldSecret, newSecret string
+
+	if oldETCDBackup != nil && oldETCDBackup.Migration != nil {
+		oldSecret = oldETCDBackup.Migration.Source.SecretRef.Name
+	}
+
+	if newETCDBackup != nil && newETCDBackup.Migration != nil {
+		newSecret = newETCDBackup.Migration.Source.SecretRef.Name
+	}
+
+	return oldSecret != newSecret
+}
+
 func authenticationWebhookSecretChanged(oldKubeAPIServer, newKubeAPIServer *operatorv1alpha1.KubeAPIServerConfig) bool {
 	var oldSecret, newSecret string
 
@@ -211,6 +226,11 @@ func getReferencedSecretNames(obj client.Object) []string {
 		out = append(out, virtualCluster.ETCD.Main.Backup.SecretRef.Name)
 	}
 
+	// The secret of the source object store must be retained until the migration of the ETCD backups is finished.
+	if garden.Status.ETCDBackup != nil && garden.Status.ETCDBackup.Migration != nil {
+		out = append(out, garden.Status.ETCDBackup.Migration.Source.SecretRef.Name)
+	}
+
 	if virtualCluster.Kubernetes.KubeAPIServer != nil {
 		for _, plugin := range virtualCluster.Kubernetes.KubeAPIServer.AdmissionPlugins {
 			if plugin.KubeconfigSecretName != nil {
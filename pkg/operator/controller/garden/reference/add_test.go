@@ -88,6 +88,12 @@ var _ = Describe("Add", func() {
 			Expect(Predicate(oldShoot, garden)).To(BeTrue())
 		})
 
+		It("should return true because the ETCD backup migration source secret field changed", func() {
+			oldShoot := garden.DeepCopy()
+			garden.Status.ETCDBackup = &operatorv1alpha1.ETCDBackupStatus{Migration: &operatorv1alpha1.ETCDBackupMigration{Source: operatorv1alpha1.Backup{SecretRef: corev1.LocalObjectReference{Name: "secret-name"}}}}
+			Expect(Predicate(oldShoot, garden)).To(BeTrue())
+		})
+
 		It("should return true because the SNI secret field changed", func() {
 			oldShoot := garden.DeepCopy()
 			garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.SNI = &operatorv1alpha1.SNI{SecretName: "secret-sni"}
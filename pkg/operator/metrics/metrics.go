@@ -0,0 +1,57 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Namespace is the metric namespace for the gardener-operator.
+const Namespace = "gardener_operator"
+
+var (
+	// Factory is used for registering metrics in the controller-runtime metrics registry.
+	Factory = promauto.With(runtimemetrics.Registry)
+
+	// GardenCondition defines the gauge garden_condition. The value is 1 for the current status of a health condition
+	// of a Garden and 0 for all other statuses.
+	GardenCondition = Factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "garden_condition",
+			Help:      "Status of the health conditions of a Garden (1 for the current status, 0 otherwise).",
+		},
+		[]string{
+			"name",
+			"condition",
+			"status",
+		},
+	)
+
+	// GardenHealthy defines the gauge garden_healthy. The value is 1 if all health conditions of a Garden are 'True'
+	// and 0 otherwise.
+	GardenHealthy = Factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "garden_healthy",
+			Help:      "Whether all health conditions of a Garden are 'True' (1) or not (0).",
+		},
+		[]string{
+			"name",
+		},
+	)
+)
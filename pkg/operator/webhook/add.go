@@ -44,6 +44,12 @@ func AddToManager(mgr manager.Manager) error {
 		return fmt.Errorf("failed adding %s webhook handler: %w", validation.HandlerName, err)
 	}
 
+	if err := (&validation.ExtensionHandler{
+		Logger: mgr.GetLogger().WithName("webhook").WithName(validation.ExtensionHandlerName),
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding %s webhook handler: %w", validation.ExtensionHandlerName, err)
+	}
+
 	return nil
 }
 
@@ -81,6 +87,26 @@ func GetValidatingWebhookConfiguration(mode, url string) *admissionregistrationv
 				MatchPolicy:    &matchPolicy,
 				TimeoutSeconds: pointer.Int32(10),
 			},
+			{
+				Name:                    "extension-validation.operator.gardener.cloud",
+				ClientConfig:            getClientConfig(validation.ExtensionWebhookPath, mode, url),
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{operatorv1alpha1.SchemeGroupVersion.Group},
+						APIVersions: []string{operatorv1alpha1.SchemeGroupVersion.Version},
+						Resources:   []string{"extensions"},
+					},
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+				}},
+				SideEffects:    &sideEffects,
+				FailurePolicy:  &failurePolicy,
+				MatchPolicy:    &matchPolicy,
+				TimeoutSeconds: pointer.Int32(10),
+			},
 		},
 	}
 }
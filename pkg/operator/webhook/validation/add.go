@@ -26,6 +26,11 @@ const (
 	HandlerName = "validator"
 	// WebhookPath is the HTTP handler path for this admission webhook handler.
 	WebhookPath = "/webhooks/validate-operator-gardener-cloud-v1alpha1-garden"
+
+	// ExtensionHandlerName is the name of the admission webhook handler for Extensions.
+	ExtensionHandlerName = "extension-validator"
+	// ExtensionWebhookPath is the HTTP handler path for the admission webhook handler for Extensions.
+	ExtensionWebhookPath = "/webhooks/validate-operator-gardener-cloud-v1alpha1-extension"
 )
 
 // AddToManager adds Handler to the given manager.
@@ -37,3 +42,13 @@ func (h *Handler) AddToManager(mgr manager.Manager) error {
 	mgr.GetWebhookServer().Register(WebhookPath, webhook)
 	return nil
 }
+
+// AddToManager adds ExtensionHandler to the given manager.
+func (h *ExtensionHandler) AddToManager(mgr manager.Manager) error {
+	webhook := admission.
+		WithCustomValidator(mgr.GetScheme(), &operatorv1alpha1.Extension{}, h).
+		WithRecoverPanic(true)
+
+	mgr.GetWebhookServer().Register(ExtensionWebhookPath, webhook)
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/operator/v1alpha1/validation"
+)
+
+// ExtensionHandler performs validation of Extensions.
+type ExtensionHandler struct {
+	Logger logr.Logger
+}
+
+// ValidateCreate performs the validation.
+func (h *ExtensionHandler) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	extension, ok := obj.(*operatorv1alpha1.Extension)
+	if !ok {
+		return nil, fmt.Errorf("expected *operatorv1alpha1.Extension but got %T", obj)
+	}
+
+	if errs := validation.ValidateExtension(extension); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(operatorv1alpha1.Kind("Extension"), extension.Name, errs)
+	}
+
+	return nil, nil
+}
+
+// ValidateUpdate performs the validation.
+func (h *ExtensionHandler) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldExtension, ok := oldObj.(*operatorv1alpha1.Extension)
+	if !ok {
+		return nil, fmt.Errorf("expected *operatorv1alpha1.Extension but got %T", oldObj)
+	}
+	newExtension, ok := newObj.(*operatorv1alpha1.Extension)
+	if !ok {
+		return nil, fmt.Errorf("expected *operatorv1alpha1.Extension but got %T", newObj)
+	}
+
+	if errs := validation.ValidateExtensionUpdate(oldExtension, newExtension); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(operatorv1alpha1.Kind("Extension"), newExtension.Name, errs)
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete performs the validation.
+func (h *ExtensionHandler) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	. "github.com/gardener/gardener/pkg/operator/webhook/validation"
+)
+
+var _ = Describe("ExtensionHandler", func() {
+	var (
+		ctx = context.TODO()
+
+		handler   *ExtensionHandler
+		extension *operatorv1alpha1.Extension
+	)
+
+	BeforeEach(func() {
+		handler = &ExtensionHandler{Logger: logr.Discard()}
+		extension = &operatorv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{Name: "extension-foo"},
+			Spec: operatorv1alpha1.ExtensionSpec{
+				Resources: []gardencorev1beta1.ControllerResource{{Kind: "Infrastructure", Type: "foo"}},
+			},
+		}
+	})
+
+	Describe("#ValidateCreate", func() {
+		It("should return success if there are no errors", func() {
+			warning, err := handler.ValidateCreate(ctx, extension)
+			Expect(warning).To(BeNil())
+			Expect(err).To(Succeed())
+		})
+
+		It("should return an error if there are validation errors", func() {
+			extension.Spec.Resources[0].Type = ""
+
+			warning, err := handler.ValidateCreate(ctx, extension)
+			Expect(warning).To(BeNil())
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.resources[0].type"))
+		})
+	})
+
+	Describe("#ValidateUpdate", func() {
+		It("should return success if there are no errors", func() {
+			warning, err := handler.ValidateUpdate(ctx, extension, extension.DeepCopy())
+			Expect(warning).To(BeNil())
+			Expect(err).To(Succeed())
+		})
+
+		It("should return an error if there are validation errors", func() {
+			newExtension := extension.DeepCopy()
+			newExtension.Spec.Resources = append(newExtension.Spec.Resources, newExtension.Spec.Resources[0])
+
+			warning, err := handler.ValidateUpdate(ctx, extension, newExtension)
+			Expect(warning).To(BeNil())
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.resources[1]"))
+		})
+	})
+
+	Describe("#ValidateDelete", func() {
+		It("should always allow the deletion", func() {
+			warning, err := handler.ValidateDelete(ctx, extension)
+			Expect(warning).To(BeNil())
+			Expect(err).To(Succeed())
+		})
+	})
+})
@@ -15,6 +15,9 @@
 package storage
 
 import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
@@ -32,19 +35,21 @@ type REST struct {
 // ControllerRegistrationStorage implements the storage for ControllerRegistrations and their status subresource.
 type ControllerRegistrationStorage struct {
 	ControllerRegistration *REST
+	Status                 *StatusREST
 }
 
 // NewStorage creates a new ControllerRegistrationStorage object.
 func NewStorage(optsGetter generic.RESTOptionsGetter) ControllerRegistrationStorage {
-	controllerRegistrationRest := NewREST(optsGetter)
+	controllerRegistrationRest, controllerRegistrationStatusRest := NewREST(optsGetter)
 
 	return ControllerRegistrationStorage{
 		ControllerRegistration: controllerRegistrationRest,
+		Status:                 controllerRegistrationStatusRest,
 	}
 }
 
 // NewREST returns a RESTStorage object that will work against controllerRegistrations.
-func NewREST(optsGetter generic.RESTOptionsGetter) *REST {
+func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
 	store := &genericregistry.Store{
 		NewFunc:                   func() runtime.Object { return &core.ControllerRegistration{} },
 		NewListFunc:               func() runtime.Object { return &core.ControllerRegistrationList{} },
@@ -63,7 +68,9 @@ func NewREST(optsGetter generic.RESTOptionsGetter) *REST {
 		panic(err)
 	}
 
-	return &REST{store}
+	statusStore := *store
+	statusStore.UpdateStrategy = controllerregistration.StatusStrategy
+	return &REST{store}, &StatusREST{store: &statusStore}
 }
 
 // Implement CategoriesProvider
@@ -74,6 +81,38 @@ func (r *REST) Categories() []string {
 	return []string{"all"}
 }
 
+// StatusREST implements the REST endpoint for changing the status of a ControllerRegistration.
+type StatusREST struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Storage = &StatusREST{}
+	_ rest.Getter  = &StatusREST{}
+	_ rest.Updater = &StatusREST{}
+)
+
+// New creates a new (empty) internal ControllerRegistration object.
+func (r *StatusREST) New() runtime.Object {
+	return &core.ControllerRegistration{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *StatusREST) Destroy() {
+	// Given that underlying store is shared with REST,
+	// we don't destroy it here explicitly.
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
+}
+
 // Implement ShortNamesProvider
 var _ rest.ShortNamesProvider = &REST{}
 
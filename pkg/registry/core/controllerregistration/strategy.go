@@ -43,11 +43,13 @@ func (controllerRegistrationStrategy) PrepareForCreate(_ context.Context, obj ru
 	controllerRegistration := obj.(*core.ControllerRegistration)
 
 	controllerRegistration.Generation = 1
+	controllerRegistration.Status = core.ControllerRegistrationStatus{}
 }
 
 func (controllerRegistrationStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
 	newControllerRegistration := obj.(*core.ControllerRegistration)
 	oldControllerRegistration := old.(*core.ControllerRegistration)
+	newControllerRegistration.Status = oldControllerRegistration.Status
 
 	if mustIncreaseGeneration(oldControllerRegistration, newControllerRegistration) {
 		newControllerRegistration.Generation = oldControllerRegistration.Generation + 1
@@ -99,3 +101,20 @@ func (controllerRegistrationStrategy) WarningsOnCreate(_ context.Context, _ runt
 func (controllerRegistrationStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
 	return nil
 }
+
+type controllerRegistrationStatusStrategy struct {
+	controllerRegistrationStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of ControllerRegistrations.
+var StatusStrategy = controllerRegistrationStatusStrategy{Strategy}
+
+func (controllerRegistrationStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newControllerRegistration := obj.(*core.ControllerRegistration)
+	oldControllerRegistration := old.(*core.ControllerRegistration)
+	newControllerRegistration.Spec = oldControllerRegistration.Spec
+}
+
+func (controllerRegistrationStatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateControllerRegistrationStatusUpdate(obj.(*core.ControllerRegistration).Status, old.(*core.ControllerRegistration).Status)
+}
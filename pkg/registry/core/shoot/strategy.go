@@ -60,10 +60,14 @@ func (shootStrategy) NamespaceScoped() bool {
 }
 
 func (shootStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
-	shoot := obj.(*core.Shoot)
+	newShoot := obj.(*core.Shoot)
 
-	shoot.Generation = 1
-	shoot.Status = core.ShootStatus{}
+	newShoot.Generation = 1
+	newShoot.Status = core.ShootStatus{}
+
+	// Some fields are not defaulted by the API defaulting but only after the mutating admission plugins have run, so
+	// that the ShootDefaults admission plugin can still default them.
+	shoot.SetDefaults(newShoot, nil)
 }
 
 func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
@@ -73,6 +77,8 @@ func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object
 	newShoot.Status = oldShoot.Status               // can only be changed by shoots/status subresource
 	newShoot.Spec.SeedName = oldShoot.Spec.SeedName // can only be changed by shoots/binding subresource
 
+	shoot.SetDefaults(newShoot, oldShoot)
+
 	if mustIncreaseGeneration(oldShoot, newShoot) {
 		newShoot.Generation = oldShoot.Generation + 1
 	}
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/utils/pointer"
 
+	apishoot "github.com/gardener/gardener/pkg/api/core/shoot"
 	"github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	. "github.com/gardener/gardener/pkg/registry/core/shoot"
@@ -66,7 +67,28 @@ var _ = Describe("Strategy", func() {
 		})
 	})
 
+	Describe("#PrepareForCreate", func() {
+		It("should default the fields which are not defaulted by the API defaulting", func() {
+			shoot := &core.Shoot{Spec: core.ShootSpec{Provider: core.Provider{Workers: []core.Worker{{}}}}}
+
+			strategy.PrepareForCreate(context.TODO(), shoot)
+
+			Expect(shoot.Spec.Maintenance.TimeWindow).NotTo(BeNil())
+			Expect(shoot.Spec.Kubernetes.Kubelet.FailSwapOn).To(Equal(pointer.Bool(true)))
+			Expect(shoot.Spec.Kubernetes.Kubelet.KubeReserved).NotTo(BeNil())
+		})
+	})
+
 	Describe("#PrepareForUpdate", func() {
+		It("should keep the maintenance time window if it is not specified", func() {
+			oldShoot := &core.Shoot{Spec: core.ShootSpec{Maintenance: &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}}}}
+			newShoot := &core.Shoot{}
+
+			strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+			Expect(newShoot.Spec.Maintenance.TimeWindow).To(Equal(oldShoot.Spec.Maintenance.TimeWindow))
+		})
+
 		Context("seedName change", func() {
 			var (
 				oldShoot *core.Shoot
@@ -97,7 +119,11 @@ var _ = Describe("Strategy", func() {
 			)
 
 			BeforeEach(func() {
-				oldShoot = &core.Shoot{}
+				oldShoot = &core.Shoot{
+					Spec: core.ShootSpec{
+						Maintenance: &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}},
+					},
+				}
 				newShoot = oldShoot.DeepCopy()
 			})
 
@@ -300,6 +326,8 @@ var _ = Describe("Strategy", func() {
 					if mutateOldShoot != nil {
 						mutateOldShoot(oldShoot)
 					}
+					// stored shoots are always defaulted
+					apishoot.SetDefaults(oldShoot, nil)
 
 					newShoot := oldShoot.DeepCopy()
 					newShoot.Annotations = map[string]string{v1beta1constants.GardenerOperation: operationAnnotation}
@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/storage"
 	"k8s.io/apiserver/pkg/storage/names"
@@ -75,11 +76,15 @@ func (s bastionStrategy) heartbeat(bastion *operations.Bastion) {
 	}
 }
 
-func (s bastionStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+func (s bastionStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	newBastion := obj.(*operations.Bastion)
 	oldBastion := old.(*operations.Bastion)
 	newBastion.Status = oldBastion.Status
 
+	if newBastion.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationApprove {
+		approve(ctx, newBastion)
+	}
+
 	if mustIncreaseGeneration(oldBastion, newBastion) {
 		newBastion.Generation = oldBastion.Generation + 1
 	}
@@ -89,6 +94,26 @@ func (s bastionStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Ob
 	}
 }
 
+// approve records the requesting user as approver of the given Bastion unless it has already been approved. The
+// admission plugin makes sure that only eligible users can approve Bastions.
+func approve(ctx context.Context, bastion *operations.Bastion) {
+	delete(bastion.Annotations, v1beta1constants.GardenerOperation)
+
+	if bastion.Status.Approval != nil {
+		return
+	}
+
+	userInfo, ok := request.UserFrom(ctx)
+	if !ok {
+		return
+	}
+
+	bastion.Status.Approval = &operations.BastionApproval{
+		ApprovedBy:        userInfo.GetName(),
+		ApprovalTimestamp: metav1.NewTime(time.Now()),
+	}
+}
+
 func mustIncreaseGeneration(oldBastion, newBastion *operations.Bastion) bool {
 	// The Bastion specification changes.
 	if !apiequality.Semantic.DeepEqual(oldBastion.Spec, newBastion.Spec) {
@@ -100,6 +125,11 @@ func mustIncreaseGeneration(oldBastion, newBastion *operations.Bastion) bool {
 		return true
 	}
 
+	// The Bastion was approved.
+	if oldBastion.Status.Approval == nil && newBastion.Status.Approval != nil {
+		return true
+	}
+
 	if kubernetesutils.HasMetaDataAnnotation(&newBastion.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile) {
 		return true
 	}
@@ -154,6 +184,8 @@ func (s bastionStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runt
 	newBastion := obj.(*operations.Bastion)
 	oldBastion := old.(*operations.Bastion)
 	newBastion.Spec = oldBastion.Spec
+	// the approval can only be given via the main resource
+	newBastion.Status.Approval = oldBastion.Status.Approval
 
 	// recalculate to prevent manipulation
 	expires := metav1.NewTime(newBastion.Status.LastHeartbeatTimestamp.Add(s.timeToLive))
@@ -23,6 +23,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -187,6 +189,52 @@ var _ = Describe("PrepareForUpdate", func() {
 			),
 		)
 	})
+
+	Context("approval", func() {
+		var (
+			ctx        context.Context
+			oldBastion *operations.Bastion
+			newBastion *operations.Bastion
+		)
+
+		BeforeEach(func() {
+			ctx = request.WithUser(context.TODO(), &user.DefaultInfo{Name: "approver"})
+			oldBastion = &operations.Bastion{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+			newBastion = oldBastion.DeepCopy()
+			metav1.SetMetaDataAnnotation(&newBastion.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationApprove)
+		})
+
+		It("should record the approval and increase the generation", func() {
+			Strategy.PrepareForUpdate(ctx, newBastion, oldBastion)
+
+			Expect(newBastion.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			Expect(newBastion.Status.Approval).NotTo(BeNil())
+			Expect(newBastion.Status.Approval.ApprovedBy).To(Equal("approver"))
+			Expect(newBastion.Status.Approval.ApprovalTimestamp.IsZero()).To(BeFalse())
+			Expect(newBastion.Generation).To(Equal(int64(2)))
+		})
+
+		It("should not overwrite an existing approval", func() {
+			oldBastion.Status.Approval = &operations.BastionApproval{ApprovedBy: "first-approver"}
+
+			Strategy.PrepareForUpdate(ctx, newBastion, oldBastion)
+
+			Expect(newBastion.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			Expect(newBastion.Status.Approval.ApprovedBy).To(Equal("first-approver"))
+			Expect(newBastion.Generation).To(Equal(int64(1)))
+		})
+
+		It("should not allow setting the approval via the status subresource", func() {
+			now := metav1.Now()
+			oldBastion.Status.LastHeartbeatTimestamp = &now
+			newBastion = oldBastion.DeepCopy()
+			newBastion.Status.Approval = &operations.BastionApproval{ApprovedBy: "approver"}
+
+			StatusStrategy.PrepareForUpdate(ctx, newBastion, oldBastion)
+
+			Expect(newBastion.Status.Approval).To(BeNil())
+		})
+	})
 })
 
 var _ = Describe("heartbeat", func() {
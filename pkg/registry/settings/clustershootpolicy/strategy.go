@@ -0,0 +1,79 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustershootpolicy
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	"github.com/gardener/gardener/pkg/apis/settings/validation"
+)
+
+type clusterShootPolicyStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for clustershootpolicies.
+var Strategy = clusterShootPolicyStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (clusterShootPolicyStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (clusterShootPolicyStrategy) PrepareForCreate(_ context.Context, _ runtime.Object) {
+
+}
+
+func (clusterShootPolicyStrategy) PrepareForUpdate(_ context.Context, _, _ runtime.Object) {
+
+}
+
+func (clusterShootPolicyStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	policy := obj.(*settings.ClusterShootPolicy)
+	return validation.ValidateClusterShootPolicy(policy)
+}
+
+func (clusterShootPolicyStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (clusterShootPolicyStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (clusterShootPolicyStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	newPolicy := newObj.(*settings.ClusterShootPolicy)
+	oldPolicy := oldObj.(*settings.ClusterShootPolicy)
+	return validation.ValidateClusterShootPolicyUpdate(newPolicy, oldPolicy)
+}
+
+func (clusterShootPolicyStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (clusterShootPolicyStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (clusterShootPolicyStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
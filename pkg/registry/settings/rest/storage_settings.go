@@ -24,7 +24,11 @@ import (
 	"github.com/gardener/gardener/pkg/apis/settings"
 	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
 	clusteropenidconnectpresetstore "github.com/gardener/gardener/pkg/registry/settings/clusteropenidconnectpreset/storage"
+	clustershootdefaultsstore "github.com/gardener/gardener/pkg/registry/settings/clustershootdefaults/storage"
+	clustershootpolicystore "github.com/gardener/gardener/pkg/registry/settings/clustershootpolicy/storage"
 	openidconnectpresetstore "github.com/gardener/gardener/pkg/registry/settings/openidconnectpreset/storage"
+	shootdefaultsstore "github.com/gardener/gardener/pkg/registry/settings/shootdefaults/storage"
+	shootpolicystore "github.com/gardener/gardener/pkg/registry/settings/shootpolicy/storage"
 )
 
 // StorageProvider is an empty struct.
@@ -47,9 +51,17 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 
 	oidcPresetStorage := openidconnectpresetstore.NewStorage(restOptionsGetter)
 	clusterOIDCStorage := clusteropenidconnectpresetstore.NewStorage(restOptionsGetter)
+	shootPolicyStorage := shootpolicystore.NewStorage(restOptionsGetter)
+	clusterShootPolicyStorage := clustershootpolicystore.NewStorage(restOptionsGetter)
+	shootDefaultsStorage := shootdefaultsstore.NewStorage(restOptionsGetter)
+	clusterShootDefaultsStorage := clustershootdefaultsstore.NewStorage(restOptionsGetter)
 
 	storage["openidconnectpresets"] = oidcPresetStorage.OpenIDConnectPreset
 	storage["clusteropenidconnectpresets"] = clusterOIDCStorage.ClusterOpenIDConnectPreset
+	storage["shootpolicies"] = shootPolicyStorage.ShootPolicy
+	storage["clustershootpolicies"] = clusterShootPolicyStorage.ClusterShootPolicy
+	storage["shootdefaults"] = shootDefaultsStorage.ShootDefaults
+	storage["clustershootdefaults"] = clusterShootDefaultsStorage.ClusterShootDefaults
 
 	return storage
 }
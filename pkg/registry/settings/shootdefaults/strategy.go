@@ -0,0 +1,79 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootdefaults
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	"github.com/gardener/gardener/pkg/apis/settings/validation"
+)
+
+type shootDefaultsStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for shootdefaults.
+var Strategy = shootDefaultsStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (shootDefaultsStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (shootDefaultsStrategy) PrepareForCreate(_ context.Context, _ runtime.Object) {
+
+}
+
+func (shootDefaultsStrategy) PrepareForUpdate(_ context.Context, _, _ runtime.Object) {
+
+}
+
+func (shootDefaultsStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	defaults := obj.(*settings.ShootDefaults)
+	return validation.ValidateShootDefaults(defaults)
+}
+
+func (shootDefaultsStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (shootDefaultsStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (shootDefaultsStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	newDefaults := newObj.(*settings.ShootDefaults)
+	oldDefaults := oldObj.(*settings.ShootDefaults)
+	return validation.ValidateShootDefaultsUpdate(newDefaults, oldDefaults)
+}
+
+func (shootDefaultsStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (shootDefaultsStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (shootDefaultsStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
@@ -60,7 +60,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 func (r *Reconciler) ShootPredicate() predicate.Predicate {
 	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
 		if shoot, ok := obj.(*gardencorev1beta1.Shoot); ok {
-			return shoot.Spec.SeedName == nil &&
+			return (shoot.Spec.SeedName == nil || shoot.Annotations[v1beta1constants.AnnotationSchedulingReschedule] == "true") &&
 				pointer.StringDeref(shoot.Spec.SchedulerName, v1beta1constants.DefaultSchedulerName) == v1beta1constants.DefaultSchedulerName
 		}
 		return false
@@ -84,6 +84,20 @@ var _ = Describe("Add", func() {
 			})
 		})
 
+		Context("shoot is assigned and should be rescheduled", func() {
+			BeforeEach(func() {
+				shoot.Spec.SeedName = pointer.String("seed")
+				shoot.Annotations = map[string]string{"scheduling.gardener.cloud/reschedule": "true"}
+			})
+
+			It("should be true", func() {
+				Expect(predicate.Create(createEvent)).To(BeTrue())
+				Expect(predicate.Update(updateEvent)).To(BeTrue())
+				Expect(predicate.Delete(deleteEvent)).To(BeTrue())
+				Expect(predicate.Generic(genericEvent)).To(BeTrue())
+			})
+		})
+
 		Context("shoot defines schedulerName", func() {
 			Context("default-scheduler", func() {
 				BeforeEach(func() {
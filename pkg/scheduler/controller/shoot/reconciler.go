@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -66,6 +67,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if shoot.Spec.SeedName != nil {
+		if shoot.Annotations[v1beta1constants.AnnotationSchedulingReschedule] == "true" {
+			return reconcile.Result{}, r.reschedule(ctx, log, shoot)
+		}
+
 		log.Info("Shoot already scheduled onto seed, nothing left to do", "seed", *shoot.Spec.SeedName)
 		return reconcile.Result{}, nil
 	}
@@ -100,6 +105,42 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return reconcile.Result{}, nil
 }
 
+// reschedule migrates the given shoot to another seed if its current seed is drained.
+func (r *Reconciler) reschedule(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
+	currentSeedName := *shoot.Spec.SeedName
+	log = log.WithValues("currentSeed", currentSeedName)
+
+	currentSeed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, kubernetesutils.Key(currentSeedName), currentSeed); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed reading current seed %s: %w", currentSeedName, err)
+	}
+
+	if shoot.DeletionTimestamp != nil || !v1beta1helper.SeedSettingDrainEnabled(currentSeed.Spec.Settings) {
+		log.Info("Removing reschedule annotation because shoot is being deleted or its seed is not drained")
+		patch := client.MergeFrom(shoot.DeepCopy())
+		delete(shoot.Annotations, v1beta1constants.AnnotationSchedulingReschedule)
+		return r.Client.Patch(ctx, shoot, patch)
+	}
+
+	seed, err := r.determineSeed(ctx, log, shoot)
+	if err != nil {
+		r.reportEvent(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventSchedulingFailed, "Failed to reschedule Shoot away from drained seed '%s': %s", currentSeedName, err.Error())
+		return fmt.Errorf("failed to determine seed for shoot: %w", err)
+	}
+
+	delete(shoot.Annotations, v1beta1constants.AnnotationSchedulingReschedule)
+	shoot.Spec.SeedName = &seed.Name
+	if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+		r.reportEvent(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventSchedulingFailed, "Failed to reschedule Shoot away from drained seed '%s': %s", currentSeedName, err.Error())
+		return fmt.Errorf("failed to bind shoot to seed: %w", err)
+	}
+
+	log.Info("Shoot successfully rescheduled to seed", "seed", seed.Name, "strategy", r.Config.Strategy)
+
+	r.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingSuccessful, "Rescheduled from drained seed '%s' to seed '%s'", currentSeedName, seed.Name)
+	return nil
+}
+
 func (r *Reconciler) reportFailedScheduling(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, err error) {
 	description := fmt.Sprintf("Failed to schedule Shoot: %s", err.Error())
 	r.reportEvent(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventSchedulingFailed, description)
@@ -151,6 +192,21 @@ func (r *Reconciler) determineSeed(
 	if err != nil {
 		return nil, err
 	}
+	if shoot.Spec.SeedName != nil {
+		filteredSeeds, err = filterSeedsForControlPlaneMigration(filteredSeeds, seedList.Items, *shoot.Spec.SeedName)
+		if err != nil {
+			return nil, err
+		}
+	} else if shoot.Spec.Source != nil {
+		backupEntry := &gardencorev1beta1.BackupEntry{}
+		if err := r.Client.Get(ctx, kubernetesutils.Key(shoot.Namespace, shoot.Spec.Source.BackupEntryName), backupEntry); err != nil {
+			return nil, fmt.Errorf("failed reading source backup entry: %w", err)
+		}
+		filteredSeeds, err = filterSeedsForShootClone(filteredSeeds, backupEntry)
+		if err != nil {
+			return nil, err
+		}
+	}
 	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
 	if err != nil {
 		return nil, err
@@ -207,7 +263,7 @@ func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cl
 }
 
 func isUsableSeed(seed *gardencorev1beta1.Seed) bool {
-	return seed.DeletionTimestamp == nil && seed.Spec.Settings.Scheduling.Visible && verifySeedReadiness(seed)
+	return seed.DeletionTimestamp == nil && seed.Spec.Settings.Scheduling.Visible && !v1beta1helper.SeedSettingDrainEnabled(seed.Spec.Settings) && verifySeedReadiness(seed)
 }
 
 func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
@@ -220,11 +276,52 @@ func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.S
 	}
 
 	if len(matchingSeeds) == 0 {
-		return nil, fmt.Errorf("none of the %d seeds is valid for scheduling (not deleting, visible and ready)", len(seedList))
+		return nil, fmt.Errorf("none of the %d seeds is valid for scheduling (not deleting, visible, not drained and ready)", len(seedList))
+	}
+	return matchingSeeds, nil
+}
+
+// filterSeedsForControlPlaneMigration returns the seeds to which the control plane of a shoot can be migrated from its
+// current seed, i.e., seeds other than the current one which have backup configured and the same provider type.
+func filterSeedsForControlPlaneMigration(seedList, allSeeds []gardencorev1beta1.Seed, currentSeedName string) ([]gardencorev1beta1.Seed, error) {
+	var currentSeed *gardencorev1beta1.Seed
+	for i := range allSeeds {
+		if allSeeds[i].Name == currentSeedName {
+			currentSeed = &allSeeds[i]
+			break
+		}
+	}
+	if currentSeed == nil {
+		return nil, fmt.Errorf("current seed %q of shoot not found", currentSeedName)
+	}
+
+	var matchingSeeds []gardencorev1beta1.Seed
+	for _, seed := range seedList {
+		if seed.Name != currentSeed.Name && seed.Spec.Backup != nil && seed.Spec.Provider.Type == currentSeed.Spec.Provider.Type {
+			matchingSeeds = append(matchingSeeds, seed)
+		}
+	}
+
+	if len(matchingSeeds) == 0 {
+		return nil, fmt.Errorf("none of the %d seeds is valid for migrating the shoot control plane from seed %q (backup configured and provider type %q)", len(seedList), currentSeed.Name, currentSeed.Spec.Provider.Type)
 	}
 	return matchingSeeds, nil
 }
 
+// filterSeedsForShootClone returns the seed to which the source BackupEntry of a cloned shoot is scheduled since the
+// ETCD backups and the ETCD encryption key of the source shoot can only be copied within this seed.
+func filterSeedsForShootClone(seedList []gardencorev1beta1.Seed, backupEntry *gardencorev1beta1.BackupEntry) ([]gardencorev1beta1.Seed, error) {
+	seedName := pointer.StringDeref(backupEntry.Spec.SeedName, "")
+
+	for _, seed := range seedList {
+		if seed.Name == seedName && seed.Spec.Backup != nil {
+			return []gardencorev1beta1.Seed{seed}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("seed %q of source backup entry %q is not valid for scheduling the cloned shoot (usable and backup configured)", seedName, backupEntry.Name)
+}
+
 func filterSeedsMatchingLabelSelector(seedList []gardencorev1beta1.Seed, seedSelector *gardencorev1beta1.SeedSelector, kind string) ([]gardencorev1beta1.Seed, error) {
 	if seedSelector == nil {
 		return seedList, nil
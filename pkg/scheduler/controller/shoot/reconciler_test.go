@@ -20,14 +20,18 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -818,6 +822,184 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
+
+		It("should fail because it cannot find a seed cluster due to draining", func() {
+			seed.Spec.Settings.Scheduling.Drain = &gardencorev1beta1.SeedSettingSchedulingDrain{}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("not drained")))
+			Expect(bestSeed).To(BeNil())
+		})
+	})
+
+	Context("SEED DETERMINATION - Shoot is cloned from a BackupEntry", func() {
+		var (
+			otherSeed   *gardencorev1beta1.Seed
+			backupEntry *gardencorev1beta1.BackupEntry
+		)
+
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			schedulerConfiguration.Schedulers.Shoot.Strategy = config.Default
+
+			seed = seedBase.DeepCopy()
+			seed.Spec.Backup = &gardencorev1beta1.SeedBackup{Provider: providerType}
+			seed.Status.Conditions = append(seed.Status.Conditions, gardencorev1beta1.Condition{Type: gardencorev1beta1.SeedBackupBucketsReady, Status: gardencorev1beta1.ConditionTrue})
+
+			otherSeed = seed.DeepCopy()
+			otherSeed.Name = "seed-2"
+
+			backupEntry = &gardencorev1beta1.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot--foo--source--1234",
+					Namespace: shootBase.Namespace,
+				},
+				Spec: gardencorev1beta1.BackupEntrySpec{
+					BucketName: "bucket",
+					SeedName:   pointer.String(seed.Name),
+				},
+			}
+
+			shoot = shootBase.DeepCopy()
+			shoot.Spec.SeedName = nil
+			shoot.Spec.Source = &gardencorev1beta1.ShootSource{BackupEntryName: backupEntry.Name}
+		})
+
+		It("should find the seed of the source backup entry", func() {
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, otherSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, backupEntry)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should fail because the source backup entry does not exist", func() {
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("failed reading source backup entry")))
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should fail because the seed of the source backup entry has no backup", func() {
+			seed.Spec.Backup = nil
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, otherSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, backupEntry)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("is not valid for scheduling the cloned shoot")))
+			Expect(bestSeed).To(BeNil())
+		})
+	})
+
+	Context("SEED DETERMINATION - Shoot is rescheduled away from a drained Seed", func() {
+		var (
+			targetSeed    *gardencorev1beta1.Seed
+			fakeRecorder  *record.FakeRecorder
+			rescheduleKey = "scheduling.gardener.cloud/reschedule"
+		)
+
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			schedulerConfiguration.Schedulers.Shoot.Strategy = config.Default
+
+			seed = seedBase.DeepCopy()
+			seed.Spec.Backup = &gardencorev1beta1.SeedBackup{Provider: providerType}
+			seed.Spec.Settings.Scheduling.Drain = &gardencorev1beta1.SeedSettingSchedulingDrain{}
+			seed.Status.Conditions = append(seed.Status.Conditions, gardencorev1beta1.Condition{Type: gardencorev1beta1.SeedBackupBucketsReady, Status: gardencorev1beta1.ConditionTrue})
+
+			targetSeed = seed.DeepCopy()
+			targetSeed.Name = "seed-2"
+			targetSeed.Spec.Settings.Scheduling.Drain = nil
+
+			shoot = shootBase.DeepCopy()
+			shoot.Spec.SeedName = &seed.Name
+			shoot.Annotations = map[string]string{rescheduleKey: "true"}
+
+			fakeRecorder = record.NewFakeRecorder(1)
+		})
+
+		JustBeforeEach(func() {
+			reconciler.Recorder = fakeRecorder
+			// The fake client does not know the binding subresource, hence we simulate it by updating the shoot.
+			reconciler.Client = interceptor.NewClient(fakeGardenClient.(client.WithWatch), interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					Expect(subResourceName).To(Equal("binding"))
+					return c.Update(ctx, obj)
+				},
+			})
+		})
+
+		It("should find another seed with backup", func() {
+			seedWithoutBackup := targetSeed.DeepCopy()
+			seedWithoutBackup.Name = "seed-3"
+			seedWithoutBackup.Spec.Backup = nil
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, targetSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seedWithoutBackup)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(targetSeed.Name))
+		})
+
+		It("should fail because no other seed has backup", func() {
+			targetSeed.Spec.Backup = nil
+			targetSeed.Status.Conditions = seedBase.Status.Conditions
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, targetSeed)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("valid for migrating the shoot control plane")))
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should bind the shoot to another seed and remove the reschedule annotation", func() {
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, targetSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.SeedName).To(PointTo(Equal(targetSeed.Name)))
+			Expect(shoot.Annotations).NotTo(HaveKey(rescheduleKey))
+			Expect(fakeRecorder.Events).To(Receive(ContainSubstring("Rescheduled from drained seed")))
+		})
+
+		It("should only remove the reschedule annotation if the seed is not drained", func() {
+			seed.Spec.Settings.Scheduling.Drain = nil
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, targetSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.SeedName).To(PointTo(Equal(seed.Name)))
+			Expect(shoot.Annotations).NotTo(HaveKey(rescheduleKey))
+		})
 	})
 
 	Context("#DetermineBestSeedCandidate", func() {
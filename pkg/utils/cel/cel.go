@@ -0,0 +1,122 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+)
+
+const (
+	// VariableObject is the name of the CEL variable containing the object under admission.
+	VariableObject = "object"
+	// VariableOldObject is the name of the CEL variable containing the existing object in case of updates. It is `null`
+	// for create requests.
+	VariableOldObject = "oldObject"
+
+	// PerCallCostLimit is the maximum runtime cost of a single evaluation of an expression. Evaluations exceeding it are
+	// aborted. It is the same limit which is used for ValidatingAdmissionPolicies.
+	PerCallCostLimit uint64 = 1000000
+	// StaticEstimatedCostLimit is the maximum estimated cost of an expression. Expressions exceeding it are rejected
+	// when they are compiled. It is the same limit which is used for validation rules of CustomResourceDefinitions.
+	StaticEstimatedCostLimit uint64 = 10000000
+	// MaxEstimatedCollectionSize is the size of lists, maps and strings of the objects which is assumed when estimating
+	// the cost of an expression since the actual sizes are unknown when the expression is compiled.
+	MaxEstimatedCollectionSize uint64 = 100
+
+	// interruptCheckFrequency is the number of iterations within a comprehension after which it is checked whether the
+	// context of the evaluation is done.
+	interruptCheckFrequency = 100
+)
+
+var env *cel.Env
+
+func init() {
+	var err error
+	env, err = cel.NewEnv(
+		cel.Variable(VariableObject, cel.DynType),
+		cel.Variable(VariableOldObject, cel.DynType),
+		cel.HomogeneousAggregateLiterals(),
+		cel.EagerlyValidateDeclarations(true),
+		ext.Strings(),
+		ext.Sets(),
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed creating CEL environment: %w", err))
+	}
+}
+
+// Compile compiles the given CEL expression and checks that it evaluates to a boolean and that its estimated cost does
+// not exceed the StaticEstimatedCostLimit. The returned program aborts evaluations exceeding the PerCallCostLimit.
+func Compile(expression string) (cel.Program, error) {
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to bool but evaluates to %s", ast.OutputType())
+	}
+
+	cost, err := env.EstimateCost(ast, sizeEstimator{})
+	if err != nil {
+		return nil, fmt.Errorf("failed estimating cost of expression: %w", err)
+	}
+	if cost.Max > StaticEstimatedCostLimit {
+		return nil, fmt.Errorf("estimated cost of expression (%d) exceeds limit (%d), assuming that lists, maps and strings have at most %d elements", cost.Max, StaticEstimatedCostLimit, MaxEstimatedCollectionSize)
+	}
+
+	return env.Program(ast, cel.CostLimit(PerCallCostLimit), cel.InterruptCheckFrequency(interruptCheckFrequency))
+}
+
+// Evaluate evaluates the given program against the given objects. The objects are expected to be in their
+// unstructured representation. oldObject may be nil. The evaluation is aborted when the given context is done.
+func Evaluate(ctx context.Context, program cel.Program, object, oldObject map[string]interface{}) (bool, error) {
+	var old interface{} = types.NullValue
+	if oldObject != nil {
+		old = oldObject
+	}
+
+	out, _, err := program.ContextEval(ctx, map[string]interface{}{
+		VariableObject:    object,
+		VariableOldObject: old,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %v instead of bool", out.Value())
+	}
+
+	return result, nil
+}
+
+// sizeEstimator estimates the size of all lists, maps and strings with MaxEstimatedCollectionSize.
+type sizeEstimator struct{}
+
+func (sizeEstimator) EstimateSize(checker.AstNode) *checker.SizeEstimate {
+	return &checker.SizeEstimate{Min: 0, Max: MaxEstimatedCollectionSize}
+}
+
+func (sizeEstimator) EstimateCallCost(string, string, *checker.AstNode, []checker.AstNode) *checker.CallEstimate {
+	return nil
+}
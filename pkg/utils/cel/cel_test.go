@@ -0,0 +1,138 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cel_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/cel"
+)
+
+var _ = Describe("CEL", func() {
+	var (
+		ctx    = context.TODO()
+		object map[string]interface{}
+	)
+
+	BeforeEach(func() {
+		object = map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "foo"},
+			"spec": map[string]interface{}{
+				"workers": []interface{}{
+					map[string]interface{}{"name": "w1", "maximum": int64(3)},
+					map[string]interface{}{"name": "w2", "maximum": int64(5)},
+				},
+			},
+		}
+	})
+
+	Describe("#Compile", func() {
+		It("should compile a valid expression", func() {
+			Expect(Compile("object.metadata.name == 'foo'")).NotTo(BeNil())
+		})
+
+		It("should fail for an invalid expression", func() {
+			_, err := Compile("object.metadata.name ==")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for an expression with unknown variables", func() {
+			_, err := Compile("shoot.metadata.name == 'foo'")
+			Expect(err).To(MatchError(ContainSubstring("undeclared reference")))
+		})
+
+		It("should fail for an expression which does not evaluate to bool", func() {
+			_, err := Compile("'foo'")
+			Expect(err).To(MatchError(ContainSubstring("must evaluate to bool")))
+		})
+
+		It("should fail for an expression whose estimated cost is too high", func() {
+			_, err := Compile("object.spec.workers.all(a, object.spec.workers.all(b, object.spec.workers.all(c, a.name != b.name || b.name != c.name)))")
+			Expect(err).To(MatchError(ContainSubstring("estimated cost of expression")))
+		})
+	})
+
+	Describe("#Evaluate", func() {
+		It("should evaluate to true", func() {
+			program, err := Compile("object.spec.workers.all(w, w.maximum <= 5)")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(Evaluate(ctx, program, object, nil)).To(BeTrue())
+		})
+
+		It("should evaluate to false", func() {
+			program, err := Compile("object.spec.workers.all(w, w.maximum <= 4)")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(Evaluate(ctx, program, object, nil)).To(BeFalse())
+		})
+
+		It("should provide null as old object for create requests", func() {
+			program, err := Compile("oldObject == null")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(Evaluate(ctx, program, object, nil)).To(BeTrue())
+		})
+
+		It("should provide the old object for update requests", func() {
+			program, err := Compile("oldObject != null && oldObject.metadata.name == object.metadata.name")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(Evaluate(ctx, program, object, object)).To(BeTrue())
+		})
+
+		It("should return an error if a field does not exist", func() {
+			program, err := Compile("object.spec.foo == 'bar'")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = Evaluate(ctx, program, object, nil)
+			Expect(err).To(MatchError(ContainSubstring("no such key")))
+		})
+
+		It("should abort the evaluation if the cost limit is exceeded", func() {
+			var workers []interface{}
+			for i := 0; i < 1000; i++ {
+				workers = append(workers, map[string]interface{}{"name": "w"})
+			}
+			object["spec"] = map[string]interface{}{"workers": workers}
+
+			program, err := Compile("object.spec.workers.all(a, object.spec.workers.all(b, a.name == b.name))")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = Evaluate(ctx, program, object, nil)
+			Expect(err).To(MatchError(ContainSubstring("cost limit exceeded")))
+		})
+
+		It("should abort the evaluation if the context is done", func() {
+			var workers []interface{}
+			for i := 0; i < 200; i++ {
+				workers = append(workers, map[string]interface{}{"name": "w"})
+			}
+			object["spec"] = map[string]interface{}{"workers": workers}
+
+			program, err := Compile("object.spec.workers.all(w, w.name == 'w')")
+			Expect(err).NotTo(HaveOccurred())
+
+			cancelledCtx, cancel := context.WithCancel(ctx)
+			cancel()
+
+			_, err = Evaluate(cancelledCtx, program, object, nil)
+			Expect(err).To(MatchError(ContainSubstring("interrupted")))
+		})
+	})
+})
@@ -30,6 +30,16 @@ const (
 	// ConfirmationDeletion is an annotation on a Shoot and Project resources whose value must be set to "true" in order to
 	// allow deleting the resource (if the annotation is not set any DELETE request will be denied).
 	ConfirmationDeletion = "confirmation.gardener.cloud/deletion"
+	// ApproveDeletion is an annotation on Shoot and Project resources which can be set to "true" by an approver in
+	// order to approve the deletion of a protected resource. The annotation is removed by the API server which records
+	// the approval in the DeletionApprovedBy and DeletionApprovedAt annotations.
+	ApproveDeletion = "confirmation.gardener.cloud/approve-deletion"
+	// DeletionApprovedBy is an annotation on Shoot and Project resources which contains the name of the user who
+	// approved the deletion of the resource. It can only be set by the API server.
+	DeletionApprovedBy = "confirmation.gardener.cloud/deletion-approved-by"
+	// DeletionApprovedAt is an annotation on Shoot and Project resources which contains the time at which the deletion
+	// of the resource was approved. It can only be set by the API server.
+	DeletionApprovedAt = "confirmation.gardener.cloud/deletion-approved-at"
 	// DeletionProtected is a label on CustomResourceDefinitions indicating that the deletion is protected, i.e.
 	// it must be confirmed with the `confirmation.gardener.cloud/deletion=true` annotation before a `DELETE` call
 	// is accepted.
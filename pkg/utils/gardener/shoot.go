@@ -644,6 +644,10 @@ func ComputeRequiredExtensionsForShoot(shoot *gardencorev1beta1.Shoot, seed *gar
 		}
 	}
 
+	for _, hook := range shoot.Spec.Hooks {
+		requiredExtensions.Insert(ExtensionsID(extensionsv1alpha1.ExtensionResource, hook.Type))
+	}
+
 	for _, pool := range shoot.Spec.Provider.Workers {
 		if pool.Machine.Image != nil {
 			requiredExtensions.Insert(ExtensionsID(extensionsv1alpha1.OperatingSystemConfigResource, pool.Machine.Image.Name))
@@ -1188,6 +1188,14 @@ var _ = Describe("Shoot", func() {
 			)))
 		})
 
+		It("should compute the correct list of required extensions (shoot uses hooks)", func() {
+			shoot.Spec.Hooks = []gardencorev1beta1.Hook{{Name: "backup", Type: "hook-backup", Point: gardencorev1beta1.HookPointPreHibernation}}
+
+			result := ComputeRequiredExtensionsForShoot(shoot, seed, controllerRegistrationList, internalDomain, externalDomain)
+
+			Expect(result.Has(ExtensionsID(extensionsv1alpha1.ExtensionResource, "hook-backup"))).To(BeTrue())
+		})
+
 		It("should compute the correct list of required extensions (workerless Shoot)", func() {
 			shoot.Spec.Provider.Workers = nil
 
@@ -0,0 +1,45 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+type fakeHelmRegistry struct {
+	charts map[string][]byte
+}
+
+var _ oci.HelmRegistry = &fakeHelmRegistry{}
+
+// NewHelmRegistry returns a simple implementation of oci.HelmRegistry which can be used to fake the registry in tests.
+// The given charts are keyed by `<repository>@<digest>`.
+func NewHelmRegistry(charts map[string][]byte) oci.HelmRegistry {
+	return &fakeHelmRegistry{charts: charts}
+}
+
+// Pull returns the chart stored for the given repository and digest.
+func (r *fakeHelmRegistry) Pull(_ context.Context, repository, manifestDigest string, _ *corev1.Secret) ([]byte, error) {
+	chart, ok := r.charts[repository+"@"+manifestDigest]
+	if !ok {
+		return nil, fmt.Errorf("chart %s@%s not found", repository, manifestDigest)
+	}
+	return chart, nil
+}
@@ -0,0 +1,240 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/lru"
+)
+
+const (
+	// MediaTypeHelmChartContent is the media type of the layer containing the Helm chart archive in an OCI artifact.
+	MediaTypeHelmChartContent = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+
+	// maxManifestSize is the maximum size of a manifest which is accepted.
+	maxManifestSize = 4 * 1024 * 1024
+	// maxChartSize is the maximum size of a Helm chart archive which is accepted.
+	maxChartSize = 32 * 1024 * 1024
+	// defaultCacheSize is the default number of Helm charts kept in the cache.
+	defaultCacheSize = 32
+)
+
+// HelmRegistry pulls Helm charts stored as OCI artifacts in a registry.
+type HelmRegistry interface {
+	// Pull pulls the Helm chart archive from the given repository. The digest of the manifest must match the given
+	// digest. The optional pull secret must be of type kubernetes.io/dockerconfigjson.
+	Pull(ctx context.Context, repository, manifestDigest string, pullSecret *corev1.Secret) ([]byte, error)
+}
+
+// Option configures a HelmRegistry.
+type Option func(*helmRegistry)
+
+// WithClient sets the HTTP client used for requests to the registry.
+func WithClient(client *http.Client) Option {
+	return func(r *helmRegistry) {
+		r.client = client
+	}
+}
+
+// WithPlainHTTP configures the registry hosts for which plain HTTP is used instead of HTTPS. By default, this is only the
+// case for localhost.
+func WithPlainHTTP(f func(string) (bool, error)) Option {
+	return func(r *helmRegistry) {
+		r.plainHTTP = f
+	}
+}
+
+// WithCacheSize sets the number of Helm charts which are kept in memory.
+func WithCacheSize(size int) Option {
+	return func(r *helmRegistry) {
+		r.cache = lru.New(size)
+	}
+}
+
+// NewHelmRegistry returns a new HelmRegistry. Pulled Helm charts are cached in memory by repository and digest.
+func NewHelmRegistry(opts ...Option) HelmRegistry {
+	r := &helmRegistry{
+		client:    http.DefaultClient,
+		plainHTTP: docker.MatchLocalhost,
+		cache:     lru.New(defaultCacheSize),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+type helmRegistry struct {
+	client    *http.Client
+	plainHTTP func(string) (bool, error)
+	cache     *lru.Cache
+}
+
+func (r *helmRegistry) Pull(ctx context.Context, repository, manifestDigest string, pullSecret *corev1.Secret) ([]byte, error) {
+	dgst, err := digest.Parse(manifestDigest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid digest %q: %w", manifestDigest, err)
+	}
+
+	ref := strings.TrimPrefix(repository, "oci://") + "@" + dgst.String()
+
+	if chart, ok := r.cache.Get(ref); ok {
+		return chart.([]byte), nil
+	}
+
+	credentials, err := credentialsFromPullSecret(pullSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := docker.NewResolver(docker.ResolverOptions{
+		Hosts: docker.ConfigureDefaultRegistries(
+			docker.WithClient(r.client),
+			docker.WithPlainHTTP(r.plainHTTP),
+			docker.WithAuthorizer(docker.NewDockerAuthorizer(
+				docker.WithAuthClient(r.client),
+				docker.WithAuthCreds(credentials),
+			)),
+		),
+	})
+
+	name, desc, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed resolving %s: %w", ref, err)
+	}
+
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Always verify against the requested digest and not against the one reported by the registry.
+	desc.Digest = dgst
+	manifestBytes, err := fetch(ctx, fetcher, desc, maxManifestSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching manifest of %s: %w", ref, err)
+	}
+
+	manifest := &ocispec.Manifest{}
+	if err := json.Unmarshal(manifestBytes, manifest); err != nil {
+		return nil, fmt.Errorf("failed decoding manifest of %s: %w", ref, err)
+	}
+
+	var chartLayer *ocispec.Descriptor
+	for i, layer := range manifest.Layers {
+		if layer.MediaType == MediaTypeHelmChartContent {
+			chartLayer = &manifest.Layers[i]
+			break
+		}
+	}
+	if chartLayer == nil {
+		return nil, fmt.Errorf("manifest of %s does not contain a layer with media type %s", ref, MediaTypeHelmChartContent)
+	}
+
+	chart, err := fetch(ctx, fetcher, *chartLayer, maxChartSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching Helm chart of %s: %w", ref, err)
+	}
+
+	r.cache.Add(ref, chart)
+	return chart, nil
+}
+
+// fetch reads the content of the given descriptor and verifies it against the descriptor's digest.
+func fetch(ctx context.Context, fetcher remotes.Fetcher, desc ocispec.Descriptor, maxSize int64) ([]byte, error) {
+	if desc.Size > maxSize {
+		return nil, fmt.Errorf("size %d exceeds the maximum of %d bytes", desc.Size, maxSize)
+	}
+
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("content exceeds the maximum of %d bytes", maxSize)
+	}
+
+	if actual := desc.Digest.Algorithm().FromBytes(data); actual != desc.Digest {
+		return nil, fmt.Errorf("digest mismatch: expected %s, got %s", desc.Digest, actual)
+	}
+
+	return data, nil
+}
+
+type dockerConfig struct {
+	Auths map[string]dockerAuth `json:"auths"`
+}
+
+type dockerAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// credentialsFromPullSecret returns a function providing the username and password for a registry host based on the
+// given pull secret.
+func credentialsFromPullSecret(pullSecret *corev1.Secret) (func(string) (string, string, error), error) {
+	config := &dockerConfig{}
+
+	if pullSecret != nil {
+		if pullSecret.Type != corev1.SecretTypeDockerConfigJson {
+			return nil, fmt.Errorf("pull secret %s must be of type %s", pullSecret.Name, corev1.SecretTypeDockerConfigJson)
+		}
+		if err := json.Unmarshal(pullSecret.Data[corev1.DockerConfigJsonKey], config); err != nil {
+			return nil, fmt.Errorf("failed decoding pull secret %s: %w", pullSecret.Name, err)
+		}
+	}
+
+	return func(host string) (string, string, error) {
+		auth, ok := config.Auths[host]
+		if !ok {
+			auth, ok = config.Auths["https://"+host]
+		}
+		if !ok {
+			return "", "", nil
+		}
+
+		if auth.Username != "" || auth.Auth == "" {
+			return auth.Username, auth.Password, nil
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return "", "", fmt.Errorf("failed decoding auth for host %s: %w", host, err)
+		}
+		username, password, _ := strings.Cut(string(decoded), ":")
+		return username, password, nil
+	}, nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/utils/oci"
+)
+
+var _ = Describe("HelmRegistry", func() {
+	var (
+		ctx = context.TODO()
+
+		chart          []byte
+		manifest       []byte
+		manifestDigest digest.Digest
+
+		blobs    map[digest.Digest][]byte
+		requests int
+		username string
+		password string
+
+		server     *httptest.Server
+		repository string
+		registry   HelmRegistry
+	)
+
+	BeforeEach(func() {
+		chart = []byte("chart-archive")
+		config := []byte("{}")
+
+		var err error
+		manifest, err = json.Marshal(ocispec.Manifest{
+			MediaType: ocispec.MediaTypeImageManifest,
+			Config: ocispec.Descriptor{
+				MediaType: "application/vnd.cncf.helm.config.v1+json",
+				Digest:    digest.FromBytes(config),
+				Size:      int64(len(config)),
+			},
+			Layers: []ocispec.Descriptor{{
+				MediaType: MediaTypeHelmChartContent,
+				Digest:    digest.FromBytes(chart),
+				Size:      int64(len(chart)),
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		manifestDigest = digest.FromBytes(manifest)
+
+		blobs = map[digest.Digest][]byte{
+			digest.FromBytes(config): config,
+			digest.FromBytes(chart):  chart,
+		}
+		requests = 0
+		username, password = "", ""
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+
+			if username != "" {
+				if u, p, ok := r.BasicAuth(); !ok || u != username || p != password {
+					w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+			}
+
+			switch {
+			case strings.HasPrefix(r.URL.Path, "/v2/charts/foo/manifests/"):
+				if strings.TrimPrefix(r.URL.Path, "/v2/charts/foo/manifests/") != manifestDigest.String() {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+				w.Header().Set("Docker-Content-Digest", manifestDigest.String())
+				w.Header().Set("Content-Length", strconv.Itoa(len(manifest)))
+				if r.Method == http.MethodHead {
+					return
+				}
+				_, _ = w.Write(manifest)
+			case strings.HasPrefix(r.URL.Path, "/v2/charts/foo/blobs/"):
+				blob, ok := blobs[digest.Digest(strings.TrimPrefix(r.URL.Path, "/v2/charts/foo/blobs/"))]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write(blob)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		DeferCleanup(server.Close)
+
+		repository = "oci://" + strings.TrimPrefix(server.URL, "http://") + "/charts/foo"
+		registry = NewHelmRegistry()
+	})
+
+	Describe("#Pull", func() {
+		It("should pull the Helm chart", func() {
+			Expect(registry.Pull(ctx, repository, manifestDigest.String(), nil)).To(Equal(chart))
+		})
+
+		It("should serve the Helm chart from the cache", func() {
+			Expect(registry.Pull(ctx, repository, manifestDigest.String(), nil)).To(Equal(chart))
+			requestsAfterFirstPull := requests
+
+			Expect(registry.Pull(ctx, repository, manifestDigest.String(), nil)).To(Equal(chart))
+			Expect(requests).To(Equal(requestsAfterFirstPull))
+		})
+
+		It("should fail if the digest is invalid", func() {
+			_, err := registry.Pull(ctx, repository, "foo", nil)
+			Expect(err).To(MatchError(ContainSubstring("invalid digest")))
+		})
+
+		It("should fail if the manifest does not exist", func() {
+			_, err := registry.Pull(ctx, repository, digest.FromString("other").String(), nil)
+			Expect(err).To(MatchError(ContainSubstring("failed resolving")))
+		})
+
+		It("should fail if the Helm chart does not match the digest from the manifest", func() {
+			blobs[digest.FromBytes(chart)] = []byte("tampered-chart")
+
+			_, err := registry.Pull(ctx, repository, manifestDigest.String(), nil)
+			Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+		})
+
+		It("should fail if the manifest does not contain a Helm chart", func() {
+			manifest = []byte(`{"schemaVersion":2,"layers":[]}`)
+			manifestDigest = digest.FromBytes(manifest)
+
+			_, err := registry.Pull(ctx, repository, manifestDigest.String(), nil)
+			Expect(err).To(MatchError(ContainSubstring("does not contain a layer with media type")))
+		})
+
+		Context("with authentication", func() {
+			var pullSecret *corev1.Secret
+
+			BeforeEach(func() {
+				username, password = "user", "pass"
+
+				pullSecret = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "pull-secret"},
+					Type:       corev1.SecretTypeDockerConfigJson,
+					Data: map[string][]byte{
+						corev1.DockerConfigJsonKey: []byte(`{"auths":{"` + strings.TrimPrefix(server.URL, "http://") + `":{"auth":"dXNlcjpwYXNz"}}}`),
+					},
+				}
+			})
+
+			It("should pull the Helm chart with the credentials from the pull secret", func() {
+				Expect(registry.Pull(ctx, repository, manifestDigest.String(), pullSecret)).To(Equal(chart))
+			})
+
+			It("should fail without credentials", func() {
+				_, err := registry.Pull(ctx, repository, manifestDigest.String(), nil)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail if the pull secret has the wrong type", func() {
+				pullSecret.Type = corev1.SecretTypeOpaque
+
+				_, err := registry.Pull(ctx, repository, manifestDigest.String(), pullSecret)
+				Expect(err).To(MatchError(ContainSubstring("must be of type")))
+			})
+		})
+	})
+})
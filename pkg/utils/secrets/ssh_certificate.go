@@ -0,0 +1,89 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// SSHUserCertificateConfig contains the information for signing an SSH user certificate.
+type SSHUserCertificateConfig struct {
+	// PublicKey is the SSH public key of the user in the OpenSSH authorized keys format.
+	PublicKey []byte
+	// KeyID is the identifier of the certificate which is logged by sshd, e.g., the identity of the user.
+	KeyID string
+	// Principals are the user names for which the certificate is valid.
+	Principals []string
+	// ValidAfter is the point in time from which the certificate is valid.
+	ValidAfter time.Time
+	// ValidBefore is the point in time until which the certificate is valid.
+	ValidBefore time.Time
+}
+
+// SignSSHUserCertificate signs an SSH user certificate for the given configuration with the RSA private key of the
+// given SSH certificate authority. It returns the certificate in the OpenSSH authorized keys format.
+func SignSSHUserCertificate(caPrivateKeyPEM []byte, config SSHUserCertificateConfig) ([]byte, error) {
+	caPrivateKey, err := utils.DecodePrivateKey(caPrivateKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding private key of SSH certificate authority: %w", err)
+	}
+
+	signer, err := ssh.NewSignerFromKey(caPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating signer for SSH certificate authority: %w", err)
+	}
+
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey(config.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing SSH public key: %w", err)
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).SetUint64(math.MaxUint64))
+	if err != nil {
+		return nil, err
+	}
+
+	certificate := &ssh.Certificate{
+		Key:             publicKey,
+		Serial:          serial.Uint64(),
+		CertType:        ssh.UserCert,
+		KeyId:           config.KeyID,
+		ValidPrincipals: config.Principals,
+		ValidAfter:      uint64(config.ValidAfter.Unix()),
+		ValidBefore:     uint64(config.ValidBefore.Unix()),
+		Permissions: ssh.Permissions{
+			Extensions: map[string]string{
+				"permit-pty":              "",
+				"permit-port-forwarding":  "",
+				"permit-agent-forwarding": "",
+			},
+		},
+	}
+
+	if err := certificate.SignCert(rand.Reader, signer); err != nil {
+		return nil, fmt.Errorf("failed signing SSH user certificate: %w", err)
+	}
+
+	return bytes.Trim(ssh.MarshalAuthorizedKey(certificate), "\x0a"), nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets_test
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("SSH Certificates", func() {
+	Describe("#SignSSHUserCertificate", func() {
+		var (
+			caSecretData map[string][]byte
+			config       SSHUserCertificateConfig
+			now          = time.Now().Truncate(time.Second)
+		)
+
+		BeforeEach(func() {
+			ca, err := (&RSASecretConfig{Name: "ssh-user-ca", Bits: 2048, UsedForSSH: true}).Generate()
+			Expect(err).NotTo(HaveOccurred())
+			caSecretData = ca.SecretData()
+
+			user, err := (&RSASecretConfig{Name: "user", Bits: 2048, UsedForSSH: true}).Generate()
+			Expect(err).NotTo(HaveOccurred())
+
+			config = SSHUserCertificateConfig{
+				PublicKey:   user.SecretData()[DataKeySSHAuthorizedKeys],
+				KeyID:       "foo@example.com",
+				Principals:  []string{"gardener"},
+				ValidAfter:  now,
+				ValidBefore: now.Add(time.Hour),
+			}
+		})
+
+		It("should sign a certificate which is accepted for the principals", func() {
+			data, err := SignSSHUserCertificate(caSecretData[DataKeyRSAPrivateKey], config)
+			Expect(err).NotTo(HaveOccurred())
+
+			publicKey, _, _, _, err := ssh.ParseAuthorizedKey(data)
+			Expect(err).NotTo(HaveOccurred())
+			certificate, ok := publicKey.(*ssh.Certificate)
+			Expect(ok).To(BeTrue())
+
+			Expect(certificate.CertType).To(Equal(uint32(ssh.UserCert)))
+			Expect(certificate.KeyId).To(Equal("foo@example.com"))
+			Expect(certificate.ValidPrincipals).To(ConsistOf("gardener"))
+			Expect(certificate.ValidAfter).To(Equal(uint64(now.Unix())))
+			Expect(certificate.ValidBefore).To(Equal(uint64(now.Add(time.Hour).Unix())))
+			Expect(certificate.Signature.Format).To(BeElementOf(ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSASHA512))
+
+			caPublicKey, _, _, _, err := ssh.ParseAuthorizedKey(caSecretData[DataKeySSHAuthorizedKeys])
+			Expect(err).NotTo(HaveOccurred())
+
+			checker := &ssh.CertChecker{
+				IsUserAuthority: func(auth ssh.PublicKey) bool {
+					return bytes.Equal(auth.Marshal(), caPublicKey.Marshal())
+				},
+				Clock: func() time.Time { return now.Add(time.Minute) },
+			}
+			Expect(checker.CheckCert("gardener", certificate)).To(Succeed())
+			Expect(checker.CheckCert("root", certificate)).NotTo(Succeed())
+
+			checker.Clock = func() time.Time { return now.Add(2 * time.Hour) }
+			Expect(checker.CheckCert("gardener", certificate)).NotTo(Succeed())
+		})
+
+		It("should fail if the public key is invalid", func() {
+			config.PublicKey = []byte("foo")
+
+			_, err := SignSSHUserCertificate(caSecretData[DataKeyRSAPrivateKey], config)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing SSH public key")))
+		})
+
+		It("should fail if the private key of the certificate authority is invalid", func() {
+			_, err := SignSSHUserCertificate([]byte("foo"), config)
+			Expect(err).To(MatchError(ContainSubstring("failed decoding private key")))
+		})
+	})
+})
@@ -19,21 +19,37 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/utils/pointer"
 
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/apis/operations"
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
 	gardencoreclientset "github.com/gardener/gardener/pkg/client/core/clientset/internalversion"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
+	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/internalversion"
 	"github.com/gardener/gardener/pkg/utils/kubernetes"
 	plugin "github.com/gardener/gardener/plugin/pkg"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+const (
+	// AuditAnnotationKeyShoot is the key of the audit annotation which contains the Shoot targeted by a Bastion.
+	AuditAnnotationKeyShoot = "bastion.operations.gardener.cloud/shoot"
+	// AuditAnnotationKeyApprovedBy is the key of the audit annotation which contains the user approving a Bastion.
+	AuditAnnotationKeyApprovedBy = "bastion.operations.gardener.cloud/approved-by"
 )
 
 // Register registers a plugin.
@@ -46,12 +62,14 @@ func Register(plugins *admission.Plugins) {
 // Bastion contains listers and admission handler.
 type Bastion struct {
 	*admission.Handler
-	coreClient gardencoreclientset.Interface
-	readyFunc  admission.ReadyFunc
+	coreClient    gardencoreclientset.Interface
+	projectLister gardencorelisters.ProjectLister
+	readyFunc     admission.ReadyFunc
 }
 
 var (
 	_ = admissioninitializer.WantsInternalCoreClientset(&Bastion{})
+	_ = admissioninitializer.WantsInternalCoreInformerFactory(&Bastion{})
 
 	readyFuncs []admission.ReadyFunc
 )
@@ -74,11 +92,22 @@ func (v *Bastion) SetInternalCoreClientset(c gardencoreclientset.Interface) {
 	v.coreClient = c
 }
 
+// SetInternalCoreInformerFactory sets the internal garden core informer factory.
+func (v *Bastion) SetInternalCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	projectInformer := f.Core().InternalVersion().Projects()
+	v.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
 // ValidateInitialization checks whether the plugin was correctly initialized.
 func (v *Bastion) ValidateInitialization() error {
 	if v.coreClient == nil {
 		return errors.New("missing garden core client")
 	}
+	if v.projectLister == nil {
+		return errors.New("missing project lister")
+	}
 	return nil
 }
 
@@ -164,9 +193,112 @@ func (v *Bastion) Admit(ctx context.Context, a admission.Attributes, _ admission
 		metav1.SetMetaDataAnnotation(&bastion.ObjectMeta, v1beta1constants.GardenCreatedBy, userInfo.GetName())
 	}
 
+	switch a.GetOperation() {
+	case admission.Create:
+		if err := v.applyProjectSettings(bastion); err != nil {
+			return err
+		}
+
+		if err := a.AddAnnotation(AuditAnnotationKeyShoot, bastion.Namespace+"/"+shootName); err != nil {
+			return err
+		}
+
+	case admission.Update:
+		oldBastion, ok := a.GetOldObject().(*operations.Bastion)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old object to Bastion")
+		}
+
+		// the settings are populated from the project on creation and cannot be changed by users
+		bastion.Spec.ApprovalRequired = oldBastion.Spec.ApprovalRequired
+		bastion.Spec.MaxSessionDuration = oldBastion.Spec.MaxSessionDuration
+	}
+
+	if bastion.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationApprove {
+		if err := v.validateApproval(a, bastion); err != nil {
+			return err
+		}
+	}
+
 	// ensure bastions are cleaned up when shoots are deleted
 	ownerRef := *metav1.NewControllerRef(shoot, gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot"))
 	bastion.OwnerReferences = kubernetes.MergeOwnerReferences(bastion.OwnerReferences, ownerRef)
 
 	return nil
 }
+
+func (v *Bastion) applyProjectSettings(bastion *operations.Bastion) error {
+	project, err := admissionutils.ProjectForNamespaceFromInternalLister(v.projectLister, bastion.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not get project for namespace %s: %w", bastion.Namespace, err))
+	}
+
+	bastion.Spec.ApprovalRequired = nil
+	bastion.Spec.MaxSessionDuration = nil
+
+	if settings := project.Spec.Bastion; settings != nil {
+		if settings.RequireApproval {
+			bastion.Spec.ApprovalRequired = pointer.Bool(true)
+		}
+		if settings.MaxSessionDuration != nil {
+			bastion.Spec.MaxSessionDuration = settings.MaxSessionDuration.DeepCopy()
+		}
+	}
+
+	return nil
+}
+
+func (v *Bastion) validateApproval(a admission.Attributes, bastion *operations.Bastion) error {
+	if a.GetOperation() == admission.Create {
+		return admission.NewForbidden(a, errors.New("bastions cannot be approved on creation"))
+	}
+
+	if !pointer.BoolDeref(bastion.Spec.ApprovalRequired, false) {
+		return admission.NewForbidden(a, errors.New("bastion does not require an approval"))
+	}
+
+	userInfo := a.GetUserInfo()
+	if userInfo == nil {
+		return admission.NewForbidden(a, errors.New("bastions can only be approved by authenticated users"))
+	}
+
+	if userInfo.GetName() == bastion.Annotations[v1beta1constants.GardenCreatedBy] {
+		return admission.NewForbidden(a, errors.New("bastions cannot be approved by the user who created them"))
+	}
+
+	project, err := admissionutils.ProjectForNamespaceFromInternalLister(v.projectLister, bastion.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not get project for namespace %s: %w", bastion.Namespace, err))
+	}
+
+	if !isProjectAdmin(project, userInfo) {
+		return admission.NewForbidden(a, fmt.Errorf("bastions can only be approved by members of project %q having the %q role", project.Name, core.ProjectMemberAdmin))
+	}
+
+	return a.AddAnnotation(AuditAnnotationKeyApprovedBy, userInfo.GetName())
+}
+
+func isProjectAdmin(project *core.Project, userInfo user.Info) bool {
+	for _, member := range project.Spec.Members {
+		if !slices.Contains(member.Roles, core.ProjectMemberAdmin) {
+			continue
+		}
+
+		switch member.Kind {
+		case rbacv1.UserKind:
+			if member.Name == userInfo.GetName() {
+				return true
+			}
+		case rbacv1.GroupKind:
+			if slices.Contains(userInfo.GetGroups(), member.Name) {
+				return true
+			}
+		case rbacv1.ServiceAccountKind:
+			if serviceaccount.MakeUsername(member.Namespace, member.Name) == userInfo.GetName() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
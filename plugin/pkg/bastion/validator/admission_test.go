@@ -16,11 +16,13 @@ package validator_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -36,6 +38,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/operations"
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	corefake "github.com/gardener/gardener/pkg/client/core/clientset/internalversion/fake"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	. "github.com/gardener/gardener/plugin/pkg/bastion/validator"
 )
@@ -49,13 +52,28 @@ const (
 	provider    = "foo-provider"
 	region      = "foo-region"
 	userName    = "ginkgo"
+	approver    = "approver"
 )
 
+type annotationRecorder struct {
+	admission.Attributes
+	annotations map[string]string
+}
+
+func (a *annotationRecorder) AddAnnotation(key, value string) error {
+	if a.annotations == nil {
+		a.annotations = map[string]string{}
+	}
+	a.annotations[key] = value
+	return nil
+}
+
 var _ = Describe("Bastion", func() {
 	Describe("#Admit", func() {
 		var (
 			bastion          *operations.Bastion
 			shoot            *gardencore.Shoot
+			project          *gardencore.Project
 			coreClient       *corefake.Clientset
 			dummyOwnerRef    *metav1.OwnerReference
 			admissionHandler *Bastion
@@ -101,6 +119,33 @@ var _ = Describe("Bastion", func() {
 				},
 			}
 
+			project = &gardencore.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "project",
+				},
+				Spec: gardencore.ProjectSpec{
+					Namespace: pointer.String(namespace),
+					Members: []gardencore.ProjectMember{
+						{
+							Subject: rbacv1.Subject{Kind: rbacv1.UserKind, Name: userName},
+							Roles:   []string{gardencore.ProjectMemberAdmin},
+						},
+						{
+							Subject: rbacv1.Subject{Kind: rbacv1.UserKind, Name: approver},
+							Roles:   []string{gardencore.ProjectMemberAdmin},
+						},
+						{
+							Subject: rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "approvers"},
+							Roles:   []string{gardencore.ProjectMemberAdmin},
+						},
+						{
+							Subject: rbacv1.Subject{Kind: rbacv1.UserKind, Name: "viewer"},
+							Roles:   []string{gardencore.ProjectMemberViewer},
+						},
+					},
+				},
+			}
+
 			var err error
 			admissionHandler, err = New()
 			Expect(err).ToNot(HaveOccurred())
@@ -108,6 +153,10 @@ var _ = Describe("Bastion", func() {
 
 			coreClient = &corefake.Clientset{}
 			admissionHandler.SetInternalCoreClientset(coreClient)
+
+			coreInformerFactory := gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalCoreInformerFactory(coreInformerFactory)
+			Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(project)).To(Succeed())
 		})
 
 		It("should do nothing if the resource is not a Bastion", func() {
@@ -289,6 +338,115 @@ var _ = Describe("Bastion", func() {
 			err := admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, oldBastion, admission.Update), nil)
 			Expect(err).To(Succeed())
 		})
+
+		It("should record the target Shoot in the audit log", func() {
+			coreClient.AddReactor("get", "shoots", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, shoot, nil
+			})
+
+			attrs := &annotationRecorder{Attributes: getBastionAttributes(bastion, nil, admission.Create)}
+			Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+			Expect(attrs.annotations).To(HaveKeyWithValue(AuditAnnotationKeyShoot, namespace+"/"+shootName))
+		})
+
+		Context("project bastion settings", func() {
+			BeforeEach(func() {
+				coreClient.AddReactor("get", "shoots", func(action testing.Action) (bool, runtime.Object, error) {
+					return true, shoot, nil
+				})
+			})
+
+			It("should populate the settings from the project on creation", func() {
+				project.Spec.Bastion = &gardencore.ProjectBastionSettings{
+					RequireApproval:    true,
+					MaxSessionDuration: &metav1.Duration{Duration: time.Hour},
+				}
+
+				err := admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, nil, admission.Create), nil)
+				Expect(err).To(Succeed())
+				Expect(bastion.Spec.ApprovalRequired).To(PointTo(BeTrue()))
+				Expect(bastion.Spec.MaxSessionDuration).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			})
+
+			It("should overwrite settings given by the user on creation", func() {
+				bastion.Spec.ApprovalRequired = pointer.Bool(false)
+				bastion.Spec.MaxSessionDuration = &metav1.Duration{Duration: time.Hour}
+
+				err := admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, nil, admission.Create), nil)
+				Expect(err).To(Succeed())
+				Expect(bastion.Spec.ApprovalRequired).To(BeNil())
+				Expect(bastion.Spec.MaxSessionDuration).To(BeNil())
+			})
+
+			It("should keep the settings on updates", func() {
+				oldBastion := bastion.DeepCopy()
+				oldBastion.Spec.ApprovalRequired = pointer.Bool(true)
+				oldBastion.Spec.MaxSessionDuration = &metav1.Duration{Duration: time.Hour}
+
+				err := admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, oldBastion, admission.Update), nil)
+				Expect(err).To(Succeed())
+				Expect(bastion.Spec.ApprovalRequired).To(PointTo(BeTrue()))
+				Expect(bastion.Spec.MaxSessionDuration).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			})
+		})
+
+		Context("approval", func() {
+			var oldBastion *operations.Bastion
+
+			BeforeEach(func() {
+				coreClient.AddReactor("get", "shoots", func(action testing.Action) (bool, runtime.Object, error) {
+					return true, shoot, nil
+				})
+
+				bastion.Annotations = map[string]string{v1beta1constants.GardenCreatedBy: userName}
+				bastion.Spec.ApprovalRequired = pointer.Bool(true)
+				oldBastion = bastion.DeepCopy()
+				metav1.SetMetaDataAnnotation(&bastion.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationApprove)
+			})
+
+			It("should allow the approval by another admin of the project", func() {
+				attrs := &annotationRecorder{Attributes: getBastionAttributesForUser(bastion, oldBastion, admission.Update, &user.DefaultInfo{Name: approver})}
+				Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+				Expect(attrs.annotations).To(HaveKeyWithValue(AuditAnnotationKeyApprovedBy, approver))
+			})
+
+			It("should allow the approval by a member of an admin group of the project", func() {
+				attrs := getBastionAttributesForUser(bastion, oldBastion, admission.Update, &user.DefaultInfo{Name: "someone", Groups: []string{"approvers"}})
+				Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should forbid the approval by the creator of the Bastion", func() {
+				err := admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, oldBastion, admission.Update), nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("cannot be approved by the user who created them"))
+			})
+
+			It("should forbid the approval by project members without the admin role", func() {
+				attrs := getBastionAttributesForUser(bastion, oldBastion, admission.Update, &user.DefaultInfo{Name: "viewer"})
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("can only be approved by members of project"))
+			})
+
+			It("should forbid the approval if the Bastion does not require it", func() {
+				bastion.Spec.ApprovalRequired = nil
+				oldBastion.Spec.ApprovalRequired = nil
+
+				attrs := getBastionAttributesForUser(bastion, oldBastion, admission.Update, &user.DefaultInfo{Name: approver})
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("does not require an approval"))
+			})
+
+			It("should forbid the approval on creation", func() {
+				project.Spec.Bastion = &gardencore.ProjectBastionSettings{RequireApproval: true}
+
+				attrs := getBastionAttributesForUser(bastion, nil, admission.Create, &user.DefaultInfo{Name: approver})
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("cannot be approved on creation"))
+			})
+		})
 	})
 
 	Describe("#Register", func() {
@@ -324,6 +482,7 @@ var _ = Describe("Bastion", func() {
 		It("should not fail if the required clients are set", func() {
 			admissionHandler, _ := New()
 			admissionHandler.SetInternalCoreClientset(&corefake.Clientset{})
+			admissionHandler.SetInternalCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
 
 			err := admissionHandler.ValidateInitialization()
 			Expect(err).ToNot(HaveOccurred())
@@ -332,6 +491,10 @@ var _ = Describe("Bastion", func() {
 })
 
 func getBastionAttributes(bastion *operations.Bastion, oldBastion *operations.Bastion, op admission.Operation) admission.Attributes {
+	return getBastionAttributesForUser(bastion, oldBastion, op, &user.DefaultInfo{Name: userName})
+}
+
+func getBastionAttributesForUser(bastion *operations.Bastion, oldBastion *operations.Bastion, op admission.Operation, userInfo user.Info) admission.Attributes {
 	return admission.NewAttributesRecord(bastion,
 		oldBastion,
 		operationsv1alpha1.Kind("Bastion").WithVersion("v1alpha1"),
@@ -342,9 +505,7 @@ func getBastionAttributes(bastion *operations.Bastion, oldBastion *operations.Ba
 		op,
 		&metav1.CreateOptions{},
 		false,
-		&user.DefaultInfo{
-			Name: userName,
-		},
+		userInfo,
 	)
 }
 
@@ -38,6 +38,12 @@ const (
 	// CustomVerbModifyProjectTolerationsWhitelist is a constant for the custom verb that allows modifying the
 	// `.spec.tolerations.whitelist` field in `Project` resources.
 	CustomVerbModifyProjectTolerationsWhitelist = "modify-spec-tolerations-whitelist"
+	// CustomVerbModifyProjectQuota is a constant for the custom verb that allows modifying the `.spec.quota` field in
+	// `Project` resources.
+	CustomVerbModifyProjectQuota = "modify-spec-quota"
+	// CustomVerbModifyProjectRestrictions is a constant for the custom verb that allows modifying the
+	// `.spec.restrictions` field in `Project` resources.
+	CustomVerbModifyProjectRestrictions = "modify-spec-restrictions"
 	// CustomVerbProjectManageMembers is a constant for the custom verb that allows to manage human users or
 	// groups subjects in the `.spec.members` field in `Project` resources.
 	CustomVerbProjectManageMembers = "manage-members"
@@ -109,42 +115,72 @@ func (c *CustomVerbAuthorizer) admitProjects(ctx context.Context, a admission.At
 		}
 	}
 
-	if mustCheckProjectTolerationsWhitelist(oldObj.Spec.Tolerations, obj.Spec.Tolerations) {
-		return c.authorize(ctx, a, CustomVerbModifyProjectTolerationsWhitelist, "modify .spec.tolerations.whitelist")
+	// Every changed field is authorized separately, so that a permission for one of the custom verbs cannot be used to
+	// change fields protected by other custom verbs in the same request.
+	var forbiddenOperations []string
+	for _, check := range []struct {
+		required  bool
+		verb      string
+		operation string
+	}{
+		{
+			required:  mustCheckProjectTolerationsWhitelist(oldObj.Spec.Tolerations, obj.Spec.Tolerations),
+			verb:      CustomVerbModifyProjectTolerationsWhitelist,
+			operation: "modify .spec.tolerations.whitelist",
+		},
+		{
+			required:  !apiequality.Semantic.DeepEqual(oldObj.Spec.Quota, obj.Spec.Quota),
+			verb:      CustomVerbModifyProjectQuota,
+			operation: "modify .spec.quota",
+		},
+		{
+			required:  !apiequality.Semantic.DeepEqual(oldObj.Spec.Restrictions, obj.Spec.Restrictions),
+			verb:      CustomVerbModifyProjectRestrictions,
+			operation: "modify .spec.restrictions",
+		},
+		{
+			required:  mustCheckProjectMembers(oldObj.Spec.Members, obj.Spec.Members, obj.Spec.Owner, a.GetUserInfo()),
+			verb:      CustomVerbProjectManageMembers,
+			operation: "manage human users or groups in .spec.members",
+		},
+	} {
+		if !check.required {
+			continue
+		}
+
+		allowed, err := c.authorize(ctx, a, check.verb)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			forbiddenOperations = append(forbiddenOperations, check.operation)
+		}
 	}
 
-	if mustCheckProjectMembers(oldObj.Spec.Members, obj.Spec.Members, obj.Spec.Owner, a.GetUserInfo()) {
-		return c.authorize(ctx, a, CustomVerbProjectManageMembers, "manage human users or groups in .spec.members")
+	if len(forbiddenOperations) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("user %q is not allowed to %s for %q", a.GetUserInfo().GetName(), strings.Join(forbiddenOperations, ", "), a.GetResource().Resource))
 	}
 
 	return nil
 }
 
-func (c *CustomVerbAuthorizer) authorize(ctx context.Context, a admission.Attributes, verb, operation string) error {
-	var (
-		userInfo  = a.GetUserInfo()
-		resource  = a.GetResource()
-		namespace = a.GetNamespace()
-		name      = a.GetName()
-	)
+func (c *CustomVerbAuthorizer) authorize(ctx context.Context, a admission.Attributes, verb string) (bool, error) {
+	resource := a.GetResource()
 
 	decision, _, err := c.authorizer.Authorize(ctx, authorizer.AttributesRecord{
-		User:            userInfo,
+		User:            a.GetUserInfo(),
 		APIGroup:        resource.Group,
 		Resource:        resource.Resource,
-		Namespace:       namespace,
-		Name:            name,
+		Namespace:       a.GetNamespace(),
+		Name:            a.GetName(),
 		Verb:            verb,
 		ResourceRequest: true,
 	})
 	if err != nil {
-		return err
-	}
-	if decision != authorizer.DecisionAllow {
-		return admission.NewForbidden(a, fmt.Errorf("user %q is not allowed to %s for %q", userInfo.GetName(), operation, resource.Resource))
+		return false, err
 	}
 
-	return nil
+	return decision == authorizer.DecisionAllow, nil
 }
 
 func mustCheckProjectTolerationsWhitelist(oldTolerations, tolerations *core.ProjectTolerations) bool {
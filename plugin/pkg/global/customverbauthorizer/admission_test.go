@@ -20,15 +20,19 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/admission"
 	servieaccount "k8s.io/apiserver/pkg/authentication/serviceaccount"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	mockauthorizer "github.com/gardener/gardener/pkg/mock/apiserver/authorization/authorizer"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	. "github.com/gardener/gardener/plugin/pkg/global/customverbauthorizer"
 )
 
@@ -155,6 +159,161 @@ var _ = Describe("customverbauthorizer", func() {
 				})
 			})
 
+			Context("modify-spec-quota verb", func() {
+				BeforeEach(func() {
+					authorizeAttributes.Verb = CustomVerbModifyProjectQuota
+				})
+
+				It("should always allow creating a project without quota", func() {
+					attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should always allow updating a project without changing the quota", func() {
+					project.Spec.Quota = &core.ProjectQuota{Limits: corev1.ResourceList{"shoots": resource.MustParse("1")}}
+					oldProject := project.DeepCopy()
+					project.Spec.Purpose = pointer.String("foo")
+
+					attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				Describe("permissions granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+					})
+
+					It("should allow creating a project with quota", func() {
+						project.Spec.Quota = &core.ProjectQuota{Limits: corev1.ResourceList{"shoots": resource.MustParse("1")}}
+
+						attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+					})
+
+					It("should allow updating a project's quota", func() {
+						project.Spec.Quota = &core.ProjectQuota{Limits: corev1.ResourceList{"shoots": resource.MustParse("1")}}
+						oldProject := project.DeepCopy()
+						project.Spec.Quota.Limits["shoots"] = resource.MustParse("2")
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+					})
+				})
+
+				Describe("permissions not granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+					})
+
+					It("should forbid creating a project with quota", func() {
+						project.Spec.Quota = &core.ProjectQuota{Limits: corev1.ResourceList{"shoots": resource.MustParse("1")}}
+
+						attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+
+					It("should forbid updating a project's quota", func() {
+						project.Spec.Quota = &core.ProjectQuota{Limits: corev1.ResourceList{"shoots": resource.MustParse("1")}}
+						oldProject := project.DeepCopy()
+						project.Spec.Quota.Limits["shoots"] = resource.MustParse("2")
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+
+					It("should forbid removing a project's quota", func() {
+						project.Spec.Quota = &core.ProjectQuota{Limits: corev1.ResourceList{"shoots": resource.MustParse("1")}}
+						oldProject := project.DeepCopy()
+						project.Spec.Quota = nil
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+				})
+			})
+
+			Context("modify-spec-restrictions verb", func() {
+				BeforeEach(func() {
+					authorizeAttributes.Verb = CustomVerbModifyProjectRestrictions
+				})
+
+				It("should always allow updating a project without changing the restrictions", func() {
+					project.Spec.Restrictions = &core.ProjectRestrictions{MachineTypes: []string{"m5.large"}}
+					oldProject := project.DeepCopy()
+					project.Spec.Purpose = pointer.String("foo")
+
+					attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should allow updating a project's restrictions if permissions are granted", func() {
+					auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+
+					project.Spec.Restrictions = &core.ProjectRestrictions{MachineTypes: []string{"m5.large"}}
+					oldProject := project.DeepCopy()
+					project.Spec.Restrictions.MachineTypes = append(project.Spec.Restrictions.MachineTypes, "p3.2xlarge")
+
+					attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should forbid removing a project's restrictions if permissions are not granted", func() {
+					auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+
+					project.Spec.Restrictions = &core.ProjectRestrictions{MachineTypes: []string{"m5.large"}}
+					oldProject := project.DeepCopy()
+					project.Spec.Restrictions = nil
+
+					attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+				})
+			})
+
+			Context("multiple fields changed", func() {
+				var (
+					quotaAttributes, restrictionsAttributes authorizer.AttributesRecord
+					oldProject                              *core.Project
+				)
+
+				BeforeEach(func() {
+					quotaAttributes = authorizeAttributes
+					quotaAttributes.Verb = CustomVerbModifyProjectQuota
+					restrictionsAttributes = authorizeAttributes
+					restrictionsAttributes.Verb = CustomVerbModifyProjectRestrictions
+
+					oldProject = project.DeepCopy()
+					project.Spec.Quota = &core.ProjectQuota{Limits: corev1.ResourceList{"shoots": resource.MustParse("1")}}
+					project.Spec.Restrictions = &core.ProjectRestrictions{MachineTypes: []string{"m5.large"}}
+				})
+
+				It("should allow the update if all changed fields are permitted", func() {
+					auth.EXPECT().Authorize(ctx, quotaAttributes).Return(authorizer.DecisionAllow, "", nil)
+					auth.EXPECT().Authorize(ctx, restrictionsAttributes).Return(authorizer.DecisionAllow, "", nil)
+
+					attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should forbid the update if only some of the changed fields are permitted", func() {
+					auth.EXPECT().Authorize(ctx, quotaAttributes).Return(authorizer.DecisionAllow, "", nil)
+					auth.EXPECT().Authorize(ctx, restrictionsAttributes).Return(authorizer.DecisionDeny, "", nil)
+
+					attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					err := admissionHandler.Validate(context.TODO(), attrs, nil)
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("modify .spec.restrictions")))
+					Expect(err).NotTo(MatchError(ContainSubstring("modify .spec.quota")))
+				})
+
+				It("should report all changed fields which are not permitted", func() {
+					auth.EXPECT().Authorize(ctx, quotaAttributes).Return(authorizer.DecisionDeny, "", nil)
+					auth.EXPECT().Authorize(ctx, restrictionsAttributes).Return(authorizer.DecisionDeny, "", nil)
+
+					attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("modify .spec.quota, modify .spec.restrictions")))
+				})
+			})
+
 			Context("manage-members verb", func() {
 				BeforeEach(func() {
 					authorizeAttributes.Verb = CustomVerbProjectManageMembers
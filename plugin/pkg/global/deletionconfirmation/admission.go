@@ -17,13 +17,18 @@ package deletionconfirmation
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -34,6 +39,8 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	plugin "github.com/gardener/gardener/plugin/pkg"
+	"github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation/apis/deletionconfirmation"
+	"github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation/apis/deletionconfirmation/validation"
 )
 
 // Register registers a plugin.
@@ -42,13 +49,23 @@ func Register(plugins *admission.Plugins) {
 }
 
 // NewFactory creates a new PluginFactory.
-func NewFactory(_ io.Reader) (admission.Interface, error) {
-	return New()
+func NewFactory(cfg io.Reader) (admission.Interface, error) {
+	config, err := LoadConfiguration(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validation.ValidateConfiguration(config); err != nil {
+		return nil, fmt.Errorf("invalid config: %+v", err)
+	}
+
+	return New(config)
 }
 
 // DeletionConfirmation contains an admission handler and listers.
 type DeletionConfirmation struct {
 	*admission.Handler
+	config           *deletionconfirmation.Configuration
 	gardenCoreClient internalversion.Interface
 	shootLister      gardencorelisters.ShootLister
 	shootStateLister gardencorelisters.ShootStateLister
@@ -64,9 +81,10 @@ var (
 )
 
 // New creates a new DeletionConfirmation admission plugin.
-func New() (*DeletionConfirmation, error) {
+func New(config *deletionconfirmation.Configuration) (*DeletionConfirmation, error) {
 	return &DeletionConfirmation{
-		Handler: admission.NewHandler(admission.Delete),
+		Handler: admission.NewHandler(admission.Create, admission.Update, admission.Delete),
+		config:  config,
 	}, nil
 }
 
@@ -117,10 +135,80 @@ func (d *DeletionConfirmation) ValidateInitialization() error {
 	return nil
 }
 
-var _ admission.ValidationInterface = &DeletionConfirmation{}
+var (
+	_ admission.MutationInterface   = &DeletionConfirmation{}
+	_ admission.ValidationInterface = &DeletionConfirmation{}
+)
+
+// Admit records deletion approvals of Shoots and Projects and makes sure that recorded approvals cannot be forged.
+func (d *DeletionConfirmation) Admit(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if a.GetOperation() != admission.Create && a.GetOperation() != admission.Update {
+		return nil
+	}
+	if gk := a.GetKind().GroupKind(); gk != core.Kind("Shoot") && gk != core.Kind("Project") {
+		return nil
+	}
+
+	obj, ok := a.GetObject().(client.Object)
+	if !ok {
+		return apierrors.NewInternalError(fmt.Errorf("could not convert object to client.Object: %T", a.GetObject()))
+	}
+
+	var oldAnnotations map[string]string
+	if a.GetOperation() == admission.Update {
+		oldObj, ok := a.GetOldObject().(client.Object)
+		if !ok {
+			return apierrors.NewInternalError(fmt.Errorf("could not convert old object to client.Object: %T", a.GetOldObject()))
+		}
+		oldAnnotations = oldObj.GetAnnotations()
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	// The approval annotations are only maintained by this plugin, hence, changes by users are reverted.
+	for _, key := range []string{gardenerutils.DeletionApprovedBy, gardenerutils.DeletionApprovedAt} {
+		if value, ok := oldAnnotations[key]; ok {
+			annotations[key] = value
+		} else {
+			delete(annotations, key)
+		}
+	}
+
+	value, ok := annotations[gardenerutils.ApproveDeletion]
+	delete(annotations, gardenerutils.ApproveDeletion)
+	obj.SetAnnotations(annotations)
+
+	// Approvals can only be given via the main resource.
+	if !ok || a.GetSubresource() != "" {
+		return nil
+	}
+	if approved, err := strconv.ParseBool(value); err != nil || !approved {
+		return nil
+	}
+
+	if d.config == nil || d.config.DeletionProtection == nil {
+		return admission.NewForbidden(a, errors.New("deletion protection is not enabled, deletions do not need to be approved"))
+	}
+	if !isApprover(a.GetUserInfo(), d.config.DeletionProtection.ApproverGroups) {
+		return admission.NewForbidden(a, fmt.Errorf("user is not allowed to approve deletions, only members of the groups %v are allowed", d.config.DeletionProtection.ApproverGroups))
+	}
+
+	annotations[gardenerutils.DeletionApprovedBy] = a.GetUserInfo().GetName()
+	annotations[gardenerutils.DeletionApprovedAt] = gardenerutils.TimeNow().UTC().Format(time.RFC3339)
+	obj.SetAnnotations(annotations)
+
+	return nil
+}
 
 // Validate makes admissions decisions based on deletion confirmation annotation.
 func (d *DeletionConfirmation) Validate(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	if a.GetOperation() != admission.Delete {
+		return nil
+	}
+
 	var (
 		obj         client.Object
 		listFunc    func() ([]client.Object, error)
@@ -148,7 +236,20 @@ func (d *DeletionConfirmation) Validate(ctx context.Context, a admission.Attribu
 		liveLookup = func() (client.Object, error) {
 			return d.gardenCoreClient.Core().Shoots(a.GetNamespace()).Get(ctx, a.GetName(), kubernetes.DefaultGetOptions())
 		}
-		checkFunc = gardenerutils.CheckIfDeletionIsConfirmed
+		checkFunc = func(obj client.Object) error {
+			if err := gardenerutils.CheckIfDeletionIsConfirmed(obj); err != nil {
+				return err
+			}
+
+			shoot, ok := obj.(*core.Shoot)
+			if !ok {
+				return fmt.Errorf("could not convert object to Shoot: %T", obj)
+			}
+			if !d.isShootProtected(shoot) {
+				return nil
+			}
+			return d.checkIfDeletionIsApproved(obj, a.GetUserInfo())
+		}
 
 	case core.Kind("Project"):
 		listFunc = func() ([]client.Object, error) {
@@ -168,7 +269,16 @@ func (d *DeletionConfirmation) Validate(ctx context.Context, a admission.Attribu
 		liveLookup = func() (client.Object, error) {
 			return d.gardenCoreClient.Core().Projects().Get(ctx, a.GetName(), kubernetes.DefaultGetOptions())
 		}
-		checkFunc = gardenerutils.CheckIfDeletionIsConfirmed
+		checkFunc = func(obj client.Object) error {
+			if err := gardenerutils.CheckIfDeletionIsConfirmed(obj); err != nil {
+				return err
+			}
+
+			if d.config == nil || d.config.DeletionProtection == nil || !d.config.DeletionProtection.Projects {
+				return nil
+			}
+			return d.checkIfDeletionIsApproved(obj, a.GetUserInfo())
+		}
 
 	case core.Kind("ShootState"):
 		listFunc = func() ([]client.Object, error) {
@@ -276,3 +386,51 @@ func (d *DeletionConfirmation) Validate(ctx context.Context, a admission.Attribu
 	}
 	return nil
 }
+
+func (d *DeletionConfirmation) isShootProtected(shoot *core.Shoot) bool {
+	if d.config == nil || d.config.DeletionProtection == nil || shoot.Spec.Purpose == nil {
+		return false
+	}
+
+	for _, purpose := range d.config.DeletionProtection.ShootPurposes {
+		if *shoot.Spec.Purpose == purpose {
+			return true
+		}
+	}
+	return false
+}
+
+// checkIfDeletionIsApproved checks whether the deletion of the given object was approved by a user different from the
+// one performing the deletion and whether the approval is not yet expired.
+func (d *DeletionConfirmation) checkIfDeletionIsApproved(obj client.Object, userInfo user.Info) error {
+	var (
+		annotations = obj.GetAnnotations()
+		approvedBy  = annotations[gardenerutils.DeletionApprovedBy]
+	)
+
+	if approvedBy == "" {
+		return fmt.Errorf("deletion is protected and must be approved by a member of the groups %v via the %q annotation", d.config.DeletionProtection.ApproverGroups, gardenerutils.ApproveDeletion)
+	}
+
+	if userInfo != nil && approvedBy == userInfo.GetName() {
+		return fmt.Errorf("deletion was approved by %q, it must be approved by a different user than the one deleting", approvedBy)
+	}
+
+	approvedAt, err := time.Parse(time.RFC3339, annotations[gardenerutils.DeletionApprovedAt])
+	if err != nil {
+		return fmt.Errorf("failed parsing deletion approval time: %w", err)
+	}
+
+	if expiresAt := approvedAt.Add(d.config.DeletionProtection.ApprovalValidity.Duration); gardenerutils.TimeNow().After(expiresAt) {
+		return fmt.Errorf("deletion approval by %q expired at %s, it must be approved again", approvedBy, expiresAt.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func isApprover(userInfo user.Info, approverGroups []string) bool {
+	if userInfo == nil {
+		return false
+	}
+	return sets.New(userInfo.GetGroups()...).HasAny(approverGroups...)
+}
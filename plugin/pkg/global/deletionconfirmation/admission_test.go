@@ -17,12 +17,15 @@ package deletionconfirmation_test
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 
@@ -30,8 +33,10 @@ import (
 	internalClientSet "github.com/gardener/gardener/pkg/client/core/clientset/internalversion/fake"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	. "github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation"
+	"github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation/apis/deletionconfirmation"
 )
 
 var _ = Describe("deleteconfirmation", func() {
@@ -53,7 +58,7 @@ var _ = Describe("deleteconfirmation", func() {
 		)
 
 		BeforeEach(func() {
-			admissionHandler, _ = New()
+			admissionHandler, _ = New(&deletionconfirmation.Configuration{})
 			admissionHandler.AssignReadyFunc(func() bool { return true })
 
 			intCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
@@ -429,6 +434,201 @@ var _ = Describe("deleteconfirmation", func() {
 		})
 	})
 
+	Describe("deletion protection", func() {
+		var (
+			ctx = context.TODO()
+			now = time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+			shoot   *core.Shoot
+			project *core.Project
+
+			shootStore   cache.Store
+			projectStore cache.Store
+
+			admissionHandler *DeletionConfirmation
+
+			owner    = &user.DefaultInfo{Name: "owner", Groups: []string{"operators"}}
+			approver = &user.DefaultInfo{Name: "approver", Groups: []string{"approvers"}}
+		)
+
+		BeforeEach(func() {
+			DeferCleanup(test.WithVar(&gardenerutils.TimeNow, func() time.Time { return now }))
+
+			var err error
+			admissionHandler, err = New(&deletionconfirmation.Configuration{
+				DeletionProtection: &deletionconfirmation.DeletionProtection{
+					ShootPurposes:    []core.ShootPurpose{core.ShootPurposeProduction},
+					Projects:         true,
+					ApproverGroups:   []string{"approvers"},
+					ApprovalValidity: &metav1.Duration{Duration: time.Hour},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+
+			intCoreInformerFactory := gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalCoreInformerFactory(intCoreInformerFactory)
+			intGardenClient := &internalClientSet.Clientset{}
+			intGardenClient.AddReactor("get", "shoots", func(_ testing.Action) (bool, runtime.Object, error) {
+				return true, shoot, nil
+			})
+			intGardenClient.AddReactor("get", "projects", func(_ testing.Action) (bool, runtime.Object, error) {
+				return true, project, nil
+			})
+			admissionHandler.SetInternalCoreClientset(intGardenClient)
+
+			shootStore = intCoreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore()
+			projectStore = intCoreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore()
+
+			purpose := core.ShootPurposeProduction
+			shoot = &core.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "dummy",
+					Namespace:   "dummy",
+					Annotations: map[string]string{gardenerutils.ConfirmationDeletion: "true"},
+				},
+				Spec: core.ShootSpec{Purpose: &purpose},
+			}
+			project = &core.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "dummy",
+					Annotations: map[string]string{gardenerutils.ConfirmationDeletion: "true"},
+				},
+			}
+		})
+
+		Describe("#Admit", func() {
+			It("should record the approval of a member of the approver groups", func() {
+				newShoot := shoot.DeepCopy()
+				newShoot.Annotations[gardenerutils.ApproveDeletion] = "true"
+				attrs := admission.NewAttributesRecord(newShoot, shoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, approver)
+
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+				Expect(newShoot.Annotations).To(Equal(map[string]string{
+					gardenerutils.ConfirmationDeletion: "true",
+					gardenerutils.DeletionApprovedBy:   "approver",
+					gardenerutils.DeletionApprovedAt:   "2023-10-01T12:00:00Z",
+				}))
+			})
+
+			It("should forbid approvals of users which are not member of the approver groups", func() {
+				newProject := project.DeepCopy()
+				newProject.Annotations[gardenerutils.ApproveDeletion] = "true"
+				attrs := admission.NewAttributesRecord(newProject, project, core.Kind("Project").WithVersion("version"), "", project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, owner)
+
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(BeForbiddenError())
+			})
+
+			It("should forbid approvals if the deletion protection is disabled", func() {
+				admissionHandler, _ = New(&deletionconfirmation.Configuration{})
+
+				newShoot := shoot.DeepCopy()
+				newShoot.Annotations[gardenerutils.ApproveDeletion] = "true"
+				attrs := admission.NewAttributesRecord(newShoot, shoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, approver)
+
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(BeForbiddenError())
+			})
+
+			It("should revert changes of the approval annotations", func() {
+				shoot.Annotations[gardenerutils.DeletionApprovedBy] = "approver"
+				shoot.Annotations[gardenerutils.DeletionApprovedAt] = "2023-10-01T11:00:00Z"
+
+				newShoot := shoot.DeepCopy()
+				newShoot.Annotations[gardenerutils.DeletionApprovedBy] = "someone-else"
+				delete(newShoot.Annotations, gardenerutils.DeletionApprovedAt)
+				attrs := admission.NewAttributesRecord(newShoot, shoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, owner)
+
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+				Expect(newShoot.Annotations).To(Equal(shoot.Annotations))
+			})
+
+			It("should remove the approval annotations on creation", func() {
+				shoot.Annotations[gardenerutils.DeletionApprovedBy] = "approver"
+				shoot.Annotations[gardenerutils.DeletionApprovedAt] = "2023-10-01T11:00:00Z"
+				attrs := admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, owner)
+
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+				Expect(shoot.Annotations).To(Equal(map[string]string{gardenerutils.ConfirmationDeletion: "true"}))
+			})
+
+			It("should ignore approvals via subresources", func() {
+				newShoot := shoot.DeepCopy()
+				newShoot.Annotations[gardenerutils.ApproveDeletion] = "true"
+				attrs := admission.NewAttributesRecord(newShoot, shoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "status", admission.Update, &metav1.UpdateOptions{}, false, approver)
+
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+				Expect(newShoot.Annotations).To(Equal(map[string]string{gardenerutils.ConfirmationDeletion: "true"}))
+			})
+		})
+
+		Describe("#Validate", func() {
+			var (
+				shootAttrs   admission.Attributes
+				projectAttrs admission.Attributes
+			)
+
+			BeforeEach(func() {
+				shootAttrs = admission.NewAttributesRecord(nil, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Delete, &metav1.DeleteOptions{}, false, owner)
+				projectAttrs = admission.NewAttributesRecord(nil, nil, core.Kind("Project").WithVersion("version"), "", project.Name, core.Resource("projects").WithVersion("version"), "", admission.Delete, &metav1.DeleteOptions{}, false, owner)
+			})
+
+			approve := func(obj metav1.Object, by string, at time.Time) {
+				annotations := obj.GetAnnotations()
+				annotations[gardenerutils.DeletionApprovedBy] = by
+				annotations[gardenerutils.DeletionApprovedAt] = at.Format(time.RFC3339)
+				obj.SetAnnotations(annotations)
+			}
+
+			It("should allow deleting shoots with a purpose which is not protected", func() {
+				purpose := core.ShootPurposeEvaluation
+				shoot.Spec.Purpose = &purpose
+				Expect(shootStore.Add(shoot)).To(Succeed())
+
+				Expect(admissionHandler.Validate(ctx, shootAttrs, nil)).To(Succeed())
+			})
+
+			It("should forbid deleting protected shoots without approval", func() {
+				Expect(shootStore.Add(shoot)).To(Succeed())
+
+				Expect(admissionHandler.Validate(ctx, shootAttrs, nil)).To(MatchError(ContainSubstring("deletion is protected and must be approved")))
+			})
+
+			It("should forbid deleting protected shoots approved by the deleting user", func() {
+				approve(shoot, "owner", now.Add(-time.Minute))
+				Expect(shootStore.Add(shoot)).To(Succeed())
+
+				Expect(admissionHandler.Validate(ctx, shootAttrs, nil)).To(MatchError(ContainSubstring("must be approved by a different user")))
+			})
+
+			It("should forbid deleting protected shoots with an expired approval", func() {
+				approve(shoot, "approver", now.Add(-2*time.Hour))
+				Expect(shootStore.Add(shoot)).To(Succeed())
+
+				Expect(admissionHandler.Validate(ctx, shootAttrs, nil)).To(MatchError(ContainSubstring("expired at 2023-10-01T11:00:00Z")))
+			})
+
+			It("should allow deleting protected shoots with a valid approval", func() {
+				approve(shoot, "approver", now.Add(-time.Minute))
+				Expect(shootStore.Add(shoot)).To(Succeed())
+
+				Expect(admissionHandler.Validate(ctx, shootAttrs, nil)).To(Succeed())
+			})
+
+			It("should forbid deleting protected projects without approval", func() {
+				Expect(projectStore.Add(project)).To(Succeed())
+
+				Expect(admissionHandler.Validate(ctx, projectAttrs, nil)).To(BeForbiddenError())
+			})
+
+			It("should allow deleting protected projects with a valid approval", func() {
+				approve(project, "approver", now.Add(-time.Minute))
+				Expect(projectStore.Add(project)).To(Succeed())
+
+				Expect(admissionHandler.Validate(ctx, projectAttrs, nil)).To(Succeed())
+			})
+		})
+	})
+
 	Describe("#Register", func() {
 		It("should register the plugin", func() {
 			plugins := admission.NewPlugins()
@@ -447,15 +647,37 @@ var _ = Describe("deleteconfirmation", func() {
 			Expect(f).NotTo(BeNil())
 			Expect(err).ToNot(HaveOccurred())
 		})
+
+		It("should create a new PluginFactory with deletion protection", func() {
+			f, err := NewFactory(strings.NewReader(`apiVersion: deletionconfirmation.admission.gardener.cloud/v1alpha1
+kind: Configuration
+deletionProtection:
+  approverGroups:
+  - approvers
+`))
+
+			Expect(f).NotTo(BeNil())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail creating a new PluginFactory with invalid configuration", func() {
+			f, err := NewFactory(strings.NewReader(`apiVersion: deletionconfirmation.admission.gardener.cloud/v1alpha1
+kind: Configuration
+deletionProtection: {}
+`))
+
+			Expect(f).To(BeNil())
+			Expect(err).To(MatchError(ContainSubstring("invalid config")))
+		})
 	})
 
 	Describe("#New", func() {
-		It("should only handle DELETE operations", func() {
-			dr, err := New()
+		It("should only handle CREATE, UPDATE and DELETE operations", func() {
+			dr, err := New(&deletionconfirmation.Configuration{})
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(dr.Handles(admission.Create)).NotTo(BeTrue())
-			Expect(dr.Handles(admission.Update)).NotTo(BeTrue())
+			Expect(dr.Handles(admission.Create)).To(BeTrue())
+			Expect(dr.Handles(admission.Update)).To(BeTrue())
 			Expect(dr.Handles(admission.Connect)).NotTo(BeTrue())
 			Expect(dr.Handles(admission.Delete)).To(BeTrue())
 		})
@@ -463,7 +685,7 @@ var _ = Describe("deleteconfirmation", func() {
 
 	Describe("#ValidateInitialization", func() {
 		It("should return error if no ShootLister or ProjectLister is set", func() {
-			dr, _ := New()
+			dr, _ := New(&deletionconfirmation.Configuration{})
 
 			err := dr.ValidateInitialization()
 
@@ -471,7 +693,7 @@ var _ = Describe("deleteconfirmation", func() {
 		})
 
 		It("should not return error if lister and core clients are set", func() {
-			dr, _ := New()
+			dr, _ := New(&deletionconfirmation.Configuration{})
 			intGardenClient := &internalClientSet.Clientset{}
 			dr.SetInternalCoreClientset(intGardenClient)
 			dr.SetInternalCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
@@ -0,0 +1,48 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides configuration for the DeletionConfirmation admission controller.
+type Configuration struct {
+	metav1.TypeMeta `json:",inline"`
+	// DeletionProtection configures the optional deletion protection mode. If it is not set, only the deletion
+	// confirmation annotation is required for deleting resources.
+	// +optional
+	DeletionProtection *DeletionProtection `json:"deletionProtection,omitempty"`
+}
+
+// DeletionProtection contains the settings for the deletion protection mode. Protected resources can only be deleted
+// after a second user, different from the one issuing the deletion, has approved the deletion.
+type DeletionProtection struct {
+	// ShootPurposes is the list of purposes of Shoots which are protected. Defaults to `[production]`.
+	// +optional
+	ShootPurposes []gardencorev1beta1.ShootPurpose `json:"shootPurposes,omitempty"`
+	// Projects specifies whether Projects are protected.
+	// +optional
+	Projects bool `json:"projects,omitempty"`
+	// ApproverGroups is the list of groups whose members are allowed to approve deletions.
+	ApproverGroups []string `json:"approverGroups"`
+	// ApprovalValidity is the duration after which an approval expires. Defaults to `1h`.
+	// +optional
+	ApprovalValidity *metav1.Duration `json:"approvalValidity,omitempty"`
+}
@@ -0,0 +1,109 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	core "github.com/gardener/gardener/pkg/apis/core"
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	deletionconfirmation "github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation/apis/deletionconfirmation"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*Configuration)(nil), (*deletionconfirmation.Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Configuration_To_deletionconfirmation_Configuration(a.(*Configuration), b.(*deletionconfirmation.Configuration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*deletionconfirmation.Configuration)(nil), (*Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_deletionconfirmation_Configuration_To_v1alpha1_Configuration(a.(*deletionconfirmation.Configuration), b.(*Configuration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DeletionProtection)(nil), (*deletionconfirmation.DeletionProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeletionProtection_To_deletionconfirmation_DeletionProtection(a.(*DeletionProtection), b.(*deletionconfirmation.DeletionProtection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*deletionconfirmation.DeletionProtection)(nil), (*DeletionProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_deletionconfirmation_DeletionProtection_To_v1alpha1_DeletionProtection(a.(*deletionconfirmation.DeletionProtection), b.(*DeletionProtection), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_Configuration_To_deletionconfirmation_Configuration(in *Configuration, out *deletionconfirmation.Configuration, s conversion.Scope) error {
+	out.DeletionProtection = (*deletionconfirmation.DeletionProtection)(unsafe.Pointer(in.DeletionProtection))
+	return nil
+}
+
+// Convert_v1alpha1_Configuration_To_deletionconfirmation_Configuration is an autogenerated conversion function.
+func Convert_v1alpha1_Configuration_To_deletionconfirmation_Configuration(in *Configuration, out *deletionconfirmation.Configuration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Configuration_To_deletionconfirmation_Configuration(in, out, s)
+}
+
+func autoConvert_deletionconfirmation_Configuration_To_v1alpha1_Configuration(in *deletionconfirmation.Configuration, out *Configuration, s conversion.Scope) error {
+	out.DeletionProtection = (*DeletionProtection)(unsafe.Pointer(in.DeletionProtection))
+	return nil
+}
+
+// Convert_deletionconfirmation_Configuration_To_v1alpha1_Configuration is an autogenerated conversion function.
+func Convert_deletionconfirmation_Configuration_To_v1alpha1_Configuration(in *deletionconfirmation.Configuration, out *Configuration, s conversion.Scope) error {
+	return autoConvert_deletionconfirmation_Configuration_To_v1alpha1_Configuration(in, out, s)
+}
+
+func autoConvert_v1alpha1_DeletionProtection_To_deletionconfirmation_DeletionProtection(in *DeletionProtection, out *deletionconfirmation.DeletionProtection, s conversion.Scope) error {
+	out.ShootPurposes = *(*[]core.ShootPurpose)(unsafe.Pointer(&in.ShootPurposes))
+	out.Projects = in.Projects
+	out.ApproverGroups = *(*[]string)(unsafe.Pointer(&in.ApproverGroups))
+	out.ApprovalValidity = (*v1.Duration)(unsafe.Pointer(in.ApprovalValidity))
+	return nil
+}
+
+// Convert_v1alpha1_DeletionProtection_To_deletionconfirmation_DeletionProtection is an autogenerated conversion function.
+func Convert_v1alpha1_DeletionProtection_To_deletionconfirmation_DeletionProtection(in *DeletionProtection, out *deletionconfirmation.DeletionProtection, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeletionProtection_To_deletionconfirmation_DeletionProtection(in, out, s)
+}
+
+func autoConvert_deletionconfirmation_DeletionProtection_To_v1alpha1_DeletionProtection(in *deletionconfirmation.DeletionProtection, out *DeletionProtection, s conversion.Scope) error {
+	out.ShootPurposes = *(*[]v1beta1.ShootPurpose)(unsafe.Pointer(&in.ShootPurposes))
+	out.Projects = in.Projects
+	out.ApproverGroups = *(*[]string)(unsafe.Pointer(&in.ApproverGroups))
+	out.ApprovalValidity = (*v1.Duration)(unsafe.Pointer(in.ApprovalValidity))
+	return nil
+}
+
+// Convert_deletionconfirmation_DeletionProtection_To_v1alpha1_DeletionProtection is an autogenerated conversion function.
+func Convert_deletionconfirmation_DeletionProtection_To_v1alpha1_DeletionProtection(in *deletionconfirmation.DeletionProtection, out *DeletionProtection, s conversion.Scope) error {
+	return autoConvert_deletionconfirmation_DeletionProtection_To_v1alpha1_DeletionProtection(in, out, s)
+}
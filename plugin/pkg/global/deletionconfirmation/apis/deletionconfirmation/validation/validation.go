@@ -0,0 +1,73 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation/apis/deletionconfirmation"
+)
+
+var availableShootPurposes = sets.New(
+	string(core.ShootPurposeEvaluation),
+	string(core.ShootPurposeTesting),
+	string(core.ShootPurposeDevelopment),
+	string(core.ShootPurposeProduction),
+	string(core.ShootPurposeInfrastructure),
+)
+
+// ValidateConfiguration validates the configuration.
+func ValidateConfiguration(config *deletionconfirmation.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if config.DeletionProtection != nil {
+		allErrs = append(allErrs, validateDeletionProtection(config.DeletionProtection, field.NewPath("deletionProtection"))...)
+	}
+
+	return allErrs
+}
+
+func validateDeletionProtection(protection *deletionconfirmation.DeletionProtection, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	purposes := sets.New[string]()
+	for i, purpose := range protection.ShootPurposes {
+		idxPath := fldPath.Child("shootPurposes").Index(i)
+
+		if !availableShootPurposes.Has(string(purpose)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, purpose, sets.List(availableShootPurposes)))
+		} else if purposes.Has(string(purpose)) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, purpose))
+		}
+		purposes.Insert(string(purpose))
+	}
+
+	if len(protection.ApproverGroups) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("approverGroups"), "must specify at least one group whose members may approve deletions"))
+	}
+	for i, group := range protection.ApproverGroups {
+		if group == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("approverGroups").Index(i), group, "group name must not be empty"))
+		}
+	}
+
+	if protection.ApprovalValidity == nil || protection.ApprovalValidity.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("approvalValidity"), protection.ApprovalValidity, "must be a positive duration"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation/apis/deletionconfirmation"
+	. "github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation/apis/deletionconfirmation/validation"
+)
+
+var _ = Describe("Validation", func() {
+	Describe("#ValidateConfiguration", func() {
+		var config *deletionconfirmation.Configuration
+
+		BeforeEach(func() {
+			config = &deletionconfirmation.Configuration{}
+		})
+
+		It("should allow empty configuration", func() {
+			Expect(ValidateConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should allow valid deletion protection settings", func() {
+			config.DeletionProtection = &deletionconfirmation.DeletionProtection{
+				ShootPurposes:    []core.ShootPurpose{core.ShootPurposeProduction, core.ShootPurposeInfrastructure},
+				Projects:         true,
+				ApproverGroups:   []string{"approvers"},
+				ApprovalValidity: &metav1.Duration{Duration: time.Hour},
+			}
+
+			Expect(ValidateConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should forbid invalid deletion protection settings", func() {
+			config.DeletionProtection = &deletionconfirmation.DeletionProtection{
+				ShootPurposes:    []core.ShootPurpose{"foo", core.ShootPurposeProduction, core.ShootPurposeProduction},
+				ApproverGroups:   []string{""},
+				ApprovalValidity: &metav1.Duration{},
+			}
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("deletionProtection.shootPurposes[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("deletionProtection.shootPurposes[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("deletionProtection.approverGroups[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("deletionProtection.approvalValidity"),
+				})),
+			))
+		})
+
+		It("should require approver groups", func() {
+			config.DeletionProtection = &deletionconfirmation.DeletionProtection{
+				ApprovalValidity: &metav1.Duration{Duration: time.Hour},
+			}
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("deletionProtection.approverGroups"),
+				})),
+			))
+		})
+	})
+})
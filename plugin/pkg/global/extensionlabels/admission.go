@@ -268,6 +268,11 @@ func getEnabledExtensionsForShoot(shoot *core.Shoot, controllerRegistrations []*
 		enabledExtensions.Insert(extension.Type)
 	}
 
+	// add labels for extensions implementing hooks
+	for _, hook := range shoot.Spec.Hooks {
+		enabledExtensions.Insert(hook.Type)
+	}
+
 	return enabledExtensions
 }
 
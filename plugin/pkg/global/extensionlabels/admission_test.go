@@ -302,6 +302,15 @@ var _ = Describe("ExtensionLabels tests", func() {
 			Expect(shoot.ObjectMeta.Labels).To(Equal(expectedLabels))
 		})
 
+		It("should add labels for the types of hooks", func() {
+			shoot.Spec.Hooks = []core.Hook{{Name: "backup", Type: "hook-backup", Point: core.HookPointPreHibernation}}
+
+			attrs := admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("Shoot").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+			Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+
+			Expect(shoot.ObjectMeta.Labels).To(HaveKeyWithValue("extensions.extensions.gardener.cloud/hook-backup", "true"))
+		})
+
 		It("should add all the correct labels on update", func() {
 			worker := core.Worker{
 				Machine: core.Machine{
@@ -305,6 +305,10 @@ func (e *ExtensionValidator) validateShoot(kindToTypesMap map[string]sets.Set[st
 		requiredExtensions = append(requiredExtensions, requiredExtension{extensionsv1alpha1.ExtensionResource, extension.Type, fmt.Sprintf("extension type: %s", field.NewPath("spec", "extensions").Index(i).Child("type"))})
 	}
 
+	for i, hook := range spec.Hooks {
+		requiredExtensions = append(requiredExtensions, requiredExtension{extensionsv1alpha1.ExtensionResource, hook.Type, fmt.Sprintf("hook type: %s", field.NewPath("spec", "hooks").Index(i).Child("type"))})
+	}
+
 	for i, worker := range spec.Provider.Workers {
 		if worker.CRI != nil {
 			for j, cr := range worker.CRI.ContainerRuntimes {
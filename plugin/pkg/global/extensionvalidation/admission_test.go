@@ -295,6 +295,9 @@ var _ = Describe("ExtensionValidator", func() {
 					{Type: "foo1"},
 					{Type: "foo2"},
 				},
+				Hooks: []core.Hook{
+					{Name: "hook", Type: "foo7", Point: core.HookPointPreDeletion},
+				},
 				Networking: &core.Networking{
 					Type: pointer.String("foo3"),
 				},
@@ -329,6 +332,7 @@ var _ = Describe("ExtensionValidator", func() {
 				{extensionsv1alpha1.ControlPlaneResource, shoot.Spec.Provider.Type},
 				{extensionsv1alpha1.ExtensionResource, shoot.Spec.Extensions[0].Type},
 				{extensionsv1alpha1.ExtensionResource, shoot.Spec.Extensions[1].Type},
+				{extensionsv1alpha1.ExtensionResource, shoot.Spec.Hooks[0].Type},
 				{extensionsv1alpha1.InfrastructureResource, shoot.Spec.Provider.Type},
 				{extensionsv1alpha1.NetworkResource, *shoot.Spec.Networking.Type},
 				{extensionsv1alpha1.OperatingSystemConfigResource, shoot.Spec.Provider.Workers[0].Machine.Image.Name},
@@ -46,6 +46,8 @@ const (
 	PluginNameProjectValidator = "ProjectValidator"
 	// PluginNameSeedValidator is the name of the SeedValidator admission plugin.
 	PluginNameSeedValidator = "SeedValidator"
+	// PluginNameShootDefaults is the name of the ShootDefaults admission plugin.
+	PluginNameShootDefaults = "ShootDefaults"
 	// PluginNameShootDNS is the name of the ShootDNS admission plugin.
 	PluginNameShootDNS = "ShootDNS"
 	// PluginNameShootDNSRewriting is the name of the ShootDNSRewriting admission plugin.
@@ -60,6 +62,10 @@ const (
 	PluginNameClusterOpenIDConnectPreset = "ClusterOpenIDConnectPreset"
 	// PluginNameOpenIDConnectPreset is the name of the OpenIDConnectPreset admission plugin.
 	PluginNameOpenIDConnectPreset = "OpenIDConnectPreset"
+	// PluginNameShootPolicy is the name of the ShootPolicy admission plugin.
+	PluginNameShootPolicy = "ShootPolicy"
+	// PluginNameShootProjectRestrictions is the name of the ShootProjectRestrictions admission plugin.
+	PluginNameShootProjectRestrictions = "ShootProjectRestrictions"
 	// PluginNameShootQuotaValidator is the name of the ShootQuotaValidator admission plugin.
 	PluginNameShootQuotaValidator = "ShootQuotaValidator"
 	// PluginNameShootTolerationRestriction is the name of the ShootTolerationRestriction admission plugin.
@@ -75,6 +81,7 @@ func AllPluginNames() []string {
 	return []string{
 		lifecycle.PluginName,                        // NamespaceLifecycle
 		PluginNameResourceReferenceManager,          // ResourceReferenceManager
+		PluginNameShootDefaults,                     // ShootDefaults
 		PluginNameExtensionValidator,                // ExtensionValidator
 		PluginNameExtensionLabels,                   // ExtensionLabels
 		PluginNameShootTolerationRestriction,        // ShootTolerationRestriction
@@ -84,6 +91,8 @@ func AllPluginNames() []string {
 		PluginNameShootNodeLocalDNSEnabledByDefault, // ShootNodeLocalDNSEnabledByDefault
 		PluginNameShootDNSRewriting,                 // ShootDNSRewriting
 		PluginNameShootQuotaValidator,               // ShootQuotaValidator
+		PluginNameShootProjectRestrictions,          // ShootProjectRestrictions
+		PluginNameShootPolicy,                       // ShootPolicy
 		PluginNameShootValidator,                    // ShootValidator
 		PluginNameSeedValidator,                     // SeedValidator
 		PluginNameControllerRegistrationResources,   // ControllerRegistrationResources
@@ -115,6 +124,7 @@ func DefaultOnPlugins() sets.Set[string] {
 	return sets.New[string](
 		lifecycle.PluginName,                      // NamespaceLifecycle
 		PluginNameResourceReferenceManager,        // ResourceReferenceManager
+		PluginNameShootDefaults,                   // ShootDefaults
 		PluginNameExtensionValidator,              // ExtensionValidator
 		PluginNameExtensionLabels,                 // ExtensionLabels
 		PluginNameShootTolerationRestriction,      // ShootTolerationRestriction
@@ -122,6 +132,8 @@ func DefaultOnPlugins() sets.Set[string] {
 		PluginNameShootDNS,                        // ShootDNS
 		PluginNameShootManagedSeed,                // ShootManagedSeed
 		PluginNameShootQuotaValidator,             // ShootQuotaValidator
+		PluginNameShootProjectRestrictions,        // ShootProjectRestrictions
+		PluginNameShootPolicy,                     // ShootPolicy
 		PluginNameShootValidator,                  // ShootValidator
 		PluginNameSeedValidator,                   // SeedValidator
 		PluginNameControllerRegistrationResources, // ControllerRegistrationResources
@@ -18,10 +18,15 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/apiserver/pkg/authentication/user"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -43,7 +48,7 @@ type handler struct {
 // New creates a new handler admission plugin.
 func New() (*handler, error) {
 	return &handler{
-		Handler: admission.NewHandler(admission.Create),
+		Handler: admission.NewHandler(admission.Create, admission.Update),
 	}, nil
 }
 
@@ -66,10 +71,52 @@ func (v *handler) Validate(_ context.Context, a admission.Attributes, _ admissio
 		return apierrors.NewBadRequest("could not convert object to Project")
 	}
 
-	// TODO: Remove this admission plugin in favor of static validation in a future release, see https://github.com/gardener/gardener/pull/4228.
-	if project.Spec.Namespace != nil && *project.Spec.Namespace != v1beta1constants.GardenNamespace && !strings.HasPrefix(*project.Spec.Namespace, gardenerutils.ProjectNamespacePrefix) {
-		return admission.NewForbidden(a, fmt.Errorf(".spec.namespace must start with %s", gardenerutils.ProjectNamespacePrefix))
+	if a.GetOperation() == admission.Create {
+		// TODO: Remove this check in favor of static validation in a future release, see https://github.com/gardener/gardener/pull/4228.
+		if project.Spec.Namespace != nil && *project.Spec.Namespace != v1beta1constants.GardenNamespace && !strings.HasPrefix(*project.Spec.Namespace, gardenerutils.ProjectNamespacePrefix) {
+			return admission.NewForbidden(a, fmt.Errorf(".spec.namespace must start with %s", gardenerutils.ProjectNamespacePrefix))
+		}
+	}
+
+	var oldProject *gardencore.Project
+	if a.GetOperation() == admission.Update {
+		if oldProject, ok = a.GetOldObject().(*gardencore.Project); !ok {
+			return apierrors.NewBadRequest("could not convert old object to Project")
+		}
+	}
+
+	// Only the owner of the project may confirm the deletion of the project by the stale project controller, and only
+	// while the project is stale.
+	if staleDeletionConfirmed(project) && (oldProject == nil || !staleDeletionConfirmed(oldProject)) {
+		if !isOwner(a.GetUserInfo(), project.Spec.Owner) {
+			return admission.NewForbidden(a, fmt.Errorf("only the owner of the project may set the %q annotation", v1beta1constants.ProjectConfirmStaleDeletion))
+		}
+		if oldProject == nil || oldProject.Status.StaleSinceTimestamp == nil {
+			return admission.NewForbidden(a, fmt.Errorf("the %q annotation may only be set for stale projects", v1beta1constants.ProjectConfirmStaleDeletion))
+		}
 	}
 
 	return nil
 }
+
+func staleDeletionConfirmed(project *gardencore.Project) bool {
+	confirmed, _ := strconv.ParseBool(project.Annotations[v1beta1constants.ProjectConfirmStaleDeletion])
+	return confirmed
+}
+
+func isOwner(userInfo user.Info, owner *rbacv1.Subject) bool {
+	if userInfo == nil || owner == nil {
+		return false
+	}
+
+	switch owner.Kind {
+	case rbacv1.ServiceAccountKind:
+		return serviceaccount.MakeUsername(owner.Namespace, owner.Name) == userInfo.GetName()
+	case rbacv1.UserKind:
+		return owner.Name == userInfo.GetName()
+	case rbacv1.GroupKind:
+		return sets.New(userInfo.GetGroups()...).Has(owner.Name)
+	}
+
+	return false
+}
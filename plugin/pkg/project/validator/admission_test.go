@@ -16,11 +16,14 @@ package validator_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -81,6 +84,76 @@ var _ = Describe("Admission", func() {
 
 			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring(".spec.namespace must start with garden-")))
 		})
+
+		Context("stale deletion confirmation", func() {
+			var (
+				oldProject *core.Project
+				owner      = &user.DefaultInfo{Name: "owner", Groups: []string{"owners"}}
+				member     = &user.DefaultInfo{Name: "member"}
+			)
+
+			BeforeEach(func() {
+				project.Spec.Namespace = &namespaceName
+				project.Spec.Owner = &rbacv1.Subject{Kind: rbacv1.UserKind, Name: owner.Name}
+				project.Status.StaleSinceTimestamp = &metav1.Time{Time: time.Now()}
+				oldProject = project.DeepCopy()
+				project.Annotations = map[string]string{v1beta1constants.ProjectConfirmStaleDeletion: "true"}
+			})
+
+			It("should allow the owner to confirm the deletion", func() {
+				attrs := admission.NewAttributesRecord(&project, oldProject, core.Kind("Project").WithVersion("version"), "", project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, owner)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should allow members of the owner group to confirm the deletion", func() {
+				project.Spec.Owner = &rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "owners"}
+				attrs := admission.NewAttributesRecord(&project, oldProject, core.Kind("Project").WithVersion("version"), "", project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, owner)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should prevent other users from confirming the deletion", func() {
+				attrs := admission.NewAttributesRecord(&project, oldProject, core.Kind("Project").WithVersion("version"), "", project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, member)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("only the owner of the project may set")))
+			})
+
+			It("should prevent other users from creating a project with confirmed deletion", func() {
+				attrs := admission.NewAttributesRecord(&project, nil, core.Kind("Project").WithVersion("version"), "", project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, member)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("only the owner of the project may set")))
+			})
+
+			It("should prevent the owner from confirming the deletion of a project which is not stale", func() {
+				oldProject.Status.StaleSinceTimestamp = nil
+				attrs := admission.NewAttributesRecord(&project, oldProject, core.Kind("Project").WithVersion("version"), "", project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, owner)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("may only be set for stale projects")))
+			})
+
+			It("should prevent the owner from creating a project with confirmed deletion", func() {
+				attrs := admission.NewAttributesRecord(&project, nil, core.Kind("Project").WithVersion("version"), "", project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, owner)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("may only be set for stale projects")))
+			})
+
+			It("should allow other users to update a project whose deletion is already confirmed", func() {
+				oldProject.Annotations = project.Annotations
+				project.Spec.Description = pointer.String("foo")
+				attrs := admission.NewAttributesRecord(&project, oldProject, core.Kind("Project").WithVersion("version"), "", project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, member)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should allow other users to revoke the confirmation", func() {
+				oldProject.Annotations = project.Annotations
+				project.Annotations = nil
+				attrs := admission.NewAttributesRecord(&project, oldProject, core.Kind("Project").WithVersion("version"), "", project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, member)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+		})
 	})
 
 	Describe("#Register", func() {
@@ -95,11 +168,11 @@ var _ = Describe("Admission", func() {
 	})
 
 	Describe("#New", func() {
-		It("should only handle CREATE operations", func() {
+		It("should only handle CREATE and UPDATE operations", func() {
 			dr, err := New()
 			Expect(err).ToNot(HaveOccurred())
 			Expect(dr.Handles(admission.Create)).To(BeTrue())
-			Expect(dr.Handles(admission.Update)).To(BeFalse())
+			Expect(dr.Handles(admission.Update)).To(BeTrue())
 			Expect(dr.Handles(admission.Connect)).To(BeFalse())
 			Expect(dr.Handles(admission.Delete)).To(BeFalse())
 		})
@@ -0,0 +1,382 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+
+	apishoot "github.com/gardener/gardener/pkg/api/core/shoot"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
+	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/internalversion"
+	settingsinformers "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	settingsv1alpha1lister "github.com/gardener/gardener/pkg/client/settings/listers/settings/v1alpha1"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+// AnnotationAppliedDefaults is the key of the Shoot annotation which lists the ShootDefaults and ClusterShootDefaults
+// that contributed values to the Shoot.
+const AnnotationAppliedDefaults = "shootdefaults.settings.gardener.cloud/applied"
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootDefaults, func(_ io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ShootDefaults contains listers and admission handler.
+type ShootDefaults struct {
+	*admission.Handler
+
+	projectLister              gardencorelisters.ProjectLister
+	shootDefaultsLister        settingsv1alpha1lister.ShootDefaultsLister
+	clusterShootDefaultsLister settingsv1alpha1lister.ClusterShootDefaultsLister
+	readyFunc                  admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsInternalCoreInformerFactory(&ShootDefaults{})
+	_ = admissioninitializer.WantsSettingsInformerFactory(&ShootDefaults{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new ShootDefaults admission plugin.
+func New() (*ShootDefaults, error) {
+	return &ShootDefaults{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (s *ShootDefaults) AssignReadyFunc(f admission.ReadyFunc) {
+	s.readyFunc = f
+	s.SetReadyFunc(f)
+}
+
+// SetInternalCoreInformerFactory gets Lister from SharedInformerFactory.
+func (s *ShootDefaults) SetInternalCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	projectInformer := f.Core().InternalVersion().Projects()
+	s.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
+// SetSettingsInformerFactory gets Lister from SharedInformerFactory.
+func (s *ShootDefaults) SetSettingsInformerFactory(f settingsinformers.SharedInformerFactory) {
+	shootDefaultsInformer := f.Settings().V1alpha1().ShootDefaults()
+	s.shootDefaultsLister = shootDefaultsInformer.Lister()
+
+	clusterShootDefaultsInformer := f.Settings().V1alpha1().ClusterShootDefaults()
+	s.clusterShootDefaultsLister = clusterShootDefaultsInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootDefaultsInformer.Informer().HasSynced, clusterShootDefaultsInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (s *ShootDefaults) ValidateInitialization() error {
+	if s.projectLister == nil {
+		return errors.New("missing project lister")
+	}
+	if s.shootDefaultsLister == nil {
+		return errors.New("missing shootdefaults lister")
+	}
+	if s.clusterShootDefaultsLister == nil {
+		return errors.New("missing clustershootdefaults lister")
+	}
+	return nil
+}
+
+var _ admission.MutationInterface = &ShootDefaults{}
+
+type defaults struct {
+	kind     string
+	name     string
+	weight   int32
+	template settingsv1alpha1.ShootDefaultsTemplate
+}
+
+// Admit merges the templates of all ShootDefaults and ClusterShootDefaults matching the Shoot into it. Only fields which
+// are not yet set are defaulted, hence the values of the Shoot always win. ShootDefaults take precedence over
+// ClusterShootDefaults, and within both kinds defaults with a higher weight take precedence over those with a lower
+// weight. Ties are broken by the name in ascending order. Afterwards, the fields which are not defaulted by the API
+// defaulting are defaulted.
+func (s *ShootDefaults) Admit(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	// Wait until the caches have been synced
+	if s.readyFunc == nil {
+		s.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !s.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than Shoot
+	// Ignore all subresource calls
+	// Ignore all operations other than CREATE
+	if len(a.GetSubresource()) != 0 || a.GetKind().GroupKind() != core.Kind("Shoot") || a.GetOperation() != admission.Create {
+		return nil
+	}
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	matchingDefaults, err := s.matchingDefaults(shoot)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	var applied []string
+	for _, d := range matchingDefaults {
+		template := &settings.ShootDefaultsTemplate{}
+		if err := settingsv1alpha1.Convert_v1alpha1_ShootDefaultsTemplate_To_settings_ShootDefaultsTemplate(&d.template, template, nil); err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("could not convert template of %s %q: %w", d.kind, d.name, err))
+		}
+
+		if applyTemplate(shoot, template) {
+			applied = append(applied, d.kind+"/"+d.name)
+		}
+	}
+
+	if len(applied) > 0 {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, AnnotationAppliedDefaults, strings.Join(applied, ","))
+	}
+
+	// Fields which are not defaulted by the API defaulting (e.g., the maintenance time window) fall back to their
+	// default values only if no template provided them. This is done here already so that the subsequent admission
+	// plugins observe the defaulted Shoot.
+	apishoot.SetDefaults(shoot, nil)
+
+	return nil
+}
+
+func (s *ShootDefaults) matchingDefaults(shoot *core.Shoot) ([]defaults, error) {
+	var namespaced, cluster []defaults
+
+	shootDefaults, err := s.shootDefaultsLister.ShootDefaults(shoot.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("could not list existing shootdefaults: %w", err)
+	}
+
+	for _, obj := range shootDefaults {
+		matches, err := selectorMatches(obj.Spec.ShootSelector, shoot.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("label selector conversion failed for shootSelector of shootdefaults %q: %w", obj.Name, err)
+		}
+		if matches {
+			namespaced = append(namespaced, defaults{kind: "ShootDefaults", name: obj.Name, weight: obj.Spec.Weight, template: obj.Spec.Template})
+		}
+	}
+
+	clusterShootDefaults, err := s.clusterShootDefaultsLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("could not list existing clustershootdefaults: %w", err)
+	}
+
+	if len(clusterShootDefaults) > 0 {
+		project, err := admissionutils.ProjectForNamespaceFromInternalLister(s.projectLister, shoot.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("could not find project for namespace %q: %w", shoot.Namespace, err)
+		}
+
+		for _, obj := range clusterShootDefaults {
+			projectMatches, err := selectorMatches(obj.Spec.ProjectSelector, project.Labels)
+			if err != nil {
+				return nil, fmt.Errorf("label selector conversion failed for projectSelector of clustershootdefaults %q: %w", obj.Name, err)
+			}
+			shootMatches, err := selectorMatches(obj.Spec.ShootSelector, shoot.Labels)
+			if err != nil {
+				return nil, fmt.Errorf("label selector conversion failed for shootSelector of clustershootdefaults %q: %w", obj.Name, err)
+			}
+			if projectMatches && shootMatches {
+				cluster = append(cluster, defaults{kind: "ClusterShootDefaults", name: obj.Name, weight: obj.Spec.Weight, template: obj.Spec.Template})
+			}
+		}
+	}
+
+	sortByPrecedence(namespaced)
+	sortByPrecedence(cluster)
+
+	return append(namespaced, cluster...), nil
+}
+
+func sortByPrecedence(d []defaults) {
+	sort.SliceStable(d, func(i, j int) bool {
+		if d[i].weight != d[j].weight {
+			return d[i].weight > d[j].weight
+		}
+		return d[i].name < d[j].name
+	})
+}
+
+func selectorMatches(selector *metav1.LabelSelector, objLabels map[string]string) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, err
+	}
+
+	return labelSelector.Matches(labels.Set(objLabels)), nil
+}
+
+// applyTemplate applies the given template to the Shoot and returns whether at least one value was defaulted.
+func applyTemplate(shoot *core.Shoot, template *settings.ShootDefaultsTemplate) bool {
+	var applied bool
+
+	if template.Kubelet != nil {
+		if shoot.Spec.Kubernetes.Kubelet == nil {
+			shoot.Spec.Kubernetes.Kubelet = template.Kubelet.DeepCopy()
+			applied = true
+		} else if setUnsetFields(reflect.ValueOf(shoot.Spec.Kubernetes.Kubelet).Elem(), reflect.ValueOf(template.Kubelet.DeepCopy()).Elem()) {
+			applied = true
+		}
+	}
+
+	if template.MaintenanceTimeWindow != nil {
+		if shoot.Spec.Maintenance == nil {
+			shoot.Spec.Maintenance = &core.Maintenance{}
+		}
+		if shoot.Spec.Maintenance.TimeWindow == nil {
+			shoot.Spec.Maintenance.TimeWindow = template.MaintenanceTimeWindow.DeepCopy()
+			applied = true
+		}
+	}
+
+	if len(template.HibernationSchedules) > 0 {
+		if shoot.Spec.Hibernation == nil {
+			shoot.Spec.Hibernation = &core.Hibernation{}
+		}
+		if len(shoot.Spec.Hibernation.Schedules) == 0 {
+			for _, schedule := range template.HibernationSchedules {
+				shoot.Spec.Hibernation.Schedules = append(shoot.Spec.Hibernation.Schedules, *schedule.DeepCopy())
+			}
+			applied = true
+		}
+	}
+
+	for _, extension := range template.Extensions {
+		if !hasExtension(shoot.Spec.Extensions, extension.Type) {
+			shoot.Spec.Extensions = append(shoot.Spec.Extensions, *extension.DeepCopy())
+			applied = true
+		}
+	}
+
+	if template.Worker != nil {
+		for i := range shoot.Spec.Provider.Workers {
+			if applyWorkerDefaults(&shoot.Spec.Provider.Workers[i], template.Worker) {
+				applied = true
+			}
+		}
+	}
+
+	return applied
+}
+
+func applyWorkerDefaults(worker *core.Worker, defaults *settings.ShootDefaultsWorker) bool {
+	var applied bool
+
+	if defaults.MachineImage != nil && worker.Machine.Image == nil {
+		worker.Machine.Image = defaults.MachineImage.DeepCopy()
+		applied = true
+	}
+
+	for key, value := range defaults.Labels {
+		if _, ok := worker.Labels[key]; !ok {
+			if worker.Labels == nil {
+				worker.Labels = make(map[string]string)
+			}
+			worker.Labels[key] = value
+			applied = true
+		}
+	}
+
+	for _, taint := range defaults.Taints {
+		if !hasTaint(worker.Taints, taint.Key) {
+			worker.Taints = append(worker.Taints, *taint.DeepCopy())
+			applied = true
+		}
+	}
+
+	return applied
+}
+
+func hasExtension(extensions []core.Extension, extensionType string) bool {
+	for _, extension := range extensions {
+		if extension.Type == extensionType {
+			return true
+		}
+	}
+	return false
+}
+
+func hasTaint(taints []corev1.Taint, key string) bool {
+	for _, taint := range taints {
+		if taint.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// setUnsetFields sets all top-level fields of the struct value dst which are not yet set to the respective value of
+// src. Fields of embedded structs are treated like top-level fields. It returns whether at least one field was set.
+func setUnsetFields(dst, src reflect.Value) bool {
+	var set bool
+
+	for i := 0; i < dst.NumField(); i++ {
+		dstField, srcField := dst.Field(i), src.Field(i)
+
+		if dst.Type().Field(i).Anonymous && dstField.Kind() == reflect.Struct {
+			if setUnsetFields(dstField, srcField) {
+				set = true
+			}
+			continue
+		}
+
+		if dstField.IsZero() && !srcField.IsZero() {
+			dstField.Set(srcField)
+			set = true
+		}
+	}
+
+	return set
+}
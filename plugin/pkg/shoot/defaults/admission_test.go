@@ -0,0 +1,364 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/pointer"
+
+	apishoot "github.com/gardener/gardener/pkg/api/core/shoot"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
+	settingsinformers "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/defaults"
+)
+
+var _ = Describe("ShootDefaults", func() {
+	Describe("#Admit", func() {
+		var (
+			ctx                     = context.TODO()
+			admissionHandler        *ShootDefaults
+			coreInformerFactory     gardencoreinformers.SharedInformerFactory
+			settingsInformerFactory settingsinformers.SharedInformerFactory
+
+			namespace = "garden-foo"
+			shoot     *core.Shoot
+			project   *core.Project
+
+			shootDefaults        *settingsv1alpha1.ShootDefaults
+			clusterShootDefaults *settingsv1alpha1.ClusterShootDefaults
+
+			kubeReservedCPU = resource.MustParse("100m")
+		)
+
+		BeforeEach(func() {
+			shoot = &core.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: namespace,
+					Labels:    map[string]string{"env": "prod"},
+				},
+				Spec: core.ShootSpec{
+					Kubernetes: core.Kubernetes{
+						Version: "1.27.3",
+					},
+					Provider: core.Provider{
+						Workers: []core.Worker{
+							{Name: "worker1"},
+							{
+								Name:    "worker2",
+								Machine: core.Machine{Image: &core.ShootMachineImage{Name: "ubuntu"}},
+								Labels:  map[string]string{"team": "ops"},
+								Taints:  []corev1.Taint{{Key: "dedicated", Value: "ops", Effect: corev1.TaintEffectNoExecute}},
+							},
+						},
+					},
+				},
+			}
+
+			project = &core.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "foo",
+					Labels: map[string]string{"tier": "gold"},
+				},
+				Spec: core.ProjectSpec{
+					Namespace: pointer.String(namespace),
+				},
+			}
+
+			shootDefaults = &settingsv1alpha1.ShootDefaults{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "defaults",
+					Namespace: namespace,
+				},
+				Spec: settingsv1alpha1.ShootDefaultsSpec{
+					ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+					Weight:        10,
+					Template: settingsv1alpha1.ShootDefaultsTemplate{
+						Kubelet: &gardencorev1beta1.KubeletConfig{
+							MaxPods:      pointer.Int32(200),
+							PodPIDsLimit: pointer.Int64(1000),
+						},
+						MaintenanceTimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+						HibernationSchedules:  []gardencorev1beta1.HibernationSchedule{{Start: pointer.String("00 20 * * 1,2,3,4,5")}},
+						Extensions:            []gardencorev1beta1.Extension{{Type: "shoot-dns-service"}},
+						Worker: &settingsv1alpha1.ShootDefaultsWorker{
+							MachineImage: &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: pointer.String("1.0.0")},
+							Labels:       map[string]string{"team": "dev", "cost-center": "123"},
+							Taints:       []corev1.Taint{{Key: "dedicated", Value: "dev", Effect: corev1.TaintEffectNoSchedule}},
+						},
+					},
+				},
+			}
+
+			clusterShootDefaults = &settingsv1alpha1.ClusterShootDefaults{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-defaults",
+				},
+				Spec: settingsv1alpha1.ClusterShootDefaultsSpec{
+					ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}},
+					ShootDefaultsSpec: settingsv1alpha1.ShootDefaultsSpec{
+						ShootSelector: &metav1.LabelSelector{},
+						Weight:        100,
+						Template: settingsv1alpha1.ShootDefaultsTemplate{
+							Kubelet: &gardencorev1beta1.KubeletConfig{
+								MaxPods:     pointer.Int32(110),
+								CPUCFSQuota: pointer.Bool(false),
+							},
+							MaintenanceTimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"},
+							Extensions:            []gardencorev1beta1.Extension{{Type: "shoot-dns-service", Disabled: pointer.Bool(true)}, {Type: "shoot-cert-service"}},
+						},
+					},
+				},
+			}
+
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			coreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalCoreInformerFactory(coreInformerFactory)
+			settingsInformerFactory = settingsinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetSettingsInformerFactory(settingsInformerFactory)
+
+			Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(project)).To(Succeed())
+		})
+
+		createAttributes := func() admission.Attributes {
+			return admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+		}
+
+		addShootDefaults := func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootDefaults().Informer().GetStore().Add(shootDefaults)).To(Succeed())
+		}
+
+		addClusterShootDefaults := func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootDefaults().Informer().GetStore().Add(clusterShootDefaults)).To(Succeed())
+		}
+
+		withFallbackDefaults := func(kubelet *core.KubeletConfig) *core.KubeletConfig {
+			obj := &core.Shoot{Spec: core.ShootSpec{Kubernetes: core.Kubernetes{Kubelet: kubelet}, Provider: core.Provider{Workers: []core.Worker{{}}}}}
+			apishoot.SetDefaults(obj, nil)
+			return obj.Spec.Kubernetes.Kubelet
+		}
+
+		expectOnlyFallbackDefaults := func(expected *core.Shoot) {
+			Expect(shoot.Spec.Maintenance.TimeWindow).NotTo(BeNil())
+			expected.Spec.Maintenance = &core.Maintenance{TimeWindow: shoot.Spec.Maintenance.TimeWindow}
+			expected.Spec.Kubernetes.Kubelet = withFallbackDefaults(nil)
+			Expect(shoot).To(Equal(expected))
+		}
+
+		It("should do nothing for other resources", func() {
+			addShootDefaults()
+			attrs := admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), "", project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should do nothing for updates", func() {
+			addShootDefaults()
+			attrs := admission.NewAttributesRecord(shoot, shoot.DeepCopy(), core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+			Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Kubelet).To(BeNil())
+			Expect(shoot.Annotations).To(BeEmpty())
+		})
+
+		It("should only apply the fallback defaults if no defaults exist", func() {
+			expected := shoot.DeepCopy()
+
+			Expect(admissionHandler.Admit(ctx, createAttributes(), nil)).To(Succeed())
+			expectOnlyFallbackDefaults(expected)
+		})
+
+		It("should only apply the fallback defaults if the shoot selector does not match", func() {
+			shoot.Labels = nil
+			addShootDefaults()
+			expected := shoot.DeepCopy()
+
+			Expect(admissionHandler.Admit(ctx, createAttributes(), nil)).To(Succeed())
+			expectOnlyFallbackDefaults(expected)
+		})
+
+		It("should only apply the fallback defaults if the project selector does not match", func() {
+			project.Labels = nil
+			addClusterShootDefaults()
+			expected := shoot.DeepCopy()
+
+			Expect(admissionHandler.Admit(ctx, createAttributes(), nil)).To(Succeed())
+			expectOnlyFallbackDefaults(expected)
+		})
+
+		It("should apply the defaults of a ShootDefaults", func() {
+			addShootDefaults()
+
+			Expect(admissionHandler.Admit(ctx, createAttributes(), nil)).To(Succeed())
+
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shootdefaults.settings.gardener.cloud/applied", "ShootDefaults/defaults"))
+			Expect(shoot.Spec.Kubernetes.Kubelet).To(Equal(withFallbackDefaults(&core.KubeletConfig{MaxPods: pointer.Int32(200), PodPIDsLimit: pointer.Int64(1000)})))
+			Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}))
+			Expect(shoot.Spec.Hibernation.Schedules).To(ConsistOf(core.HibernationSchedule{Start: pointer.String("00 20 * * 1,2,3,4,5")}))
+			Expect(shoot.Spec.Extensions).To(ConsistOf(core.Extension{Type: "shoot-dns-service"}))
+
+			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(&core.ShootMachineImage{Name: "gardenlinux", Version: "1.0.0"}))
+			Expect(shoot.Spec.Provider.Workers[0].Labels).To(Equal(map[string]string{"team": "dev", "cost-center": "123"}))
+			Expect(shoot.Spec.Provider.Workers[0].Taints).To(ConsistOf(corev1.Taint{Key: "dedicated", Value: "dev", Effect: corev1.TaintEffectNoSchedule}))
+
+			Expect(shoot.Spec.Provider.Workers[1].Machine.Image).To(Equal(&core.ShootMachineImage{Name: "ubuntu"}))
+			Expect(shoot.Spec.Provider.Workers[1].Labels).To(Equal(map[string]string{"team": "ops", "cost-center": "123"}))
+			Expect(shoot.Spec.Provider.Workers[1].Taints).To(ConsistOf(corev1.Taint{Key: "dedicated", Value: "ops", Effect: corev1.TaintEffectNoExecute}))
+		})
+
+		It("should not overwrite values specified in the shoot", func() {
+			shoot.Spec.Kubernetes.Kubelet = &core.KubeletConfig{MaxPods: pointer.Int32(50)}
+			shoot.Spec.Maintenance = &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "030000+0100", End: "040000+0100"}}
+			shoot.Spec.Hibernation = &core.Hibernation{Schedules: []core.HibernationSchedule{{End: pointer.String("00 08 * * 1,2,3,4,5")}}}
+			shoot.Spec.Extensions = []core.Extension{{Type: "shoot-dns-service", Disabled: pointer.Bool(true)}}
+			addShootDefaults()
+
+			Expect(admissionHandler.Admit(ctx, createAttributes(), nil)).To(Succeed())
+
+			Expect(shoot.Spec.Kubernetes.Kubelet).To(Equal(withFallbackDefaults(&core.KubeletConfig{MaxPods: pointer.Int32(50), PodPIDsLimit: pointer.Int64(1000)})))
+			Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&core.MaintenanceTimeWindow{Begin: "030000+0100", End: "040000+0100"}))
+			Expect(shoot.Spec.Hibernation.Schedules).To(ConsistOf(core.HibernationSchedule{End: pointer.String("00 08 * * 1,2,3,4,5")}))
+			Expect(shoot.Spec.Extensions).To(ConsistOf(core.Extension{Type: "shoot-dns-service", Disabled: pointer.Bool(true)}))
+		})
+
+		It("should apply kubelet defaults of templates instead of the fallback defaults", func() {
+			shootDefaults.Spec.Template.Kubelet = &gardencorev1beta1.KubeletConfig{
+				FailSwapOn:                  pointer.Bool(false),
+				ImageGCHighThresholdPercent: pointer.Int32(80),
+				ImageGCLowThresholdPercent:  pointer.Int32(60),
+				SerializeImagePulls:         pointer.Bool(false),
+				KubeReserved:                &gardencorev1beta1.KubeletConfigReserved{CPU: &kubeReservedCPU},
+			}
+			addShootDefaults()
+
+			Expect(admissionHandler.Admit(ctx, createAttributes(), nil)).To(Succeed())
+
+			Expect(shoot.Spec.Kubernetes.Kubelet.FailSwapOn).To(Equal(pointer.Bool(false)))
+			Expect(shoot.Spec.Kubernetes.Kubelet.ImageGCHighThresholdPercent).To(Equal(pointer.Int32(80)))
+			Expect(shoot.Spec.Kubernetes.Kubelet.ImageGCLowThresholdPercent).To(Equal(pointer.Int32(60)))
+			Expect(shoot.Spec.Kubernetes.Kubelet.SerializeImagePulls).To(Equal(pointer.Bool(false)))
+			Expect(shoot.Spec.Kubernetes.Kubelet.KubeReserved.CPU).To(Equal(&kubeReservedCPU))
+			Expect(shoot.Spec.Kubernetes.Kubelet.KubeReserved.Memory).NotTo(BeNil())
+		})
+
+		It("should compute a random maintenance time window if no template provides one", func() {
+			shootDefaults.Spec.Template.MaintenanceTimeWindow = nil
+			addShootDefaults()
+
+			Expect(admissionHandler.Admit(ctx, createAttributes(), nil)).To(Succeed())
+
+			Expect(shoot.Spec.Maintenance.TimeWindow).NotTo(BeNil())
+			Expect(shoot.Spec.Maintenance.TimeWindow.Begin).To(HaveSuffix("0000+0000"))
+			Expect(shoot.Spec.Maintenance.TimeWindow.End).To(HaveSuffix("0000+0000"))
+		})
+
+		It("should not record defaults which did not contribute any value", func() {
+			shoot.Spec.Kubernetes.Kubelet = &core.KubeletConfig{MaxPods: pointer.Int32(50), CPUCFSQuota: pointer.Bool(true)}
+			shoot.Spec.Maintenance = &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "030000+0100", End: "040000+0100"}}
+			shoot.Spec.Extensions = []core.Extension{{Type: "shoot-dns-service"}, {Type: "shoot-cert-service"}}
+			addClusterShootDefaults()
+
+			Expect(admissionHandler.Admit(ctx, createAttributes(), nil)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey("shootdefaults.settings.gardener.cloud/applied"))
+		})
+
+		It("should give ShootDefaults precedence over ClusterShootDefaults", func() {
+			addShootDefaults()
+			addClusterShootDefaults()
+
+			Expect(admissionHandler.Admit(ctx, createAttributes(), nil)).To(Succeed())
+
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shootdefaults.settings.gardener.cloud/applied", "ShootDefaults/defaults,ClusterShootDefaults/cluster-defaults"))
+			Expect(shoot.Spec.Kubernetes.Kubelet).To(Equal(withFallbackDefaults(&core.KubeletConfig{MaxPods: pointer.Int32(200), PodPIDsLimit: pointer.Int64(1000), CPUCFSQuota: pointer.Bool(false)})))
+			Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}))
+			Expect(shoot.Spec.Extensions).To(ConsistOf(core.Extension{Type: "shoot-dns-service"}, core.Extension{Type: "shoot-cert-service"}))
+		})
+
+		It("should give defaults with a higher weight precedence", func() {
+			addShootDefaults()
+			shootDefaults2 := shootDefaults.DeepCopy()
+			shootDefaults2.Name = "defaults2"
+			shootDefaults2.Spec.Weight = 20
+			shootDefaults2.Spec.Template.MaintenanceTimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"}
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootDefaults().Informer().GetStore().Add(shootDefaults2)).To(Succeed())
+
+			Expect(admissionHandler.Admit(ctx, createAttributes(), nil)).To(Succeed())
+
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shootdefaults.settings.gardener.cloud/applied", "ShootDefaults/defaults2"))
+			Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&core.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"}))
+		})
+
+		It("should order defaults with the same weight by name", func() {
+			shootDefaults.Name = "b"
+			addShootDefaults()
+			shootDefaults2 := shootDefaults.DeepCopy()
+			shootDefaults2.Name = "a"
+			shootDefaults2.Spec.Template.MaintenanceTimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"}
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootDefaults().Informer().GetStore().Add(shootDefaults2)).To(Succeed())
+
+			Expect(admissionHandler.Admit(ctx, createAttributes(), nil)).To(Succeed())
+
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shootdefaults.settings.gardener.cloud/applied", "ShootDefaults/a"))
+			Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&core.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"}))
+		})
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("ShootDefaults"))
+		})
+	})
+
+	Describe("#New", func() {
+		It("should only handle CREATE operations", func() {
+			admissionHandler, err := New()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(admissionHandler.Handles(admission.Create)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Update)).NotTo(BeTrue())
+			Expect(admissionHandler.Handles(admission.Connect)).NotTo(BeTrue())
+			Expect(admissionHandler.Handles(admission.Delete)).NotTo(BeTrue())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return error if no listers are set", func() {
+			admissionHandler, _ := New()
+			Expect(admissionHandler.ValidateInitialization()).To(HaveOccurred())
+		})
+
+		It("should not return error if all listers are set", func() {
+			admissionHandler, _ := New()
+			admissionHandler.SetInternalCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
+			admissionHandler.SetSettingsInformerFactory(settingsinformers.NewSharedInformerFactory(nil, 0))
+			Expect(admissionHandler.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
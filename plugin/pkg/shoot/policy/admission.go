@@ -0,0 +1,420 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	celgo "github.com/google/cel-go/cel"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/warning"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
+	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/internalversion"
+	settingsinformers "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	settingsv1alpha1lister "github.com/gardener/gardener/pkg/client/settings/listers/settings/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/cel"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+// AuditAnnotationKey is the key of the audit annotation which is added for violated rules with the `Audit` action.
+const AuditAnnotationKey = "shootpolicy.settings.gardener.cloud/violations"
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootPolicy, func(_ io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ShootPolicy contains listers and admission handler.
+type ShootPolicy struct {
+	*admission.Handler
+
+	projectLister            gardencorelisters.ProjectLister
+	shootPolicyLister        settingsv1alpha1lister.ShootPolicyLister
+	clusterShootPolicyLister settingsv1alpha1lister.ClusterShootPolicyLister
+	readyFunc                admission.ReadyFunc
+
+	// compiledPolicies contains the compiled rules of the policies by their key to prevent compiling them for every
+	// request.
+	compiledPolicies     map[string]*compiledPolicy
+	compiledPoliciesLock sync.RWMutex
+}
+
+// compiledPolicy contains the compiled rules of a policy in a certain resource version.
+type compiledPolicy struct {
+	resourceVersion string
+	rules           []compiledRule
+}
+
+// compiledRule contains a rule and either its compiled program or the error which occurred during compilation.
+type compiledRule struct {
+	settingsv1alpha1.ShootPolicyRule
+	program celgo.Program
+	err     error
+}
+
+var (
+	_ = admissioninitializer.WantsInternalCoreInformerFactory(&ShootPolicy{})
+	_ = admissioninitializer.WantsSettingsInformerFactory(&ShootPolicy{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new ShootPolicy admission plugin.
+func New() (*ShootPolicy, error) {
+	return &ShootPolicy{
+		Handler:          admission.NewHandler(admission.Create, admission.Update),
+		compiledPolicies: make(map[string]*compiledPolicy),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (s *ShootPolicy) AssignReadyFunc(f admission.ReadyFunc) {
+	s.readyFunc = f
+	s.SetReadyFunc(f)
+}
+
+// SetInternalCoreInformerFactory gets Lister from SharedInformerFactory.
+func (s *ShootPolicy) SetInternalCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	projectInformer := f.Core().InternalVersion().Projects()
+	s.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
+// SetSettingsInformerFactory gets Lister from SharedInformerFactory.
+func (s *ShootPolicy) SetSettingsInformerFactory(f settingsinformers.SharedInformerFactory) {
+	shootPolicyInformer := f.Settings().V1alpha1().ShootPolicies()
+	s.shootPolicyLister = shootPolicyInformer.Lister()
+
+	clusterShootPolicyInformer := f.Settings().V1alpha1().ClusterShootPolicies()
+	s.clusterShootPolicyLister = clusterShootPolicyInformer.Lister()
+
+	// Compiled rules of deleted policies are removed from the cache.
+	forgetDeletedPolicies := cache.ResourceEventHandlerFuncs{DeleteFunc: s.forgetCompiledPolicy}
+	_, _ = shootPolicyInformer.Informer().AddEventHandler(forgetDeletedPolicies)
+	_, _ = clusterShootPolicyInformer.Informer().AddEventHandler(forgetDeletedPolicies)
+
+	readyFuncs = append(readyFuncs, shootPolicyInformer.Informer().HasSynced, clusterShootPolicyInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (s *ShootPolicy) ValidateInitialization() error {
+	if s.projectLister == nil {
+		return errors.New("missing project lister")
+	}
+	if s.shootPolicyLister == nil {
+		return errors.New("missing shootpolicy lister")
+	}
+	if s.clusterShootPolicyLister == nil {
+		return errors.New("missing clustershootpolicy lister")
+	}
+	return nil
+}
+
+var _ admission.ValidationInterface = &ShootPolicy{}
+
+type policy struct {
+	kind  string
+	name  string
+	rules []compiledRule
+}
+
+// Validate evaluates the rules of all ShootPolicies and ClusterShootPolicies matching the Shoot.
+func (s *ShootPolicy) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	// Wait until the caches have been synced
+	if s.readyFunc == nil {
+		s.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !s.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than Shoot
+	// Ignore all subresource calls
+	if len(a.GetSubresource()) != 0 || a.GetKind().GroupKind() != core.Kind("Shoot") {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	// Do not block the removal of finalizers or other updates of Shoots which are already being deleted.
+	if shoot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	// Gardener system components must always be able to act on Shoots, e.g., for maintenance operations.
+	if isGardenerSystemUser(a.GetUserInfo()) {
+		return nil
+	}
+
+	var oldShoot *core.Shoot
+	if a.GetOperation() == admission.Update {
+		oldShoot, ok = a.GetOldObject().(*core.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+
+		// Policies are only evaluated if the specification changes so that metadata-only updates, e.g., for annotating
+		// the Shoot with an operation or for confirming its deletion, are never blocked.
+		if apiequality.Semantic.DeepEqual(shoot.Spec, oldShoot.Spec) {
+			return nil
+		}
+	}
+
+	policies, err := s.matchingPolicies(shoot)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	object, err := toUnstructured(shoot)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	var oldObject map[string]interface{}
+	if oldShoot != nil {
+		if oldObject, err = toUnstructured(oldShoot); err != nil {
+			return apierrors.NewInternalError(err)
+		}
+	}
+
+	var denied, audited []string
+	for _, p := range policies {
+		for _, rule := range p.rules {
+			compliant, evalErr := evaluate(ctx, rule, object, oldObject)
+			if compliant {
+				continue
+			}
+
+			message := fmt.Sprintf("%s %q, rule %q: %s", p.kind, p.name, rule.Name, rule.Message)
+			if evalErr != nil {
+				message = fmt.Sprintf("%s (expression could not be evaluated: %v)", message, evalErr)
+			}
+
+			action := rule.Action
+			// Updates of Shoots which already violated the rule before are not denied so that existing Shoots can still
+			// be changed after a policy was created. The violation is only reported as a warning in this case.
+			if action == settingsv1alpha1.ShootPolicyActionDeny && oldObject != nil {
+				if oldCompliant, _ := evaluate(ctx, rule, oldObject, nil); !oldCompliant {
+					action = settingsv1alpha1.ShootPolicyActionWarn
+				}
+			}
+
+			switch action {
+			case settingsv1alpha1.ShootPolicyActionWarn:
+				warning.AddWarning(ctx, "", message)
+			case settingsv1alpha1.ShootPolicyActionAudit:
+				audited = append(audited, message)
+			default:
+				denied = append(denied, message)
+			}
+		}
+	}
+
+	if len(audited) > 0 {
+		if err := a.AddAnnotation(AuditAnnotationKey, strings.Join(audited, "; ")); err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("failed adding audit annotation: %w", err))
+		}
+	}
+
+	if len(denied) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("shoot violates policies: %s", strings.Join(denied, "; ")))
+	}
+
+	return nil
+}
+
+func (s *ShootPolicy) matchingPolicies(shoot *core.Shoot) ([]policy, error) {
+	var policies []policy
+
+	shootPolicies, err := s.shootPolicyLister.ShootPolicies(shoot.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("could not list existing shootpolicies: %w", err)
+	}
+	sort.Slice(shootPolicies, func(i, j int) bool { return shootPolicies[i].Name < shootPolicies[j].Name })
+
+	for _, shootPolicy := range shootPolicies {
+		matches, err := selectorMatches(shootPolicy.Spec.ShootSelector, shoot.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("label selector conversion failed for shootSelector of shootpolicy %q: %w", shootPolicy.Name, err)
+		}
+		if matches {
+			policies = append(policies, s.compile("ShootPolicy", shootPolicy, shootPolicy.Spec))
+		}
+	}
+
+	clusterShootPolicies, err := s.clusterShootPolicyLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("could not list existing clustershootpolicies: %w", err)
+	}
+	if len(clusterShootPolicies) == 0 {
+		return policies, nil
+	}
+	sort.Slice(clusterShootPolicies, func(i, j int) bool { return clusterShootPolicies[i].Name < clusterShootPolicies[j].Name })
+
+	project, err := admissionutils.ProjectForNamespaceFromInternalLister(s.projectLister, shoot.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not find project for namespace %q: %w", shoot.Namespace, err)
+	}
+
+	for _, clusterShootPolicy := range clusterShootPolicies {
+		projectMatches, err := selectorMatches(clusterShootPolicy.Spec.ProjectSelector, project.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("label selector conversion failed for projectSelector of clustershootpolicy %q: %w", clusterShootPolicy.Name, err)
+		}
+		shootMatches, err := selectorMatches(clusterShootPolicy.Spec.ShootSelector, shoot.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("label selector conversion failed for shootSelector of clustershootpolicy %q: %w", clusterShootPolicy.Name, err)
+		}
+		if projectMatches && shootMatches {
+			policies = append(policies, s.compile("ClusterShootPolicy", clusterShootPolicy, clusterShootPolicy.Spec.ShootPolicySpec))
+		}
+	}
+
+	return policies, nil
+}
+
+// compile returns the given policy with its compiled rules. The rules are only compiled if they have not been compiled
+// for the current resource version of the policy yet.
+func (s *ShootPolicy) compile(kind string, obj metav1.Object, spec settingsv1alpha1.ShootPolicySpec) policy {
+	key := policyKey(obj)
+
+	s.compiledPoliciesLock.RLock()
+	compiled, ok := s.compiledPolicies[key]
+	s.compiledPoliciesLock.RUnlock()
+
+	if !ok || compiled.resourceVersion != obj.GetResourceVersion() {
+		compiled = &compiledPolicy{resourceVersion: obj.GetResourceVersion()}
+		for _, rule := range spec.Rules {
+			program, err := cel.Compile(rule.Expression)
+			compiled.rules = append(compiled.rules, compiledRule{ShootPolicyRule: rule, program: program, err: err})
+		}
+
+		s.compiledPoliciesLock.Lock()
+		s.compiledPolicies[key] = compiled
+		s.compiledPoliciesLock.Unlock()
+	}
+
+	return policy{kind: kind, name: obj.GetName(), rules: compiled.rules}
+}
+
+func (s *ShootPolicy) forgetCompiledPolicy(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	metaObj, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+
+	s.compiledPoliciesLock.Lock()
+	delete(s.compiledPolicies, policyKey(metaObj))
+	s.compiledPoliciesLock.Unlock()
+}
+
+// policyKey returns the key of the given policy in the cache of compiled policies. Keys of ShootPolicies contain their
+// namespace, hence they cannot collide with keys of ClusterShootPolicies.
+func policyKey(obj metav1.Object) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// isGardenerSystemUser returns true if the given user is a gardenlet, a Gardener component running with a service
+// account in the kube-system namespace, or a cluster administrator.
+func isGardenerSystemUser(userInfo user.Info) bool {
+	if userInfo == nil {
+		return false
+	}
+
+	groups := sets.New(userInfo.GetGroups()...)
+	return groups.HasAny(
+		v1beta1constants.SeedsGroup,
+		serviceaccount.MakeNamespaceGroupName(metav1.NamespaceSystem),
+		user.SystemPrivilegedGroup,
+	)
+}
+
+func selectorMatches(selector *metav1.LabelSelector, objLabels map[string]string) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, err
+	}
+
+	return labelSelector.Matches(labels.Set(objLabels)), nil
+}
+
+// toUnstructured converts the given Shoot into the unstructured representation of its v1beta1 version which is the
+// input for the CEL expressions.
+func toUnstructured(shoot *core.Shoot) (map[string]interface{}, error) {
+	shootV1beta1 := &gardencorev1beta1.Shoot{}
+	if err := api.Scheme.Convert(shoot, shootV1beta1, nil); err != nil {
+		return nil, fmt.Errorf("could not convert shoot to v1beta1: %w", err)
+	}
+
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(shootV1beta1)
+}
+
+func evaluate(ctx context.Context, rule compiledRule, object, oldObject map[string]interface{}) (bool, error) {
+	if rule.err != nil {
+		return false, rule.err
+	}
+
+	return cel.Evaluate(ctx, rule.program, object, oldObject)
+}
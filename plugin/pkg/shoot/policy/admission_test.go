@@ -0,0 +1,386 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/warning"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
+	settingsinformers "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/policy"
+)
+
+type fakeWarningRecorder struct {
+	warnings []string
+}
+
+func (f *fakeWarningRecorder) AddWarning(_, text string) {
+	f.warnings = append(f.warnings, text)
+}
+
+type annotationRecorder struct {
+	admission.Attributes
+	annotations map[string]string
+}
+
+func (a *annotationRecorder) AddAnnotation(key, value string) error {
+	if a.annotations == nil {
+		a.annotations = map[string]string{}
+	}
+	a.annotations[key] = value
+	return nil
+}
+
+var _ = Describe("ShootPolicy", func() {
+	Describe("#Validate", func() {
+		var (
+			ctx                     context.Context
+			warningRecorder         *fakeWarningRecorder
+			admissionHandler        *ShootPolicy
+			coreInformerFactory     gardencoreinformers.SharedInformerFactory
+			settingsInformerFactory settingsinformers.SharedInformerFactory
+
+			namespace = "garden-foo"
+			shoot     *core.Shoot
+			project   *core.Project
+
+			shootPolicy        *settingsv1alpha1.ShootPolicy
+			clusterShootPolicy *settingsv1alpha1.ClusterShootPolicy
+		)
+
+		BeforeEach(func() {
+			warningRecorder = &fakeWarningRecorder{}
+			ctx = warning.WithWarningRecorder(context.TODO(), warningRecorder)
+
+			shoot = &core.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: namespace,
+					Labels:    map[string]string{"env": "prod"},
+				},
+				Spec: core.ShootSpec{
+					Kubernetes: core.Kubernetes{
+						Version: "1.27.3",
+					},
+				},
+			}
+
+			project = &core.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "foo",
+					Labels: map[string]string{"tier": "gold"},
+				},
+				Spec: core.ProjectSpec{
+					Namespace: pointer.String(namespace),
+				},
+			}
+
+			shootPolicy = &settingsv1alpha1.ShootPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "policy",
+					Namespace: namespace,
+				},
+				Spec: settingsv1alpha1.ShootPolicySpec{
+					ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+					Rules: []settingsv1alpha1.ShootPolicyRule{{
+						Name:       "kubernetes-version",
+						Expression: `object.spec.kubernetes.version.startsWith("1.27")`,
+						Message:    "only Kubernetes 1.27 is allowed",
+						Action:     settingsv1alpha1.ShootPolicyActionDeny,
+					}},
+				},
+			}
+
+			clusterShootPolicy = &settingsv1alpha1.ClusterShootPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-policy",
+				},
+				Spec: settingsv1alpha1.ClusterShootPolicySpec{
+					ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}},
+					ShootPolicySpec: settingsv1alpha1.ShootPolicySpec{
+						ShootSelector: &metav1.LabelSelector{},
+						Rules: []settingsv1alpha1.ShootPolicyRule{{
+							Name:       "purpose",
+							Expression: `has(object.spec.purpose) && object.spec.purpose == "production"`,
+							Message:    "purpose must be production",
+							Action:     settingsv1alpha1.ShootPolicyActionDeny,
+						}},
+					},
+				},
+			}
+
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			coreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalCoreInformerFactory(coreInformerFactory)
+			settingsInformerFactory = settingsinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetSettingsInformerFactory(settingsInformerFactory)
+
+			Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(project)).To(Succeed())
+		})
+
+		createAttributes := func() admission.Attributes {
+			return admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+		}
+
+		addShootPolicy := func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(shootPolicy)).To(Succeed())
+		}
+
+		addClusterShootPolicy := func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPolicies().Informer().GetStore().Add(clusterShootPolicy)).To(Succeed())
+		}
+
+		It("should do nothing for other resources", func() {
+			addShootPolicy()
+			attrs := admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), "", project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should do nothing for subresources", func() {
+			shoot.Spec.Kubernetes.Version = "1.26.0"
+			addShootPolicy()
+			attrs := admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "status", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should do nothing for shoots in deletion", func() {
+			shoot.Spec.Kubernetes.Version = "1.26.0"
+			shoot.DeletionTimestamp = &metav1.Time{}
+			addShootPolicy()
+
+			Expect(admissionHandler.Validate(ctx, createAttributes(), nil)).To(Succeed())
+		})
+
+		It("should allow the shoot if no policy exists", func() {
+			Expect(admissionHandler.Validate(ctx, createAttributes(), nil)).To(Succeed())
+		})
+
+		It("should allow the shoot if it complies with all rules", func() {
+			shoot.Spec.Purpose = (*core.ShootPurpose)(pointer.String("production"))
+			addShootPolicy()
+			addClusterShootPolicy()
+
+			Expect(admissionHandler.Validate(ctx, createAttributes(), nil)).To(Succeed())
+			Expect(warningRecorder.warnings).To(BeEmpty())
+		})
+
+		It("should deny the shoot if it violates a ShootPolicy rule", func() {
+			shoot.Spec.Kubernetes.Version = "1.26.0"
+			addShootPolicy()
+
+			err := admissionHandler.Validate(ctx, createAttributes(), nil)
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(ContainSubstring(`ShootPolicy "policy", rule "kubernetes-version": only Kubernetes 1.27 is allowed`)))
+		})
+
+		It("should ignore ShootPolicies whose shoot selector does not match", func() {
+			shoot.Spec.Kubernetes.Version = "1.26.0"
+			shootPolicy.Spec.ShootSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}}
+			addShootPolicy()
+
+			Expect(admissionHandler.Validate(ctx, createAttributes(), nil)).To(Succeed())
+		})
+
+		It("should ignore ShootPolicies in other namespaces", func() {
+			shoot.Spec.Kubernetes.Version = "1.26.0"
+			shootPolicy.Namespace = "garden-bar"
+			addShootPolicy()
+
+			Expect(admissionHandler.Validate(ctx, createAttributes(), nil)).To(Succeed())
+		})
+
+		It("should deny the shoot if it violates a ClusterShootPolicy rule", func() {
+			addClusterShootPolicy()
+
+			err := admissionHandler.Validate(ctx, createAttributes(), nil)
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(ContainSubstring(`ClusterShootPolicy "cluster-policy", rule "purpose": purpose must be production`)))
+		})
+
+		It("should ignore ClusterShootPolicies whose project selector does not match", func() {
+			clusterShootPolicy.Spec.ProjectSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "silver"}}
+			addClusterShootPolicy()
+
+			Expect(admissionHandler.Validate(ctx, createAttributes(), nil)).To(Succeed())
+		})
+
+		It("should fail if the project for a ClusterShootPolicy cannot be found", func() {
+			Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Delete(project)).To(Succeed())
+			addClusterShootPolicy()
+
+			Expect(admissionHandler.Validate(ctx, createAttributes(), nil)).To(BeInternalServerError())
+		})
+
+		It("should only add a warning for rules with action Warn", func() {
+			shoot.Spec.Kubernetes.Version = "1.26.0"
+			shootPolicy.Spec.Rules[0].Action = settingsv1alpha1.ShootPolicyActionWarn
+			addShootPolicy()
+
+			Expect(admissionHandler.Validate(ctx, createAttributes(), nil)).To(Succeed())
+			Expect(warningRecorder.warnings).To(ConsistOf(`ShootPolicy "policy", rule "kubernetes-version": only Kubernetes 1.27 is allowed`))
+		})
+
+		It("should only add an audit annotation for rules with action Audit", func() {
+			shoot.Spec.Kubernetes.Version = "1.26.0"
+			shootPolicy.Spec.Rules[0].Action = settingsv1alpha1.ShootPolicyActionAudit
+			addShootPolicy()
+			attrs := &annotationRecorder{Attributes: createAttributes()}
+
+			Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+			Expect(warningRecorder.warnings).To(BeEmpty())
+			Expect(attrs.annotations).To(HaveKeyWithValue(
+				"shootpolicy.settings.gardener.cloud/violations",
+				`ShootPolicy "policy", rule "kubernetes-version": only Kubernetes 1.27 is allowed`,
+			))
+		})
+
+		It("should have access to the old object on updates", func() {
+			shootPolicy.Spec.Rules[0].Expression = `oldObject == null || object.spec.kubernetes.version == oldObject.spec.kubernetes.version`
+			shootPolicy.Spec.Rules[0].Message = "kubernetes version must not be changed"
+			addShootPolicy()
+
+			oldShoot := shoot.DeepCopy()
+			oldShoot.Spec.Kubernetes.Version = "1.27.2"
+			attrs := admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(ctx, createAttributes(), nil)).To(Succeed())
+			Expect(admissionHandler.Validate(ctx, attrs, nil)).To(MatchError(ContainSubstring("kubernetes version must not be changed")))
+		})
+
+		Context("updates", func() {
+			var oldShoot *core.Shoot
+
+			BeforeEach(func() {
+				oldShoot = shoot.DeepCopy()
+			})
+
+			updateAttributes := func() admission.Attributes {
+				return admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+			}
+
+			It("should not evaluate policies if the spec is unchanged", func() {
+				oldShoot.Spec.Kubernetes.Version = "1.26.0"
+				shoot = oldShoot.DeepCopy()
+				shoot.Annotations = map[string]string{"confirmation.gardener.cloud/deletion": "true"}
+				addShootPolicy()
+
+				Expect(admissionHandler.Validate(ctx, updateAttributes(), nil)).To(Succeed())
+				Expect(warningRecorder.warnings).To(BeEmpty())
+			})
+
+			It("should deny the update if the shoot starts violating a rule", func() {
+				shoot.Spec.Kubernetes.Version = "1.26.0"
+				addShootPolicy()
+
+				Expect(admissionHandler.Validate(ctx, updateAttributes(), nil)).To(BeForbiddenError())
+			})
+
+			It("should only warn if the shoot already violated the rule before", func() {
+				oldShoot.Spec.Kubernetes.Version = "1.26.0"
+				shoot.Spec.Kubernetes.Version = "1.26.1"
+				addShootPolicy()
+
+				Expect(admissionHandler.Validate(ctx, updateAttributes(), nil)).To(Succeed())
+				Expect(warningRecorder.warnings).To(ConsistOf(`ShootPolicy "policy", rule "kubernetes-version": only Kubernetes 1.27 is allowed`))
+			})
+		})
+
+		It("should not evaluate policies for gardener system users", func() {
+			shoot.Spec.Kubernetes.Version = "1.26.0"
+			addShootPolicy()
+			attrs := admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, &user.DefaultInfo{
+				Name:   "system:serviceaccount:kube-system:gardener-controller-manager",
+				Groups: []string{"system:serviceaccounts", "system:serviceaccounts:kube-system"},
+			})
+
+			Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should only recompile the rules of a policy if its resource version changes", func() {
+			shootPolicy.ResourceVersion = "1"
+			addShootPolicy()
+			Expect(admissionHandler.Validate(ctx, createAttributes(), nil)).To(Succeed())
+
+			By("Update policy without changing the resource version")
+			shootPolicy.Spec.Rules[0].Expression = `object.spec.kubernetes.version.startsWith("1.28")`
+			addShootPolicy()
+			Expect(admissionHandler.Validate(ctx, createAttributes(), nil)).To(Succeed())
+
+			By("Update policy with a new resource version")
+			shootPolicy.ResourceVersion = "2"
+			addShootPolicy()
+			Expect(admissionHandler.Validate(ctx, createAttributes(), nil)).To(BeForbiddenError())
+		})
+
+		It("should treat evaluation errors as violations", func() {
+			shootPolicy.Spec.Rules[0].Expression = `object.spec.doesNotExist == "foo"`
+			addShootPolicy()
+
+			err := admissionHandler.Validate(ctx, createAttributes(), nil)
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(ContainSubstring("expression could not be evaluated")))
+		})
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("ShootPolicy"))
+		})
+	})
+
+	Describe("#New", func() {
+		It("should only handle CREATE and UPDATE operations", func() {
+			admissionHandler, err := New()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(admissionHandler.Handles(admission.Create)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Update)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Connect)).NotTo(BeTrue())
+			Expect(admissionHandler.Handles(admission.Delete)).NotTo(BeTrue())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return error if no listers are set", func() {
+			admissionHandler, _ := New()
+			Expect(admissionHandler.ValidateInitialization()).To(HaveOccurred())
+		})
+
+		It("should not return error if all listers are set", func() {
+			admissionHandler, _ := New()
+			admissionHandler.SetInternalCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
+			admissionHandler.SetSettingsInformerFactory(settingsinformers.NewSharedInformerFactory(nil, 0))
+			Expect(admissionHandler.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,247 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projectrestrictions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
+	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/internalversion"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootProjectRestrictions, func(_ io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ProjectRestrictions contains listers and admission handler.
+type ProjectRestrictions struct {
+	*admission.Handler
+
+	projectLister gardencorelisters.ProjectLister
+	readyFunc     admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsInternalCoreInformerFactory(&ProjectRestrictions{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new ProjectRestrictions admission plugin.
+func New() (*ProjectRestrictions, error) {
+	return &ProjectRestrictions{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (p *ProjectRestrictions) AssignReadyFunc(f admission.ReadyFunc) {
+	p.readyFunc = f
+	p.SetReadyFunc(f)
+}
+
+// SetInternalCoreInformerFactory sets the internal garden core informer factory.
+func (p *ProjectRestrictions) SetInternalCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	projectInformer := f.Core().InternalVersion().Projects()
+	p.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (p *ProjectRestrictions) ValidateInitialization() error {
+	if p.projectLister == nil {
+		return errors.New("missing Project lister")
+	}
+	return nil
+}
+
+var _ admission.ValidationInterface = &ProjectRestrictions{}
+
+// Validate makes admissions decisions based on the restrictions configured in the Project of the Shoot.
+func (p *ProjectRestrictions) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	// Wait until the caches have been synced
+	if p.readyFunc == nil {
+		p.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !p.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	if a.GetKind().GroupKind() != core.Kind("Shoot") {
+		return nil
+	}
+
+	// Ignore updates to all subresources, as they do not change the worker pools.
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	var oldShoot *core.Shoot
+	if a.GetOperation() == admission.Update {
+		oldShoot, ok = a.GetOldObject().(*core.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+	}
+
+	project, err := admissionutils.ProjectForNamespaceFromInternalLister(p.projectLister, shoot.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not find referenced project: %w", err))
+	}
+
+	if project.Spec.Restrictions == nil {
+		return nil
+	}
+
+	if allErrs := validateShoot(project.Spec.Restrictions, shoot, oldShoot); len(allErrs) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("shoot violates the restrictions of project %q: %w", project.Name, allErrs.ToAggregate()))
+	}
+
+	return nil
+}
+
+// validateShoot validates the given Shoot against the given restrictions. Only settings which are new or which were
+// changed compared to the old Shoot are validated, so that existing Shoots are not blocked by restrictions which were
+// added later.
+func validateShoot(restrictions *core.ProjectRestrictions, shoot, oldShoot *core.Shoot) field.ErrorList {
+	var (
+		allErrs    field.ErrorList
+		specPath   = field.NewPath("spec")
+		oldWorkers = map[string]core.Worker{}
+	)
+
+	if (oldShoot == nil || oldShoot.Spec.Region != shoot.Spec.Region) && !isAllowed(restrictions.Regions, shoot.Spec.Region) {
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("region"), shoot.Spec.Region, restrictions.Regions))
+	}
+
+	if shoot.Spec.Provider.Workers == nil {
+		return allErrs
+	}
+
+	if oldShoot != nil {
+		for _, worker := range oldShoot.Spec.Provider.Workers {
+			oldWorkers[worker.Name] = worker
+		}
+	}
+
+	for i, worker := range shoot.Spec.Provider.Workers {
+		var (
+			idxPath           = specPath.Child("provider", "workers").Index(i)
+			oldWorker, exists = oldWorkers[worker.Name]
+		)
+
+		if (!exists || oldWorker.Machine.Type != worker.Machine.Type) && !isAllowed(restrictions.MachineTypes, worker.Machine.Type) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machine", "type"), worker.Machine.Type, restrictions.MachineTypes))
+		}
+
+		if architecture := worker.Machine.Architecture; architecture != nil && (!exists || !pointer.StringEqual(oldWorker.Machine.Architecture, architecture)) && !isAllowed(restrictions.Architectures, *architecture) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machine", "architecture"), *architecture, restrictions.Architectures))
+		}
+
+		if image := worker.Machine.Image; image != nil && (!exists || !equalMachineImages(oldWorker.Machine.Image, image)) {
+			allErrs = append(allErrs, validateMachineImage(restrictions.MachineImages, image, idxPath.Child("machine", "image"))...)
+		}
+
+		if volume := worker.Volume; volume != nil && volume.Type != nil && (!exists || oldWorker.Volume == nil || !pointer.StringEqual(oldWorker.Volume.Type, volume.Type)) && !isAllowed(restrictions.VolumeTypes, *volume.Type) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volume", "type"), *volume.Type, restrictions.VolumeTypes))
+		}
+
+		oldDataVolumes := map[string]core.DataVolume{}
+		for _, volume := range oldWorker.DataVolumes {
+			oldDataVolumes[volume.Name] = volume
+		}
+
+		for j, volume := range worker.DataVolumes {
+			if volume.Type == nil {
+				continue
+			}
+
+			if oldVolume, ok := oldDataVolumes[volume.Name]; (!ok || !pointer.StringEqual(oldVolume.Type, volume.Type)) && !isAllowed(restrictions.VolumeTypes, *volume.Type) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("dataVolumes").Index(j).Child("type"), *volume.Type, restrictions.VolumeTypes))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateMachineImage(allowedImages []core.ProjectMachineImageRestriction, image *core.ShootMachineImage, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs    field.ErrorList
+		imageNames []string
+	)
+
+	if len(allowedImages) == 0 {
+		return nil
+	}
+
+	for _, allowedImage := range allowedImages {
+		if allowedImage.Name != image.Name {
+			imageNames = append(imageNames, allowedImage.Name)
+			continue
+		}
+
+		if len(allowedImage.Versions) == 0 {
+			return nil
+		}
+
+		if !slices.Contains(allowedImage.Versions, image.Version) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("version"), image.Version, allowedImage.Versions))
+		}
+		return allErrs
+	}
+
+	return append(allErrs, field.NotSupported(fldPath.Child("name"), image.Name, imageNames))
+}
+
+func isAllowed(allowed []string, value string) bool {
+	return len(allowed) == 0 || slices.Contains(allowed, value)
+}
+
+func equalMachineImages(a, b *core.ShootMachineImage) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,238 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projectrestrictions_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/projectrestrictions"
+)
+
+var _ = Describe("ProjectRestrictions", func() {
+	Describe("#Validate", func() {
+		var (
+			ctx = context.TODO()
+
+			admissionHandler     *ProjectRestrictions
+			coreInformerFactory  gardencoreinformers.SharedInformerFactory
+			project              *core.Project
+			shoot                *core.Shoot
+			namespace            = "garden-dev"
+			validateWithOldShoot func(oldShoot *core.Shoot) error
+			validateNewShoot     func() error
+		)
+
+		BeforeEach(func() {
+			var err error
+			admissionHandler, err = New()
+			Expect(err).NotTo(HaveOccurred())
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+
+			coreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalCoreInformerFactory(coreInformerFactory)
+
+			project = &core.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+				Spec: core.ProjectSpec{
+					Namespace: &namespace,
+					Restrictions: &core.ProjectRestrictions{
+						Regions:       []string{"europe"},
+						MachineTypes:  []string{"small", "medium"},
+						Architectures: []string{"amd64"},
+						MachineImages: []core.ProjectMachineImageRestriction{
+							{Name: "gardenlinux", Versions: []string{"1.0.0"}},
+							{Name: "suse-chost"},
+						},
+						VolumeTypes: []string{"standard"},
+					},
+				},
+			}
+
+			shoot = &core.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: namespace},
+				Spec: core.ShootSpec{
+					Region: "europe",
+					Provider: core.Provider{
+						Workers: []core.Worker{{
+							Name: "worker",
+							Machine: core.Machine{
+								Type:         "small",
+								Architecture: pointer.String("amd64"),
+								Image:        &core.ShootMachineImage{Name: "gardenlinux", Version: "1.0.0"},
+							},
+							Volume:      &core.Volume{Type: pointer.String("standard")},
+							DataVolumes: []core.DataVolume{{Name: "data", Type: pointer.String("standard")}},
+						}},
+					},
+				},
+			}
+
+			validateNewShoot = func() error {
+				attrs := admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+				return admissionHandler.Validate(ctx, attrs, nil)
+			}
+			validateWithOldShoot = func(oldShoot *core.Shoot) error {
+				attrs := admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				return admissionHandler.Validate(ctx, attrs, nil)
+			}
+		})
+
+		JustBeforeEach(func() {
+			Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(project)).To(Succeed())
+		})
+
+		It("should ignore other resources", func() {
+			attrs := admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), "", project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+			Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should allow shoots which comply with the restrictions", func() {
+			Expect(validateNewShoot()).To(Succeed())
+		})
+
+		Context("project without restrictions", func() {
+			BeforeEach(func() {
+				project.Spec.Restrictions = nil
+			})
+
+			It("should allow any shoot", func() {
+				shoot.Spec.Region = "asia"
+				shoot.Spec.Provider.Workers[0].Machine.Type = "gpu"
+
+				Expect(validateNewShoot()).To(Succeed())
+			})
+		})
+
+		It("should allow any setting if the respective restriction list is empty", func() {
+			project.Spec.Restrictions = &core.ProjectRestrictions{MachineTypes: []string{"small"}}
+			shoot.Spec.Region = "asia"
+			shoot.Spec.Provider.Workers[0].Machine.Image = &core.ShootMachineImage{Name: "ubuntu", Version: "22.04"}
+
+			Expect(validateNewShoot()).To(Succeed())
+		})
+
+		It("should forbid shoots which violate the restrictions", func() {
+			shoot.Spec.Region = "asia"
+			shoot.Spec.Provider.Workers[0].Machine.Type = "gpu"
+			shoot.Spec.Provider.Workers[0].Machine.Architecture = pointer.String("arm64")
+			shoot.Spec.Provider.Workers[0].Volume.Type = pointer.String("premium")
+			shoot.Spec.Provider.Workers[0].DataVolumes[0].Type = pointer.String("premium")
+
+			err := validateNewShoot()
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(And(
+				ContainSubstring(`spec.region: Unsupported value: "asia"`),
+				ContainSubstring(`spec.provider.workers[0].machine.type: Unsupported value: "gpu"`),
+				ContainSubstring(`spec.provider.workers[0].machine.architecture: Unsupported value: "arm64"`),
+				ContainSubstring(`spec.provider.workers[0].volume.type: Unsupported value: "premium"`),
+				ContainSubstring(`spec.provider.workers[0].dataVolumes[0].type: Unsupported value: "premium"`),
+			)))
+		})
+
+		It("should forbid machine images which are not allowed", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Image = &core.ShootMachineImage{Name: "ubuntu", Version: "22.04"}
+
+			Expect(validateNewShoot()).To(MatchError(ContainSubstring(`spec.provider.workers[0].machine.image.name: Unsupported value: "ubuntu"`)))
+		})
+
+		It("should forbid machine image versions which are not allowed", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Image.Version = "2.0.0"
+
+			Expect(validateNewShoot()).To(MatchError(ContainSubstring(`spec.provider.workers[0].machine.image.version: Unsupported value: "2.0.0"`)))
+		})
+
+		It("should allow all versions of machine images without version restrictions", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Image = &core.ShootMachineImage{Name: "suse-chost", Version: "15.4"}
+
+			Expect(validateNewShoot()).To(Succeed())
+		})
+
+		It("should allow unchanged settings of existing shoots which violate the restrictions", func() {
+			shoot.Spec.Region = "asia"
+			shoot.Spec.Provider.Workers[0].Machine.Type = "gpu"
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Provider.Workers[0].Maximum = 5
+
+			Expect(validateWithOldShoot(oldShoot)).To(Succeed())
+		})
+
+		It("should forbid changing settings of existing shoots to values which violate the restrictions", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Provider.Workers[0].Machine.Type = "gpu"
+
+			Expect(validateWithOldShoot(oldShoot)).To(BeForbiddenError())
+		})
+
+		It("should forbid adding worker pools which violate the restrictions", func() {
+			oldShoot := shoot.DeepCopy()
+			worker := shoot.Spec.Provider.Workers[0].DeepCopy()
+			worker.Name = "gpu"
+			worker.Machine.Type = "gpu"
+			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, *worker)
+
+			Expect(validateWithOldShoot(oldShoot)).To(MatchError(ContainSubstring(`spec.provider.workers[1].machine.type: Unsupported value: "gpu"`)))
+		})
+
+		It("should ignore subresources", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Type = "gpu"
+			attrs := admission.NewAttributesRecord(shoot, shoot.DeepCopy(), core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "status", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(ConsistOf("ShootProjectRestrictions"))
+		})
+	})
+
+	Describe("#New", func() {
+		It("should handle CREATE and UPDATE operations", func() {
+			admissionHandler, err := New()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(admissionHandler.Handles(admission.Create)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Update)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Connect)).NotTo(BeTrue())
+			Expect(admissionHandler.Handles(admission.Delete)).NotTo(BeTrue())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return error if no Project lister is set", func() {
+			admissionHandler, _ := New()
+			Expect(admissionHandler.ValidateInitialization()).To(MatchError("missing Project lister"))
+		})
+
+		It("should not return error if all listers are set", func() {
+			admissionHandler, _ := New()
+			admissionHandler.SetInternalCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
+			Expect(admissionHandler.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
@@ -37,6 +37,7 @@ import (
 	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/internalversion"
 	timeutils "github.com/gardener/gardener/pkg/utils/time"
 	plugin "github.com/gardener/gardener/plugin/pkg"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
 )
 
 var (
@@ -47,6 +48,13 @@ var (
 		core.QuotaMetricStorageStandard,
 		core.QuotaMetricStoragePremium,
 		core.QuotaMetricLoadbalancer}
+
+	projectQuotaMetricNames = [5]corev1.ResourceName{
+		core.ProjectQuotaMetricShoots,
+		core.QuotaMetricCPU,
+		core.QuotaMetricGPU,
+		core.QuotaMetricMemory,
+		core.QuotaMetricLoadbalancer}
 )
 
 // Register registers a plugin.
@@ -63,6 +71,7 @@ type QuotaValidator struct {
 	cloudProfileLister  gardencorelisters.CloudProfileLister
 	secretBindingLister gardencorelisters.SecretBindingLister
 	quotaLister         gardencorelisters.QuotaLister
+	projectLister       gardencorelisters.ProjectLister
 	readyFunc           admission.ReadyFunc
 	time                timeutils.Ops
 }
@@ -101,7 +110,10 @@ func (q *QuotaValidator) SetInternalCoreInformerFactory(f gardencoreinformers.Sh
 	quotaInformer := f.Core().InternalVersion().Quotas()
 	q.quotaLister = quotaInformer.Lister()
 
-	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, cloudProfileInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced, quotaInformer.Informer().HasSynced)
+	projectInformer := f.Core().InternalVersion().Projects()
+	q.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, cloudProfileInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced, quotaInformer.Informer().HasSynced, projectInformer.Informer().HasSynced)
 }
 
 // ValidateInitialization checks whether the plugin was correctly initialized.
@@ -118,6 +130,9 @@ func (q *QuotaValidator) ValidateInitialization() error {
 	if q.quotaLister == nil {
 		return errors.New("missing quota lister")
 	}
+	if q.projectLister == nil {
+		return errors.New("missing project lister")
+	}
 	return nil
 }
 
@@ -218,6 +233,20 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 		}
 	}
 
+	if checkQuota {
+		exceededMetrics, err := q.isProjectQuotaExceeded(*shoot, a.GetOperation() == admission.Create)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		if len(exceededMetrics) > 0 {
+			message := ""
+			for _, metric := range exceededMetrics {
+				message = message + metric.String() + " "
+			}
+			return admission.NewForbidden(a, fmt.Errorf("project quota limits exceeded. Unable to allocate further %s", message))
+		}
+	}
+
 	// Admit Shoot lifetime changes
 	if lifetime, exists := shoot.Annotations[v1beta1constants.ShootExpirationTimestamp]; checkLifetime && exists && maxShootLifetime != nil {
 		var (
@@ -264,6 +293,63 @@ func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota core.Quota) (*[
 	return nil, nil
 }
 
+// isProjectQuotaExceeded checks the resources of all Shoots in the project of the given Shoot against the limits in
+// the project's `.spec.quota`. The number of Shoots is only checked if `checkShootCount` is true.
+func (q *QuotaValidator) isProjectQuotaExceeded(shoot core.Shoot, checkShootCount bool) ([]corev1.ResourceName, error) {
+	project, err := admissionutils.ProjectForNamespaceFromInternalLister(q.projectLister, shoot.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not find project for namespace %q: %w", shoot.Namespace, err)
+	}
+
+	if project.Spec.Quota == nil || len(project.Spec.Quota.Limits) == 0 {
+		return nil, nil
+	}
+
+	shoots, err := q.shootLister.Shoots(shoot.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	requiredResources := make(corev1.ResourceList)
+	addShootResources := func(s core.Shoot) error {
+		shootResources, err := q.getShootResources(s)
+		if err != nil {
+			return err
+		}
+		shootResources[core.ProjectQuotaMetricShoots] = *resource.NewQuantity(1, resource.DecimalSI)
+
+		for _, metric := range projectQuotaMetricNames {
+			requiredResources[metric] = sumQuantity(requiredResources[metric], shootResources[metric])
+		}
+		return nil
+	}
+
+	for _, s := range shoots {
+		if s.Name == shoot.Name {
+			continue
+		}
+		if err := addShootResources(*s); err != nil {
+			return nil, err
+		}
+	}
+	if err := addShootResources(shoot); err != nil {
+		return nil, err
+	}
+
+	var exceededMetrics []corev1.ResourceName
+	for _, metric := range projectQuotaMetricNames {
+		limit, ok := project.Spec.Quota.Limits[metric]
+		if !ok || (metric == core.ProjectQuotaMetricShoots && !checkShootCount) {
+			continue
+		}
+		if !hasSufficientQuota(limit, requiredResources[metric]) {
+			exceededMetrics = append(exceededMetrics, metric)
+		}
+	}
+
+	return exceededMetrics, nil
+}
+
 func (q *QuotaValidator) determineAllocatedResources(quota core.Quota, shoot core.Shoot) (corev1.ResourceList, error) {
 	shoots, err := q.findShootsReferQuota(quota, shoot)
 	if err != nil {
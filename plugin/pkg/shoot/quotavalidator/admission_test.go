@@ -30,6 +30,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	mocktime "github.com/gardener/gardener/pkg/utils/time/mock"
 	. "github.com/gardener/gardener/plugin/pkg/shoot/quotavalidator"
 )
@@ -47,6 +48,7 @@ var _ = Describe("quotavalidator", func() {
 			secretBinding       core.SecretBinding
 			quotaProject        core.Quota
 			quotaSecret         core.Quota
+			project             core.Project
 			cloudProfile        core.CloudProfile
 			namespace           string = "test"
 			trialNamespace      string = "trial"
@@ -221,6 +223,14 @@ var _ = Describe("quotavalidator", func() {
 			secretBinding = *secretBindingBase.DeepCopy()
 			quotaProject = *quotaProjectBase.DeepCopy()
 			quotaSecret = *quotaSecretBase.DeepCopy()
+			project = core.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-project",
+				},
+				Spec: core.ProjectSpec{
+					Namespace: pointer.String(namespace),
+				},
+			}
 
 			ctrl = gomock.NewController(GinkgoT())
 			timeOps = mocktime.NewMockOps(ctrl)
@@ -233,6 +243,7 @@ var _ = Describe("quotavalidator", func() {
 			Expect(coreInformerFactory.Core().InternalVersion().Quotas().Informer().GetStore().Add(&quotaProject)).To(Succeed())
 			Expect(coreInformerFactory.Core().InternalVersion().Quotas().Informer().GetStore().Add(&quotaSecret)).To(Succeed())
 			Expect(coreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBindingBase)).To(Succeed())
+			Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(&project)).To(Succeed())
 		})
 
 		Context("tests for Shoots, which have at least one Quota referenced", func() {
@@ -312,6 +323,95 @@ var _ = Describe("quotavalidator", func() {
 			})
 		})
 
+		Context("tests for Shoots in Projects with quota limits", func() {
+			BeforeEach(func() {
+				secretBinding.Quotas = nil
+				Expect(coreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+
+				project.Spec.Quota = &core.ProjectQuota{
+					Limits: corev1.ResourceList{
+						core.ProjectQuotaMetricShoots: resource.MustParse("2"),
+						core.QuotaMetricCPU:           resource.MustParse("4"),
+						core.QuotaMetricLoadbalancer:  resource.MustParse("4"),
+					},
+				}
+			})
+
+			It("should pass because the project quota limits are sufficient", func() {
+				shoot2 := *shoot.DeepCopy()
+				shoot2.Name = "test-shoot-2"
+				Expect(coreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore().Add(&shoot2)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should fail because the maximum number of shoots is reached", func() {
+				project.Spec.Quota.Limits[core.ProjectQuotaMetricShoots] = resource.MustParse("1")
+
+				shoot2 := *shoot.DeepCopy()
+				shoot2.Name = "test-shoot-2"
+				shoot2.Spec.Provider.Workers = nil
+				Expect(coreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore().Add(&shoot2)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("project quota limits exceeded. Unable to allocate further shoots")))
+			})
+
+			It("should fail because other shoots in the project exhaust the cpu limit", func() {
+				shoot2 := *shoot.DeepCopy()
+				shoot2.Name = "test-shoot-2"
+				shoot2.Spec.Provider.Workers[0].Maximum = 2
+				Expect(coreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore().Add(&shoot2)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("project quota limits exceeded. Unable to allocate further cpu")))
+			})
+
+			It("should fail because a worker update exceeds the cpu limit", func() {
+				oldShoot = *shoot.DeepCopy()
+				Expect(coreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore().Add(&oldShoot)).To(Succeed())
+				shoot.Spec.Provider.Workers[0].Maximum = 3
+
+				attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("project quota limits exceeded. Unable to allocate further cpu")))
+			})
+
+			It("should pass updates of existing shoots although the maximum number of shoots is reached", func() {
+				project.Spec.Quota.Limits[core.ProjectQuotaMetricShoots] = resource.MustParse("1")
+
+				oldShoot = *shoot.DeepCopy()
+				shoot2 := *shoot.DeepCopy()
+				shoot2.Name = "test-shoot-2"
+				shoot2.Spec.Provider.Workers = nil
+				Expect(coreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore().Add(&oldShoot)).To(Succeed())
+				Expect(coreInformerFactory.Core().InternalVersion().Shoots().Informer().GetStore().Add(&shoot2)).To(Succeed())
+				shoot.Spec.Provider.Workers[0].Maximum = 2
+
+				attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should fail because the project cannot be found", func() {
+				Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Delete(&project)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(BeInternalServerError())
+			})
+		})
+
 		Context("tests for Quota validation corner cases", func() {
 			It("should pass because shoot is intended to get deleted", func() {
 				var now metav1.Time
@@ -76,6 +76,7 @@ type ValidateShoot struct {
 	shootLister         gardencorelisters.ShootLister
 	projectLister       gardencorelisters.ProjectLister
 	secretBindingLister gardencorelisters.SecretBindingLister
+	backupEntryLister   gardencorelisters.BackupEntryLister
 	readyFunc           admission.ReadyFunc
 }
 
@@ -122,6 +123,9 @@ func (v *ValidateShoot) SetInternalCoreInformerFactory(f gardencoreinformers.Sha
 	secretBindingInformer := f.Core().InternalVersion().SecretBindings()
 	v.secretBindingLister = secretBindingInformer.Lister()
 
+	backupEntryInformer := f.Core().InternalVersion().BackupEntries()
+	v.backupEntryLister = backupEntryInformer.Lister()
+
 	readyFuncs = append(
 		readyFuncs,
 		seedInformer.Informer().HasSynced,
@@ -129,6 +133,7 @@ func (v *ValidateShoot) SetInternalCoreInformerFactory(f gardencoreinformers.Sha
 		cloudProfileInformer.Informer().HasSynced,
 		projectInformer.Informer().HasSynced,
 		secretBindingInformer.Informer().HasSynced,
+		backupEntryInformer.Informer().HasSynced,
 	)
 }
 
@@ -160,6 +165,9 @@ func (v *ValidateShoot) ValidateInitialization() error {
 	if v.projectLister == nil {
 		return errors.New("missing project lister")
 	}
+	if v.backupEntryLister == nil {
+		return errors.New("missing backupEntry lister")
+	}
 	return nil
 }
 
@@ -260,12 +268,22 @@ func (v *ValidateShoot) Admit(ctx context.Context, a admission.Attributes, _ adm
 		}
 	}
 
+	// The source BackupEntry of a cloned shoot is only relevant when the shoot is created or scheduled.
+	var backupEntry *core.BackupEntry
+	if shoot.Spec.Source != nil && (a.GetOperation() == admission.Create || (oldShoot.Spec.SeedName == nil && shoot.Spec.SeedName != nil)) {
+		backupEntry, err = v.backupEntryLister.BackupEntries(shoot.Namespace).Get(shoot.Spec.Source.BackupEntryName)
+		if err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("could not find referenced backup entry: %+v", err.Error()))
+		}
+	}
+
 	// begin of validation code
 	validationContext := &validationContext{
 		cloudProfile:  cloudProfile,
 		project:       project,
 		seed:          seed,
 		secretBinding: secretBinding,
+		backupEntry:   backupEntry,
 		shoot:         shoot,
 		oldShoot:      oldShoot,
 	}
@@ -282,6 +300,9 @@ func (v *ValidateShoot) Admit(ctx context.Context, a admission.Attributes, _ adm
 	if err := validationContext.validateShootHibernation(a); err != nil {
 		return err
 	}
+	if err := validationContext.validateSource(a); err != nil {
+		return err
+	}
 	if allErrs = validationContext.ensureMachineImages(); len(allErrs) > 0 {
 		return admission.NewForbidden(a, fmt.Errorf("%+v", allErrs))
 	}
@@ -316,6 +337,7 @@ type validationContext struct {
 	project       *core.Project
 	seed          *core.Seed
 	secretBinding *core.SecretBinding
+	backupEntry   *core.BackupEntry
 	shoot         *core.Shoot
 	oldShoot      *core.Shoot
 }
@@ -403,6 +425,10 @@ func (c *validationContext) validateScheduling(ctx context.Context, a admission.
 			return admission.NewForbidden(a, fmt.Errorf("cannot schedule shoot '%s' on seed '%s' that is already marked for deletion", c.shoot.Name, c.seed.Name))
 		}
 
+		if helper.SeedSettingDrainEnabled(c.seed.Spec.Settings) {
+			return admission.NewForbidden(a, fmt.Errorf("cannot schedule shoot '%s' on seed '%s' that is being drained", c.shoot.Name, c.seed.Name))
+		}
+
 		if !helper.TaintsAreTolerated(c.seed.Spec.Taints, c.shoot.Spec.Tolerations) {
 			return admission.NewForbidden(a, fmt.Errorf("forbidden to use a seed whose taints are not tolerated by the shoot"))
 		}
@@ -585,6 +611,35 @@ func (c *validationContext) validateShootHibernation(a admission.Attributes) err
 	return nil
 }
 
+// validateSource validates that a cloned shoot can be created from the referenced BackupEntry. The control plane of the
+// cloned shoot must run on the same seed as the source shoot since gardenlet copies the ETCD backups and the ETCD
+// encryption key of the source shoot within this seed.
+func (c *validationContext) validateSource(a admission.Attributes) error {
+	if c.backupEntry == nil {
+		return nil
+	}
+
+	if a.GetOperation() == admission.Create && c.shoot.Spec.Hibernation != nil && pointer.BoolDeref(c.shoot.Spec.Hibernation.Enabled, false) {
+		return admission.NewForbidden(a, fmt.Errorf("cannot create shoot '%s' from backup entry '%s' in hibernated state", c.shoot.Name, c.backupEntry.Name))
+	}
+
+	if c.backupEntry.DeletionTimestamp != nil {
+		return admission.NewForbidden(a, fmt.Errorf("cannot create shoot '%s' from backup entry '%s' that is already marked for deletion", c.shoot.Name, c.backupEntry.Name))
+	}
+
+	if c.seed != nil {
+		if c.backupEntry.Spec.SeedName == nil || *c.backupEntry.Spec.SeedName != c.seed.Name {
+			return admission.NewForbidden(a, fmt.Errorf("cannot schedule shoot '%s' on seed '%s' because its source backup entry '%s' is not scheduled to this seed", c.shoot.Name, c.seed.Name, c.backupEntry.Name))
+		}
+
+		if c.seed.Spec.Backup == nil {
+			return admission.NewForbidden(a, fmt.Errorf("cannot schedule shoot '%s' on seed '%s' because backup is not configured for this seed", c.shoot.Name, c.seed.Name))
+		}
+	}
+
+	return nil
+}
+
 func (c *validationContext) ensureMachineImages() field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -709,6 +709,91 @@ var _ = Describe("validator", func() {
 			})
 		})
 
+		Context("source checks", func() {
+			var backupEntry *core.BackupEntry
+
+			BeforeEach(func() {
+				backupEntry = &core.BackupEntry{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "shoot--foo--source--1234",
+						Namespace: shoot.Namespace,
+					},
+					Spec: core.BackupEntrySpec{
+						BucketName: "bucket",
+						SeedName:   pointer.String(seed.Name),
+					},
+				}
+
+				shoot.Spec.Source = &core.ShootSource{BackupEntryName: backupEntry.Name}
+
+				Expect(coreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().InternalVersion().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+			})
+
+			It("should allow creating a shoot from a backup entry on the same seed", func() {
+				Expect(coreInformerFactory.Core().InternalVersion().BackupEntries().Informer().GetStore().Add(backupEntry)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should reject creating a shoot from a non-existing backup entry", func() {
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(MatchError(ContainSubstring("could not find referenced backup entry")))
+			})
+
+			It("should reject creating a hibernated shoot from a backup entry", func() {
+				Expect(coreInformerFactory.Core().InternalVersion().BackupEntries().Informer().GetStore().Add(backupEntry)).To(Succeed())
+				shoot.Spec.Hibernation = &core.Hibernation{Enabled: pointer.Bool(true)}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("in hibernated state")))
+			})
+
+			It("should reject creating a shoot from a backup entry which is marked for deletion", func() {
+				now := metav1.Now()
+				backupEntry.DeletionTimestamp = &now
+				Expect(coreInformerFactory.Core().InternalVersion().BackupEntries().Informer().GetStore().Add(backupEntry)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("already marked for deletion")))
+			})
+
+			It("should reject scheduling a shoot onto a different seed than the one of the backup entry", func() {
+				backupEntry.Spec.SeedName = pointer.String("other-seed")
+				Expect(coreInformerFactory.Core().InternalVersion().BackupEntries().Informer().GetStore().Add(backupEntry)).To(Succeed())
+
+				oldShoot := shoot.DeepCopy()
+				oldShoot.Spec.SeedName = nil
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("because its source backup entry '%s' is not scheduled to this seed", backupEntry.Name)))
+			})
+
+			It("should reject scheduling a shoot onto a seed without backup", func() {
+				seed.Spec.Backup = nil
+				Expect(coreInformerFactory.Core().InternalVersion().Seeds().Informer().GetStore().Update(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().InternalVersion().BackupEntries().Informer().GetStore().Add(backupEntry)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("because backup is not configured for this seed")))
+			})
+		})
+
 		Context("seedName change", func() {
 			var (
 				oldShoot core.Shoot
@@ -4656,6 +4741,18 @@ var _ = Describe("validator", func() {
 					Expect(err.Error()).To(ContainSubstring("cannot schedule shoot '%s' on seed '%s' that is already marked for deletion", shoot.Name, newSeedName))
 				})
 
+				It("should reject update of binding if target seed is drained", func() {
+					newSeed.Spec.Settings = &core.SeedSettings{
+						Scheduling: &core.SeedSettingScheduling{Visible: true, Drain: &core.SeedSettingSchedulingDrain{}},
+					}
+
+					attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("cannot schedule shoot '%s' on seed '%s' that is being drained", shoot.Name, newSeedName))
+				})
+
 				It("should reject update of binding, because target Seed doesn't have configuration for backup", func() {
 					newSeed.Spec.Backup = nil
 
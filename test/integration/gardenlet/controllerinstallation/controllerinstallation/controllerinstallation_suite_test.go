@@ -16,6 +16,7 @@ package controllerinstallation_test
 
 import (
 	"context"
+	"encoding/base64"
 	"path/filepath"
 	"testing"
 
@@ -48,6 +49,7 @@ import (
 	gardenletfeatures "github.com/gardener/gardener/pkg/gardenlet/features"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/utils"
+	fakeoci "github.com/gardener/gardener/pkg/utils/oci/fake"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	"github.com/gardener/gardener/test/utils/namespacefinalizer"
 )
@@ -61,6 +63,14 @@ func TestControllerInstallation(t *testing.T) {
 const (
 	testID              = "controllerinstallation-controller-test"
 	seedClusterIdentity = "seed"
+
+	// chartBase64 was created via the following commands in the ./testdata/chart directory:
+	//   helm package . --version 0.1.0 --app-version 0.1.0 --destination /tmp/chart
+	//   cat /tmp/chart/test-0.1.0.tgz | base64 | tr -d '\n'
+	chartBase64 = "H4sIFAAAAAAA/ykAK2FIUjBjSE02THk5NWIzVjBkUzVpWlM5Nk9WVjZNV2xqYW5keVRRbz1IZWxtAOyUz2rDMAzGc/ZT6AkcOXHb4WvP22GMwo6i0RbT/DGxWhhp3300XQcLjB22rozldxGSkW2Z77NwlHRZUif6heoquQSIiHNrh4iI44jGLhJjc5PnmZkvbIImM7N5AniR24zYRqEuwW+fNR7uj0DBr7iLvm0c7IyiEN5T1EajKjiuOx9kKD1wFFW2NTsoRUJ0abq5idq3aclVrRo6rhwlpXYfd7n2mBOfMPhfuA4VCcd03TZP/vmHv4Kv/J9lOPK/zWc4+f83GPl/45vCwXJQwS0FVbNQQUJOAZzcfVLIWxoDrdlB34O+54opsr47l+FwUOfWHVVbjg72qu9B2keqK9CroQh78E3BjYA9dlz7PSYmJib+C68BAAD//6xO2UUADAAA"
+
+	ociRepository = "registry.example.com/charts/test"
+	ociDigest     = "sha256:0b3e6a6c0b4e0f3d37c1a9c5f1d1e2e8f4c5b0c7a4f6f2f0a9b8d3e7c1a2b3c4"
 )
 
 var (
@@ -238,6 +248,9 @@ var _ = BeforeSuite(func() {
 	// controller.
 	Expect((&namespacefinalizer.Reconciler{}).AddToManager(mgr)).To(Succeed())
 
+	chart, err := base64.StdEncoding.DecodeString(chartBase64)
+	Expect(err).NotTo(HaveOccurred())
+
 	By("Register controller")
 	Expect((&controllerinstallation.Reconciler{
 		SeedClientSet: testClientSet,
@@ -250,6 +263,7 @@ var _ = BeforeSuite(func() {
 		},
 		Identity:              identity,
 		GardenClusterIdentity: gardenClusterIdentity,
+		HelmRegistry:          fakeoci.NewHelmRegistry(map[string][]byte{ociRepository + "@" + ociDigest: chart}),
 	}).AddToManager(ctx, mgr, mgr)).To(Succeed())
 
 	By("Start manager")
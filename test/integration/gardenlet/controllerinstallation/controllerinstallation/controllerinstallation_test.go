@@ -59,10 +59,7 @@ var _ = Describe("ControllerInstallation controller tests", func() {
 			},
 			Type: "helm",
 			ProviderConfig: runtime.RawExtension{
-				// created via the following commands in the ./testdata/chart directory:
-				//   helm package . --version 0.1.0 --app-version 0.1.0 --destination /tmp/chart
-				//   cat /tmp/chart/test-0.1.0.tgz | base64 | tr -d '\n'
-				Raw: []byte(`{"chart": "H4sIFAAAAAAA/ykAK2FIUjBjSE02THk5NWIzVjBkUzVpWlM5Nk9WVjZNV2xqYW5keVRRbz1IZWxtAOyUz2rDMAzGc/ZT6AkcOXHb4WvP22GMwo6i0RbT/DGxWhhp3300XQcLjB22rozldxGSkW2Z77NwlHRZUif6heoquQSIiHNrh4iI44jGLhJjc5PnmZkvbIImM7N5AniR24zYRqEuwW+fNR7uj0DBr7iLvm0c7IyiEN5T1EajKjiuOx9kKD1wFFW2NTsoRUJ0abq5idq3aclVrRo6rhwlpXYfd7n2mBOfMPhfuA4VCcd03TZP/vmHv4Kv/J9lOPK/zWc4+f83GPl/45vCwXJQwS0FVbNQQUJOAZzcfVLIWxoDrdlB34O+54opsr47l+FwUOfWHVVbjg72qu9B2keqK9CroQh78E3BjYA9dlz7PSYmJib+C68BAAD//6xO2UUADAAA"}`),
+				Raw: []byte(`{"chart": "` + chartBase64 + `"}`),
 			},
 		}
 		controllerInstallation = &gardencorev1beta1.ControllerInstallation{
@@ -352,6 +349,37 @@ var _ = Describe("ControllerInstallation controller tests", func() {
 			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(gardenClusterServiceAccount), gardenClusterServiceAccount)).To(BeNotFoundError())
 		})
 
+		Context("with chart from OCI repository", func() {
+			BeforeEach(func() {
+				controllerDeployment.ProviderConfig.Raw = []byte(`{"ociRepository": {"repository": "` + ociRepository + `", "digest": "` + ociDigest + `"}}`)
+			})
+
+			It("should pull and deploy the chart", func() {
+				Eventually(func(g Gomega) {
+					managedResource := &resourcesv1alpha1.ManagedResource{}
+					g.Expect(testClient.Get(ctx, client.ObjectKey{Namespace: "garden", Name: controllerInstallation.Name}, managedResource)).To(Succeed())
+
+					secret := &corev1.Secret{}
+					g.Expect(testClient.Get(ctx, client.ObjectKey{Namespace: managedResource.Namespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+					g.Expect(secret.Data).To(HaveKey("test_templates_config.yaml"))
+				}).Should(Succeed())
+
+				Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(controllerInstallation), controllerInstallation)).To(Succeed())
+					return controllerInstallation.Status.Conditions
+				}).Should(ContainCondition(OfType(gardencorev1beta1.ControllerInstallationValid), WithStatus(gardencorev1beta1.ConditionTrue), WithReason("RegistrationValid")))
+			})
+
+			It("should report a failure if the chart cannot be pulled", func() {
+				controllerDeployment.ProviderConfig.Raw = []byte(`{"ociRepository": {"repository": "` + ociRepository + `", "digest": "sha256:0000000000000000000000000000000000000000000000000000000000000000"}}`)
+
+				Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(controllerInstallation), controllerInstallation)).To(Succeed())
+					return controllerInstallation.Status.Conditions
+				}).Should(ContainCondition(OfType(gardencorev1beta1.ControllerInstallationValid), WithStatus(gardencorev1beta1.ConditionFalse), WithReason("ChartCannotBePulled")))
+			})
+		})
+
 		It("should not overwrite the Installed condition when it is not 'Unknown'", func() {
 			By("Wait for condition to be maintained initially")
 			Eventually(func(g Gomega) []gardencorev1beta1.Condition {